@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ConcludeTransaction makes a ConcludeTransaction gRPC request to a vtctld.
+	ConcludeTransaction = &cobra.Command{
+		Use:  "ConcludeTransaction <dtid>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandConcludeTransaction,
+	}
+	// GetUnresolvedTransactions makes a GetUnresolvedTransactions gRPC request to a vtctld.
+	GetUnresolvedTransactions = &cobra.Command{
+		Use:  "GetUnresolvedTransactions <keyspace>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetUnresolvedTransactions,
+	}
+	// RollbackTransaction makes a RollbackTransaction gRPC request to a vtctld.
+	RollbackTransaction = &cobra.Command{
+		Use:  "RollbackTransaction <dtid>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandRollbackTransaction,
+	}
+)
+
+func commandConcludeTransaction(cmd *cobra.Command, args []string) error {
+	dtid := cmd.Flags().Arg(0)
+
+	cli.FinishedParsing(cmd)
+
+	_, err := client.ConcludeTransaction(commandCtx, &vtctldatapb.ConcludeTransactionRequest{
+		Dtid: dtid,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully concluded distributed transaction %s\n", dtid)
+
+	return nil
+}
+
+var getUnresolvedTransactionsOptions = struct {
+	AbandonAge time.Duration
+}{}
+
+func commandGetUnresolvedTransactions(cmd *cobra.Command, args []string) error {
+	req := &vtctldatapb.GetUnresolvedTransactionsRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	}
+	if getUnresolvedTransactionsOptions.AbandonAge > 0 {
+		req.AbandonAge = protoutil.DurationToProto(getUnresolvedTransactionsOptions.AbandonAge)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetUnresolvedTransactions(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandRollbackTransaction(cmd *cobra.Command, args []string) error {
+	dtid := cmd.Flags().Arg(0)
+
+	cli.FinishedParsing(cmd)
+
+	_, err := client.RollbackTransaction(commandCtx, &vtctldatapb.RollbackTransactionRequest{
+		Dtid: dtid,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully rolled back distributed transaction %s\n", dtid)
+
+	return nil
+}
+
+func init() {
+	Root.AddCommand(ConcludeTransaction)
+
+	GetUnresolvedTransactions.Flags().DurationVar(&getUnresolvedTransactionsOptions.AbandonAge, "abandon-age", 0, "Only list the transactions that were created at least this long ago. By default, all the transactions are listed, including the ones that are being committed.")
+	Root.AddCommand(GetUnresolvedTransactions)
+
+	Root.AddCommand(RollbackTransaction)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+	"vitess.io/vitess/go/vt/vtstream"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+var (
+	usage = `
+vtstream streams the changes of a keyspace from a vtgate server, and writes
+them as change events in the JSON envelope of Debezium to the standard
+output, a file or Kafka. The position of the stream is saved in the
+checkpoint store after each transaction, and the stream resumes from it
+when vtstream restarts. The changes of the last transaction may be written
+again after a restart.
+
+Examples:
+
+  $ vtstream -server vtgate:15991 -keyspace commerce -name cdc
+
+  $ vtstream -server vtgate:15991 -keyspace commerce -shards 0 -position '' -tables customer,corder \
+      -name cdc -sink kafka -kafka_brokers kafka1:9092,kafka2:9092 -checkpoint_dir /var/lib/vtstream
+`
+	server          = flag.String("server", "", "vtgate server to connect to")
+	keyspace        = flag.String("keyspace", "", "keyspace to stream from")
+	shards          = flag.String("shards", "", "comma-separated shards to stream from, all the shards of the keyspace if empty")
+	position        = flag.String("position", "current", "position to start from when there's no checkpoint: current, or empty to copy the tables first, which requires -shards")
+	tabletType      = flag.String("tablet_type", "replica", "tablet type to stream from")
+	tables          = flag.String("tables", "", "comma-separated tables to stream, all the tables if empty")
+	name            = flag.String("name", "", "name of the stream, which prefixes the topics and identifies the checkpoint")
+	format          = flag.String("format", string(vtstream.FormatDebezium), "format of the change events: debezium or cloudevents")
+	sink            = flag.String("sink", "stdout", "where to write the change events: stdout, file or kafka")
+	file            = flag.String("file", "", "file to append the change events to, with -sink file")
+	kafkaBrokers    = flag.String("kafka_brokers", "", "comma-separated host:port of the Kafka bootstrap brokers, with -sink kafka")
+	checkpointStore = flag.String("checkpoint_store", "file", "checkpoint store: file or memory")
+	checkpointDir   = flag.String("checkpoint_dir", ".", "directory of the checkpoints, with -checkpoint_store file")
+	retryDelay      = flag.Duration("retry_delay", 5*time.Second, "time to wait before restarting a stream that failed")
+)
+
+func init() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprint(os.Stderr, usage)
+	}
+}
+
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
+}
+
+func newSink() (vtstream.Sink, error) {
+	switch *sink {
+	case "stdout":
+		return vtstream.NewStdoutSink(), nil
+	case "file":
+		if *file == "" {
+			return nil, fmt.Errorf("-file is required with -sink file")
+		}
+		return vtstream.NewFileSink(*file)
+	case "kafka":
+		if *kafkaBrokers == "" {
+			return nil, fmt.Errorf("-kafka_brokers is required with -sink kafka")
+		}
+		return vtstream.NewKafkaSink(splitList(*kafkaBrokers))
+	}
+	return nil, fmt.Errorf("unknown sink %s, must be one of stdout, file, kafka", *sink)
+}
+
+func run(ctx context.Context) error {
+	tt, err := topoproto.ParseTabletType(*tabletType)
+	if err != nil {
+		return err
+	}
+	config := vtstream.Config{
+		Name:       *name,
+		Keyspace:   *keyspace,
+		Shards:     splitList(*shards),
+		Position:   *position,
+		TabletType: tt,
+		Format:     vtstream.Format(*format),
+		RetryDelay: *retryDelay,
+	}
+	if *tables != "" {
+		config.Filter = &binlogdatapb.Filter{}
+		for _, table := range splitList(*tables) {
+			config.Filter.Rules = append(config.Filter.Rules, &binlogdatapb.Rule{Match: table})
+		}
+	}
+
+	store, err := vtstream.NewCheckpointStore(*checkpointStore, *checkpointDir)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	s, err := newSink()
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	streamer, err := vtstream.NewStreamer(config, conn, store, s)
+	if err != nil {
+		return err
+	}
+	return streamer.Run(ctx)
+}
+
+func main() {
+	defer exit.Recover()
+	defer logutil.Flush()
+
+	// The change events may be written to the standard output.
+	flag.Lookup("logtostderr").Value.Set("true")
+	flag.Parse()
+	if len(flag.Args()) != 0 {
+		flag.Usage()
+		exit.Return(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		<-sigChan
+		cancel()
+	}()
+
+	if err := run(ctx); err != nil {
+		log.Errorf("vtstream: %v", err)
+		exit.Return(1)
+	}
+}
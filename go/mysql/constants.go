@@ -327,6 +327,7 @@ const (
 
 	// deadline exceeded
 	ERLockWaitTimeout = 1205
+	ERQueryTimeout    = 3024
 
 	// unavailable
 	ERServerShutdown = 1053
@@ -491,6 +492,7 @@ const (
 	ERDerivedMustHaveAlias         = 1248
 	ERTableNameNotAllowedHere      = 1250
 	ERQueryInterrupted             = 1317
+	ERViewWrongList                = 1353
 	ERTruncatedWrongValueForField  = 1366
 	ERDataTooLong                  = 1406
 	ERDataOutOfRange               = 1690
@@ -532,6 +534,9 @@ const (
 	// SSDupKey is ER_DUP_KEY
 	SSDupKey = "23000"
 
+	// SSAmbiguousColumn is ER_NON_UNIQ_ERROR
+	SSAmbiguousColumn = "23000"
+
 	// SSCantDoThisDuringAnTransaction is
 	// ER_CANT_DO_THIS_DURING_AN_TRANSACTION
 	SSCantDoThisDuringAnTransaction = "25000"
@@ -40,3 +40,8 @@ func DurationFromProto(dpb *durationpb.Duration) (time.Duration, bool, error) {
 
 	return dgo, true, nil
 }
+
+// DurationToProto converts a time.Duration to a durationpb type.
+func DurationToProto(dgo time.Duration) *durationpb.Duration {
+	return ptypes.DurationProto(dgo)
+}
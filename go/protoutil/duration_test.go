@@ -81,3 +81,15 @@ func TestDurationFromProto(t *testing.T) {
 		})
 	}
 }
+
+func TestDurationToProto(t *testing.T) {
+	t.Parallel()
+
+	dpb := DurationToProto(time.Second*1000 + time.Millisecond)
+	assert.Equal(t, &durationpb.Duration{Seconds: 1000, Nanos: 1000000}, dpb)
+
+	dgo, ok, err := DurationFromProto(dpb)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second*1000+time.Millisecond, dgo)
+}
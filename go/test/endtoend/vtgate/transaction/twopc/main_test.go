@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twopc
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/endtoend/cluster"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/vtctldclient"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+
+	_ "vitess.io/vitess/go/vt/vtctl/grpcvtctldclient"
+	_ "vitess.io/vitess/go/vt/vttablet/grpctabletconn"
+)
+
+var (
+	clusterInstance *cluster.LocalProcessCluster
+	vtParams        mysql.ConnParams
+	keyspaceName    = "ks"
+	cell            = "zone1"
+	hostname        = "localhost"
+	sqlSchema       = `
+	create table twopc_t (
+		id bigint,
+		msg varchar(64),
+		primary key (id)
+	) Engine=InnoDB;`
+
+	vSchema = `
+	{
+		"sharded": true,
+		"vindexes": {
+			"hash_index": {
+				"type": "hash"
+			}
+		},
+		"tables": {
+			"twopc_t": {
+				"column_vindexes": [
+					{
+						"column": "id",
+						"name": "hash_index"
+					}
+				]
+			}
+		}
+	}
+	`
+)
+
+func TestMain(m *testing.M) {
+	defer cluster.PanicHandler(nil)
+	flag.Parse()
+
+	exitcode, err := func() (int, error) {
+		clusterInstance = cluster.NewCluster(cell, hostname)
+		defer clusterInstance.Teardown()
+
+		// Reserve vtGate port in order to pass it to vtTablet
+		clusterInstance.VtgateGrpcPort = clusterInstance.GetAndReservePort()
+		// The abandon age is long enough for the tablets' watchdogs to leave
+		// the transactions that the tests abandon to the vtctld.
+		clusterInstance.VtTabletExtraArgs = []string{
+			"-twopc_enable",
+			"-twopc_coordinator_address", fmt.Sprintf("localhost:%d", clusterInstance.VtgateGrpcPort),
+			"-twopc_abandon_age", "3600",
+		}
+
+		// Start topo server
+		if err := clusterInstance.StartTopo(); err != nil {
+			return 1, err
+		}
+		if err := restartVtctld(); err != nil {
+			return 1, err
+		}
+
+		// Start keyspace
+		keyspace := &cluster.Keyspace{
+			Name:      keyspaceName,
+			SchemaSQL: sqlSchema,
+			VSchema:   vSchema,
+		}
+		if err := clusterInstance.StartKeyspace(*keyspace, []string{"-80", "80-"}, 0, false); err != nil {
+			return 1, err
+		}
+
+		clusterInstance.VtGateExtraArgs = []string{"-transaction_mode", "TWOPC"}
+		if err := clusterInstance.StartVtgate(); err != nil {
+			return 1, err
+		}
+		vtParams = mysql.ConnParams{
+			Host: clusterInstance.Hostname,
+			Port: clusterInstance.VtgateMySQLPort,
+		}
+
+		return m.Run(), nil
+	}()
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		os.Exit(1)
+	} else {
+		os.Exit(exitcode)
+	}
+}
+
+// restartVtctld restarts the vtctld with the gRPC vtctld service, which the
+// distributed transactions are resolved with, and with extra arguments.
+func restartVtctld(extraArgs ...string) error {
+	if err := clusterInstance.VtctldProcess.TearDown(); err != nil {
+		return err
+	}
+	clusterInstance.VtctldProcess.ServiceMap = "grpc-vtctl,grpc-vtctld"
+	return clusterInstance.VtctldProcess.Setup(cell, extraArgs...)
+}
+
+func vtctldClient(t *testing.T) vtctldclient.VtctldClient {
+	t.Helper()
+	client, err := vtctldclient.New("grpc", fmt.Sprintf("%s:%d", clusterInstance.Hostname, clusterInstance.VtctldProcess.GrpcPort))
+	require.NoError(t, err)
+	return client
+}
+
+// shardPrimary returns the primary tablet of a shard.
+func shardPrimary(shard string) *cluster.Vttablet {
+	for _, s := range clusterInstance.Keyspaces[0].Shards {
+		if s.Name == shard {
+			return s.MasterTablet()
+		}
+	}
+	return nil
+}
+
+// dialTablet connects to the query service of a tablet, the way a VTGate
+// coordinating a distributed transaction does.
+func dialTablet(t *testing.T, tablet *cluster.Vttablet) queryservice.QueryService {
+	t.Helper()
+	alias, err := topoproto.ParseTabletAlias(tablet.Alias)
+	require.NoError(t, err)
+	qs, err := tabletconn.GetDialer()(&topodatapb.Tablet{
+		Alias:    alias,
+		Hostname: clusterInstance.Hostname,
+		PortMap:  map[string]int32{"grpc": int32(tablet.GrpcPort)},
+	}, grpcclient.FailFast(false))
+	require.NoError(t, err)
+	return qs
+}
+
+// crashTablet stops a tablet while it has prepared transactions, and starts
+// it again. The tablet prepares them again from its redo logs.
+func crashTablet(t *testing.T, tablet *cluster.Vttablet) {
+	t.Helper()
+	require.NoError(t, tablet.VttabletProcess.TearDown())
+	tablet.VttabletProcess.ServingStatus = "SERVING"
+	require.NoError(t, tablet.VttabletProcess.Setup())
+}
+
+func exec(t *testing.T, conn *mysql.Conn, query string) *sqltypes.Result {
+	t.Helper()
+	qr, err := conn.ExecuteFetch(query, 1000, true)
+	require.NoError(t, err, query)
+	return qr
+}
+
+func connect(t *testing.T) *mysql.Conn {
+	t.Helper()
+	conn, err := mysql.Connect(context.Background(), &vtParams)
+	require.NoError(t, err)
+	return conn
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twopc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/endtoend/cluster"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/vtctl/vtctldclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// abandonTransaction runs a distributed transaction that writes the row mmID
+// on shard -80, which is its metadata manager, and the row rmID on shard 80-,
+// and abandons it the way a VTGate that crashes does: after 80- is prepared,
+// and after the decision to commit is made if commit is set.
+func abandonTransaction(t *testing.T, mmID, rmID int, commit bool) string {
+	t.Helper()
+	ctx := context.Background()
+	mmTarget := &querypb.Target{Keyspace: keyspaceName, Shard: "-80", TabletType: topodatapb.TabletType_MASTER}
+	rmTarget := &querypb.Target{Keyspace: keyspaceName, Shard: "80-", TabletType: topodatapb.TabletType_MASTER}
+	mm := dialTablet(t, shardPrimary("-80"))
+	defer mm.Close(ctx)
+	rm := dialTablet(t, shardPrimary("80-"))
+	defer rm.Close(ctx)
+
+	mmTxID, _, err := mm.Begin(ctx, mmTarget, nil)
+	require.NoError(t, err)
+	_, err = mm.Execute(ctx, mmTarget, fmt.Sprintf("insert into twopc_t(id, msg) values (%d, 'mm')", mmID), nil, mmTxID, 0, nil)
+	require.NoError(t, err)
+	rmTxID, _, err := rm.Begin(ctx, rmTarget, nil)
+	require.NoError(t, err)
+	_, err = rm.Execute(ctx, rmTarget, fmt.Sprintf("insert into twopc_t(id, msg) values (%d, 'rm')", rmID), nil, rmTxID, 0, nil)
+	require.NoError(t, err)
+
+	dtid := dtids.New(&vtgatepb.Session_ShardSession{Target: mmTarget, TransactionId: mmTxID})
+	require.NoError(t, mm.CreateTransaction(ctx, mmTarget, dtid, []*querypb.Target{rmTarget}))
+	require.NoError(t, rm.Prepare(ctx, rmTarget, rmTxID, dtid))
+	if commit {
+		require.NoError(t, mm.StartCommit(ctx, mmTarget, mmTxID, dtid))
+	}
+	return dtid
+}
+
+func unresolvedTransactions(t *testing.T, client vtctldclient.VtctldClient) map[string]querypb.TransactionState {
+	t.Helper()
+	resp, err := client.GetUnresolvedTransactions(context.Background(), &vtctldatapb.GetUnresolvedTransactionsRequest{Keyspace: keyspaceName})
+	require.NoError(t, err)
+	states := make(map[string]querypb.TransactionState)
+	for _, transaction := range resp.Transactions {
+		states[transaction.Dtid] = transaction.State
+	}
+	return states
+}
+
+func rowCount(t *testing.T, ids ...int) int {
+	t.Helper()
+	conn := connect(t)
+	defer conn.Close()
+	count := 0
+	for _, id := range ids {
+		count += len(exec(t, conn, fmt.Sprintf("select id from twopc_t where id = %d", id)).Rows)
+	}
+	return count
+}
+
+// TestConcludeCommittedTransaction crashes a participant of a transaction that
+// has a commit decision, and commits it on the participant with vtctld.
+func TestConcludeCommittedTransaction(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	client := vtctldClient(t)
+	defer client.Close()
+
+	dtid := abandonTransaction(t, 1, 4, true)
+	crashTablet(t, shardPrimary("80-"))
+
+	assert.Equal(t, querypb.TransactionState_COMMIT, unresolvedTransactions(t, client)[dtid])
+	assert.Equal(t, 1, rowCount(t, 1, 4), "only the metadata manager is committed")
+
+	_, err := client.ConcludeTransaction(context.Background(), &vtctldatapb.ConcludeTransactionRequest{Dtid: dtid})
+	require.NoError(t, err)
+	assert.Equal(t, 2, rowCount(t, 1, 4))
+	assert.NotContains(t, unresolvedTransactions(t, client), dtid)
+}
+
+// TestRollbackPreparedTransaction crashes a participant of a transaction that
+// has no decision, and rolls it back with vtctld.
+func TestRollbackPreparedTransaction(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	client := vtctldClient(t)
+	defer client.Close()
+
+	dtid := abandonTransaction(t, 2, 6, false)
+	crashTablet(t, shardPrimary("80-"))
+
+	assert.Equal(t, querypb.TransactionState_PREPARE, unresolvedTransactions(t, client)[dtid])
+	_, err := client.ConcludeTransaction(context.Background(), &vtctldatapb.ConcludeTransactionRequest{Dtid: dtid})
+	require.Error(t, err, "a transaction without a decision can't be concluded")
+
+	_, err = client.RollbackTransaction(context.Background(), &vtctldatapb.RollbackTransactionRequest{Dtid: dtid})
+	require.NoError(t, err)
+	assert.Equal(t, 0, rowCount(t, 2, 6))
+	assert.NotContains(t, unresolvedTransactions(t, client), dtid)
+
+	// The rows that were rolled back can be written again.
+	conn := connect(t)
+	defer conn.Close()
+	exec(t, conn, "begin")
+	exec(t, conn, "insert into twopc_t(id, msg) values (2, 'again'), (6, 'again')")
+	exec(t, conn, "commit")
+	assert.Equal(t, 2, rowCount(t, 2, 6))
+}
+
+// TestTransactionResolver crashes the participants of abandoned transactions,
+// and waits for the vtctld transaction resolver to resolve them.
+func TestTransactionResolver(t *testing.T) {
+	defer cluster.PanicHandler(t)
+	require.NoError(t, restartVtctld("-transaction_resolver_interval", "1s", "-transaction_resolver_abandon_age", "1s"))
+	defer func() {
+		require.NoError(t, restartVtctld())
+	}()
+	client := vtctldClient(t)
+	defer client.Close()
+
+	committed := abandonTransaction(t, 3, 7, true)
+	rolledBack := abandonTransaction(t, 5, 8, false)
+	crashTablet(t, shardPrimary("80-"))
+
+	timeout := time.After(30 * time.Second)
+	for {
+		unresolved := unresolvedTransactions(t, client)
+		_, ok1 := unresolved[committed]
+		_, ok2 := unresolved[rolledBack]
+		if !ok1 && !ok2 {
+			break
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("the abandoned transactions were not resolved: %v", unresolved)
+		case <-time.After(time.Second):
+		}
+	}
+	assert.Equal(t, 2, rowCount(t, 3, 7))
+	assert.Equal(t, 0, rowCount(t, 5, 8))
+}
@@ -259,6 +259,51 @@ func TestHealthCheckStreamError(t *testing.T) {
 	assert.True(t, want.DeepEqual(result), "Wrong TabletHealth data\n Expected: %v\n Actual:   %v", want, result)
 }
 
+func TestHealthCheckTableStatistics(t *testing.T) {
+	ts := memorytopo.NewServer("cell")
+	hc := createTestHc(ts)
+	defer hc.Close()
+
+	tablet := createTestTablet(0, "cell", "a")
+	input := make(chan *querypb.StreamHealthResponse)
+	resultChan := hc.Subscribe()
+	createFakeConn(tablet, input)
+	hc.AddTablet(tablet)
+	<-resultChan
+
+	masterTarget := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_MASTER}
+	stats := []*querypb.TableStatistics{{Name: "t1", Rows: 10}}
+	input <- &querypb.StreamHealthResponse{
+		TabletAlias:   tablet.Alias,
+		Target:        masterTarget,
+		Serving:       true,
+		RealtimeStats: &querypb.RealtimeStats{TableStatistics: stats},
+	}
+	result := <-resultChan
+	assert.Equal(t, stats, result.Stats.TableStatistics)
+
+	// The master only sends the table statistics when they
+	// change, so the last ones are kept in its other messages.
+	input <- &querypb.StreamHealthResponse{
+		TabletAlias:   tablet.Alias,
+		Target:        masterTarget,
+		Serving:       true,
+		RealtimeStats: &querypb.RealtimeStats{CpuUsage: 0.5},
+	}
+	result = <-resultChan
+	assert.Equal(t, stats, result.Stats.TableStatistics)
+
+	// They are dropped when the tablet is not a master anymore.
+	input <- &querypb.StreamHealthResponse{
+		TabletAlias:   tablet.Alias,
+		Target:        &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+		Serving:       true,
+		RealtimeStats: &querypb.RealtimeStats{},
+	}
+	result = <-resultChan
+	assert.Nil(t, result.Stats.TableStatistics)
+}
+
 func TestHealthCheckVerifiesTabletAlias(t *testing.T) {
 	ts := memorytopo.NewServer("cell")
 	hc := createTestHc(ts)
@@ -190,6 +190,11 @@ func (thc *tabletHealthCheck) processResponse(hc *HealthCheckImpl, shr *query.St
 		currentTarget.TabletType != topodata.TabletType_MASTER && currentTarget.TabletType == shr.Target.TabletType && thc.isTrivialReplagChange(shr.RealtimeStats)
 	isMasterUpdate := shr.Target.TabletType == topodata.TabletType_MASTER
 	isMasterChange := thc.Target.TabletType != topodata.TabletType_MASTER && shr.Target.TabletType == topodata.TabletType_MASTER
+	if isMasterUpdate && !isMasterChange && len(shr.RealtimeStats.TableStatistics) == 0 && thc.Stats != nil {
+		// The master only sends the table statistics when they change,
+		// so the last ones are kept for the subscribers that missed them.
+		shr.RealtimeStats.TableStatistics = thc.Stats.TableStatistics
+	}
 	thc.lastResponseTimestamp = time.Now()
 	thc.Target = shr.Target
 	thc.MasterTermStartTime = shr.TabletExternallyReparentedTimestamp
@@ -3971,10 +3971,18 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// table_schema_changed is the list of tables whose schema has changed
+	// since the last health message. It is only set in the health message
+	// sent when the change is detected.
+	TableSchemaChanged []string `protobuf:"bytes,7,rep,name=table_schema_changed,json=tableSchemaChanged,proto3" json:"table_schema_changed,omitempty"`
+	// table_statistics contains the estimated statistics of the tables
+	// of the keyspace. It is only set by masters when the table statistics
+	// are enabled, in the first message of a stream and when it changes.
+	TableStatistics      []*TableStatistics `protobuf:"bytes,8,rep,name=table_statistics,json=tableStatistics,proto3" json:"table_statistics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *RealtimeStats) Reset()         { *m = RealtimeStats{} }
@@ -4044,6 +4052,20 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetTableSchemaChanged() []string {
+	if m != nil {
+		return m.TableSchemaChanged
+	}
+	return nil
+}
+
+func (m *RealtimeStats) GetTableStatistics() []*TableStatistics {
+	if m != nil {
+		return m.TableStatistics
+	}
+	return nil
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4296,6 +4318,66 @@ func (m *TransactionMetadata) GetParticipants() []*Target {
 	return nil
 }
 
+// TableStatistics contains the estimated statistics of a table,
+// as maintained by MySQL.
+type TableStatistics struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// rows is the estimated number of rows of the table.
+	Rows int64 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	// cardinality is the estimated number of distinct values of
+	// the leading column of each index, keyed by column name.
+	Cardinality          map[string]int64 `protobuf:"bytes,3,rep,name=cardinality,proto3" json:"cardinality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TableStatistics) Reset()         { *m = TableStatistics{} }
+func (m *TableStatistics) String() string { return proto.CompactTextString(m) }
+func (*TableStatistics) ProtoMessage()    {}
+func (*TableStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{60}
+}
+
+func (m *TableStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableStatistics.Unmarshal(m, b)
+}
+func (m *TableStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableStatistics.Marshal(b, m, deterministic)
+}
+func (m *TableStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableStatistics.Merge(m, src)
+}
+func (m *TableStatistics) XXX_Size() int {
+	return xxx_messageInfo_TableStatistics.Size(m)
+}
+func (m *TableStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableStatistics proto.InternalMessageInfo
+
+func (m *TableStatistics) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TableStatistics) GetRows() int64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *TableStatistics) GetCardinality() map[string]int64 {
+	if m != nil {
+		return m.Cardinality
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("query.MySqlFlag", MySqlFlag_name, MySqlFlag_value)
 	proto.RegisterEnum("query.Flag", Flag_name, Flag_value)
@@ -4368,214 +4450,222 @@ func init() {
 	proto.RegisterType((*AggregateStats)(nil), "query.AggregateStats")
 	proto.RegisterType((*StreamHealthResponse)(nil), "query.StreamHealthResponse")
 	proto.RegisterType((*TransactionMetadata)(nil), "query.TransactionMetadata")
+	proto.RegisterType((*TableStatistics)(nil), "query.TableStatistics")
+	proto.RegisterMapType((map[string]int64)(nil), "query.TableStatistics.CardinalityEntry")
 }
 
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x70, 0x23, 0x49,
-	0x5a, 0xee, 0x2a, 0x3d, 0x2c, 0xfd, 0xb2, 0xe4, 0x74, 0xda, 0xee, 0xd1, 0xb8, 0xe7, 0xe1, 0xad,
-	0xdd, 0xd9, 0x6d, 0x0c, 0xb8, 0x7b, 0xdc, 0xbd, 0x4d, 0x33, 0xbb, 0xc0, 0x94, 0xe5, 0xb2, 0x47,
-	0xdd, 0x52, 0x49, 0x9d, 0x2a, 0xb9, 0xb7, 0x27, 0x88, 0xa8, 0x28, 0x4b, 0xd9, 0x72, 0x85, 0x4b,
-	0x55, 0x72, 0x55, 0xd9, 0x3d, 0xba, 0x35, 0x2c, 0xcb, 0xf2, 0x66, 0x79, 0xb3, 0x6c, 0xb0, 0x41,
-	0x04, 0x07, 0x82, 0x0b, 0x67, 0xce, 0x1c, 0xe6, 0xc0, 0x81, 0x08, 0x8e, 0xc0, 0x01, 0x38, 0x10,
-	0x70, 0xda, 0x20, 0x38, 0x70, 0xd8, 0x03, 0x41, 0xe4, 0xa3, 0x4a, 0x92, 0xad, 0xe9, 0xf6, 0xf6,
-	0xb2, 0x41, 0x74, 0xcf, 0xdc, 0xf2, 0x7f, 0xe4, 0xe3, 0xff, 0xf2, 0xcf, 0xff, 0xff, 0x2b, 0x2b,
-	0xa1, 0x74, 0x72, 0x4a, 0xc3, 0xf1, 0xd6, 0x28, 0x0c, 0xe2, 0x00, 0xe7, 0x38, 0xb1, 0x5e, 0x89,
-	0x83, 0x51, 0xd0, 0x77, 0x62, 0x47, 0xb0, 0xd7, 0x4b, 0x67, 0x71, 0x38, 0xea, 0x09, 0x42, 0xfb,
-	0x86, 0x02, 0x79, 0xcb, 0x09, 0x07, 0x34, 0xc6, 0xeb, 0x50, 0x38, 0xa6, 0xe3, 0x68, 0xe4, 0xf4,
-	0x68, 0x55, 0xd9, 0x50, 0xae, 0x17, 0x49, 0x4a, 0xe3, 0x55, 0xc8, 0x45, 0x47, 0x4e, 0xd8, 0xaf,
-	0xaa, 0x5c, 0x20, 0x08, 0xfc, 0x65, 0x28, 0xc5, 0xce, 0xa1, 0x47, 0x63, 0x3b, 0x1e, 0x8f, 0x68,
-	0x35, 0xb3, 0xa1, 0x5c, 0xaf, 0x6c, 0xaf, 0x6e, 0xa5, 0xf3, 0x59, 0x5c, 0x68, 0x8d, 0x47, 0x94,
-	0x40, 0x9c, 0xb6, 0x31, 0x86, 0x6c, 0x8f, 0x7a, 0x5e, 0x35, 0xcb, 0xc7, 0xe2, 0x6d, 0x6d, 0x17,
-	0x2a, 0x07, 0xd6, 0xbe, 0x13, 0xd3, 0x9a, 0xe3, 0x79, 0x34, 0xac, 0xef, 0xb2, 0xe5, 0x9c, 0x46,
-	0x34, 0xf4, 0x9d, 0x61, 0xba, 0x9c, 0x84, 0xc6, 0x57, 0x21, 0x3f, 0x08, 0x83, 0xd3, 0x51, 0x54,
-	0x55, 0x37, 0x32, 0xd7, 0x8b, 0x44, 0x52, 0xda, 0xcf, 0x03, 0x18, 0x67, 0xd4, 0x8f, 0xad, 0xe0,
-	0x98, 0xfa, 0xf8, 0x0d, 0x28, 0xc6, 0xee, 0x90, 0x46, 0xb1, 0x33, 0x1c, 0xf1, 0x21, 0x32, 0x64,
-	0xc2, 0xf8, 0x04, 0x93, 0xd6, 0xa1, 0x30, 0x0a, 0x22, 0x37, 0x76, 0x03, 0x9f, 0xdb, 0x53, 0x24,
-	0x29, 0xad, 0xfd, 0x2c, 0xe4, 0x0e, 0x1c, 0xef, 0x94, 0xe2, 0xb7, 0x21, 0xcb, 0x0d, 0x56, 0xb8,
-	0xc1, 0xa5, 0x2d, 0x01, 0x3a, 0xb7, 0x93, 0x0b, 0xd8, 0xd8, 0x67, 0x4c, 0x93, 0x8f, 0xbd, 0x48,
-	0x04, 0xa1, 0x1d, 0xc3, 0xe2, 0x8e, 0xeb, 0xf7, 0x0f, 0x9c, 0xd0, 0x65, 0x60, 0xbc, 0xe0, 0x30,
-	0xf8, 0x0b, 0x90, 0xe7, 0x8d, 0xa8, 0x9a, 0xd9, 0xc8, 0x5c, 0x2f, 0x6d, 0x2f, 0xca, 0x8e, 0x7c,
-	0x6d, 0x44, 0xca, 0xb4, 0xbf, 0x51, 0x00, 0x76, 0x82, 0x53, 0xbf, 0xff, 0x80, 0x09, 0x31, 0x82,
-	0x4c, 0x74, 0xe2, 0x49, 0x20, 0x59, 0x13, 0xdf, 0x87, 0xca, 0xa1, 0xeb, 0xf7, 0xed, 0x33, 0xb9,
-	0x1c, 0x81, 0x65, 0x69, 0xfb, 0x0b, 0x72, 0xb8, 0x49, 0xe7, 0xad, 0xe9, 0x55, 0x47, 0x86, 0x1f,
-	0x87, 0x63, 0x52, 0x3e, 0x9c, 0xe6, 0xad, 0x77, 0x01, 0x5f, 0x54, 0x62, 0x93, 0x1e, 0xd3, 0x71,
-	0x32, 0xe9, 0x31, 0x1d, 0xe3, 0x1f, 0x9b, 0xb6, 0xa8, 0xb4, 0xbd, 0x92, 0xcc, 0x35, 0xd5, 0x57,
-	0x9a, 0xf9, 0x9e, 0x7a, 0x57, 0xd1, 0xbe, 0x9f, 0x87, 0x8a, 0xf1, 0x11, 0xed, 0x9d, 0xc6, 0xb4,
-	0x35, 0x62, 0x7b, 0x10, 0xe1, 0x26, 0x2c, 0xb9, 0x7e, 0xcf, 0x3b, 0xed, 0xd3, 0xbe, 0xfd, 0xd8,
-	0xa5, 0x5e, 0x3f, 0xe2, 0x7e, 0x54, 0x49, 0xd7, 0x3d, 0xab, 0xbf, 0x55, 0x97, 0xca, 0x7b, 0x5c,
-	0x97, 0x54, 0xdc, 0x19, 0x1a, 0x6f, 0xc2, 0x72, 0xcf, 0x73, 0xa9, 0x1f, 0xdb, 0x8f, 0x99, 0xbd,
-	0x76, 0x18, 0x3c, 0x89, 0xaa, 0xb9, 0x0d, 0xe5, 0x7a, 0x81, 0x2c, 0x09, 0xc1, 0x1e, 0xe3, 0x93,
-	0xe0, 0x49, 0x84, 0xdf, 0x83, 0xc2, 0x93, 0x20, 0x3c, 0xf6, 0x02, 0xa7, 0x5f, 0xcd, 0xf3, 0x39,
-	0xdf, 0x9a, 0x3f, 0xe7, 0x43, 0xa9, 0x45, 0x52, 0x7d, 0x7c, 0x1d, 0x50, 0x74, 0xe2, 0xd9, 0x11,
-	0xf5, 0x68, 0x2f, 0xb6, 0x3d, 0x77, 0xe8, 0xc6, 0xd5, 0x02, 0x77, 0xc9, 0x4a, 0x74, 0xe2, 0x75,
-	0x38, 0xbb, 0xc1, 0xb8, 0xd8, 0x86, 0xb5, 0x38, 0x74, 0xfc, 0xc8, 0xe9, 0xb1, 0xc1, 0x6c, 0x37,
-	0x0a, 0x3c, 0x87, 0xbb, 0x63, 0x91, 0x4f, 0xb9, 0x39, 0x7f, 0x4a, 0x6b, 0xd2, 0xa5, 0x9e, 0xf4,
-	0x20, 0xab, 0xf1, 0x1c, 0x2e, 0x7e, 0x17, 0xd6, 0xa2, 0x63, 0x77, 0x64, 0xf3, 0x71, 0xec, 0x91,
-	0xe7, 0xf8, 0x76, 0xcf, 0xe9, 0x1d, 0xd1, 0x2a, 0x70, 0xb3, 0x31, 0x13, 0xf2, 0x7d, 0x6f, 0x7b,
-	0x8e, 0x5f, 0x63, 0x12, 0x06, 0x3a, 0xd3, 0xf3, 0x69, 0x68, 0x9f, 0xd1, 0x30, 0x62, 0xab, 0x29,
-	0x3d, 0x0b, 0xf4, 0xb6, 0x50, 0x3e, 0x10, 0xba, 0xa4, 0x32, 0x9a, 0xa1, 0xb5, 0xaf, 0x40, 0x65,
-	0x76, 0x5b, 0xf0, 0x32, 0x94, 0xad, 0x47, 0x6d, 0xc3, 0xd6, 0xcd, 0x5d, 0xdb, 0xd4, 0x9b, 0x06,
-	0xba, 0x82, 0xcb, 0x50, 0xe4, 0xac, 0x96, 0xd9, 0x78, 0x84, 0x14, 0xbc, 0x00, 0x19, 0xbd, 0xd1,
-	0x40, 0xaa, 0x76, 0x17, 0x0a, 0x09, 0xbe, 0x78, 0x09, 0x4a, 0x5d, 0xb3, 0xd3, 0x36, 0x6a, 0xf5,
-	0xbd, 0xba, 0xb1, 0x8b, 0xae, 0xe0, 0x02, 0x64, 0x5b, 0x0d, 0xab, 0x8d, 0x14, 0xd1, 0xd2, 0xdb,
-	0x48, 0x65, 0x3d, 0x77, 0x77, 0x74, 0x94, 0xd1, 0xfe, 0x42, 0x81, 0xd5, 0x79, 0x38, 0xe1, 0x12,
-	0x2c, 0xec, 0x1a, 0x7b, 0x7a, 0xb7, 0x61, 0xa1, 0x2b, 0x78, 0x05, 0x96, 0x88, 0xd1, 0x36, 0x74,
-	0x4b, 0xdf, 0x69, 0x18, 0x36, 0x31, 0xf4, 0x5d, 0xa4, 0x60, 0x0c, 0x15, 0xd6, 0xb2, 0x6b, 0xad,
-	0x66, 0xb3, 0x6e, 0x59, 0xc6, 0x2e, 0x52, 0xf1, 0x2a, 0x20, 0xce, 0xeb, 0x9a, 0x13, 0x6e, 0x06,
-	0x23, 0x58, 0xec, 0x18, 0xa4, 0xae, 0x37, 0xea, 0x1f, 0xb2, 0x01, 0x50, 0x16, 0x7f, 0x0e, 0xde,
-	0xac, 0xb5, 0xcc, 0x4e, 0xbd, 0x63, 0x19, 0xa6, 0x65, 0x77, 0x4c, 0xbd, 0xdd, 0xf9, 0xa0, 0x65,
-	0xf1, 0x91, 0x85, 0x71, 0x39, 0x5c, 0x01, 0xd0, 0xbb, 0x56, 0x4b, 0x8c, 0x83, 0xf2, 0xda, 0x09,
-	0x54, 0x66, 0x21, 0x64, 0xab, 0x92, 0x4b, 0xb4, 0xdb, 0x0d, 0xdd, 0x34, 0x0d, 0x82, 0xae, 0xe0,
-	0x3c, 0xa8, 0x07, 0xb7, 0x84, 0xad, 0xfb, 0xd4, 0xbf, 0x8d, 0x54, 0x36, 0x10, 0x6b, 0xed, 0x87,
-	0x94, 0xf6, 0xc7, 0x28, 0xc3, 0xd6, 0xcd, 0xe8, 0x06, 0x7d, 0x1c, 0x6f, 0x13, 0x77, 0x70, 0x14,
-	0xa3, 0x2c, 0x5b, 0x37, 0xe3, 0x3d, 0x74, 0xe3, 0xa3, 0x3d, 0xc7, 0xf3, 0x0e, 0x9d, 0xde, 0x31,
-	0xca, 0xdd, 0xcb, 0x16, 0x14, 0xa4, 0xde, 0xcb, 0x16, 0x54, 0x94, 0xb9, 0x97, 0x2d, 0x64, 0x50,
-	0x56, 0xfb, 0x6b, 0x15, 0x72, 0x7c, 0x7b, 0x58, 0xc0, 0x9e, 0x0a, 0xc3, 0xbc, 0x9d, 0x06, 0x2f,
-	0xf5, 0x19, 0xc1, 0x8b, 0xc7, 0x7c, 0x19, 0x46, 0x05, 0x81, 0xaf, 0x41, 0x31, 0x08, 0x07, 0xb6,
-	0x90, 0x88, 0x04, 0x50, 0x08, 0xc2, 0x01, 0xcf, 0x14, 0x2c, 0xf8, 0xb2, 0xbc, 0x71, 0xe8, 0x44,
-	0x94, 0x9f, 0xc1, 0x22, 0x49, 0x69, 0xfc, 0x3a, 0x30, 0x3d, 0x9b, 0xaf, 0x23, 0xcf, 0x65, 0x0b,
-	0x41, 0x38, 0x30, 0xd9, 0x52, 0x3e, 0x0f, 0xe5, 0x5e, 0xe0, 0x9d, 0x0e, 0x7d, 0xdb, 0xa3, 0xfe,
-	0x20, 0x3e, 0xaa, 0x2e, 0x6c, 0x28, 0xd7, 0xcb, 0x64, 0x51, 0x30, 0x1b, 0x9c, 0x87, 0xab, 0xb0,
-	0xd0, 0x3b, 0x72, 0xc2, 0x88, 0x8a, 0x73, 0x57, 0x26, 0x09, 0xc9, 0x67, 0xa5, 0x3d, 0x77, 0xe8,
-	0x78, 0x11, 0x3f, 0x63, 0x65, 0x92, 0xd2, 0xcc, 0x88, 0xc7, 0x9e, 0x33, 0x88, 0xf8, 0xd9, 0x28,
-	0x13, 0x41, 0xe0, 0xb7, 0xa1, 0x24, 0x27, 0xe4, 0x10, 0x94, 0xf8, 0x72, 0x40, 0xb0, 0x18, 0x02,
-	0xda, 0x4f, 0x41, 0x86, 0x04, 0x4f, 0xd8, 0x9c, 0x62, 0x45, 0x51, 0x55, 0xd9, 0xc8, 0x5c, 0xc7,
-	0x24, 0x21, 0x59, 0x02, 0x93, 0x31, 0x5c, 0x84, 0xf6, 0x24, 0x6a, 0x7f, 0x47, 0x81, 0x12, 0x3f,
-	0x7b, 0x84, 0x46, 0xa7, 0x5e, 0xcc, 0x62, 0xbd, 0x0c, 0x72, 0xca, 0x4c, 0xac, 0xe7, 0xfb, 0x42,
-	0xa4, 0x8c, 0x01, 0xc0, 0xe2, 0x96, 0xed, 0x3c, 0x7e, 0x4c, 0x7b, 0x31, 0x15, 0x29, 0x2d, 0x4b,
-	0x16, 0x19, 0x53, 0x97, 0x3c, 0x86, 0xbc, 0xeb, 0x47, 0x34, 0x8c, 0x6d, 0xb7, 0xcf, 0xf7, 0x24,
-	0x4b, 0x0a, 0x82, 0x51, 0xef, 0xe3, 0xb7, 0x20, 0xcb, 0x23, 0x5f, 0x96, 0xcf, 0x02, 0x72, 0x16,
-	0x12, 0x3c, 0x21, 0x9c, 0x7f, 0x2f, 0x5b, 0xc8, 0xa1, 0xbc, 0xf6, 0x55, 0x58, 0xe4, 0x8b, 0x7b,
-	0xe8, 0x84, 0xbe, 0xeb, 0x0f, 0x78, 0x22, 0x0f, 0xfa, 0xc2, 0x2f, 0xca, 0x84, 0xb7, 0x99, 0xcd,
-	0x43, 0x1a, 0x45, 0xce, 0x80, 0xca, 0xc4, 0x9a, 0x90, 0xda, 0x9f, 0x65, 0xa0, 0xd4, 0x89, 0x43,
-	0xea, 0x0c, 0x79, 0x8e, 0xc6, 0x5f, 0x05, 0x88, 0x62, 0x27, 0xa6, 0x43, 0xea, 0xc7, 0x89, 0x7d,
-	0x6f, 0xc8, 0x99, 0xa7, 0xf4, 0xb6, 0x3a, 0x89, 0x12, 0x99, 0xd2, 0xc7, 0xdb, 0x50, 0xa2, 0x4c,
-	0x6c, 0xc7, 0x2c, 0xd7, 0xcb, 0x7c, 0xb2, 0x9c, 0x84, 0xa3, 0xb4, 0x08, 0x20, 0x40, 0xd3, 0xf6,
-	0xfa, 0x77, 0x55, 0x28, 0xa6, 0xa3, 0x61, 0x1d, 0x0a, 0x3d, 0x27, 0xa6, 0x83, 0x20, 0x1c, 0xcb,
-	0x14, 0xfc, 0xce, 0xb3, 0x66, 0xdf, 0xaa, 0x49, 0x65, 0x92, 0x76, 0xc3, 0x6f, 0x82, 0xa8, 0x6b,
-	0x84, 0x5b, 0x0a, 0x7b, 0x8b, 0x9c, 0xc3, 0x1d, 0xf3, 0x3d, 0xc0, 0xa3, 0xd0, 0x1d, 0x3a, 0xe1,
-	0xd8, 0x3e, 0xa6, 0xe3, 0x24, 0x5d, 0x65, 0xe6, 0xec, 0x24, 0x92, 0x7a, 0xf7, 0xe9, 0x58, 0x46,
-	0xc4, 0xbb, 0xb3, 0x7d, 0xa5, 0xb7, 0x5c, 0xdc, 0x9f, 0xa9, 0x9e, 0xbc, 0x00, 0x88, 0x92, 0x54,
-	0x9f, 0xe3, 0x8e, 0xc5, 0x9a, 0xda, 0x97, 0xa0, 0x90, 0x2c, 0x1e, 0x17, 0x21, 0x67, 0x84, 0x61,
-	0x10, 0xa2, 0x2b, 0x3c, 0x30, 0x36, 0x1b, 0x22, 0xb6, 0xee, 0xee, 0xb2, 0xd8, 0xfa, 0xaf, 0x6a,
-	0x9a, 0x6f, 0x09, 0x3d, 0x39, 0xa5, 0x51, 0x8c, 0x7f, 0x0e, 0x56, 0x28, 0x77, 0x21, 0xf7, 0x8c,
-	0xda, 0x3d, 0x5e, 0x9c, 0x31, 0x07, 0x52, 0x38, 0xde, 0x4b, 0x5b, 0xa2, 0x96, 0x4c, 0x8a, 0x36,
-	0xb2, 0x9c, 0xea, 0x4a, 0x56, 0x1f, 0x1b, 0xb0, 0xe2, 0x0e, 0x87, 0xb4, 0xef, 0x3a, 0xf1, 0xf4,
-	0x00, 0x62, 0xc3, 0xd6, 0x92, 0xda, 0x65, 0xa6, 0xf6, 0x23, 0xcb, 0x69, 0x8f, 0x74, 0x98, 0x77,
-	0x20, 0x1f, 0xf3, 0x3a, 0x95, 0xfb, 0x6e, 0x69, 0xbb, 0x9c, 0x44, 0x1c, 0xce, 0x24, 0x52, 0x88,
-	0xbf, 0x04, 0xa2, 0xea, 0xe5, 0xb1, 0x65, 0xe2, 0x10, 0x93, 0x62, 0x86, 0x08, 0x39, 0x7e, 0x07,
-	0x2a, 0x33, 0x69, 0xb6, 0xcf, 0x01, 0xcb, 0x90, 0xf2, 0x74, 0xce, 0xec, 0xe3, 0x1b, 0xb0, 0x10,
-	0x88, 0xa4, 0xc6, 0xa3, 0xce, 0x64, 0xc5, 0xb3, 0x19, 0x8f, 0x24, 0x5a, 0x2c, 0x36, 0x84, 0x34,
-	0xa2, 0xe1, 0x19, 0xed, 0xb3, 0x41, 0x17, 0xf8, 0xa0, 0x90, 0xb0, 0xea, 0x7d, 0xed, 0x67, 0x60,
-	0x29, 0x85, 0x38, 0x1a, 0x05, 0x7e, 0x44, 0xf1, 0x26, 0xe4, 0x43, 0x7e, 0xde, 0x25, 0xac, 0x58,
-	0xce, 0x31, 0x15, 0x09, 0x88, 0xd4, 0xd0, 0xfa, 0xb0, 0x24, 0x38, 0x2c, 0x7e, 0xf3, 0x9d, 0xc4,
-	0xef, 0x40, 0x8e, 0xb2, 0xc6, 0xb9, 0x4d, 0x21, 0xed, 0x1a, 0x97, 0x13, 0x21, 0x9d, 0x9a, 0x45,
-	0x7d, 0xee, 0x2c, 0xff, 0xa9, 0xc2, 0x8a, 0x5c, 0xe5, 0x8e, 0x13, 0xf7, 0x8e, 0x5e, 0x52, 0x6f,
-	0xf8, 0x71, 0x58, 0x60, 0x7c, 0x37, 0x3d, 0x39, 0x73, 0xfc, 0x21, 0xd1, 0x60, 0x1e, 0xe1, 0x44,
-	0xf6, 0xd4, 0xf6, 0xcb, 0x3a, 0xb0, 0xec, 0x44, 0x53, 0x55, 0xc3, 0x1c, 0xc7, 0xc9, 0x3f, 0xc7,
-	0x71, 0x16, 0x2e, 0xe3, 0x38, 0xda, 0x2e, 0xac, 0xce, 0x22, 0x2e, 0x9d, 0xe3, 0x27, 0x60, 0x41,
-	0x6c, 0x4a, 0x12, 0x23, 0xe7, 0xed, 0x5b, 0xa2, 0xa2, 0x7d, 0xac, 0xc2, 0xaa, 0x0c, 0x5f, 0x9f,
-	0x8e, 0x73, 0x3c, 0x85, 0x73, 0xee, 0x52, 0x07, 0xf4, 0x72, 0xfb, 0xa7, 0xd5, 0x60, 0xed, 0x1c,
-	0x8e, 0x2f, 0x70, 0x58, 0xbf, 0xa7, 0xc0, 0xe2, 0x0e, 0x1d, 0xb8, 0xfe, 0x4b, 0xba, 0x0b, 0x53,
-	0xe0, 0x66, 0x2f, 0xe5, 0xc4, 0x23, 0x28, 0x4b, 0x7b, 0x25, 0x5a, 0x17, 0xd1, 0x56, 0xe6, 0x9d,
-	0x96, 0xbb, 0xb0, 0x28, 0x6f, 0x12, 0x1c, 0xcf, 0x75, 0xa2, 0xd4, 0x9e, 0x73, 0x57, 0x09, 0x3a,
-	0x13, 0x12, 0x79, 0xe9, 0xc0, 0x09, 0xed, 0xdf, 0x14, 0x28, 0xd7, 0x82, 0xe1, 0xd0, 0x8d, 0x5f,
-	0x52, 0x8c, 0x2f, 0x22, 0x94, 0x9d, 0xe7, 0x8f, 0xef, 0x42, 0x25, 0x31, 0x53, 0x42, 0x7b, 0x2e,
-	0xd3, 0x28, 0x17, 0x32, 0xcd, 0xbf, 0x2b, 0xb0, 0x44, 0x02, 0x51, 0xe1, 0xbf, 0xda, 0xe0, 0xdc,
-	0x02, 0x34, 0x31, 0xf4, 0xb2, 0xf0, 0x7c, 0x5f, 0x81, 0x4a, 0x3b, 0xa4, 0x23, 0x27, 0xa4, 0xaf,
-	0x34, 0x3a, 0xac, 0x4c, 0xef, 0xc7, 0xb2, 0xc0, 0x29, 0x12, 0xde, 0xd6, 0x96, 0x61, 0x29, 0xb5,
-	0x5d, 0x00, 0xa6, 0xfd, 0xa3, 0x02, 0x6b, 0xc2, 0xc5, 0xa4, 0xa4, 0xff, 0x92, 0xc2, 0x92, 0xd8,
-	0x9b, 0x9d, 0xb2, 0xb7, 0x0a, 0x57, 0xcf, 0xdb, 0x26, 0xcd, 0xfe, 0xba, 0x0a, 0xaf, 0x25, 0xce,
-	0xf3, 0x92, 0x1b, 0xfe, 0x43, 0xf8, 0xc3, 0x3a, 0x54, 0x2f, 0x82, 0x20, 0x11, 0xfa, 0x96, 0x0a,
-	0xd5, 0x5a, 0x48, 0x9d, 0x98, 0x4e, 0xd5, 0x41, 0xaf, 0x8e, 0x6f, 0xe0, 0x77, 0x61, 0x71, 0xe4,
-	0x84, 0xb1, 0xdb, 0x73, 0x47, 0x0e, 0xfb, 0x14, 0xcd, 0xf1, 0x32, 0xeb, 0xdc, 0x00, 0x33, 0x2a,
-	0xda, 0x35, 0x78, 0x7d, 0x0e, 0x22, 0x12, 0xaf, 0xff, 0x51, 0x00, 0x77, 0x62, 0x27, 0x8c, 0x3f,
-	0x05, 0x79, 0x69, 0xae, 0x33, 0xad, 0xc1, 0xca, 0x8c, 0xfd, 0xd3, 0xb8, 0xd0, 0xf8, 0x53, 0x91,
-	0x92, 0x3e, 0x11, 0x97, 0x69, 0xfb, 0x25, 0x2e, 0xff, 0xac, 0xc0, 0x7a, 0x2d, 0x10, 0x17, 0xa2,
-	0xaf, 0xe4, 0x09, 0xd3, 0xde, 0x84, 0x6b, 0x73, 0x0d, 0x94, 0x00, 0xfc, 0x93, 0x02, 0x57, 0x09,
-	0x75, 0xfa, 0xaf, 0xa6, 0xf1, 0x0f, 0xe0, 0xb5, 0x0b, 0xc6, 0xc9, 0x1a, 0xe5, 0x0e, 0x14, 0x86,
-	0x34, 0x76, 0x58, 0x85, 0x2b, 0x4d, 0x5a, 0x4f, 0xc6, 0x9d, 0x68, 0x37, 0xa5, 0x06, 0x49, 0x75,
-	0xb5, 0x7f, 0x51, 0x61, 0x85, 0xd7, 0xd9, 0x9f, 0x7d, 0xe4, 0x5d, 0xea, 0x16, 0x26, 0x7f, 0xbe,
-	0xf8, 0x63, 0x0a, 0xa3, 0x90, 0xda, 0xc9, 0xed, 0xc0, 0x02, 0xff, 0x8d, 0x08, 0xa3, 0x90, 0x3e,
-	0x10, 0x1c, 0xed, 0x6f, 0x15, 0x58, 0x9d, 0x85, 0x38, 0xfd, 0xa2, 0xf9, 0xbf, 0xbe, 0x6d, 0x99,
-	0x13, 0x52, 0x32, 0x97, 0xf9, 0x48, 0xca, 0x5e, 0xfa, 0x23, 0xe9, 0xef, 0x54, 0xa8, 0x4e, 0x1b,
-	0xf3, 0xd9, 0x9d, 0xce, 0xec, 0x9d, 0xce, 0x0f, 0x7a, 0xcb, 0xa7, 0xfd, 0xbd, 0x02, 0xaf, 0xcf,
-	0x01, 0xf4, 0x07, 0x73, 0x91, 0xa9, 0x9b, 0x1d, 0xf5, 0xb9, 0x37, 0x3b, 0x3f, 0x7a, 0x27, 0xf9,
-	0x07, 0x05, 0x56, 0x9b, 0xe2, 0xae, 0x5e, 0xdc, 0x7c, 0xbc, 0xbc, 0x31, 0x98, 0x5f, 0xc7, 0x67,
-	0x27, 0x7f, 0xab, 0xb4, 0x1a, 0xac, 0x9d, 0x33, 0xed, 0x05, 0x6e, 0x73, 0xfe, 0x5b, 0x81, 0x65,
-	0x39, 0x8a, 0xfe, 0xd2, 0x96, 0x2f, 0x73, 0xd0, 0xc1, 0x6f, 0x41, 0xc6, 0xed, 0x27, 0x75, 0xef,
-	0xec, 0x73, 0x02, 0x26, 0xd0, 0xde, 0x07, 0x3c, 0x6d, 0xf7, 0x0b, 0x40, 0xf7, 0x1f, 0x2a, 0xac,
-	0x11, 0x11, 0x7d, 0x3f, 0xfb, 0xbf, 0xf0, 0xc3, 0xfe, 0x5f, 0x78, 0x76, 0xe2, 0xfa, 0x98, 0x17,
-	0x53, 0xb3, 0x50, 0xff, 0xe8, 0x52, 0xd7, 0xb9, 0x44, 0x9b, 0xb9, 0x90, 0x68, 0x5f, 0x3c, 0x1e,
-	0x7d, 0xac, 0xc2, 0xba, 0x34, 0xe4, 0xb3, 0x5a, 0xe7, 0xf2, 0x1e, 0x91, 0xbf, 0xe0, 0x11, 0xff,
-	0xa5, 0xc0, 0xb5, 0xb9, 0x40, 0xfe, 0xbf, 0x57, 0x34, 0xe7, 0xbc, 0x27, 0xfb, 0x5c, 0xef, 0xc9,
-	0x5d, 0xda, 0x7b, 0xbe, 0xa9, 0x42, 0x85, 0x50, 0x8f, 0x3a, 0xd1, 0x2b, 0x7e, 0xbb, 0x77, 0x0e,
-	0xc3, 0xdc, 0x85, 0x7b, 0xce, 0x65, 0x58, 0x4a, 0x81, 0x90, 0x1f, 0x5c, 0xfc, 0x03, 0x9d, 0xe5,
-	0xc1, 0x0f, 0xa8, 0xe3, 0xc5, 0x49, 0x25, 0xa8, 0xfd, 0xb9, 0x0a, 0x65, 0xc2, 0x38, 0xee, 0x90,
-	0x76, 0x62, 0x27, 0x8e, 0xf0, 0xe7, 0x60, 0xf1, 0x88, 0xab, 0xd8, 0x13, 0x0f, 0x29, 0x92, 0x92,
-	0xe0, 0x89, 0xbf, 0x8f, 0xdb, 0xb0, 0x16, 0xd1, 0x5e, 0xe0, 0xf7, 0x23, 0xfb, 0x90, 0x1e, 0xb9,
-	0x7e, 0xdf, 0x1e, 0x3a, 0x51, 0x4c, 0x43, 0x0e, 0x4b, 0x99, 0xac, 0x48, 0xe1, 0x0e, 0x97, 0x35,
-	0xb9, 0x08, 0xdf, 0x84, 0xd5, 0x43, 0xd7, 0xf7, 0x82, 0x81, 0x3d, 0xf2, 0x9c, 0x31, 0x0d, 0x23,
-	0xbb, 0x17, 0x9c, 0xfa, 0x02, 0x8f, 0x1c, 0xc1, 0x42, 0xd6, 0x16, 0xa2, 0x1a, 0x93, 0xe0, 0x0f,
-	0x61, 0x73, 0xee, 0x2c, 0xf6, 0x63, 0xd7, 0x8b, 0x69, 0x48, 0xfb, 0x76, 0x48, 0x47, 0x9e, 0xdb,
-	0x13, 0x4f, 0xa5, 0x04, 0x50, 0x5f, 0x9c, 0x33, 0xf5, 0x9e, 0x54, 0x27, 0x13, 0x6d, 0x7c, 0x0d,
-	0x8a, 0xbd, 0xd1, 0xa9, 0x7d, 0xca, 0x1f, 0x2d, 0x30, 0xfc, 0x14, 0x52, 0xe8, 0x8d, 0x4e, 0xbb,
-	0x8c, 0xc6, 0x08, 0x32, 0x27, 0x23, 0x11, 0x9c, 0x15, 0xc2, 0x9a, 0xda, 0xf7, 0x14, 0xa8, 0xe8,
-	0x83, 0x41, 0x48, 0x07, 0x4e, 0x2c, 0x61, 0xba, 0x09, 0xab, 0x02, 0x92, 0xb1, 0x2d, 0xdd, 0x55,
-	0xd8, 0xa3, 0x08, 0x7b, 0xa4, 0x4c, 0xf8, 0xaa, 0xb0, 0xe7, 0x36, 0x5c, 0x3d, 0xf5, 0xe7, 0xf6,
-	0x51, 0x79, 0x9f, 0xd5, 0x54, 0x3a, 0xdd, 0xeb, 0xa7, 0xe1, 0xf5, 0xf9, 0x28, 0x0c, 0x5d, 0xf1,
-	0x5c, 0xb1, 0x4c, 0xae, 0xce, 0x31, 0xba, 0xe9, 0xfa, 0xcf, 0xe8, 0xea, 0x7c, 0xc4, 0xf1, 0xfa,
-	0x84, 0xae, 0xce, 0x47, 0xda, 0x5f, 0xa6, 0xff, 0x14, 0x13, 0x77, 0x49, 0x03, 0x47, 0xe2, 0xc8,
-	0xca, 0xb3, 0x1c, 0xb9, 0x0a, 0x0b, 0xcc, 0x19, 0x5d, 0x7f, 0xc0, 0x8d, 0x2b, 0x90, 0x84, 0xc4,
-	0x1d, 0xf8, 0xa2, 0xb4, 0x9d, 0x7e, 0x14, 0xd3, 0xd0, 0x77, 0x3c, 0x6f, 0x6c, 0x8b, 0xeb, 0x47,
-	0x3f, 0xa6, 0x7d, 0x7b, 0xf2, 0x7c, 0x53, 0x84, 0x8f, 0xcf, 0x0b, 0x6d, 0x23, 0x55, 0x26, 0xa9,
-	0xae, 0x95, 0x3e, 0xec, 0xfc, 0x0a, 0x54, 0x42, 0xe9, 0xc4, 0x76, 0xc4, 0xb6, 0x47, 0x86, 0xdc,
-	0xd5, 0xe4, 0xd5, 0xc4, 0xb4, 0x87, 0x93, 0x72, 0x38, 0xe3, 0xf0, 0x2f, 0x1c, 0x70, 0xee, 0x65,
-	0x0b, 0x79, 0xb4, 0xa0, 0xfd, 0x95, 0x02, 0x2b, 0x73, 0xbe, 0xdd, 0xd3, 0x8b, 0x01, 0x65, 0xea,
-	0xde, 0xf1, 0x27, 0x21, 0xc7, 0x1f, 0xb4, 0xc8, 0x37, 0x54, 0xaf, 0x5d, 0xfc, 0xf4, 0xe7, 0x8f,
-	0x4f, 0x88, 0xd0, 0x62, 0x67, 0x91, 0xdb, 0xd4, 0xe3, 0x17, 0x8f, 0x49, 0x44, 0x2d, 0x31, 0x9e,
-	0xb8, 0x8b, 0xbc, 0x78, 0x93, 0x99, 0x7d, 0xee, 0x4d, 0xe6, 0xe6, 0xef, 0x66, 0xa0, 0xd8, 0x1c,
-	0x77, 0x4e, 0xbc, 0x3d, 0xcf, 0x19, 0xf0, 0xd7, 0x21, 0xcd, 0xb6, 0xf5, 0x08, 0x5d, 0xc1, 0xcb,
-	0x50, 0x36, 0x5b, 0x96, 0x6d, 0x76, 0x1b, 0x0d, 0x7b, 0xaf, 0xa1, 0xef, 0x23, 0x05, 0x23, 0x58,
-	0x6c, 0x93, 0xba, 0x7d, 0xdf, 0x78, 0x24, 0x38, 0x2a, 0x5e, 0x81, 0xa5, 0xae, 0x59, 0x7f, 0xd0,
-	0x35, 0x26, 0xcc, 0x2c, 0x5e, 0x83, 0xe5, 0x66, 0xb7, 0x61, 0xd5, 0xdb, 0x8d, 0x29, 0x76, 0x01,
-	0x97, 0xa1, 0xb8, 0xd3, 0x68, 0xed, 0x08, 0x12, 0xb1, 0xf1, 0xbb, 0x66, 0xa7, 0xbe, 0x6f, 0x1a,
-	0xbb, 0x82, 0xb5, 0xc1, 0x58, 0x1f, 0x1a, 0xa4, 0xb5, 0x57, 0x4f, 0xa6, 0x7c, 0x1f, 0x23, 0x28,
-	0xed, 0xd4, 0x4d, 0x9d, 0xc8, 0x51, 0x9e, 0x2a, 0xb8, 0x02, 0x45, 0xc3, 0xec, 0x36, 0x25, 0xad,
-	0xe2, 0x2a, 0xac, 0xe8, 0x5d, 0xab, 0x65, 0xd7, 0xcd, 0x1a, 0x31, 0x9a, 0x86, 0x69, 0x49, 0x49,
-	0x16, 0xaf, 0x40, 0xc5, 0xaa, 0x37, 0x8d, 0x8e, 0xa5, 0x37, 0xdb, 0x92, 0xc9, 0x56, 0x51, 0xe8,
-	0x18, 0x89, 0x0e, 0xc2, 0xeb, 0xb0, 0x66, 0xb6, 0xec, 0xe4, 0x69, 0xdd, 0x81, 0xde, 0xe8, 0x1a,
-	0x52, 0xb6, 0x81, 0x5f, 0x03, 0xdc, 0x32, 0xed, 0x6e, 0x7b, 0x57, 0xb7, 0x0c, 0xdb, 0x6c, 0x3d,
-	0x94, 0x82, 0xf7, 0x71, 0x05, 0x0a, 0x93, 0x15, 0x3c, 0x65, 0x28, 0x94, 0xdb, 0x3a, 0xb1, 0x26,
-	0xc6, 0x3e, 0x7d, 0xca, 0xc0, 0x82, 0x7d, 0xd2, 0xea, 0xb6, 0x27, 0x6a, 0xcb, 0x50, 0x92, 0x60,
-	0x49, 0x56, 0x96, 0xb1, 0x76, 0xea, 0x66, 0x2d, 0x5d, 0xdf, 0xd3, 0xc2, 0xba, 0x8a, 0x94, 0xcd,
-	0x63, 0xc8, 0xf2, 0xed, 0x28, 0x40, 0xd6, 0x6c, 0x99, 0x06, 0xba, 0x82, 0x97, 0x00, 0xea, 0x9d,
-	0xba, 0x69, 0x19, 0xfb, 0x44, 0x6f, 0x30, 0xb3, 0x39, 0x23, 0x01, 0x90, 0x59, 0xbb, 0x08, 0x0b,
-	0xf5, 0xce, 0x5e, 0xa3, 0xa5, 0x5b, 0xd2, 0xcc, 0x7a, 0xe7, 0x41, 0xb7, 0x65, 0x31, 0x21, 0xc2,
-	0x25, 0xc8, 0xd7, 0x3b, 0x96, 0xf1, 0x35, 0x8b, 0xd9, 0xc5, 0x65, 0x02, 0x55, 0xf4, 0xf4, 0xfd,
-	0xcd, 0x6f, 0x67, 0x20, 0xcb, 0xdf, 0x65, 0x97, 0xa1, 0xc8, 0x77, 0xdb, 0x7a, 0xd4, 0x66, 0x53,
-	0x16, 0x21, 0x5b, 0x37, 0xad, 0xbb, 0xe8, 0x17, 0x54, 0x0c, 0x90, 0xeb, 0xf2, 0xf6, 0x2f, 0xe6,
-	0x59, 0xbb, 0x6e, 0x5a, 0xef, 0xde, 0x41, 0x5f, 0x57, 0xd9, 0xb0, 0x5d, 0x41, 0xfc, 0x52, 0x22,
-	0xd8, 0xbe, 0x8d, 0xbe, 0x91, 0x0a, 0xb6, 0x6f, 0xa3, 0x5f, 0x4e, 0x04, 0xb7, 0xb6, 0xd1, 0x37,
-	0x53, 0xc1, 0xad, 0x6d, 0xf4, 0x2b, 0x89, 0xe0, 0xce, 0x6d, 0xf4, 0xab, 0xa9, 0xe0, 0xce, 0x6d,
-	0xf4, 0x6b, 0x79, 0x66, 0x0b, 0xb7, 0xe4, 0xd6, 0x36, 0xfa, 0xf5, 0x42, 0x4a, 0xdd, 0xb9, 0x8d,
-	0x7e, 0xa3, 0xc0, 0xf6, 0x3f, 0xdd, 0x55, 0xf4, 0x9b, 0x88, 0x2d, 0x93, 0x6d, 0x10, 0xfa, 0x2d,
-	0xde, 0x64, 0x22, 0xf4, 0xdb, 0x88, 0xd9, 0xc8, 0xb8, 0x9c, 0xfc, 0x16, 0x97, 0x3c, 0x32, 0x74,
-	0x82, 0x7e, 0x27, 0x2f, 0x1e, 0x7b, 0xd6, 0xea, 0x4d, 0xbd, 0x81, 0x30, 0xef, 0xc1, 0x50, 0xf9,
-	0xbd, 0x9b, 0xac, 0xc9, 0xdc, 0x13, 0xfd, 0x7e, 0x9b, 0x4d, 0x78, 0xa0, 0x93, 0xda, 0x07, 0x3a,
-	0x41, 0x7f, 0x70, 0x93, 0x4d, 0x78, 0xa0, 0x13, 0x89, 0xd7, 0x1f, 0xb6, 0x99, 0x22, 0x17, 0xfd,
-	0xd1, 0x4d, 0xb6, 0x68, 0xc9, 0xff, 0xe3, 0x36, 0x2e, 0x40, 0x66, 0xa7, 0x6e, 0xa1, 0x6f, 0xf3,
-	0xd9, 0x98, 0x8b, 0xa2, 0x3f, 0x41, 0x8c, 0xd9, 0x31, 0x2c, 0xf4, 0x1d, 0xc6, 0xcc, 0x59, 0xdd,
-	0x76, 0xc3, 0x40, 0x6f, 0xb0, 0xc5, 0xed, 0x1b, 0xad, 0xa6, 0x61, 0x91, 0x47, 0xe8, 0x4f, 0xb9,
-	0xfa, 0xbd, 0x4e, 0xcb, 0x44, 0xdf, 0x45, 0xb8, 0x02, 0x60, 0x7c, 0xad, 0x4d, 0x8c, 0x4e, 0xa7,
-	0xde, 0x32, 0xd1, 0xdb, 0x9b, 0x7b, 0x80, 0xce, 0x87, 0x03, 0x66, 0x40, 0xd7, 0xbc, 0x6f, 0xb6,
-	0x1e, 0x9a, 0xe8, 0x0a, 0x23, 0xda, 0xc4, 0x68, 0xeb, 0xc4, 0x40, 0x0a, 0x06, 0xc8, 0xcb, 0x27,
-	0xa4, 0x2a, 0x5e, 0x84, 0x02, 0x69, 0x35, 0x1a, 0x3b, 0x7a, 0xed, 0x3e, 0xca, 0xec, 0x7c, 0x19,
-	0x96, 0xdc, 0x60, 0xeb, 0xcc, 0x8d, 0x69, 0x14, 0x89, 0x97, 0xff, 0x1f, 0x6a, 0x92, 0x72, 0x83,
-	0x1b, 0xa2, 0x75, 0x63, 0x10, 0xdc, 0x38, 0x8b, 0x6f, 0x70, 0xe9, 0x0d, 0x1e, 0x31, 0x0e, 0xf3,
-	0x9c, 0xb8, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x1a, 0xbb, 0xe6, 0x57, 0x30, 0x00,
-	0x00,
+	// 3351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x70, 0x1b, 0xc9,
+	0x75, 0xd6, 0x0c, 0x7e, 0x08, 0x3c, 0x10, 0x60, 0xb3, 0x49, 0x6a, 0xb1, 0xdc, 0x3f, 0x7a, 0xec,
+	0xf5, 0x2a, 0x4a, 0x42, 0x69, 0x29, 0x59, 0x51, 0xd6, 0x8e, 0xb3, 0x43, 0x70, 0xa8, 0x85, 0x04,
+	0x0c, 0xa0, 0xc6, 0x80, 0xb2, 0xb6, 0x52, 0x35, 0x35, 0x04, 0x5a, 0xe0, 0x14, 0x07, 0x33, 0xe0,
+	0xcc, 0x80, 0x5a, 0xdc, 0x94, 0x38, 0x8e, 0xf3, 0x1f, 0xe7, 0xdf, 0x8e, 0x2b, 0xae, 0xdc, 0x52,
+	0xb9, 0xe4, 0x9c, 0x73, 0x2a, 0xb5, 0x87, 0x1c, 0x52, 0x95, 0x63, 0x92, 0x43, 0x92, 0x43, 0x2a,
+	0x39, 0xa5, 0x52, 0x39, 0xe4, 0xe0, 0x43, 0x2a, 0xd5, 0x3f, 0x33, 0x00, 0x48, 0xac, 0x44, 0xcb,
+	0x71, 0xb9, 0xa4, 0xdd, 0x5b, 0xbf, 0x9f, 0xee, 0x7e, 0xef, 0xeb, 0xd7, 0xaf, 0x1b, 0x3d, 0x0f,
+	0x50, 0x3a, 0x19, 0xd3, 0x70, 0xb2, 0x3d, 0x0a, 0x83, 0x38, 0xc0, 0x39, 0x4e, 0x6c, 0x56, 0xe2,
+	0x60, 0x14, 0xf4, 0x9d, 0xd8, 0x11, 0xec, 0xcd, 0xd2, 0x69, 0x1c, 0x8e, 0x7a, 0x82, 0xd0, 0xbe,
+	0xa1, 0x40, 0xde, 0x72, 0xc2, 0x01, 0x8d, 0xf1, 0x26, 0x14, 0x8e, 0xe9, 0x24, 0x1a, 0x39, 0x3d,
+	0x5a, 0x55, 0xb6, 0x94, 0x2b, 0x45, 0x92, 0xd2, 0x78, 0x1d, 0x72, 0xd1, 0x91, 0x13, 0xf6, 0xab,
+	0x2a, 0x17, 0x08, 0x02, 0x7f, 0x09, 0x4a, 0xb1, 0x73, 0xe8, 0xd1, 0xd8, 0x8e, 0x27, 0x23, 0x5a,
+	0xcd, 0x6c, 0x29, 0x57, 0x2a, 0x3b, 0xeb, 0xdb, 0xe9, 0x7c, 0x16, 0x17, 0x5a, 0x93, 0x11, 0x25,
+	0x10, 0xa7, 0x6d, 0x8c, 0x21, 0xdb, 0xa3, 0x9e, 0x57, 0xcd, 0xf2, 0xb1, 0x78, 0x5b, 0xdb, 0x83,
+	0xca, 0x81, 0x75, 0xc7, 0x89, 0x69, 0xcd, 0xf1, 0x3c, 0x1a, 0xd6, 0xf7, 0x98, 0x39, 0xe3, 0x88,
+	0x86, 0xbe, 0x33, 0x4c, 0xcd, 0x49, 0x68, 0x7c, 0x19, 0xf2, 0x83, 0x30, 0x18, 0x8f, 0xa2, 0xaa,
+	0xba, 0x95, 0xb9, 0x52, 0x24, 0x92, 0xd2, 0x7e, 0x01, 0xc0, 0x38, 0xa5, 0x7e, 0x6c, 0x05, 0xc7,
+	0xd4, 0xc7, 0xaf, 0x43, 0x31, 0x76, 0x87, 0x34, 0x8a, 0x9d, 0xe1, 0x88, 0x0f, 0x91, 0x21, 0x53,
+	0xc6, 0x27, 0xb8, 0xb4, 0x09, 0x85, 0x51, 0x10, 0xb9, 0xb1, 0x1b, 0xf8, 0xdc, 0x9f, 0x22, 0x49,
+	0x69, 0xed, 0xab, 0x90, 0x3b, 0x70, 0xbc, 0x31, 0xc5, 0x6f, 0x41, 0x96, 0x3b, 0xac, 0x70, 0x87,
+	0x4b, 0xdb, 0x02, 0x74, 0xee, 0x27, 0x17, 0xb0, 0xb1, 0x4f, 0x99, 0x26, 0x1f, 0x7b, 0x99, 0x08,
+	0x42, 0x3b, 0x86, 0xe5, 0x5d, 0xd7, 0xef, 0x1f, 0x38, 0xa1, 0xcb, 0xc0, 0x78, 0xce, 0x61, 0xf0,
+	0x17, 0x20, 0xcf, 0x1b, 0x51, 0x35, 0xb3, 0x95, 0xb9, 0x52, 0xda, 0x59, 0x96, 0x1d, 0xb9, 0x6d,
+	0x44, 0xca, 0xb4, 0xbf, 0x56, 0x00, 0x76, 0x83, 0xb1, 0xdf, 0xbf, 0xcf, 0x84, 0x18, 0x41, 0x26,
+	0x3a, 0xf1, 0x24, 0x90, 0xac, 0x89, 0xef, 0x41, 0xe5, 0xd0, 0xf5, 0xfb, 0xf6, 0xa9, 0x34, 0x47,
+	0x60, 0x59, 0xda, 0xf9, 0x82, 0x1c, 0x6e, 0xda, 0x79, 0x7b, 0xd6, 0xea, 0xc8, 0xf0, 0xe3, 0x70,
+	0x42, 0xca, 0x87, 0xb3, 0xbc, 0xcd, 0x2e, 0xe0, 0xf3, 0x4a, 0x6c, 0xd2, 0x63, 0x3a, 0x49, 0x26,
+	0x3d, 0xa6, 0x13, 0xfc, 0x13, 0xb3, 0x1e, 0x95, 0x76, 0xd6, 0x92, 0xb9, 0x66, 0xfa, 0x4a, 0x37,
+	0xdf, 0x53, 0x6f, 0x2b, 0xda, 0xf7, 0xf3, 0x50, 0x31, 0x3e, 0xa2, 0xbd, 0x71, 0x4c, 0x5b, 0x23,
+	0xb6, 0x06, 0x11, 0x6e, 0xc2, 0x8a, 0xeb, 0xf7, 0xbc, 0x71, 0x9f, 0xf6, 0xed, 0x47, 0x2e, 0xf5,
+	0xfa, 0x11, 0x8f, 0xa3, 0x4a, 0x6a, 0xf7, 0xbc, 0xfe, 0x76, 0x5d, 0x2a, 0xef, 0x73, 0x5d, 0x52,
+	0x71, 0xe7, 0x68, 0x7c, 0x15, 0x56, 0x7b, 0x9e, 0x4b, 0xfd, 0xd8, 0x7e, 0xc4, 0xfc, 0xb5, 0xc3,
+	0xe0, 0x71, 0x54, 0xcd, 0x6d, 0x29, 0x57, 0x0a, 0x64, 0x45, 0x08, 0xf6, 0x19, 0x9f, 0x04, 0x8f,
+	0x23, 0xfc, 0x1e, 0x14, 0x1e, 0x07, 0xe1, 0xb1, 0x17, 0x38, 0xfd, 0x6a, 0x9e, 0xcf, 0xf9, 0xe6,
+	0xe2, 0x39, 0x1f, 0x48, 0x2d, 0x92, 0xea, 0xe3, 0x2b, 0x80, 0xa2, 0x13, 0xcf, 0x8e, 0xa8, 0x47,
+	0x7b, 0xb1, 0xed, 0xb9, 0x43, 0x37, 0xae, 0x16, 0x78, 0x48, 0x56, 0xa2, 0x13, 0xaf, 0xc3, 0xd9,
+	0x0d, 0xc6, 0xc5, 0x36, 0x6c, 0xc4, 0xa1, 0xe3, 0x47, 0x4e, 0x8f, 0x0d, 0x66, 0xbb, 0x51, 0xe0,
+	0x39, 0xac, 0x55, 0x2d, 0xf2, 0x29, 0xaf, 0x2e, 0x9e, 0xd2, 0x9a, 0x76, 0xa9, 0x27, 0x3d, 0xc8,
+	0x7a, 0xbc, 0x80, 0x8b, 0xdf, 0x85, 0x8d, 0xe8, 0xd8, 0x1d, 0xd9, 0x7c, 0x1c, 0x7b, 0xe4, 0x39,
+	0xbe, 0xdd, 0x73, 0x7a, 0x47, 0xb4, 0x0a, 0xdc, 0x6d, 0xcc, 0x84, 0x7c, 0xdd, 0xdb, 0x9e, 0xe3,
+	0xd7, 0x98, 0x84, 0x81, 0xce, 0xf4, 0x7c, 0x1a, 0xda, 0xa7, 0x34, 0x8c, 0x98, 0x35, 0xa5, 0xa7,
+	0x81, 0xde, 0x16, 0xca, 0x07, 0x42, 0x97, 0x54, 0x46, 0x73, 0xb4, 0xf6, 0x65, 0xa8, 0xcc, 0x2f,
+	0x0b, 0x5e, 0x85, 0xb2, 0xf5, 0xb0, 0x6d, 0xd8, 0xba, 0xb9, 0x67, 0x9b, 0x7a, 0xd3, 0x40, 0x97,
+	0x70, 0x19, 0x8a, 0x9c, 0xd5, 0x32, 0x1b, 0x0f, 0x91, 0x82, 0x97, 0x20, 0xa3, 0x37, 0x1a, 0x48,
+	0xd5, 0x6e, 0x43, 0x21, 0xc1, 0x17, 0xaf, 0x40, 0xa9, 0x6b, 0x76, 0xda, 0x46, 0xad, 0xbe, 0x5f,
+	0x37, 0xf6, 0xd0, 0x25, 0x5c, 0x80, 0x6c, 0xab, 0x61, 0xb5, 0x91, 0x22, 0x5a, 0x7a, 0x1b, 0xa9,
+	0xac, 0xe7, 0xde, 0xae, 0x8e, 0x32, 0xda, 0x9f, 0x2b, 0xb0, 0xbe, 0x08, 0x27, 0x5c, 0x82, 0xa5,
+	0x3d, 0x63, 0x5f, 0xef, 0x36, 0x2c, 0x74, 0x09, 0xaf, 0xc1, 0x0a, 0x31, 0xda, 0x86, 0x6e, 0xe9,
+	0xbb, 0x0d, 0xc3, 0x26, 0x86, 0xbe, 0x87, 0x14, 0x8c, 0xa1, 0xc2, 0x5a, 0x76, 0xad, 0xd5, 0x6c,
+	0xd6, 0x2d, 0xcb, 0xd8, 0x43, 0x2a, 0x5e, 0x07, 0xc4, 0x79, 0x5d, 0x73, 0xca, 0xcd, 0x60, 0x04,
+	0xcb, 0x1d, 0x83, 0xd4, 0xf5, 0x46, 0xfd, 0x43, 0x36, 0x00, 0xca, 0xe2, 0xcf, 0xc1, 0x1b, 0xb5,
+	0x96, 0xd9, 0xa9, 0x77, 0x2c, 0xc3, 0xb4, 0xec, 0x8e, 0xa9, 0xb7, 0x3b, 0x1f, 0xb4, 0x2c, 0x3e,
+	0xb2, 0x70, 0x2e, 0x87, 0x2b, 0x00, 0x7a, 0xd7, 0x6a, 0x89, 0x71, 0x50, 0x5e, 0x3b, 0x81, 0xca,
+	0x3c, 0x84, 0xcc, 0x2a, 0x69, 0xa2, 0xdd, 0x6e, 0xe8, 0xa6, 0x69, 0x10, 0x74, 0x09, 0xe7, 0x41,
+	0x3d, 0xb8, 0x21, 0x7c, 0xbd, 0x43, 0xfd, 0x9b, 0x48, 0x65, 0x03, 0xb1, 0xd6, 0x9d, 0x90, 0xd2,
+	0xfe, 0x04, 0x65, 0x98, 0xdd, 0x8c, 0x6e, 0xd0, 0x47, 0xf1, 0x0e, 0x71, 0x07, 0x47, 0x31, 0xca,
+	0x32, 0xbb, 0x19, 0xef, 0x81, 0x1b, 0x1f, 0xed, 0x3b, 0x9e, 0x77, 0xe8, 0xf4, 0x8e, 0x51, 0xee,
+	0x6e, 0xb6, 0xa0, 0x20, 0xf5, 0x6e, 0xb6, 0xa0, 0xa2, 0xcc, 0xdd, 0x6c, 0x21, 0x83, 0xb2, 0xda,
+	0x5f, 0xa9, 0x90, 0xe3, 0xcb, 0xc3, 0x12, 0xf6, 0x4c, 0x1a, 0xe6, 0xed, 0x34, 0x79, 0xa9, 0x4f,
+	0x49, 0x5e, 0x3c, 0xe7, 0xcb, 0x34, 0x2a, 0x08, 0xfc, 0x1a, 0x14, 0x83, 0x70, 0x60, 0x0b, 0x89,
+	0x38, 0x00, 0x0a, 0x41, 0x38, 0xe0, 0x27, 0x05, 0x4b, 0xbe, 0xec, 0xdc, 0x38, 0x74, 0x22, 0xca,
+	0xf7, 0x60, 0x91, 0xa4, 0x34, 0x7e, 0x15, 0x98, 0x9e, 0xcd, 0xed, 0xc8, 0x73, 0xd9, 0x52, 0x10,
+	0x0e, 0x4c, 0x66, 0xca, 0xe7, 0xa1, 0xdc, 0x0b, 0xbc, 0xf1, 0xd0, 0xb7, 0x3d, 0xea, 0x0f, 0xe2,
+	0xa3, 0xea, 0xd2, 0x96, 0x72, 0xa5, 0x4c, 0x96, 0x05, 0xb3, 0xc1, 0x79, 0xb8, 0x0a, 0x4b, 0xbd,
+	0x23, 0x27, 0x8c, 0xa8, 0xd8, 0x77, 0x65, 0x92, 0x90, 0x7c, 0x56, 0xda, 0x73, 0x87, 0x8e, 0x17,
+	0xf1, 0x3d, 0x56, 0x26, 0x29, 0xcd, 0x9c, 0x78, 0xe4, 0x39, 0x83, 0x88, 0xef, 0x8d, 0x32, 0x11,
+	0x04, 0x7e, 0x0b, 0x4a, 0x72, 0x42, 0x0e, 0x41, 0x89, 0x9b, 0x03, 0x82, 0xc5, 0x10, 0xd0, 0x7e,
+	0x06, 0x32, 0x24, 0x78, 0xcc, 0xe6, 0x14, 0x16, 0x45, 0x55, 0x65, 0x2b, 0x73, 0x05, 0x93, 0x84,
+	0x64, 0x07, 0x98, 0xcc, 0xe1, 0x22, 0xb5, 0x27, 0x59, 0xfb, 0xbb, 0x0a, 0x94, 0xf8, 0xde, 0x23,
+	0x34, 0x1a, 0x7b, 0x31, 0xcb, 0xf5, 0x32, 0xc9, 0x29, 0x73, 0xb9, 0x9e, 0xaf, 0x0b, 0x91, 0x32,
+	0x06, 0x00, 0xcb, 0x5b, 0xb6, 0xf3, 0xe8, 0x11, 0xed, 0xc5, 0x54, 0x1c, 0x69, 0x59, 0xb2, 0xcc,
+	0x98, 0xba, 0xe4, 0x31, 0xe4, 0x5d, 0x3f, 0xa2, 0x61, 0x6c, 0xbb, 0x7d, 0xbe, 0x26, 0x59, 0x52,
+	0x10, 0x8c, 0x7a, 0x1f, 0xbf, 0x09, 0x59, 0x9e, 0xf9, 0xb2, 0x7c, 0x16, 0x90, 0xb3, 0x90, 0xe0,
+	0x31, 0xe1, 0xfc, 0xbb, 0xd9, 0x42, 0x0e, 0xe5, 0xb5, 0xaf, 0xc0, 0x32, 0x37, 0xee, 0x81, 0x13,
+	0xfa, 0xae, 0x3f, 0xe0, 0x07, 0x79, 0xd0, 0x17, 0x71, 0x51, 0x26, 0xbc, 0xcd, 0x7c, 0x1e, 0xd2,
+	0x28, 0x72, 0x06, 0x54, 0x1e, 0xac, 0x09, 0xa9, 0xfd, 0x59, 0x06, 0x4a, 0x9d, 0x38, 0xa4, 0xce,
+	0x90, 0x9f, 0xd1, 0xf8, 0x2b, 0x00, 0x51, 0xec, 0xc4, 0x74, 0x48, 0xfd, 0x38, 0xf1, 0xef, 0x75,
+	0x39, 0xf3, 0x8c, 0xde, 0x76, 0x27, 0x51, 0x22, 0x33, 0xfa, 0x78, 0x07, 0x4a, 0x94, 0x89, 0xed,
+	0x98, 0x9d, 0xf5, 0xf2, 0x3c, 0x59, 0x4d, 0xd2, 0x51, 0x7a, 0x09, 0x20, 0x40, 0xd3, 0xf6, 0xe6,
+	0xf7, 0x54, 0x28, 0xa6, 0xa3, 0x61, 0x1d, 0x0a, 0x3d, 0x27, 0xa6, 0x83, 0x20, 0x9c, 0xc8, 0x23,
+	0xf8, 0xed, 0xa7, 0xcd, 0xbe, 0x5d, 0x93, 0xca, 0x24, 0xed, 0x86, 0xdf, 0x00, 0x71, 0xaf, 0x11,
+	0x61, 0x29, 0xfc, 0x2d, 0x72, 0x0e, 0x0f, 0xcc, 0xf7, 0x00, 0x8f, 0x42, 0x77, 0xe8, 0x84, 0x13,
+	0xfb, 0x98, 0x4e, 0x92, 0xe3, 0x2a, 0xb3, 0x60, 0x25, 0x91, 0xd4, 0xbb, 0x47, 0x27, 0x32, 0x23,
+	0xde, 0x9e, 0xef, 0x2b, 0xa3, 0xe5, 0xfc, 0xfa, 0xcc, 0xf4, 0xe4, 0x17, 0x80, 0x28, 0x39, 0xea,
+	0x73, 0x3c, 0xb0, 0x58, 0x53, 0x7b, 0x07, 0x0a, 0x89, 0xf1, 0xb8, 0x08, 0x39, 0x23, 0x0c, 0x83,
+	0x10, 0x5d, 0xe2, 0x89, 0xb1, 0xd9, 0x10, 0xb9, 0x75, 0x6f, 0x8f, 0xe5, 0xd6, 0x7f, 0x55, 0xd3,
+	0xf3, 0x96, 0xd0, 0x93, 0x31, 0x8d, 0x62, 0xfc, 0xf3, 0xb0, 0x46, 0x79, 0x08, 0xb9, 0xa7, 0xd4,
+	0xee, 0xf1, 0xcb, 0x19, 0x0b, 0x20, 0x85, 0xe3, 0xbd, 0xb2, 0x2d, 0xee, 0x92, 0xc9, 0xa5, 0x8d,
+	0xac, 0xa6, 0xba, 0x92, 0xd5, 0xc7, 0x06, 0xac, 0xb9, 0xc3, 0x21, 0xed, 0xbb, 0x4e, 0x3c, 0x3b,
+	0x80, 0x58, 0xb0, 0x8d, 0xe4, 0xee, 0x32, 0x77, 0xf7, 0x23, 0xab, 0x69, 0x8f, 0x74, 0x98, 0xb7,
+	0x21, 0x1f, 0xf3, 0x7b, 0x2a, 0x8f, 0xdd, 0xd2, 0x4e, 0x39, 0xc9, 0x38, 0x9c, 0x49, 0xa4, 0x10,
+	0xbf, 0x03, 0xe2, 0xd6, 0xcb, 0x73, 0xcb, 0x34, 0x20, 0xa6, 0x97, 0x19, 0x22, 0xe4, 0xf8, 0x6d,
+	0xa8, 0xcc, 0x1d, 0xb3, 0x7d, 0x0e, 0x58, 0x86, 0x94, 0x67, 0xb8, 0xf5, 0x3e, 0xbe, 0x06, 0x4b,
+	0x81, 0x38, 0xd4, 0xaa, 0xf9, 0x39, 0x8b, 0xe7, 0x4f, 0x3c, 0x92, 0x68, 0xb1, 0xdc, 0x10, 0xd2,
+	0x88, 0x86, 0xa7, 0xb4, 0xcf, 0x06, 0x5d, 0xe2, 0x83, 0x42, 0xc2, 0xaa, 0xf7, 0xb5, 0x9f, 0x83,
+	0x95, 0x14, 0xe2, 0x68, 0x14, 0xf8, 0x11, 0xc5, 0x57, 0x21, 0x1f, 0xf2, 0xfd, 0x2e, 0x61, 0xc5,
+	0x72, 0x8e, 0x99, 0x4c, 0x40, 0xa4, 0x86, 0xd6, 0x87, 0x15, 0xc1, 0x61, 0xf9, 0x9b, 0xaf, 0x24,
+	0x7e, 0x1b, 0x72, 0x94, 0x35, 0xce, 0x2c, 0x0a, 0x69, 0xd7, 0xb8, 0x9c, 0x08, 0xe9, 0xcc, 0x2c,
+	0xea, 0x33, 0x67, 0xf9, 0x2f, 0x15, 0xd6, 0xa4, 0x95, 0xbb, 0x4e, 0xdc, 0x3b, 0x7a, 0x41, 0xa3,
+	0xe1, 0x27, 0x61, 0x89, 0xf1, 0xdd, 0x74, 0xe7, 0x2c, 0x88, 0x87, 0x44, 0x83, 0x45, 0x84, 0x13,
+	0xd9, 0x33, 0xcb, 0x2f, 0xef, 0x81, 0x65, 0x27, 0x9a, 0xb9, 0x35, 0x2c, 0x08, 0x9c, 0xfc, 0x33,
+	0x02, 0x67, 0xe9, 0x22, 0x81, 0xa3, 0xed, 0xc1, 0xfa, 0x3c, 0xe2, 0x32, 0x38, 0x7e, 0x0a, 0x96,
+	0xc4, 0xa2, 0x24, 0x39, 0x72, 0xd1, 0xba, 0x25, 0x2a, 0xda, 0xc7, 0x2a, 0xac, 0xcb, 0xf4, 0xf5,
+	0xe9, 0xd8, 0xc7, 0x33, 0x38, 0xe7, 0x2e, 0xb4, 0x41, 0x2f, 0xb6, 0x7e, 0x5a, 0x0d, 0x36, 0xce,
+	0xe0, 0xf8, 0x1c, 0x9b, 0xf5, 0x3f, 0x15, 0x58, 0xde, 0xa5, 0x03, 0xd7, 0x7f, 0x41, 0x57, 0x61,
+	0x06, 0xdc, 0xec, 0x85, 0x82, 0x78, 0x04, 0x65, 0xe9, 0xaf, 0x44, 0xeb, 0x3c, 0xda, 0xca, 0xa2,
+	0xdd, 0x72, 0x1b, 0x96, 0xe5, 0x4b, 0x82, 0xe3, 0xb9, 0x4e, 0x94, 0xfa, 0x73, 0xe6, 0x29, 0x41,
+	0x67, 0x42, 0x52, 0x8a, 0xa7, 0x84, 0xf6, 0x6f, 0x0a, 0x94, 0x6b, 0xc1, 0x70, 0xe8, 0xc6, 0x2f,
+	0x28, 0xc6, 0xe7, 0x11, 0xca, 0x2e, 0x8a, 0xc7, 0x77, 0xa1, 0x92, 0xb8, 0x29, 0xa1, 0x3d, 0x73,
+	0xd2, 0x28, 0xe7, 0x4e, 0x9a, 0x7f, 0x57, 0x60, 0x85, 0x04, 0xe2, 0x86, 0xff, 0x72, 0x83, 0x73,
+	0x03, 0xd0, 0xd4, 0xd1, 0x8b, 0xc2, 0xf3, 0x7d, 0x05, 0x2a, 0xed, 0x90, 0x8e, 0x9c, 0x90, 0xbe,
+	0xd4, 0xe8, 0xb0, 0x6b, 0x7a, 0x3f, 0x96, 0x17, 0x9c, 0x22, 0xe1, 0x6d, 0x6d, 0x15, 0x56, 0x52,
+	0xdf, 0x05, 0x60, 0xda, 0x3f, 0x2a, 0xb0, 0x21, 0x42, 0x4c, 0x4a, 0xfa, 0x2f, 0x28, 0x2c, 0x89,
+	0xbf, 0xd9, 0x19, 0x7f, 0xab, 0x70, 0xf9, 0xac, 0x6f, 0xd2, 0xed, 0xaf, 0xab, 0xf0, 0x4a, 0x12,
+	0x3c, 0x2f, 0xb8, 0xe3, 0x3f, 0x44, 0x3c, 0x6c, 0x42, 0xf5, 0x3c, 0x08, 0x12, 0xa1, 0x6f, 0xa9,
+	0x50, 0xad, 0x85, 0xd4, 0x89, 0xe9, 0xcc, 0x3d, 0xe8, 0xe5, 0x89, 0x0d, 0xfc, 0x2e, 0x2c, 0x8f,
+	0x9c, 0x30, 0x76, 0x7b, 0xee, 0xc8, 0x61, 0x3f, 0x45, 0x73, 0x5b, 0x99, 0xf3, 0x03, 0xcc, 0xa9,
+	0x68, 0xaf, 0xc1, 0xab, 0x0b, 0x10, 0x91, 0x78, 0xfd, 0xaf, 0x02, 0xb8, 0x13, 0x3b, 0x61, 0xfc,
+	0x29, 0x38, 0x97, 0x16, 0x06, 0xd3, 0x06, 0xac, 0xcd, 0xf9, 0x3f, 0x8b, 0x0b, 0x8d, 0x3f, 0x15,
+	0x47, 0xd2, 0x27, 0xe2, 0x32, 0xeb, 0xbf, 0xc4, 0xe5, 0x9f, 0x15, 0xd8, 0xac, 0x05, 0xe2, 0x41,
+	0xf4, 0xa5, 0xdc, 0x61, 0xda, 0x1b, 0xf0, 0xda, 0x42, 0x07, 0x25, 0x00, 0xff, 0xa4, 0xc0, 0x65,
+	0x42, 0x9d, 0xfe, 0xcb, 0xe9, 0xfc, 0x7d, 0x78, 0xe5, 0x9c, 0x73, 0xf2, 0x8e, 0x72, 0x0b, 0x0a,
+	0x43, 0x1a, 0x3b, 0x7d, 0x27, 0x76, 0xa4, 0x4b, 0x9b, 0xc9, 0xb8, 0x53, 0xed, 0xa6, 0xd4, 0x20,
+	0xa9, 0xae, 0xf6, 0x2f, 0x2a, 0xac, 0xf1, 0x7b, 0xf6, 0x67, 0x3f, 0xf2, 0x2e, 0xf4, 0x0a, 0x93,
+	0x3f, 0x7b, 0xf9, 0x63, 0x0a, 0xa3, 0x90, 0xda, 0xc9, 0xeb, 0xc0, 0x12, 0xff, 0x8c, 0x08, 0xa3,
+	0x90, 0xde, 0x17, 0x1c, 0xed, 0x6f, 0x15, 0x58, 0x9f, 0x87, 0x38, 0xfd, 0x45, 0xf3, 0xff, 0xfd,
+	0xda, 0xb2, 0x20, 0xa5, 0x64, 0x2e, 0xf2, 0x23, 0x29, 0x7b, 0xe1, 0x1f, 0x49, 0x7f, 0xa7, 0x42,
+	0x75, 0xd6, 0x99, 0xcf, 0xde, 0x74, 0xe6, 0xdf, 0x74, 0x7e, 0xd0, 0x57, 0x3e, 0xed, 0xef, 0x15,
+	0x78, 0x75, 0x01, 0xa0, 0x3f, 0x58, 0x88, 0xcc, 0xbc, 0xec, 0xa8, 0xcf, 0x7c, 0xd9, 0xf9, 0xd1,
+	0x07, 0xc9, 0x3f, 0x28, 0xb0, 0xde, 0x14, 0x6f, 0xf5, 0xe2, 0xe5, 0xe3, 0xc5, 0xcd, 0xc1, 0xfc,
+	0x39, 0x3e, 0x3b, 0xfd, 0x5a, 0xc5, 0x5e, 0x73, 0xce, 0xb8, 0xf6, 0x1c, 0xaf, 0x39, 0xff, 0xa3,
+	0xc0, 0xaa, 0x1c, 0x45, 0xef, 0x1d, 0xbf, 0x3c, 0xe8, 0xe0, 0x37, 0x21, 0xe3, 0xf6, 0x93, 0x7b,
+	0xef, 0x7c, 0x39, 0x01, 0x13, 0x68, 0xef, 0x03, 0x9e, 0xf5, 0xfb, 0x39, 0xa0, 0xfb, 0x0f, 0x15,
+	0x36, 0x88, 0xc8, 0xbe, 0x9f, 0x7d, 0x5f, 0xf8, 0x61, 0xbf, 0x2f, 0x3c, 0xfd, 0xe0, 0xfa, 0x98,
+	0x5f, 0xa6, 0xe6, 0xa1, 0xfe, 0xd1, 0x1d, 0x5d, 0x67, 0x0e, 0xda, 0xcc, 0xb9, 0x83, 0xf6, 0xf9,
+	0xf3, 0xd1, 0xc7, 0x2a, 0x6c, 0x4a, 0x47, 0x3e, 0xbb, 0xeb, 0x5c, 0x3c, 0x22, 0xf2, 0xe7, 0x22,
+	0xe2, 0xbf, 0x15, 0x78, 0x6d, 0x21, 0x90, 0x3f, 0xf6, 0x1b, 0xcd, 0x99, 0xe8, 0xc9, 0x3e, 0x33,
+	0x7a, 0x72, 0x17, 0x8e, 0x9e, 0x6f, 0xaa, 0x50, 0x21, 0xd4, 0xa3, 0x4e, 0xf4, 0x92, 0xbf, 0xee,
+	0x9d, 0xc1, 0x30, 0x77, 0xee, 0x9d, 0x73, 0x15, 0x56, 0x52, 0x20, 0xe4, 0x0f, 0x2e, 0xfe, 0x03,
+	0x9d, 0x9d, 0x83, 0x1f, 0x50, 0xc7, 0x8b, 0x93, 0x9b, 0xa0, 0xf6, 0xed, 0x0c, 0x94, 0x09, 0xe3,
+	0xb8, 0x43, 0xca, 0xbe, 0x7b, 0x47, 0xf8, 0x73, 0xb0, 0x7c, 0xc4, 0x55, 0xec, 0x69, 0x84, 0x14,
+	0x49, 0x49, 0xf0, 0xc4, 0xd7, 0xc7, 0x1d, 0xd8, 0x88, 0x68, 0x2f, 0xf0, 0xfb, 0x91, 0x7d, 0x48,
+	0x8f, 0x58, 0x45, 0xd9, 0xd0, 0x89, 0x62, 0x1a, 0x72, 0x58, 0xca, 0x64, 0x4d, 0x0a, 0x77, 0xb9,
+	0xac, 0xc9, 0x45, 0xf8, 0x3a, 0xac, 0x1f, 0xba, 0xbe, 0x17, 0x0c, 0x58, 0xf9, 0xd1, 0x84, 0x86,
+	0x91, 0xdd, 0x0b, 0xc6, 0xbe, 0xc0, 0x23, 0x47, 0xb0, 0x90, 0xb5, 0x85, 0xa8, 0xc6, 0x24, 0xf8,
+	0x43, 0xb8, 0xba, 0x70, 0x16, 0xfb, 0x91, 0xeb, 0xc5, 0x34, 0xa4, 0x7d, 0x3b, 0xa4, 0x23, 0xcf,
+	0xed, 0x89, 0x52, 0x29, 0x01, 0xd4, 0x17, 0x17, 0x4c, 0xbd, 0x2f, 0xd5, 0xc9, 0x54, 0x9b, 0x55,
+	0x46, 0xf4, 0x46, 0x63, 0x7b, 0xcc, 0x8b, 0x16, 0x18, 0x7e, 0x0a, 0x29, 0xf4, 0x46, 0xe3, 0x2e,
+	0xa3, 0xd9, 0xd7, 0xf4, 0x93, 0x91, 0x48, 0xce, 0x0a, 0x61, 0x4d, 0x66, 0xbc, 0xf8, 0xe8, 0x1f,
+	0xf5, 0x8e, 0xe8, 0xd0, 0xb1, 0x7b, 0x47, 0x8e, 0x3f, 0xa0, 0x7d, 0x99, 0x8a, 0x31, 0x97, 0x75,
+	0xb8, 0xa8, 0x26, 0x24, 0x58, 0x07, 0x24, 0x7b, 0xc4, 0x4e, 0xec, 0x46, 0xb1, 0xdb, 0x8b, 0xaa,
+	0x05, 0x7e, 0xd8, 0x5e, 0x4e, 0x97, 0x9e, 0x75, 0x4a, 0xa5, 0x64, 0x25, 0x9e, 0x67, 0xb0, 0x2f,
+	0x49, 0x15, 0x7d, 0x30, 0x08, 0xe9, 0xc0, 0x89, 0xe5, 0xda, 0x5c, 0x87, 0x75, 0xb1, 0x0e, 0x13,
+	0x5b, 0xee, 0x11, 0x01, 0xa2, 0x22, 0x40, 0x94, 0x32, 0xb1, 0x41, 0x04, 0x88, 0x37, 0xe1, 0xf2,
+	0xd8, 0x5f, 0xd8, 0x47, 0xe5, 0x7d, 0xd6, 0xc7, 0xfe, 0x82, 0x5e, 0x3f, 0x0b, 0xaf, 0x2e, 0x86,
+	0x7e, 0xe8, 0x8a, 0x1a, 0xc9, 0x32, 0xb9, 0xbc, 0x00, 0xe9, 0xa6, 0xeb, 0x3f, 0xa5, 0xab, 0xf3,
+	0x51, 0x35, 0xfb, 0xc9, 0x5d, 0x9d, 0x8f, 0xb4, 0xbf, 0x48, 0x3f, 0x64, 0x26, 0x31, 0x9a, 0x66,
+	0xab, 0x64, 0xf7, 0x28, 0x4f, 0xdb, 0x3d, 0x55, 0x58, 0x62, 0x3b, 0xc0, 0xf5, 0x07, 0xdc, 0xb9,
+	0x02, 0x49, 0x48, 0xdc, 0x81, 0x2f, 0x4a, 0xdf, 0xe9, 0x47, 0x31, 0x0d, 0x7d, 0xc7, 0xf3, 0x26,
+	0xb6, 0x78, 0xf3, 0xf4, 0x63, 0xda, 0xb7, 0xa7, 0x35, 0xa3, 0x22, 0x67, 0x7d, 0x5e, 0x68, 0x1b,
+	0xa9, 0x32, 0x49, 0x75, 0xad, 0x44, 0x15, 0x7f, 0x19, 0x2a, 0xa1, 0xdc, 0x39, 0x7c, 0x95, 0x93,
+	0x83, 0x6e, 0x5d, 0x5a, 0x37, 0xb7, 0xad, 0x48, 0x39, 0x9c, 0x25, 0x9f, 0x3f, 0xcb, 0xdd, 0xcd,
+	0x16, 0xf2, 0x68, 0x49, 0xfb, 0x4b, 0x05, 0xd6, 0x16, 0x3c, 0x18, 0xa4, 0xaf, 0x11, 0xca, 0xcc,
+	0x63, 0xe7, 0x4f, 0x43, 0x8e, 0xd9, 0x97, 0x14, 0x6e, 0xbd, 0x72, 0xfe, 0xbd, 0x81, 0xd9, 0x44,
+	0x89, 0xd0, 0x62, 0x09, 0x80, 0xfb, 0xd4, 0xe3, 0xaf, 0x9d, 0x49, 0x1a, 0x2f, 0x31, 0x9e, 0x78,
+	0x00, 0x3d, 0xff, 0x7c, 0x9a, 0x7d, 0xf6, 0xf3, 0xe9, 0xdf, 0x28, 0xb0, 0x72, 0x26, 0xe4, 0x17,
+	0x16, 0x99, 0x61, 0x59, 0x96, 0xa4, 0xf2, 0x59, 0x79, 0x1b, 0xd7, 0xa1, 0xd4, 0x73, 0xc2, 0xbe,
+	0xeb, 0x3b, 0x9e, 0x1b, 0x4f, 0x64, 0x35, 0xcd, 0x3b, 0x8b, 0xf7, 0xd1, 0x76, 0x6d, 0xaa, 0x29,
+	0xea, 0x56, 0x67, 0xfb, 0x6e, 0x7e, 0x15, 0xd0, 0x59, 0x85, 0x05, 0x35, 0xab, 0x73, 0x55, 0xb8,
+	0x99, 0x99, 0xf2, 0xd4, 0xab, 0xbf, 0x97, 0x81, 0x62, 0x73, 0xd2, 0x39, 0xf1, 0xf6, 0x3d, 0x67,
+	0xc0, 0x2b, 0x6b, 0x9a, 0x6d, 0xeb, 0x21, 0xba, 0xc4, 0xca, 0x19, 0xcd, 0x96, 0x65, 0x9b, 0xdd,
+	0x46, 0xc3, 0xde, 0x6f, 0xe8, 0x77, 0x90, 0xc2, 0xea, 0x02, 0xdb, 0xa4, 0x6e, 0xdf, 0x33, 0x1e,
+	0x0a, 0x8e, 0xca, 0x4a, 0xfa, 0xba, 0x66, 0xfd, 0x7e, 0xd7, 0x98, 0x32, 0xb3, 0x78, 0x03, 0x56,
+	0x9b, 0xdd, 0x86, 0x55, 0x6f, 0x37, 0x66, 0xd8, 0x05, 0x56, 0x0c, 0xb9, 0xdb, 0x68, 0xed, 0x0a,
+	0x12, 0xb1, 0xf1, 0xbb, 0x66, 0xa7, 0x7e, 0xc7, 0x34, 0xf6, 0x04, 0x6b, 0x8b, 0xb1, 0x3e, 0x34,
+	0x48, 0x6b, 0xbf, 0x9e, 0x4c, 0xf9, 0x3e, 0x46, 0x50, 0xda, 0xad, 0x9b, 0x3a, 0x91, 0xa3, 0x3c,
+	0x51, 0x70, 0x05, 0x8a, 0x86, 0xd9, 0x6d, 0x4a, 0x5a, 0xc5, 0x55, 0x58, 0x63, 0x75, 0x87, 0x76,
+	0xdd, 0xac, 0x11, 0xa3, 0xc9, 0xca, 0x13, 0x85, 0x24, 0x8b, 0xd7, 0xa0, 0x62, 0xd5, 0x9b, 0x46,
+	0xc7, 0xd2, 0x9b, 0x6d, 0xc9, 0x64, 0x56, 0x14, 0x3a, 0x46, 0xa2, 0x83, 0xf0, 0x26, 0x6c, 0x98,
+	0x2d, 0x3b, 0x29, 0x4b, 0x3c, 0xd0, 0x1b, 0x5d, 0x43, 0xca, 0xb6, 0xf0, 0x2b, 0x80, 0x5b, 0xa6,
+	0xdd, 0x6d, 0xef, 0xe9, 0x96, 0x61, 0x9b, 0xad, 0x07, 0x52, 0xf0, 0x3e, 0xae, 0x40, 0x61, 0x6a,
+	0xc1, 0x13, 0x86, 0x42, 0xb9, 0xad, 0x13, 0x6b, 0xea, 0xec, 0x93, 0x27, 0x0c, 0x2c, 0xb8, 0x43,
+	0x5a, 0xdd, 0xf6, 0x54, 0x6d, 0x15, 0x4a, 0x12, 0x2c, 0xc9, 0xca, 0x32, 0xd6, 0x6e, 0xdd, 0xac,
+	0xa5, 0xf6, 0x3d, 0x29, 0x6c, 0xaa, 0x48, 0xb9, 0x7a, 0x0c, 0x59, 0xbe, 0x1c, 0x05, 0xc8, 0x9a,
+	0x2d, 0x93, 0x55, 0x92, 0xae, 0x00, 0xd4, 0x3b, 0x75, 0xd3, 0x32, 0xee, 0x10, 0xbd, 0xc1, 0xdc,
+	0xe6, 0x8c, 0x04, 0x40, 0xe6, 0xed, 0x32, 0x2c, 0xd5, 0x3b, 0xfb, 0x8d, 0x96, 0x6e, 0x49, 0x37,
+	0xeb, 0x9d, 0xfb, 0xdd, 0x16, 0x2b, 0xe8, 0x7c, 0x82, 0x70, 0x09, 0xf2, 0xac, 0x76, 0xf3, 0x6b,
+	0x16, 0xf3, 0x8b, 0xcb, 0x04, 0xaa, 0xe8, 0xc9, 0xfb, 0x57, 0xbf, 0x93, 0x81, 0x2c, 0xaf, 0x69,
+	0x2f, 0x43, 0x91, 0xaf, 0x36, 0x2b, 0x59, 0x45, 0x97, 0x70, 0x11, 0xb2, 0x75, 0xd3, 0xba, 0x8d,
+	0x7e, 0x51, 0xc5, 0x00, 0xb9, 0x2e, 0x6f, 0xff, 0x52, 0x9e, 0xb5, 0xeb, 0xa6, 0xf5, 0xee, 0x2d,
+	0xf4, 0x75, 0x95, 0x0d, 0xdb, 0x15, 0xc4, 0x2f, 0x27, 0x82, 0x9d, 0x9b, 0xe8, 0x1b, 0xa9, 0x60,
+	0xe7, 0x26, 0xfa, 0x95, 0x44, 0x70, 0x63, 0x07, 0x7d, 0x33, 0x15, 0xdc, 0xd8, 0x41, 0xbf, 0x9a,
+	0x08, 0x6e, 0xdd, 0x44, 0xbf, 0x96, 0x0a, 0x6e, 0xdd, 0x44, 0xbf, 0x9e, 0x67, 0xbe, 0x70, 0x4f,
+	0x6e, 0xec, 0xa0, 0xdf, 0x28, 0xa4, 0xd4, 0xad, 0x9b, 0xe8, 0x37, 0x0b, 0x6c, 0xfd, 0xd3, 0x55,
+	0x45, 0xbf, 0x85, 0x98, 0x99, 0x6c, 0x81, 0xd0, 0x6f, 0xf3, 0x26, 0x13, 0xa1, 0xdf, 0x41, 0xcc,
+	0x47, 0xc6, 0xe5, 0xe4, 0xb7, 0xb8, 0xe4, 0xa1, 0xa1, 0x13, 0xf4, 0xbb, 0x79, 0x51, 0x28, 0x5b,
+	0xab, 0x37, 0xf5, 0x06, 0xc2, 0xbc, 0x07, 0x43, 0xe5, 0xf7, 0xaf, 0xb3, 0x26, 0x0b, 0x4f, 0xf4,
+	0x07, 0x6d, 0x36, 0xe1, 0x81, 0x4e, 0x6a, 0x1f, 0xe8, 0x04, 0xfd, 0xe1, 0x75, 0x36, 0xe1, 0x81,
+	0x4e, 0x24, 0x5e, 0x7f, 0xd4, 0x66, 0x8a, 0x5c, 0xf4, 0xc7, 0xd7, 0x99, 0xd1, 0x92, 0xff, 0xed,
+	0x36, 0x2e, 0x40, 0x66, 0xb7, 0x6e, 0xa1, 0xef, 0xf0, 0xd9, 0x58, 0x88, 0xa2, 0x3f, 0x41, 0x8c,
+	0xd9, 0x31, 0x2c, 0xf4, 0x5d, 0xc6, 0xcc, 0x59, 0xdd, 0x76, 0xc3, 0x40, 0xaf, 0x33, 0xe3, 0xee,
+	0x18, 0xad, 0xa6, 0x61, 0x91, 0x87, 0xe8, 0x4f, 0xb9, 0xfa, 0xdd, 0x4e, 0xcb, 0x44, 0xdf, 0x43,
+	0xac, 0xf6, 0xd5, 0xf8, 0x5a, 0x9b, 0x18, 0x9d, 0x4e, 0xbd, 0x65, 0xa2, 0xb7, 0xae, 0xee, 0x03,
+	0x3a, 0x9b, 0xd5, 0x98, 0x03, 0x5d, 0xf3, 0x9e, 0xd9, 0x7a, 0x60, 0xa2, 0x4b, 0x8c, 0x68, 0x13,
+	0xa3, 0xad, 0x13, 0x03, 0x29, 0x18, 0x20, 0x2f, 0xcb, 0x6f, 0x55, 0xbc, 0x0c, 0x05, 0xd2, 0x6a,
+	0x34, 0x76, 0xf5, 0xda, 0x3d, 0x94, 0xd9, 0xfd, 0x12, 0xac, 0xb8, 0xc1, 0xf6, 0xa9, 0x1b, 0xd3,
+	0x28, 0x12, 0xff, 0x9a, 0xf8, 0x50, 0x93, 0x94, 0x1b, 0x5c, 0x13, 0xad, 0x6b, 0x83, 0xe0, 0xda,
+	0x69, 0x7c, 0x8d, 0x4b, 0xaf, 0xf1, 0x54, 0x74, 0x98, 0xe7, 0xc4, 0x8d, 0xff, 0x1b, 0x00, 0xf6,
+	0x1d, 0xe6, 0x9d, 0x93, 0x31, 0x00, 0x00,
 }
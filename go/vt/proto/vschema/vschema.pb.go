@@ -271,10 +271,20 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// source is the keyspace a reference table is copied from.
+	// The copy is kept in sync by a materialization workflow,
+	// and the DMLs on the table are sent to the source keyspace.
+	// The table must have the same name in the source keyspace.
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	// result_cache_ttl enables the caching of the results of the
+	// selects that only read tables that have it, for as long as
+	// the shortest of their ttls, like "10s". VTGate invalidates
+	// the results when the rows of the tables change.
+	ResultCacheTtl       string   `protobuf:"bytes,8,opt,name=result_cache_ttl,json=resultCacheTtl,proto3" json:"result_cache_ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -344,6 +354,20 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Table) GetResultCacheTtl() string {
+	if m != nil {
+		return m.ResultCacheTtl
+	}
+	return ""
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -568,48 +592,50 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x13, 0x62, 0x92, 0x31, 0x09, 0xbf, 0xdf, 0x0a, 0xa8, 0x1b, 0x84, 0x88, 0x2c, 0xda,
-	0xa6, 0x3d, 0x24, 0x52, 0x50, 0x25, 0x9a, 0x8a, 0xaa, 0x14, 0x71, 0x40, 0x45, 0x6a, 0x65, 0x10,
-	0x87, 0x5e, 0x2c, 0xe3, 0x6c, 0x61, 0x45, 0xe2, 0x35, 0xbb, 0x6b, 0x97, 0x3c, 0x4a, 0xaf, 0x7d,
-	0xad, 0x3e, 0x42, 0x5f, 0xa2, 0xf2, 0xfe, 0x31, 0x1b, 0x48, 0x6f, 0x3b, 0x3b, 0xf3, 0x7d, 0xf3,
-	0xed, 0xec, 0xcc, 0x40, 0xbb, 0xe0, 0xc9, 0x0d, 0x9e, 0xc5, 0x83, 0x8c, 0x51, 0x41, 0xd1, 0xaa,
-	0x36, 0xbb, 0xde, 0x5d, 0x8e, 0xd9, 0x5c, 0xdd, 0x06, 0x63, 0x58, 0x0b, 0x69, 0x2e, 0x48, 0x7a,
-	0x1d, 0xe6, 0x53, 0xcc, 0xd1, 0x1b, 0x68, 0xb0, 0xf2, 0xe0, 0x3b, 0xbd, 0x7a, 0xdf, 0x1b, 0x6d,
-	0x0c, 0x0c, 0x89, 0x15, 0x15, 0xaa, 0x90, 0xe0, 0x14, 0x3c, 0xeb, 0x16, 0xed, 0x00, 0x7c, 0x67,
-	0x74, 0x16, 0x89, 0xf8, 0x6a, 0x8a, 0x7d, 0xa7, 0xe7, 0xf4, 0x5b, 0x61, 0xab, 0xbc, 0xb9, 0x28,
-	0x2f, 0xd0, 0x36, 0xb4, 0x04, 0x55, 0x4e, 0xee, 0xd7, 0x7a, 0xf5, 0x7e, 0x2b, 0x6c, 0x0a, 0x2a,
-	0x7d, 0x3c, 0xf8, 0x53, 0x83, 0xe6, 0x67, 0x3c, 0xe7, 0x59, 0x9c, 0x60, 0xe4, 0xc3, 0x2a, 0xbf,
-	0x89, 0xd9, 0x04, 0x4f, 0x24, 0x4b, 0x33, 0x34, 0x26, 0x7a, 0x0f, 0xcd, 0x82, 0xa4, 0x13, 0x7c,
-	0xaf, 0x29, 0xbc, 0xd1, 0x6e, 0x25, 0xd0, 0xc0, 0x07, 0x97, 0x3a, 0xe2, 0x24, 0x15, 0x6c, 0x1e,
-	0x56, 0x00, 0xf4, 0x16, 0x5c, 0x9d, 0xbd, 0x2e, 0xa1, 0x3b, 0x4f, 0xa1, 0x4a, 0x8d, 0x02, 0xea,
-	0x60, 0x74, 0x00, 0x3e, 0xc3, 0x77, 0x39, 0x61, 0x38, 0xc2, 0xf7, 0xd9, 0x94, 0x24, 0x44, 0x44,
-	0x4c, 0x3d, 0xdb, 0x5f, 0x91, 0xf2, 0xb6, 0xb4, 0xff, 0x44, 0xbb, 0x75, 0x51, 0xba, 0x67, 0xd0,
-	0x5e, 0xd0, 0x82, 0xfe, 0x83, 0xfa, 0x2d, 0x9e, 0xeb, 0xd2, 0x94, 0x47, 0xf4, 0x02, 0x1a, 0x45,
-	0x3c, 0xcd, 0xb1, 0x5f, 0xeb, 0x39, 0x7d, 0x6f, 0xb4, 0x5e, 0x49, 0x52, 0xc0, 0x50, 0x79, 0xc7,
-	0xb5, 0x03, 0xa7, 0x7b, 0x0a, 0x9e, 0x25, 0x6f, 0x09, 0xd7, 0xde, 0x22, 0x57, 0xa7, 0xe2, 0x92,
-	0x30, 0x8b, 0x2a, 0xf8, 0xe5, 0x80, 0xab, 0x12, 0x20, 0x04, 0x2b, 0x62, 0x9e, 0x99, 0xef, 0x92,
-	0x67, 0xb4, 0x0f, 0x6e, 0x16, 0xb3, 0x78, 0x66, 0x6a, 0xbc, 0xfd, 0x48, 0xd5, 0xe0, 0xab, 0xf4,
-	0xea, 0x32, 0xa9, 0x50, 0xb4, 0x01, 0x0d, 0xfa, 0x23, 0xc5, 0xcc, 0xaf, 0x4b, 0x26, 0x65, 0x74,
-	0xdf, 0x81, 0x67, 0x05, 0x2f, 0x11, 0xbd, 0x61, 0x8b, 0x6e, 0xd9, 0x22, 0x7f, 0xd6, 0xa0, 0xa1,
-	0x3a, 0x67, 0x99, 0xc6, 0x0f, 0xb0, 0x9e, 0xd0, 0x69, 0x3e, 0x4b, 0xa3, 0x47, 0x0d, 0xb1, 0x59,
-	0x89, 0x3d, 0x96, 0x7e, 0x5d, 0xc8, 0x4e, 0x62, 0x59, 0x98, 0xa3, 0x43, 0xe8, 0xc4, 0xb9, 0xa0,
-	0x11, 0x49, 0x13, 0x86, 0x67, 0x38, 0x15, 0x52, 0xb7, 0x37, 0xda, 0xaa, 0xe0, 0x47, 0xb9, 0xa0,
-	0xa7, 0xc6, 0x1b, 0xb6, 0x63, 0xdb, 0x44, 0xaf, 0x61, 0x55, 0x11, 0x72, 0x7f, 0x45, 0xa6, 0x5d,
-	0x7f, 0x94, 0x36, 0x34, 0x7e, 0xb4, 0x05, 0x6e, 0x46, 0xd2, 0x14, 0x4f, 0xfc, 0x86, 0xd4, 0xaf,
-	0x2d, 0x34, 0x86, 0xe7, 0xfa, 0x05, 0x53, 0xc2, 0x45, 0x14, 0xe7, 0xe2, 0x86, 0x32, 0x22, 0x62,
-	0x41, 0x0a, 0xec, 0xbb, 0xb2, 0xb1, 0x9e, 0xa9, 0x80, 0x33, 0xc2, 0xc5, 0x91, 0xed, 0x0e, 0x2e,
-	0x60, 0xcd, 0x7e, 0x5d, 0x99, 0x43, 0x85, 0xea, 0x1a, 0x69, 0xab, 0xac, 0x5c, 0x1a, 0xcf, 0x4c,
-	0x71, 0xe5, 0xb9, 0x9c, 0x2e, 0x23, 0xbd, 0x2e, 0xa7, 0xd0, 0x98, 0xc1, 0x31, 0xb4, 0x17, 0x1e,
-	0xfd, 0x4f, 0xda, 0x2e, 0x34, 0x39, 0xbe, 0xcb, 0x71, 0x9a, 0x18, 0xea, 0xca, 0x0e, 0x0e, 0xc1,
-	0x3d, 0x5e, 0x4c, 0xee, 0x58, 0xc9, 0x77, 0xf5, 0x57, 0x96, 0xa8, 0xce, 0xc8, 0x1b, 0xa8, 0x55,
-	0x74, 0x31, 0xcf, 0xb0, 0xfa, 0xd7, 0xe0, 0xb7, 0x03, 0x70, 0xce, 0x8a, 0xcb, 0x73, 0x59, 0x4c,
-	0xf4, 0x11, 0x5a, 0xb7, 0x7a, 0x38, 0xcd, 0x4a, 0x0a, 0xaa, 0x4a, 0x3f, 0xc4, 0x55, 0x13, 0xac,
-	0x9b, 0xf2, 0x01, 0x84, 0xc6, 0xd0, 0xd6, 0xd3, 0x1a, 0xa9, 0xc5, 0xa6, 0xa6, 0x63, 0x73, 0xd9,
-	0x62, 0xe3, 0xe1, 0x1a, 0xb3, 0xac, 0xee, 0x17, 0xe8, 0x2c, 0x12, 0x2f, 0x69, 0xe0, 0x57, 0x8b,
-	0x53, 0xf7, 0xff, 0x93, 0xa5, 0x62, 0xf5, 0xf4, 0xa7, 0x97, 0xdf, 0xf6, 0x0a, 0x22, 0x30, 0xe7,
-	0x03, 0x42, 0x87, 0xea, 0x34, 0xbc, 0xa6, 0xc3, 0x42, 0x0c, 0xe5, 0x36, 0x1e, 0x6a, 0xec, 0x95,
-	0x2b, 0xcd, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa7, 0x99, 0x19, 0xc3, 0x05, 0x00,
-	0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xd1, 0x4e, 0xdb, 0x4a,
+	0x10, 0x95, 0x13, 0xe2, 0x24, 0x63, 0x12, 0xb8, 0x2b, 0xe0, 0xfa, 0x06, 0x21, 0x22, 0x8b, 0xdb,
+	0xa6, 0x7d, 0x48, 0xa4, 0xa0, 0x4a, 0x34, 0x15, 0x55, 0x69, 0xc4, 0x03, 0x2a, 0x52, 0x2b, 0x13,
+	0xf1, 0xd0, 0x17, 0xcb, 0x38, 0x5b, 0x62, 0xe1, 0x78, 0xcd, 0xee, 0xda, 0x25, 0xbf, 0xd3, 0xdf,
+	0xe2, 0x13, 0xfa, 0x13, 0x95, 0x77, 0xd7, 0x66, 0x0d, 0xe9, 0xdb, 0x9e, 0x9d, 0x99, 0x33, 0x67,
+	0x67, 0x67, 0x06, 0x3a, 0x19, 0x0b, 0x16, 0x78, 0xe9, 0x0f, 0x13, 0x4a, 0x38, 0x41, 0x4d, 0x05,
+	0x7b, 0xd6, 0x7d, 0x8a, 0xe9, 0x4a, 0xde, 0x3a, 0x13, 0xd8, 0x74, 0x49, 0xca, 0xc3, 0xf8, 0xd6,
+	0x4d, 0x23, 0xcc, 0xd0, 0x5b, 0x68, 0xd0, 0xfc, 0x60, 0x1b, 0xfd, 0xfa, 0xc0, 0x1a, 0xef, 0x0c,
+	0x0b, 0x12, 0xcd, 0xcb, 0x95, 0x2e, 0xce, 0x05, 0x58, 0xda, 0x2d, 0x3a, 0x00, 0xf8, 0x41, 0xc9,
+	0xd2, 0xe3, 0xfe, 0x4d, 0x84, 0x6d, 0xa3, 0x6f, 0x0c, 0xda, 0x6e, 0x3b, 0xbf, 0x99, 0xe5, 0x17,
+	0x68, 0x1f, 0xda, 0x9c, 0x48, 0x23, 0xb3, 0x6b, 0xfd, 0xfa, 0xa0, 0xed, 0xb6, 0x38, 0x11, 0x36,
+	0xe6, 0xfc, 0xae, 0x41, 0xeb, 0x0b, 0x5e, 0xb1, 0xc4, 0x0f, 0x30, 0xb2, 0xa1, 0xc9, 0x16, 0x3e,
+	0x9d, 0xe3, 0xb9, 0x60, 0x69, 0xb9, 0x05, 0x44, 0x1f, 0xa0, 0x95, 0x85, 0xf1, 0x1c, 0x3f, 0x28,
+	0x0a, 0x6b, 0x7c, 0x58, 0x0a, 0x2c, 0xc2, 0x87, 0xd7, 0xca, 0xe3, 0x3c, 0xe6, 0x74, 0xe5, 0x96,
+	0x01, 0xe8, 0x1d, 0x98, 0x2a, 0x7b, 0x5d, 0x84, 0x1e, 0xbc, 0x0c, 0x95, 0x6a, 0x64, 0xa0, 0x72,
+	0x46, 0x27, 0x60, 0x53, 0x7c, 0x9f, 0x86, 0x14, 0x7b, 0xf8, 0x21, 0x89, 0xc2, 0x20, 0xe4, 0x1e,
+	0x95, 0xcf, 0xb6, 0x37, 0x84, 0xbc, 0x3d, 0x65, 0x3f, 0x57, 0x66, 0x55, 0x94, 0xde, 0x25, 0x74,
+	0x2a, 0x5a, 0xd0, 0x36, 0xd4, 0xef, 0xf0, 0x4a, 0x95, 0x26, 0x3f, 0xa2, 0xff, 0xa1, 0x91, 0xf9,
+	0x51, 0x8a, 0xed, 0x5a, 0xdf, 0x18, 0x58, 0xe3, 0xad, 0x52, 0x92, 0x0c, 0x74, 0xa5, 0x75, 0x52,
+	0x3b, 0x31, 0x7a, 0x17, 0x60, 0x69, 0xf2, 0xd6, 0x70, 0x1d, 0x55, 0xb9, 0xba, 0x25, 0x97, 0x08,
+	0xd3, 0xa8, 0x9c, 0x5f, 0x06, 0x98, 0x32, 0x01, 0x42, 0xb0, 0xc1, 0x57, 0x49, 0xf1, 0x5d, 0xe2,
+	0x8c, 0x8e, 0xc1, 0x4c, 0x7c, 0xea, 0x2f, 0x8b, 0x1a, 0xef, 0x3f, 0x53, 0x35, 0xfc, 0x26, 0xac,
+	0xaa, 0x4c, 0xd2, 0x15, 0xed, 0x40, 0x83, 0xfc, 0x8c, 0x31, 0xb5, 0xeb, 0x82, 0x49, 0x82, 0xde,
+	0x7b, 0xb0, 0x34, 0xe7, 0x35, 0xa2, 0x77, 0x74, 0xd1, 0x6d, 0x5d, 0xe4, 0x63, 0x0d, 0x1a, 0xb2,
+	0x73, 0xd6, 0x69, 0xfc, 0x08, 0x5b, 0x01, 0x89, 0xd2, 0x65, 0xec, 0x3d, 0x6b, 0x88, 0xdd, 0x52,
+	0xec, 0x54, 0xd8, 0x55, 0x21, 0xbb, 0x81, 0x86, 0x30, 0x43, 0xa7, 0xd0, 0xf5, 0x53, 0x4e, 0xbc,
+	0x30, 0x0e, 0x28, 0x5e, 0xe2, 0x98, 0x0b, 0xdd, 0xd6, 0x78, 0xaf, 0x0c, 0x3f, 0x4b, 0x39, 0xb9,
+	0x28, 0xac, 0x6e, 0xc7, 0xd7, 0x21, 0x7a, 0x03, 0x4d, 0x49, 0xc8, 0xec, 0x8d, 0x7e, 0xbd, 0xf2,
+	0x73, 0x32, 0xad, 0x5b, 0xd8, 0xd1, 0x1e, 0x98, 0x49, 0x18, 0xc7, 0x78, 0x6e, 0x37, 0x84, 0x7e,
+	0x85, 0xd0, 0x04, 0xfe, 0x53, 0x2f, 0x88, 0x42, 0xc6, 0x3d, 0x3f, 0xe5, 0x0b, 0x42, 0x43, 0xee,
+	0xf3, 0x30, 0xc3, 0xb6, 0x29, 0x1a, 0xeb, 0x5f, 0xe9, 0x70, 0x19, 0x32, 0x7e, 0xa6, 0x9b, 0x73,
+	0x4e, 0x46, 0x52, 0x1a, 0x60, 0xbb, 0x29, 0x39, 0x25, 0x42, 0x03, 0xd8, 0xa6, 0x98, 0xa5, 0x11,
+	0xf7, 0x02, 0x3f, 0x58, 0x60, 0x8f, 0xf3, 0xc8, 0x6e, 0x09, 0x8f, 0xae, 0xbc, 0x9f, 0xe6, 0xd7,
+	0x33, 0x1e, 0x39, 0x33, 0xd8, 0xd4, 0xeb, 0x93, 0x33, 0xca, 0x64, 0xaa, 0xca, 0x0a, 0xe5, 0xb5,
+	0x8f, 0xfd, 0x65, 0xf1, 0x3d, 0xe2, 0x9c, 0xcf, 0x67, 0xf1, 0xf8, 0xba, 0x98, 0xe3, 0x02, 0x3a,
+	0x53, 0xe8, 0x54, 0xca, 0xf6, 0x57, 0xda, 0x1e, 0xb4, 0x18, 0xbe, 0x4f, 0x71, 0x1c, 0x14, 0xd4,
+	0x25, 0x76, 0x4e, 0xc1, 0x9c, 0x56, 0x93, 0x1b, 0x5a, 0xf2, 0x43, 0xd5, 0x0c, 0x79, 0x54, 0x77,
+	0x6c, 0x0d, 0xe5, 0x32, 0x9b, 0xad, 0x12, 0x2c, 0x3b, 0xc3, 0x79, 0x34, 0x00, 0xae, 0x68, 0x76,
+	0x7d, 0x25, 0xbe, 0x03, 0x7d, 0x82, 0xf6, 0x9d, 0x1a, 0xef, 0x62, 0xa9, 0x39, 0xe5, 0x5f, 0x3d,
+	0xf9, 0x95, 0x3b, 0x40, 0xb5, 0xf5, 0x53, 0x10, 0x9a, 0x40, 0x47, 0xcd, 0xbb, 0x27, 0x57, 0xa3,
+	0x9c, 0xaf, 0xdd, 0x75, 0xab, 0x91, 0xb9, 0x9b, 0x54, 0x43, 0xbd, 0xaf, 0xd0, 0xad, 0x12, 0xaf,
+	0x19, 0x81, 0xd7, 0xd5, 0xb9, 0xfd, 0xe7, 0xc5, 0x5a, 0xd2, 0xa6, 0xe2, 0xf3, 0xab, 0xef, 0x47,
+	0x59, 0xc8, 0x31, 0x63, 0xc3, 0x90, 0x8c, 0xe4, 0x69, 0x74, 0x4b, 0x46, 0x19, 0x1f, 0x89, 0x7d,
+	0x3e, 0x52, 0xb1, 0x37, 0xa6, 0x80, 0xc7, 0x7f, 0x06, 0x00, 0x97, 0x50, 0xc0, 0xd5, 0x05, 0x06,
+	0x00, 0x00,
 }
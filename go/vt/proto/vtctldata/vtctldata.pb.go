@@ -11,6 +11,7 @@ import (
 	duration "github.com/golang/protobuf/ptypes/duration"
 	logutil "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctl "vitess.io/vitess/go/vt/proto/mysqlctl"
+	query "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdata "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
 	vschema "vitess.io/vitess/go/vt/proto/vschema"
@@ -227,6 +228,76 @@ func (m *ChangeTabletTypeResponse) GetWasDryRun() bool {
 	return false
 }
 
+type ConcludeTransactionRequest struct {
+	Dtid                 string   `protobuf:"bytes,1,opt,name=dtid,proto3" json:"dtid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConcludeTransactionRequest) Reset()         { *m = ConcludeTransactionRequest{} }
+func (m *ConcludeTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ConcludeTransactionRequest) ProtoMessage()    {}
+func (*ConcludeTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{4}
+}
+
+func (m *ConcludeTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConcludeTransactionRequest.Unmarshal(m, b)
+}
+func (m *ConcludeTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConcludeTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *ConcludeTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcludeTransactionRequest.Merge(m, src)
+}
+func (m *ConcludeTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_ConcludeTransactionRequest.Size(m)
+}
+func (m *ConcludeTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcludeTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConcludeTransactionRequest proto.InternalMessageInfo
+
+func (m *ConcludeTransactionRequest) GetDtid() string {
+	if m != nil {
+		return m.Dtid
+	}
+	return ""
+}
+
+type ConcludeTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConcludeTransactionResponse) Reset()         { *m = ConcludeTransactionResponse{} }
+func (m *ConcludeTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*ConcludeTransactionResponse) ProtoMessage()    {}
+func (*ConcludeTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{5}
+}
+
+func (m *ConcludeTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConcludeTransactionResponse.Unmarshal(m, b)
+}
+func (m *ConcludeTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConcludeTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *ConcludeTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcludeTransactionResponse.Merge(m, src)
+}
+func (m *ConcludeTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_ConcludeTransactionResponse.Size(m)
+}
+func (m *ConcludeTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcludeTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConcludeTransactionResponse proto.InternalMessageInfo
+
 type CreateKeyspaceRequest struct {
 	// Name is the name of the keyspace.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -259,7 +330,7 @@ func (m *CreateKeyspaceRequest) Reset()         { *m = CreateKeyspaceRequest{} }
 func (m *CreateKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyspaceRequest) ProtoMessage()    {}
 func (*CreateKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{4}
+	return fileDescriptor_f41247b323a1ab2e, []int{6}
 }
 
 func (m *CreateKeyspaceRequest) XXX_Unmarshal(b []byte) error {
@@ -355,7 +426,7 @@ func (m *CreateKeyspaceResponse) Reset()         { *m = CreateKeyspaceResponse{}
 func (m *CreateKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyspaceResponse) ProtoMessage()    {}
 func (*CreateKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{5}
+	return fileDescriptor_f41247b323a1ab2e, []int{7}
 }
 
 func (m *CreateKeyspaceResponse) XXX_Unmarshal(b []byte) error {
@@ -403,7 +474,7 @@ func (m *CreateShardRequest) Reset()         { *m = CreateShardRequest{} }
 func (m *CreateShardRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateShardRequest) ProtoMessage()    {}
 func (*CreateShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{6}
+	return fileDescriptor_f41247b323a1ab2e, []int{8}
 }
 
 func (m *CreateShardRequest) XXX_Unmarshal(b []byte) error {
@@ -470,7 +541,7 @@ func (m *CreateShardResponse) Reset()         { *m = CreateShardResponse{} }
 func (m *CreateShardResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateShardResponse) ProtoMessage()    {}
 func (*CreateShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{7}
+	return fileDescriptor_f41247b323a1ab2e, []int{9}
 }
 
 func (m *CreateShardResponse) XXX_Unmarshal(b []byte) error {
@@ -528,7 +599,7 @@ func (m *DeleteKeyspaceRequest) Reset()         { *m = DeleteKeyspaceRequest{} }
 func (m *DeleteKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyspaceRequest) ProtoMessage()    {}
 func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{8}
+	return fileDescriptor_f41247b323a1ab2e, []int{10}
 }
 
 func (m *DeleteKeyspaceRequest) XXX_Unmarshal(b []byte) error {
@@ -573,7 +644,7 @@ func (m *DeleteKeyspaceResponse) Reset()         { *m = DeleteKeyspaceResponse{}
 func (m *DeleteKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyspaceResponse) ProtoMessage()    {}
 func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{9}
+	return fileDescriptor_f41247b323a1ab2e, []int{11}
 }
 
 func (m *DeleteKeyspaceResponse) XXX_Unmarshal(b []byte) error {
@@ -614,7 +685,7 @@ func (m *DeleteShardsRequest) Reset()         { *m = DeleteShardsRequest{} }
 func (m *DeleteShardsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardsRequest) ProtoMessage()    {}
 func (*DeleteShardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{10}
+	return fileDescriptor_f41247b323a1ab2e, []int{12}
 }
 
 func (m *DeleteShardsRequest) XXX_Unmarshal(b []byte) error {
@@ -666,7 +737,7 @@ func (m *DeleteShardsResponse) Reset()         { *m = DeleteShardsResponse{} }
 func (m *DeleteShardsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardsResponse) ProtoMessage()    {}
 func (*DeleteShardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{11}
+	return fileDescriptor_f41247b323a1ab2e, []int{13}
 }
 
 func (m *DeleteShardsResponse) XXX_Unmarshal(b []byte) error {
@@ -702,7 +773,7 @@ func (m *DeleteTabletsRequest) Reset()         { *m = DeleteTabletsRequest{} }
 func (m *DeleteTabletsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteTabletsRequest) ProtoMessage()    {}
 func (*DeleteTabletsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{12}
+	return fileDescriptor_f41247b323a1ab2e, []int{14}
 }
 
 func (m *DeleteTabletsRequest) XXX_Unmarshal(b []byte) error {
@@ -747,7 +818,7 @@ func (m *DeleteTabletsResponse) Reset()         { *m = DeleteTabletsResponse{} }
 func (m *DeleteTabletsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteTabletsResponse) ProtoMessage()    {}
 func (*DeleteTabletsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{13}
+	return fileDescriptor_f41247b323a1ab2e, []int{15}
 }
 
 func (m *DeleteTabletsResponse) XXX_Unmarshal(b []byte) error {
@@ -780,7 +851,7 @@ func (m *GetBackupsRequest) Reset()         { *m = GetBackupsRequest{} }
 func (m *GetBackupsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBackupsRequest) ProtoMessage()    {}
 func (*GetBackupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{14}
+	return fileDescriptor_f41247b323a1ab2e, []int{16}
 }
 
 func (m *GetBackupsRequest) XXX_Unmarshal(b []byte) error {
@@ -826,7 +897,7 @@ func (m *GetBackupsResponse) Reset()         { *m = GetBackupsResponse{} }
 func (m *GetBackupsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBackupsResponse) ProtoMessage()    {}
 func (*GetBackupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{15}
+	return fileDescriptor_f41247b323a1ab2e, []int{17}
 }
 
 func (m *GetBackupsResponse) XXX_Unmarshal(b []byte) error {
@@ -864,7 +935,7 @@ func (m *GetCellInfoNamesRequest) Reset()         { *m = GetCellInfoNamesRequest
 func (m *GetCellInfoNamesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoNamesRequest) ProtoMessage()    {}
 func (*GetCellInfoNamesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{16}
+	return fileDescriptor_f41247b323a1ab2e, []int{18}
 }
 
 func (m *GetCellInfoNamesRequest) XXX_Unmarshal(b []byte) error {
@@ -896,7 +967,7 @@ func (m *GetCellInfoNamesResponse) Reset()         { *m = GetCellInfoNamesRespon
 func (m *GetCellInfoNamesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoNamesResponse) ProtoMessage()    {}
 func (*GetCellInfoNamesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{17}
+	return fileDescriptor_f41247b323a1ab2e, []int{19}
 }
 
 func (m *GetCellInfoNamesResponse) XXX_Unmarshal(b []byte) error {
@@ -935,7 +1006,7 @@ func (m *GetCellInfoRequest) Reset()         { *m = GetCellInfoRequest{} }
 func (m *GetCellInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoRequest) ProtoMessage()    {}
 func (*GetCellInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{18}
+	return fileDescriptor_f41247b323a1ab2e, []int{20}
 }
 
 func (m *GetCellInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -974,7 +1045,7 @@ func (m *GetCellInfoResponse) Reset()         { *m = GetCellInfoResponse{} }
 func (m *GetCellInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoResponse) ProtoMessage()    {}
 func (*GetCellInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{19}
+	return fileDescriptor_f41247b323a1ab2e, []int{21}
 }
 
 func (m *GetCellInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1012,7 +1083,7 @@ func (m *GetCellsAliasesRequest) Reset()         { *m = GetCellsAliasesRequest{}
 func (m *GetCellsAliasesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCellsAliasesRequest) ProtoMessage()    {}
 func (*GetCellsAliasesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{20}
+	return fileDescriptor_f41247b323a1ab2e, []int{22}
 }
 
 func (m *GetCellsAliasesRequest) XXX_Unmarshal(b []byte) error {
@@ -1044,7 +1115,7 @@ func (m *GetCellsAliasesResponse) Reset()         { *m = GetCellsAliasesResponse
 func (m *GetCellsAliasesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCellsAliasesResponse) ProtoMessage()    {}
 func (*GetCellsAliasesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{21}
+	return fileDescriptor_f41247b323a1ab2e, []int{23}
 }
 
 func (m *GetCellsAliasesResponse) XXX_Unmarshal(b []byte) error {
@@ -1082,7 +1153,7 @@ func (m *GetKeyspacesRequest) Reset()         { *m = GetKeyspacesRequest{} }
 func (m *GetKeyspacesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspacesRequest) ProtoMessage()    {}
 func (*GetKeyspacesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{22}
+	return fileDescriptor_f41247b323a1ab2e, []int{24}
 }
 
 func (m *GetKeyspacesRequest) XXX_Unmarshal(b []byte) error {
@@ -1114,7 +1185,7 @@ func (m *GetKeyspacesResponse) Reset()         { *m = GetKeyspacesResponse{} }
 func (m *GetKeyspacesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspacesResponse) ProtoMessage()    {}
 func (*GetKeyspacesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{23}
+	return fileDescriptor_f41247b323a1ab2e, []int{25}
 }
 
 func (m *GetKeyspacesResponse) XXX_Unmarshal(b []byte) error {
@@ -1153,7 +1224,7 @@ func (m *GetKeyspaceRequest) Reset()         { *m = GetKeyspaceRequest{} }
 func (m *GetKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspaceRequest) ProtoMessage()    {}
 func (*GetKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{24}
+	return fileDescriptor_f41247b323a1ab2e, []int{26}
 }
 
 func (m *GetKeyspaceRequest) XXX_Unmarshal(b []byte) error {
@@ -1192,7 +1263,7 @@ func (m *GetKeyspaceResponse) Reset()         { *m = GetKeyspaceResponse{} }
 func (m *GetKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspaceResponse) ProtoMessage()    {}
 func (*GetKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{25}
+	return fileDescriptor_f41247b323a1ab2e, []int{27}
 }
 
 func (m *GetKeyspaceResponse) XXX_Unmarshal(b []byte) error {
@@ -1246,7 +1317,7 @@ func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
 func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaRequest) ProtoMessage()    {}
 func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{26}
+	return fileDescriptor_f41247b323a1ab2e, []int{28}
 }
 
 func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1320,7 +1391,7 @@ func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
 func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaResponse) ProtoMessage()    {}
 func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{27}
+	return fileDescriptor_f41247b323a1ab2e, []int{29}
 }
 
 func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1360,7 +1431,7 @@ func (m *GetShardRequest) Reset()         { *m = GetShardRequest{} }
 func (m *GetShardRequest) String() string { return proto.CompactTextString(m) }
 func (*GetShardRequest) ProtoMessage()    {}
 func (*GetShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{28}
+	return fileDescriptor_f41247b323a1ab2e, []int{30}
 }
 
 func (m *GetShardRequest) XXX_Unmarshal(b []byte) error {
@@ -1406,7 +1477,7 @@ func (m *GetShardResponse) Reset()         { *m = GetShardResponse{} }
 func (m *GetShardResponse) String() string { return proto.CompactTextString(m) }
 func (*GetShardResponse) ProtoMessage()    {}
 func (*GetShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{29}
+	return fileDescriptor_f41247b323a1ab2e, []int{31}
 }
 
 func (m *GetShardResponse) XXX_Unmarshal(b []byte) error {
@@ -1445,7 +1516,7 @@ func (m *GetSrvVSchemaRequest) Reset()         { *m = GetSrvVSchemaRequest{} }
 func (m *GetSrvVSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSrvVSchemaRequest) ProtoMessage()    {}
 func (*GetSrvVSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{30}
+	return fileDescriptor_f41247b323a1ab2e, []int{32}
 }
 
 func (m *GetSrvVSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1484,7 +1555,7 @@ func (m *GetSrvVSchemaResponse) Reset()         { *m = GetSrvVSchemaResponse{} }
 func (m *GetSrvVSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSrvVSchemaResponse) ProtoMessage()    {}
 func (*GetSrvVSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{31}
+	return fileDescriptor_f41247b323a1ab2e, []int{33}
 }
 
 func (m *GetSrvVSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1523,7 +1594,7 @@ func (m *GetTabletRequest) Reset()         { *m = GetTabletRequest{} }
 func (m *GetTabletRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTabletRequest) ProtoMessage()    {}
 func (*GetTabletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{32}
+	return fileDescriptor_f41247b323a1ab2e, []int{34}
 }
 
 func (m *GetTabletRequest) XXX_Unmarshal(b []byte) error {
@@ -1562,7 +1633,7 @@ func (m *GetTabletResponse) Reset()         { *m = GetTabletResponse{} }
 func (m *GetTabletResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTabletResponse) ProtoMessage()    {}
 func (*GetTabletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{33}
+	return fileDescriptor_f41247b323a1ab2e, []int{35}
 }
 
 func (m *GetTabletResponse) XXX_Unmarshal(b []byte) error {
@@ -1608,7 +1679,7 @@ func (m *GetTabletsRequest) Reset()         { *m = GetTabletsRequest{} }
 func (m *GetTabletsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTabletsRequest) ProtoMessage()    {}
 func (*GetTabletsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{34}
+	return fileDescriptor_f41247b323a1ab2e, []int{36}
 }
 
 func (m *GetTabletsRequest) XXX_Unmarshal(b []byte) error {
@@ -1661,7 +1732,7 @@ func (m *GetTabletsResponse) Reset()         { *m = GetTabletsResponse{} }
 func (m *GetTabletsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTabletsResponse) ProtoMessage()    {}
 func (*GetTabletsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{35}
+	return fileDescriptor_f41247b323a1ab2e, []int{37}
 }
 
 func (m *GetTabletsResponse) XXX_Unmarshal(b []byte) error {
@@ -1689,6 +1760,94 @@ func (m *GetTabletsResponse) GetTablets() []*topodata.Tablet {
 	return nil
 }
 
+type GetUnresolvedTransactionsRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// AbandonAge is the minimum age of the transactions to return. Omit to
+	// return all the transactions, including the ones being committed.
+	AbandonAge           *duration.Duration `protobuf:"bytes,2,opt,name=abandon_age,json=abandonAge,proto3" json:"abandon_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetUnresolvedTransactionsRequest) Reset()         { *m = GetUnresolvedTransactionsRequest{} }
+func (m *GetUnresolvedTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUnresolvedTransactionsRequest) ProtoMessage()    {}
+func (*GetUnresolvedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{38}
+}
+
+func (m *GetUnresolvedTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUnresolvedTransactionsRequest.Unmarshal(m, b)
+}
+func (m *GetUnresolvedTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUnresolvedTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUnresolvedTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUnresolvedTransactionsRequest.Merge(m, src)
+}
+func (m *GetUnresolvedTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUnresolvedTransactionsRequest.Size(m)
+}
+func (m *GetUnresolvedTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUnresolvedTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUnresolvedTransactionsRequest proto.InternalMessageInfo
+
+func (m *GetUnresolvedTransactionsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetUnresolvedTransactionsRequest) GetAbandonAge() *duration.Duration {
+	if m != nil {
+		return m.AbandonAge
+	}
+	return nil
+}
+
+type GetUnresolvedTransactionsResponse struct {
+	Transactions         []*query.TransactionMetadata `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *GetUnresolvedTransactionsResponse) Reset()         { *m = GetUnresolvedTransactionsResponse{} }
+func (m *GetUnresolvedTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUnresolvedTransactionsResponse) ProtoMessage()    {}
+func (*GetUnresolvedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{39}
+}
+
+func (m *GetUnresolvedTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUnresolvedTransactionsResponse.Unmarshal(m, b)
+}
+func (m *GetUnresolvedTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUnresolvedTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetUnresolvedTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUnresolvedTransactionsResponse.Merge(m, src)
+}
+func (m *GetUnresolvedTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUnresolvedTransactionsResponse.Size(m)
+}
+func (m *GetUnresolvedTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUnresolvedTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUnresolvedTransactionsResponse proto.InternalMessageInfo
+
+func (m *GetUnresolvedTransactionsResponse) GetTransactions() []*query.TransactionMetadata {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
 type GetVSchemaRequest struct {
 	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1700,7 +1859,7 @@ func (m *GetVSchemaRequest) Reset()         { *m = GetVSchemaRequest{} }
 func (m *GetVSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetVSchemaRequest) ProtoMessage()    {}
 func (*GetVSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{36}
+	return fileDescriptor_f41247b323a1ab2e, []int{40}
 }
 
 func (m *GetVSchemaRequest) XXX_Unmarshal(b []byte) error {
@@ -1739,7 +1898,7 @@ func (m *GetVSchemaResponse) Reset()         { *m = GetVSchemaResponse{} }
 func (m *GetVSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetVSchemaResponse) ProtoMessage()    {}
 func (*GetVSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{37}
+	return fileDescriptor_f41247b323a1ab2e, []int{41}
 }
 
 func (m *GetVSchemaResponse) XXX_Unmarshal(b []byte) error {
@@ -1782,7 +1941,7 @@ func (m *InitShardPrimaryRequest) Reset()         { *m = InitShardPrimaryRequest
 func (m *InitShardPrimaryRequest) String() string { return proto.CompactTextString(m) }
 func (*InitShardPrimaryRequest) ProtoMessage()    {}
 func (*InitShardPrimaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{38}
+	return fileDescriptor_f41247b323a1ab2e, []int{42}
 }
 
 func (m *InitShardPrimaryRequest) XXX_Unmarshal(b []byte) error {
@@ -1849,7 +2008,7 @@ func (m *InitShardPrimaryResponse) Reset()         { *m = InitShardPrimaryRespon
 func (m *InitShardPrimaryResponse) String() string { return proto.CompactTextString(m) }
 func (*InitShardPrimaryResponse) ProtoMessage()    {}
 func (*InitShardPrimaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{39}
+	return fileDescriptor_f41247b323a1ab2e, []int{43}
 }
 
 func (m *InitShardPrimaryResponse) XXX_Unmarshal(b []byte) error {
@@ -1896,7 +2055,7 @@ func (m *RemoveKeyspaceCellRequest) Reset()         { *m = RemoveKeyspaceCellReq
 func (m *RemoveKeyspaceCellRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveKeyspaceCellRequest) ProtoMessage()    {}
 func (*RemoveKeyspaceCellRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{40}
+	return fileDescriptor_f41247b323a1ab2e, []int{44}
 }
 
 func (m *RemoveKeyspaceCellRequest) XXX_Unmarshal(b []byte) error {
@@ -1955,7 +2114,7 @@ func (m *RemoveKeyspaceCellResponse) Reset()         { *m = RemoveKeyspaceCellRe
 func (m *RemoveKeyspaceCellResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveKeyspaceCellResponse) ProtoMessage()    {}
 func (*RemoveKeyspaceCellResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{41}
+	return fileDescriptor_f41247b323a1ab2e, []int{45}
 }
 
 func (m *RemoveKeyspaceCellResponse) XXX_Unmarshal(b []byte) error {
@@ -1996,7 +2155,7 @@ func (m *RemoveShardCellRequest) Reset()         { *m = RemoveShardCellRequest{}
 func (m *RemoveShardCellRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveShardCellRequest) ProtoMessage()    {}
 func (*RemoveShardCellRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{42}
+	return fileDescriptor_f41247b323a1ab2e, []int{46}
 }
 
 func (m *RemoveShardCellRequest) XXX_Unmarshal(b []byte) error {
@@ -2062,7 +2221,7 @@ func (m *RemoveShardCellResponse) Reset()         { *m = RemoveShardCellResponse
 func (m *RemoveShardCellResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveShardCellResponse) ProtoMessage()    {}
 func (*RemoveShardCellResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{43}
+	return fileDescriptor_f41247b323a1ab2e, []int{47}
 }
 
 func (m *RemoveShardCellResponse) XXX_Unmarshal(b []byte) error {
@@ -2083,6 +2242,76 @@ func (m *RemoveShardCellResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveShardCellResponse proto.InternalMessageInfo
 
+type RollbackTransactionRequest struct {
+	Dtid                 string   `protobuf:"bytes,1,opt,name=dtid,proto3" json:"dtid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackTransactionRequest) Reset()         { *m = RollbackTransactionRequest{} }
+func (m *RollbackTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackTransactionRequest) ProtoMessage()    {}
+func (*RollbackTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{48}
+}
+
+func (m *RollbackTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackTransactionRequest.Unmarshal(m, b)
+}
+func (m *RollbackTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackTransactionRequest.Merge(m, src)
+}
+func (m *RollbackTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackTransactionRequest.Size(m)
+}
+func (m *RollbackTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackTransactionRequest proto.InternalMessageInfo
+
+func (m *RollbackTransactionRequest) GetDtid() string {
+	if m != nil {
+		return m.Dtid
+	}
+	return ""
+}
+
+type RollbackTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackTransactionResponse) Reset()         { *m = RollbackTransactionResponse{} }
+func (m *RollbackTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackTransactionResponse) ProtoMessage()    {}
+func (*RollbackTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{49}
+}
+
+func (m *RollbackTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackTransactionResponse.Unmarshal(m, b)
+}
+func (m *RollbackTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *RollbackTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackTransactionResponse.Merge(m, src)
+}
+func (m *RollbackTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_RollbackTransactionResponse.Size(m)
+}
+func (m *RollbackTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackTransactionResponse proto.InternalMessageInfo
+
 type Keyspace struct {
 	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Keyspace             *topodata.Keyspace `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
@@ -2095,7 +2324,7 @@ func (m *Keyspace) Reset()         { *m = Keyspace{} }
 func (m *Keyspace) String() string { return proto.CompactTextString(m) }
 func (*Keyspace) ProtoMessage()    {}
 func (*Keyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{44}
+	return fileDescriptor_f41247b323a1ab2e, []int{50}
 }
 
 func (m *Keyspace) XXX_Unmarshal(b []byte) error {
@@ -2141,7 +2370,7 @@ func (m *FindAllShardsInKeyspaceRequest) Reset()         { *m = FindAllShardsInK
 func (m *FindAllShardsInKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*FindAllShardsInKeyspaceRequest) ProtoMessage()    {}
 func (*FindAllShardsInKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{45}
+	return fileDescriptor_f41247b323a1ab2e, []int{51}
 }
 
 func (m *FindAllShardsInKeyspaceRequest) XXX_Unmarshal(b []byte) error {
@@ -2180,7 +2409,7 @@ func (m *FindAllShardsInKeyspaceResponse) Reset()         { *m = FindAllShardsIn
 func (m *FindAllShardsInKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*FindAllShardsInKeyspaceResponse) ProtoMessage()    {}
 func (*FindAllShardsInKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{46}
+	return fileDescriptor_f41247b323a1ab2e, []int{52}
 }
 
 func (m *FindAllShardsInKeyspaceResponse) XXX_Unmarshal(b []byte) error {
@@ -2221,7 +2450,7 @@ func (m *Shard) Reset()         { *m = Shard{} }
 func (m *Shard) String() string { return proto.CompactTextString(m) }
 func (*Shard) ProtoMessage()    {}
 func (*Shard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{47}
+	return fileDescriptor_f41247b323a1ab2e, []int{53}
 }
 
 func (m *Shard) XXX_Unmarshal(b []byte) error {
@@ -2281,7 +2510,7 @@ func (m *TableMaterializeSettings) Reset()         { *m = TableMaterializeSettin
 func (m *TableMaterializeSettings) String() string { return proto.CompactTextString(m) }
 func (*TableMaterializeSettings) ProtoMessage()    {}
 func (*TableMaterializeSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{48}
+	return fileDescriptor_f41247b323a1ab2e, []int{54}
 }
 
 func (m *TableMaterializeSettings) XXX_Unmarshal(b []byte) error {
@@ -2344,7 +2573,7 @@ func (m *MaterializeSettings) Reset()         { *m = MaterializeSettings{} }
 func (m *MaterializeSettings) String() string { return proto.CompactTextString(m) }
 func (*MaterializeSettings) ProtoMessage()    {}
 func (*MaterializeSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{49}
+	return fileDescriptor_f41247b323a1ab2e, []int{55}
 }
 
 func (m *MaterializeSettings) XXX_Unmarshal(b []byte) error {
@@ -2419,6 +2648,8 @@ func init() {
 	proto.RegisterType((*ExecuteVtctlCommandResponse)(nil), "vtctldata.ExecuteVtctlCommandResponse")
 	proto.RegisterType((*ChangeTabletTypeRequest)(nil), "vtctldata.ChangeTabletTypeRequest")
 	proto.RegisterType((*ChangeTabletTypeResponse)(nil), "vtctldata.ChangeTabletTypeResponse")
+	proto.RegisterType((*ConcludeTransactionRequest)(nil), "vtctldata.ConcludeTransactionRequest")
+	proto.RegisterType((*ConcludeTransactionResponse)(nil), "vtctldata.ConcludeTransactionResponse")
 	proto.RegisterType((*CreateKeyspaceRequest)(nil), "vtctldata.CreateKeyspaceRequest")
 	proto.RegisterType((*CreateKeyspaceResponse)(nil), "vtctldata.CreateKeyspaceResponse")
 	proto.RegisterType((*CreateShardRequest)(nil), "vtctldata.CreateShardRequest")
@@ -2452,6 +2683,8 @@ func init() {
 	proto.RegisterType((*GetTabletResponse)(nil), "vtctldata.GetTabletResponse")
 	proto.RegisterType((*GetTabletsRequest)(nil), "vtctldata.GetTabletsRequest")
 	proto.RegisterType((*GetTabletsResponse)(nil), "vtctldata.GetTabletsResponse")
+	proto.RegisterType((*GetUnresolvedTransactionsRequest)(nil), "vtctldata.GetUnresolvedTransactionsRequest")
+	proto.RegisterType((*GetUnresolvedTransactionsResponse)(nil), "vtctldata.GetUnresolvedTransactionsResponse")
 	proto.RegisterType((*GetVSchemaRequest)(nil), "vtctldata.GetVSchemaRequest")
 	proto.RegisterType((*GetVSchemaResponse)(nil), "vtctldata.GetVSchemaResponse")
 	proto.RegisterType((*InitShardPrimaryRequest)(nil), "vtctldata.InitShardPrimaryRequest")
@@ -2460,6 +2693,8 @@ func init() {
 	proto.RegisterType((*RemoveKeyspaceCellResponse)(nil), "vtctldata.RemoveKeyspaceCellResponse")
 	proto.RegisterType((*RemoveShardCellRequest)(nil), "vtctldata.RemoveShardCellRequest")
 	proto.RegisterType((*RemoveShardCellResponse)(nil), "vtctldata.RemoveShardCellResponse")
+	proto.RegisterType((*RollbackTransactionRequest)(nil), "vtctldata.RollbackTransactionRequest")
+	proto.RegisterType((*RollbackTransactionResponse)(nil), "vtctldata.RollbackTransactionResponse")
 	proto.RegisterType((*Keyspace)(nil), "vtctldata.Keyspace")
 	proto.RegisterType((*FindAllShardsInKeyspaceRequest)(nil), "vtctldata.FindAllShardsInKeyspaceRequest")
 	proto.RegisterType((*FindAllShardsInKeyspaceResponse)(nil), "vtctldata.FindAllShardsInKeyspaceResponse")
@@ -2472,120 +2707,127 @@ func init() {
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 1825 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0xe3, 0xc6,
-	0x19, 0x07, 0x25, 0x4b, 0x96, 0x3e, 0x3d, 0xec, 0xa5, 0x65, 0x4b, 0xab, 0x26, 0x5b, 0x87, 0xdb,
-	0xdd, 0x08, 0xdb, 0x56, 0xda, 0x6c, 0xd0, 0x22, 0x48, 0x53, 0x20, 0x6b, 0x5b, 0xbb, 0x50, 0x9c,
-	0xb8, 0x2e, 0x6d, 0xb8, 0x40, 0x0b, 0x94, 0x18, 0x91, 0x23, 0x99, 0x30, 0x45, 0x32, 0x9c, 0x91,
-	0x6c, 0xe6, 0xd2, 0x4b, 0x7a, 0x28, 0xd0, 0xff, 0x20, 0x97, 0x9e, 0x7a, 0xec, 0x31, 0xc7, 0xfe,
-	0x6d, 0xc5, 0xbc, 0x48, 0x8a, 0x92, 0xbc, 0x9b, 0xdd, 0x9e, 0xc4, 0xf9, 0x1e, 0xf3, 0xfd, 0x66,
-	0xbe, 0xe7, 0x08, 0x76, 0x16, 0xd4, 0xa6, 0x9e, 0x83, 0x28, 0xea, 0x87, 0x51, 0x40, 0x03, 0xbd,
-	0x9a, 0x10, 0xba, 0x8f, 0xa6, 0x41, 0x30, 0xf5, 0xf0, 0x80, 0x33, 0xc6, 0xf3, 0xc9, 0xc0, 0x99,
-	0x47, 0x88, 0xba, 0x81, 0x2f, 0x44, 0xbb, 0x0d, 0x2f, 0x98, 0xce, 0xa9, 0xeb, 0xc9, 0x65, 0x73,
-	0x16, 0x93, 0x6f, 0x3d, 0x9b, 0xaa, 0x75, 0x9b, 0xa2, 0xb1, 0x87, 0xe9, 0x0c, 0xf9, 0x68, 0x8a,
-	0xa3, 0xd4, 0x44, 0xb7, 0x49, 0x83, 0x30, 0xc8, 0xac, 0x1b, 0x0b, 0x62, 0x5f, 0xe3, 0x99, 0x5a,
-	0xd6, 0x17, 0x94, 0xba, 0x33, 0x2c, 0x56, 0xc6, 0x9f, 0xa0, 0x3b, 0xbc, 0xc3, 0xf6, 0x9c, 0xe2,
-	0x2b, 0x06, 0xec, 0x38, 0x98, 0xcd, 0x90, 0xef, 0x98, 0xf8, 0xdb, 0x39, 0x26, 0x54, 0xd7, 0x61,
-	0x0b, 0x45, 0x53, 0xd2, 0xd1, 0x0e, 0x8b, 0xbd, 0xaa, 0xc9, 0xbf, 0xf5, 0x27, 0xd0, 0x44, 0x36,
-	0x83, 0x69, 0xb1, 0x6d, 0x82, 0x39, 0xed, 0x14, 0x0e, 0xb5, 0x5e, 0xd1, 0x6c, 0x08, 0xea, 0xa5,
-	0x20, 0x1a, 0xc7, 0xf0, 0xb3, 0xb5, 0x1b, 0x93, 0x30, 0xf0, 0x09, 0xd6, 0x7f, 0x01, 0x25, 0xbc,
-	0xc0, 0x3e, 0xed, 0x68, 0x87, 0x5a, 0xaf, 0xf6, 0xa2, 0xd9, 0x57, 0x87, 0x1d, 0x32, 0xaa, 0x29,
-	0x98, 0xc6, 0x0f, 0x1a, 0xb4, 0x8f, 0xaf, 0x91, 0x3f, 0xc5, 0x97, 0xfc, 0xb0, 0x97, 0x71, 0x88,
-	0x15, 0xb6, 0xcf, 0xa0, 0x2e, 0x6e, 0xc0, 0x42, 0x9e, 0x8b, 0x88, 0xdc, 0x68, 0xbf, 0x9f, 0x9c,
-	0x5e, 0xa8, 0xbc, 0x64, 0x4c, 0xb3, 0x46, 0xd3, 0x85, 0xfe, 0x6b, 0xd8, 0x76, 0xc6, 0x16, 0x8d,
-	0x43, 0xcc, 0xa1, 0x37, 0x5f, 0xb4, 0xf2, 0x4a, 0xdc, 0x4e, 0xd9, 0x19, 0xb3, 0x5f, 0xbd, 0x0d,
-	0xdb, 0x4e, 0x14, 0x5b, 0xd1, 0xdc, 0xef, 0x14, 0x0f, 0xb5, 0x5e, 0xc5, 0x2c, 0x3b, 0x51, 0x6c,
-	0xce, 0x7d, 0xe3, 0xdf, 0x1a, 0x74, 0x56, 0xd1, 0xc9, 0x03, 0xfe, 0x06, 0x1a, 0x63, 0x3c, 0x09,
-	0x22, 0x6c, 0x09, 0xd3, 0x12, 0xdf, 0x6e, 0xde, 0x94, 0x59, 0x17, 0x62, 0x62, 0xa5, 0x7f, 0x0a,
-	0x75, 0x34, 0xa1, 0x38, 0x52, 0x5a, 0x85, 0x0d, 0x5a, 0x35, 0x2e, 0x25, 0x95, 0x1e, 0x41, 0xed,
-	0x16, 0x11, 0x6b, 0x19, 0x65, 0xf5, 0x16, 0x91, 0x13, 0x01, 0xf4, 0xc7, 0x22, 0xec, 0x1f, 0x47,
-	0x18, 0x51, 0x7c, 0x8a, 0x63, 0x12, 0x22, 0x1b, 0x67, 0x1c, 0xec, 0xa3, 0x19, 0xe6, 0xe0, 0xaa,
-	0x26, 0xff, 0xd6, 0x5b, 0x50, 0x9a, 0x04, 0x91, 0x2d, 0x2e, 0xa7, 0x62, 0x8a, 0x85, 0x3e, 0x80,
-	0x16, 0xf2, 0xbc, 0xe0, 0xd6, 0xc2, 0xb3, 0x90, 0xc6, 0xd6, 0xc2, 0x12, 0x41, 0x25, 0x8d, 0x3d,
-	0xe0, 0xbc, 0x21, 0x63, 0x5d, 0x5d, 0x70, 0x86, 0xfe, 0x1c, 0x5a, 0xe4, 0x1a, 0x45, 0x8e, 0xeb,
-	0x4f, 0x2d, 0x3b, 0xf0, 0xe6, 0x33, 0xdf, 0xe2, 0xa6, 0xb6, 0xb8, 0x29, 0x5d, 0xf1, 0x8e, 0x39,
-	0xeb, 0x8c, 0x19, 0xfe, 0x6a, 0x55, 0x83, 0x3b, 0xa9, 0xc4, 0x9d, 0xd4, 0x49, 0xef, 0x40, 0x9d,
-	0x62, 0xe4, 0xf0, 0x2b, 0xcf, 0xed, 0xc5, 0x9d, 0xf6, 0x25, 0xd4, 0x09, 0x8e, 0x16, 0xd8, 0xb1,
-	0x26, 0x51, 0x30, 0x23, 0x9d, 0xf2, 0x61, 0xb1, 0x57, 0x7b, 0xf1, 0xe1, 0xea, 0x1e, 0xfd, 0x0b,
-	0x2e, 0xf6, 0x2a, 0x0a, 0x66, 0x66, 0x8d, 0x24, 0xdf, 0x44, 0x7f, 0x06, 0x5b, 0xdc, 0xfa, 0x36,
-	0xb7, 0x7e, 0xb0, 0xaa, 0xc9, 0x6d, 0x73, 0x19, 0xfd, 0x31, 0x34, 0xc6, 0x88, 0x60, 0xeb, 0x46,
-	0xb2, 0x3a, 0x15, 0x7e, 0xc8, 0x3a, 0x23, 0x2a, 0x71, 0xfd, 0x13, 0x68, 0x10, 0x1f, 0x85, 0xe4,
-	0x3a, 0xa0, 0x3c, 0x75, 0x3a, 0x55, 0xee, 0xdb, 0x7a, 0x5f, 0x26, 0x24, 0xcb, 0x1c, 0xb3, 0xae,
-	0x44, 0xd8, 0xca, 0x18, 0xc1, 0x41, 0xde, 0x6f, 0x32, 0xbc, 0x06, 0x50, 0x49, 0x8c, 0x89, 0xc8,
-	0xda, 0xeb, 0xa7, 0xb5, 0x26, 0x11, 0x4f, 0x84, 0x8c, 0x7f, 0x6a, 0xa0, 0x8b, 0xbd, 0x2e, 0xd8,
-	0x6d, 0xa9, 0x00, 0xe8, 0xe6, 0xf6, 0xa9, 0xa6, 0x2a, 0xfa, 0x87, 0x00, 0xfc, 0x66, 0x85, 0xdf,
-	0x0a, 0x9c, 0x5b, 0xe5, 0x94, 0xb3, 0xa5, 0x38, 0x29, 0x66, 0xe3, 0xe4, 0x09, 0x34, 0x5d, 0xdf,
-	0xf6, 0xe6, 0x0e, 0xb6, 0x42, 0x14, 0xb1, 0x0c, 0xdf, 0xe2, 0xec, 0x86, 0xa4, 0x9e, 0x73, 0xa2,
-	0xf1, 0x2f, 0x0d, 0xf6, 0x96, 0xe0, 0xbc, 0xe3, 0xb9, 0xf4, 0xa7, 0x50, 0xe2, 0x90, 0x92, 0x4c,
-	0x49, 0xa5, 0xc5, 0xce, 0x82, 0x9d, 0x84, 0xa3, 0x85, 0xbc, 0x08, 0x23, 0x27, 0xb6, 0xf0, 0x9d,
-	0x4b, 0x28, 0x91, 0xe0, 0x45, 0x08, 0xbd, 0x14, 0xac, 0x21, 0xe7, 0x18, 0x7f, 0x84, 0xfd, 0x13,
-	0xec, 0xe1, 0xd5, 0xa4, 0xb9, 0xef, 0xce, 0x3e, 0x80, 0x6a, 0x84, 0xed, 0x79, 0x44, 0xdc, 0x85,
-	0x4a, 0xa0, 0x94, 0x60, 0x74, 0xe0, 0x20, 0xbf, 0xa5, 0x38, 0xb7, 0xf1, 0x77, 0x0d, 0xf6, 0x04,
-	0x8b, 0xa3, 0x26, 0xca, 0x56, 0x0f, 0xca, 0x1c, 0x9a, 0xa8, 0xc1, 0xeb, 0xce, 0x27, 0xf9, 0xf7,
-	0x5b, 0xd6, 0x9f, 0xc2, 0x0e, 0x2b, 0xa9, 0x96, 0x3b, 0xb1, 0x58, 0x90, 0xbb, 0xfe, 0x54, 0xf9,
-	0x85, 0x91, 0x47, 0x93, 0x0b, 0x41, 0x34, 0x0e, 0xa0, 0xb5, 0x0c, 0x43, 0xe2, 0x8b, 0x15, 0x5d,
-	0x94, 0x9c, 0x04, 0xdf, 0x17, 0xd0, 0xcc, 0x56, 0x61, 0xac, 0x70, 0x6e, 0xa8, 0xc3, 0x8d, 0x4c,
-	0x1d, 0xc6, 0x84, 0xe5, 0x8d, 0x28, 0x2a, 0x61, 0xe4, 0xce, 0x50, 0x14, 0x4b, 0xdc, 0x75, 0x4e,
-	0x3c, 0x17, 0x34, 0xa3, 0xad, 0xfc, 0x90, 0x98, 0x96, 0x98, 0x86, 0xf0, 0xe0, 0x35, 0xa6, 0x47,
-	0xc8, 0xbe, 0x99, 0x87, 0xe4, 0x6d, 0x9c, 0xd3, 0xca, 0xc6, 0x4a, 0x55, 0x46, 0x86, 0x71, 0x02,
-	0x7a, 0x76, 0x1b, 0x19, 0x88, 0x7d, 0xd8, 0x1e, 0x0b, 0x92, 0x3c, 0x51, 0xab, 0x9f, 0x34, 0x60,
-	0x21, 0x3b, 0xf2, 0x27, 0x81, 0xa9, 0x84, 0x8c, 0x87, 0xd0, 0x7e, 0x8d, 0xe9, 0x31, 0xf6, 0x3c,
-	0x46, 0x67, 0x09, 0xa2, 0x20, 0x19, 0xcf, 0xa1, 0xb3, 0xca, 0x92, 0x66, 0x5a, 0x50, 0x62, 0xd9,
-	0xa5, 0x5a, 0xac, 0x58, 0x18, 0x3d, 0x0e, 0x49, 0x69, 0x64, 0x8a, 0xb5, 0x8d, 0x3d, 0x4f, 0x15,
-	0x6b, 0xf6, 0x6d, 0xbc, 0x82, 0xbd, 0x25, 0xc9, 0x24, 0x8d, 0xaa, 0x8c, 0x6d, 0xb9, 0xfe, 0x24,
-	0x90, 0x79, 0xa4, 0xa7, 0x1e, 0x49, 0xc4, 0x2b, 0xb6, 0xfc, 0x62, 0x91, 0x29, 0xf7, 0x21, 0xd2,
-	0x39, 0x0a, 0xfd, 0x8f, 0x5a, 0x72, 0xb2, 0x94, 0x25, 0xcd, 0x8c, 0x60, 0x7b, 0xd9, 0xed, 0x83,
-	0x4c, 0x78, 0x6e, 0x50, 0xea, 0xcb, 0xf5, 0xd0, 0xa7, 0x51, 0x6c, 0x2a, 0xfd, 0xee, 0x39, 0xd4,
-	0xb3, 0x0c, 0x7d, 0x17, 0x8a, 0x37, 0x38, 0x96, 0x67, 0x65, 0x9f, 0xfa, 0x33, 0x28, 0x2d, 0x90,
-	0x37, 0xc7, 0x32, 0xd3, 0x5b, 0xcb, 0xe7, 0x11, 0x66, 0x4c, 0x21, 0xf2, 0x79, 0xe1, 0x33, 0xcd,
-	0xd8, 0xe7, 0x57, 0xa3, 0x32, 0x2d, 0x39, 0xcf, 0x08, 0x5a, 0xcb, 0x64, 0x79, 0x96, 0x4f, 0xa0,
-	0xaa, 0x02, 0x45, 0x9d, 0x66, 0x6d, 0xe9, 0x49, 0xa5, 0x8c, 0xe7, 0xdc, 0x4d, 0x3f, 0xa1, 0x3c,
-	0x48, 0x77, 0xbd, 0x7f, 0x35, 0xff, 0xbe, 0x00, 0xbb, 0xaf, 0x31, 0x15, 0xad, 0xf6, 0xfd, 0x27,
-	0xa2, 0x03, 0x28, 0xf3, 0x25, 0xe9, 0x14, 0x78, 0x18, 0xca, 0x15, 0x2b, 0xe6, 0xf8, 0x4e, 0x14,
-	0x73, 0xc9, 0x2f, 0x72, 0x7e, 0x43, 0x52, 0x2f, 0x85, 0xd8, 0x63, 0x50, 0xd5, 0xdd, 0x5a, 0xb8,
-	0xf8, 0x96, 0xc8, 0xd2, 0x52, 0x97, 0xc4, 0x2b, 0x46, 0xd3, 0x7b, 0xb0, 0xcb, 0xf7, 0xe0, 0xdd,
-	0x84, 0x58, 0x81, 0xef, 0xc5, 0xbc, 0xb3, 0x57, 0x4c, 0x51, 0x41, 0x78, 0x5e, 0xfc, 0xc1, 0xf7,
-	0xe2, 0x54, 0x92, 0xb8, 0xdf, 0x29, 0xc9, 0x72, 0x46, 0xf2, 0x82, 0x91, 0x99, 0xa4, 0x71, 0xce,
-	0x2b, 0x80, 0xba, 0x05, 0x79, 0x99, 0xbf, 0x83, 0xb2, 0x9c, 0x4d, 0xc4, 0x05, 0x3c, 0xee, 0xaf,
-	0x4e, 0xca, 0x42, 0xe5, 0x04, 0x4f, 0x5c, 0xdf, 0x65, 0x53, 0xab, 0x29, 0x55, 0x8c, 0xaf, 0x61,
-	0x87, 0xed, 0xf8, 0xff, 0x69, 0x91, 0xc6, 0xe7, 0xc2, 0x4b, 0x4b, 0x1d, 0x2e, 0x69, 0x58, 0xda,
-	0xbd, 0x0d, 0xcb, 0x78, 0xc6, 0xe3, 0xf4, 0x22, 0x5a, 0x5c, 0x2d, 0x7b, 0x79, 0x5d, 0x15, 0x38,
-	0x83, 0xfd, 0x9c, 0x6c, 0x32, 0x85, 0xd6, 0x49, 0xb4, 0x48, 0xa7, 0xb5, 0x24, 0xb8, 0xe4, 0x93,
-	0x20, 0xa3, 0x02, 0x24, 0xf9, 0x36, 0xbe, 0xe6, 0xb8, 0xe5, 0xa8, 0xf9, 0xbe, 0xd1, 0x65, 0xfc,
-	0x9e, 0x7b, 0x49, 0xed, 0x26, 0x91, 0xf5, 0x64, 0xc8, 0x6d, 0x1e, 0x8c, 0x25, 0xdf, 0xf8, 0x4b,
-	0x46, 0xfd, 0xdd, 0xcb, 0x3c, 0xa3, 0xb2, 0xbb, 0x52, 0x21, 0x2c, 0x16, 0xc6, 0x97, 0x3c, 0x85,
-	0x73, 0x9d, 0x45, 0x7f, 0x06, 0xdb, 0xc2, 0x78, 0xda, 0x76, 0xf3, 0xe8, 0x94, 0x80, 0x31, 0xe0,
-	0xf0, 0x72, 0x4e, 0xba, 0xaf, 0x06, 0x1c, 0x71, 0x93, 0x79, 0x4f, 0xfd, 0x0a, 0x2a, 0x39, 0x2f,
-	0x3d, 0x48, 0xbc, 0x94, 0x14, 0x80, 0xed, 0x85, 0x74, 0xd0, 0xf7, 0x05, 0x68, 0x8f, 0x7c, 0x57,
-	0x84, 0x96, 0x6c, 0x94, 0xef, 0x7e, 0x35, 0x26, 0x74, 0x65, 0x03, 0xb6, 0xb0, 0x87, 0x6d, 0x6a,
-	0x2d, 0x39, 0xba, 0x78, 0x9f, 0xa3, 0xdb, 0x52, 0x71, 0xc8, 0xf4, 0x32, 0x8c, 0x74, 0x3a, 0xdc,
-	0xca, 0x4e, 0x87, 0xdf, 0xc0, 0xfe, 0x2d, 0x72, 0xa9, 0x15, 0xe1, 0xd0, 0x73, 0x6d, 0x44, 0x92,
-	0x37, 0x64, 0x89, 0x1b, 0x79, 0xd8, 0x17, 0x6f, 0xe2, 0xbe, 0x7a, 0x13, 0xf7, 0x4f, 0xe4, 0x9b,
-	0xd8, 0xdc, 0x63, 0x7a, 0xa6, 0x54, 0x53, 0x8f, 0xcc, 0x23, 0xe8, 0xac, 0xde, 0x42, 0x92, 0x67,
-	0x65, 0xfe, 0x88, 0x54, 0x2e, 0xcc, 0x3f, 0x31, 0x25, 0xd7, 0xf8, 0x1b, 0x3c, 0x34, 0xf1, 0x2c,
-	0x58, 0x24, 0x33, 0x19, 0xeb, 0x26, 0x6f, 0x73, 0x97, 0x2a, 0x11, 0x0b, 0x69, 0x22, 0x6e, 0x98,
-	0x89, 0x97, 0x46, 0xb3, 0xad, 0xfc, 0x50, 0xf8, 0x01, 0x74, 0xd7, 0x01, 0x90, 0x43, 0xce, 0x0f,
-	0x1a, 0x1c, 0x08, 0x36, 0x3f, 0xe5, 0xdb, 0x82, 0x7b, 0xc3, 0xec, 0xae, 0xb0, 0x17, 0xd7, 0x61,
-	0xdf, 0xda, 0x88, 0xbd, 0x94, 0xc7, 0xfe, 0x10, 0xda, 0x2b, 0xe0, 0x24, 0xf0, 0x33, 0xa8, 0x9c,
-	0x66, 0xae, 0x6a, 0xe5, 0x99, 0xd9, 0xcf, 0xa0, 0x2f, 0xe4, 0x27, 0x94, 0x35, 0x2d, 0xef, 0x0b,
-	0x78, 0xf4, 0xca, 0xf5, 0x9d, 0x97, 0x9e, 0x27, 0x46, 0xd3, 0x91, 0xff, 0x53, 0x1a, 0xef, 0x7f,
-	0x35, 0xf8, 0xf9, 0x46, 0x75, 0x19, 0x31, 0x67, 0xb9, 0x59, 0xfb, 0xb7, 0x99, 0xd2, 0xfc, 0x06,
-	0x5d, 0x51, 0xba, 0xe5, 0x4c, 0x23, 0x77, 0xe9, 0x9e, 0x42, 0x2d, 0x43, 0x5e, 0x33, 0xd1, 0x3c,
-	0x5d, 0x9e, 0x68, 0xd6, 0xb4, 0x82, 0x74, 0x9a, 0xf9, 0x2b, 0x94, 0x38, 0xed, 0x4d, 0x21, 0x99,
-	0xf1, 0xb7, 0xb8, 0xe7, 0x27, 0x2a, 0xe5, 0x45, 0x1e, 0xef, 0xa4, 0x97, 0xbc, 0xd4, 0x6e, 0xfe,
-	0xa1, 0x41, 0x87, 0xe7, 0xef, 0x37, 0x88, 0xe2, 0xc8, 0x45, 0x9e, 0xfb, 0x1d, 0xbe, 0xc0, 0x94,
-	0xba, 0xfe, 0x94, 0xe8, 0x1f, 0xb1, 0xda, 0x1f, 0x4d, 0xb1, 0xac, 0x0c, 0xd2, 0x6e, 0x4d, 0xd0,
-	0xb8, 0x96, 0xfe, 0x4b, 0x78, 0x40, 0x82, 0x79, 0x64, 0x63, 0x0b, 0xdf, 0x85, 0x11, 0x26, 0xc4,
-	0x0d, 0x7c, 0x89, 0x63, 0x57, 0x30, 0x86, 0x09, 0x9d, 0x45, 0xa7, 0xcd, 0x1f, 0x7f, 0x96, 0xe3,
-	0xa8, 0x20, 0xac, 0x0a, 0xca, 0x89, 0xe3, 0x19, 0xff, 0x29, 0xc0, 0xde, 0x3a, 0x18, 0x5d, 0xa8,
-	0xdc, 0x06, 0xd1, 0xcd, 0xc4, 0x0b, 0x6e, 0xd5, 0xd1, 0xd5, 0x5a, 0xff, 0x18, 0x76, 0xa4, 0xfd,
-	0xa5, 0xa8, 0xaa, 0x9a, 0x4d, 0x41, 0x4e, 0x62, 0xf1, 0x63, 0xd8, 0x91, 0x67, 0x49, 0x04, 0x05,
-	0x80, 0xa6, 0x20, 0x9f, 0xa6, 0x2f, 0xcb, 0x1d, 0x42, 0x83, 0xd0, 0x12, 0xff, 0xc7, 0xd8, 0x41,
-	0x18, 0xab, 0x27, 0x13, 0x23, 0xbf, 0x64, 0xd4, 0xe3, 0x20, 0x8c, 0xf5, 0xaf, 0xe4, 0x13, 0xc8,
-	0x22, 0x12, 0x67, 0xa7, 0xc4, 0xc3, 0xe7, 0x71, 0xc6, 0x9d, 0x9b, 0x6e, 0x56, 0x3e, 0x88, 0x92,
-	0x13, 0xaa, 0xbc, 0x2c, 0x67, 0xf2, 0xf2, 0xa3, 0xa4, 0xf1, 0xd2, 0x38, 0xc4, 0x84, 0xff, 0x21,
-	0x51, 0x55, 0x1d, 0xf6, 0x92, 0x91, 0x8e, 0x7a, 0x7f, 0x7e, 0xba, 0x70, 0x29, 0x26, 0xa4, 0xef,
-	0x06, 0x03, 0xf1, 0x35, 0x98, 0x06, 0x83, 0x05, 0x15, 0x7f, 0x2e, 0x0e, 0x12, 0x20, 0xe3, 0x32,
-	0x27, 0x7c, 0xfa, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x08, 0xd6, 0xd7, 0x99, 0x14, 0x00,
-	0x00,
+	// 1939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0xdb, 0xc8,
+	0x19, 0x87, 0x24, 0x4b, 0x96, 0x3e, 0x3d, 0x6c, 0xd3, 0xb2, 0xa5, 0x68, 0x37, 0xa9, 0xc3, 0x34,
+	0x59, 0x21, 0x6d, 0x29, 0x6f, 0x16, 0x2d, 0x16, 0xe9, 0xb6, 0x58, 0xc7, 0x76, 0x02, 0x6f, 0x36,
+	0x6e, 0x4a, 0xbb, 0x29, 0xd0, 0x02, 0x25, 0x46, 0xe4, 0x48, 0x21, 0x42, 0x71, 0x18, 0xce, 0x48,
+	0x0e, 0x73, 0xe9, 0x65, 0x7b, 0x28, 0xd0, 0xff, 0x60, 0x2f, 0x3d, 0xf5, 0xd8, 0xe3, 0x1e, 0xfb,
+	0xb7, 0x15, 0xf3, 0x22, 0xa9, 0x87, 0x1f, 0x9b, 0xec, 0x49, 0x9c, 0xef, 0x31, 0xdf, 0xef, 0x7b,
+	0xcc, 0x37, 0xdf, 0x08, 0x36, 0x66, 0xcc, 0x65, 0x81, 0x87, 0x18, 0xb2, 0xa2, 0x98, 0x30, 0x62,
+	0xd4, 0x52, 0x42, 0xef, 0xce, 0x98, 0x90, 0x71, 0x80, 0x07, 0x82, 0x31, 0x9c, 0x8e, 0x06, 0xde,
+	0x34, 0x46, 0xcc, 0x27, 0xa1, 0x14, 0xed, 0x35, 0x03, 0x32, 0x9e, 0x32, 0x3f, 0x50, 0xcb, 0xd6,
+	0x24, 0xa1, 0x6f, 0x03, 0x97, 0xe9, 0x75, 0xfd, 0xed, 0x14, 0xc7, 0x89, 0x5a, 0x74, 0x18, 0x1a,
+	0x06, 0x98, 0x4d, 0x50, 0x88, 0xc6, 0x38, 0xce, 0xec, 0xf5, 0x5a, 0x8c, 0x44, 0x24, 0xb7, 0x6e,
+	0xce, 0xa8, 0xfb, 0x1a, 0x4f, 0xf4, 0xb2, 0x31, 0x63, 0xcc, 0x9f, 0x60, 0xb9, 0x32, 0xff, 0x0c,
+	0xbd, 0xe3, 0x77, 0xd8, 0x9d, 0x32, 0xfc, 0x8a, 0xa3, 0x3c, 0x24, 0x93, 0x09, 0x0a, 0x3d, 0x1b,
+	0xbf, 0x9d, 0x62, 0xca, 0x0c, 0x03, 0xd6, 0x50, 0x3c, 0xa6, 0xdd, 0xc2, 0x5e, 0xa9, 0x5f, 0xb3,
+	0xc5, 0xb7, 0x71, 0x1f, 0x5a, 0xc8, 0xe5, 0x98, 0x1d, 0xbe, 0x0d, 0x99, 0xb2, 0x6e, 0x71, 0xaf,
+	0xd0, 0x2f, 0xd9, 0x4d, 0x49, 0x3d, 0x97, 0x44, 0xf3, 0x10, 0x3e, 0x59, 0xb9, 0x31, 0x8d, 0x48,
+	0x48, 0xb1, 0xf1, 0x73, 0x28, 0xe3, 0x19, 0x0e, 0x59, 0xb7, 0xb0, 0x57, 0xe8, 0xd7, 0x1f, 0xb5,
+	0x2c, 0xed, 0xf9, 0x31, 0xa7, 0xda, 0x92, 0x69, 0x7e, 0x5f, 0x80, 0xce, 0xe1, 0x6b, 0x14, 0x8e,
+	0xf1, 0xb9, 0x70, 0xf6, 0x3c, 0x89, 0xb0, 0xc6, 0xf6, 0x25, 0x34, 0x64, 0x04, 0x1c, 0x14, 0xf8,
+	0x88, 0xaa, 0x8d, 0x76, 0xac, 0xd4, 0x7b, 0xa9, 0x72, 0xc0, 0x99, 0x76, 0x9d, 0x65, 0x0b, 0xe3,
+	0x57, 0xb0, 0xee, 0x0d, 0x1d, 0x96, 0x44, 0x58, 0x40, 0x6f, 0x3d, 0x6a, 0x2f, 0x2a, 0x09, 0x3b,
+	0x15, 0x6f, 0xc8, 0x7f, 0x8d, 0x0e, 0xac, 0x7b, 0x71, 0xe2, 0xc4, 0xd3, 0xb0, 0x5b, 0xda, 0x2b,
+	0xf4, 0xab, 0x76, 0xc5, 0x8b, 0x13, 0x7b, 0x1a, 0x9a, 0xff, 0x29, 0x40, 0x77, 0x19, 0x9d, 0x72,
+	0xf0, 0xd7, 0xd0, 0x1c, 0xe2, 0x11, 0x89, 0xb1, 0x23, 0x4d, 0x2b, 0x7c, 0x9b, 0x8b, 0xa6, 0xec,
+	0x86, 0x14, 0x93, 0x2b, 0xe3, 0x0b, 0x68, 0xa0, 0x11, 0xc3, 0xb1, 0xd6, 0x2a, 0x5e, 0xa2, 0x55,
+	0x17, 0x52, 0x4a, 0xe9, 0x0e, 0xd4, 0x2f, 0x10, 0x75, 0xe6, 0x51, 0xd6, 0x2e, 0x10, 0x3d, 0x92,
+	0x40, 0xf7, 0xa1, 0x77, 0x48, 0x42, 0x37, 0x98, 0x7a, 0xf8, 0x3c, 0x46, 0x21, 0x95, 0x99, 0xca,
+	0x25, 0xd9, 0x63, 0xbe, 0x27, 0x00, 0xd6, 0x6c, 0xf1, 0x6d, 0xde, 0x86, 0x4f, 0x56, 0x6a, 0x48,
+	0xe7, 0xcc, 0x1f, 0x4a, 0xb0, 0x73, 0x18, 0x63, 0xc4, 0xf0, 0x73, 0x9c, 0xd0, 0x08, 0xb9, 0x38,
+	0xb7, 0x59, 0x88, 0x26, 0x58, 0x6f, 0xc6, 0xbf, 0x8d, 0x36, 0x94, 0x47, 0x24, 0x76, 0x65, 0xb4,
+	0xab, 0xb6, 0x5c, 0x18, 0x03, 0x68, 0xa3, 0x20, 0x20, 0x17, 0x0e, 0x9e, 0x44, 0x2c, 0x71, 0x66,
+	0x8e, 0xac, 0x52, 0x85, 0x7e, 0x4b, 0xf0, 0x8e, 0x39, 0xeb, 0xd5, 0x99, 0x60, 0x18, 0xfb, 0xd0,
+	0xa6, 0xaf, 0x51, 0xec, 0xf9, 0xe1, 0xd8, 0x71, 0x49, 0x30, 0x9d, 0x84, 0x8e, 0x30, 0xb5, 0x26,
+	0x4c, 0x19, 0x9a, 0x77, 0x28, 0x58, 0xa7, 0xdc, 0xf0, 0x37, 0xcb, 0x1a, 0x22, 0xeb, 0x65, 0x91,
+	0xf5, 0x6e, 0x16, 0x54, 0xed, 0xc5, 0x89, 0x27, 0x72, 0xb8, 0xb0, 0x97, 0xa8, 0x82, 0xaf, 0xa1,
+	0x41, 0x71, 0x3c, 0xc3, 0x9e, 0x33, 0x8a, 0xc9, 0x84, 0x76, 0x2b, 0x7b, 0xa5, 0x7e, 0xfd, 0xd1,
+	0xed, 0xe5, 0x3d, 0xac, 0x33, 0x21, 0xf6, 0x34, 0x26, 0x13, 0xbb, 0x4e, 0xd3, 0x6f, 0x6a, 0x3c,
+	0x84, 0x35, 0x61, 0x7d, 0x5d, 0x58, 0xdf, 0x5d, 0xd6, 0x14, 0xb6, 0x85, 0x8c, 0x71, 0x0f, 0x9a,
+	0x43, 0x44, 0xb1, 0xf3, 0x46, 0xb1, 0xba, 0x55, 0xe1, 0x64, 0x83, 0x13, 0xb5, 0xb8, 0xf1, 0x39,
+	0x34, 0x69, 0x88, 0x22, 0xfa, 0x9a, 0x30, 0x71, 0x16, 0xbb, 0x35, 0x51, 0x2c, 0x0d, 0x4b, 0x9d,
+	0x70, 0x7e, 0x14, 0xed, 0x86, 0x16, 0xe1, 0x2b, 0xf3, 0x04, 0x76, 0x17, 0xf3, 0xa6, 0xea, 0x75,
+	0x00, 0xd5, 0xd4, 0x98, 0x2c, 0xd5, 0x6d, 0x2b, 0xeb, 0x64, 0xa9, 0x78, 0x2a, 0x64, 0xfe, 0xab,
+	0x00, 0x86, 0xdc, 0xeb, 0x8c, 0x47, 0x4b, 0x17, 0x40, 0x6f, 0x61, 0x9f, 0x5a, 0xa6, 0x62, 0xdc,
+	0x06, 0x10, 0x91, 0x95, 0x79, 0x2b, 0x0a, 0x6e, 0x4d, 0x50, 0x4e, 0xe7, 0xea, 0xa4, 0x94, 0xaf,
+	0x93, 0xfb, 0xd0, 0xf2, 0x65, 0x25, 0x3a, 0x11, 0x8a, 0x79, 0xcb, 0x58, 0x13, 0xec, 0xa6, 0xa2,
+	0xbe, 0x14, 0x44, 0xf3, 0xdf, 0x05, 0xd8, 0x9e, 0x83, 0xf3, 0x81, 0x7e, 0x19, 0x0f, 0xa0, 0x2c,
+	0x20, 0xa5, 0x47, 0x2f, 0x93, 0x96, 0x3b, 0x4b, 0x76, 0x5a, 0x8e, 0x0e, 0x0a, 0x62, 0x8c, 0xbc,
+	0xc4, 0xc1, 0xef, 0x7c, 0xca, 0xa8, 0x02, 0x2f, 0x4b, 0xe8, 0x40, 0xb2, 0x8e, 0x05, 0xc7, 0xfc,
+	0x23, 0xec, 0x1c, 0xe1, 0x00, 0x2f, 0x1f, 0x9a, 0xab, 0x62, 0xf6, 0x29, 0xd4, 0x62, 0xec, 0x4e,
+	0x63, 0xea, 0xcf, 0xf4, 0x01, 0xca, 0x08, 0x66, 0x17, 0x76, 0x17, 0xb7, 0x54, 0x47, 0xf4, 0x1f,
+	0x05, 0xd8, 0x96, 0x2c, 0x81, 0x9a, 0x6a, 0x5b, 0x7d, 0xa8, 0x08, 0x68, 0xb2, 0xa9, 0xaf, 0xf2,
+	0x4f, 0xf1, 0xaf, 0xb6, 0x6c, 0x3c, 0x80, 0x0d, 0xde, 0xa3, 0x1d, 0x7f, 0xe4, 0xf0, 0x22, 0xf7,
+	0xc3, 0xb1, 0xce, 0x0b, 0x27, 0x9f, 0x8c, 0xce, 0x24, 0xd1, 0xdc, 0x85, 0xf6, 0x3c, 0x0c, 0x85,
+	0x2f, 0xd1, 0x74, 0xd9, 0xc3, 0x52, 0x7c, 0x5f, 0x41, 0x2b, 0xdf, 0xd6, 0xb1, 0xc6, 0x79, 0x49,
+	0x63, 0x6f, 0xe6, 0x1a, 0x3b, 0xa6, 0xfc, 0xdc, 0xc8, 0xa6, 0x12, 0xc5, 0xfe, 0x04, 0xc5, 0x89,
+	0xc2, 0xdd, 0x10, 0xc4, 0x97, 0x92, 0x66, 0x76, 0x74, 0x1e, 0x52, 0xd3, 0x0a, 0xd3, 0x31, 0x6c,
+	0x3d, 0xc3, 0xec, 0x09, 0x72, 0xdf, 0x4c, 0x23, 0x7a, 0x93, 0xe4, 0xb4, 0xf3, 0xb5, 0x52, 0x53,
+	0x95, 0x61, 0x1e, 0x81, 0x91, 0xdf, 0x46, 0x15, 0xa2, 0x05, 0xeb, 0x43, 0x49, 0x52, 0x1e, 0xb5,
+	0xad, 0xf4, 0x7a, 0x97, 0xb2, 0x27, 0xe1, 0x88, 0xd8, 0x5a, 0xc8, 0xbc, 0x05, 0x9d, 0x67, 0x98,
+	0x1d, 0xe2, 0x20, 0xe0, 0x74, 0x7e, 0x40, 0x34, 0x24, 0x73, 0x1f, 0xba, 0xcb, 0x2c, 0x65, 0xa6,
+	0x0d, 0x65, 0x7e, 0xba, 0xf4, 0x9d, 0x2d, 0x17, 0x66, 0x1f, 0x8c, 0x9c, 0x46, 0xae, 0x59, 0xbb,
+	0x38, 0x08, 0x74, 0xb3, 0xe6, 0xdf, 0xe6, 0x53, 0xd8, 0x9e, 0x93, 0x4c, 0x8f, 0x51, 0x8d, 0xb3,
+	0x1d, 0x3f, 0x1c, 0x11, 0x75, 0x8e, 0x8c, 0x2c, 0x23, 0xa9, 0x78, 0xd5, 0x55, 0x5f, 0xbc, 0x32,
+	0xd5, 0x3e, 0x54, 0x25, 0x47, 0xa3, 0xff, 0xa1, 0x00, 0x9d, 0x25, 0x96, 0x32, 0x73, 0x02, 0xeb,
+	0xf3, 0x69, 0x1f, 0xe4, 0xca, 0xf3, 0x12, 0x25, 0x4b, 0xad, 0x8f, 0x43, 0x16, 0x27, 0xb6, 0xd6,
+	0xef, 0xbd, 0x84, 0x46, 0x9e, 0x61, 0x6c, 0x42, 0xe9, 0x0d, 0x4e, 0x94, 0xaf, 0xfc, 0xd3, 0x78,
+	0x08, 0xe5, 0x19, 0x0a, 0xa6, 0x58, 0x9d, 0xf4, 0xf6, 0xbc, 0x3f, 0xd2, 0x8c, 0x2d, 0x45, 0x1e,
+	0x17, 0xbf, 0x2c, 0x98, 0x3b, 0x22, 0x34, 0xfa, 0xa4, 0xa5, 0xfe, 0x9c, 0x40, 0x7b, 0x9e, 0xac,
+	0x7c, 0xf9, 0x1c, 0x6a, 0xba, 0x50, 0xb4, 0x37, 0x2b, 0x5b, 0x4f, 0x26, 0x65, 0xee, 0x8b, 0x34,
+	0xfd, 0x88, 0xf6, 0xa0, 0xd2, 0xf5, 0xf1, 0xdd, 0xfc, 0xbb, 0x22, 0x6c, 0x3e, 0xc3, 0x4c, 0x5e,
+	0xb5, 0x1f, 0x3f, 0x62, 0xed, 0x42, 0x45, 0x2c, 0x69, 0xb7, 0x28, 0xca, 0x50, 0xad, 0x78, 0x33,
+	0xc7, 0xef, 0x64, 0x33, 0x57, 0xfc, 0x92, 0xe0, 0x37, 0x15, 0xf5, 0x5c, 0x8a, 0xdd, 0x03, 0xdd,
+	0xdd, 0x9d, 0x99, 0x8f, 0x2f, 0xa8, 0x6a, 0x2d, 0x0d, 0x45, 0x7c, 0xc5, 0x69, 0x46, 0x1f, 0x36,
+	0xc5, 0x1e, 0xe2, 0x36, 0xa1, 0x0e, 0x09, 0x83, 0x44, 0xdc, 0xec, 0x55, 0x5b, 0x76, 0x10, 0x71,
+	0x2e, 0xfe, 0x10, 0x06, 0x49, 0x26, 0x49, 0xfd, 0xf7, 0x5a, 0xb2, 0x92, 0x93, 0x3c, 0xf3, 0xdf,
+	0x4b, 0x49, 0xf3, 0x25, 0x6c, 0xe5, 0xa2, 0xa0, 0x82, 0xf9, 0x5b, 0xa8, 0xa8, 0xd9, 0x44, 0x06,
+	0xe0, 0x9e, 0xb5, 0x3c, 0x7a, 0x4b, 0x95, 0x23, 0x3c, 0xf2, 0x43, 0x5f, 0x8c, 0x4a, 0x4a, 0xc5,
+	0xfc, 0x16, 0x36, 0xf8, 0x8e, 0x3f, 0xcd, 0x15, 0x69, 0x3e, 0x96, 0x59, 0x9a, 0xbb, 0xe1, 0xd2,
+	0x0b, 0xab, 0x70, 0xe5, 0x85, 0x65, 0x3e, 0x14, 0x75, 0x7a, 0x16, 0xcf, 0x5e, 0xcd, 0x67, 0x79,
+	0x55, 0x17, 0x38, 0x85, 0x9d, 0x05, 0xd9, 0x74, 0xac, 0x6d, 0xd0, 0x78, 0x96, 0x4d, 0x6b, 0x69,
+	0x71, 0xc9, 0xb5, 0x95, 0x53, 0x01, 0x9a, 0x7e, 0x9b, 0xdf, 0x0a, 0xdc, 0x6a, 0x76, 0xfd, 0xd8,
+	0xea, 0x32, 0x7f, 0x27, 0xb2, 0xa4, 0x77, 0x53, 0xc8, 0xfa, 0x50, 0xb9, 0x66, 0xd2, 0x56, 0x7c,
+	0xf3, 0xaf, 0x39, 0xf5, 0x0f, 0x6f, 0xf3, 0x9c, 0xca, 0x63, 0xa5, 0x4b, 0x58, 0x2e, 0xcc, 0xaf,
+	0xc1, 0xc8, 0x6f, 0xae, 0xc0, 0x3d, 0x84, 0x75, 0x69, 0x3c, 0xbb, 0x76, 0x17, 0xd1, 0x69, 0x01,
+	0xf3, 0x3d, 0xec, 0x3d, 0xc3, 0xec, 0x4f, 0x61, 0x8c, 0x29, 0x09, 0x66, 0xd8, 0xcb, 0x0d, 0xe0,
+	0x37, 0x42, 0xfb, 0x18, 0xea, 0x68, 0x88, 0x42, 0x8f, 0x84, 0x0e, 0x1a, 0xeb, 0xe6, 0x76, 0xcb,
+	0x92, 0x4f, 0x4f, 0x4b, 0x3f, 0x3d, 0xad, 0x23, 0xf5, 0xf4, 0xb4, 0x41, 0x49, 0x1f, 0x8c, 0xb1,
+	0xe9, 0xc2, 0xdd, 0x2b, 0x6c, 0x2b, 0x67, 0x7e, 0x0f, 0x0d, 0x96, 0xa3, 0x2b, 0x8f, 0x7a, 0x96,
+	0x7c, 0x9d, 0xe6, 0x54, 0x5e, 0x60, 0x86, 0xb8, 0x8b, 0xf6, 0x9c, 0xbc, 0x39, 0x10, 0xf1, 0x5f,
+	0xa8, 0xc2, 0xab, 0x9a, 0xdc, 0x13, 0x30, 0xf2, 0x0a, 0x0a, 0xc6, 0x2f, 0xa1, 0xba, 0x50, 0x86,
+	0x5b, 0x69, 0x19, 0xa6, 0x1d, 0x6e, 0x7d, 0xa6, 0x2a, 0xf0, 0xbb, 0x22, 0x74, 0x4e, 0x42, 0x5f,
+	0x9e, 0x1d, 0x35, 0x09, 0x7c, 0x78, 0xee, 0x6d, 0xe8, 0xa9, 0x09, 0xc3, 0xc1, 0x01, 0x76, 0x99,
+	0x33, 0x57, 0xc9, 0xa5, 0xab, 0x2a, 0xb9, 0xa3, 0x14, 0x8f, 0xb9, 0x5e, 0x8e, 0x91, 0x8d, 0xbf,
+	0x6b, 0xf9, 0xf1, 0xf7, 0x05, 0xec, 0x5c, 0x20, 0x9f, 0x39, 0x31, 0x8e, 0x02, 0xdf, 0x45, 0x34,
+	0x7d, 0x75, 0x97, 0xaf, 0xcb, 0xeb, 0x36, 0xd7, 0xb3, 0x95, 0x9a, 0x7e, 0x96, 0x3f, 0x81, 0xee,
+	0x72, 0x14, 0xd2, 0x46, 0x52, 0x11, 0xcf, 0x6e, 0x9d, 0xd1, 0xc5, 0x47, 0xb9, 0xe2, 0x9a, 0x7f,
+	0x87, 0x5b, 0x36, 0x9e, 0x90, 0x59, 0x3a, 0x74, 0xf2, 0xeb, 0xf2, 0x26, 0xb1, 0xd4, 0x9d, 0xa6,
+	0x98, 0x75, 0x9a, 0x4b, 0x86, 0xfe, 0xb9, 0xd9, 0x73, 0x6d, 0x71, 0xea, 0xfd, 0x14, 0x7a, 0xab,
+	0x00, 0xa8, 0x29, 0xee, 0xfb, 0x02, 0xec, 0x4a, 0xb6, 0xf0, 0xf2, 0xa6, 0xe0, 0xae, 0x79, 0x9c,
+	0x68, 0xec, 0xa5, 0x55, 0xd8, 0xd7, 0x2e, 0xc5, 0x5e, 0x5e, 0xc4, 0x7e, 0x0b, 0x3a, 0x4b, 0xe0,
+	0x14, 0xf0, 0x7d, 0xe8, 0xd9, 0x24, 0x08, 0xf8, 0x00, 0x78, 0xf3, 0x67, 0xfa, 0x4a, 0x0d, 0xb5,
+	0xe1, 0x29, 0x54, 0x9f, 0xe7, 0x62, 0xbf, 0xf4, 0x30, 0xb7, 0x72, 0xe1, 0x28, 0x2e, 0xce, 0x74,
+	0x2b, 0x86, 0x84, 0xaf, 0xe0, 0xce, 0x53, 0x3f, 0xf4, 0x0e, 0x82, 0x40, 0x80, 0xa7, 0x27, 0xe1,
+	0x8f, 0x19, 0x55, 0xfe, 0x57, 0x80, 0x9f, 0x5d, 0xaa, 0xae, 0x4a, 0xf0, 0x74, 0xe1, 0x75, 0xf2,
+	0x9b, 0xdc, 0x65, 0x76, 0x8d, 0xae, 0xbc, 0xec, 0xd4, 0x14, 0xa8, 0x76, 0xe9, 0x3d, 0x87, 0x7a,
+	0x8e, 0xbc, 0x62, 0x06, 0x7c, 0x30, 0x3f, 0x03, 0xae, 0xb8, 0x3c, 0xb3, 0xf9, 0xef, 0x6f, 0x50,
+	0x16, 0xb4, 0xeb, 0x6a, 0x3c, 0x57, 0x40, 0x32, 0xce, 0xf7, 0x75, 0x0f, 0x91, 0x8d, 0x61, 0x23,
+	0x0b, 0xf2, 0xdc, 0x05, 0xfd, 0xcf, 0x02, 0x74, 0x45, 0x43, 0x78, 0x81, 0x18, 0x8e, 0x7d, 0x14,
+	0xf8, 0xef, 0xf1, 0x19, 0x66, 0xcc, 0x0f, 0xc7, 0xd4, 0xb8, 0xcb, 0x6f, 0xcb, 0x78, 0x8c, 0x55,
+	0xab, 0x51, 0x76, 0xeb, 0x92, 0x26, 0xb4, 0x8c, 0x5f, 0xc0, 0x16, 0x25, 0xd3, 0xd8, 0xc5, 0x0e,
+	0x7e, 0x17, 0xc5, 0x98, 0x52, 0x9f, 0x84, 0x0a, 0xc7, 0xa6, 0x64, 0x1c, 0xa7, 0x74, 0x5e, 0xee,
+	0xae, 0x78, 0x2e, 0x3b, 0x9e, 0xa7, 0xab, 0xba, 0x26, 0x29, 0x47, 0x5e, 0x60, 0xfe, 0xb7, 0x08,
+	0xdb, 0xab, 0x60, 0xf4, 0xa0, 0x7a, 0x41, 0xe2, 0x37, 0xa3, 0x80, 0x5c, 0x68, 0xd7, 0xf5, 0xda,
+	0xf8, 0x0c, 0x36, 0x94, 0xfd, 0xb9, 0xaa, 0xaa, 0xd9, 0x2d, 0x49, 0x4e, 0x6b, 0xf1, 0x33, 0xd8,
+	0x50, 0xbe, 0xa4, 0x82, 0x12, 0x40, 0x4b, 0x92, 0x9f, 0x67, 0x6f, 0xf1, 0x0d, 0xca, 0x48, 0xe4,
+	0xc8, 0xbf, 0xc4, 0x5c, 0x12, 0x25, 0xfa, 0x91, 0xc9, 0xc9, 0x07, 0x9c, 0x7a, 0x48, 0xa2, 0xc4,
+	0xf8, 0x46, 0x3d, 0x1a, 0x1d, 0xaa, 0x70, 0x76, 0xcb, 0xa2, 0x7c, 0xee, 0xe5, 0xd2, 0x79, 0x59,
+	0x64, 0xd5, 0x13, 0x32, 0xf5, 0x50, 0x1f, 0xf4, 0x4a, 0xee, 0xa0, 0xdf, 0x4d, 0x47, 0x15, 0x96,
+	0x44, 0x98, 0x8a, 0xbf, 0x70, 0x6a, 0x7a, 0x26, 0xe1, 0x7f, 0xdb, 0xd0, 0x27, 0xfd, 0xbf, 0x3c,
+	0x98, 0xf9, 0x0c, 0x53, 0x6a, 0xf9, 0x64, 0x20, 0xbf, 0x06, 0x63, 0x32, 0x98, 0x31, 0xf9, 0x67,
+	0xef, 0x20, 0x05, 0x32, 0xac, 0x08, 0xc2, 0x17, 0xff, 0x1f, 0x00, 0x0a, 0x1a, 0xc5, 0x3a, 0x29,
+	0x16, 0x00, 0x00,
 }
@@ -29,43 +29,48 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("vtctlservice.proto", fileDescriptor_27055cdbb1148d2b) }
 
 var fileDescriptor_27055cdbb1148d2b = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x6d, 0x4f, 0x13, 0x41,
-	0x10, 0xc7, 0xf5, 0x85, 0x44, 0x57, 0x04, 0xb2, 0xbe, 0x30, 0x29, 0xb4, 0xd2, 0x2a, 0x26, 0x68,
-	0xd2, 0x1a, 0xfc, 0x04, 0x50, 0xb1, 0x36, 0x24, 0x44, 0xa1, 0xc1, 0x84, 0x84, 0x17, 0xcb, 0xdd,
-	0x48, 0x2f, 0xde, 0x13, 0xb7, 0xdb, 0x8b, 0xfd, 0xce, 0x7e, 0x08, 0xd3, 0xdd, 0xee, 0x76, 0x6e,
-	0x1f, 0x0a, 0xef, 0xda, 0xf9, 0xfd, 0xe7, 0x3f, 0xb3, 0x77, 0x33, 0x9b, 0x23, 0xb4, 0x16, 0x91,
-	0x48, 0x39, 0x54, 0x75, 0x12, 0x41, 0xbf, 0xac, 0x0a, 0x51, 0xd0, 0x4d, 0x1c, 0x6b, 0x6d, 0xcb,
-	0x7f, 0x31, 0x13, 0x4c, 0xe1, 0xa3, 0x7b, 0xf2, 0xec, 0x6a, 0x11, 0xa2, 0x53, 0xf2, 0xfa, 0xf4,
-	0x2f, 0x44, 0x33, 0x01, 0xf2, 0xff, 0xb0, 0xc8, 0x32, 0x96, 0xc7, 0xf4, 0xa0, 0xbf, 0xca, 0xf0,
-	0xf0, 0x0b, 0xb8, 0x9f, 0x01, 0x17, 0xad, 0x0f, 0x0f, 0xc9, 0x78, 0x59, 0xe4, 0x1c, 0x7a, 0x4f,
-	0x3e, 0x3f, 0x3d, 0xfa, 0xb7, 0x45, 0x36, 0x24, 0x8c, 0xe9, 0x0d, 0xd9, 0x19, 0x4e, 0x59, 0x7e,
-	0x07, 0x13, 0x76, 0x9b, 0x82, 0x98, 0xcc, 0x4b, 0xa0, 0x3d, 0x64, 0x65, 0x43, 0x5d, 0xee, 0xdd,
-	0x5a, 0x8d, 0xae, 0x45, 0x7f, 0x91, 0xad, 0x61, 0x05, 0x4c, 0xc0, 0x19, 0xcc, 0x79, 0xc9, 0x22,
-	0xa0, 0xfb, 0x38, 0xb1, 0x81, 0xb4, 0x75, 0x77, 0x8d, 0xc2, 0x18, 0x9f, 0x93, 0x97, 0x8a, 0x5d,
-	0x4e, 0x59, 0x15, 0xd3, 0xb6, 0x93, 0x23, 0xe3, 0xda, 0xb2, 0x13, 0xc2, 0xb8, 0xd1, 0xaf, 0x90,
-	0x42, 0xa0, 0xd1, 0x26, 0xf2, 0x35, 0x6a, 0x2b, 0x8c, 0xf1, 0x4f, 0xb2, 0xa9, 0x98, 0xac, 0xc8,
-	0x69, 0xc7, 0x49, 0x52, 0x40, 0x9b, 0xbe, 0x0d, 0x72, 0x63, 0x39, 0x21, 0xaf, 0x14, 0x51, 0x8f,
-	0x9c, 0x53, 0x37, 0x67, 0x49, 0xb4, 0xe9, 0x7e, 0x58, 0x60, 0x5c, 0x2b, 0xf2, 0xe6, 0x5b, 0x92,
-	0xc7, 0xc7, 0x69, 0xaa, 0x0a, 0x8e, 0x73, 0xf3, 0x28, 0x0e, 0x51, 0x7a, 0x40, 0xa3, 0x2b, 0x7d,
-	0x7c, 0x8c, 0xd4, 0xd4, 0x3c, 0x23, 0x64, 0x04, 0xe2, 0x84, 0x45, 0x7f, 0x66, 0x25, 0xa7, 0x7b,
-	0x28, 0x77, 0x15, 0xd6, 0xce, 0xed, 0x00, 0x35, 0x66, 0x37, 0x64, 0x67, 0x04, 0x62, 0x08, 0x69,
-	0x3a, 0xce, 0x7f, 0x17, 0xe7, 0x2c, 0x03, 0xde, 0x18, 0x65, 0x1b, 0xfa, 0x46, 0xd9, 0xd5, 0xe0,
-	0x89, 0x43, 0x94, 0xb6, 0xfd, 0x59, 0xbe, 0x89, 0x6b, 0x60, 0xe3, 0x77, 0x4d, 0xb6, 0x97, 0x80,
-	0x1f, 0xa7, 0x09, 0xe3, 0xc0, 0x69, 0xd7, 0x4d, 0xd2, 0x4c, 0xfb, 0xf6, 0xd6, 0x49, 0xac, 0x5e,
-	0xcd, 0xfb, 0xb3, 0x7a, 0xb5, 0xdf, 0x59, 0x27, 0x84, 0xf1, 0x10, 0x23, 0xd0, 0x1c, 0x62, 0x0c,
-	0x7c, 0x43, 0xdc, 0xe4, 0xc6, 0xf2, 0x3b, 0x79, 0x31, 0x02, 0x71, 0x19, 0x4d, 0x21, 0x63, 0x74,
-	0xb7, 0xa9, 0x57, 0x51, 0x6d, 0xb6, 0xe7, 0x87, 0xc6, 0xe9, 0x94, 0x3c, 0x5f, 0x84, 0xe5, 0x3d,
-	0xd0, 0xb2, 0xb4, 0xf8, 0x12, 0xd8, 0xf5, 0x32, 0xbc, 0x55, 0x8b, 0x68, 0x55, 0x5f, 0x2d, 0x9b,
-	0xb2, 0x0e, 0xb1, 0x22, 0xbe, 0xad, 0xb2, 0x04, 0xd6, 0x31, 0xd5, 0xb6, 0xd9, 0xc7, 0x54, 0xd1,
-	0xc0, 0x31, 0x35, 0xb4, 0x76, 0x45, 0xaf, 0xbc, 0x57, 0x1d, 0xda, 0x15, 0x77, 0xd9, 0x95, 0x99,
-	0x3e, 0xa9, 0x65, 0x66, 0x1d, 0xb3, 0x1d, 0xa0, 0x78, 0xf1, 0xc6, 0x79, 0xa2, 0x1e, 0xe8, 0x8f,
-	0x2a, 0xc9, 0x58, 0x35, 0x6f, 0x2c, 0x9e, 0x0d, 0x7d, 0x8b, 0xe7, 0x6a, 0x8c, 0x7d, 0x44, 0xe8,
-	0x05, 0x64, 0x45, 0x6d, 0x6e, 0xd7, 0xc5, 0xd0, 0xd3, 0xf7, 0x28, 0xd9, 0xc5, 0xba, 0xc4, 0xc1,
-	0x03, 0x2a, 0xbc, 0x8d, 0x8a, 0xcb, 0x26, 0x64, 0x85, 0xae, 0x93, 0x6b, 0x98, 0x6f, 0x1b, 0x1d,
-	0x89, 0xf6, 0x3e, 0xf9, 0x74, 0x7d, 0x58, 0x27, 0x02, 0x38, 0xef, 0x27, 0xc5, 0x40, 0xfd, 0x1a,
-	0xdc, 0x15, 0x83, 0x5a, 0x0c, 0xe4, 0x17, 0xc0, 0x00, 0x7f, 0x1f, 0xdc, 0x6e, 0xc8, 0xd8, 0x97,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x3d, 0x15, 0xc0, 0x4a, 0x08, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x61, 0x4f, 0x13, 0x31,
+	0x18, 0xc7, 0xf5, 0x85, 0x44, 0x2b, 0x0a, 0x96, 0x17, 0xc6, 0xc1, 0x26, 0x9b, 0x62, 0x82, 0x98,
+	0xcd, 0xe0, 0x27, 0x80, 0x89, 0x73, 0x21, 0x21, 0x3a, 0x26, 0x26, 0x24, 0xbc, 0xe8, 0xee, 0x1e,
+	0xd8, 0x85, 0x5e, 0x3b, 0xae, 0xdd, 0x85, 0x7d, 0x31, 0x3f, 0x9f, 0xd9, 0x75, 0xed, 0x7a, 0xbd,
+	0x76, 0xf0, 0x6e, 0x7b, 0x7e, 0xff, 0xe7, 0xff, 0xf4, 0xb9, 0xf6, 0xe9, 0x1d, 0xc2, 0xb9, 0x8c,
+	0x24, 0x15, 0x90, 0xe5, 0x49, 0x04, 0xed, 0x49, 0xc6, 0x25, 0xc7, 0xeb, 0x76, 0xac, 0xb6, 0x51,
+	0xfc, 0x8b, 0x89, 0x24, 0x0a, 0x1f, 0xde, 0xa1, 0x67, 0x17, 0xf3, 0x10, 0x1e, 0xa3, 0xad, 0x93,
+	0x7b, 0x88, 0xa6, 0x12, 0x8a, 0xff, 0x5d, 0x9e, 0xa6, 0x84, 0xc5, 0x78, 0xaf, 0xbd, 0xcc, 0xf0,
+	0xf0, 0x01, 0xdc, 0x4d, 0x41, 0xc8, 0xda, 0xa7, 0x87, 0x64, 0x62, 0xc2, 0x99, 0x80, 0xd6, 0x93,
+	0xaf, 0x4f, 0x0f, 0xff, 0xbd, 0x41, 0x6b, 0x05, 0x8c, 0xf1, 0x15, 0xda, 0xec, 0x8e, 0x09, 0xbb,
+	0x81, 0x21, 0x19, 0x51, 0x90, 0xc3, 0xd9, 0x04, 0x70, 0xcb, 0xb2, 0x72, 0xa1, 0x2e, 0xf7, 0x61,
+	0xa5, 0x46, 0xd7, 0xc2, 0xd7, 0x68, 0xab, 0xcb, 0x59, 0x44, 0xa7, 0x31, 0x0c, 0x33, 0xc2, 0x04,
+	0x89, 0x64, 0xc2, 0x59, 0xa9, 0x27, 0x0f, 0xf7, 0xf5, 0xe4, 0x95, 0x99, 0x3a, 0x7f, 0xd1, 0xeb,
+	0x6e, 0x06, 0x44, 0xc2, 0x29, 0xcc, 0xc4, 0x84, 0x44, 0x80, 0x77, 0xed, 0xdc, 0x12, 0xd2, 0xee,
+	0xcd, 0x15, 0x0a, 0x63, 0x7c, 0x86, 0x5e, 0x2a, 0x76, 0x3e, 0x26, 0x59, 0x8c, 0xeb, 0x95, 0x9c,
+	0x22, 0xae, 0x2d, 0x1b, 0x21, 0x6c, 0x2f, 0xf4, 0x3b, 0x50, 0x08, 0x2c, 0xb4, 0x8c, 0x7c, 0x0b,
+	0x75, 0x15, 0xc6, 0xf8, 0x37, 0x5a, 0x57, 0xac, 0xa8, 0x28, 0x70, 0xa3, 0x92, 0xa4, 0x80, 0x36,
+	0x7d, 0x1f, 0xe4, 0xc6, 0x72, 0x88, 0x5e, 0x29, 0xa2, 0xb6, 0x56, 0xe0, 0x6a, 0xce, 0x82, 0x68,
+	0xd3, 0xdd, 0xb0, 0xc0, 0xb8, 0x66, 0xe8, 0xed, 0x8f, 0x84, 0xc5, 0x47, 0x94, 0xaa, 0x82, 0x7d,
+	0x66, 0x1e, 0xc5, 0xbe, 0x95, 0x1e, 0xd0, 0xe8, 0x4a, 0x9f, 0x1f, 0x23, 0x35, 0x35, 0x4f, 0x11,
+	0xea, 0x81, 0x3c, 0x26, 0xd1, 0xed, 0x74, 0x22, 0xf0, 0x8e, 0x95, 0xbb, 0x0c, 0x6b, 0xe7, 0x7a,
+	0x80, 0x1a, 0xb3, 0x2b, 0xb4, 0xd9, 0x03, 0xd9, 0x05, 0x4a, 0xfb, 0xec, 0x9a, 0x9f, 0x91, 0x14,
+	0x44, 0x69, 0x64, 0x5c, 0xe8, 0x1b, 0x99, 0xaa, 0xc6, 0x3e, 0x71, 0x16, 0xc5, 0x75, 0x7f, 0x96,
+	0xef, 0xc4, 0x95, 0xb0, 0xf1, 0xbb, 0x44, 0x1b, 0x0b, 0x20, 0x8e, 0x68, 0x42, 0x04, 0x08, 0xdc,
+	0xac, 0x26, 0x69, 0xa6, 0x7d, 0x5b, 0xab, 0x24, 0xce, 0x5a, 0xcd, 0xfe, 0x39, 0x6b, 0x75, 0xf7,
+	0xac, 0x11, 0xc2, 0xf6, 0x21, 0xb6, 0x40, 0xf9, 0x10, 0xdb, 0xc0, 0x77, 0x88, 0xcb, 0xdc, 0x58,
+	0xfe, 0x44, 0x2f, 0x7a, 0x20, 0xcf, 0xa3, 0x31, 0xa4, 0x04, 0x6f, 0x97, 0xf5, 0x2a, 0xaa, 0xcd,
+	0x76, 0xfc, 0xd0, 0x38, 0x9d, 0xa0, 0xe7, 0xf3, 0x70, 0x71, 0x0f, 0xd4, 0x1c, 0xad, 0x7d, 0x09,
+	0x6c, 0x7b, 0x99, 0x3d, 0x55, 0xf3, 0x68, 0x96, 0x5f, 0x2c, 0x16, 0xe5, 0x34, 0xb1, 0x24, 0xbe,
+	0xa9, 0x72, 0x04, 0x4e, 0x9b, 0x6a, 0xda, 0xdc, 0x36, 0x55, 0x34, 0xd0, 0xa6, 0x86, 0xce, 0xac,
+	0xe8, 0x91, 0xf7, 0xaa, 0x43, 0xb3, 0x52, 0x1d, 0xf6, 0x7b, 0xf4, 0xae, 0x07, 0xf2, 0x0f, 0xcb,
+	0x40, 0x70, 0x9a, 0x43, 0x6c, 0xdd, 0xde, 0x02, 0x1f, 0x94, 0xb3, 0xfd, 0x2a, 0x5d, 0xea, 0xcb,
+	0xe3, 0xc4, 0x4e, 0x1b, 0xfa, 0x19, 0x3b, 0x6d, 0x38, 0x0f, 0xb8, 0x1e, 0xa0, 0xf6, 0xc8, 0xf7,
+	0x59, 0xa2, 0xb6, 0xf2, 0x57, 0x96, 0xa4, 0x24, 0x9b, 0x95, 0x46, 0xde, 0x85, 0xbe, 0x91, 0xaf,
+	0x6a, 0x8c, 0x7d, 0x84, 0xf0, 0x00, 0x52, 0x9e, 0x9b, 0x7b, 0x7d, 0x3e, 0x6e, 0xf8, 0xa3, 0x95,
+	0x5c, 0xc5, 0xba, 0xc4, 0xde, 0x03, 0x2a, 0xfb, 0x1e, 0x50, 0xbc, 0x58, 0x44, 0x51, 0xa1, 0x59,
+	0xc9, 0x35, 0xcc, 0x77, 0x0f, 0x54, 0x24, 0xf6, 0x6b, 0x7e, 0xc0, 0x29, 0x1d, 0x91, 0xe8, 0x36,
+	0xf4, 0x9a, 0xf7, 0x70, 0xdf, 0x6b, 0xde, 0x2b, 0xd3, 0x75, 0x8e, 0x0f, 0x2e, 0xf7, 0xf3, 0x44,
+	0x82, 0x10, 0xed, 0x84, 0x77, 0xd4, 0xaf, 0xce, 0x0d, 0xef, 0xe4, 0xb2, 0x53, 0x7c, 0x4b, 0x75,
+	0xec, 0x2f, 0xad, 0xd1, 0x5a, 0x11, 0xfb, 0xf6, 0x7f, 0x00, 0xc4, 0x6f, 0x24, 0x65, 0x94, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -185,6 +190,11 @@ type VtctldClient interface {
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error)
+	// ConcludeTransaction completes a distributed transaction that has a
+	// decision: it commits or rolls back the transaction on its participants,
+	// and deletes its metadata. A transaction that is still being prepared has
+	// no decision, see RollbackTransaction.
+	ConcludeTransaction(ctx context.Context, in *vtctldata.ConcludeTransactionRequest, opts ...grpc.CallOption) (*vtctldata.ConcludeTransactionResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
@@ -231,6 +241,10 @@ type VtctldClient interface {
 	GetTablet(ctx context.Context, in *vtctldata.GetTabletRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletResponse, error)
 	// GetTablets returns tablets, optionally filtered by keyspace and shard.
 	GetTablets(ctx context.Context, in *vtctldata.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletsResponse, error)
+	// GetUnresolvedTransactions returns the distributed transactions of a
+	// keyspace whose metadata is on its shards, which are the transactions
+	// that are not concluded yet.
+	GetUnresolvedTransactions(ctx context.Context, in *vtctldata.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*vtctldata.GetUnresolvedTransactionsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
@@ -247,6 +261,11 @@ type VtctldClient interface {
 	// RemoveShardCell removes the specified cell from the specified shard's Cells
 	// list.
 	RemoveShardCell(ctx context.Context, in *vtctldata.RemoveShardCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveShardCellResponse, error)
+	// RollbackTransaction rolls back a distributed transaction that was not
+	// committed, on all its participants, and deletes its metadata. It fails
+	// for a transaction that has the decision to commit, see
+	// ConcludeTransaction.
+	RollbackTransaction(ctx context.Context, in *vtctldata.RollbackTransactionRequest, opts ...grpc.CallOption) (*vtctldata.RollbackTransactionResponse, error)
 }
 
 type vtctldClient struct {
@@ -266,6 +285,15 @@ func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.Chang
 	return out, nil
 }
 
+func (c *vtctldClient) ConcludeTransaction(ctx context.Context, in *vtctldata.ConcludeTransactionRequest, opts ...grpc.CallOption) (*vtctldata.ConcludeTransactionResponse, error) {
+	out := new(vtctldata.ConcludeTransactionResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ConcludeTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) CreateKeyspace(ctx context.Context, in *vtctldata.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.CreateKeyspaceResponse, error) {
 	out := new(vtctldata.CreateKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateKeyspace", in, out, opts...)
@@ -419,6 +447,15 @@ func (c *vtctldClient) GetTablets(ctx context.Context, in *vtctldata.GetTabletsR
 	return out, nil
 }
 
+func (c *vtctldClient) GetUnresolvedTransactions(ctx context.Context, in *vtctldata.GetUnresolvedTransactionsRequest, opts ...grpc.CallOption) (*vtctldata.GetUnresolvedTransactionsResponse, error) {
+	out := new(vtctldata.GetUnresolvedTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetUnresolvedTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error) {
 	out := new(vtctldata.GetVSchemaResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetVSchema", in, out, opts...)
@@ -455,6 +492,15 @@ func (c *vtctldClient) RemoveShardCell(ctx context.Context, in *vtctldata.Remove
 	return out, nil
 }
 
+func (c *vtctldClient) RollbackTransaction(ctx context.Context, in *vtctldata.RollbackTransactionRequest, opts ...grpc.CallOption) (*vtctldata.RollbackTransactionResponse, error) {
+	out := new(vtctldata.RollbackTransactionResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RollbackTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VtctldServer is the server API for Vtctld service.
 type VtctldServer interface {
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
@@ -463,6 +509,11 @@ type VtctldServer interface {
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(context.Context, *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error)
+	// ConcludeTransaction completes a distributed transaction that has a
+	// decision: it commits or rolls back the transaction on its participants,
+	// and deletes its metadata. A transaction that is still being prepared has
+	// no decision, see RollbackTransaction.
+	ConcludeTransaction(context.Context, *vtctldata.ConcludeTransactionRequest) (*vtctldata.ConcludeTransactionResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
@@ -509,6 +560,10 @@ type VtctldServer interface {
 	GetTablet(context.Context, *vtctldata.GetTabletRequest) (*vtctldata.GetTabletResponse, error)
 	// GetTablets returns tablets, optionally filtered by keyspace and shard.
 	GetTablets(context.Context, *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error)
+	// GetUnresolvedTransactions returns the distributed transactions of a
+	// keyspace whose metadata is on its shards, which are the transactions
+	// that are not concluded yet.
+	GetUnresolvedTransactions(context.Context, *vtctldata.GetUnresolvedTransactionsRequest) (*vtctldata.GetUnresolvedTransactionsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(context.Context, *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
@@ -525,6 +580,11 @@ type VtctldServer interface {
 	// RemoveShardCell removes the specified cell from the specified shard's Cells
 	// list.
 	RemoveShardCell(context.Context, *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error)
+	// RollbackTransaction rolls back a distributed transaction that was not
+	// committed, on all its participants, and deletes its metadata. It fails
+	// for a transaction that has the decision to commit, see
+	// ConcludeTransaction.
+	RollbackTransaction(context.Context, *vtctldata.RollbackTransactionRequest) (*vtctldata.RollbackTransactionResponse, error)
 }
 
 // UnimplementedVtctldServer can be embedded to have forward compatible implementations.
@@ -534,6 +594,9 @@ type UnimplementedVtctldServer struct {
 func (*UnimplementedVtctldServer) ChangeTabletType(ctx context.Context, req *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeTabletType not implemented")
 }
+func (*UnimplementedVtctldServer) ConcludeTransaction(ctx context.Context, req *vtctldata.ConcludeTransactionRequest) (*vtctldata.ConcludeTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConcludeTransaction not implemented")
+}
 func (*UnimplementedVtctldServer) CreateKeyspace(ctx context.Context, req *vtctldata.CreateKeyspaceRequest) (*vtctldata.CreateKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
 }
@@ -585,6 +648,9 @@ func (*UnimplementedVtctldServer) GetTablet(ctx context.Context, req *vtctldata.
 func (*UnimplementedVtctldServer) GetTablets(ctx context.Context, req *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTablets not implemented")
 }
+func (*UnimplementedVtctldServer) GetUnresolvedTransactions(ctx context.Context, req *vtctldata.GetUnresolvedTransactionsRequest) (*vtctldata.GetUnresolvedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnresolvedTransactions not implemented")
+}
 func (*UnimplementedVtctldServer) GetVSchema(ctx context.Context, req *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVSchema not implemented")
 }
@@ -597,6 +663,9 @@ func (*UnimplementedVtctldServer) RemoveKeyspaceCell(ctx context.Context, req *v
 func (*UnimplementedVtctldServer) RemoveShardCell(ctx context.Context, req *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveShardCell not implemented")
 }
+func (*UnimplementedVtctldServer) RollbackTransaction(ctx context.Context, req *vtctldata.RollbackTransactionRequest) (*vtctldata.RollbackTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTransaction not implemented")
+}
 
 func RegisterVtctldServer(s *grpc.Server, srv VtctldServer) {
 	s.RegisterService(&_Vtctld_serviceDesc, srv)
@@ -620,6 +689,24 @@ func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_ConcludeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ConcludeTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ConcludeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ConcludeTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ConcludeTransaction(ctx, req.(*vtctldata.ConcludeTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.CreateKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -926,6 +1013,24 @@ func _Vtctld_GetTablets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetUnresolvedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetUnresolvedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetUnresolvedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetUnresolvedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetUnresolvedTransactions(ctx, req.(*vtctldata.GetUnresolvedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetVSchemaRequest)
 	if err := dec(in); err != nil {
@@ -998,6 +1103,24 @@ func _Vtctld_RemoveShardCell_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_RollbackTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RollbackTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RollbackTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RollbackTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RollbackTransaction(ctx, req.(*vtctldata.RollbackTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Vtctld_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "vtctlservice.Vtctld",
 	HandlerType: (*VtctldServer)(nil),
@@ -1006,6 +1129,10 @@ var _Vtctld_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeTabletType",
 			Handler:    _Vtctld_ChangeTabletType_Handler,
 		},
+		{
+			MethodName: "ConcludeTransaction",
+			Handler:    _Vtctld_ConcludeTransaction_Handler,
+		},
 		{
 			MethodName: "CreateKeyspace",
 			Handler:    _Vtctld_CreateKeyspace_Handler,
@@ -1074,6 +1201,10 @@ var _Vtctld_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTablets",
 			Handler:    _Vtctld_GetTablets_Handler,
 		},
+		{
+			MethodName: "GetUnresolvedTransactions",
+			Handler:    _Vtctld_GetUnresolvedTransactions_Handler,
+		},
 		{
 			MethodName: "GetVSchema",
 			Handler:    _Vtctld_GetVSchema_Handler,
@@ -1090,6 +1221,10 @@ var _Vtctld_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveShardCell",
 			Handler:    _Vtctld_RemoveShardCell_Handler,
 		},
+		{
+			MethodName: "RollbackTransaction",
+			Handler:    _Vtctld_RollbackTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vtctlservice.proto",
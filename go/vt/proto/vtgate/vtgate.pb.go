@@ -158,7 +158,10 @@ type Session struct {
 	// Session UUID
 	SessionUUID string `protobuf:"bytes,22,opt,name=SessionUUID,proto3" json:"SessionUUID,omitempty"`
 	// enable_system_settings defines if we can use reserved connections.
-	EnableSystemSettings bool     `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	EnableSystemSettings bool `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	// query_timeout is the max_execution_time of the session, in milliseconds.
+	// The selects that run for longer are interrupted. 0 means no timeout.
+	QueryTimeout         int64    `protobuf:"varint,24,opt,name=query_timeout,json=queryTimeout,proto3" json:"query_timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -343,6 +346,13 @@ func (m *Session) GetEnableSystemSettings() bool {
 	return false
 }
 
+func (m *Session) GetQueryTimeout() int64 {
+	if m != nil {
+		return m.QueryTimeout
+	}
+	return 0
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -1110,92 +1120,93 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1382 bytes of a gzipped FileDescriptorProto
+	// 1396 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1b, 0xb7,
-	0x12, 0xce, 0xea, 0x5f, 0xa3, 0xbf, 0x35, 0x2d, 0x3b, 0x1b, 0x9f, 0x9c, 0x73, 0x04, 0x25, 0x41,
-	0x94, 0x9c, 0x03, 0xbb, 0x75, 0xff, 0x82, 0xa2, 0x45, 0x6b, 0xcb, 0x4a, 0xaa, 0xc0, 0x8e, 0x5c,
-	0x4a, 0xb6, 0x81, 0xa2, 0xc5, 0x62, 0xad, 0xa5, 0x65, 0xc2, 0xf2, 0x52, 0x21, 0x29, 0xb9, 0x7a,
-	0x8a, 0xde, 0xf7, 0x05, 0x7a, 0xd3, 0xfb, 0xbe, 0x43, 0xef, 0xf2, 0x46, 0x05, 0xc9, 0x5d, 0x69,
-	0xa5, 0xb8, 0x8d, 0x93, 0x20, 0x37, 0xc2, 0x72, 0xbe, 0xe1, 0x70, 0x38, 0xdf, 0xfc, 0x50, 0x50,
-	0x9c, 0xc8, 0x81, 0x27, 0xc9, 0xe6, 0x88, 0x33, 0xc9, 0x50, 0xc6, 0xac, 0x36, 0xec, 0x53, 0x1a,
-	0x0c, 0xd9, 0xc0, 0xf7, 0xa4, 0x67, 0x90, 0x8d, 0xc2, 0xcb, 0x31, 0xe1, 0xd3, 0x70, 0x51, 0x96,
-	0x6c, 0xc4, 0xe2, 0xe0, 0x44, 0xf2, 0x51, 0xdf, 0x2c, 0xea, 0xaf, 0x0a, 0x90, 0xed, 0x12, 0x21,
-	0x28, 0x0b, 0xd0, 0x03, 0x28, 0xd3, 0xc0, 0x95, 0xdc, 0x0b, 0x84, 0xd7, 0x97, 0x94, 0x05, 0x8e,
-	0x55, 0xb3, 0x1a, 0x39, 0x5c, 0xa2, 0x41, 0x6f, 0x2e, 0x44, 0x4d, 0x28, 0x8b, 0x73, 0x8f, 0xfb,
-	0xae, 0x30, 0xfb, 0x84, 0x93, 0xa8, 0x25, 0x1b, 0x85, 0xed, 0xbb, 0x9b, 0xa1, 0x77, 0xa1, 0xbd,
-	0xcd, 0xae, 0xd2, 0x0a, 0x17, 0xb8, 0x24, 0x62, 0x2b, 0x81, 0xfe, 0x03, 0xe0, 0x8d, 0x25, 0xeb,
-	0xb3, 0xcb, 0x4b, 0x2a, 0x9d, 0x94, 0x3e, 0x27, 0x26, 0x41, 0xf7, 0xa0, 0x24, 0x3d, 0x3e, 0x20,
-	0xd2, 0x15, 0x92, 0xd3, 0x60, 0xe0, 0xa4, 0x6b, 0x56, 0x23, 0x8f, 0x8b, 0x46, 0xd8, 0xd5, 0x32,
-	0xb4, 0x05, 0x59, 0x36, 0x92, 0xda, 0x85, 0x4c, 0xcd, 0x6a, 0x14, 0xb6, 0xd7, 0x36, 0xcd, 0xc5,
-	0x5b, 0x3f, 0x93, 0xfe, 0x58, 0x92, 0x8e, 0x01, 0x71, 0xa4, 0x85, 0x76, 0xc1, 0x8e, 0x5d, 0xcf,
-	0xbd, 0x64, 0x3e, 0x71, 0xb2, 0x35, 0xab, 0x51, 0xde, 0xbe, 0x1d, 0x39, 0x1f, 0xbb, 0xe9, 0x01,
-	0xf3, 0x09, 0xae, 0xc8, 0x45, 0x01, 0xda, 0x82, 0xdc, 0x95, 0xc7, 0x03, 0x1a, 0x0c, 0x84, 0x93,
-	0xd3, 0x17, 0x5f, 0x0d, 0x4f, 0xfd, 0x5e, 0xfd, 0x9e, 0x18, 0x0c, 0xcf, 0x94, 0xd0, 0x37, 0x50,
-	0x1c, 0x71, 0x32, 0x8f, 0x56, 0xfe, 0x06, 0xd1, 0x2a, 0x8c, 0x38, 0x99, 0xc5, 0x6a, 0x07, 0x4a,
-	0x23, 0x26, 0xe4, 0xdc, 0x02, 0xdc, 0xc0, 0x42, 0x51, 0x6d, 0x99, 0x99, 0xb8, 0x0f, 0xe5, 0xa1,
-	0x27, 0xa4, 0x4b, 0x03, 0x41, 0xb8, 0x74, 0xa9, 0xef, 0x14, 0x6a, 0x56, 0x23, 0x85, 0x8b, 0x4a,
-	0xda, 0xd6, 0xc2, 0xb6, 0x8f, 0xfe, 0x0d, 0x70, 0xc6, 0xc6, 0x81, 0xef, 0x72, 0x76, 0x25, 0x9c,
-	0xa2, 0xd6, 0xc8, 0x6b, 0x09, 0x66, 0x57, 0x02, 0xb9, 0xb0, 0x3e, 0x16, 0x84, 0xbb, 0x3e, 0x39,
-	0xa3, 0x01, 0xf1, 0xdd, 0x89, 0xc7, 0xa9, 0x77, 0x3a, 0x24, 0xc2, 0x29, 0x69, 0x87, 0x1e, 0x2d,
-	0x3b, 0x74, 0x24, 0x08, 0xdf, 0x33, 0xca, 0xc7, 0x91, 0x6e, 0x2b, 0x90, 0x7c, 0x8a, 0xab, 0xe3,
-	0x6b, 0x20, 0xd4, 0x01, 0x5b, 0x4c, 0x85, 0x24, 0x97, 0x31, 0xd3, 0x65, 0x6d, 0xfa, 0xfe, 0x6b,
-	0x77, 0xd5, 0x7a, 0x4b, 0x56, 0x2b, 0x62, 0x51, 0x8a, 0xfe, 0x05, 0x79, 0xce, 0xae, 0xdc, 0x3e,
-	0x1b, 0x07, 0xd2, 0xa9, 0xd4, 0xac, 0x46, 0x12, 0xe7, 0x38, 0xbb, 0x6a, 0xaa, 0xb5, 0x4a, 0x41,
-	0xe1, 0x4d, 0xc8, 0x88, 0xd1, 0x40, 0x0a, 0xc7, 0xae, 0x25, 0x1b, 0x79, 0x1c, 0x93, 0xa0, 0x06,
-	0xd8, 0x34, 0x70, 0x39, 0x11, 0x84, 0x4f, 0x88, 0xef, 0xf6, 0x59, 0x10, 0x38, 0x2b, 0x3a, 0x51,
-	0xcb, 0x34, 0xc0, 0xa1, 0xb8, 0xc9, 0x82, 0x40, 0x31, 0x3c, 0x64, 0xfd, 0x8b, 0x88, 0x20, 0x07,
-	0xe9, 0x64, 0x7c, 0x03, 0xc3, 0x6a, 0x47, 0x54, 0x79, 0x9b, 0xb0, 0xaa, 0xe9, 0xd1, 0x56, 0xce,
-	0x89, 0xc7, 0xe5, 0x29, 0xf1, 0xa4, 0xb3, 0xaa, 0x3d, 0x5e, 0x51, 0xd0, 0x3e, 0xeb, 0x5f, 0x7c,
-	0x17, 0x01, 0xe8, 0x5b, 0xb0, 0x39, 0xf1, 0x7c, 0xd7, 0x3b, 0x93, 0x84, 0xbb, 0x57, 0x9c, 0x4a,
-	0xe2, 0x54, 0xf5, 0xa1, 0xeb, 0xd1, 0xa1, 0x98, 0x78, 0xfe, 0x8e, 0x82, 0x4f, 0x14, 0x8a, 0xcb,
-	0x7c, 0x61, 0x8d, 0x6a, 0x50, 0xd8, 0xdb, 0xdb, 0xef, 0x4a, 0xee, 0x49, 0x32, 0x98, 0x3a, 0x6b,
-	0xba, 0xba, 0xe2, 0x22, 0xa5, 0x11, 0xba, 0x77, 0x74, 0xd4, 0xde, 0x73, 0xd6, 0x8d, 0x46, 0x4c,
-	0x84, 0x3e, 0x85, 0x75, 0x12, 0xa8, 0x40, 0xbb, 0x21, 0x6b, 0x82, 0x48, 0xa9, 0xeb, 0xe2, 0xb6,
-	0x0e, 0x53, 0xd5, 0xa0, 0x86, 0xaa, 0x6e, 0x88, 0x6d, 0xfc, 0x61, 0x41, 0x31, 0x1e, 0x09, 0xf4,
-	0x00, 0x32, 0xa6, 0xaa, 0x75, 0xbb, 0x29, 0x6c, 0x97, 0xc2, 0x72, 0xea, 0x69, 0x21, 0x0e, 0x41,
-	0xd5, 0x9d, 0xe2, 0xb5, 0x4b, 0x7d, 0x27, 0xa1, 0xc3, 0x53, 0x8a, 0x49, 0xdb, 0x3e, 0x7a, 0x02,
-	0x45, 0xa9, 0x4e, 0x95, 0xae, 0x37, 0xa4, 0x9e, 0x70, 0x92, 0x61, 0x63, 0x98, 0x35, 0xc1, 0x9e,
-	0x46, 0x77, 0x14, 0x88, 0x0b, 0x72, 0xbe, 0x40, 0xff, 0x85, 0xc2, 0x8c, 0x6c, 0xea, 0xeb, 0x9e,
-	0x94, 0xc4, 0x10, 0x89, 0xda, 0xfe, 0xc6, 0x8f, 0x70, 0xe7, 0x6f, 0x33, 0x1a, 0xd9, 0x90, 0xbc,
-	0x20, 0x53, 0x7d, 0x85, 0x3c, 0x56, 0x9f, 0xe8, 0x11, 0xa4, 0x27, 0xde, 0x70, 0x4c, 0xb4, 0x9f,
-	0xf3, 0x2e, 0xb1, 0x4b, 0x83, 0xd9, 0x5e, 0x6c, 0x34, 0xbe, 0x4c, 0x3c, 0xb1, 0x36, 0x76, 0xa1,
-	0x7a, 0x5d, 0x52, 0x5f, 0x63, 0xb8, 0x1a, 0x37, 0x9c, 0x8f, 0xd9, 0x78, 0x9e, 0xca, 0x25, 0xed,
-	0x54, 0xfd, 0x77, 0x0b, 0xca, 0x8b, 0xf4, 0xa3, 0x8f, 0x61, 0x6d, 0x39, 0x61, 0xdc, 0x81, 0xa4,
-	0x7e, 0x68, 0x16, 0x2d, 0x66, 0xc7, 0x33, 0x49, 0x7d, 0xf4, 0x05, 0x38, 0xaf, 0x6d, 0x91, 0xf4,
-	0x92, 0xb0, 0xb1, 0xd4, 0x07, 0x5b, 0x78, 0x6d, 0x71, 0x57, 0xcf, 0x80, 0x2a, 0x99, 0xc3, 0x42,
-	0x50, 0xb3, 0xa4, 0x7f, 0xa1, 0x0f, 0x32, 0x44, 0xe4, 0xf0, 0x4a, 0x08, 0xf5, 0x14, 0xa2, 0xce,
-	0x11, 0xf5, 0xdf, 0x12, 0x50, 0x0e, 0x1b, 0x36, 0x26, 0x2f, 0xc7, 0x44, 0x48, 0xf4, 0x7f, 0xc8,
-	0xf7, 0xbd, 0xe1, 0x90, 0x70, 0x37, 0x74, 0xb1, 0xb0, 0x5d, 0xd9, 0x34, 0x63, 0xab, 0xa9, 0xe5,
-	0xed, 0x3d, 0x9c, 0x33, 0x1a, 0x6d, 0x1f, 0x3d, 0x82, 0x6c, 0x54, 0x79, 0x89, 0x99, 0x6e, 0xbc,
-	0xf2, 0x70, 0x84, 0xa3, 0x87, 0x90, 0xd6, 0x2c, 0x84, 0x69, 0xb1, 0x12, 0x71, 0xa2, 0x7a, 0x9c,
-	0x6e, 0xdf, 0xd8, 0xe0, 0xe8, 0x33, 0x08, 0x73, 0xc3, 0x95, 0xd3, 0x11, 0xd1, 0xc9, 0x50, 0xde,
-	0xae, 0x2e, 0x67, 0x51, 0x6f, 0x3a, 0x22, 0x18, 0xe4, 0xec, 0x5b, 0x25, 0xe9, 0x05, 0x99, 0x8a,
-	0x91, 0xd7, 0x27, 0xae, 0x1e, 0x78, 0x7a, 0x30, 0xe5, 0x71, 0x29, 0x92, 0xea, 0xcc, 0x8f, 0x0f,
-	0xae, 0xec, 0x4d, 0x06, 0xd7, 0xf3, 0x54, 0x2e, 0x6d, 0x67, 0xea, 0xbf, 0x58, 0x50, 0x99, 0x45,
-	0x4a, 0x8c, 0x58, 0x20, 0xd4, 0x89, 0x69, 0xc2, 0x39, 0xe3, 0x4b, 0x61, 0xc2, 0x87, 0xcd, 0x96,
-	0x12, 0x63, 0x83, 0xbe, 0x4d, 0x8c, 0x1e, 0x43, 0x86, 0x13, 0x31, 0x1e, 0xca, 0x30, 0x48, 0x28,
-	0x3e, 0xde, 0xb0, 0x46, 0x70, 0xa8, 0x51, 0x7f, 0x95, 0x80, 0xd5, 0xd0, 0xa3, 0x5d, 0x4f, 0xf6,
-	0xcf, 0x3f, 0x38, 0x81, 0xff, 0x83, 0xac, 0xf2, 0x86, 0x12, 0x95, 0x50, 0xc9, 0xeb, 0x29, 0x8c,
-	0x34, 0xde, 0x83, 0x44, 0x4f, 0x2c, 0xbc, 0x83, 0xd2, 0xe6, 0x1d, 0xe4, 0x89, 0xf8, 0x3b, 0xe8,
-	0x03, 0x71, 0x5d, 0xff, 0xd5, 0x82, 0xea, 0x62, 0x4c, 0x3f, 0x18, 0xd5, 0x1f, 0x41, 0xd6, 0x10,
-	0x19, 0x45, 0x73, 0x3d, 0xf4, 0xcd, 0xd0, 0x7c, 0x42, 0xe5, 0xb9, 0x31, 0x1d, 0xa9, 0xa9, 0x62,
-	0xad, 0x76, 0x25, 0x27, 0xde, 0xe5, 0x7b, 0x95, 0xec, 0xac, 0x0e, 0x13, 0x6f, 0x57, 0x87, 0xc9,
-	0x77, 0xae, 0xc3, 0xd4, 0x1b, 0xb8, 0x49, 0xdf, 0xe8, 0x01, 0x19, 0x8b, 0x6d, 0xe6, 0x9f, 0x63,
-	0x5b, 0x6f, 0xc2, 0xda, 0x52, 0xa0, 0x42, 0x1a, 0xe7, 0xf5, 0x65, 0xbd, 0xb1, 0xbe, 0x7e, 0x82,
-	0x3b, 0x98, 0x08, 0x36, 0x9c, 0x90, 0x58, 0xe6, 0xbd, 0x5b, 0xc8, 0x11, 0xa4, 0x7c, 0x19, 0x4e,
-	0xcd, 0x3c, 0xd6, 0xdf, 0xf5, 0xbb, 0xb0, 0x71, 0x9d, 0x79, 0xe3, 0x68, 0xfd, 0x4f, 0x0b, 0xca,
-	0xc7, 0xe6, 0x0e, 0xef, 0x76, 0xe4, 0x12, 0x79, 0x89, 0x1b, 0x92, 0xf7, 0x10, 0xd2, 0x13, 0x3d,
-	0x9c, 0xa2, 0x26, 0x1d, 0xfb, 0x7f, 0x73, 0xac, 0x66, 0x06, 0x36, 0xb8, 0x8a, 0xe4, 0x19, 0x1d,
-	0x4a, 0xc2, 0x35, 0xbb, 0x2a, 0x92, 0x31, 0xcd, 0xa7, 0x1a, 0xc1, 0xa1, 0x46, 0xfd, 0x6b, 0xa8,
-	0xcc, 0xee, 0x32, 0x27, 0x82, 0x4c, 0x88, 0x7a, 0xfc, 0x59, 0x3a, 0xf9, 0x17, 0xb6, 0x1f, 0xb7,
-	0x14, 0x84, 0x43, 0x8d, 0xc7, 0x7b, 0x50, 0x59, 0xfa, 0x67, 0x80, 0x2a, 0x50, 0x38, 0x7a, 0xd1,
-	0x3d, 0x6c, 0x35, 0xdb, 0x4f, 0xdb, 0xad, 0x3d, 0xfb, 0x16, 0x02, 0xc8, 0x74, 0xdb, 0x2f, 0x9e,
-	0xed, 0xb7, 0x6c, 0x0b, 0xe5, 0x21, 0x7d, 0x70, 0xb4, 0xdf, 0x6b, 0xdb, 0x09, 0xf5, 0xd9, 0x3b,
-	0xe9, 0x1c, 0x36, 0xed, 0xe4, 0xe3, 0xaf, 0xa0, 0xd0, 0xd4, 0xff, 0x6f, 0x3a, 0xdc, 0x27, 0x5c,
-	0x6d, 0x78, 0xd1, 0xc1, 0x07, 0x3b, 0xfb, 0xf6, 0x2d, 0x94, 0x85, 0xe4, 0x21, 0x56, 0x3b, 0x73,
-	0x90, 0x3a, 0xec, 0x74, 0x7b, 0x76, 0x02, 0x95, 0x01, 0x76, 0x8e, 0x7a, 0x9d, 0x66, 0xe7, 0xe0,
-	0xa0, 0xdd, 0xb3, 0x93, 0xbb, 0x9f, 0x43, 0x85, 0xb2, 0xcd, 0x09, 0x95, 0x44, 0x08, 0xf3, 0xf7,
-	0xed, 0x87, 0x7b, 0xe1, 0x8a, 0xb2, 0x2d, 0xf3, 0xb5, 0x35, 0x60, 0x5b, 0x13, 0xb9, 0xa5, 0xd1,
-	0x2d, 0x93, 0x9a, 0xa7, 0x19, 0xbd, 0xfa, 0xe4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0x85,
-	0xad, 0x4f, 0x3e, 0x0e, 0x00, 0x00,
+	0x12, 0xce, 0xea, 0x5f, 0xb3, 0xfa, 0x59, 0xd3, 0xb2, 0xb3, 0xf1, 0xc9, 0x39, 0x47, 0x50, 0x12,
+	0x44, 0xc9, 0x39, 0xb0, 0x5b, 0xf7, 0x2f, 0x28, 0x5a, 0xb4, 0xb6, 0xec, 0xa4, 0x0a, 0xec, 0xc8,
+	0xa5, 0x64, 0x1b, 0x28, 0x5a, 0x2c, 0xd6, 0x5a, 0x5a, 0x5e, 0x58, 0x5e, 0x2a, 0x24, 0x25, 0x57,
+	0x4f, 0xd1, 0xfb, 0xbe, 0x40, 0x6f, 0x7a, 0xdf, 0x77, 0xe8, 0x5d, 0x5f, 0xa6, 0xd7, 0x05, 0x7f,
+	0x56, 0x5e, 0x29, 0x6e, 0xe3, 0x24, 0xc8, 0x8d, 0xb0, 0xfc, 0xbe, 0xe1, 0x70, 0x38, 0xdf, 0x0c,
+	0x49, 0x41, 0x69, 0x22, 0x06, 0xbe, 0x20, 0xeb, 0x23, 0x46, 0x05, 0x45, 0x39, 0x3d, 0x5a, 0x73,
+	0x4e, 0xc2, 0x68, 0x48, 0x07, 0x81, 0x2f, 0x7c, 0xcd, 0xac, 0xd9, 0x2f, 0xc7, 0x84, 0x4d, 0xcd,
+	0xa0, 0x22, 0xe8, 0x88, 0x26, 0xc9, 0x89, 0x60, 0xa3, 0xbe, 0x1e, 0x34, 0xfe, 0xb4, 0x21, 0xdf,
+	0x25, 0x9c, 0x87, 0x34, 0x42, 0x0f, 0xa0, 0x12, 0x46, 0x9e, 0x60, 0x7e, 0xc4, 0xfd, 0xbe, 0x08,
+	0x69, 0xe4, 0x5a, 0x75, 0xab, 0x59, 0xc0, 0xe5, 0x30, 0xea, 0x5d, 0x81, 0xa8, 0x05, 0x15, 0x7e,
+	0xe6, 0xb3, 0xc0, 0xe3, 0x7a, 0x1e, 0x77, 0x53, 0xf5, 0x74, 0xd3, 0xde, 0xbc, 0xbb, 0x6e, 0xa2,
+	0x33, 0xfe, 0xd6, 0xbb, 0xd2, 0xca, 0x0c, 0x70, 0x99, 0x27, 0x46, 0x1c, 0xfd, 0x07, 0xc0, 0x1f,
+	0x0b, 0xda, 0xa7, 0x17, 0x17, 0xa1, 0x70, 0x33, 0x6a, 0x9d, 0x04, 0x82, 0xee, 0x41, 0x59, 0xf8,
+	0x6c, 0x40, 0x84, 0xc7, 0x05, 0x0b, 0xa3, 0x81, 0x9b, 0xad, 0x5b, 0xcd, 0x22, 0x2e, 0x69, 0xb0,
+	0xab, 0x30, 0xb4, 0x01, 0x79, 0x3a, 0x12, 0x2a, 0x84, 0x5c, 0xdd, 0x6a, 0xda, 0x9b, 0x2b, 0xeb,
+	0x7a, 0xe3, 0xbb, 0x3f, 0x92, 0xfe, 0x58, 0x90, 0x8e, 0x26, 0x71, 0x6c, 0x85, 0xb6, 0xc1, 0x49,
+	0x6c, 0xcf, 0xbb, 0xa0, 0x01, 0x71, 0xf3, 0x75, 0xab, 0x59, 0xd9, 0xbc, 0x1d, 0x07, 0x9f, 0xd8,
+	0xe9, 0x3e, 0x0d, 0x08, 0xae, 0x8a, 0x79, 0x00, 0x6d, 0x40, 0xe1, 0xd2, 0x67, 0x51, 0x18, 0x0d,
+	0xb8, 0x5b, 0x50, 0x1b, 0x5f, 0x36, 0xab, 0x7e, 0x2b, 0x7f, 0x8f, 0x35, 0x87, 0x67, 0x46, 0xe8,
+	0x2b, 0x28, 0x8d, 0x18, 0xb9, 0xca, 0x56, 0xf1, 0x06, 0xd9, 0xb2, 0x47, 0x8c, 0xcc, 0x72, 0xb5,
+	0x05, 0xe5, 0x11, 0xe5, 0xe2, 0xca, 0x03, 0xdc, 0xc0, 0x43, 0x49, 0x4e, 0x99, 0xb9, 0xb8, 0x0f,
+	0x95, 0xa1, 0xcf, 0x85, 0x17, 0x46, 0x9c, 0x30, 0xe1, 0x85, 0x81, 0x6b, 0xd7, 0xad, 0x66, 0x06,
+	0x97, 0x24, 0xda, 0x56, 0x60, 0x3b, 0x40, 0xff, 0x06, 0x38, 0xa5, 0xe3, 0x28, 0xf0, 0x18, 0xbd,
+	0xe4, 0x6e, 0x49, 0x59, 0x14, 0x15, 0x82, 0xe9, 0x25, 0x47, 0x1e, 0xac, 0x8e, 0x39, 0x61, 0x5e,
+	0x40, 0x4e, 0xc3, 0x88, 0x04, 0xde, 0xc4, 0x67, 0xa1, 0x7f, 0x32, 0x24, 0xdc, 0x2d, 0xab, 0x80,
+	0x1e, 0x2d, 0x06, 0x74, 0xc8, 0x09, 0xdb, 0xd1, 0xc6, 0x47, 0xb1, 0xed, 0x6e, 0x24, 0xd8, 0x14,
+	0xd7, 0xc6, 0xd7, 0x50, 0xa8, 0x03, 0x0e, 0x9f, 0x72, 0x41, 0x2e, 0x12, 0xae, 0x2b, 0xca, 0xf5,
+	0xfd, 0x57, 0xf6, 0xaa, 0xec, 0x16, 0xbc, 0x56, 0xf9, 0x3c, 0x8a, 0xfe, 0x05, 0x45, 0x46, 0x2f,
+	0xbd, 0x3e, 0x1d, 0x47, 0xc2, 0xad, 0xd6, 0xad, 0x66, 0x1a, 0x17, 0x18, 0xbd, 0x6c, 0xc9, 0xb1,
+	0x2c, 0x41, 0xee, 0x4f, 0xc8, 0x88, 0x86, 0x91, 0xe0, 0xae, 0x53, 0x4f, 0x37, 0x8b, 0x38, 0x81,
+	0xa0, 0x26, 0x38, 0x61, 0xe4, 0x31, 0xc2, 0x09, 0x9b, 0x90, 0xc0, 0xeb, 0xd3, 0x28, 0x72, 0x97,
+	0x54, 0xa1, 0x56, 0xc2, 0x08, 0x1b, 0xb8, 0x45, 0xa3, 0x48, 0x2a, 0x3c, 0xa4, 0xfd, 0xf3, 0x58,
+	0x20, 0x17, 0xd5, 0xad, 0xd7, 0xea, 0x63, 0xcb, 0x19, 0x66, 0x80, 0xd6, 0x61, 0x59, 0xc9, 0xa3,
+	0xbc, 0x9c, 0x11, 0x9f, 0x89, 0x13, 0xe2, 0x0b, 0x77, 0x59, 0x45, 0xbc, 0x24, 0xa9, 0x3d, 0xda,
+	0x3f, 0xff, 0x26, 0x26, 0xd0, 0xd7, 0xe0, 0x30, 0xe2, 0x07, 0x9e, 0x7f, 0x2a, 0x08, 0xf3, 0x2e,
+	0x59, 0x28, 0x88, 0x5b, 0x53, 0x8b, 0xae, 0xc6, 0x8b, 0x62, 0xe2, 0x07, 0x5b, 0x92, 0x3e, 0x96,
+	0x2c, 0xae, 0xb0, 0xb9, 0x31, 0xaa, 0x83, 0xbd, 0xb3, 0xb3, 0xd7, 0x15, 0xcc, 0x17, 0x64, 0x30,
+	0x75, 0x57, 0x54, 0x77, 0x25, 0x21, 0x69, 0x61, 0xc2, 0x3b, 0x3c, 0x6c, 0xef, 0xb8, 0xab, 0xda,
+	0x22, 0x01, 0xa1, 0x8f, 0x61, 0x95, 0x44, 0x32, 0xd1, 0x9e, 0x51, 0x8d, 0x13, 0x21, 0x54, 0x5f,
+	0xdc, 0x56, 0x69, 0xaa, 0x69, 0x56, 0x4b, 0xd5, 0x35, 0x9c, 0xec, 0x6c, 0xd5, 0x2e, 0x9e, 0x08,
+	0x2f, 0x08, 0x1d, 0x0b, 0xd7, 0x55, 0xbb, 0x2c, 0x29, 0xb0, 0xa7, 0xb1, 0xb5, 0xdf, 0x2c, 0x28,
+	0x25, 0xd3, 0x85, 0x1e, 0x40, 0x4e, 0xb7, 0xbe, 0x3a, 0x93, 0xec, 0xcd, 0xb2, 0xe9, 0xb9, 0x9e,
+	0x02, 0xb1, 0x21, 0xe5, 0x11, 0x96, 0x6c, 0xf0, 0x30, 0x70, 0x53, 0xca, 0x7b, 0x39, 0x81, 0xb6,
+	0x03, 0xf4, 0x04, 0x4a, 0x42, 0x86, 0x26, 0x3c, 0x7f, 0x18, 0xfa, 0xdc, 0x4d, 0x9b, 0xd3, 0x63,
+	0x76, 0x52, 0xf6, 0x14, 0xbb, 0x25, 0x49, 0x6c, 0x8b, 0xab, 0x01, 0xfa, 0x2f, 0xd8, 0xb3, 0x8a,
+	0x08, 0x03, 0x75, 0x70, 0xa5, 0x31, 0xc4, 0x50, 0x3b, 0x58, 0xfb, 0x1e, 0xee, 0xfc, 0x6d, 0xd9,
+	0x23, 0x07, 0xd2, 0xe7, 0x64, 0xaa, 0xb6, 0x50, 0xc4, 0xf2, 0x13, 0x3d, 0x82, 0xec, 0xc4, 0x1f,
+	0x8e, 0x89, 0x8a, 0xf3, 0xea, 0x28, 0xd9, 0x0e, 0xa3, 0xd9, 0x5c, 0xac, 0x2d, 0x3e, 0x4f, 0x3d,
+	0xb1, 0xd6, 0xb6, 0xa1, 0x76, 0x5d, 0xe5, 0x5f, 0xe3, 0xb8, 0x96, 0x74, 0x5c, 0x4c, 0xf8, 0x78,
+	0x9e, 0x29, 0xa4, 0x9d, 0x4c, 0xe3, 0x57, 0x0b, 0x2a, 0xf3, 0x35, 0x82, 0x3e, 0x84, 0x95, 0xc5,
+	0xaa, 0xf2, 0x06, 0x22, 0x0c, 0x8c, 0x5b, 0x34, 0x5f, 0x42, 0xcf, 0x44, 0x18, 0xa0, 0xcf, 0xc0,
+	0x7d, 0x65, 0x4a, 0xac, 0xab, 0x5c, 0xd8, 0xc2, 0x2b, 0xf3, 0xb3, 0x8c, 0xc0, 0xb2, 0xe2, 0x4d,
+	0xb7, 0xc8, 0x0b, 0xa7, 0x7f, 0xae, 0x16, 0xd2, 0x42, 0x14, 0xf0, 0x92, 0xa1, 0x7a, 0x92, 0x91,
+	0xeb, 0xf0, 0xc6, 0x2f, 0x29, 0xa8, 0x98, 0x53, 0x1d, 0x93, 0x97, 0x63, 0xc2, 0x05, 0xfa, 0x3f,
+	0x14, 0xfb, 0xfe, 0x70, 0x48, 0x98, 0x67, 0x42, 0xb4, 0x37, 0xab, 0xeb, 0xfa, 0x6e, 0x6b, 0x29,
+	0xbc, 0xbd, 0x83, 0x0b, 0xda, 0xa2, 0x1d, 0xa0, 0x47, 0x90, 0x8f, 0xdb, 0x33, 0x35, 0xb3, 0x4d,
+	0xb6, 0x27, 0x8e, 0x79, 0xf4, 0x10, 0xb2, 0x4a, 0x05, 0x53, 0x16, 0x4b, 0xb1, 0x26, 0xf2, 0x20,
+	0x54, 0x67, 0x3c, 0xd6, 0x3c, 0xfa, 0x04, 0x4c, 0x6d, 0x78, 0x62, 0x3a, 0x22, 0xaa, 0x18, 0x2a,
+	0x9b, 0xb5, 0xc5, 0x2a, 0xea, 0x4d, 0x47, 0x04, 0x83, 0x98, 0x7d, 0xcb, 0x22, 0x3d, 0x27, 0x53,
+	0x3e, 0xf2, 0xfb, 0xc4, 0x53, 0xb7, 0xa2, 0xba, 0xbd, 0x8a, 0xb8, 0x1c, 0xa3, 0xaa, 0xf2, 0x93,
+	0xb7, 0x5b, 0xfe, 0x26, 0xb7, 0xdb, 0xf3, 0x4c, 0x21, 0xeb, 0xe4, 0x1a, 0x3f, 0x59, 0x50, 0x9d,
+	0x65, 0x8a, 0x8f, 0x68, 0xc4, 0xe5, 0x8a, 0x59, 0xc2, 0x18, 0x65, 0x0b, 0x69, 0xc2, 0x07, 0xad,
+	0x5d, 0x09, 0x63, 0xcd, 0xbe, 0x49, 0x8e, 0x1e, 0x43, 0x8e, 0x11, 0x3e, 0x1e, 0x0a, 0x93, 0x24,
+	0x94, 0xbc, 0x03, 0xb1, 0x62, 0xb0, 0xb1, 0x68, 0xfc, 0x91, 0x82, 0x65, 0x13, 0xd1, 0xb6, 0x2f,
+	0xfa, 0x67, 0xef, 0x5d, 0xc0, 0xff, 0x41, 0x5e, 0x46, 0x13, 0x12, 0x59, 0x50, 0xe9, 0xeb, 0x25,
+	0x8c, 0x2d, 0xde, 0x41, 0x44, 0x9f, 0xcf, 0x3d, 0x96, 0xb2, 0xfa, 0xb1, 0xe4, 0xf3, 0xe4, 0x63,
+	0xe9, 0x3d, 0x69, 0xdd, 0xf8, 0xd9, 0x82, 0xda, 0x7c, 0x4e, 0xdf, 0x9b, 0xd4, 0x1f, 0x40, 0x5e,
+	0x0b, 0x19, 0x67, 0x73, 0xd5, 0xc4, 0xa6, 0x65, 0x3e, 0x0e, 0xc5, 0x99, 0x76, 0x1d, 0x9b, 0xc9,
+	0x66, 0xad, 0x75, 0x05, 0x23, 0xfe, 0xc5, 0x3b, 0xb5, 0xec, 0xac, 0x0f, 0x53, 0x6f, 0xd6, 0x87,
+	0xe9, 0xb7, 0xee, 0xc3, 0xcc, 0x6b, 0xb4, 0xc9, 0xde, 0xe8, 0x95, 0x99, 0xc8, 0x6d, 0xee, 0x9f,
+	0x73, 0xdb, 0x68, 0xc1, 0xca, 0x42, 0xa2, 0x8c, 0x8c, 0x57, 0xfd, 0x65, 0xbd, 0xb6, 0xbf, 0x7e,
+	0x80, 0x3b, 0x98, 0x70, 0x3a, 0x9c, 0x90, 0x44, 0xe5, 0xbd, 0x5d, 0xca, 0x11, 0x64, 0x02, 0x61,
+	0x6e, 0xcd, 0x22, 0x56, 0xdf, 0x8d, 0xbb, 0xb0, 0x76, 0x9d, 0x7b, 0x1d, 0x68, 0xe3, 0x77, 0x0b,
+	0x2a, 0x47, 0x7a, 0x0f, 0x6f, 0xb7, 0xe4, 0x82, 0x78, 0xa9, 0x1b, 0x8a, 0xf7, 0x10, 0xb2, 0x13,
+	0x75, 0x39, 0xc5, 0x87, 0x74, 0xe2, 0x4f, 0xd0, 0x91, 0xbc, 0x33, 0xb0, 0xe6, 0x65, 0x26, 0x4f,
+	0xc3, 0xa1, 0x20, 0xcc, 0xcd, 0x98, 0x4c, 0x26, 0x2c, 0x9f, 0x2a, 0x06, 0x1b, 0x8b, 0xc6, 0x97,
+	0x50, 0x9d, 0xed, 0xe5, 0x4a, 0x08, 0x32, 0x21, 0xf2, 0x85, 0x68, 0xd5, 0xd3, 0x8b, 0xd3, 0x8f,
+	0x76, 0x25, 0x85, 0x8d, 0xc5, 0xe3, 0x1d, 0xa8, 0x2e, 0xfc, 0x7d, 0x40, 0x55, 0xb0, 0x0f, 0x5f,
+	0x74, 0x0f, 0x76, 0x5b, 0xed, 0xa7, 0xed, 0xdd, 0x1d, 0xe7, 0x16, 0x02, 0xc8, 0x75, 0xdb, 0x2f,
+	0x9e, 0xed, 0xed, 0x3a, 0x16, 0x2a, 0x42, 0x76, 0xff, 0x70, 0xaf, 0xd7, 0x76, 0x52, 0xf2, 0xb3,
+	0x77, 0xdc, 0x39, 0x68, 0x39, 0xe9, 0xc7, 0x5f, 0x80, 0xdd, 0x52, 0x7f, 0x82, 0x3a, 0x2c, 0x20,
+	0x4c, 0x4e, 0x78, 0xd1, 0xc1, 0xfb, 0x5b, 0x7b, 0xce, 0x2d, 0x94, 0x87, 0xf4, 0x01, 0x96, 0x33,
+	0x0b, 0x90, 0x39, 0xe8, 0x74, 0x7b, 0x4e, 0x0a, 0x55, 0x00, 0xb6, 0x0e, 0x7b, 0x9d, 0x56, 0x67,
+	0x7f, 0xbf, 0xdd, 0x73, 0xd2, 0xdb, 0x9f, 0x42, 0x35, 0xa4, 0xeb, 0x93, 0x50, 0x10, 0xce, 0xf5,
+	0x7f, 0xbc, 0xef, 0xee, 0x99, 0x51, 0x48, 0x37, 0xf4, 0xd7, 0xc6, 0x80, 0x6e, 0x4c, 0xc4, 0x86,
+	0x62, 0x37, 0x74, 0x69, 0x9e, 0xe4, 0xd4, 0xe8, 0xa3, 0xbf, 0x06, 0x00, 0x7b, 0x03, 0xbb, 0x7f,
+	0x63, 0x0e, 0x00, 0x00,
 }
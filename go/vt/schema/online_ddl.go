@@ -32,7 +32,7 @@ var (
 	migrationBasePath                 = "schema-migration"
 	onlineDdlUUIDRegexp               = regexp.MustCompile(`^[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}$`)
 	strategyParserRegexp              = regexp.MustCompile(`^([\S]+)\s+(.*)$`)
-	onlineDDLGeneratedTableNameRegexp = regexp.MustCompile(`^_[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}_([0-9]{14})_(gho|ghc|del|new)$`)
+	onlineDDLGeneratedTableNameRegexp = regexp.MustCompile(`^_[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}_([0-9]{14})_(gho|ghc|del|new|vrepl)$`)
 	ptOSCGeneratedTableNameRegexp     = regexp.MustCompile(`^_.*_old$`)
 )
 
@@ -74,9 +74,10 @@ const (
 	OnlineDDLStatusRunning   OnlineDDLStatus = "running"
 	OnlineDDLStatusComplete  OnlineDDLStatus = "complete"
 	OnlineDDLStatusFailed    OnlineDDLStatus = "failed"
+	OnlineDDLStatusReverted  OnlineDDLStatus = "reverted"
 )
 
-// DDLStrategy suggests how an ALTER TABLE should run (e.g. "" for normal, "gh-ost" or "pt-osc")
+// DDLStrategy suggests how an ALTER TABLE should run (e.g. "" for normal, "vitess", "gh-ost" or "pt-osc")
 type DDLStrategy string
 
 const (
@@ -86,13 +87,15 @@ const (
 	DDLStrategyGhost DDLStrategy = "gh-ost"
 	// DDLStrategyPTOSC requests pt-online-schema-change to run the migration
 	DDLStrategyPTOSC DDLStrategy = "pt-osc"
+	// DDLStrategyVitess requests the migration to run with VReplication, with no external tools
+	DDLStrategyVitess DDLStrategy = "vitess"
 )
 
 // IsDirect returns true if this strategy is a direct strategy
 // A strategy is direct if it's not explciitly one of the online DDL strategies
 func (s DDLStrategy) IsDirect() bool {
 	switch s {
-	case DDLStrategyGhost, DDLStrategyPTOSC:
+	case DDLStrategyGhost, DDLStrategyPTOSC, DDLStrategyVitess:
 		return false
 	}
 	return true
@@ -125,7 +128,7 @@ func ParseDDLStrategy(strategyVariable string) (strategy DDLStrategy, options st
 	switch strategy = DDLStrategy(strategyName); strategy {
 	case "": // backwards compatiblity and to handle unspecified values
 		return DDLStrategyDirect, options, nil
-	case DDLStrategyGhost, DDLStrategyPTOSC, DDLStrategyDirect:
+	case DDLStrategyGhost, DDLStrategyPTOSC, DDLStrategyVitess, DDLStrategyDirect:
 		return strategy, options, nil
 	default:
 		return DDLStrategyDirect, options, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
@@ -33,9 +33,11 @@ func TestIsDirect(t *testing.T) {
 	assert.True(t, DDLStrategyDirect.IsDirect())
 	assert.False(t, DDLStrategyGhost.IsDirect())
 	assert.False(t, DDLStrategyPTOSC.IsDirect())
+	assert.False(t, DDLStrategyVitess.IsDirect())
 	assert.True(t, DDLStrategy("").IsDirect())
 	assert.False(t, DDLStrategy("gh-ost").IsDirect())
 	assert.False(t, DDLStrategy("pt-osc").IsDirect())
+	assert.False(t, DDLStrategy("vitess").IsDirect())
 	assert.True(t, DDLStrategy("something").IsDirect())
 }
 
@@ -58,6 +60,10 @@ func TestParseDDLStrategy(t *testing.T) {
 			strategyVariable: "pt-osc",
 			strategy:         DDLStrategyPTOSC,
 		},
+		{
+			strategyVariable: "vitess",
+			strategy:         DDLStrategyVitess,
+		},
 		{
 			strategy: DDLStrategyDirect,
 		},
@@ -151,6 +157,7 @@ func TestIsOnlineDDLTableName(t *testing.T) {
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_ghc",
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_del",
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114013_new",
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114013_vrepl",
 		"_table_old",
 		"__table_old",
 	}
@@ -164,6 +171,7 @@ func TestIsOnlineDDLTableName(t *testing.T) {
 		"_table_gho",
 		"_table_ghc",
 		"_table_del",
+		"_table_vrepl",
 		"table_old",
 	}
 	for _, tableName := range irrelevantNames {
@@ -197,6 +197,7 @@ type (
 
 	// Select represents a SELECT statement.
 	Select struct {
+		With             *With
 		Cache            *bool // a reference here so it can be nil
 		Distinct         bool
 		StraightJoinHint bool
@@ -207,6 +208,7 @@ type (
 		Where            *Where
 		GroupBy          GroupBy
 		Having           *Where
+		Windows          WindowDefinitions
 		OrderBy          OrderBy
 		Limit            *Limit
 		Lock             Lock
@@ -230,6 +232,29 @@ type (
 	// Lock is an enum for the type of lock in the statement
 	Lock int8
 
+	// With represents the WITH clause of a SELECT statement: the list of
+	// common table expressions and whether they may reference themselves.
+	With struct {
+		Ctes      []*CommonTableExpr
+		Recursive bool
+	}
+
+	// WindowDefinition represents a named window of the WINDOW clause.
+	WindowDefinition struct {
+		Name       ColIdent
+		WindowSpec *WindowSpecification
+	}
+
+	// WindowDefinitions represents the WINDOW clause of a SELECT statement.
+	WindowDefinitions []*WindowDefinition
+
+	// CommonTableExpr represents a single named subquery of a WITH clause.
+	CommonTableExpr struct {
+		TableID  TableIdent
+		Columns  Columns
+		Subquery *Subquery
+	}
+
 	// UnionSelect represents union type and select statement after first select statement.
 	UnionSelect struct {
 		Distinct  bool
@@ -237,6 +262,7 @@ type (
 	}
 	// Union represents a UNION statement.
 	Union struct {
+		With           *With
 		FirstStatement SelectStatement
 		UnionSelects   []*UnionSelect
 		OrderBy        OrderBy
@@ -1631,13 +1657,52 @@ type (
 	}
 
 	// FuncExpr represents a function call.
+	// Over is set for window function calls.
 	FuncExpr struct {
 		Qualifier TableIdent
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
+	}
+
+	// OverClause represents the OVER clause of a window function call.
+	// It either references a named window, or specifies the window inline.
+	OverClause struct {
+		WindowName ColIdent
+		WindowSpec *WindowSpecification
+	}
+
+	// WindowSpecification represents the specification of a window.
+	// Name is set when the specification builds upon a named window.
+	WindowSpecification struct {
+		Name            ColIdent
+		PartitionClause Exprs
+		OrderClause     OrderBy
+		FrameClause     *FrameClause
+	}
+
+	// FrameClause represents the frame of a window: the rows of the
+	// partition the window function is computed over.
+	FrameClause struct {
+		Unit  FrameUnitType
+		Start *FramePoint
+		End   *FramePoint
+	}
+
+	// FrameUnitType is an enum for FrameClause.Unit
+	FrameUnitType int8
+
+	// FramePoint represents a bound of a window frame.
+	// Expr is only set for the ExprPrecedingType and ExprFollowingType bounds.
+	FramePoint struct {
+		Type FramePointType
+		Expr Expr
 	}
 
+	// FramePointType is an enum for FramePoint.Type
+	FramePointType int8
+
 	// GroupConcatExpr represents a call to GROUP_CONCAT
 	GroupConcatExpr struct {
 		Distinct  bool
@@ -1849,10 +1914,10 @@ func (node *Select) Format(buf *TrackedBuffer) {
 	addIf(node.StraightJoinHint, StraightJoinHint)
 	addIf(node.SQLCalcFoundRows, SQLCalcFoundRowsStr)
 
-	buf.astPrintf(node, "select %v%s%v from %v%v%v%v%v%v%s%v",
-		node.Comments, options, node.SelectExprs,
+	buf.astPrintf(node, "%vselect %v%s%v from %v%v%v%v%v%v%v%s%v",
+		node.With, node.Comments, options, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock.ToString(), node.Into)
 }
 
@@ -1863,13 +1928,35 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%v", node.FirstStatement)
+	buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 	for _, us := range node.UnionSelects {
 		buf.astPrintf(node, "%v", us)
 	}
 	buf.astPrintf(node, "%v%v%s", node.OrderBy, node.Limit, node.Lock.ToString())
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.Ctes {
+		buf.astPrintf(node, "%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v%v as %v", node.TableID, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node *UnionSelect) Format(buf *TrackedBuffer) {
 	if node.Distinct {
@@ -2852,6 +2939,79 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	if node.Over != nil {
+		buf.astPrintf(node, " %v", node.Over)
+	}
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node.WindowSpec == nil {
+		buf.astPrintf(node, "over %v", node.WindowName)
+		return
+	}
+	buf.astPrintf(node, "over (%v)", node.WindowSpec)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	// Every part of the specification is optional, so the separator
+	// is only written between the parts that are present.
+	var prefix string
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.astPrintf(node, "%spartition by %v", prefix, node.PartitionClause)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.WriteString(prefix + "order by ")
+		for i, order := range node.OrderClause {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", order)
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.FrameClause)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case ExprPrecedingType, ExprFollowingType:
+		buf.astPrintf(node, "%v %s", node.Expr, node.Type.ToString())
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// Format formats the node.
+func (node WindowDefinitions) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *WindowDefinition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
 }
 
 // Format formats the node
@@ -516,8 +516,15 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// Aggregate functions called with an OVER clause are window
+// functions: they don't group the rows of the query.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return Aggregates[node.Name.Lowered()] && node.Over == nil
+}
+
+// IsWindowFunc returns true if the function is called with an OVER clause.
+func (node *FuncExpr) IsWindowFunc() bool {
+	return node.Over != nil
 }
 
 // NewColIdent makes a new ColIdent.
@@ -863,7 +870,12 @@ func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit,
 		return union
 	}
 
-	return &Union{FirstStatement: lhs, UnionSelects: []*UnionSelect{{Distinct: distinct, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
+	// A WITH clause in front of the first SELECT is scoped to the whole UNION.
+	var with *With
+	if sel, isSelect := lhs.(*Select); isSelect {
+		with, sel.With = sel.With, nil
+	}
+	return &Union{With: with, FirstStatement: lhs, UnionSelects: []*UnionSelect{{Distinct: distinct, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
 }
 
 // ToString returns the string associated with the DDLAction Enum
@@ -1132,6 +1144,36 @@ func (dir OrderDirection) ToString() string {
 	}
 }
 
+// ToString returns the frame unit as a string
+func (ty FrameUnitType) ToString() string {
+	switch ty {
+	case FrameRowsType:
+		return FrameRowsStr
+	case FrameRangeType:
+		return FrameRangeStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the frame point type as a string
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return ExprPrecedingStr
+	case ExprFollowingType:
+		return ExprFollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 // ToString returns the operator as a string
 func (op ConvertTypeOperator) ToString() string {
 	switch op {
@@ -231,6 +231,7 @@ func (er *expressionRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 		sysvars.ClientFoundRows.Name,
 		sysvars.SkipQueryPlanCache.Name,
 		sysvars.SQLSelectLimit.Name,
+		sysvars.MaxExecutionTime.Name,
 		sysvars.TransactionMode.Name,
 		sysvars.Workload.Name,
 		sysvars.DDLStrategy.Name,
@@ -447,6 +447,27 @@ func (cached *ColumnType) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *CommonTableExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field TableID vitess.io/vitess/go/vt/sqlparser.TableIdent
+	size += cached.TableID.CachedSize(false)
+	// field Columns vitess.io/vitess/go/vt/sqlparser.Columns
+	{
+		size += int64(cap(cached.Columns)) * int64(40)
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Subquery *vitess.io/vitess/go/vt/sqlparser.Subquery
+	size += cached.Subquery.CachedSize(true)
+	return size
+}
 func (cached *ComparisonExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -720,13 +741,41 @@ func (cached *ForeignKeyDefinition) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *FrameClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Start *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.Start.CachedSize(true)
+	// field End *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.End.CachedSize(true)
+	return size
+}
+func (cached *FramePoint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(88)
+		size += int64(96)
 	}
 	// field Qualifier vitess.io/vitess/go/vt/sqlparser.TableIdent
 	size += cached.Qualifier.CachedSize(false)
@@ -741,6 +790,8 @@ func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 			}
 		}
 	}
+	// field Over *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.Over.CachedSize(true)
 	return size
 }
 func (cached *GroupConcatExpr) CachedSize(alloc bool) int64 {
@@ -1111,6 +1162,20 @@ func (cached *OrderByOption) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *OverClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field WindowName vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.WindowName.CachedSize(false)
+	// field WindowSpec *vitess.io/vitess/go/vt/sqlparser.WindowSpecification
+	size += cached.WindowSpec.CachedSize(true)
+	return size
+}
 func (cached *ParenSelect) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1289,8 +1354,10 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(208)
 	}
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
 	// field Cache *bool
 	size += int64(1)
 	// field Comments vitess.io/vitess/go/vt/sqlparser.Comments
@@ -1331,6 +1398,13 @@ func (cached *Select) CachedSize(alloc bool) int64 {
 	}
 	// field Having *vitess.io/vitess/go/vt/sqlparser.Where
 	size += cached.Having.CachedSize(true)
+	// field Windows vitess.io/vitess/go/vt/sqlparser.WindowDefinitions
+	{
+		size += int64(cap(cached.Windows)) * int64(8)
+		for _, elem := range cached.Windows {
+			size += elem.CachedSize(true)
+		}
+	}
 	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
 	{
 		size += int64(cap(cached.OrderBy)) * int64(8)
@@ -1565,8 +1639,10 @@ func (cached *Union) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(73)
+		size += int64(81)
 	}
+	// field With *vitess.io/vitess/go/vt/sqlparser.With
+	size += cached.With.CachedSize(true)
 	// field FirstStatement vitess.io/vitess/go/vt/sqlparser.SelectStatement
 	if cc, ok := cached.FirstStatement.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -1692,6 +1768,67 @@ func (cached *Where) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *WindowDefinition) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field WindowSpec *vitess.io/vitess/go/vt/sqlparser.WindowSpecification
+	size += cached.WindowSpec.CachedSize(true)
+	return size
+}
+func (cached *WindowSpecification) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field Name vitess.io/vitess/go/vt/sqlparser.ColIdent
+	size += cached.Name.CachedSize(false)
+	// field PartitionClause vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += int64(cap(cached.PartitionClause)) * int64(16)
+		for _, elem := range cached.PartitionClause {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field OrderClause vitess.io/vitess/go/vt/sqlparser.OrderBy
+	{
+		size += int64(cap(cached.OrderClause)) * int64(8)
+		for _, elem := range cached.OrderClause {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field FrameClause *vitess.io/vitess/go/vt/sqlparser.FrameClause
+	size += cached.FrameClause.CachedSize(true)
+	return size
+}
+func (cached *With) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(25)
+	}
+	// field Ctes []*vitess.io/vitess/go/vt/sqlparser.CommonTableExpr
+	{
+		size += int64(cap(cached.Ctes)) * int64(8)
+		for _, elem := range cached.Ctes {
+			size += elem.CachedSize(true)
+		}
+	}
+	return size
+}
 func (cached *XorExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
@@ -36,6 +37,9 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectiveQueryTag tags a query with the workload it belongs to. It is
+	// read from the margin comments of a query, before it is parsed.
+	DirectiveQueryTag = "QUERY_TAG"
 )
 
 func isNonSpace(r rune) bool {
@@ -344,3 +348,61 @@ func IgnoreMaxMaxMemoryRowsDirective(stmt Statement) bool {
 		return false
 	}
 }
+
+// QueryTag returns the tag of a query that is set with the query tag
+// directive in its margin comments, or "" if it is not set:
+//
+//     /*vt+ QUERY_TAG=reporting */ select ...
+//
+func QueryTag(comments MarginComments) string {
+	var directives Comments
+	for _, text := range []string{comments.Leading, comments.Trailing} {
+		for {
+			start := strings.Index(text, commentDirectivePreamble)
+			if start == -1 {
+				break
+			}
+			end := strings.Index(text[start:], "*/")
+			if end == -1 {
+				break
+			}
+			end += start + 2
+			directives = append(directives, []byte(text[start:end]))
+			text = text[end:]
+		}
+	}
+	switch tag := ExtractCommentDirectives(directives)[DirectiveQueryTag].(type) {
+	case string:
+		return tag
+	case int:
+		return strconv.Itoa(tag)
+	}
+	return ""
+}
+
+const optimizerHintPreamble = "/*+"
+
+var maxExecutionTimeHint = regexp.MustCompile(`(?i)\bMAX_EXECUTION_TIME\s*\(\s*(\d+)\s*\)`)
+
+// MaxExecutionTime returns the timeout in milliseconds that is set with the
+// MAX_EXECUTION_TIME optimizer hint in the comments of a select, or 0 if it
+// is not set:
+//
+//     select /*+ MAX_EXECUTION_TIME(1000) */ * from users
+//
+func MaxExecutionTime(comments Comments) int {
+	for _, comment := range comments {
+		if !strings.HasPrefix(string(comment), optimizerHintPreamble) {
+			continue
+		}
+		match := maxExecutionTimeHint.FindSubmatch(comment)
+		if match == nil {
+			continue
+		}
+		timeout, err := strconv.Atoi(string(match[1]))
+		if err == nil {
+			return timeout
+		}
+	}
+	return 0
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSplitComments(t *testing.T) {
@@ -440,3 +441,46 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryTag(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"/*vt+ QUERY_TAG=reporting */ select * from users", "reporting"},
+		{"select * from users /* trailing */ /*vt+ QUERY_TAG=batch */", "batch"},
+		{"/* leading */ /*vt+ SKIP_QUERY_PLAN_CACHE=1 QUERY_TAG=42 */ select * from users", "42"},
+		{"/*vt+ QUERY_TAG */ select * from users", ""},
+		{"select /*vt+ QUERY_TAG=reporting */ * from users", ""},
+		{"select * from users", ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			_, comments := SplitMarginComments(test.query)
+			assert.Equal(t, test.expected, QueryTag(comments))
+		})
+	}
+}
+
+func TestMaxExecutionTime(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected int
+	}{
+		{"select /*+ MAX_EXECUTION_TIME(1000) */ * from users", 1000},
+		{"select /*+ BKA(users) max_execution_time( 50 ) */ * from users", 50},
+		{"select /* MAX_EXECUTION_TIME(1000) */ * from users", 0},
+		{"select /*vt+ QUERY_TIMEOUT_MS=1000 */ * from users", 0},
+		{"select /*+ MAX_EXECUTION_TIME(-1) */ * from users", 0},
+		{"select * from users", 0},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, MaxExecutionTime(stmt.(*Select).Comments))
+		})
+	}
+}
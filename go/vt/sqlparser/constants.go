@@ -181,6 +181,17 @@ const (
 	AscScr  = "asc"
 	DescScr = "desc"
 
+	// FrameClause.Unit
+	FrameRowsStr  = "rows"
+	FrameRangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	ExprPrecedingStr      = "preceding"
+	ExprFollowingStr      = "following"
+
 	// SetExpr.Expr, for SET TRANSACTION ... or START TRANSACTION
 	// TransactionStr is the Name for a SET TRANSACTION statement
 	TransactionStr = "transaction"
@@ -382,6 +393,21 @@ const (
 	DescOrder
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	FrameRowsType FrameUnitType = iota
+	FrameRangeType
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constant for Enum Type - ConvertTypeOperator
 const (
 	NoOperator ConvertTypeOperator = iota
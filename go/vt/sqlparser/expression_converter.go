@@ -18,6 +18,8 @@ package sqlparser
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
@@ -25,8 +27,64 @@ import (
 // ErrExprNotSupported signals that the expression cannot be handled by expression evaluation engine.
 var ErrExprNotSupported = fmt.Errorf("Expr Not Supported")
 
+// ColumnResolver returns the offset of a column in the rows an expression is evaluated on.
+type ColumnResolver func(col *ColName) (int, error)
+
+// ExprResolver is called for every node of an expression before it's converted.
+// It returns the executable expression that replaces the node, or nil if
+// the node must be converted normally.
+type ExprResolver func(e Expr) (evalengine.Expr, error)
+
 //Convert converts between AST expressions and executable expressions
 func Convert(e Expr) (evalengine.Expr, error) {
+	return ConvertWithColumns(e, nil)
+}
+
+//ConvertWithColumns converts between AST expressions and executable expressions.
+//Columns are evaluated from the row at the offset returned by the resolver.
+//Without a resolver, expressions referencing columns are not supported.
+func ConvertWithColumns(e Expr, resolve ColumnResolver) (evalengine.Expr, error) {
+	if resolve == nil {
+		return ConvertWithResolver(e, nil)
+	}
+	return ConvertWithResolver(e, func(e Expr) (evalengine.Expr, error) {
+		col, ok := e.(*ColName)
+		if !ok {
+			return nil, nil
+		}
+		offset, err := resolve(col)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewColumn(offset), nil
+	})
+}
+
+//ConvertWithResolver converts between AST expressions and executable expressions,
+//letting the resolver replace any node of the expression. Columns are only supported
+//if the resolver replaces them.
+func ConvertWithResolver(e Expr, resolve ExprResolver) (evalengine.Expr, error) {
+	if resolve != nil {
+		expr, err := resolve(e)
+		if err != nil || expr != nil {
+			return expr, err
+		}
+	}
+	convert := func(e Expr) (evalengine.Expr, error) {
+		return ConvertWithResolver(e, resolve)
+	}
+	convertAll := func(exprs []Expr) ([]evalengine.Expr, error) {
+		var result []evalengine.Expr
+		for _, expr := range exprs {
+			evalExpr, err := convert(expr)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, evalExpr)
+		}
+		return result, nil
+	}
+
 	switch node := e.(type) {
 	case Argument:
 		return evalengine.NewBindVar(string(node[1:])), nil
@@ -35,7 +93,12 @@ func Convert(e Expr) (evalengine.Expr, error) {
 		case IntVal:
 			return evalengine.NewLiteralIntFromBytes(node.Val)
 		case FloatVal:
-			return evalengine.NewLiteralFloat(node.Val)
+			// The numbers with an exponent are doubles,
+			// the other ones are exact DECIMALs.
+			if strings.ContainsAny(string(node.Val), "eE") {
+				return evalengine.NewLiteralFloat(node.Val)
+			}
+			return evalengine.NewLiteralDecimal(node.Val)
 		case StrVal:
 			return evalengine.NewLiteralString(node.Val), nil
 		}
@@ -44,7 +107,17 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			return evalengine.NewLiteralIntFromBytes([]byte("1"))
 		}
 		return evalengine.NewLiteralIntFromBytes([]byte("0"))
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
+	case *ColName:
+		return nil, ErrExprNotSupported
 	case *BinaryExpr:
+		if interval, ok := node.Right.(*IntervalExpr); ok && (node.Operator == PlusOp || node.Operator == MinusOp) {
+			return convertDateArithmetic(node.Left, interval, node.Operator == MinusOp, resolve)
+		}
+		if interval, ok := node.Left.(*IntervalExpr); ok && node.Operator == PlusOp {
+			return convertDateArithmetic(node.Right, interval, false, resolve)
+		}
 		var op evalengine.BinaryExpr
 		switch node.Operator {
 		case PlusOp:
@@ -55,23 +128,258 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			op = &evalengine.Multiplication{}
 		case DivOp:
 			op = &evalengine.Division{}
+		case IntDivOp:
+			op = &evalengine.IntegerDivision{}
+		case ModOp:
+			op = &evalengine.Modulo{}
 		default:
 			return nil, ErrExprNotSupported
 		}
-		left, err := Convert(node.Left)
+		return convertBinaryOp(op, node.Left, node.Right, resolve)
+	case *ComparisonExpr:
+		var op evalengine.BinaryExpr
+		switch node.Operator {
+		case EqualOp:
+			op = &evalengine.Equals{}
+		case NotEqualOp:
+			op = &evalengine.NotEquals{}
+		case NullSafeEqualOp:
+			op = &evalengine.NullSafeEquals{}
+		case LessThanOp:
+			op = &evalengine.LessThan{}
+		case LessEqualOp:
+			op = &evalengine.LessThanOrEqual{}
+		case GreaterThanOp:
+			op = &evalengine.GreaterThan{}
+		case GreaterEqualOp:
+			op = &evalengine.GreaterThanOrEqual{}
+		case InOp, NotInOp:
+			tuple, ok := node.Right.(ValTuple)
+			if !ok {
+				return nil, ErrExprNotSupported
+			}
+			left, err := convert(node.Left)
+			if err != nil {
+				return nil, err
+			}
+			right, err := convertAll(tuple)
+			if err != nil {
+				return nil, err
+			}
+			return &evalengine.InExpr{Left: left, Right: right, Negate: node.Operator == NotInOp}, nil
+		case LikeOp, NotLikeOp:
+			like := &evalengine.LikeExpr{Negate: node.Operator == NotLikeOp}
+			var err error
+			if like.Left, err = convert(node.Left); err != nil {
+				return nil, err
+			}
+			if like.Pattern, err = convert(node.Right); err != nil {
+				return nil, err
+			}
+			if node.Escape != nil {
+				if like.Escape, err = convert(node.Escape); err != nil {
+					return nil, err
+				}
+			}
+			return like, nil
+		default:
+			return nil, ErrExprNotSupported
+		}
+		return convertBinaryOp(op, node.Left, node.Right, resolve)
+	case *RangeCond:
+		// BETWEEN is evaluated as two comparisons, which gives the same result,
+		// NULL included: a NOT BETWEEN b AND c is a < b OR a > c.
+		from := &ComparisonExpr{Operator: GreaterEqualOp, Left: node.Left, Right: node.From}
+		to := &ComparisonExpr{Operator: LessEqualOp, Left: node.Left, Right: node.To}
+		if node.Operator == NotBetweenOp {
+			from.Operator, to.Operator = LessThanOp, GreaterThanOp
+			return convert(&OrExpr{Left: from, Right: to})
+		}
+		return convert(&AndExpr{Left: from, Right: to})
+	case *AndExpr:
+		return convertBinaryOp(&evalengine.And{}, node.Left, node.Right, resolve)
+	case *OrExpr:
+		return convertBinaryOp(&evalengine.Or{}, node.Left, node.Right, resolve)
+	case *XorExpr:
+		return convertBinaryOp(&evalengine.Xor{}, node.Left, node.Right, resolve)
+	case *NotExpr:
+		inner, err := convert(node.Expr)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Convert(node.Right)
+		return &evalengine.NotExpr{Expr: inner}, nil
+	case *IsExpr:
+		inner, err := convert(node.Expr)
 		if err != nil {
 			return nil, err
 		}
-		return &evalengine.BinaryOp{
-			Expr:  op,
-			Left:  left,
-			Right: right,
-		}, nil
-
+		ops := map[IsExprOperator]evalengine.IsOp{
+			IsNullOp:     evalengine.IsNull,
+			IsNotNullOp:  evalengine.IsNotNull,
+			IsTrueOp:     evalengine.IsTrue,
+			IsNotTrueOp:  evalengine.IsNotTrue,
+			IsFalseOp:    evalengine.IsFalse,
+			IsNotFalseOp: evalengine.IsNotFalse,
+		}
+		return &evalengine.IsExpr{Expr: inner, Op: ops[node.Operator]}, nil
+	case *UnaryExpr:
+		inner, err := convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		switch node.Operator {
+		case UPlusOp:
+			return inner, nil
+		case UMinusOp:
+			return &evalengine.NegateExpr{Expr: inner}, nil
+		case BangOp:
+			return &evalengine.NotExpr{Expr: inner}, nil
+		case BinaryOp:
+			return &evalengine.CollateExpr{Expr: inner, Collation: evalengine.CollationBinary}, nil
+		}
+	case *CollateExpr:
+		collation, ok := evalengine.CollationFromName(node.Charset)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		inner, err := convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.CollateExpr{Expr: inner, Collation: collation}, nil
+	case *CaseExpr:
+		caseExpr := &evalengine.CaseExpr{}
+		var err error
+		if node.Expr != nil {
+			if caseExpr.Base, err = convert(node.Expr); err != nil {
+				return nil, err
+			}
+		}
+		for _, when := range node.Whens {
+			cond, err := convert(when.Cond)
+			if err != nil {
+				return nil, err
+			}
+			val, err := convert(when.Val)
+			if err != nil {
+				return nil, err
+			}
+			caseExpr.Whens = append(caseExpr.Whens, evalengine.When{Cond: cond, Val: val})
+		}
+		if node.Else != nil {
+			if caseExpr.Else, err = convert(node.Else); err != nil {
+				return nil, err
+			}
+		}
+		return caseExpr, nil
+	case *ConvertExpr:
+		if node.Type.Scale != nil || node.Type.Charset != "" {
+			return nil, ErrExprNotSupported
+		}
+		length := -1
+		if node.Type.Length != nil {
+			l, err := strconv.Atoi(string(node.Type.Length.Val))
+			if err != nil {
+				return nil, ErrExprNotSupported
+			}
+			length = l
+		}
+		inner, err := convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		convertExpr, err := evalengine.NewConvertExpr(inner, node.Type.Type, length)
+		if err != nil {
+			return nil, ErrExprNotSupported
+		}
+		return convertExpr, nil
+	case *SubstrExpr:
+		var str Expr = node.StrVal
+		if node.Name != nil {
+			str = node.Name
+		}
+		args := []Expr{str, node.From}
+		if node.To != nil {
+			args = append(args, node.To)
+		}
+		return convert(&FuncExpr{Name: NewColIdent("substring"), Exprs: selectExprs(args)})
+	case *FuncExpr:
+		if !node.Qualifier.IsEmpty() || node.Distinct || node.Over != nil {
+			return nil, ErrExprNotSupported
+		}
+		var args []Expr
+		for _, expr := range node.Exprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				return nil, ErrExprNotSupported
+			}
+			args = append(args, aliased.Expr)
+		}
+		name := node.Name.Lowered()
+		switch name {
+		case "date_add", "date_sub", "adddate", "subdate":
+			if len(args) != 2 {
+				return nil, ErrExprNotSupported
+			}
+			interval, ok := args[1].(*IntervalExpr)
+			if !ok {
+				return nil, ErrExprNotSupported
+			}
+			return convertDateArithmetic(args[0], interval, strings.HasSuffix(name, "sub"), resolve)
+		}
+		if !evalengine.IsBuiltin(name) {
+			return nil, ErrExprNotSupported
+		}
+		evalArgs, err := convertAll(args)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewCallExpr(name, evalArgs)
 	}
 	return nil, ErrExprNotSupported
 }
+
+func convertBinaryOp(op evalengine.BinaryExpr, l, r Expr, resolve ExprResolver) (evalengine.Expr, error) {
+	left, err := ConvertWithResolver(l, resolve)
+	if err != nil {
+		return nil, err
+	}
+	right, err := ConvertWithResolver(r, resolve)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.BinaryOp{
+		Expr:  op,
+		Left:  left,
+		Right: right,
+	}, nil
+}
+
+func convertDateArithmetic(date Expr, interval *IntervalExpr, subtract bool, resolve ExprResolver) (evalengine.Expr, error) {
+	unit, err := evalengine.NewIntervalUnit(interval.Unit)
+	if err != nil {
+		return nil, ErrExprNotSupported
+	}
+	evalDate, err := ConvertWithResolver(date, resolve)
+	if err != nil {
+		return nil, err
+	}
+	evalInterval, err := ConvertWithResolver(interval.Expr, resolve)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.DateArithmetic{
+		Date:     evalDate,
+		Interval: evalInterval,
+		Unit:     unit,
+		Subtract: subtract,
+	}, nil
+}
+
+func selectExprs(exprs []Expr) SelectExprs {
+	var result SelectExprs
+	for _, expr := range exprs {
+		result = append(result, &AliasedExpr{Expr: expr})
+	}
+	return result
+}
@@ -45,7 +45,7 @@ func TestEvaluate(t *testing.T) {
 		expected:   sqltypes.NewInt64(42),
 	}, {
 		expression: "42.42",
-		expected:   sqltypes.NewFloat64(42.42),
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("42.42")),
 	}, {
 		expression: "40+2",
 		expected:   sqltypes.NewInt64(42),
@@ -57,7 +57,7 @@ func TestEvaluate(t *testing.T) {
 		expected:   sqltypes.NewInt64(80),
 	}, {
 		expression: "40/2",
-		expected:   sqltypes.NewFloat64(20),
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("20.0000")),
 	}, {
 		expression: ":exp",
 		expected:   sqltypes.NewInt64(66),
@@ -70,6 +70,18 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: ":exp + null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: ":exp > 42",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":string_bind_variable in ('foo', 'BAR')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "case when :exp % 2 = 0 then 'even' else 'odd' end",
+		expected:   sqltypes.NewVarBinary("even"),
 	}}
 
 	for _, test := range tests {
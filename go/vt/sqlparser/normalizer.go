@@ -97,6 +97,9 @@ func (nz *normalizer) WalkSelect(cursor *Cursor) bool {
 	case *ConvertType:
 		// we should not rewrite the type description
 		return false
+	case *FrameClause:
+		// window frame offsets must be literals
+		return false
 	}
 	return true
 }
@@ -154,6 +154,13 @@ func TestNormalize(t *testing.T) {
 		in:      "select a, b from t order by c asc",
 		outstmt: "select a, b from t order by c asc",
 		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// Window frame offsets
+		in:      "select lag(a, 2) over (order by b rows between 1 preceding and current row) from t",
+		outstmt: "select lag(a, :bv1) over (order by b asc rows between 1 preceding and current row) from t",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(2),
+		},
 	}, {
 		// Values up to len 256 will reuse.
 		in:      fmt.Sprintf("select * from t where v1 = '%256s' and v2 = '%256s'", "a", "a"),
@@ -147,6 +147,47 @@ var (
 		input: "select * from t1 where exists (select a from t2 union select b from t3)",
 	}, {
 		input: "select 1 from dual union select 2 from dual union all select 3 from dual union select 4 from dual union all select 5 from dual",
+	}, {
+		input: "with t as (select 1 from dual) select * from t",
+	}, {
+		input:  "WITH t1 AS (SELECT a FROM t2), t3 AS (SELECT b FROM t1) SELECT a, b FROM t1 JOIN t3",
+		output: "with t1 as (select a from t2), t3 as (select b from t1) select a, b from t1 join t3",
+	}, {
+		input: "with t(a, b) as (select 1, 2 from dual) select a, b from t",
+	}, {
+		input: "with recursive cte(n) as (select 1 from dual union all select n + 1 from cte where n < 5) select n from cte",
+	}, {
+		input: "with t as (select a from t1) select a from t union select a from t2 order by a asc limit 1",
+	}, {
+		input: "select * from t1 where a in (with t as (select a from t2) select a from t)",
+	}, {
+		input: "select * from (with t as (select a from t2) select a from t) as x",
+	}, {
+		input: "select row_number() over () from t",
+	}, {
+		input:  "select a, rank() OVER (PARTITION BY b ORDER BY c DESC) from t",
+		output: "select a, rank() over (partition by b order by c desc) from t",
+	}, {
+		input:  "select sum(a) over (order by b rows between unbounded preceding and current row) from t",
+		output: "select sum(a) over (order by b asc rows between unbounded preceding and current row) from t",
+	}, {
+		input:  "select avg(a) over (partition by b, c order by d rows between 2 preceding and 1 following) from t",
+		output: "select avg(a) over (partition by b, c order by d asc rows between 2 preceding and 1 following) from t",
+	}, {
+		input:  "select count(*) over (order by d range interval 1 day preceding) from t",
+		output: "select count(*) over (order by d asc range interval 1 day preceding) from t",
+	}, {
+		input: "select max(a) over (rows unbounded following) from t",
+	}, {
+		input: "select lag(a, 1, 0) over w, lead(a) over w from t window w as (partition by b order by c asc)",
+	}, {
+		input:  "select first_value(a) over (w order by c), ntile(4) over w2 from t window w as (partition by b), w2 as (w order by c)",
+		output: "select first_value(a) over (w order by c asc), ntile(4) over w2 from t window w as (partition by b), w2 as (w order by c asc)",
+	}, {
+		input: "select a, count(*) from t group by a having count(*) > 1 window w as (partition by a) order by a asc",
+	}, {
+		input:  "select row, `rows`, current, unbounded, preceding, following from t where rows > 70",
+		output: "select `row`, `rows`, `current`, `unbounded`, `preceding`, `following` from t where `rows` > 70",
 	}, {
 		input: "(select 1 from dual) order by 1 asc limit 2",
 	}, {
@@ -1427,6 +1468,14 @@ var (
 	}, {
 		input:  "show table status from dbname Where col=42 ",
 		output: "show table status from dbname where col = 42",
+	}, {
+		// ROWS, ROW and CURRENT are non-reserved keywords of the window
+		// frames, so they are escaped when used as identifiers.
+		input:  "show table status where Rows > 70",
+		output: "show table status where `Rows` > 70",
+	}, {
+		input:  "select current, row, rows from t",
+		output: "select `current`, `row`, `rows` from t",
 	}, {
 		input: "show tables",
 	}, {
@@ -242,6 +242,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
+func replaceCommonTableExprTableID(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).TableID = newNode.(TableIdent)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -400,6 +412,18 @@ func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
 	parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 }
 
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	parent.(*FrameClause).End = newNode.(*FramePoint)
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	parent.(*FrameClause).Start = newNode.(*FramePoint)
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	parent.(*FramePoint).Expr = newNode.(Expr)
+}
+
 func replaceFuncExprExprs(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 }
@@ -408,6 +432,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -587,6 +615,14 @@ func replaceOrderByOptionCols(newNode, parent SQLNode) {
 	parent.(*OrderByOption).Cols = newNode.(Columns)
 }
 
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowName = newNode.(ColIdent)
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowSpec = newNode.(*WindowSpecification)
+}
+
 func replaceParenSelectSelect(newNode, parent SQLNode) {
 	parent.(*ParenSelect).Select = newNode.(SelectStatement)
 }
@@ -699,6 +735,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(WindowDefinitions)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(*With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -923,6 +967,10 @@ func (r *replaceUnionUnionSelects) inc() {
 	*r++
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(*With)
+}
+
 func replaceUnionSelectStatement(newNode, parent SQLNode) {
 	parent.(*UnionSelect).Statement = newNode.(SelectStatement)
 }
@@ -1052,6 +1100,50 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowDefinitionName(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).Name = newNode.(ColIdent)
+}
+
+func replaceWindowDefinitionWindowSpec(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).WindowSpec = newNode.(*WindowSpecification)
+}
+
+type replaceWindowDefinitionsItems int
+
+func (r *replaceWindowDefinitionsItems) replace(newNode, container SQLNode) {
+	container.(WindowDefinitions)[int(*r)] = newNode.(*WindowDefinition)
+}
+
+func (r *replaceWindowDefinitionsItems) inc() {
+	*r++
+}
+
+func replaceWindowSpecificationFrameClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).FrameClause = newNode.(*FrameClause)
+}
+
+func replaceWindowSpecificationName(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecificationOrderClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).OrderClause = newNode.(OrderBy)
+}
+
+func replaceWindowSpecificationPartitionClause(newNode, parent SQLNode) {
+	parent.(*WindowSpecification).PartitionClause = newNode.(Exprs)
+}
+
+type replaceWithCtes int
+
+func (r *replaceWithCtes) replace(newNode, container SQLNode) {
+	container.(*With).Ctes[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithCtes) inc() {
+	*r++
+}
+
 func replaceXorExprLeft(newNode, parent SQLNode) {
 	parent.(*XorExpr).Left = newNode.(Expr)
 }
@@ -1218,6 +1310,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+		a.apply(node, n.TableID, replaceCommonTableExprTableID)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -1309,9 +1406,17 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
 		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
 
+	case *FrameClause:
+		a.apply(node, n.End, replaceFrameClauseEnd)
+		a.apply(node, n.Start, replaceFrameClauseStart)
+
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1433,6 +1538,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+
 	case *ParenSelect:
 		a.apply(node, n.Select, replaceParenSelectSelect)
 
@@ -1497,6 +1606,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1638,6 +1749,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			a.apply(node, item, replacerUnionSelectsB.replace)
 			replacerUnionSelectsB.inc()
 		}
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *UnionSelect:
 		a.apply(node, n.Statement, replaceUnionSelectStatement)
@@ -1715,6 +1827,32 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowDefinition:
+		a.apply(node, n.Name, replaceWindowDefinitionName)
+		a.apply(node, n.WindowSpec, replaceWindowDefinitionWindowSpec)
+
+	case WindowDefinitions:
+		replacer := replaceWindowDefinitionsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
+	case *WindowSpecification:
+		a.apply(node, n.FrameClause, replaceWindowSpecificationFrameClause)
+		a.apply(node, n.Name, replaceWindowSpecificationName)
+		a.apply(node, n.OrderClause, replaceWindowSpecificationOrderClause)
+		a.apply(node, n.PartitionClause, replaceWindowSpecificationPartitionClause)
+
+	case *With:
+		replacerCtes := replaceWithCtes(0)
+		replacerCtesB := &replacerCtes
+		for _, item := range n.Ctes {
+			a.apply(node, item, replacerCtesB.replace)
+			replacerCtesB.inc()
+		}
+
 	case *XorExpr:
 		a.apply(node, n.Left, replaceXorExprLeft)
 		a.apply(node, n.Right, replaceXorExprRight)
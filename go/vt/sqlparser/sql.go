@@ -136,6 +136,16 @@ type yySymType struct {
 	tableOption            *TableOption
 	tableOptions           TableOptions
 	renameTablePairs       []*RenameTablePair
+	with                   *With
+	cte                    *CommonTableExpr
+	ctes                   []*CommonTableExpr
+	overClause             *OverClause
+	windowSpec             *WindowSpecification
+	windowDef              *WindowDefinition
+	windowDefs             WindowDefinitions
+	frameClause            *FrameClause
+	frameUnit              FrameUnitType
+	framePoint             *FramePoint
 }
 
 const LEX_ERROR = 57346
@@ -215,371 +225,376 @@ const ALGORITHM = 57419
 const NONE = 57420
 const SHARED = 57421
 const EXCLUSIVE = 57422
-const ID = 57423
-const AT_ID = 57424
-const AT_AT_ID = 57425
-const HEX = 57426
-const STRING = 57427
-const INTEGRAL = 57428
-const FLOAT = 57429
-const HEXNUM = 57430
-const VALUE_ARG = 57431
-const LIST_ARG = 57432
-const COMMENT = 57433
-const COMMENT_KEYWORD = 57434
-const BIT_LITERAL = 57435
-const COMPRESSION = 57436
-const NULL = 57437
-const TRUE = 57438
-const FALSE = 57439
-const OFF = 57440
-const DISCARD = 57441
-const IMPORT = 57442
-const ENABLE = 57443
-const DISABLE = 57444
-const TABLESPACE = 57445
-const OR = 57446
-const XOR = 57447
-const AND = 57448
-const NOT = 57449
-const BETWEEN = 57450
-const CASE = 57451
-const WHEN = 57452
-const THEN = 57453
-const ELSE = 57454
-const END = 57455
-const LE = 57456
-const GE = 57457
-const NE = 57458
-const NULL_SAFE_EQUAL = 57459
-const IS = 57460
-const LIKE = 57461
-const REGEXP = 57462
-const IN = 57463
-const SHIFT_LEFT = 57464
-const SHIFT_RIGHT = 57465
-const DIV = 57466
-const MOD = 57467
-const UNARY = 57468
-const COLLATE = 57469
-const BINARY = 57470
-const UNDERSCORE_BINARY = 57471
-const UNDERSCORE_UTF8MB4 = 57472
-const UNDERSCORE_UTF8 = 57473
-const UNDERSCORE_LATIN1 = 57474
-const INTERVAL = 57475
-const JSON_EXTRACT_OP = 57476
-const JSON_UNQUOTE_EXTRACT_OP = 57477
-const CREATE = 57478
-const ALTER = 57479
-const DROP = 57480
-const RENAME = 57481
-const ANALYZE = 57482
-const ADD = 57483
-const FLUSH = 57484
-const CHANGE = 57485
-const MODIFY = 57486
-const SCHEMA = 57487
-const TABLE = 57488
-const INDEX = 57489
-const VIEW = 57490
-const TO = 57491
-const IGNORE = 57492
-const IF = 57493
-const UNIQUE = 57494
-const PRIMARY = 57495
-const COLUMN = 57496
-const SPATIAL = 57497
-const FULLTEXT = 57498
-const KEY_BLOCK_SIZE = 57499
-const CHECK = 57500
-const INDEXES = 57501
-const ACTION = 57502
-const CASCADE = 57503
-const CONSTRAINT = 57504
-const FOREIGN = 57505
-const NO = 57506
-const REFERENCES = 57507
-const RESTRICT = 57508
-const SHOW = 57509
-const DESCRIBE = 57510
-const EXPLAIN = 57511
-const DATE = 57512
-const ESCAPE = 57513
-const REPAIR = 57514
-const OPTIMIZE = 57515
-const TRUNCATE = 57516
-const COALESCE = 57517
-const EXCHANGE = 57518
-const REBUILD = 57519
-const PARTITIONING = 57520
-const REMOVE = 57521
-const MAXVALUE = 57522
-const PARTITION = 57523
-const REORGANIZE = 57524
-const LESS = 57525
-const THAN = 57526
-const PROCEDURE = 57527
-const TRIGGER = 57528
-const VINDEX = 57529
-const VINDEXES = 57530
-const DIRECTORY = 57531
-const NAME = 57532
-const UPGRADE = 57533
-const STATUS = 57534
-const VARIABLES = 57535
-const WARNINGS = 57536
-const CASCADED = 57537
-const DEFINER = 57538
-const OPTION = 57539
-const SQL = 57540
-const UNDEFINED = 57541
-const SEQUENCE = 57542
-const MERGE = 57543
-const TEMPTABLE = 57544
-const INVOKER = 57545
-const SECURITY = 57546
-const FIRST = 57547
-const AFTER = 57548
-const LAST = 57549
-const BEGIN = 57550
-const START = 57551
-const TRANSACTION = 57552
-const COMMIT = 57553
-const ROLLBACK = 57554
-const SAVEPOINT = 57555
-const RELEASE = 57556
-const WORK = 57557
-const BIT = 57558
-const TINYINT = 57559
-const SMALLINT = 57560
-const MEDIUMINT = 57561
-const INT = 57562
-const INTEGER = 57563
-const BIGINT = 57564
-const INTNUM = 57565
-const REAL = 57566
-const DOUBLE = 57567
-const FLOAT_TYPE = 57568
-const DECIMAL = 57569
-const NUMERIC = 57570
-const TIME = 57571
-const TIMESTAMP = 57572
-const DATETIME = 57573
-const YEAR = 57574
-const CHAR = 57575
-const VARCHAR = 57576
-const BOOL = 57577
-const CHARACTER = 57578
-const VARBINARY = 57579
-const NCHAR = 57580
-const TEXT = 57581
-const TINYTEXT = 57582
-const MEDIUMTEXT = 57583
-const LONGTEXT = 57584
-const BLOB = 57585
-const TINYBLOB = 57586
-const MEDIUMBLOB = 57587
-const LONGBLOB = 57588
-const JSON = 57589
-const ENUM = 57590
-const GEOMETRY = 57591
-const POINT = 57592
-const LINESTRING = 57593
-const POLYGON = 57594
-const GEOMETRYCOLLECTION = 57595
-const MULTIPOINT = 57596
-const MULTILINESTRING = 57597
-const MULTIPOLYGON = 57598
-const NULLX = 57599
-const AUTO_INCREMENT = 57600
-const APPROXNUM = 57601
-const SIGNED = 57602
-const UNSIGNED = 57603
-const ZEROFILL = 57604
-const COLLATION = 57605
-const DATABASES = 57606
-const SCHEMAS = 57607
-const TABLES = 57608
-const VITESS_METADATA = 57609
-const VSCHEMA = 57610
-const FULL = 57611
-const PROCESSLIST = 57612
-const COLUMNS = 57613
-const FIELDS = 57614
-const ENGINES = 57615
-const PLUGINS = 57616
-const EXTENDED = 57617
-const KEYSPACES = 57618
-const VITESS_KEYSPACES = 57619
-const VITESS_SHARDS = 57620
-const VITESS_TABLETS = 57621
-const CODE = 57622
-const PRIVILEGES = 57623
-const FUNCTION = 57624
-const NAMES = 57625
-const CHARSET = 57626
-const GLOBAL = 57627
-const SESSION = 57628
-const ISOLATION = 57629
-const LEVEL = 57630
-const READ = 57631
-const WRITE = 57632
-const ONLY = 57633
-const REPEATABLE = 57634
-const COMMITTED = 57635
-const UNCOMMITTED = 57636
-const SERIALIZABLE = 57637
-const CURRENT_TIMESTAMP = 57638
-const DATABASE = 57639
-const CURRENT_DATE = 57640
-const CURRENT_TIME = 57641
-const LOCALTIME = 57642
-const LOCALTIMESTAMP = 57643
-const CURRENT_USER = 57644
-const UTC_DATE = 57645
-const UTC_TIME = 57646
-const UTC_TIMESTAMP = 57647
-const REPLACE = 57648
-const CONVERT = 57649
-const CAST = 57650
-const SUBSTR = 57651
-const SUBSTRING = 57652
-const GROUP_CONCAT = 57653
-const SEPARATOR = 57654
-const TIMESTAMPADD = 57655
-const TIMESTAMPDIFF = 57656
-const MATCH = 57657
-const AGAINST = 57658
-const BOOLEAN = 57659
-const LANGUAGE = 57660
-const WITH = 57661
-const QUERY = 57662
-const EXPANSION = 57663
-const WITHOUT = 57664
-const VALIDATION = 57665
-const UNUSED = 57666
-const ARRAY = 57667
-const CUME_DIST = 57668
-const DESCRIPTION = 57669
-const DENSE_RANK = 57670
-const EMPTY = 57671
-const EXCEPT = 57672
-const FIRST_VALUE = 57673
-const GROUPING = 57674
-const GROUPS = 57675
-const JSON_TABLE = 57676
-const LAG = 57677
-const LAST_VALUE = 57678
-const LATERAL = 57679
-const LEAD = 57680
-const MEMBER = 57681
-const NTH_VALUE = 57682
-const NTILE = 57683
-const OF = 57684
-const OVER = 57685
-const PERCENT_RANK = 57686
-const RANK = 57687
-const RECURSIVE = 57688
-const ROW_NUMBER = 57689
-const SYSTEM = 57690
-const WINDOW = 57691
-const ACTIVE = 57692
-const ADMIN = 57693
-const BUCKETS = 57694
-const CLONE = 57695
-const COMPONENT = 57696
-const DEFINITION = 57697
-const ENFORCED = 57698
-const EXCLUDE = 57699
-const FOLLOWING = 57700
-const GEOMCOLLECTION = 57701
-const GET_MASTER_PUBLIC_KEY = 57702
-const HISTOGRAM = 57703
-const HISTORY = 57704
-const INACTIVE = 57705
-const INVISIBLE = 57706
-const LOCKED = 57707
-const MASTER_COMPRESSION_ALGORITHMS = 57708
-const MASTER_PUBLIC_KEY_PATH = 57709
-const MASTER_TLS_CIPHERSUITES = 57710
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57711
-const NESTED = 57712
-const NETWORK_NAMESPACE = 57713
-const NOWAIT = 57714
-const NULLS = 57715
-const OJ = 57716
-const OLD = 57717
-const OPTIONAL = 57718
-const ORDINALITY = 57719
-const ORGANIZATION = 57720
-const OTHERS = 57721
-const PATH = 57722
-const PERSIST = 57723
-const PERSIST_ONLY = 57724
-const PRECEDING = 57725
-const PRIVILEGE_CHECKS_USER = 57726
-const PROCESS = 57727
-const RANDOM = 57728
-const REFERENCE = 57729
-const REQUIRE_ROW_FORMAT = 57730
-const RESOURCE = 57731
-const RESPECT = 57732
-const RESTART = 57733
-const RETAIN = 57734
-const REUSE = 57735
-const ROLE = 57736
-const SECONDARY = 57737
-const SECONDARY_ENGINE = 57738
-const SECONDARY_LOAD = 57739
-const SECONDARY_UNLOAD = 57740
-const SKIP = 57741
-const SRID = 57742
-const THREAD_PRIORITY = 57743
-const TIES = 57744
-const UNBOUNDED = 57745
-const VCPU = 57746
-const VISIBLE = 57747
-const FORMAT = 57748
-const TREE = 57749
-const VITESS = 57750
-const TRADITIONAL = 57751
-const LOCAL = 57752
-const LOW_PRIORITY = 57753
-const NO_WRITE_TO_BINLOG = 57754
-const LOGS = 57755
-const ERROR = 57756
-const GENERAL = 57757
-const HOSTS = 57758
-const OPTIMIZER_COSTS = 57759
-const USER_RESOURCES = 57760
-const SLOW = 57761
-const CHANNEL = 57762
-const RELAY = 57763
-const EXPORT = 57764
-const AVG_ROW_LENGTH = 57765
-const CONNECTION = 57766
-const CHECKSUM = 57767
-const DELAY_KEY_WRITE = 57768
-const ENCRYPTION = 57769
-const ENGINE = 57770
-const INSERT_METHOD = 57771
-const MAX_ROWS = 57772
-const MIN_ROWS = 57773
-const PACK_KEYS = 57774
-const PASSWORD = 57775
-const FIXED = 57776
-const DYNAMIC = 57777
-const COMPRESSED = 57778
-const REDUNDANT = 57779
-const COMPACT = 57780
-const ROW_FORMAT = 57781
-const STATS_AUTO_RECALC = 57782
-const STATS_PERSISTENT = 57783
-const STATS_SAMPLE_PAGES = 57784
-const STORAGE = 57785
-const MEMORY = 57786
-const DISK = 57787
+const ROWS = 57423
+const EMPTY_WINDOW_NAME = 57424
+const ID = 57425
+const AT_ID = 57426
+const AT_AT_ID = 57427
+const HEX = 57428
+const STRING = 57429
+const INTEGRAL = 57430
+const FLOAT = 57431
+const HEXNUM = 57432
+const VALUE_ARG = 57433
+const LIST_ARG = 57434
+const COMMENT = 57435
+const COMMENT_KEYWORD = 57436
+const BIT_LITERAL = 57437
+const COMPRESSION = 57438
+const NULL = 57439
+const TRUE = 57440
+const FALSE = 57441
+const OFF = 57442
+const DISCARD = 57443
+const IMPORT = 57444
+const ENABLE = 57445
+const DISABLE = 57446
+const TABLESPACE = 57447
+const OR = 57448
+const XOR = 57449
+const AND = 57450
+const NOT = 57451
+const BETWEEN = 57452
+const CASE = 57453
+const WHEN = 57454
+const THEN = 57455
+const ELSE = 57456
+const END = 57457
+const LE = 57458
+const GE = 57459
+const NE = 57460
+const NULL_SAFE_EQUAL = 57461
+const IS = 57462
+const LIKE = 57463
+const REGEXP = 57464
+const IN = 57465
+const SHIFT_LEFT = 57466
+const SHIFT_RIGHT = 57467
+const DIV = 57468
+const MOD = 57469
+const UNARY = 57470
+const COLLATE = 57471
+const BINARY = 57472
+const UNDERSCORE_BINARY = 57473
+const UNDERSCORE_UTF8MB4 = 57474
+const UNDERSCORE_UTF8 = 57475
+const UNDERSCORE_LATIN1 = 57476
+const INTERVAL = 57477
+const JSON_EXTRACT_OP = 57478
+const JSON_UNQUOTE_EXTRACT_OP = 57479
+const CREATE = 57480
+const ALTER = 57481
+const DROP = 57482
+const RENAME = 57483
+const ANALYZE = 57484
+const ADD = 57485
+const FLUSH = 57486
+const CHANGE = 57487
+const MODIFY = 57488
+const SCHEMA = 57489
+const TABLE = 57490
+const INDEX = 57491
+const VIEW = 57492
+const TO = 57493
+const IGNORE = 57494
+const IF = 57495
+const UNIQUE = 57496
+const PRIMARY = 57497
+const COLUMN = 57498
+const SPATIAL = 57499
+const FULLTEXT = 57500
+const KEY_BLOCK_SIZE = 57501
+const CHECK = 57502
+const INDEXES = 57503
+const ACTION = 57504
+const CASCADE = 57505
+const CONSTRAINT = 57506
+const FOREIGN = 57507
+const NO = 57508
+const REFERENCES = 57509
+const RESTRICT = 57510
+const SHOW = 57511
+const DESCRIBE = 57512
+const EXPLAIN = 57513
+const DATE = 57514
+const ESCAPE = 57515
+const REPAIR = 57516
+const OPTIMIZE = 57517
+const TRUNCATE = 57518
+const COALESCE = 57519
+const EXCHANGE = 57520
+const REBUILD = 57521
+const PARTITIONING = 57522
+const REMOVE = 57523
+const MAXVALUE = 57524
+const PARTITION = 57525
+const REORGANIZE = 57526
+const LESS = 57527
+const THAN = 57528
+const PROCEDURE = 57529
+const TRIGGER = 57530
+const VINDEX = 57531
+const VINDEXES = 57532
+const DIRECTORY = 57533
+const NAME = 57534
+const UPGRADE = 57535
+const STATUS = 57536
+const VARIABLES = 57537
+const WARNINGS = 57538
+const CASCADED = 57539
+const DEFINER = 57540
+const OPTION = 57541
+const SQL = 57542
+const UNDEFINED = 57543
+const SEQUENCE = 57544
+const MERGE = 57545
+const TEMPTABLE = 57546
+const INVOKER = 57547
+const SECURITY = 57548
+const FIRST = 57549
+const AFTER = 57550
+const LAST = 57551
+const BEGIN = 57552
+const START = 57553
+const TRANSACTION = 57554
+const COMMIT = 57555
+const ROLLBACK = 57556
+const SAVEPOINT = 57557
+const RELEASE = 57558
+const WORK = 57559
+const BIT = 57560
+const TINYINT = 57561
+const SMALLINT = 57562
+const MEDIUMINT = 57563
+const INT = 57564
+const INTEGER = 57565
+const BIGINT = 57566
+const INTNUM = 57567
+const REAL = 57568
+const DOUBLE = 57569
+const FLOAT_TYPE = 57570
+const DECIMAL = 57571
+const NUMERIC = 57572
+const TIME = 57573
+const TIMESTAMP = 57574
+const DATETIME = 57575
+const YEAR = 57576
+const CHAR = 57577
+const VARCHAR = 57578
+const BOOL = 57579
+const CHARACTER = 57580
+const VARBINARY = 57581
+const NCHAR = 57582
+const TEXT = 57583
+const TINYTEXT = 57584
+const MEDIUMTEXT = 57585
+const LONGTEXT = 57586
+const BLOB = 57587
+const TINYBLOB = 57588
+const MEDIUMBLOB = 57589
+const LONGBLOB = 57590
+const JSON = 57591
+const ENUM = 57592
+const GEOMETRY = 57593
+const POINT = 57594
+const LINESTRING = 57595
+const POLYGON = 57596
+const GEOMETRYCOLLECTION = 57597
+const MULTIPOINT = 57598
+const MULTILINESTRING = 57599
+const MULTIPOLYGON = 57600
+const NULLX = 57601
+const AUTO_INCREMENT = 57602
+const APPROXNUM = 57603
+const SIGNED = 57604
+const UNSIGNED = 57605
+const ZEROFILL = 57606
+const COLLATION = 57607
+const DATABASES = 57608
+const SCHEMAS = 57609
+const TABLES = 57610
+const VITESS_METADATA = 57611
+const VSCHEMA = 57612
+const FULL = 57613
+const PROCESSLIST = 57614
+const COLUMNS = 57615
+const FIELDS = 57616
+const ENGINES = 57617
+const PLUGINS = 57618
+const EXTENDED = 57619
+const KEYSPACES = 57620
+const VITESS_KEYSPACES = 57621
+const VITESS_SHARDS = 57622
+const VITESS_TABLETS = 57623
+const CODE = 57624
+const PRIVILEGES = 57625
+const FUNCTION = 57626
+const NAMES = 57627
+const CHARSET = 57628
+const GLOBAL = 57629
+const SESSION = 57630
+const ISOLATION = 57631
+const LEVEL = 57632
+const READ = 57633
+const WRITE = 57634
+const ONLY = 57635
+const REPEATABLE = 57636
+const COMMITTED = 57637
+const UNCOMMITTED = 57638
+const SERIALIZABLE = 57639
+const CURRENT_TIMESTAMP = 57640
+const DATABASE = 57641
+const CURRENT_DATE = 57642
+const CURRENT_TIME = 57643
+const LOCALTIME = 57644
+const LOCALTIMESTAMP = 57645
+const CURRENT_USER = 57646
+const UTC_DATE = 57647
+const UTC_TIME = 57648
+const UTC_TIMESTAMP = 57649
+const REPLACE = 57650
+const CONVERT = 57651
+const CAST = 57652
+const SUBSTR = 57653
+const SUBSTRING = 57654
+const GROUP_CONCAT = 57655
+const SEPARATOR = 57656
+const TIMESTAMPADD = 57657
+const TIMESTAMPDIFF = 57658
+const MATCH = 57659
+const AGAINST = 57660
+const BOOLEAN = 57661
+const LANGUAGE = 57662
+const WITH = 57663
+const QUERY = 57664
+const EXPANSION = 57665
+const WITHOUT = 57666
+const VALIDATION = 57667
+const UNUSED = 57668
+const ARRAY = 57669
+const CUME_DIST = 57670
+const DESCRIPTION = 57671
+const DENSE_RANK = 57672
+const EMPTY = 57673
+const EXCEPT = 57674
+const FIRST_VALUE = 57675
+const GROUPING = 57676
+const GROUPS = 57677
+const JSON_TABLE = 57678
+const LAG = 57679
+const LAST_VALUE = 57680
+const LATERAL = 57681
+const LEAD = 57682
+const MEMBER = 57683
+const NTH_VALUE = 57684
+const NTILE = 57685
+const OF = 57686
+const OVER = 57687
+const PERCENT_RANK = 57688
+const RANK = 57689
+const RECURSIVE = 57690
+const ROW_NUMBER = 57691
+const SYSTEM = 57692
+const WINDOW = 57693
+const ACTIVE = 57694
+const ADMIN = 57695
+const BUCKETS = 57696
+const CLONE = 57697
+const COMPONENT = 57698
+const DEFINITION = 57699
+const ENFORCED = 57700
+const EXCLUDE = 57701
+const FOLLOWING = 57702
+const GEOMCOLLECTION = 57703
+const GET_MASTER_PUBLIC_KEY = 57704
+const HISTOGRAM = 57705
+const HISTORY = 57706
+const INACTIVE = 57707
+const INVISIBLE = 57708
+const LOCKED = 57709
+const MASTER_COMPRESSION_ALGORITHMS = 57710
+const MASTER_PUBLIC_KEY_PATH = 57711
+const MASTER_TLS_CIPHERSUITES = 57712
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57713
+const NESTED = 57714
+const NETWORK_NAMESPACE = 57715
+const NOWAIT = 57716
+const NULLS = 57717
+const OJ = 57718
+const OLD = 57719
+const OPTIONAL = 57720
+const ORDINALITY = 57721
+const ORGANIZATION = 57722
+const OTHERS = 57723
+const PATH = 57724
+const PERSIST = 57725
+const PERSIST_ONLY = 57726
+const PRECEDING = 57727
+const PRIVILEGE_CHECKS_USER = 57728
+const PROCESS = 57729
+const RANDOM = 57730
+const REFERENCE = 57731
+const REQUIRE_ROW_FORMAT = 57732
+const RESOURCE = 57733
+const RESPECT = 57734
+const RESTART = 57735
+const RETAIN = 57736
+const REUSE = 57737
+const ROLE = 57738
+const SECONDARY = 57739
+const SECONDARY_ENGINE = 57740
+const SECONDARY_LOAD = 57741
+const SECONDARY_UNLOAD = 57742
+const SKIP = 57743
+const SRID = 57744
+const THREAD_PRIORITY = 57745
+const TIES = 57746
+const UNBOUNDED = 57747
+const VCPU = 57748
+const VISIBLE = 57749
+const CURRENT = 57750
+const ROW = 57751
+const RANGE = 57752
+const FORMAT = 57753
+const TREE = 57754
+const VITESS = 57755
+const TRADITIONAL = 57756
+const LOCAL = 57757
+const LOW_PRIORITY = 57758
+const NO_WRITE_TO_BINLOG = 57759
+const LOGS = 57760
+const ERROR = 57761
+const GENERAL = 57762
+const HOSTS = 57763
+const OPTIMIZER_COSTS = 57764
+const USER_RESOURCES = 57765
+const SLOW = 57766
+const CHANNEL = 57767
+const RELAY = 57768
+const EXPORT = 57769
+const AVG_ROW_LENGTH = 57770
+const CONNECTION = 57771
+const CHECKSUM = 57772
+const DELAY_KEY_WRITE = 57773
+const ENCRYPTION = 57774
+const ENGINE = 57775
+const INSERT_METHOD = 57776
+const MAX_ROWS = 57777
+const MIN_ROWS = 57778
+const PACK_KEYS = 57779
+const PASSWORD = 57780
+const FIXED = 57781
+const DYNAMIC = 57782
+const COMPRESSED = 57783
+const REDUNDANT = 57784
+const COMPACT = 57785
+const ROW_FORMAT = 57786
+const STATS_AUTO_RECALC = 57787
+const STATS_PERSISTENT = 57788
+const STATS_SAMPLE_PAGES = 57789
+const STORAGE = 57790
+const MEMORY = 57791
+const DISK = 57792
 
 var yyToknames = [...]string{
 	"$end",
@@ -662,6 +677,8 @@ var yyToknames = [...]string{
 	"NONE",
 	"SHARED",
 	"EXCLUSIVE",
+	"ROWS",
+	"EMPTY_WINDOW_NAME",
 	"'('",
 	"','",
 	"')'",
@@ -1004,6 +1021,9 @@ var yyToknames = [...]string{
 	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"CURRENT",
+	"ROW",
+	"RANGE",
 	"FORMAT",
 	"TREE",
 	"VITESS",
@@ -1058,2902 +1078,3011 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 44,
-	1, 111,
-	463, 111,
-	-2, 117,
 	-1, 45,
-	143, 117,
-	253, 117,
-	301, 117,
-	-2, 329,
-	-1, 52,
-	34, 468,
-	164, 468,
-	176, 468,
-	209, 482,
-	210, 482,
-	-2, 470,
-	-1, 57,
-	166, 492,
-	-2, 490,
-	-1, 82,
-	56, 559,
-	-2, 567,
-	-1, 106,
-	1, 112,
-	463, 112,
-	-2, 117,
-	-1, 116,
-	169, 234,
-	170, 234,
-	-2, 323,
-	-1, 135,
-	143, 117,
-	253, 117,
-	301, 117,
-	-2, 338,
-	-1, 562,
-	150, 945,
-	-2, 941,
-	-1, 563,
-	150, 946,
-	-2, 942,
-	-1, 581,
-	56, 560,
-	-2, 572,
-	-1, 582,
-	56, 561,
-	-2, 573,
-	-1, 602,
-	118, 1282,
-	-2, 83,
-	-1, 603,
-	118, 1166,
-	-2, 84,
-	-1, 609,
-	118, 1216,
-	-2, 920,
-	-1, 746,
-	118, 1105,
-	-2, 917,
-	-1, 781,
-	175, 37,
-	180, 37,
-	-2, 245,
-	-1, 860,
-	1, 376,
-	463, 376,
-	-2, 117,
-	-1, 1092,
-	1, 272,
-	463, 272,
-	-2, 117,
-	-1, 1170,
-	169, 234,
-	170, 234,
-	-2, 323,
-	-1, 1179,
-	175, 38,
-	180, 38,
-	-2, 246,
-	-1, 1383,
-	150, 948,
-	-2, 944,
-	-1, 1475,
-	74, 65,
-	82, 65,
-	-2, 69,
-	-1, 1496,
-	1, 273,
-	463, 273,
-	-2, 117,
-	-1, 1898,
-	5, 814,
-	18, 814,
-	20, 814,
-	32, 814,
-	83, 814,
-	-2, 598,
-	-1, 2114,
-	46, 888,
-	-2, 886,
+	1, 118,
+	468, 118,
+	-2, 124,
+	-1, 46,
+	145, 124,
+	255, 124,
+	303, 124,
+	-2, 336,
+	-1, 53,
+	34, 475,
+	166, 475,
+	178, 475,
+	211, 489,
+	212, 489,
+	-2, 477,
+	-1, 58,
+	168, 499,
+	-2, 497,
+	-1, 86,
+	56, 566,
+	-2, 574,
+	-1, 110,
+	1, 119,
+	468, 119,
+	-2, 124,
+	-1, 120,
+	171, 241,
+	172, 241,
+	-2, 330,
+	-1, 139,
+	145, 124,
+	255, 124,
+	303, 124,
+	-2, 345,
+	-1, 569,
+	152, 978,
+	-2, 974,
+	-1, 570,
+	152, 979,
+	-2, 975,
+	-1, 593,
+	56, 567,
+	-2, 579,
+	-1, 594,
+	56, 568,
+	-2, 580,
+	-1, 614,
+	120, 1319,
+	-2, 90,
+	-1, 615,
+	120, 1201,
+	-2, 91,
+	-1, 621,
+	120, 1251,
+	-2, 953,
+	-1, 759,
+	120, 1139,
+	-2, 950,
+	-1, 794,
+	177, 37,
+	182, 37,
+	-2, 252,
+	-1, 873,
+	1, 383,
+	468, 383,
+	-2, 124,
+	-1, 1110,
+	1, 279,
+	468, 279,
+	-2, 124,
+	-1, 1188,
+	171, 241,
+	172, 241,
+	-2, 330,
+	-1, 1197,
+	177, 38,
+	182, 38,
+	-2, 253,
+	-1, 1401,
+	152, 981,
+	-2, 977,
+	-1, 1499,
+	74, 72,
+	84, 72,
+	-2, 76,
+	-1, 1520,
+	1, 280,
+	468, 280,
+	-2, 124,
+	-1, 1930,
+	5, 847,
+	18, 847,
+	20, 847,
+	32, 847,
+	85, 847,
+	-2, 605,
+	-1, 2152,
+	46, 921,
+	-2, 919,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 27664
+const yyLast = 28741
 
 var yyAct = [...]int{
-	562, 2200, 2187, 1947, 2114, 1812, 2164, 2127, 1708, 2060,
-	2038, 506, 535, 1878, 1675, 1560, 521, 1781, 1879, 1493,
-	1709, 1528, 1420, 995, 914, 1944, 1040, 1695, 1785, 1875,
-	1047, 872, 1533, 1767, 750, 504, 1766, 144, 1155, 1149,
-	1890, 1837, 1377, 1513, 501, 1472, 1635, 1301, 175, 81,
-	3, 187, 1765, 469, 187, 811, 1369, 607, 1288, 485,
-	130, 187, 1177, 79, 1558, 1535, 1759, 1077, 1084, 187,
-	776, 1454, 1461, 583, 1050, 1422, 1033, 1045, 1070, 568,
-	508, 1067, 1403, 1346, 931, 1607, 1380, 757, 497, 32,
-	485, 754, 1074, 485, 187, 485, 1154, 1068, 763, 574,
-	1184, 782, 1437, 1524, 758, 1267, 777, 778, 1083, 1057,
-	1081, 77, 779, 174, 604, 1477, 1304, 789, 107, 1514,
-	147, 108, 853, 492, 1008, 8, 7, 1195, 6, 1804,
-	1803, 1009, 1169, 113, 114, 76, 1589, 1825, 1826, 1335,
-	1334, 2062, 912, 932, 1417, 1418, 1254, 176, 177, 178,
-	1333, 1332, 1331, 82, 1330, 495, 1323, 496, 2156, 589,
-	593, 1673, 2111, 751, 1921, 109, 2017, 569, 2089, 2088,
-	187, 815, 447, 816, 176, 177, 178, 814, 115, 2206,
-	187, 2033, 866, 1625, 2034, 187, 493, 2161, 813, 84,
-	85, 86, 87, 88, 89, 2199, 2138, 2190, 1948, 1577,
-	601, 827, 828, 2160, 831, 832, 833, 834, 942, 608,
-	837, 838, 839, 840, 841, 842, 843, 844, 845, 846,
-	847, 848, 849, 850, 851, 770, 2137, 109, 769, 771,
-	792, 1854, 1979, 78, 460, 793, 768, 173, 1596, 1085,
-	932, 1086, 1595, 461, 1674, 1478, 1904, 1156, 1487, 817,
-	818, 819, 566, 458, 168, 547, 1824, 553, 554, 551,
-	552, 824, 550, 549, 548, 473, 1419, 829, 1538, 1905,
-	1906, 1623, 555, 556, 104, 180, 181, 182, 882, 110,
-	565, 132, 930, 176, 177, 178, 1488, 1489, 1739, 880,
-	152, 1738, 455, 1750, 1740, 109, 1507, 938, 176, 177,
-	178, 467, 830, 102, 34, 942, 2010, 70, 38, 39,
-	772, 891, 892, 472, 2140, 1970, 1324, 1325, 1326, 910,
-	1968, 142, 104, 169, 1814, 483, 131, 1322, 487, 481,
-	2101, 957, 956, 966, 967, 959, 960, 961, 962, 963,
-	964, 965, 958, 473, 149, 968, 150, 1537, 1786, 1559,
-	1592, 119, 120, 141, 140, 167, 1808, 1244, 104, 96,
-	97, 1268, 901, 1809, 903, 100, 883, 2189, 99, 98,
-	448, 449, 450, 2157, 465, 466, 476, 881, 854, 69,
-	462, 464, 477, 451, 452, 479, 478, 908, 454, 453,
-	888, 472, 457, 474, 938, 1815, 1273, 909, 1245, 861,
-	1246, 900, 902, 136, 117, 143, 124, 116, 1817, 137,
-	138, 893, 473, 153, 1604, 894, 891, 892, 836, 1276,
-	473, 1277, 1278, 158, 125, 835, 103, 1920, 1272, 937,
-	934, 935, 936, 941, 943, 940, 1816, 939, 128, 126,
-	121, 122, 123, 127, 933, 172, 2028, 187, 118, 1270,
-	1274, 886, 887, 884, 885, 2085, 800, 129, 1561, 798,
-	472, 895, 899, 906, 1163, 1455, 809, 473, 472, 1271,
-	485, 485, 485, 1594, 103, 808, 1838, 807, 806, 805,
-	875, 876, 877, 878, 879, 804, 803, 791, 485, 485,
-	2136, 802, 797, 896, 2029, 773, 810, 791, 898, 1624,
-	911, 755, 2204, 755, 2207, 753, 1611, 1478, 785, 1539,
-	103, 2176, 755, 897, 784, 472, 865, 475, 791, 1840,
-	864, 2077, 2141, 762, 145, 106, 937, 934, 935, 936,
-	941, 943, 940, 595, 939, 470, 1183, 1182, 2102, 924,
-	1818, 933, 1583, 1281, 918, 820, 1775, 1591, 801, 1863,
-	471, 799, 1862, 1861, 982, 983, 984, 985, 986, 987,
-	988, 989, 990, 991, 2128, 766, 187, 1256, 1255, 1257,
-	1258, 1259, 765, 139, 1676, 1678, 1842, 826, 1846, 791,
-	1841, 764, 1839, 791, 978, 133, 1796, 1844, 134, 446,
-	179, 1603, 485, 905, 1602, 187, 1843, 187, 187, 1037,
-	485, 889, 1609, 1609, 791, 907, 485, 1608, 1608, 1845,
-	1847, 980, 981, 915, 916, 927, 925, 1579, 926, 1654,
-	1038, 71, 790, 2118, 604, 1999, 996, 1903, 1700, 784,
-	787, 788, 790, 755, 1651, 1643, 1569, 781, 785, 784,
-	787, 788, 1066, 755, 1034, 1483, 1061, 781, 785, 2202,
-	993, 870, 2203, 790, 2201, 958, 780, 1051, 968, 794,
-	784, 2078, 2076, 1494, 176, 177, 178, 1049, 1371, 795,
-	1677, 858, 968, 1011, 1013, 1015, 1017, 1019, 1021, 1022,
-	1012, 1014, 1735, 1018, 1020, 1302, 1023, 796, 1031, 146,
-	151, 148, 154, 155, 156, 157, 159, 160, 161, 162,
-	860, 1353, 1305, 867, 868, 163, 164, 165, 166, 176,
-	177, 178, 1433, 874, 790, 1351, 1352, 1350, 790, 608,
-	825, 947, 945, 2093, 1372, 1039, 957, 956, 966, 967,
-	959, 960, 961, 962, 963, 964, 965, 958, 948, 790,
-	968, 1578, 92, 1319, 1649, 794, 784, 945, 948, 1435,
-	187, 855, 1648, 856, 1145, 795, 857, 812, 980, 981,
-	1888, 1269, 187, 948, 1087, 1157, 1158, 1159, 1160, 1755,
-	946, 947, 945, 980, 981, 928, 1650, 946, 947, 945,
-	485, 1856, 1179, 859, 1504, 1636, 1404, 93, 948, 1404,
-	1188, 1661, 1153, 1576, 1192, 948, 1505, 485, 485, 1574,
-	485, 1303, 485, 485, 1189, 485, 485, 485, 485, 485,
-	485, 1571, 1434, 800, 1175, 946, 947, 945, 1306, 798,
-	485, 761, 2208, 1858, 187, 1228, 873, 1161, 1162, 1223,
-	1224, 1748, 1571, 948, 2121, 1575, 1168, 946, 947, 945,
-	1241, 966, 967, 959, 960, 961, 962, 963, 964, 965,
-	958, 485, 1187, 968, 1225, 948, 1573, 171, 69, 187,
-	946, 947, 945, 1054, 1082, 187, 2194, 1144, 187, 1287,
-	1349, 187, 961, 962, 963, 964, 965, 958, 948, 594,
-	968, 2193, 187, 1151, 187, 1231, 1232, 2191, 2016, 1186,
-	2209, 1237, 1238, 2015, 1982, 1178, 485, 485, 485, 187,
-	485, 485, 187, 485, 485, 1185, 1185, 1165, 1166, 1164,
-	1926, 2181, 1293, 1763, 1295, 2192, 1297, 1298, 1299, 1300,
-	1341, 1343, 1344, 767, 1762, 1197, 1811, 1198, 1290, 1200,
-	1202, 187, 1342, 1206, 1208, 1210, 1212, 1214, 1307, 2182,
-	1226, 957, 956, 966, 967, 959, 960, 961, 962, 963,
-	964, 965, 958, 760, 599, 968, 1263, 1282, 1628, 1629,
-	1630, 176, 177, 178, 1347, 1742, 2183, 596, 597, 1370,
-	959, 960, 961, 962, 963, 964, 965, 958, 1373, 770,
-	968, 109, 769, 176, 177, 178, 1865, 1553, 1261, 1251,
-	1542, 1264, 485, 1438, 1439, 1345, 1249, 1248, 1354, 1355,
-	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
-	1366, 1367, 1368, 1392, 1395, 1262, 1374, 1375, 1381, 1405,
-	1247, 1317, 1239, 1329, 1233, 485, 485, 1387, 1230, 176,
-	177, 178, 1348, 1551, 1866, 2172, 187, 1229, 1308, 1309,
-	1310, 563, 1312, 1313, 1204, 1315, 1316, 1260, 1250, 485,
-	2150, 1383, 1382, 2051, 2013, 1407, 187, 1987, 1867, 485,
-	1427, 1772, 1760, 187, 1619, 187, 176, 177, 178, 996,
-	1411, 1412, 1764, 187, 187, 946, 947, 945, 1587, 1586,
-	485, 1291, 1252, 485, 1388, 1389, 1381, 1240, 1394, 1397,
-	1398, 1236, 188, 948, 485, 188, 946, 947, 945, 1235,
-	486, 1234, 188, 578, 604, 2083, 1384, 604, 1933, 2175,
-	188, 1933, 2134, 1410, 948, 2082, 1413, 1414, 1946, 1383,
-	1452, 1788, 1473, 1933, 2119, 1448, 1933, 578, 1498, 78,
-	1497, 486, 1933, 2091, 486, 188, 486, 1428, 2031, 578,
-	1508, 1696, 1509, 1510, 1511, 1512, 1774, 1440, 1729, 485,
-	1502, 1515, 1516, 1517, 1571, 578, 1478, 485, 1520, 1521,
-	1522, 1523, 1550, 1552, 1501, 1530, 1476, 1479, 1450, 524,
-	523, 526, 527, 528, 529, 485, 1997, 578, 525, 1887,
-	530, 485, 1933, 1938, 1994, 1188, 1536, 1188, 1479, 1485,
-	1484, 1481, 176, 177, 178, 1570, 1242, 1918, 1917, 608,
-	1500, 1499, 608, 1914, 1915, 1914, 1913, 578, 1572, 1557,
-	1458, 188, 1446, 578, 1478, 1805, 1148, 1790, 1783, 1784,
-	34, 188, 1458, 578, 1447, 485, 188, 1370, 1696, 1480,
-	944, 578, 1370, 1370, 1148, 1147, 1531, 1482, 1093, 1092,
-	944, 1457, 1876, 2092, 1526, 1527, 1933, 1567, 34, 1568,
-	1480, 1887, 1541, 1540, 1547, 1548, 1549, 1543, 1478, 1580,
-	578, 1916, 1458, 1571, 80, 1486, 1666, 187, 1563, 1665,
-	1531, 187, 187, 187, 187, 187, 1446, 34, 571, 2018,
-	1562, 187, 187, 1582, 1566, 187, 792, 1219, 1584, 1585,
-	1581, 793, 1458, 1981, 1446, 69, 2067, 1887, 1571, 1554,
-	1436, 1185, 1703, 187, 187, 187, 957, 956, 966, 967,
-	959, 960, 961, 962, 963, 964, 965, 958, 187, 1415,
-	968, 187, 485, 69, 2126, 1704, 1976, 2019, 2020, 2021,
-	1446, 1769, 1327, 1613, 1280, 1220, 1221, 1222, 1079, 1617,
-	957, 956, 966, 967, 959, 960, 961, 962, 963, 964,
-	965, 958, 69, 69, 968, 775, 952, 774, 955, 1590,
-	69, 2040, 1945, 1347, 969, 970, 971, 972, 973, 974,
-	975, 1612, 953, 954, 951, 957, 956, 966, 967, 959,
-	960, 961, 962, 963, 964, 965, 958, 2022, 2005, 968,
-	1150, 1529, 1810, 1564, 1525, 1519, 1632, 1633, 1634, 957,
-	956, 966, 967, 959, 960, 961, 962, 963, 964, 965,
-	958, 1518, 1622, 968, 1266, 1180, 187, 1176, 1146, 1463,
-	1466, 1467, 1468, 1464, 187, 1465, 1469, 94, 1768, 1891,
-	1892, 1348, 2023, 2024, 1631, 957, 956, 966, 967, 959,
-	960, 961, 962, 963, 964, 965, 958, 173, 187, 968,
-	1813, 1216, 1891, 1892, 2041, 1682, 1156, 2196, 2188, 187,
-	187, 187, 187, 187, 1644, 1640, 1641, 1689, 1909, 1710,
-	1894, 187, 1876, 1769, 569, 187, 1779, 1778, 187, 187,
-	1777, 1701, 187, 187, 187, 1660, 1658, 1645, 188, 1545,
-	1320, 1284, 1672, 1698, 1034, 1741, 1217, 1218, 1720, 1680,
-	1718, 1897, 1896, 1721, 1705, 1719, 1722, 1717, 1467, 1468,
-	1716, 486, 486, 486, 1688, 1754, 2178, 2159, 1868, 1685,
-	1048, 1697, 1699, 1730, 1727, 1998, 1936, 1732, 1694, 486,
-	486, 1693, 2146, 1753, 1744, 1756, 1757, 1758, 2143, 2180,
-	1711, 1290, 1723, 1714, 2163, 2165, 187, 1728, 2171, 584,
-	1733, 2170, 2115, 1751, 1752, 1736, 485, 1712, 1713, 101,
-	1715, 2113, 485, 1957, 585, 485, 1279, 1188, 1773, 1745,
-	564, 1787, 485, 1536, 1463, 1466, 1467, 1468, 1464, 822,
-	1465, 1469, 1791, 584, 1802, 821, 1761, 1052, 1053, 587,
-	1400, 586, 187, 1771, 1793, 1683, 1041, 1768, 585, 1823,
-	1770, 917, 1801, 1684, 1798, 1401, 170, 188, 1042, 183,
-	1800, 1797, 187, 1747, 110, 1168, 2123, 2122, 2065, 1565,
-	1194, 581, 582, 587, 1193, 586, 1181, 1383, 1382, 1792,
-	1975, 1828, 1992, 486, 1438, 1439, 188, 2084, 188, 188,
-	1431, 486, 1544, 1283, 485, 1799, 2035, 486, 1471, 1627,
-	1370, 957, 956, 966, 967, 959, 960, 961, 962, 963,
-	964, 965, 958, 1819, 1821, 968, 1822, 575, 1834, 572,
-	573, 2185, 1836, 2184, 2168, 1835, 1692, 2147, 1991, 1827,
-	485, 1829, 1830, 1932, 1691, 1833, 1555, 576, 80, 1855,
-	1990, 187, 1849, 1871, 1696, 1655, 1850, 1851, 1652, 1852,
-	1853, 485, 2198, 2197, 2198, 1062, 1848, 485, 485, 1055,
-	1859, 1860, 1877, 2116, 2011, 1710, 1432, 1880, 571, 78,
-	83, 75, 1, 456, 1416, 1834, 1032, 468, 2186, 1253,
-	187, 1243, 1949, 2037, 1939, 1534, 1886, 783, 135, 957,
-	956, 966, 967, 959, 960, 961, 962, 963, 964, 965,
-	958, 1406, 1495, 968, 1496, 1895, 1899, 2130, 1901, 91,
-	1902, 748, 90, 786, 904, 1556, 2075, 2032, 1749, 1506,
-	2009, 1911, 1912, 1908, 1927, 1900, 187, 1746, 187, 187,
-	187, 1907, 2120, 1099, 485, 1097, 1098, 1096, 1101, 1100,
-	1095, 188, 1910, 1321, 482, 1470, 1088, 187, 1935, 1056,
-	1922, 1874, 823, 188, 1923, 1919, 1503, 1864, 1318, 1588,
-	463, 1940, 890, 459, 1950, 485, 485, 485, 1934, 187,
-	976, 486, 1690, 1937, 1924, 1925, 1737, 1958, 1943, 1536,
-	605, 598, 1882, 577, 1942, 1885, 2169, 2144, 486, 486,
-	2142, 486, 2112, 486, 486, 2061, 486, 486, 486, 486,
-	486, 486, 2145, 1955, 1956, 2110, 2179, 2162, 1430, 1044,
-	1989, 486, 1870, 1659, 1966, 188, 1005, 1402, 1071, 507,
-	1426, 1340, 522, 1959, 519, 520, 1441, 1702, 1963, 1964,
-	950, 1965, 505, 499, 1967, 1063, 1969, 1462, 1460, 1459,
-	1285, 1075, 486, 1893, 1889, 1069, 1445, 1593, 1807, 1710,
-	188, 929, 580, 494, 95, 1399, 188, 1993, 2002, 188,
-	1988, 2100, 188, 1626, 1978, 579, 60, 2001, 37, 489,
-	2155, 920, 588, 188, 31, 188, 30, 29, 2008, 28,
-	2007, 23, 22, 485, 485, 1961, 21, 486, 486, 486,
-	188, 486, 486, 188, 486, 486, 485, 20, 19, 485,
-	2026, 25, 18, 17, 2025, 16, 105, 2012, 47, 2014,
-	44, 42, 112, 2036, 111, 2044, 45, 41, 862, 27,
-	26, 15, 188, 2039, 14, 13, 12, 11, 10, 9,
-	5, 4, 923, 24, 485, 485, 485, 187, 2042, 994,
-	2, 0, 0, 0, 0, 0, 0, 0, 485, 0,
-	485, 2054, 2056, 2057, 0, 0, 485, 1880, 2043, 2058,
-	0, 1880, 2070, 2066, 0, 2064, 0, 0, 2045, 2046,
-	2047, 2048, 2049, 2073, 0, 0, 2052, 2053, 2079, 0,
-	187, 2059, 2080, 486, 2081, 0, 0, 0, 0, 0,
-	0, 485, 187, 0, 0, 0, 2068, 2087, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2094, 0,
-	0, 0, 0, 0, 0, 0, 486, 486, 0, 0,
-	0, 0, 0, 2109, 0, 0, 0, 188, 2050, 0,
-	1880, 2117, 534, 0, 0, 2090, 0, 0, 0, 0,
-	486, 0, 0, 485, 485, 0, 0, 188, 0, 0,
-	486, 2072, 0, 2129, 188, 168, 188, 2074, 0, 0,
-	0, 0, 0, 0, 188, 188, 0, 2039, 2131, 485,
-	2139, 486, 1974, 0, 486, 2148, 0, 485, 1710, 168,
-	110, 0, 2151, 186, 2124, 486, 480, 0, 0, 0,
-	2158, 152, 0, 186, 2154, 0, 0, 2167, 2166, 0,
-	0, 186, 0, 0, 110, 0, 0, 0, 0, 0,
-	0, 0, 2177, 0, 0, 152, 1973, 592, 592, 0,
-	2152, 0, 0, 0, 0, 0, 186, 0, 0, 0,
-	0, 0, 1743, 0, 0, 0, 0, 0, 0, 2195,
-	486, 0, 0, 0, 0, 149, 0, 150, 486, 0,
-	2205, 0, 533, 0, 0, 0, 167, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 486, 0, 0, 149,
-	0, 150, 486, 0, 0, 0, 0, 0, 0, 0,
-	167, 957, 956, 966, 967, 959, 960, 961, 962, 963,
-	964, 965, 958, 0, 0, 968, 0, 0, 0, 0,
-	0, 0, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 484, 186, 0, 153, 0, 486, 186, 0, 0,
-	0, 0, 0, 0, 158, 957, 956, 966, 967, 959,
-	960, 961, 962, 963, 964, 965, 958, 0, 153, 968,
-	0, 0, 606, 0, 0, 752, 0, 759, 158, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 188, 0,
-	0, 0, 188, 188, 188, 188, 188, 0, 0, 0,
-	0, 0, 188, 188, 168, 0, 188, 0, 0, 0,
-	0, 0, 0, 0, 0, 1780, 0, 0, 0, 0,
-	0, 0, 0, 0, 188, 188, 188, 591, 0, 110,
-	0, 132, 0, 0, 0, 0, 1637, 0, 0, 188,
-	152, 0, 188, 486, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 145, 957, 956, 966, 967,
-	959, 960, 961, 962, 963, 964, 965, 958, 0, 0,
-	968, 142, 0, 0, 0, 0, 131, 0, 0, 145,
-	956, 966, 967, 959, 960, 961, 962, 963, 964, 965,
-	958, 0, 498, 968, 149, 0, 150, 0, 0, 0,
-	0, 1171, 1172, 141, 140, 167, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 188, 0, 0,
-	0, 0, 0, 0, 0, 188, 0, 0, 0, 0,
-	0, 0, 0, 136, 1173, 143, 0, 1170, 0, 137,
-	138, 0, 0, 153, 0, 0, 0, 0, 0, 188,
-	0, 0, 0, 158, 0, 0, 0, 0, 0, 0,
-	188, 188, 188, 188, 188, 0, 0, 0, 0, 0,
-	0, 0, 188, 0, 0, 0, 188, 0, 0, 188,
-	188, 0, 0, 188, 188, 188, 0, 0, 0, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	146, 151, 148, 154, 155, 156, 157, 159, 160, 161,
-	162, 0, 0, 0, 0, 0, 163, 164, 165, 166,
-	0, 0, 0, 0, 146, 151, 148, 154, 155, 156,
-	157, 159, 160, 161, 162, 0, 0, 0, 0, 0,
-	163, 164, 165, 166, 0, 0, 0, 188, 0, 0,
-	0, 0, 0, 0, 145, 0, 0, 486, 0, 0,
-	0, 0, 0, 486, 0, 0, 486, 0, 0, 0,
-	0, 0, 0, 486, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 188, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 139, 0, 0, 0, 0, 186, 0,
-	0, 0, 0, 188, 0, 133, 0, 0, 134, 0,
-	0, 0, 0, 592, 0, 0, 0, 0, 0, 0,
-	0, 0, 606, 606, 606, 0, 0, 186, 0, 186,
-	1078, 0, 0, 0, 0, 486, 0, 0, 0, 0,
-	919, 921, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 486, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 188, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 486, 0, 0, 0, 1385, 1386, 486, 486,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 146,
-	151, 148, 154, 155, 156, 157, 159, 160, 161, 162,
-	0, 188, 536, 33, 0, 163, 164, 165, 166, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1429, 0, 0, 0, 1059, 0, 0, 0, 0, 0,
-	0, 0, 606, 0, 0, 0, 33, 0, 1089, 0,
-	0, 0, 0, 0, 0, 0, 0, 188, 0, 188,
-	188, 188, 0, 0, 0, 486, 0, 0, 0, 0,
-	0, 0, 186, 0, 0, 0, 0, 0, 188, 0,
-	0, 0, 0, 0, 186, 0, 0, 0, 949, 0,
-	570, 0, 0, 0, 0, 0, 486, 486, 486, 0,
-	188, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1191, 0, 0, 0, 0,
-	0, 0, 0, 0, 498, 0, 0, 0, 0, 0,
-	0, 0, 0, 1006, 0, 0, 0, 0, 0, 0,
-	1191, 1191, 0, 0, 0, 0, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1043, 1046, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 186, 0, 0, 0, 0, 0, 186, 0, 0,
-	186, 0, 0, 1289, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 186, 0, 186, 0, 0, 0,
-	0, 0, 0, 0, 486, 486, 0, 0, 0, 0,
-	0, 186, 752, 0, 186, 0, 0, 486, 0, 0,
-	486, 0, 0, 0, 0, 1190, 0, 0, 0, 1196,
-	1196, 0, 1196, 0, 1196, 1196, 0, 1205, 1196, 1196,
-	1196, 1196, 1196, 186, 0, 0, 0, 0, 0, 0,
-	1190, 1190, 752, 0, 0, 486, 486, 486, 188, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 486,
-	0, 486, 0, 0, 0, 0, 0, 486, 0, 0,
-	0, 0, 0, 1265, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 188, 0, 0, 0, 592, 1289, 0, 0, 0,
-	592, 592, 486, 188, 592, 592, 592, 0, 0, 0,
-	1191, 0, 0, 0, 0, 0, 0, 0, 606, 606,
-	606, 0, 606, 606, 0, 606, 606, 0, 0, 592,
-	592, 592, 592, 592, 0, 0, 0, 0, 1424, 0,
-	0, 0, 0, 0, 0, 1638, 0, 0, 0, 1639,
-	0, 0, 0, 0, 486, 486, 0, 0, 186, 0,
-	1646, 1647, 0, 0, 1289, 186, 1653, 186, 0, 1656,
-	1657, 0, 0, 0, 0, 186, 186, 1663, 0, 1664,
-	486, 0, 1667, 1668, 1669, 1670, 1671, 0, 486, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1681, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1376, 0, 606, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1190, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1292, 0, 1725, 1726, 0, 1408, 1409, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 913, 913, 913, 0, 0, 0, 0, 0,
-	0, 1442, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1059, 33, 0, 606, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 977, 979, 0, 0, 0,
-	0, 0, 606, 0, 0, 606, 0, 0, 0, 0,
-	0, 0, 1336, 1337, 1338, 1339, 752, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 992, 0, 0, 0,
-	997, 998, 999, 1000, 1001, 1002, 1003, 1004, 0, 1007,
-	1010, 1010, 1010, 1016, 1010, 1010, 1016, 1010, 1024, 1025,
-	1026, 1027, 1028, 1029, 1030, 0, 0, 0, 0, 0,
-	1036, 0, 0, 33, 0, 0, 0, 1390, 1391, 186,
-	0, 759, 0, 186, 186, 186, 186, 186, 0, 1546,
-	0, 0, 0, 186, 186, 0, 0, 186, 0, 1072,
-	0, 0, 0, 0, 0, 0, 0, 752, 0, 0,
-	0, 0, 0, 759, 498, 1614, 1615, 186, 0, 0,
-	0, 0, 1831, 1832, 0, 0, 0, 0, 0, 0,
-	186, 0, 1035, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 752, 0, 0,
-	0, 0, 0, 0, 0, 1492, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 185, 0, 0, 0, 0, 1883, 0,
-	0, 592, 592, 488, 0, 0, 0, 0, 0, 0,
-	0, 567, 0, 0, 0, 0, 0, 0, 0, 1898,
-	0, 0, 592, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1532, 0, 756, 0, 186, 0,
-	0, 0, 0, 0, 0, 0, 1424, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1621, 0, 0, 0, 0, 592,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1191, 186, 186, 186, 186, 186, 0, 0, 0, 0,
-	0, 0, 0, 1724, 0, 0, 0, 186, 0, 0,
-	186, 186, 0, 0, 186, 1734, 1289, 0, 0, 0,
-	0, 0, 852, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 863, 0, 0, 1960, 0, 869, 0, 1962,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1971, 1972, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1986, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 186, 0,
-	0, 0, 0, 1995, 1996, 0, 0, 2000, 0, 0,
-	0, 0, 1191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1289, 0, 0, 0, 0, 0, 0, 0,
-	1190, 0, 0, 0, 0, 0, 0, 0, 913, 913,
-	913, 0, 913, 913, 186, 913, 913, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2030, 0, 0, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 592, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2055, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1662, 0, 0, 0, 0, 0, 1782, 0,
-	0, 0, 1190, 0, 1789, 0, 0, 1782, 0, 0,
-	0, 0, 606, 186, 1794, 0, 0, 0, 0, 0,
-	0, 0, 1686, 1687, 1046, 0, 1191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2096, 2097, 2098,
-	2099, 0, 2103, 0, 2104, 2105, 2106, 0, 2107, 2108,
-	0, 0, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1474, 606, 0, 0, 871,
-	0, 0, 2135, 0, 0, 0, 0, 0, 186, 0,
-	186, 186, 186, 0, 0, 0, 0, 0, 0, 1191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 186,
-	0, 0, 1196, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 186, 0, 606, 2173, 2174, 1190, 0, 0, 1884,
-	1196, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 34, 35, 36, 70,
-	38, 39, 0, 0, 0, 0, 0, 0, 0, 168,
-	0, 0, 0, 0, 0, 0, 74, 0, 0, 0,
-	1167, 40, 66, 67, 0, 64, 68, 0, 0, 0,
-	1191, 0, 65, 0, 110, 0, 132, 0, 0, 0,
-	0, 0, 0, 0, 0, 152, 752, 0, 0, 1190,
-	0, 0, 0, 0, 0, 0, 0, 1065, 0, 0,
-	1076, 53, 0, 0, 0, 0, 0, 0, 0, 1857,
-	0, 69, 0, 0, 0, 0, 142, 1951, 1952, 1953,
-	0, 131, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
-	0, 150, 0, 0, 1872, 0, 1171, 1172, 141, 140,
-	167, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1424,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 43, 46, 49, 48, 51, 0, 63,
-	1190, 0, 0, 0, 0, 0, 0, 0, 136, 1173,
-	143, 0, 1170, 0, 137, 138, 0, 0, 153, 0,
-	0, 0, 186, 0, 52, 73, 72, 0, 158, 61,
-	62, 50, 0, 0, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1782, 2027, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1782, 0,
-	0, 606, 1094, 0, 1642, 54, 55, 570, 56, 57,
-	58, 59, 0, 0, 1152, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1782, 1782, 1782, 0,
-	0, 0, 0, 0, 1679, 0, 0, 0, 0, 1191,
-	2069, 0, 2071, 1116, 0, 0, 1980, 0, 1782, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
-	1072, 0, 0, 0, 0, 0, 1227, 1706, 1707, 498,
-	0, 1072, 1072, 1072, 1072, 1072, 2003, 0, 0, 2004,
-	0, 0, 2006, 1782, 0, 0, 0, 1474, 0, 0,
-	1072, 0, 0, 71, 1072, 0, 0, 0, 0, 0,
-	0, 1275, 0, 0, 0, 0, 0, 1076, 139, 0,
-	1286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	133, 0, 0, 134, 1294, 0, 1296, 0, 0, 0,
-	0, 0, 0, 0, 0, 606, 606, 0, 0, 0,
-	0, 1311, 0, 0, 1314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1104, 0, 0, 1190,
-	0, 2149, 0, 0, 0, 0, 0, 0, 0, 1782,
-	0, 0, 0, 1076, 0, 0, 0, 0, 2063, 498,
-	0, 0, 0, 0, 0, 1795, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1117,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 146, 151, 148, 154, 155, 156,
-	157, 159, 160, 161, 162, 0, 0, 0, 0, 0,
-	163, 164, 165, 166, 0, 1130, 1133, 1134, 1135, 1136,
-	1137, 1138, 0, 1139, 1140, 1141, 1142, 1143, 1118, 1119,
-	1120, 1121, 1102, 1103, 1131, 0, 1105, 0, 1106, 1107,
-	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1122, 1123,
-	1124, 1125, 1126, 1127, 1128, 1129, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1449, 0,
-	0, 0, 0, 0, 0, 1453, 0, 1456, 0, 0,
-	0, 0, 0, 0, 0, 0, 1475, 1881, 0, 33,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1072, 0, 0, 0, 1132, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1977, 0, 0,
-	0, 0, 0, 0, 1983, 1984, 1985, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1076,
-	0, 0, 0, 1597, 1598, 1599, 1600, 1601, 0, 0,
-	0, 0, 0, 1605, 1606, 0, 0, 1610, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1616, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1618, 0, 0, 1620, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1881, 0, 33,
-	0, 1881, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 33, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1881, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 33, 2125, 0,
+	569, 2230, 2248, 2073, 1977, 2152, 2155, 1737, 2214, 1008,
+	1840, 1067, 2271, 2168, 2069, 2094, 1910, 1700, 542, 85,
+	3, 528, 1584, 1911, 1439, 1053, 1724, 1738, 927, 1057,
+	1907, 1813, 1552, 1517, 1173, 511, 1795, 1449, 763, 1796,
+	148, 1496, 1395, 1537, 81, 1557, 1213, 584, 513, 179,
+	824, 885, 191, 1922, 476, 191, 1387, 1868, 1659, 1319,
+	492, 1306, 191, 134, 619, 1049, 1582, 1788, 1794, 1559,
+	191, 191, 1195, 603, 789, 504, 1102, 1095, 1086, 1478,
+	595, 1085, 1485, 1070, 1453, 1062, 515, 1047, 1088, 1422,
+	579, 1441, 944, 1364, 492, 587, 1631, 492, 191, 492,
+	1092, 767, 776, 802, 792, 770, 1202, 771, 1285, 1548,
+	1172, 32, 1460, 790, 795, 791, 1101, 189, 1398, 1075,
+	1501, 577, 616, 33, 79, 1322, 111, 495, 575, 151,
+	1099, 178, 112, 117, 1538, 574, 1187, 118, 499, 505,
+	1022, 8, 7, 78, 866, 83, 6, 1023, 1832, 1831,
+	1613, 1853, 2096, 1854, 1436, 1437, 1272, 180, 181, 182,
+	1353, 1352, 1351, 769, 1350, 1349, 1348, 502, 2201, 503,
+	1698, 764, 601, 605, 191, 113, 1341, 2149, 2252, 1953,
+	829, 2050, 2098, 119, 191, 2206, 879, 826, 454, 191,
+	1860, 580, 2125, 2124, 945, 828, 827, 180, 181, 182,
+	840, 841, 500, 844, 845, 846, 847, 1167, 2278, 850,
+	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
+	861, 862, 863, 864, 613, 806, 805, 2065, 620, 2270,
+	2066, 945, 2235, 2235, 2211, 2236, 2236, 783, 2256, 865,
+	113, 1649, 2254, 782, 2181, 830, 831, 832, 784, 876,
+	2258, 837, 1425, 1978, 882, 2231, 80, 467, 35, 955,
+	35, 84, 1601, 2255, 2210, 1885, 468, 2253, 35, 2012,
+	2180, 72, 39, 40, 925, 172, 465, 781, 1174, 1438,
+	554, 1699, 560, 561, 558, 559, 842, 557, 556, 555,
+	1937, 1938, 2237, 2237, 1936, 35, 955, 562, 563, 1620,
+	114, 1562, 136, 1619, 1768, 1502, 2103, 1767, 113, 1852,
+	1769, 156, 1512, 1513, 1103, 462, 1104, 1647, 1511, 573,
+	1732, 180, 181, 182, 474, 480, 106, 904, 905, 108,
+	184, 185, 186, 943, 843, 71, 895, 71, 589, 1342,
+	1343, 1344, 146, 1733, 572, 71, 785, 135, 951, 2139,
+	970, 969, 979, 980, 972, 973, 974, 975, 976, 977,
+	978, 971, 893, 923, 981, 1779, 480, 153, 1531, 154,
+	2043, 2003, 71, 479, 123, 124, 145, 144, 171, 2001,
+	1561, 108, 100, 101, 1842, 951, 2202, 490, 104, 2183,
+	1340, 103, 102, 455, 456, 457, 494, 472, 473, 483,
+	488, 1814, 1616, 469, 471, 484, 458, 459, 486, 485,
+	1262, 461, 460, 906, 479, 464, 481, 907, 904, 905,
+	1583, 180, 181, 182, 896, 1286, 140, 121, 147, 128,
+	120, 1836, 141, 142, 2250, 867, 157, 921, 1837, 1291,
+	901, 922, 1952, 874, 480, 1845, 162, 129, 899, 900,
+	894, 1263, 1628, 1264, 191, 1843, 480, 1294, 849, 1295,
+	1296, 132, 130, 125, 126, 127, 131, 848, 908, 912,
+	1844, 122, 897, 898, 1290, 1288, 804, 492, 492, 492,
+	133, 107, 2121, 950, 947, 948, 949, 954, 956, 953,
+	480, 952, 479, 1292, 2060, 492, 492, 2133, 946, 1585,
+	914, 1479, 916, 813, 479, 822, 909, 888, 889, 890,
+	891, 892, 821, 820, 819, 1289, 937, 818, 177, 884,
+	950, 947, 948, 949, 954, 956, 953, 924, 952, 817,
+	816, 2207, 815, 107, 1618, 946, 2179, 804, 479, 913,
+	915, 811, 1563, 482, 810, 786, 1181, 149, 823, 877,
+	2061, 768, 1635, 1502, 768, 2279, 798, 2140, 766, 1648,
+	110, 477, 2228, 2233, 2233, 768, 2232, 2232, 1201, 1200,
+	2113, 797, 878, 191, 775, 607, 478, 191, 1846, 1607,
+	1299, 962, 1274, 1273, 1275, 1276, 1277, 73, 931, 2169,
+	70, 1055, 70, 70, 919, 814, 143, 991, 833, 2184,
+	70, 1056, 1804, 804, 492, 108, 173, 191, 137, 191,
+	191, 138, 492, 803, 1824, 1615, 2275, 505, 492, 902,
+	797, 800, 801, 1894, 768, 804, 1020, 70, 794, 798,
+	1893, 839, 1892, 812, 2159, 1064, 911, 804, 940, 938,
+	616, 779, 778, 939, 777, 804, 453, 793, 1633, 1633,
+	1084, 910, 183, 1632, 1632, 1009, 2032, 1603, 1679, 1060,
+	1063, 1048, 1701, 1703, 1676, 1935, 1627, 1010, 1729, 1626,
+	993, 994, 1083, 1667, 803, 1094, 1071, 871, 1593, 1507,
+	807, 797, 972, 973, 974, 975, 976, 977, 978, 971,
+	808, 1079, 981, 1518, 1006, 883, 1025, 1027, 1029, 1031,
+	1033, 1035, 1036, 1026, 1028, 1052, 1032, 1034, 809, 1037,
+	1045, 1069, 2114, 2112, 981, 150, 155, 152, 158, 159,
+	160, 161, 163, 164, 165, 166, 918, 1764, 176, 1320,
+	1323, 167, 168, 169, 170, 1456, 880, 881, 920, 873,
+	803, 180, 181, 182, 961, 1389, 620, 797, 800, 801,
+	1337, 768, 928, 929, 2128, 794, 798, 107, 887, 868,
+	1702, 869, 803, 191, 870, 2273, 971, 1163, 2274, 981,
+	2272, 1371, 96, 825, 803, 191, 838, 1887, 1175, 1176,
+	1177, 1178, 803, 1602, 1920, 1369, 1370, 1368, 807, 797,
+	959, 960, 958, 492, 1287, 1197, 958, 1105, 808, 993,
+	994, 1390, 941, 1206, 872, 993, 994, 1210, 961, 1423,
+	492, 492, 961, 492, 1171, 492, 492, 97, 492, 492,
+	492, 492, 492, 492, 2268, 180, 181, 182, 1112, 1600,
+	1423, 1193, 1686, 492, 1595, 1179, 1180, 191, 1246, 1598,
+	1170, 974, 975, 976, 977, 978, 971, 1321, 1324, 981,
+	1595, 1528, 1186, 1259, 1207, 1461, 1462, 1215, 1599, 1216,
+	813, 1218, 1220, 1529, 492, 1224, 1226, 1228, 1230, 1232,
+	811, 1243, 191, 886, 1597, 1205, 2259, 2241, 191, 1241,
+	1242, 191, 1305, 1675, 191, 1784, 960, 958, 2280, 774,
+	1869, 1777, 2162, 1249, 1250, 191, 1072, 191, 1162, 1255,
+	1256, 2049, 1245, 961, 1203, 1203, 2260, 2242, 1204, 492,
+	492, 492, 191, 492, 492, 191, 492, 492, 1169, 1674,
+	71, 1184, 175, 1100, 1183, 1182, 1196, 2048, 1458, 1673,
+	1958, 1792, 1367, 1871, 1652, 1653, 1654, 1293, 1791, 959,
+	960, 958, 1566, 1094, 191, 1311, 1304, 1313, 1308, 1315,
+	1316, 1317, 1318, 1282, 959, 960, 958, 961, 2281, 1267,
+	1312, 1310, 1314, 1325, 1359, 1361, 1362, 1281, 1244, 959,
+	960, 958, 961, 1793, 606, 1897, 1360, 1329, 1300, 2262,
+	1332, 1279, 1388, 1365, 180, 181, 182, 961, 1771, 2261,
+	1873, 1391, 1877, 1457, 1872, 780, 1870, 959, 960, 958,
+	783, 1875, 1266, 113, 1265, 492, 782, 1257, 1251, 1094,
+	1874, 1269, 180, 181, 182, 961, 1577, 611, 959, 960,
+	958, 1405, 773, 1876, 1878, 1898, 1839, 590, 1280, 1392,
+	1393, 1354, 1355, 1356, 1357, 1399, 961, 1248, 1247, 492,
+	492, 1222, 1278, 1335, 1347, 959, 960, 958, 2243, 2222,
+	191, 1366, 191, 1889, 2195, 492, 2085, 180, 181, 182,
+	1446, 1575, 2046, 961, 1411, 1414, 608, 609, 1400, 492,
+	1424, 1401, 1268, 2020, 191, 1899, 1801, 492, 180, 181,
+	182, 191, 1260, 191, 1430, 1431, 1789, 1409, 1410, 1643,
+	1611, 191, 191, 1610, 1463, 180, 181, 182, 492, 2119,
+	1309, 492, 1270, 1451, 1009, 1258, 1254, 1253, 1399, 1252,
+	1497, 2118, 492, 1709, 2227, 1464, 1010, 1709, 2175, 1709,
+	2160, 80, 1406, 1976, 505, 1816, 616, 1709, 590, 616,
+	1407, 1408, 1402, 1803, 1413, 1416, 1417, 2063, 590, 1473,
+	1526, 1476, 1595, 590, 1401, 957, 1477, 1908, 1480, 82,
+	1522, 1521, 1472, 2030, 590, 1709, 1969, 1499, 1919, 1429,
+	1950, 1949, 1432, 1433, 1946, 1947, 1919, 492, 1758, 1539,
+	1540, 1541, 1471, 1447, 2027, 492, 1946, 1945, 1502, 1516,
+	1574, 1576, 86, 1326, 1327, 1328, 1525, 1330, 1331, 2191,
+	1333, 1334, 1500, 492, 1554, 2127, 1474, 1470, 590, 492,
+	1560, 590, 1725, 1206, 1503, 1206, 1596, 531, 530, 533,
+	534, 535, 536, 1594, 1505, 1509, 532, 1470, 537, 88,
+	89, 90, 91, 92, 93, 1524, 1725, 1523, 1508, 1502,
+	1833, 1581, 620, 1166, 1818, 620, 1811, 1812, 1556, 1482,
+	590, 1709, 1708, 492, 1470, 1388, 957, 590, 1166, 1165,
+	1388, 1388, 1503, 1111, 1110, 1709, 1948, 1482, 1510, 1481,
+	1403, 1404, 1691, 1595, 1555, 1690, 1504, 588, 1550, 1551,
+	1470, 84, 1595, 1482, 1564, 1591, 1506, 1592, 1567, 1578,
+	1565, 1604, 1459, 1051, 1434, 191, 1571, 1572, 1573, 191,
+	191, 191, 191, 191, 1345, 806, 805, 1919, 1555, 191,
+	191, 1606, 1298, 191, 1587, 1203, 1608, 1609, 1590, 1452,
+	1605, 1586, 1482, 1097, 1504, 788, 787, 71, 2167, 2071,
+	1054, 191, 191, 191, 1502, 2051, 1532, 2038, 1533, 1534,
+	1535, 1536, 1168, 1553, 1838, 1588, 191, 1549, 1543, 191,
+	492, 1542, 1284, 1198, 1544, 1545, 1546, 1547, 71, 1194,
+	1094, 1164, 98, 1798, 1621, 1622, 1623, 1624, 1625, 1237,
+	2055, 177, 570, 1841, 1629, 1630, 1797, 1234, 1634, 1923,
+	1924, 1637, 2072, 2052, 2053, 2054, 1174, 1641, 2265, 2249,
+	1941, 1926, 1908, 1808, 1807, 1614, 1806, 1365, 1640, 969,
+	979, 980, 972, 973, 974, 975, 976, 977, 978, 971,
+	1636, 1642, 981, 1929, 1644, 2056, 2057, 1238, 1239, 1240,
+	1928, 1798, 1235, 1236, 192, 1569, 1338, 192, 1670, 1302,
+	1749, 1747, 493, 1746, 192, 1750, 1748, 1751, 1745, 1491,
+	1492, 2238, 192, 192, 2209, 191, 1900, 596, 1646, 1068,
+	1713, 2031, 1967, 191, 1487, 1490, 1491, 1492, 1488, 1723,
+	1489, 1493, 597, 1669, 2189, 1366, 493, 1722, 2186, 493,
+	192, 493, 1655, 1487, 1490, 1491, 1492, 1488, 2240, 1489,
+	1493, 2213, 191, 1923, 1924, 1065, 1066, 599, 105, 598,
+	2215, 1710, 1711, 191, 191, 191, 191, 191, 2221, 2220,
+	1712, 2153, 2151, 1668, 1718, 191, 1297, 1687, 1734, 191,
+	571, 1802, 191, 191, 1730, 1419, 191, 191, 191, 835,
+	834, 1685, 1058, 1727, 580, 1664, 1665, 1987, 1756, 1770,
+	1420, 1797, 1697, 1048, 1059, 1851, 174, 930, 1826, 187,
+	1739, 1714, 1715, 1063, 1705, 1707, 192, 1683, 1825, 1783,
+	1776, 114, 2164, 1759, 2163, 1716, 192, 1761, 1717, 2101,
+	1589, 192, 1212, 1728, 1726, 1211, 1199, 2025, 1454, 1461,
+	1462, 1773, 1741, 1742, 1740, 1744, 1568, 1743, 1760, 1301,
+	191, 1782, 1308, 1785, 1786, 1787, 1752, 1780, 1781, 1757,
+	492, 2192, 2120, 2067, 1762, 1495, 492, 1765, 1448, 492,
+	1651, 1206, 585, 1560, 582, 583, 492, 1774, 979, 980,
+	972, 973, 974, 975, 976, 977, 978, 971, 1830, 82,
+	981, 1790, 1800, 1721, 596, 2245, 191, 1810, 1903, 2244,
+	2218, 1720, 1821, 2190, 2177, 2024, 1819, 1964, 1799, 597,
+	1579, 586, 1815, 2023, 1725, 1805, 191, 1828, 2267, 2266,
+	1829, 1680, 1186, 1677, 1662, 1080, 1073, 2267, 1663, 2157,
+	2044, 1455, 593, 594, 599, 1400, 598, 84, 1401, 80,
+	1671, 1672, 87, 1820, 77, 1, 1678, 463, 492, 1681,
+	1682, 1827, 1435, 1046, 475, 1388, 2247, 1688, 1271, 1689,
+	1865, 1834, 1692, 1693, 1694, 1695, 1696, 1261, 1979, 2068,
+	1970, 1558, 796, 139, 1519, 1886, 1864, 1520, 1706, 1866,
+	1849, 1848, 1850, 1847, 2171, 492, 95, 761, 94, 1863,
+	492, 799, 917, 1580, 2111, 1855, 2064, 1778, 1867, 1530,
+	191, 2042, 1940, 1775, 2161, 1117, 1115, 1116, 1114, 1119,
+	492, 1879, 1118, 1880, 1113, 1339, 492, 492, 489, 1494,
+	1909, 1106, 1074, 836, 1951, 1527, 1336, 1612, 470, 1754,
+	1755, 1895, 1912, 903, 466, 989, 1719, 1766, 1864, 191,
+	1888, 617, 610, 1914, 1918, 2219, 2187, 508, 2185, 2150,
+	2095, 2188, 2148, 2239, 2212, 1061, 2022, 1902, 1906, 1684,
+	1019, 1739, 1421, 1917, 1931, 1901, 1933, 1089, 1934, 514,
+	1445, 1358, 529, 526, 527, 1465, 1731, 1927, 963, 1904,
+	1932, 512, 506, 1959, 1081, 191, 1486, 191, 191, 191,
+	1484, 1483, 492, 1939, 1943, 1944, 192, 1303, 1093, 1925,
+	1921, 1087, 1469, 1617, 1835, 191, 942, 592, 501, 99,
+	1418, 2138, 1650, 1955, 2011, 1954, 591, 61, 38, 493,
+	493, 493, 1980, 492, 492, 492, 1971, 191, 1956, 1957,
+	1965, 496, 2200, 1560, 933, 1988, 600, 493, 493, 1974,
+	1975, 492, 1973, 1966, 1968, 31, 30, 29, 28, 23,
+	1960, 22, 1961, 1962, 1963, 970, 969, 979, 980, 972,
+	973, 974, 975, 976, 977, 978, 971, 21, 20, 981,
+	1972, 19, 25, 18, 17, 16, 109, 1994, 48, 45,
+	43, 1985, 1986, 116, 115, 46, 1999, 42, 875, 27,
+	26, 15, 1984, 1993, 14, 13, 12, 1861, 1862, 11,
+	10, 9, 5, 4, 936, 24, 2074, 2132, 2234, 2205,
+	2034, 2204, 2154, 2097, 1660, 192, 1859, 1007, 2, 192,
+	2026, 0, 2021, 2040, 0, 0, 0, 2035, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2041,
+	0, 0, 0, 2013, 0, 492, 493, 0, 1739, 192,
+	0, 192, 192, 0, 493, 0, 0, 0, 0, 492,
+	493, 0, 0, 0, 0, 0, 2058, 505, 1915, 2045,
+	0, 2047, 492, 0, 2036, 0, 0, 2037, 2078, 0,
+	2039, 0, 2059, 0, 0, 0, 0, 2070, 0, 1930,
+	0, 0, 0, 0, 0, 0, 0, 492, 492, 492,
+	191, 0, 0, 2076, 0, 0, 0, 0, 0, 0,
+	0, 492, 0, 492, 2100, 0, 0, 0, 0, 492,
+	0, 2077, 0, 0, 2092, 0, 1912, 0, 2106, 2104,
+	1912, 2102, 0, 0, 2088, 2090, 2091, 0, 0, 2084,
+	0, 0, 191, 0, 2093, 0, 2115, 0, 0, 0,
+	0, 0, 492, 191, 0, 0, 2109, 1996, 1997, 2116,
+	1998, 2117, 2108, 2000, 0, 2002, 2123, 2126, 2110, 0,
+	0, 0, 0, 0, 0, 0, 0, 2099, 505, 492,
+	0, 0, 0, 540, 0, 0, 0, 0, 0, 2129,
+	2147, 0, 0, 0, 0, 0, 1992, 0, 0, 0,
+	2158, 1995, 1912, 0, 0, 192, 0, 2122, 492, 492,
+	0, 0, 2004, 2005, 0, 0, 0, 192, 2130, 0,
+	2165, 0, 2170, 0, 2176, 0, 0, 0, 2019, 0,
+	0, 0, 0, 0, 0, 493, 2070, 2172, 0, 0,
+	492, 0, 0, 491, 2182, 2193, 2028, 2029, 492, 0,
+	2033, 0, 493, 493, 0, 493, 0, 493, 493, 2196,
+	493, 493, 493, 493, 493, 493, 2203, 2208, 0, 0,
+	1856, 2217, 492, 2216, 0, 493, 0, 618, 2223, 192,
+	765, 0, 772, 0, 0, 2199, 1739, 0, 2229, 0,
+	970, 969, 979, 980, 972, 973, 974, 975, 976, 977,
+	978, 971, 0, 2062, 981, 492, 493, 0, 2246, 0,
+	0, 0, 0, 2251, 192, 0, 0, 0, 0, 0,
+	192, 0, 0, 192, 0, 0, 192, 0, 590, 0,
+	2264, 505, 0, 0, 0, 0, 0, 192, 492, 192,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2089,
+	2277, 493, 493, 493, 192, 493, 493, 192, 493, 493,
+	2276, 0, 0, 0, 995, 996, 997, 998, 999, 1000,
+	1001, 1002, 1003, 1004, 970, 969, 979, 980, 972, 973,
+	974, 975, 976, 977, 978, 971, 192, 2015, 981, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 0,
+	0, 0, 0, 0, 0, 0, 2131, 0, 0, 0,
+	0, 0, 2134, 2135, 2136, 2137, 0, 2141, 0, 2142,
+	2143, 2144, 114, 2145, 2146, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 970, 969, 979, 980, 972, 973,
+	974, 975, 976, 977, 978, 971, 0, 493, 981, 0,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1809, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2178, 0, 0, 0, 114, 1772, 136, 0, 0,
+	0, 493, 493, 0, 0, 0, 156, 0, 0, 153,
+	0, 154, 192, 0, 192, 0, 0, 493, 0, 0,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 172, 0, 0, 0, 192, 146, 0, 493,
+	0, 0, 135, 192, 0, 192, 0, 0, 0, 0,
+	2225, 2226, 0, 192, 192, 0, 0, 114, 0, 0,
+	493, 0, 153, 493, 154, 0, 0, 0, 156, 1189,
+	1190, 145, 144, 171, 493, 0, 0, 0, 157, 0,
+	0, 0, 0, 0, 965, 0, 968, 0, 162, 0,
+	0, 0, 982, 983, 984, 985, 986, 987, 988, 2263,
+	966, 967, 964, 970, 969, 979, 980, 972, 973, 974,
+	975, 976, 977, 978, 971, 2014, 2009, 981, 0, 0,
+	0, 140, 1191, 147, 153, 1188, 154, 141, 142, 493,
+	0, 157, 0, 0, 0, 171, 0, 493, 0, 0,
+	0, 162, 0, 970, 969, 979, 980, 972, 973, 974,
+	975, 976, 977, 978, 971, 493, 2008, 981, 0, 0,
+	0, 493, 970, 969, 979, 980, 972, 973, 974, 975,
+	976, 977, 978, 971, 0, 0, 981, 0, 0, 0,
+	618, 618, 618, 0, 0, 0, 1661, 0, 0, 149,
+	0, 0, 0, 157, 0, 0, 0, 0, 932, 934,
+	0, 0, 0, 162, 0, 493, 970, 969, 979, 980,
+	972, 973, 974, 975, 976, 977, 978, 971, 0, 0,
+	981, 0, 0, 0, 0, 0, 0, 970, 969, 979,
+	980, 972, 973, 974, 975, 976, 977, 978, 971, 0,
+	0, 981, 149, 0, 0, 0, 0, 192, 0, 0,
+	0, 192, 192, 192, 192, 192, 0, 0, 0, 0,
+	0, 192, 192, 0, 0, 192, 2007, 970, 969, 979,
+	980, 972, 973, 974, 975, 976, 977, 978, 971, 0,
+	0, 981, 0, 192, 192, 192, 0, 0, 0, 0,
+	0, 143, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 192, 493, 137, 149, 0, 138, 1077, 0, 0,
+	0, 0, 0, 0, 0, 618, 0, 0, 0, 0,
+	0, 1107, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1363, 0, 0, 1372, 1373, 1374, 1375, 1376, 1377,
+	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 155, 152,
+	158, 159, 160, 161, 163, 164, 165, 166, 0, 0,
+	0, 0, 0, 167, 168, 169, 170, 970, 969, 979,
+	980, 972, 973, 974, 975, 976, 977, 978, 971, 0,
+	0, 981, 1426, 0, 0, 0, 0, 192, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 0, 0,
+	150, 155, 152, 158, 159, 160, 161, 163, 164, 165,
+	166, 0, 0, 0, 0, 0, 167, 168, 169, 170,
+	0, 0, 0, 0, 192, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 192, 192, 192, 192,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
+	0, 192, 0, 0, 192, 192, 0, 0, 192, 192,
+	192, 2006, 150, 155, 152, 158, 159, 160, 161, 163,
+	164, 165, 166, 0, 0, 0, 0, 0, 167, 168,
+	169, 170, 0, 0, 0, 0, 765, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1208,
+	0, 0, 0, 1214, 1214, 0, 1214, 0, 1214, 1214,
+	0, 1223, 1214, 1214, 1214, 1214, 1214, 0, 0, 0,
+	0, 0, 192, 0, 1208, 1208, 765, 0, 0, 0,
+	0, 0, 493, 0, 0, 0, 0, 0, 493, 0,
+	0, 493, 0, 0, 0, 0, 0, 0, 493, 0,
+	0, 0, 0, 0, 0, 0, 541, 1283, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
+	0, 0, 970, 969, 979, 980, 972, 973, 974, 975,
+	976, 977, 978, 971, 0, 0, 981, 0, 192, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 618, 618, 618, 0, 618, 618, 190, 618,
+	618, 487, 0, 0, 0, 0, 0, 0, 190, 0,
+	493, 0, 0, 0, 0, 0, 190, 578, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 604, 604, 0, 0, 0,
+	0, 0, 0, 0, 190, 0, 0, 493, 0, 0,
+	0, 0, 493, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 0, 0, 543, 34, 0, 0, 0,
+	0, 0, 493, 0, 0, 0, 0, 0, 493, 493,
+	0, 0, 0, 0, 0, 0, 0, 0, 1394, 0,
+	618, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	34, 192, 0, 0, 0, 1208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1656, 1657, 1658,
+	190, 0, 1427, 1428, 0, 0, 0, 0, 0, 0,
+	190, 0, 0, 0, 0, 190, 0, 0, 1450, 0,
+	0, 0, 0, 0, 0, 581, 0, 192, 0, 192,
+	192, 192, 1466, 0, 493, 0, 0, 0, 0, 0,
+	1077, 0, 0, 618, 0, 0, 0, 192, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 618, 0, 0, 618, 493, 493, 493, 0, 192,
+	0, 0, 0, 0, 0, 765, 0, 0, 0, 0,
+	0, 0, 0, 493, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	772, 0, 0, 0, 0, 0, 0, 0, 1570, 0,
+	0, 0, 0, 0, 0, 0, 0, 35, 36, 37,
+	72, 39, 40, 0, 0, 0, 765, 0, 0, 0,
+	0, 0, 772, 0, 0, 0, 0, 76, 0, 0,
+	0, 0, 41, 67, 68, 0, 65, 69, 0, 0,
+	0, 0, 0, 66, 0, 0, 0, 493, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 493, 0, 0, 0, 0, 765, 0, 0, 0,
+	0, 0, 54, 0, 493, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 71, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 493,
+	493, 493, 192, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 493, 0, 493, 0, 0, 0, 0,
+	0, 493, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	190, 0, 0, 0, 192, 0, 0, 0, 1857, 1858,
+	0, 0, 0, 0, 493, 192, 44, 47, 50, 49,
+	52, 0, 64, 1645, 1881, 1882, 0, 1883, 1884, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1890, 1891,
+	0, 493, 0, 0, 0, 0, 0, 53, 75, 74,
+	0, 0, 62, 63, 51, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	493, 493, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 55, 56,
+	0, 57, 58, 59, 60, 0, 0, 0, 0, 0,
+	0, 0, 493, 0, 0, 0, 0, 0, 0, 0,
+	493, 0, 0, 0, 0, 0, 0, 0, 0, 190,
+	0, 0, 0, 578, 1942, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 493, 0, 0, 0, 0, 604,
+	0, 0, 926, 926, 926, 0, 0, 0, 0, 0,
+	0, 0, 0, 190, 0, 190, 1096, 0, 0, 0,
+	0, 0, 34, 0, 0, 1208, 0, 493, 0, 0,
+	0, 0, 0, 0, 0, 990, 992, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 73, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 70,
+	493, 0, 0, 0, 1989, 0, 1005, 0, 0, 0,
+	1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 0, 1021,
+	1024, 1024, 1024, 1030, 1024, 1024, 1030, 1024, 1038, 1039,
+	1040, 1041, 1042, 1043, 1044, 0, 0, 0, 0, 0,
+	1050, 0, 0, 0, 0, 0, 0, 34, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1450, 0, 0, 0, 1208, 0, 1817,
+	0, 0, 1450, 0, 1090, 0, 0, 618, 0, 1822,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 618, 1209, 0, 2079, 2080, 2081, 2082, 2083, 0,
+	0, 0, 2086, 2087, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1209, 1209, 0,
+	0, 0, 0, 190, 0, 0, 0, 0, 1214, 0,
+	0, 0, 0, 1896, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 618, 0, 0, 1208, 0, 190, 1916,
+	1214, 0, 0, 0, 190, 0, 0, 190, 0, 0,
+	1307, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 0, 190, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 0,
+	0, 190, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	190, 0, 0, 0, 0, 765, 0, 0, 1208, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1981, 1982, 1983, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1731, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1990, 0, 0, 0, 0, 0,
+	0, 0, 604, 1307, 0, 0, 0, 0, 604, 604,
+	0, 0, 604, 604, 604, 0, 0, 0, 1209, 0,
+	0, 0, 0, 0, 926, 926, 926, 0, 926, 926,
+	0, 926, 926, 0, 0, 2257, 0, 604, 604, 604,
+	604, 604, 0, 0, 0, 0, 1443, 0, 578, 0,
+	0, 0, 0, 1208, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	190, 0, 0, 0, 0, 0, 1307, 190, 0, 190,
+	0, 0, 0, 0, 0, 0, 0, 190, 190, 0,
+	0, 0, 0, 172, 0, 0, 0, 0, 1450, 0,
+	0, 0, 0, 0, 1185, 0, 0, 0, 0, 0,
+	0, 0, 618, 0, 0, 0, 0, 0, 114, 0,
+	136, 0, 0, 0, 0, 2075, 0, 0, 0, 156,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1450, 1450, 1450, 0, 0, 0, 0, 0, 0, 0,
+	146, 0, 0, 0, 2105, 135, 2107, 0, 0, 0,
+	0, 0, 1450, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 153, 0, 154, 0, 0,
+	0, 0, 1189, 1190, 145, 144, 171, 0, 0, 0,
+	0, 0, 0, 0, 0, 1450, 1498, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1776, 0,
+	0, 0, 2156, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 1191, 147, 0, 1188, 0,
+	141, 142, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 618, 618, 0, 162, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 0, 0, 0, 190, 190, 190, 190, 190,
+	0, 1208, 0, 2194, 0, 190, 190, 0, 0, 190,
+	0, 1450, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1638, 1639, 190,
+	0, 0, 0, 0, 0, 2156, 0, 0, 0, 0,
+	0, 0, 190, 0, 0, 190, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2075, 0,
+	0, 0, 0, 0, 0, 149, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1806, 0, 0, 0, 0, 0,
+	0, 2269, 0, 604, 604, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1820, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 143, 604, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 137, 0, 0, 138,
+	0, 190, 0, 0, 0, 0, 0, 0, 0, 1443,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1134, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 604, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1209, 190,
+	190, 190, 190, 190, 0, 0, 0, 0, 0, 0,
+	0, 1753, 0, 0, 0, 190, 0, 0, 190, 190,
+	0, 0, 190, 1763, 1307, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1666, 0, 0, 581, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 155, 152, 158, 159, 160, 161,
+	163, 164, 165, 166, 0, 0, 0, 0, 0, 167,
+	168, 169, 170, 0, 0, 0, 1704, 0, 0, 0,
+	0, 0, 0, 0, 992, 0, 190, 1122, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1209, 0, 0, 0, 0, 0, 0, 1090, 0, 0,
+	1307, 0, 0, 0, 1735, 1736, 0, 0, 1090, 1090,
+	1090, 1090, 1090, 0, 0, 0, 0, 0, 0, 0,
+	1135, 0, 190, 0, 1498, 0, 0, 1090, 0, 0,
+	0, 1090, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1148, 1151, 1152, 1153,
+	1154, 1155, 1156, 604, 1157, 1158, 1159, 1160, 1161, 1136,
+	1137, 1138, 1139, 1120, 1121, 1149, 0, 1123, 0, 1124,
+	1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1140,
+	1141, 1142, 1143, 1144, 1145, 1146, 1147, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1823, 0, 0, 0, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1209,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1869, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 190, 0, 1150, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 0, 190, 190, 190, 0, 0, 0, 0,
+	0, 1209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 190, 0, 0, 0, 0, 0, 1913,
+	0, 34, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1090, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1928, 0,
-	1929, 1930, 1931, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1941,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1954, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1209, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1991, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 730, 717, 0, 0, 666, 733, 637, 655,
-	742, 657, 660, 700, 617, 679, 329, 652, 0, 641,
-	613, 648, 614, 639, 668, 240, 672, 636, 719, 682,
-	732, 287, 0, 619, 642, 342, 702, 379, 226, 296,
-	294, 406, 250, 243, 239, 225, 271, 302, 340, 397,
-	334, 739, 291, 689, 0, 388, 314, 0, 0, 0,
-	670, 722, 677, 713, 665, 701, 626, 688, 734, 653,
-	697, 735, 277, 224, 194, 326, 389, 253, 0, 0,
-	0, 176, 177, 178, 0, 2132, 2133, 0, 0, 0,
-	0, 0, 216, 0, 222, 694, 729, 650, 696, 236,
-	275, 242, 235, 404, 699, 745, 612, 691, 0, 615,
-	618, 741, 725, 645, 646, 0, 0, 0, 0, 0,
-	0, 0, 669, 678, 710, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 643, 0, 687, 0, 0, 0,
-	622, 616, 2086, 0, 0, 0, 667, 0, 0, 0,
-	625, 0, 644, 711, 2095, 610, 261, 620, 315, 715,
-	724, 664, 432, 728, 662, 661, 731, 706, 623, 721,
-	656, 286, 621, 283, 190, 204, 0, 654, 325, 363,
-	369, 720, 640, 649, 227, 647, 367, 338, 419, 212,
-	251, 360, 343, 365, 686, 704, 366, 292, 408, 355,
-	418, 433, 434, 234, 319, 425, 401, 430, 442, 205,
-	231, 332, 394, 422, 385, 312, 405, 282, 384, 259,
-	193, 290, 197, 396, 416, 217, 377, 0, 0, 0,
-	199, 414, 393, 309, 279, 280, 198, 0, 359, 238,
-	257, 229, 328, 411, 412, 228, 444, 207, 429, 201,
-	208, 428, 321, 407, 415, 310, 301, 200, 413, 308,
-	300, 285, 248, 267, 353, 295, 354, 268, 317, 316,
-	318, 0, 195, 0, 390, 423, 445, 214, 635, 716,
-	403, 438, 441, 0, 356, 215, 258, 247, 352, 256,
-	288, 437, 439, 440, 213, 350, 264, 320, 209, 270,
-	386, 284, 293, 708, 744, 337, 368, 218, 421, 387,
-	630, 634, 628, 629, 680, 681, 631, 736, 737, 738,
-	712, 624, 0, 632, 633, 0, 718, 726, 727, 685,
-	189, 202, 289, 740, 357, 254, 443, 427, 424, 611,
-	627, 233, 638, 0, 0, 651, 658, 659, 671, 673,
-	674, 675, 676, 684, 692, 693, 695, 703, 705, 707,
-	709, 714, 723, 743, 191, 192, 203, 211, 220, 232,
-	245, 252, 262, 266, 269, 272, 273, 276, 281, 298,
-	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
-	333, 335, 336, 339, 345, 346, 347, 348, 349, 351,
-	358, 362, 370, 371, 372, 373, 374, 375, 376, 380,
-	381, 382, 383, 391, 395, 409, 410, 420, 431, 435,
-	263, 417, 436, 0, 297, 683, 690, 299, 249, 265,
-	274, 698, 426, 392, 206, 364, 255, 196, 223, 210,
-	230, 244, 246, 278, 307, 313, 341, 344, 260, 241,
-	221, 361, 219, 378, 398, 399, 400, 402, 311, 237,
-	730, 717, 0, 0, 666, 733, 637, 655, 742, 657,
-	660, 700, 617, 679, 329, 652, 0, 641, 613, 648,
-	614, 639, 668, 240, 672, 636, 719, 682, 732, 287,
-	0, 619, 642, 342, 702, 379, 226, 296, 294, 406,
-	250, 243, 239, 225, 271, 302, 340, 397, 334, 739,
-	291, 689, 0, 388, 314, 0, 0, 0, 670, 722,
-	677, 713, 665, 701, 626, 688, 734, 653, 697, 735,
-	277, 224, 194, 326, 389, 253, 0, 0, 0, 176,
-	177, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	216, 0, 222, 694, 729, 650, 696, 236, 275, 242,
-	235, 404, 699, 745, 612, 691, 0, 615, 618, 741,
-	725, 645, 646, 0, 0, 0, 0, 0, 0, 0,
-	669, 678, 710, 663, 0, 0, 0, 0, 0, 0,
-	1873, 0, 643, 0, 687, 0, 0, 0, 622, 616,
-	0, 0, 0, 0, 667, 0, 0, 0, 625, 0,
-	644, 711, 0, 610, 261, 620, 315, 715, 724, 664,
-	432, 728, 662, 661, 731, 706, 623, 721, 656, 286,
-	621, 283, 190, 204, 0, 654, 325, 363, 369, 720,
-	640, 649, 227, 647, 367, 338, 419, 212, 251, 360,
-	343, 365, 686, 704, 366, 292, 408, 355, 418, 433,
-	434, 234, 319, 425, 401, 430, 442, 205, 231, 332,
-	394, 422, 385, 312, 405, 282, 384, 259, 193, 290,
-	197, 396, 416, 217, 377, 0, 0, 0, 199, 414,
-	393, 309, 279, 280, 198, 0, 359, 238, 257, 229,
-	328, 411, 412, 228, 444, 207, 429, 201, 208, 428,
-	321, 407, 415, 310, 301, 200, 413, 308, 300, 285,
-	248, 267, 353, 295, 354, 268, 317, 316, 318, 0,
-	195, 0, 390, 423, 445, 214, 635, 716, 403, 438,
-	441, 0, 356, 215, 258, 247, 352, 256, 288, 437,
-	439, 440, 213, 350, 264, 320, 209, 270, 386, 284,
-	293, 708, 744, 337, 368, 218, 421, 387, 630, 634,
-	628, 629, 680, 681, 631, 736, 737, 738, 712, 624,
-	0, 632, 633, 0, 718, 726, 727, 685, 189, 202,
-	289, 740, 357, 254, 443, 427, 424, 611, 627, 233,
-	638, 0, 0, 651, 658, 659, 671, 673, 674, 675,
-	676, 684, 692, 693, 695, 703, 705, 707, 709, 714,
-	723, 743, 191, 192, 203, 211, 220, 232, 245, 252,
-	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
-	305, 306, 322, 323, 324, 327, 330, 331, 333, 335,
-	336, 339, 345, 346, 347, 348, 349, 351, 358, 362,
-	370, 371, 372, 373, 374, 375, 376, 380, 381, 382,
-	383, 391, 395, 409, 410, 420, 431, 435, 263, 417,
-	436, 0, 297, 683, 690, 299, 249, 265, 274, 698,
-	426, 392, 206, 364, 255, 196, 223, 210, 230, 244,
-	246, 278, 307, 313, 341, 344, 260, 241, 221, 361,
-	219, 378, 398, 399, 400, 402, 311, 237, 730, 717,
-	0, 0, 666, 733, 637, 655, 742, 657, 660, 700,
-	617, 679, 329, 652, 0, 641, 613, 648, 614, 639,
-	668, 240, 672, 636, 719, 682, 732, 287, 0, 619,
-	642, 342, 702, 379, 226, 296, 294, 406, 250, 243,
-	239, 225, 271, 302, 340, 397, 334, 739, 291, 689,
-	0, 388, 314, 0, 0, 0, 670, 722, 677, 713,
-	665, 701, 626, 688, 734, 653, 697, 735, 277, 224,
-	194, 326, 389, 253, 0, 0, 0, 176, 177, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 216, 0,
-	222, 694, 729, 650, 696, 236, 275, 242, 235, 404,
-	699, 745, 612, 691, 0, 615, 618, 741, 725, 645,
-	646, 0, 0, 0, 0, 0, 0, 0, 669, 678,
-	710, 663, 0, 0, 0, 0, 0, 0, 1735, 0,
-	643, 0, 687, 0, 0, 0, 622, 616, 0, 0,
-	0, 0, 667, 0, 0, 0, 625, 0, 644, 711,
-	0, 610, 261, 620, 315, 715, 724, 664, 432, 728,
-	662, 661, 731, 706, 623, 721, 656, 286, 621, 283,
-	190, 204, 0, 654, 325, 363, 369, 720, 640, 649,
-	227, 647, 367, 338, 419, 212, 251, 360, 343, 365,
-	686, 704, 366, 292, 408, 355, 418, 433, 434, 234,
-	319, 425, 401, 430, 442, 205, 231, 332, 394, 422,
-	385, 312, 405, 282, 384, 259, 193, 290, 197, 396,
-	416, 217, 377, 0, 0, 0, 199, 414, 393, 309,
-	279, 280, 198, 0, 359, 238, 257, 229, 328, 411,
-	412, 228, 444, 207, 429, 201, 208, 428, 321, 407,
-	415, 310, 301, 200, 413, 308, 300, 285, 248, 267,
-	353, 295, 354, 268, 317, 316, 318, 0, 195, 0,
-	390, 423, 445, 214, 635, 716, 403, 438, 441, 0,
-	356, 215, 258, 247, 352, 256, 288, 437, 439, 440,
-	213, 350, 264, 320, 209, 270, 386, 284, 293, 708,
-	744, 337, 368, 218, 421, 387, 630, 634, 628, 629,
-	680, 681, 631, 736, 737, 738, 712, 624, 0, 632,
-	633, 0, 718, 726, 727, 685, 189, 202, 289, 740,
-	357, 254, 443, 427, 424, 611, 627, 233, 638, 0,
-	0, 651, 658, 659, 671, 673, 674, 675, 676, 684,
-	692, 693, 695, 703, 705, 707, 709, 714, 723, 743,
-	191, 192, 203, 211, 220, 232, 245, 252, 262, 266,
-	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
-	322, 323, 324, 327, 330, 331, 333, 335, 336, 339,
-	345, 346, 347, 348, 349, 351, 358, 362, 370, 371,
-	372, 373, 374, 375, 376, 380, 381, 382, 383, 391,
-	395, 409, 410, 420, 431, 435, 263, 417, 436, 0,
-	297, 683, 690, 299, 249, 265, 274, 698, 426, 392,
-	206, 364, 255, 196, 223, 210, 230, 244, 246, 278,
-	307, 313, 341, 344, 260, 241, 221, 361, 219, 378,
-	398, 399, 400, 402, 311, 237, 730, 717, 0, 0,
-	666, 733, 637, 655, 742, 657, 660, 700, 617, 679,
-	329, 652, 0, 641, 613, 648, 614, 639, 668, 240,
-	672, 636, 719, 682, 732, 287, 0, 619, 642, 342,
-	702, 379, 226, 296, 294, 406, 250, 243, 239, 225,
-	271, 302, 340, 397, 334, 739, 291, 689, 0, 388,
-	314, 0, 0, 0, 670, 722, 677, 713, 665, 701,
-	626, 688, 734, 653, 697, 735, 277, 224, 194, 326,
-	389, 253, 0, 0, 0, 176, 177, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 216, 0, 222, 694,
-	729, 650, 696, 236, 275, 242, 235, 404, 699, 745,
-	612, 691, 0, 615, 618, 741, 725, 645, 646, 0,
-	0, 0, 0, 0, 0, 0, 669, 678, 710, 663,
-	0, 0, 0, 0, 0, 0, 1451, 0, 643, 0,
-	687, 0, 0, 0, 622, 616, 0, 0, 0, 0,
-	667, 0, 0, 0, 625, 0, 644, 711, 0, 610,
-	261, 620, 315, 715, 724, 664, 432, 728, 662, 661,
-	731, 706, 623, 721, 656, 286, 621, 283, 190, 204,
-	0, 654, 325, 363, 369, 720, 640, 649, 227, 647,
-	367, 338, 419, 212, 251, 360, 343, 365, 686, 704,
-	366, 292, 408, 355, 418, 433, 434, 234, 319, 425,
-	401, 430, 442, 205, 231, 332, 394, 422, 385, 312,
-	405, 282, 384, 259, 193, 290, 197, 396, 416, 217,
-	377, 0, 0, 0, 199, 414, 393, 309, 279, 280,
-	198, 0, 359, 238, 257, 229, 328, 411, 412, 228,
-	444, 207, 429, 201, 208, 428, 321, 407, 415, 310,
-	301, 200, 413, 308, 300, 285, 248, 267, 353, 295,
-	354, 268, 317, 316, 318, 0, 195, 0, 390, 423,
-	445, 214, 635, 716, 403, 438, 441, 0, 356, 215,
-	258, 247, 352, 256, 288, 437, 439, 440, 213, 350,
-	264, 320, 209, 270, 386, 284, 293, 708, 744, 337,
-	368, 218, 421, 387, 630, 634, 628, 629, 680, 681,
-	631, 736, 737, 738, 712, 624, 0, 632, 633, 0,
-	718, 726, 727, 685, 189, 202, 289, 740, 357, 254,
-	443, 427, 424, 611, 627, 233, 638, 0, 0, 651,
-	658, 659, 671, 673, 674, 675, 676, 684, 692, 693,
-	695, 703, 705, 707, 709, 714, 723, 743, 191, 192,
-	203, 211, 220, 232, 245, 252, 262, 266, 269, 272,
-	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
-	324, 327, 330, 331, 333, 335, 336, 339, 345, 346,
-	347, 348, 349, 351, 358, 362, 370, 371, 372, 373,
-	374, 375, 376, 380, 381, 382, 383, 391, 395, 409,
-	410, 420, 431, 435, 263, 417, 436, 0, 297, 683,
-	690, 299, 249, 265, 274, 698, 426, 392, 206, 364,
-	255, 196, 223, 210, 230, 244, 246, 278, 307, 313,
-	341, 344, 260, 241, 221, 361, 219, 378, 398, 399,
-	400, 402, 311, 237, 730, 717, 0, 0, 666, 733,
-	637, 655, 742, 657, 660, 700, 617, 679, 329, 652,
-	0, 641, 613, 648, 614, 639, 668, 240, 672, 636,
-	719, 682, 732, 287, 0, 619, 642, 342, 702, 379,
-	226, 296, 294, 406, 250, 243, 239, 225, 271, 302,
-	340, 397, 334, 739, 291, 689, 0, 388, 314, 0,
-	0, 0, 670, 722, 677, 713, 665, 701, 626, 688,
-	734, 653, 697, 735, 277, 224, 194, 326, 389, 253,
-	69, 0, 0, 176, 177, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 216, 0, 222, 694, 729, 650,
-	696, 236, 275, 242, 235, 404, 699, 745, 612, 691,
-	0, 615, 618, 741, 725, 645, 646, 0, 0, 0,
-	0, 0, 0, 0, 669, 678, 710, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 643, 0, 687, 0,
-	0, 0, 622, 616, 0, 0, 0, 0, 667, 0,
-	0, 0, 625, 0, 644, 711, 0, 610, 261, 620,
-	315, 715, 724, 664, 432, 728, 662, 661, 731, 706,
-	623, 721, 656, 286, 621, 283, 190, 204, 0, 654,
-	325, 363, 369, 720, 640, 649, 227, 647, 367, 338,
-	419, 212, 251, 360, 343, 365, 686, 704, 366, 292,
-	408, 355, 418, 433, 434, 234, 319, 425, 401, 430,
-	442, 205, 231, 332, 394, 422, 385, 312, 405, 282,
-	384, 259, 193, 290, 197, 396, 416, 217, 377, 0,
-	0, 0, 199, 414, 393, 309, 279, 280, 198, 0,
-	359, 238, 257, 229, 328, 411, 412, 228, 444, 207,
-	429, 201, 208, 428, 321, 407, 415, 310, 301, 200,
-	413, 308, 300, 285, 248, 267, 353, 295, 354, 268,
-	317, 316, 318, 0, 195, 0, 390, 423, 445, 214,
-	635, 716, 403, 438, 441, 0, 356, 215, 258, 247,
-	352, 256, 288, 437, 439, 440, 213, 350, 264, 320,
-	209, 270, 386, 284, 293, 708, 744, 337, 368, 218,
-	421, 387, 630, 634, 628, 629, 680, 681, 631, 736,
-	737, 738, 712, 624, 0, 632, 633, 0, 718, 726,
-	727, 685, 189, 202, 289, 740, 357, 254, 443, 427,
-	424, 611, 627, 233, 638, 0, 0, 651, 658, 659,
-	671, 673, 674, 675, 676, 684, 692, 693, 695, 703,
-	705, 707, 709, 714, 723, 743, 191, 192, 203, 211,
-	220, 232, 245, 252, 262, 266, 269, 272, 273, 276,
-	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
-	330, 331, 333, 335, 336, 339, 345, 346, 347, 348,
-	349, 351, 358, 362, 370, 371, 372, 373, 374, 375,
-	376, 380, 381, 382, 383, 391, 395, 409, 410, 420,
-	431, 435, 263, 417, 436, 0, 297, 683, 690, 299,
-	249, 265, 274, 698, 426, 392, 206, 364, 255, 196,
-	223, 210, 230, 244, 246, 278, 307, 313, 341, 344,
-	260, 241, 221, 361, 219, 378, 398, 399, 400, 402,
-	311, 237, 730, 717, 0, 0, 666, 733, 637, 655,
-	742, 657, 660, 700, 617, 679, 329, 652, 0, 641,
-	613, 648, 614, 639, 668, 240, 672, 636, 719, 682,
-	732, 287, 0, 619, 642, 342, 702, 379, 226, 296,
-	294, 406, 250, 243, 239, 225, 271, 302, 340, 397,
-	334, 739, 291, 689, 0, 388, 314, 0, 0, 0,
-	670, 722, 677, 713, 665, 701, 626, 688, 734, 653,
-	697, 735, 277, 224, 194, 326, 389, 253, 0, 0,
-	0, 176, 177, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 216, 0, 222, 694, 729, 650, 696, 236,
-	275, 242, 235, 404, 699, 745, 612, 691, 0, 615,
-	618, 741, 725, 645, 646, 0, 0, 0, 0, 0,
-	0, 0, 669, 678, 710, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 643, 0, 687, 0, 0, 0,
-	622, 616, 0, 0, 0, 0, 667, 0, 0, 0,
-	625, 0, 644, 711, 0, 610, 261, 620, 315, 715,
-	724, 664, 432, 728, 662, 661, 731, 706, 623, 721,
-	656, 286, 621, 283, 190, 204, 0, 654, 325, 363,
-	369, 720, 640, 649, 227, 647, 367, 338, 419, 212,
-	251, 360, 343, 365, 686, 704, 366, 292, 408, 355,
-	418, 433, 434, 234, 319, 425, 401, 430, 442, 205,
-	231, 332, 394, 422, 385, 312, 405, 282, 384, 259,
-	193, 290, 197, 396, 416, 217, 377, 0, 0, 0,
-	199, 414, 393, 309, 279, 280, 198, 0, 359, 238,
-	257, 229, 328, 411, 412, 228, 444, 207, 429, 201,
-	208, 428, 321, 407, 415, 310, 301, 200, 413, 308,
-	300, 285, 248, 267, 353, 295, 354, 268, 317, 316,
-	318, 0, 195, 0, 390, 423, 445, 214, 635, 716,
-	403, 438, 441, 0, 356, 215, 258, 247, 352, 256,
-	288, 437, 439, 440, 213, 350, 264, 320, 209, 270,
-	386, 284, 293, 708, 744, 337, 368, 218, 421, 387,
-	630, 634, 628, 629, 680, 681, 631, 736, 737, 738,
-	712, 624, 0, 632, 633, 0, 718, 726, 727, 685,
-	189, 202, 289, 740, 357, 254, 443, 427, 424, 611,
-	627, 233, 638, 0, 0, 651, 658, 659, 671, 673,
-	674, 675, 676, 684, 692, 693, 695, 703, 705, 707,
-	709, 714, 723, 743, 191, 192, 203, 211, 220, 232,
-	245, 252, 262, 266, 269, 272, 273, 276, 281, 298,
-	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
-	333, 335, 336, 339, 345, 346, 347, 348, 349, 351,
-	358, 362, 370, 371, 372, 373, 374, 375, 376, 380,
-	381, 382, 383, 391, 395, 409, 410, 420, 431, 435,
-	263, 417, 436, 0, 297, 683, 690, 299, 249, 265,
-	274, 698, 426, 392, 206, 364, 255, 196, 223, 210,
-	230, 244, 246, 278, 307, 313, 341, 344, 260, 241,
-	221, 361, 219, 378, 398, 399, 400, 402, 311, 237,
-	730, 717, 0, 0, 666, 733, 637, 655, 742, 657,
-	660, 700, 617, 679, 329, 652, 0, 641, 613, 648,
-	614, 639, 668, 240, 672, 636, 719, 682, 732, 287,
-	0, 619, 642, 342, 702, 379, 226, 296, 294, 406,
-	250, 243, 239, 225, 271, 302, 340, 397, 334, 739,
-	291, 689, 0, 388, 314, 0, 0, 0, 670, 722,
-	677, 713, 665, 701, 626, 688, 734, 653, 697, 735,
-	277, 224, 194, 326, 389, 253, 0, 0, 0, 176,
-	177, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	216, 0, 222, 694, 729, 650, 696, 236, 275, 242,
-	235, 404, 699, 745, 612, 691, 0, 615, 618, 741,
-	725, 645, 646, 0, 0, 0, 0, 0, 0, 0,
-	669, 678, 710, 663, 0, 0, 0, 0, 0, 0,
-	0, 0, 643, 0, 687, 0, 0, 0, 622, 616,
-	0, 0, 0, 0, 667, 0, 0, 0, 625, 0,
-	644, 711, 0, 610, 261, 620, 315, 715, 724, 664,
-	432, 728, 662, 661, 731, 706, 623, 721, 656, 286,
-	621, 283, 190, 204, 0, 654, 325, 363, 369, 720,
-	640, 649, 227, 647, 367, 338, 419, 212, 251, 360,
-	343, 365, 686, 704, 366, 292, 408, 355, 418, 433,
-	434, 234, 319, 425, 401, 430, 442, 205, 231, 332,
-	394, 422, 385, 312, 405, 282, 384, 259, 193, 290,
-	197, 396, 416, 217, 377, 0, 0, 0, 199, 414,
-	393, 309, 279, 280, 198, 0, 359, 238, 257, 229,
-	328, 411, 412, 228, 444, 207, 429, 201, 747, 428,
-	321, 407, 415, 310, 301, 200, 413, 308, 300, 285,
-	248, 267, 353, 295, 354, 268, 317, 316, 318, 0,
-	195, 0, 390, 423, 445, 214, 635, 716, 403, 438,
-	441, 0, 356, 215, 258, 247, 352, 256, 288, 437,
-	439, 440, 213, 350, 264, 609, 746, 603, 602, 284,
-	293, 708, 744, 337, 368, 218, 421, 387, 630, 634,
-	628, 629, 680, 681, 631, 736, 737, 738, 712, 624,
-	0, 632, 633, 0, 718, 726, 727, 685, 189, 202,
-	289, 740, 357, 254, 443, 427, 424, 611, 627, 233,
-	638, 0, 0, 651, 658, 659, 671, 673, 674, 675,
-	676, 684, 692, 693, 695, 703, 705, 707, 709, 714,
-	723, 743, 191, 192, 203, 211, 220, 232, 245, 252,
-	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
-	305, 306, 322, 323, 324, 327, 330, 331, 333, 335,
-	336, 339, 345, 346, 347, 348, 349, 351, 358, 362,
-	370, 371, 372, 373, 374, 375, 376, 380, 381, 382,
-	383, 391, 395, 409, 410, 420, 431, 435, 263, 417,
-	436, 0, 297, 683, 690, 299, 249, 265, 274, 698,
-	426, 392, 206, 364, 255, 196, 223, 210, 230, 244,
-	246, 278, 307, 313, 341, 344, 260, 241, 221, 361,
-	219, 378, 398, 399, 400, 402, 311, 237, 730, 717,
-	0, 0, 666, 733, 637, 655, 742, 657, 660, 700,
-	617, 679, 329, 652, 0, 641, 613, 648, 614, 639,
-	668, 240, 672, 636, 719, 682, 732, 287, 0, 619,
-	642, 342, 702, 379, 226, 296, 294, 406, 250, 243,
-	239, 225, 271, 302, 340, 397, 334, 739, 291, 689,
-	0, 388, 314, 0, 0, 0, 670, 722, 677, 713,
-	665, 701, 626, 688, 734, 653, 697, 735, 277, 224,
-	194, 326, 389, 253, 0, 0, 0, 176, 177, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 216, 0,
-	222, 694, 729, 650, 696, 236, 275, 242, 235, 404,
-	699, 745, 612, 691, 0, 615, 618, 741, 725, 645,
-	646, 0, 0, 0, 0, 0, 0, 0, 669, 678,
-	710, 663, 0, 0, 0, 0, 0, 0, 0, 0,
-	643, 0, 687, 0, 0, 0, 622, 616, 0, 0,
-	0, 0, 667, 0, 0, 0, 625, 0, 644, 711,
-	0, 610, 261, 620, 315, 715, 724, 664, 432, 728,
-	662, 661, 731, 706, 623, 721, 656, 286, 621, 283,
-	190, 204, 0, 654, 325, 363, 369, 720, 640, 649,
-	227, 647, 367, 338, 419, 212, 251, 360, 343, 365,
-	686, 704, 366, 292, 408, 355, 418, 433, 434, 234,
-	319, 425, 401, 430, 442, 205, 231, 332, 394, 422,
-	385, 312, 405, 282, 384, 259, 193, 290, 197, 396,
-	1080, 217, 377, 0, 0, 0, 199, 414, 393, 309,
-	279, 280, 198, 0, 359, 238, 257, 229, 328, 411,
-	412, 228, 444, 207, 429, 201, 747, 428, 321, 407,
-	415, 310, 301, 200, 413, 308, 300, 285, 248, 267,
-	353, 295, 354, 268, 317, 316, 318, 0, 195, 0,
-	390, 423, 445, 214, 635, 716, 403, 438, 441, 0,
-	356, 215, 258, 247, 352, 256, 288, 437, 439, 440,
-	213, 350, 264, 609, 746, 603, 602, 284, 293, 708,
-	744, 337, 368, 218, 421, 387, 630, 634, 628, 629,
-	680, 681, 631, 736, 737, 738, 712, 624, 0, 632,
-	633, 0, 718, 726, 727, 685, 189, 202, 289, 740,
-	357, 254, 443, 427, 424, 611, 627, 233, 638, 0,
-	0, 651, 658, 659, 671, 673, 674, 675, 676, 684,
-	692, 693, 695, 703, 705, 707, 709, 714, 723, 743,
-	191, 192, 203, 211, 220, 232, 245, 252, 262, 266,
-	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
-	322, 323, 324, 327, 330, 331, 333, 335, 336, 339,
-	345, 346, 347, 348, 349, 351, 358, 362, 370, 371,
-	372, 373, 374, 375, 376, 380, 381, 382, 383, 391,
-	395, 409, 410, 420, 431, 435, 263, 417, 436, 0,
-	297, 683, 690, 299, 249, 265, 274, 698, 426, 392,
-	206, 364, 255, 196, 223, 210, 230, 244, 246, 278,
-	307, 313, 341, 344, 260, 241, 221, 361, 219, 378,
-	398, 399, 400, 402, 311, 237, 730, 717, 0, 0,
-	666, 733, 637, 655, 742, 657, 660, 700, 617, 679,
-	329, 652, 0, 641, 613, 648, 614, 639, 668, 240,
-	672, 636, 719, 682, 732, 287, 0, 619, 642, 342,
-	702, 379, 226, 296, 294, 406, 250, 243, 239, 225,
-	271, 302, 340, 397, 334, 739, 291, 689, 0, 388,
-	314, 0, 0, 0, 670, 722, 677, 713, 665, 701,
-	626, 688, 734, 653, 697, 735, 277, 224, 194, 326,
-	389, 253, 0, 0, 0, 176, 177, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 216, 0, 222, 694,
-	729, 650, 696, 236, 275, 242, 235, 404, 699, 745,
-	612, 691, 0, 615, 618, 741, 725, 645, 646, 0,
-	0, 0, 0, 0, 0, 0, 669, 678, 710, 663,
-	0, 0, 0, 0, 0, 0, 0, 0, 643, 0,
-	687, 0, 0, 0, 622, 616, 0, 0, 0, 0,
-	667, 0, 0, 0, 625, 0, 644, 711, 0, 610,
-	261, 620, 315, 715, 724, 664, 432, 728, 662, 661,
-	731, 706, 623, 721, 656, 286, 621, 283, 190, 204,
-	0, 654, 325, 363, 369, 720, 640, 649, 227, 647,
-	367, 338, 419, 212, 251, 360, 343, 365, 686, 704,
-	366, 292, 408, 355, 418, 433, 434, 234, 319, 425,
-	401, 430, 442, 205, 231, 332, 394, 422, 385, 312,
-	405, 282, 384, 259, 193, 290, 197, 396, 600, 217,
-	377, 0, 0, 0, 199, 414, 393, 309, 279, 280,
-	198, 0, 359, 238, 257, 229, 328, 411, 412, 228,
-	444, 207, 429, 201, 747, 428, 321, 407, 415, 310,
-	301, 200, 413, 308, 300, 285, 248, 267, 353, 295,
-	354, 268, 317, 316, 318, 0, 195, 0, 390, 423,
-	445, 214, 635, 716, 403, 438, 441, 0, 356, 215,
-	258, 247, 352, 256, 288, 437, 439, 440, 213, 350,
-	264, 609, 746, 603, 602, 284, 293, 708, 744, 337,
-	368, 218, 421, 387, 630, 634, 628, 629, 680, 681,
-	631, 736, 737, 738, 712, 624, 0, 632, 633, 0,
-	718, 726, 727, 685, 189, 202, 289, 740, 357, 254,
-	443, 427, 424, 611, 627, 233, 638, 0, 0, 651,
-	658, 659, 671, 673, 674, 675, 676, 684, 692, 693,
-	695, 703, 705, 707, 709, 714, 723, 743, 191, 192,
-	203, 211, 220, 232, 245, 252, 262, 266, 269, 272,
-	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
-	324, 327, 330, 331, 333, 335, 336, 339, 345, 346,
-	347, 348, 349, 351, 358, 362, 370, 371, 372, 373,
-	374, 375, 376, 380, 381, 382, 383, 391, 395, 409,
-	410, 420, 431, 435, 263, 417, 436, 0, 297, 683,
-	690, 299, 249, 265, 274, 698, 426, 392, 206, 364,
-	255, 196, 223, 210, 230, 244, 246, 278, 307, 313,
-	341, 344, 260, 241, 221, 361, 219, 378, 398, 399,
-	400, 402, 311, 237, 329, 0, 0, 1378, 0, 503,
-	0, 0, 0, 240, 0, 502, 0, 0, 0, 287,
-	0, 0, 1379, 342, 0, 379, 226, 296, 294, 406,
-	250, 243, 239, 225, 271, 302, 340, 397, 334, 546,
-	291, 0, 0, 388, 314, 0, 0, 0, 0, 0,
-	537, 538, 0, 0, 0, 0, 0, 0, 0, 0,
-	277, 224, 194, 326, 389, 253, 69, 0, 0, 176,
-	177, 178, 524, 523, 526, 527, 528, 529, 0, 0,
-	216, 525, 222, 530, 531, 532, 0, 236, 275, 242,
-	235, 404, 0, 0, 0, 500, 517, 0, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 514, 515,
-	590, 0, 0, 0, 560, 0, 516, 0, 0, 509,
-	510, 512, 511, 513, 518, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 315, 559, 0, 0,
-	432, 0, 0, 557, 0, 0, 0, 0, 0, 286,
-	0, 283, 190, 204, 0, 0, 325, 363, 369, 0,
-	0, 0, 227, 0, 367, 338, 419, 212, 251, 360,
-	343, 365, 0, 0, 366, 292, 408, 355, 418, 433,
-	434, 234, 319, 425, 401, 430, 442, 205, 231, 332,
-	394, 422, 385, 312, 405, 282, 384, 259, 193, 290,
-	197, 396, 416, 217, 377, 0, 0, 0, 199, 414,
-	393, 309, 279, 280, 198, 0, 359, 238, 257, 229,
-	328, 411, 412, 228, 444, 207, 429, 201, 208, 428,
-	321, 407, 415, 310, 301, 200, 413, 308, 300, 285,
-	248, 267, 353, 295, 354, 268, 317, 316, 318, 0,
-	195, 0, 390, 423, 445, 214, 0, 0, 403, 438,
-	441, 0, 356, 215, 258, 247, 352, 256, 288, 437,
-	439, 440, 213, 350, 264, 320, 209, 270, 386, 284,
-	293, 0, 0, 337, 368, 218, 421, 387, 547, 558,
-	553, 554, 551, 552, 0, 550, 549, 548, 561, 539,
-	540, 541, 542, 544, 0, 555, 556, 543, 189, 202,
-	289, 0, 357, 254, 443, 427, 424, 0, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 203, 211, 220, 232, 245, 252,
-	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
-	305, 306, 322, 323, 324, 327, 330, 331, 333, 335,
-	336, 339, 345, 346, 347, 348, 349, 351, 358, 362,
-	370, 371, 372, 373, 374, 375, 376, 380, 381, 382,
-	383, 391, 395, 409, 410, 420, 431, 435, 263, 417,
-	436, 0, 297, 0, 0, 299, 249, 265, 274, 0,
-	426, 392, 206, 364, 255, 196, 223, 210, 230, 244,
-	246, 278, 307, 313, 341, 344, 260, 241, 221, 361,
-	219, 378, 398, 399, 400, 402, 311, 237, 329, 0,
-	0, 0, 0, 503, 0, 0, 0, 240, 0, 502,
-	0, 0, 0, 287, 0, 0, 0, 342, 0, 379,
-	226, 296, 294, 406, 250, 243, 239, 225, 271, 302,
-	340, 397, 334, 546, 291, 0, 0, 388, 314, 0,
-	0, 0, 0, 0, 537, 538, 0, 0, 0, 0,
-	0, 0, 1490, 0, 277, 224, 194, 326, 389, 253,
-	69, 0, 0, 176, 177, 178, 524, 523, 526, 527,
-	528, 529, 0, 0, 216, 525, 222, 530, 531, 532,
-	1491, 236, 275, 242, 235, 404, 0, 0, 0, 500,
-	517, 0, 545, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 514, 515, 0, 0, 0, 0, 560, 0,
-	516, 0, 0, 509, 510, 512, 511, 513, 518, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	315, 559, 0, 0, 432, 0, 0, 557, 0, 0,
-	0, 0, 0, 286, 0, 283, 190, 204, 0, 0,
-	325, 363, 369, 0, 0, 0, 227, 0, 367, 338,
-	419, 212, 251, 360, 343, 365, 0, 0, 366, 292,
-	408, 355, 418, 433, 434, 234, 319, 425, 401, 430,
-	442, 205, 231, 332, 394, 422, 385, 312, 405, 282,
-	384, 259, 193, 290, 197, 396, 416, 217, 377, 0,
-	0, 0, 199, 414, 393, 309, 279, 280, 198, 0,
-	359, 238, 257, 229, 328, 411, 412, 228, 444, 207,
-	429, 201, 208, 428, 321, 407, 415, 310, 301, 200,
-	413, 308, 300, 285, 248, 267, 353, 295, 354, 268,
-	317, 316, 318, 0, 195, 0, 390, 423, 445, 214,
-	0, 0, 403, 438, 441, 0, 356, 215, 258, 247,
-	352, 256, 288, 437, 439, 440, 213, 350, 264, 320,
-	209, 270, 386, 284, 293, 0, 0, 337, 368, 218,
-	421, 387, 547, 558, 553, 554, 551, 552, 0, 550,
-	549, 548, 561, 539, 540, 541, 542, 544, 0, 555,
-	556, 543, 189, 202, 289, 0, 357, 254, 443, 427,
-	424, 0, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 203, 211,
-	220, 232, 245, 252, 262, 266, 269, 272, 273, 276,
-	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
-	330, 331, 333, 335, 336, 339, 345, 346, 347, 348,
-	349, 351, 358, 362, 370, 371, 372, 373, 374, 375,
-	376, 380, 381, 382, 383, 391, 395, 409, 410, 420,
-	431, 435, 263, 417, 436, 0, 297, 0, 0, 299,
-	249, 265, 274, 0, 426, 392, 206, 364, 255, 196,
-	223, 210, 230, 244, 246, 278, 307, 313, 341, 344,
-	260, 241, 221, 361, 219, 378, 398, 399, 400, 402,
-	311, 237, 329, 0, 0, 0, 0, 503, 0, 0,
-	0, 240, 0, 502, 0, 0, 0, 287, 0, 0,
-	0, 342, 0, 379, 226, 296, 294, 406, 250, 243,
-	239, 225, 271, 302, 340, 397, 334, 546, 291, 0,
-	0, 388, 314, 0, 0, 0, 0, 0, 537, 538,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 224,
-	194, 326, 389, 253, 69, 0, 578, 176, 177, 178,
-	524, 523, 526, 527, 528, 529, 0, 0, 216, 525,
-	222, 530, 531, 532, 0, 236, 275, 242, 235, 404,
-	0, 0, 0, 500, 517, 0, 545, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 514, 515, 0, 0,
-	0, 0, 560, 0, 516, 0, 0, 509, 510, 512,
-	511, 513, 518, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 0, 315, 559, 0, 0, 432, 0,
-	0, 557, 0, 0, 0, 0, 0, 286, 0, 283,
-	190, 204, 0, 0, 325, 363, 369, 0, 0, 0,
-	227, 0, 367, 338, 419, 212, 251, 360, 343, 365,
-	0, 0, 366, 292, 408, 355, 418, 433, 434, 234,
-	319, 425, 401, 430, 442, 205, 231, 332, 394, 422,
-	385, 312, 405, 282, 384, 259, 193, 290, 197, 396,
-	416, 217, 377, 0, 0, 0, 199, 414, 393, 309,
-	279, 280, 198, 0, 359, 238, 257, 229, 328, 411,
-	412, 228, 444, 207, 429, 201, 208, 428, 321, 407,
-	415, 310, 301, 200, 413, 308, 300, 285, 248, 267,
-	353, 295, 354, 268, 317, 316, 318, 0, 195, 0,
-	390, 423, 445, 214, 0, 0, 403, 438, 441, 0,
-	356, 215, 258, 247, 352, 256, 288, 437, 439, 440,
-	213, 350, 264, 320, 209, 270, 386, 284, 293, 0,
-	0, 337, 368, 218, 421, 387, 547, 558, 553, 554,
-	551, 552, 0, 550, 549, 548, 561, 539, 540, 541,
-	542, 544, 0, 555, 556, 543, 189, 202, 289, 0,
-	357, 254, 443, 427, 424, 0, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 203, 211, 220, 232, 245, 252, 262, 266,
-	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
-	322, 323, 324, 327, 330, 331, 333, 335, 336, 339,
-	345, 346, 347, 348, 349, 351, 358, 362, 370, 371,
-	372, 373, 374, 375, 376, 380, 381, 382, 383, 391,
-	395, 409, 410, 420, 431, 435, 263, 417, 436, 0,
-	297, 0, 0, 299, 249, 265, 274, 0, 426, 392,
-	206, 364, 255, 196, 223, 210, 230, 244, 246, 278,
-	307, 313, 341, 344, 260, 241, 221, 361, 219, 378,
-	398, 399, 400, 402, 311, 237, 329, 0, 0, 0,
-	0, 503, 0, 0, 0, 240, 0, 502, 0, 0,
-	0, 287, 0, 0, 0, 342, 0, 379, 226, 296,
-	294, 406, 250, 243, 239, 225, 271, 302, 340, 397,
-	334, 546, 291, 0, 0, 388, 314, 0, 0, 0,
-	0, 0, 537, 538, 0, 0, 0, 0, 0, 0,
-	0, 0, 277, 224, 194, 326, 389, 253, 69, 0,
-	0, 176, 177, 178, 524, 523, 526, 527, 528, 529,
-	0, 0, 216, 525, 222, 530, 531, 532, 0, 236,
-	275, 242, 235, 404, 0, 0, 0, 500, 517, 0,
-	545, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	514, 515, 590, 0, 0, 0, 560, 0, 516, 0,
-	0, 509, 510, 512, 511, 513, 518, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 0, 315, 559,
-	0, 0, 432, 0, 0, 557, 0, 0, 0, 0,
-	0, 286, 0, 283, 190, 204, 0, 0, 325, 363,
-	369, 0, 0, 0, 227, 0, 367, 338, 419, 212,
-	251, 360, 343, 365, 0, 0, 366, 292, 408, 355,
-	418, 433, 434, 234, 319, 425, 401, 430, 442, 205,
-	231, 332, 394, 422, 385, 312, 405, 282, 384, 259,
-	193, 290, 197, 396, 416, 217, 377, 0, 0, 0,
-	199, 414, 393, 309, 279, 280, 198, 0, 359, 238,
-	257, 229, 328, 411, 412, 228, 444, 207, 429, 201,
-	208, 428, 321, 407, 415, 310, 301, 200, 413, 308,
-	300, 285, 248, 267, 353, 295, 354, 268, 317, 316,
-	318, 0, 195, 0, 390, 423, 445, 214, 0, 0,
-	403, 438, 441, 0, 356, 215, 258, 247, 352, 256,
-	288, 437, 439, 440, 213, 350, 264, 320, 209, 270,
-	386, 284, 293, 0, 0, 337, 368, 218, 421, 387,
-	547, 558, 553, 554, 551, 552, 0, 550, 549, 548,
-	561, 539, 540, 541, 542, 544, 0, 555, 556, 543,
-	189, 202, 289, 0, 357, 254, 443, 427, 424, 0,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 203, 211, 220, 232,
-	245, 252, 262, 266, 269, 272, 273, 276, 281, 298,
-	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
-	333, 335, 336, 339, 345, 346, 347, 348, 349, 351,
-	358, 362, 370, 371, 372, 373, 374, 375, 376, 380,
-	381, 382, 383, 391, 395, 409, 410, 420, 431, 435,
-	263, 417, 436, 0, 297, 0, 0, 299, 249, 265,
-	274, 0, 426, 392, 206, 364, 255, 196, 223, 210,
-	230, 244, 246, 278, 307, 313, 341, 344, 260, 241,
-	221, 361, 219, 378, 398, 399, 400, 402, 311, 237,
-	329, 0, 0, 0, 0, 503, 0, 0, 0, 240,
-	0, 502, 0, 0, 0, 287, 0, 0, 0, 342,
-	0, 379, 226, 296, 294, 406, 250, 243, 239, 225,
-	271, 302, 340, 397, 334, 546, 291, 0, 0, 388,
-	314, 0, 0, 0, 0, 0, 537, 538, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 224, 194, 326,
-	389, 253, 69, 0, 0, 176, 177, 178, 524, 1396,
-	526, 527, 528, 529, 0, 0, 216, 525, 222, 530,
-	531, 532, 0, 236, 275, 242, 235, 404, 0, 0,
-	0, 500, 517, 0, 545, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 514, 515, 590, 0, 0, 0,
-	560, 0, 516, 0, 0, 509, 510, 512, 511, 513,
-	518, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 0, 315, 559, 0, 0, 432, 0, 0, 557,
-	0, 0, 0, 0, 0, 286, 0, 283, 190, 204,
-	0, 0, 325, 363, 369, 0, 0, 0, 227, 0,
-	367, 338, 419, 212, 251, 360, 343, 365, 0, 0,
-	366, 292, 408, 355, 418, 433, 434, 234, 319, 425,
-	401, 430, 442, 205, 231, 332, 394, 422, 385, 312,
-	405, 282, 384, 259, 193, 290, 197, 396, 416, 217,
-	377, 0, 0, 0, 199, 414, 393, 309, 279, 280,
-	198, 0, 359, 238, 257, 229, 328, 411, 412, 228,
-	444, 207, 429, 201, 208, 428, 321, 407, 415, 310,
-	301, 200, 413, 308, 300, 285, 248, 267, 353, 295,
-	354, 268, 317, 316, 318, 0, 195, 0, 390, 423,
-	445, 214, 0, 0, 403, 438, 441, 0, 356, 215,
-	258, 247, 352, 256, 288, 437, 439, 440, 213, 350,
-	264, 320, 209, 270, 386, 284, 293, 0, 0, 337,
-	368, 218, 421, 387, 547, 558, 553, 554, 551, 552,
-	0, 550, 549, 548, 561, 539, 540, 541, 542, 544,
-	0, 555, 556, 543, 189, 202, 289, 0, 357, 254,
-	443, 427, 424, 0, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	203, 211, 220, 232, 245, 252, 262, 266, 269, 272,
-	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
-	324, 327, 330, 331, 333, 335, 336, 339, 345, 346,
-	347, 348, 349, 351, 358, 362, 370, 371, 372, 373,
-	374, 375, 376, 380, 381, 382, 383, 391, 395, 409,
-	410, 420, 431, 435, 263, 417, 436, 0, 297, 0,
-	0, 299, 249, 265, 274, 0, 426, 392, 206, 364,
-	255, 196, 223, 210, 230, 244, 246, 278, 307, 313,
-	341, 344, 260, 241, 221, 361, 219, 378, 398, 399,
-	400, 402, 311, 237, 329, 0, 0, 0, 0, 503,
-	0, 0, 0, 240, 0, 502, 0, 0, 0, 287,
-	0, 0, 0, 342, 0, 379, 226, 296, 294, 406,
-	250, 243, 239, 225, 271, 302, 340, 397, 334, 546,
-	291, 0, 0, 388, 314, 0, 0, 0, 0, 0,
-	537, 538, 0, 0, 0, 0, 0, 0, 0, 0,
-	277, 224, 194, 326, 389, 253, 69, 0, 0, 176,
-	177, 178, 524, 1393, 526, 527, 528, 529, 0, 0,
-	216, 525, 222, 530, 531, 532, 0, 236, 275, 242,
-	235, 404, 0, 0, 0, 500, 517, 0, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 514, 515,
-	590, 0, 0, 0, 560, 0, 516, 0, 0, 509,
-	510, 512, 511, 513, 518, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 315, 559, 0, 0,
-	432, 0, 0, 557, 0, 0, 0, 0, 0, 286,
-	0, 283, 190, 204, 0, 0, 325, 363, 369, 0,
-	0, 0, 227, 0, 367, 338, 419, 212, 251, 360,
-	343, 365, 0, 0, 366, 292, 408, 355, 418, 433,
-	434, 234, 319, 425, 401, 430, 442, 205, 231, 332,
-	394, 422, 385, 312, 405, 282, 384, 259, 193, 290,
-	197, 396, 416, 217, 377, 0, 0, 0, 199, 414,
-	393, 309, 279, 280, 198, 0, 359, 238, 257, 229,
-	328, 411, 412, 228, 444, 207, 429, 201, 208, 428,
-	321, 407, 415, 310, 301, 200, 413, 308, 300, 285,
-	248, 267, 353, 295, 354, 268, 317, 316, 318, 0,
-	195, 0, 390, 423, 445, 214, 0, 0, 403, 438,
-	441, 0, 356, 215, 258, 247, 352, 256, 288, 437,
-	439, 440, 213, 350, 264, 320, 209, 270, 386, 284,
-	293, 0, 0, 337, 368, 218, 421, 387, 547, 558,
-	553, 554, 551, 552, 0, 550, 549, 548, 561, 539,
-	540, 541, 542, 544, 0, 555, 556, 543, 189, 202,
-	289, 0, 357, 254, 443, 427, 424, 0, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 203, 211, 220, 232, 245, 252,
-	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
-	305, 306, 322, 323, 324, 327, 330, 331, 333, 335,
-	336, 339, 345, 346, 347, 348, 349, 351, 358, 362,
-	370, 371, 372, 373, 374, 375, 376, 380, 381, 382,
-	383, 391, 395, 409, 410, 420, 431, 435, 263, 417,
-	436, 0, 297, 0, 0, 299, 249, 265, 274, 0,
-	426, 392, 206, 364, 255, 196, 223, 210, 230, 244,
-	246, 278, 307, 313, 341, 344, 260, 241, 221, 361,
-	219, 378, 398, 399, 400, 402, 311, 237, 571, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 329, 0, 0, 0, 0, 503, 0, 0, 0,
-	240, 0, 502, 0, 0, 0, 287, 0, 0, 0,
-	342, 0, 379, 226, 296, 294, 406, 250, 243, 239,
-	225, 271, 302, 340, 397, 334, 546, 291, 0, 0,
-	388, 314, 0, 0, 0, 0, 0, 537, 538, 0,
-	0, 0, 0, 0, 0, 0, 0, 277, 224, 194,
-	326, 389, 253, 69, 0, 0, 176, 177, 178, 524,
-	523, 526, 527, 528, 529, 0, 0, 216, 525, 222,
-	530, 531, 532, 0, 236, 275, 242, 235, 404, 0,
-	0, 0, 500, 517, 0, 545, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 514, 515, 0, 0, 0,
-	0, 560, 0, 516, 0, 0, 509, 510, 512, 511,
-	513, 518, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 315, 559, 0, 0, 432, 0, 0,
-	557, 0, 0, 0, 0, 0, 286, 0, 283, 190,
-	204, 0, 0, 325, 363, 369, 0, 0, 0, 227,
-	0, 367, 338, 419, 212, 251, 360, 343, 365, 0,
-	0, 366, 292, 408, 355, 418, 433, 434, 234, 319,
-	425, 401, 430, 442, 205, 231, 332, 394, 422, 385,
-	312, 405, 282, 384, 259, 193, 290, 197, 396, 416,
-	217, 377, 0, 0, 0, 199, 414, 393, 309, 279,
-	280, 198, 0, 359, 238, 257, 229, 328, 411, 412,
-	228, 444, 207, 429, 201, 208, 428, 321, 407, 415,
-	310, 301, 200, 413, 308, 300, 285, 248, 267, 353,
-	295, 354, 268, 317, 316, 318, 0, 195, 0, 390,
-	423, 445, 214, 0, 0, 403, 438, 441, 0, 356,
-	215, 258, 247, 352, 256, 288, 437, 439, 440, 213,
-	350, 264, 320, 209, 270, 386, 284, 293, 0, 0,
-	337, 368, 218, 421, 387, 547, 558, 553, 554, 551,
-	552, 0, 550, 549, 548, 561, 539, 540, 541, 542,
-	544, 0, 555, 556, 543, 189, 202, 289, 0, 357,
-	254, 443, 427, 424, 0, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-	192, 203, 211, 220, 232, 245, 252, 262, 266, 269,
-	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
-	323, 324, 327, 330, 331, 333, 335, 336, 339, 345,
-	346, 347, 348, 349, 351, 358, 362, 370, 371, 372,
-	373, 374, 375, 376, 380, 381, 382, 383, 391, 395,
-	409, 410, 420, 431, 435, 263, 417, 436, 0, 297,
-	0, 0, 299, 249, 265, 274, 0, 426, 392, 206,
-	364, 255, 196, 223, 210, 230, 244, 246, 278, 307,
-	313, 341, 344, 260, 241, 221, 361, 219, 378, 398,
-	399, 400, 402, 311, 237, 329, 0, 0, 0, 0,
-	503, 0, 0, 0, 240, 0, 502, 0, 0, 0,
-	287, 0, 0, 0, 342, 0, 379, 226, 296, 294,
-	406, 250, 243, 239, 225, 271, 302, 340, 397, 334,
-	546, 291, 0, 0, 388, 314, 0, 0, 0, 0,
-	0, 537, 538, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 224, 194, 326, 389, 253, 69, 0, 0,
-	176, 177, 178, 524, 523, 526, 527, 528, 529, 0,
-	0, 216, 525, 222, 530, 531, 532, 0, 236, 275,
-	242, 235, 404, 0, 0, 0, 500, 517, 0, 545,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 514,
-	515, 0, 0, 0, 0, 560, 0, 516, 0, 0,
-	509, 510, 512, 511, 513, 518, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 315, 559, 0,
-	0, 432, 0, 0, 557, 0, 0, 0, 0, 0,
-	286, 0, 283, 190, 204, 0, 0, 325, 363, 369,
-	0, 0, 0, 227, 0, 367, 338, 419, 212, 251,
-	360, 343, 365, 0, 0, 366, 292, 408, 355, 418,
-	433, 434, 234, 319, 425, 401, 430, 442, 205, 231,
-	332, 394, 422, 385, 312, 405, 282, 384, 259, 193,
-	290, 197, 396, 416, 217, 377, 0, 0, 0, 199,
-	414, 393, 309, 279, 280, 198, 0, 359, 238, 257,
-	229, 328, 411, 412, 228, 444, 207, 429, 201, 208,
-	428, 321, 407, 415, 310, 301, 200, 413, 308, 300,
-	285, 248, 267, 353, 295, 354, 268, 317, 316, 318,
-	0, 195, 0, 390, 423, 445, 214, 0, 0, 403,
-	438, 441, 0, 356, 215, 258, 247, 352, 256, 288,
-	437, 439, 440, 213, 350, 264, 320, 209, 270, 386,
-	284, 293, 0, 0, 337, 368, 218, 421, 387, 547,
-	558, 553, 554, 551, 552, 0, 550, 549, 548, 561,
-	539, 540, 541, 542, 544, 0, 555, 556, 543, 189,
-	202, 289, 0, 357, 254, 443, 427, 424, 0, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 191, 192, 203, 211, 220, 232, 245,
-	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
-	304, 305, 306, 322, 323, 324, 327, 330, 331, 333,
-	335, 336, 339, 345, 346, 347, 348, 349, 351, 358,
-	362, 370, 371, 372, 373, 374, 375, 376, 380, 381,
-	382, 383, 391, 395, 409, 410, 420, 431, 435, 263,
-	417, 436, 0, 297, 0, 0, 299, 249, 265, 274,
-	0, 426, 392, 206, 364, 255, 196, 223, 210, 230,
-	244, 246, 278, 307, 313, 341, 344, 260, 241, 221,
-	361, 219, 378, 398, 399, 400, 402, 311, 237, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 342, 0,
-	379, 226, 296, 294, 406, 250, 243, 239, 225, 271,
-	302, 340, 397, 334, 546, 291, 0, 0, 388, 314,
-	0, 0, 0, 0, 0, 537, 538, 0, 0, 0,
-	0, 0, 0, 0, 0, 277, 224, 194, 326, 389,
-	253, 69, 0, 0, 176, 177, 178, 524, 523, 526,
-	527, 528, 529, 0, 0, 216, 525, 222, 530, 531,
-	532, 0, 236, 275, 242, 235, 404, 0, 0, 0,
-	0, 517, 0, 545, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 514, 515, 0, 0, 0, 0, 560,
-	0, 516, 0, 0, 509, 510, 512, 511, 513, 518,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 315, 559, 0, 0, 432, 0, 0, 557, 0,
-	0, 0, 0, 0, 286, 0, 283, 190, 204, 0,
-	0, 325, 363, 369, 0, 0, 0, 227, 0, 367,
-	338, 419, 212, 251, 360, 343, 365, 2153, 0, 366,
-	292, 408, 355, 418, 433, 434, 234, 319, 425, 401,
-	430, 442, 205, 231, 332, 394, 422, 385, 312, 405,
-	282, 384, 259, 193, 290, 197, 396, 416, 217, 377,
-	0, 0, 0, 199, 414, 393, 309, 279, 280, 198,
-	0, 359, 238, 257, 229, 328, 411, 412, 228, 444,
-	207, 429, 201, 208, 428, 321, 407, 415, 310, 301,
-	200, 413, 308, 300, 285, 248, 267, 353, 295, 354,
-	268, 317, 316, 318, 0, 195, 0, 390, 423, 445,
-	214, 0, 0, 403, 438, 441, 0, 356, 215, 258,
-	247, 352, 256, 288, 437, 439, 440, 213, 350, 264,
-	320, 209, 270, 386, 284, 293, 0, 0, 337, 368,
-	218, 421, 387, 547, 558, 553, 554, 551, 552, 0,
-	550, 549, 548, 561, 539, 540, 541, 542, 544, 0,
-	555, 556, 543, 189, 202, 289, 0, 357, 254, 443,
-	427, 424, 0, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 203,
-	211, 220, 232, 245, 252, 262, 266, 269, 272, 273,
-	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
-	327, 330, 331, 333, 335, 336, 339, 345, 346, 347,
-	348, 349, 351, 358, 362, 370, 371, 372, 373, 374,
-	375, 376, 380, 381, 382, 383, 391, 395, 409, 410,
-	420, 431, 435, 263, 417, 436, 0, 297, 0, 0,
-	299, 249, 265, 274, 0, 426, 392, 206, 364, 255,
-	196, 223, 210, 230, 244, 246, 278, 307, 313, 341,
-	344, 260, 241, 221, 361, 219, 378, 398, 399, 400,
-	402, 311, 237, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 342, 0, 379, 226, 296, 294, 406, 250,
-	243, 239, 225, 271, 302, 340, 397, 334, 546, 291,
-	0, 0, 388, 314, 0, 0, 0, 0, 0, 537,
-	538, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	224, 194, 326, 389, 253, 69, 0, 578, 176, 177,
-	178, 524, 523, 526, 527, 528, 529, 0, 0, 216,
-	525, 222, 530, 531, 532, 0, 236, 275, 242, 235,
-	404, 0, 0, 0, 0, 517, 0, 545, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 514, 515, 0,
-	0, 0, 0, 560, 0, 516, 0, 0, 509, 510,
-	512, 511, 513, 518, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 315, 559, 0, 0, 432,
-	0, 0, 557, 0, 0, 0, 0, 0, 286, 0,
-	283, 190, 204, 0, 0, 325, 363, 369, 0, 0,
-	0, 227, 0, 367, 338, 419, 212, 251, 360, 343,
-	365, 0, 0, 366, 292, 408, 355, 418, 433, 434,
-	234, 319, 425, 401, 430, 442, 205, 231, 332, 394,
-	422, 385, 312, 405, 282, 384, 259, 193, 290, 197,
-	396, 416, 217, 377, 0, 0, 0, 199, 414, 393,
-	309, 279, 280, 198, 0, 359, 238, 257, 229, 328,
-	411, 412, 228, 444, 207, 429, 201, 208, 428, 321,
-	407, 415, 310, 301, 200, 413, 308, 300, 285, 248,
-	267, 353, 295, 354, 268, 317, 316, 318, 0, 195,
-	0, 390, 423, 445, 214, 0, 0, 403, 438, 441,
-	0, 356, 215, 258, 247, 352, 256, 288, 437, 439,
-	440, 213, 350, 264, 320, 209, 270, 386, 284, 293,
-	0, 0, 337, 368, 218, 421, 387, 547, 558, 553,
-	554, 551, 552, 0, 550, 549, 548, 561, 539, 540,
-	541, 542, 544, 0, 555, 556, 543, 189, 202, 289,
-	0, 357, 254, 443, 427, 424, 0, 0, 233, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 191, 192, 203, 211, 220, 232, 245, 252, 262,
-	266, 269, 272, 273, 276, 281, 298, 303, 304, 305,
-	306, 322, 323, 324, 327, 330, 331, 333, 335, 336,
-	339, 345, 346, 347, 348, 349, 351, 358, 362, 370,
-	371, 372, 373, 374, 375, 376, 380, 381, 382, 383,
-	391, 395, 409, 410, 420, 431, 435, 263, 417, 436,
-	0, 297, 0, 0, 299, 249, 265, 274, 0, 426,
-	392, 206, 364, 255, 196, 223, 210, 230, 244, 246,
-	278, 307, 313, 341, 344, 260, 241, 221, 361, 219,
-	378, 398, 399, 400, 402, 311, 237, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
-	0, 0, 287, 0, 0, 0, 342, 0, 379, 226,
-	296, 294, 406, 250, 243, 239, 225, 271, 302, 340,
-	397, 334, 546, 291, 0, 0, 388, 314, 0, 0,
-	0, 0, 0, 537, 538, 0, 0, 0, 0, 0,
-	0, 0, 0, 277, 224, 194, 326, 389, 253, 69,
-	0, 0, 176, 177, 178, 524, 523, 526, 527, 528,
-	529, 0, 0, 216, 525, 222, 530, 531, 532, 0,
-	236, 275, 242, 235, 404, 0, 0, 0, 0, 517,
-	0, 545, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 514, 515, 0, 0, 0, 0, 560, 0, 516,
-	0, 0, 509, 510, 512, 511, 513, 518, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
-	559, 0, 0, 432, 0, 0, 557, 0, 0, 0,
-	0, 0, 286, 0, 283, 190, 204, 0, 0, 325,
-	363, 369, 0, 0, 0, 227, 0, 367, 338, 419,
-	212, 251, 360, 343, 365, 0, 0, 366, 292, 408,
-	355, 418, 433, 434, 234, 319, 425, 401, 430, 442,
-	205, 231, 332, 394, 422, 385, 312, 405, 282, 384,
-	259, 193, 290, 197, 396, 416, 217, 377, 0, 0,
-	0, 199, 414, 393, 309, 279, 280, 198, 0, 359,
-	238, 257, 229, 328, 411, 412, 228, 444, 207, 429,
-	201, 208, 428, 321, 407, 415, 310, 301, 200, 413,
-	308, 300, 285, 248, 267, 353, 295, 354, 268, 317,
-	316, 318, 0, 195, 0, 390, 423, 445, 214, 0,
-	0, 403, 438, 441, 0, 356, 215, 258, 247, 352,
-	256, 288, 437, 439, 440, 213, 350, 264, 320, 209,
-	270, 386, 284, 293, 0, 0, 337, 368, 218, 421,
-	387, 547, 558, 553, 554, 551, 552, 0, 550, 549,
-	548, 561, 539, 540, 541, 542, 544, 0, 555, 556,
-	543, 189, 202, 289, 0, 357, 254, 443, 427, 424,
-	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 191, 192, 203, 211, 220,
-	232, 245, 252, 262, 266, 269, 272, 273, 276, 281,
-	298, 303, 304, 305, 306, 322, 323, 324, 327, 330,
-	331, 333, 335, 336, 339, 345, 346, 347, 348, 349,
-	351, 358, 362, 370, 371, 372, 373, 374, 375, 376,
-	380, 381, 382, 383, 391, 395, 409, 410, 420, 431,
-	435, 263, 417, 436, 0, 297, 0, 0, 299, 249,
-	265, 274, 0, 426, 392, 206, 364, 255, 196, 223,
-	210, 230, 244, 246, 278, 307, 313, 341, 344, 260,
-	241, 221, 361, 219, 378, 398, 399, 400, 402, 311,
-	237, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	342, 0, 379, 226, 296, 294, 406, 250, 243, 239,
-	225, 271, 302, 340, 397, 334, 0, 291, 0, 0,
-	388, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 277, 224, 194,
-	326, 389, 253, 0, 0, 0, 176, 177, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 216, 0, 222,
-	0, 0, 0, 0, 236, 275, 242, 235, 404, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 957, 956, 966, 967, 959, 960, 961, 962, 963,
-	964, 965, 958, 0, 0, 968, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 315, 0, 0, 0, 432, 0, 0,
-	0, 0, 0, 0, 0, 0, 286, 0, 283, 190,
-	204, 0, 0, 325, 363, 369, 0, 0, 0, 227,
-	0, 367, 338, 419, 212, 251, 360, 343, 365, 0,
-	0, 366, 292, 408, 355, 418, 433, 434, 234, 319,
-	425, 401, 430, 442, 205, 231, 332, 394, 422, 385,
-	312, 405, 282, 384, 259, 193, 290, 197, 396, 416,
-	217, 377, 0, 0, 0, 199, 414, 393, 309, 279,
-	280, 198, 0, 359, 238, 257, 229, 328, 411, 412,
-	228, 444, 207, 429, 201, 208, 428, 321, 407, 415,
-	310, 301, 200, 413, 308, 300, 285, 248, 267, 353,
-	295, 354, 268, 317, 316, 318, 0, 195, 0, 390,
-	423, 445, 214, 0, 0, 403, 438, 441, 0, 356,
-	215, 258, 247, 352, 256, 288, 437, 439, 440, 213,
-	350, 264, 320, 209, 270, 386, 284, 293, 0, 0,
-	337, 368, 218, 421, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 202, 289, 0, 357,
-	254, 443, 427, 424, 0, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-	192, 203, 211, 220, 232, 245, 252, 262, 266, 269,
-	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
-	323, 324, 327, 330, 331, 333, 335, 336, 339, 345,
-	346, 347, 348, 349, 351, 358, 362, 370, 371, 372,
-	373, 374, 375, 376, 380, 381, 382, 383, 391, 395,
-	409, 410, 420, 431, 435, 263, 417, 436, 0, 297,
-	0, 0, 299, 249, 265, 274, 0, 426, 392, 206,
-	364, 255, 196, 223, 210, 230, 244, 246, 278, 307,
-	313, 341, 344, 260, 241, 221, 361, 219, 378, 398,
-	399, 400, 402, 311, 237, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 791, 0, 0, 0, 0,
-	287, 0, 0, 0, 342, 0, 379, 226, 296, 294,
-	406, 250, 243, 239, 225, 271, 302, 340, 397, 334,
-	0, 291, 0, 0, 388, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 224, 194, 326, 389, 253, 0, 0, 0,
-	176, 177, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 216, 0, 222, 0, 0, 0, 0, 236, 275,
-	242, 235, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
-	790, 432, 0, 0, 0, 0, 0, 0, 787, 788,
-	286, 755, 283, 190, 204, 781, 785, 325, 363, 369,
-	0, 0, 0, 227, 0, 367, 338, 419, 212, 251,
-	360, 343, 365, 0, 0, 366, 292, 408, 355, 418,
-	433, 434, 234, 319, 425, 401, 430, 442, 205, 231,
-	332, 394, 422, 385, 312, 405, 282, 384, 259, 193,
-	290, 197, 396, 416, 217, 377, 0, 0, 0, 199,
-	414, 393, 309, 279, 280, 198, 0, 359, 238, 257,
-	229, 328, 411, 412, 228, 444, 207, 429, 201, 208,
-	428, 321, 407, 415, 310, 301, 200, 413, 308, 300,
-	285, 248, 267, 353, 295, 354, 268, 317, 316, 318,
-	0, 195, 0, 390, 423, 445, 214, 0, 0, 403,
-	438, 441, 0, 356, 215, 258, 247, 352, 256, 288,
-	437, 439, 440, 213, 350, 264, 320, 209, 270, 386,
-	284, 293, 0, 0, 337, 368, 218, 421, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	202, 289, 0, 357, 254, 443, 427, 424, 0, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 191, 192, 203, 211, 220, 232, 245,
-	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
-	304, 305, 306, 322, 323, 324, 327, 330, 331, 333,
-	335, 336, 339, 345, 346, 347, 348, 349, 351, 358,
-	362, 370, 371, 372, 373, 374, 375, 376, 380, 381,
-	382, 383, 391, 395, 409, 410, 420, 431, 435, 263,
-	417, 436, 0, 297, 0, 0, 299, 249, 265, 274,
-	0, 426, 392, 206, 364, 255, 196, 223, 210, 230,
-	244, 246, 278, 307, 313, 341, 344, 260, 241, 221,
-	361, 219, 378, 398, 399, 400, 402, 311, 237, 329,
-	0, 0, 0, 1058, 0, 0, 0, 0, 240, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 342, 0,
-	379, 226, 296, 294, 406, 250, 243, 239, 225, 271,
-	302, 340, 397, 334, 0, 291, 0, 0, 388, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 277, 224, 194, 326, 389,
-	253, 0, 0, 0, 176, 177, 178, 0, 1060, 0,
-	0, 0, 0, 0, 0, 216, 0, 222, 0, 0,
-	0, 0, 236, 275, 242, 235, 404, 946, 947, 945,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 948, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 315, 0, 0, 0, 432, 0, 0, 0, 0,
-	0, 0, 0, 0, 286, 0, 283, 190, 204, 0,
-	0, 325, 363, 369, 0, 0, 0, 227, 0, 367,
-	338, 419, 212, 251, 360, 343, 365, 0, 0, 366,
-	292, 408, 355, 418, 433, 434, 234, 319, 425, 401,
-	430, 442, 205, 231, 332, 394, 422, 385, 312, 405,
-	282, 384, 259, 193, 290, 197, 396, 416, 217, 377,
-	0, 0, 0, 199, 414, 393, 309, 279, 280, 198,
-	0, 359, 238, 257, 229, 328, 411, 412, 228, 444,
-	207, 429, 201, 208, 428, 321, 407, 415, 310, 301,
-	200, 413, 308, 300, 285, 248, 267, 353, 295, 354,
-	268, 317, 316, 318, 0, 195, 0, 390, 423, 445,
-	214, 0, 0, 403, 438, 441, 0, 356, 215, 258,
-	247, 352, 256, 288, 437, 439, 440, 213, 350, 264,
-	320, 209, 270, 386, 284, 293, 0, 0, 337, 368,
-	218, 421, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 202, 289, 0, 357, 254, 443,
-	427, 424, 0, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 203,
-	211, 220, 232, 245, 252, 262, 266, 269, 272, 273,
-	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
-	327, 330, 331, 333, 335, 336, 339, 345, 346, 347,
-	348, 349, 351, 358, 362, 370, 371, 372, 373, 374,
-	375, 376, 380, 381, 382, 383, 391, 395, 409, 410,
-	420, 431, 435, 263, 417, 436, 0, 297, 0, 0,
-	299, 249, 265, 274, 0, 426, 392, 206, 364, 255,
-	196, 223, 210, 230, 244, 246, 278, 307, 313, 341,
-	344, 260, 241, 221, 361, 219, 378, 398, 399, 400,
-	402, 311, 237, 34, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 342, 0, 379, 226, 296,
-	294, 406, 250, 243, 239, 225, 271, 302, 340, 397,
-	334, 0, 291, 0, 0, 388, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 277, 224, 194, 326, 389, 253, 69, 0,
-	578, 176, 177, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 216, 0, 222, 0, 0, 0, 0, 236,
-	275, 242, 235, 404, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 0, 315, 0,
-	0, 0, 432, 0, 0, 0, 0, 0, 0, 0,
-	0, 286, 0, 283, 190, 204, 0, 0, 325, 363,
-	369, 0, 0, 0, 227, 0, 367, 338, 419, 212,
-	251, 360, 343, 365, 0, 0, 366, 292, 408, 355,
-	418, 433, 434, 234, 319, 425, 401, 430, 442, 205,
-	231, 332, 394, 422, 385, 312, 405, 282, 384, 259,
-	193, 290, 197, 396, 416, 217, 377, 0, 0, 0,
-	199, 414, 393, 309, 279, 280, 198, 0, 359, 238,
-	257, 229, 328, 411, 412, 228, 444, 207, 429, 201,
-	208, 428, 321, 407, 415, 310, 301, 200, 413, 308,
-	300, 285, 248, 267, 353, 295, 354, 268, 317, 316,
-	318, 0, 195, 0, 390, 423, 445, 214, 0, 0,
-	403, 438, 441, 0, 356, 215, 258, 247, 352, 256,
-	288, 437, 439, 440, 213, 350, 264, 320, 209, 270,
-	386, 284, 293, 0, 0, 337, 368, 218, 421, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 202, 289, 0, 357, 254, 443, 427, 424, 0,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 203, 211, 220, 232,
-	245, 252, 262, 266, 269, 272, 273, 276, 281, 298,
-	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
-	333, 335, 336, 339, 345, 346, 347, 348, 349, 351,
-	358, 362, 370, 371, 372, 373, 374, 375, 376, 380,
-	381, 382, 383, 391, 395, 409, 410, 420, 431, 435,
-	263, 417, 436, 0, 297, 0, 0, 299, 249, 265,
-	274, 0, 426, 392, 206, 364, 255, 196, 223, 210,
-	230, 244, 246, 278, 307, 313, 341, 344, 260, 241,
-	221, 361, 219, 378, 398, 399, 400, 402, 311, 237,
-	329, 0, 0, 0, 1423, 0, 0, 0, 0, 240,
-	0, 0, 0, 0, 0, 287, 0, 0, 0, 342,
-	0, 379, 226, 296, 294, 406, 250, 243, 239, 225,
-	271, 302, 340, 397, 334, 0, 291, 0, 0, 388,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 224, 194, 326,
-	389, 253, 0, 0, 0, 176, 177, 178, 0, 1425,
-	0, 0, 0, 0, 0, 0, 216, 0, 222, 0,
-	0, 0, 0, 236, 275, 242, 235, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 0, 315, 0, 0, 0, 432, 0, 0, 0,
-	0, 0, 0, 0, 0, 286, 0, 283, 190, 204,
-	0, 0, 325, 363, 369, 0, 0, 0, 227, 0,
-	367, 338, 419, 212, 251, 360, 343, 365, 0, 1421,
-	366, 292, 408, 355, 418, 433, 434, 234, 319, 425,
-	401, 430, 442, 205, 231, 332, 394, 422, 385, 312,
-	405, 282, 384, 259, 193, 290, 197, 396, 416, 217,
-	377, 0, 0, 0, 199, 414, 393, 309, 279, 280,
-	198, 0, 359, 238, 257, 229, 328, 411, 412, 228,
-	444, 207, 429, 201, 208, 428, 321, 407, 415, 310,
-	301, 200, 413, 308, 300, 285, 248, 267, 353, 295,
-	354, 268, 317, 316, 318, 0, 195, 0, 390, 423,
-	445, 214, 0, 0, 403, 438, 441, 0, 356, 215,
-	258, 247, 352, 256, 288, 437, 439, 440, 213, 350,
-	264, 320, 209, 270, 386, 284, 293, 0, 0, 337,
-	368, 218, 421, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 202, 289, 0, 357, 254,
-	443, 427, 424, 0, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	203, 211, 220, 232, 245, 252, 262, 266, 269, 272,
-	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
-	324, 327, 330, 331, 333, 335, 336, 339, 345, 346,
-	347, 348, 349, 351, 358, 362, 370, 371, 372, 373,
-	374, 375, 376, 380, 381, 382, 383, 391, 395, 409,
-	410, 420, 431, 435, 263, 417, 436, 0, 297, 0,
-	0, 299, 249, 265, 274, 0, 426, 392, 206, 364,
-	255, 196, 223, 210, 230, 244, 246, 278, 307, 313,
-	341, 344, 260, 241, 221, 361, 219, 378, 398, 399,
-	400, 402, 311, 237, 329, 0, 0, 0, 0, 0,
-	0, 0, 0, 240, 0, 0, 0, 0, 0, 287,
-	0, 0, 0, 342, 0, 379, 226, 296, 294, 406,
-	250, 243, 239, 225, 271, 302, 340, 397, 334, 0,
-	291, 0, 0, 388, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	277, 224, 194, 326, 389, 253, 0, 0, 0, 176,
-	177, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	216, 0, 222, 0, 0, 0, 0, 236, 275, 242,
-	235, 404, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 749, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 315, 0, 0, 0,
-	432, 0, 0, 0, 0, 0, 0, 0, 0, 286,
-	755, 283, 190, 204, 753, 0, 325, 363, 369, 0,
-	0, 0, 227, 0, 367, 338, 419, 212, 251, 360,
-	343, 365, 0, 0, 366, 292, 408, 355, 418, 433,
-	434, 234, 319, 425, 401, 430, 442, 205, 231, 332,
-	394, 422, 385, 312, 405, 282, 384, 259, 193, 290,
-	197, 396, 416, 217, 377, 0, 0, 0, 199, 414,
-	393, 309, 279, 280, 198, 0, 359, 238, 257, 229,
-	328, 411, 412, 228, 444, 207, 429, 201, 208, 428,
-	321, 407, 415, 310, 301, 200, 413, 308, 300, 285,
-	248, 267, 353, 295, 354, 268, 317, 316, 318, 0,
-	195, 0, 390, 423, 445, 214, 0, 0, 403, 438,
-	441, 0, 356, 215, 258, 247, 352, 256, 288, 437,
-	439, 440, 213, 350, 264, 320, 209, 270, 386, 284,
-	293, 0, 0, 337, 368, 218, 421, 387, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 202,
-	289, 0, 357, 254, 443, 427, 424, 0, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 203, 211, 220, 232, 245, 252,
-	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
-	305, 306, 322, 323, 324, 327, 330, 331, 333, 335,
-	336, 339, 345, 346, 347, 348, 349, 351, 358, 362,
-	370, 371, 372, 373, 374, 375, 376, 380, 381, 382,
-	383, 391, 395, 409, 410, 420, 431, 435, 263, 417,
-	436, 0, 297, 0, 0, 299, 249, 265, 274, 0,
-	426, 392, 206, 364, 255, 196, 223, 210, 230, 244,
-	246, 278, 307, 313, 341, 344, 260, 241, 221, 361,
-	219, 378, 398, 399, 400, 402, 311, 237, 329, 0,
-	0, 0, 1423, 0, 0, 0, 0, 240, 0, 0,
-	0, 0, 0, 287, 0, 0, 0, 342, 0, 379,
-	226, 296, 294, 406, 250, 243, 239, 225, 271, 302,
-	340, 397, 334, 0, 291, 0, 0, 388, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 277, 224, 194, 326, 389, 253,
-	0, 0, 0, 176, 177, 178, 0, 1425, 0, 0,
-	0, 0, 0, 0, 216, 0, 222, 0, 0, 0,
-	0, 236, 275, 242, 235, 404, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	315, 0, 0, 0, 432, 0, 0, 0, 0, 0,
-	0, 0, 0, 286, 0, 283, 190, 204, 0, 0,
-	325, 363, 369, 0, 0, 0, 227, 0, 367, 338,
-	419, 212, 251, 360, 343, 365, 0, 0, 366, 292,
-	408, 355, 418, 433, 434, 234, 319, 425, 401, 430,
-	442, 205, 231, 332, 394, 422, 385, 312, 405, 282,
-	384, 259, 193, 290, 197, 396, 416, 217, 377, 0,
-	0, 0, 199, 414, 393, 309, 279, 280, 198, 0,
-	359, 238, 257, 229, 328, 411, 412, 228, 444, 207,
-	429, 201, 208, 428, 321, 407, 415, 310, 301, 200,
-	413, 308, 300, 285, 248, 267, 353, 295, 354, 268,
-	317, 316, 318, 0, 195, 0, 390, 423, 445, 214,
-	0, 0, 403, 438, 441, 0, 356, 215, 258, 247,
-	352, 256, 288, 437, 439, 440, 213, 350, 264, 320,
-	209, 270, 386, 284, 293, 0, 0, 337, 368, 218,
-	421, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 202, 289, 0, 357, 254, 443, 427,
-	424, 0, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 203, 211,
-	220, 232, 245, 252, 262, 266, 269, 272, 273, 276,
-	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
-	330, 331, 333, 335, 336, 339, 345, 346, 347, 348,
-	349, 351, 358, 362, 370, 371, 372, 373, 374, 375,
-	376, 380, 381, 382, 383, 391, 395, 409, 410, 420,
-	431, 435, 263, 417, 436, 0, 297, 0, 0, 299,
-	249, 265, 274, 0, 426, 392, 206, 364, 255, 196,
-	223, 210, 230, 244, 246, 278, 307, 313, 341, 344,
-	260, 241, 221, 361, 219, 378, 398, 399, 400, 402,
-	311, 237, 34, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 0, 0, 0,
-	287, 0, 0, 0, 342, 0, 379, 226, 296, 294,
-	406, 250, 243, 239, 225, 271, 302, 340, 397, 334,
-	0, 291, 0, 0, 388, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 224, 194, 326, 389, 253, 69, 0, 0,
-	176, 177, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 216, 0, 222, 0, 0, 0, 0, 236, 275,
-	242, 235, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
-	0, 432, 0, 0, 0, 0, 0, 0, 0, 0,
-	286, 0, 283, 190, 204, 0, 0, 325, 363, 369,
-	0, 0, 0, 227, 0, 367, 338, 419, 212, 251,
-	360, 343, 365, 0, 0, 366, 292, 408, 355, 418,
-	433, 434, 234, 319, 425, 401, 430, 442, 205, 231,
-	332, 394, 422, 385, 312, 405, 282, 384, 259, 193,
-	290, 197, 396, 416, 217, 377, 0, 0, 0, 199,
-	414, 393, 309, 279, 280, 198, 0, 359, 238, 257,
-	229, 328, 411, 412, 228, 444, 207, 429, 201, 208,
-	428, 321, 407, 415, 310, 301, 200, 413, 308, 300,
-	285, 248, 267, 353, 295, 354, 268, 317, 316, 318,
-	0, 195, 0, 390, 423, 445, 214, 0, 0, 403,
-	438, 441, 0, 356, 215, 258, 247, 352, 256, 288,
-	437, 439, 440, 213, 350, 264, 320, 209, 270, 386,
-	284, 293, 0, 0, 337, 368, 218, 421, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	202, 289, 0, 357, 254, 443, 427, 424, 0, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 191, 192, 203, 211, 220, 232, 245,
-	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
-	304, 305, 306, 322, 323, 324, 327, 330, 331, 333,
-	335, 336, 339, 345, 346, 347, 348, 349, 351, 358,
-	362, 370, 371, 372, 373, 374, 375, 376, 380, 381,
-	382, 383, 391, 395, 409, 410, 420, 431, 435, 263,
-	417, 436, 0, 297, 0, 0, 299, 249, 265, 274,
-	0, 426, 392, 206, 364, 255, 196, 223, 210, 230,
-	244, 246, 278, 307, 313, 341, 344, 260, 241, 221,
-	361, 219, 378, 398, 399, 400, 402, 311, 237, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 342, 0,
-	379, 226, 296, 294, 406, 250, 243, 239, 225, 271,
-	302, 340, 397, 334, 0, 291, 0, 0, 388, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 277, 224, 194, 326, 389,
-	253, 0, 0, 0, 176, 177, 178, 0, 0, 1443,
-	0, 0, 1444, 0, 0, 216, 0, 222, 0, 0,
-	0, 0, 236, 275, 242, 235, 404, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 315, 0, 0, 0, 432, 0, 0, 0, 0,
-	0, 0, 0, 0, 286, 0, 283, 190, 204, 0,
-	0, 325, 363, 369, 0, 0, 0, 227, 0, 367,
-	338, 419, 212, 251, 360, 343, 365, 0, 0, 366,
-	292, 408, 355, 418, 433, 434, 234, 319, 425, 401,
-	430, 442, 205, 231, 332, 394, 422, 385, 312, 405,
-	282, 384, 259, 193, 290, 197, 396, 416, 217, 377,
-	0, 0, 0, 199, 414, 393, 309, 279, 280, 198,
-	0, 359, 238, 257, 229, 328, 411, 412, 228, 444,
-	207, 429, 201, 208, 428, 321, 407, 415, 310, 301,
-	200, 413, 308, 300, 285, 248, 267, 353, 295, 354,
-	268, 317, 316, 318, 0, 195, 0, 390, 423, 445,
-	214, 0, 0, 403, 438, 441, 0, 356, 215, 258,
-	247, 352, 256, 288, 437, 439, 440, 213, 350, 264,
-	320, 209, 270, 386, 284, 293, 0, 0, 337, 368,
-	218, 421, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 202, 289, 0, 357, 254, 443,
-	427, 424, 0, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 203,
-	211, 220, 232, 245, 252, 262, 266, 269, 272, 273,
-	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
-	327, 330, 331, 333, 335, 336, 339, 345, 346, 347,
-	348, 349, 351, 358, 362, 370, 371, 372, 373, 374,
-	375, 376, 380, 381, 382, 383, 391, 395, 409, 410,
-	420, 431, 435, 263, 417, 436, 0, 297, 0, 0,
-	299, 249, 265, 274, 0, 426, 392, 206, 364, 255,
-	196, 223, 210, 230, 244, 246, 278, 307, 313, 341,
-	344, 260, 241, 221, 361, 219, 378, 398, 399, 400,
-	402, 311, 237, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 240, 0, 1091, 0, 0, 0, 287, 0,
-	0, 0, 342, 0, 379, 226, 296, 294, 406, 250,
-	243, 239, 225, 271, 302, 340, 397, 334, 0, 291,
-	0, 0, 388, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	224, 194, 326, 389, 253, 0, 0, 0, 176, 177,
-	178, 0, 1090, 0, 0, 0, 0, 0, 0, 216,
-	0, 222, 0, 0, 0, 0, 236, 275, 242, 235,
-	404, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 315, 0, 0, 0, 432,
-	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
-	283, 190, 204, 0, 0, 325, 363, 369, 0, 0,
-	0, 227, 0, 367, 338, 419, 212, 251, 360, 343,
-	365, 0, 0, 366, 292, 408, 355, 418, 433, 434,
-	234, 319, 425, 401, 430, 442, 205, 231, 332, 394,
-	422, 385, 312, 405, 282, 384, 259, 193, 290, 197,
-	396, 416, 217, 377, 0, 0, 0, 199, 414, 393,
-	309, 279, 280, 198, 0, 359, 238, 257, 229, 328,
-	411, 412, 228, 444, 207, 429, 201, 208, 428, 321,
-	407, 415, 310, 301, 200, 413, 308, 300, 285, 248,
-	267, 353, 295, 354, 268, 317, 316, 318, 0, 195,
-	0, 390, 423, 445, 214, 0, 0, 403, 438, 441,
-	0, 356, 215, 258, 247, 352, 256, 288, 437, 439,
-	440, 213, 350, 264, 320, 209, 270, 386, 284, 293,
-	0, 0, 337, 368, 218, 421, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 202, 289,
-	0, 357, 254, 443, 427, 424, 0, 0, 233, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 191, 192, 203, 211, 220, 232, 245, 252, 262,
-	266, 269, 272, 273, 276, 281, 298, 303, 304, 305,
-	306, 322, 323, 324, 327, 330, 331, 333, 335, 336,
-	339, 345, 346, 347, 348, 349, 351, 358, 362, 370,
-	371, 372, 373, 374, 375, 376, 380, 381, 382, 383,
-	391, 395, 409, 410, 420, 431, 435, 263, 417, 436,
-	0, 297, 0, 0, 299, 249, 265, 274, 0, 426,
-	392, 206, 364, 255, 196, 223, 210, 230, 244, 246,
-	278, 307, 313, 341, 344, 260, 241, 221, 361, 219,
-	378, 398, 399, 400, 402, 311, 237, 329, 0, 0,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
-	0, 0, 287, 0, 0, 0, 342, 0, 379, 226,
-	296, 294, 406, 250, 243, 239, 225, 271, 302, 340,
-	397, 334, 0, 291, 0, 0, 388, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 277, 224, 194, 326, 389, 253, 0,
-	0, 578, 176, 177, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 216, 0, 222, 0, 0, 0, 0,
-	236, 275, 242, 235, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
-	0, 0, 0, 432, 0, 0, 0, 0, 0, 0,
-	0, 0, 286, 0, 283, 190, 204, 0, 0, 325,
-	363, 369, 0, 0, 0, 227, 0, 367, 338, 419,
-	212, 251, 360, 343, 365, 0, 0, 366, 292, 408,
-	355, 418, 433, 434, 234, 319, 425, 401, 430, 442,
-	205, 231, 332, 394, 422, 385, 312, 405, 282, 384,
-	259, 193, 290, 197, 396, 416, 217, 377, 0, 0,
-	0, 199, 414, 393, 309, 279, 280, 198, 0, 359,
-	238, 257, 229, 328, 411, 412, 228, 444, 207, 429,
-	201, 208, 428, 321, 407, 415, 310, 301, 200, 413,
-	308, 300, 285, 248, 267, 353, 295, 354, 268, 317,
-	316, 318, 0, 195, 0, 390, 423, 445, 214, 0,
-	0, 403, 438, 441, 0, 356, 215, 258, 247, 352,
-	256, 288, 437, 439, 440, 213, 350, 264, 320, 209,
-	270, 386, 284, 293, 0, 0, 337, 368, 218, 421,
-	387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 202, 289, 0, 357, 254, 443, 427, 424,
-	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 191, 192, 203, 211, 220,
-	232, 245, 252, 262, 266, 269, 272, 273, 276, 281,
-	298, 303, 304, 305, 306, 322, 323, 324, 327, 330,
-	331, 333, 335, 336, 339, 345, 346, 347, 348, 349,
-	351, 358, 362, 370, 371, 372, 373, 374, 375, 376,
-	380, 381, 382, 383, 391, 395, 409, 410, 420, 431,
-	435, 263, 417, 436, 0, 297, 0, 0, 299, 249,
-	265, 274, 0, 426, 392, 206, 364, 255, 196, 223,
-	210, 230, 244, 246, 278, 307, 313, 341, 344, 260,
-	241, 221, 361, 219, 378, 398, 399, 400, 402, 311,
-	237, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	342, 0, 379, 226, 296, 294, 406, 250, 243, 239,
-	225, 271, 302, 340, 397, 334, 0, 291, 0, 0,
-	388, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 277, 224, 194,
-	326, 389, 253, 69, 0, 0, 176, 177, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 216, 0, 222,
-	0, 0, 0, 0, 236, 275, 242, 235, 404, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 315, 0, 0, 0, 432, 0, 0,
-	0, 0, 0, 0, 0, 0, 286, 0, 283, 190,
-	204, 0, 0, 325, 363, 369, 0, 0, 0, 227,
-	0, 367, 338, 419, 212, 251, 360, 343, 365, 0,
-	0, 366, 292, 408, 355, 418, 433, 434, 234, 319,
-	425, 401, 430, 442, 205, 231, 332, 394, 422, 385,
-	312, 405, 282, 384, 259, 193, 290, 197, 396, 416,
-	217, 377, 0, 0, 0, 199, 414, 393, 309, 279,
-	280, 198, 0, 359, 238, 257, 229, 328, 411, 412,
-	228, 444, 207, 429, 201, 208, 428, 321, 407, 415,
-	310, 301, 200, 413, 308, 300, 285, 248, 267, 353,
-	295, 354, 268, 317, 316, 318, 0, 195, 0, 390,
-	423, 445, 214, 0, 0, 403, 438, 441, 0, 356,
-	215, 258, 247, 352, 256, 288, 437, 439, 440, 213,
-	350, 264, 320, 209, 270, 386, 284, 293, 0, 0,
-	337, 368, 218, 421, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 202, 289, 0, 357,
-	254, 443, 427, 424, 0, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-	192, 203, 211, 220, 232, 245, 252, 262, 266, 269,
-	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
-	323, 324, 327, 330, 331, 333, 335, 336, 339, 345,
-	346, 347, 348, 349, 351, 358, 362, 370, 371, 372,
-	373, 374, 375, 376, 380, 381, 382, 383, 391, 395,
-	409, 410, 420, 431, 435, 263, 417, 436, 0, 297,
-	0, 0, 299, 249, 265, 274, 0, 426, 392, 206,
-	364, 255, 196, 223, 210, 230, 244, 246, 278, 307,
-	313, 341, 344, 260, 241, 221, 361, 219, 378, 398,
-	399, 400, 402, 311, 237, 329, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 0, 0, 0,
-	287, 0, 0, 0, 342, 0, 379, 226, 296, 294,
-	406, 250, 243, 239, 225, 271, 302, 340, 397, 334,
-	0, 291, 0, 0, 388, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 224, 194, 326, 389, 253, 0, 0, 0,
-	176, 177, 178, 0, 1425, 0, 0, 0, 0, 0,
-	0, 216, 0, 222, 0, 0, 0, 0, 236, 275,
-	242, 235, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
-	0, 432, 0, 0, 0, 0, 0, 0, 0, 0,
-	286, 0, 283, 190, 204, 0, 0, 325, 363, 369,
-	0, 0, 0, 227, 0, 367, 338, 419, 212, 251,
-	360, 343, 365, 0, 0, 366, 292, 408, 355, 418,
-	433, 434, 234, 319, 425, 401, 430, 442, 205, 231,
-	332, 394, 422, 385, 312, 405, 282, 384, 259, 193,
-	290, 197, 396, 416, 217, 377, 0, 0, 0, 199,
-	414, 393, 309, 279, 280, 198, 0, 359, 238, 257,
-	229, 328, 411, 412, 228, 444, 207, 429, 201, 208,
-	428, 321, 407, 415, 310, 301, 200, 413, 308, 300,
-	285, 248, 267, 353, 295, 354, 268, 317, 316, 318,
-	0, 195, 0, 390, 423, 445, 214, 0, 0, 403,
-	438, 441, 0, 356, 215, 258, 247, 352, 256, 288,
-	437, 439, 440, 213, 350, 264, 320, 209, 270, 386,
-	284, 293, 0, 0, 337, 368, 218, 421, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	202, 289, 0, 357, 254, 443, 427, 424, 0, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 191, 192, 203, 211, 220, 232, 245,
-	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
-	304, 305, 306, 322, 323, 324, 327, 330, 331, 333,
-	335, 336, 339, 345, 346, 347, 348, 349, 351, 358,
-	362, 370, 371, 372, 373, 374, 375, 376, 380, 381,
-	382, 383, 391, 395, 409, 410, 420, 431, 435, 263,
-	417, 436, 0, 297, 0, 0, 299, 249, 265, 274,
-	0, 426, 392, 206, 364, 255, 196, 223, 210, 230,
-	244, 246, 278, 307, 313, 341, 344, 260, 241, 221,
-	361, 219, 378, 398, 399, 400, 402, 311, 237, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 342, 0,
-	379, 226, 296, 294, 406, 250, 243, 239, 225, 271,
-	302, 340, 397, 334, 0, 291, 0, 0, 388, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 277, 224, 194, 326, 389,
-	253, 0, 0, 0, 176, 177, 178, 0, 1060, 0,
-	0, 0, 0, 0, 0, 216, 0, 222, 0, 0,
-	0, 0, 236, 275, 242, 235, 404, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 315, 0, 0, 0, 432, 0, 0, 0, 0,
-	0, 0, 0, 0, 286, 0, 283, 190, 204, 0,
-	0, 325, 363, 369, 0, 0, 0, 227, 0, 367,
-	338, 419, 212, 251, 360, 343, 365, 0, 0, 366,
-	292, 408, 355, 418, 433, 434, 234, 319, 425, 401,
-	430, 442, 205, 231, 332, 394, 422, 385, 312, 405,
-	282, 384, 259, 193, 290, 197, 396, 416, 217, 377,
-	0, 0, 0, 199, 414, 393, 309, 279, 280, 198,
-	0, 359, 238, 257, 229, 328, 411, 412, 228, 444,
-	207, 429, 201, 208, 428, 321, 407, 415, 310, 301,
-	200, 413, 308, 300, 285, 248, 267, 353, 295, 354,
-	268, 317, 316, 318, 0, 195, 0, 390, 423, 445,
-	214, 0, 0, 403, 438, 441, 0, 356, 215, 258,
-	247, 352, 256, 288, 437, 439, 440, 213, 350, 264,
-	320, 209, 270, 386, 284, 293, 0, 0, 337, 368,
-	218, 421, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 202, 289, 0, 357, 254, 443,
-	427, 424, 0, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 203,
-	211, 220, 232, 245, 252, 262, 266, 269, 272, 273,
-	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
-	327, 330, 331, 333, 335, 336, 339, 345, 346, 347,
-	348, 349, 351, 358, 362, 370, 371, 372, 373, 374,
-	375, 376, 380, 381, 382, 383, 391, 395, 409, 410,
-	420, 431, 435, 263, 417, 436, 0, 297, 0, 0,
-	299, 249, 265, 274, 0, 426, 392, 206, 364, 255,
-	196, 223, 210, 230, 244, 246, 278, 307, 313, 341,
-	344, 260, 241, 221, 361, 219, 378, 398, 399, 400,
-	402, 311, 237, 329, 0, 0, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 342, 0, 379, 226, 296, 294, 406, 250,
-	243, 239, 225, 271, 302, 340, 397, 334, 0, 291,
-	0, 0, 388, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	224, 194, 326, 389, 253, 0, 0, 0, 176, 177,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 216,
-	0, 222, 0, 0, 0, 0, 236, 275, 242, 235,
-	404, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 315, 0, 0, 0, 432,
-	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
-	283, 190, 204, 0, 0, 325, 363, 369, 0, 0,
-	0, 227, 0, 367, 338, 419, 212, 251, 360, 343,
-	365, 0, 0, 366, 292, 408, 355, 418, 433, 434,
-	234, 319, 425, 401, 430, 442, 205, 231, 332, 394,
-	422, 385, 312, 405, 282, 384, 259, 193, 290, 197,
-	396, 416, 217, 377, 0, 0, 0, 199, 414, 393,
-	309, 279, 280, 198, 0, 359, 238, 257, 229, 328,
-	411, 412, 228, 444, 207, 429, 201, 208, 428, 321,
-	407, 415, 310, 301, 200, 413, 308, 300, 285, 248,
-	267, 353, 295, 354, 268, 317, 316, 318, 0, 195,
-	0, 390, 423, 445, 214, 0, 0, 403, 438, 441,
-	0, 356, 215, 258, 247, 352, 256, 288, 437, 439,
-	440, 213, 350, 264, 320, 209, 270, 386, 284, 293,
-	0, 0, 337, 368, 218, 421, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 202, 289,
-	1328, 357, 254, 443, 427, 424, 0, 0, 233, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 191, 192, 203, 211, 220, 232, 245, 252, 262,
-	266, 269, 272, 273, 276, 281, 298, 303, 304, 305,
-	306, 322, 323, 324, 327, 330, 331, 333, 335, 336,
-	339, 345, 346, 347, 348, 349, 351, 358, 362, 370,
-	371, 372, 373, 374, 375, 376, 380, 381, 382, 383,
-	391, 395, 409, 410, 420, 431, 435, 263, 417, 436,
-	0, 297, 0, 0, 299, 249, 265, 274, 0, 426,
-	392, 206, 364, 255, 196, 223, 210, 230, 244, 246,
-	278, 307, 313, 341, 344, 260, 241, 221, 361, 219,
-	378, 398, 399, 400, 402, 311, 237, 329, 0, 1215,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
-	0, 0, 287, 0, 0, 0, 342, 0, 379, 226,
-	296, 294, 406, 250, 243, 239, 225, 271, 302, 340,
-	397, 334, 0, 291, 0, 0, 388, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 277, 224, 194, 326, 389, 253, 0,
-	0, 0, 176, 177, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 216, 0, 222, 0, 0, 0, 0,
-	236, 275, 242, 235, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
-	0, 0, 0, 432, 0, 0, 0, 0, 0, 0,
-	0, 0, 286, 0, 283, 190, 204, 0, 0, 325,
-	363, 369, 0, 0, 0, 227, 0, 367, 338, 419,
-	212, 251, 360, 343, 365, 0, 0, 366, 292, 408,
-	355, 418, 433, 434, 234, 319, 425, 401, 430, 442,
-	205, 231, 332, 394, 422, 385, 312, 405, 282, 384,
-	259, 193, 290, 197, 396, 416, 217, 377, 0, 0,
-	0, 199, 414, 393, 309, 279, 280, 198, 0, 359,
-	238, 257, 229, 328, 411, 412, 228, 444, 207, 429,
-	201, 208, 428, 321, 407, 415, 310, 301, 200, 413,
-	308, 300, 285, 248, 267, 353, 295, 354, 268, 317,
-	316, 318, 0, 195, 0, 390, 423, 445, 214, 0,
-	0, 403, 438, 441, 0, 356, 215, 258, 247, 352,
-	256, 288, 437, 439, 440, 213, 350, 264, 320, 209,
-	270, 386, 284, 293, 0, 0, 337, 368, 218, 421,
-	387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 202, 289, 0, 357, 254, 443, 427, 424,
-	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 191, 192, 203, 211, 220,
-	232, 245, 252, 262, 266, 269, 272, 273, 276, 281,
-	298, 303, 304, 305, 306, 322, 323, 324, 327, 330,
-	331, 333, 335, 336, 339, 345, 346, 347, 348, 349,
-	351, 358, 362, 370, 371, 372, 373, 374, 375, 376,
-	380, 381, 382, 383, 391, 395, 409, 410, 420, 431,
-	435, 263, 417, 436, 0, 297, 0, 0, 299, 249,
-	265, 274, 0, 426, 392, 206, 364, 255, 196, 223,
-	210, 230, 244, 246, 278, 307, 313, 341, 344, 260,
-	241, 221, 361, 219, 378, 398, 399, 400, 402, 311,
-	237, 329, 0, 1213, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	342, 0, 379, 226, 296, 294, 406, 250, 243, 239,
-	225, 271, 302, 340, 397, 334, 0, 291, 0, 0,
-	388, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 277, 224, 194,
-	326, 389, 253, 0, 0, 0, 176, 177, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 216, 0, 222,
-	0, 0, 0, 0, 236, 275, 242, 235, 404, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 315, 0, 0, 0, 432, 0, 0,
-	0, 0, 0, 0, 0, 0, 286, 0, 283, 190,
-	204, 0, 0, 325, 363, 369, 0, 0, 0, 227,
-	0, 367, 338, 419, 212, 251, 360, 343, 365, 0,
-	0, 366, 292, 408, 355, 418, 433, 434, 234, 319,
-	425, 401, 430, 442, 205, 231, 332, 394, 422, 385,
-	312, 405, 282, 384, 259, 193, 290, 197, 396, 416,
-	217, 377, 0, 0, 0, 199, 414, 393, 309, 279,
-	280, 198, 0, 359, 238, 257, 229, 328, 411, 412,
-	228, 444, 207, 429, 201, 208, 428, 321, 407, 415,
-	310, 301, 200, 413, 308, 300, 285, 248, 267, 353,
-	295, 354, 268, 317, 316, 318, 0, 195, 0, 390,
-	423, 445, 214, 0, 0, 403, 438, 441, 0, 356,
-	215, 258, 247, 352, 256, 288, 437, 439, 440, 213,
-	350, 264, 320, 209, 270, 386, 284, 293, 0, 0,
-	337, 368, 218, 421, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 202, 289, 0, 357,
-	254, 443, 427, 424, 0, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-	192, 203, 211, 220, 232, 245, 252, 262, 266, 269,
-	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
-	323, 324, 327, 330, 331, 333, 335, 336, 339, 345,
-	346, 347, 348, 349, 351, 358, 362, 370, 371, 372,
-	373, 374, 375, 376, 380, 381, 382, 383, 391, 395,
-	409, 410, 420, 431, 435, 263, 417, 436, 0, 297,
-	0, 0, 299, 249, 265, 274, 0, 426, 392, 206,
-	364, 255, 196, 223, 210, 230, 244, 246, 278, 307,
-	313, 341, 344, 260, 241, 221, 361, 219, 378, 398,
-	399, 400, 402, 311, 237, 329, 0, 1211, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 0, 0, 0,
-	287, 0, 0, 0, 342, 0, 379, 226, 296, 294,
-	406, 250, 243, 239, 225, 271, 302, 340, 397, 334,
-	0, 291, 0, 0, 388, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 224, 194, 326, 389, 253, 0, 0, 0,
-	176, 177, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 216, 0, 222, 0, 0, 0, 0, 236, 275,
-	242, 235, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
-	0, 432, 0, 0, 0, 0, 0, 0, 0, 0,
-	286, 0, 283, 190, 204, 0, 0, 325, 363, 369,
-	0, 0, 0, 227, 0, 367, 338, 419, 212, 251,
-	360, 343, 365, 0, 0, 366, 292, 408, 355, 418,
-	433, 434, 234, 319, 425, 401, 430, 442, 205, 231,
-	332, 394, 422, 385, 312, 405, 282, 384, 259, 193,
-	290, 197, 396, 416, 217, 377, 0, 0, 0, 199,
-	414, 393, 309, 279, 280, 198, 0, 359, 238, 257,
-	229, 328, 411, 412, 228, 444, 207, 429, 201, 208,
-	428, 321, 407, 415, 310, 301, 200, 413, 308, 300,
-	285, 248, 267, 353, 295, 354, 268, 317, 316, 318,
-	0, 195, 0, 390, 423, 445, 214, 0, 0, 403,
-	438, 441, 0, 356, 215, 258, 247, 352, 256, 288,
-	437, 439, 440, 213, 350, 264, 320, 209, 270, 386,
-	284, 293, 0, 0, 337, 368, 218, 421, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	202, 289, 0, 357, 254, 443, 427, 424, 0, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 191, 192, 203, 211, 220, 232, 245,
-	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
-	304, 305, 306, 322, 323, 324, 327, 330, 331, 333,
-	335, 336, 339, 345, 346, 347, 348, 349, 351, 358,
-	362, 370, 371, 372, 373, 374, 375, 376, 380, 381,
-	382, 383, 391, 395, 409, 410, 420, 431, 435, 263,
-	417, 436, 0, 297, 0, 0, 299, 249, 265, 274,
-	0, 426, 392, 206, 364, 255, 196, 223, 210, 230,
-	244, 246, 278, 307, 313, 341, 344, 260, 241, 221,
-	361, 219, 378, 398, 399, 400, 402, 311, 237, 329,
-	0, 1209, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 342, 0,
-	379, 226, 296, 294, 406, 250, 243, 239, 225, 271,
-	302, 340, 397, 334, 0, 291, 0, 0, 388, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 277, 224, 194, 326, 389,
-	253, 0, 0, 0, 176, 177, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 216, 0, 222, 0, 0,
-	0, 0, 236, 275, 242, 235, 404, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 315, 0, 0, 0, 432, 0, 0, 0, 0,
-	0, 0, 0, 0, 286, 0, 283, 190, 204, 0,
-	0, 325, 363, 369, 0, 0, 0, 227, 0, 367,
-	338, 419, 212, 251, 360, 343, 365, 0, 0, 366,
-	292, 408, 355, 418, 433, 434, 234, 319, 425, 401,
-	430, 442, 205, 231, 332, 394, 422, 385, 312, 405,
-	282, 384, 259, 193, 290, 197, 396, 416, 217, 377,
-	0, 0, 0, 199, 414, 393, 309, 279, 280, 198,
-	0, 359, 238, 257, 229, 328, 411, 412, 228, 444,
-	207, 429, 201, 208, 428, 321, 407, 415, 310, 301,
-	200, 413, 308, 300, 285, 248, 267, 353, 295, 354,
-	268, 317, 316, 318, 0, 195, 0, 390, 423, 445,
-	214, 0, 0, 403, 438, 441, 0, 356, 215, 258,
-	247, 352, 256, 288, 437, 439, 440, 213, 350, 264,
-	320, 209, 270, 386, 284, 293, 0, 0, 337, 368,
-	218, 421, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 202, 289, 0, 357, 254, 443,
-	427, 424, 0, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 203,
-	211, 220, 232, 245, 252, 262, 266, 269, 272, 273,
-	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
-	327, 330, 331, 333, 335, 336, 339, 345, 346, 347,
-	348, 349, 351, 358, 362, 370, 371, 372, 373, 374,
-	375, 376, 380, 381, 382, 383, 391, 395, 409, 410,
-	420, 431, 435, 263, 417, 436, 0, 297, 0, 0,
-	299, 249, 265, 274, 0, 426, 392, 206, 364, 255,
-	196, 223, 210, 230, 244, 246, 278, 307, 313, 341,
-	344, 260, 241, 221, 361, 219, 378, 398, 399, 400,
-	402, 311, 237, 329, 0, 1207, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 0, 0, 0, 287, 0,
-	0, 0, 342, 0, 379, 226, 296, 294, 406, 250,
-	243, 239, 225, 271, 302, 340, 397, 334, 0, 291,
-	0, 0, 388, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	224, 194, 326, 389, 253, 0, 0, 0, 176, 177,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 216,
-	0, 222, 0, 0, 0, 0, 236, 275, 242, 235,
-	404, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 315, 0, 0, 0, 432,
-	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
-	283, 190, 204, 0, 0, 325, 363, 369, 0, 0,
-	0, 227, 0, 367, 338, 419, 212, 251, 360, 343,
-	365, 0, 0, 366, 292, 408, 355, 418, 433, 434,
-	234, 319, 425, 401, 430, 442, 205, 231, 332, 394,
-	422, 385, 312, 405, 282, 384, 259, 193, 290, 197,
-	396, 416, 217, 377, 0, 0, 0, 199, 414, 393,
-	309, 279, 280, 198, 0, 359, 238, 257, 229, 328,
-	411, 412, 228, 444, 207, 429, 201, 208, 428, 321,
-	407, 415, 310, 301, 200, 413, 308, 300, 285, 248,
-	267, 353, 295, 354, 268, 317, 316, 318, 0, 195,
-	0, 390, 423, 445, 214, 0, 0, 403, 438, 441,
-	0, 356, 215, 258, 247, 352, 256, 288, 437, 439,
-	440, 213, 350, 264, 320, 209, 270, 386, 284, 293,
-	0, 0, 337, 368, 218, 421, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 202, 289,
-	0, 357, 254, 443, 427, 424, 0, 0, 233, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 191, 192, 203, 211, 220, 232, 245, 252, 262,
-	266, 269, 272, 273, 276, 281, 298, 303, 304, 305,
-	306, 322, 323, 324, 327, 330, 331, 333, 335, 336,
-	339, 345, 346, 347, 348, 349, 351, 358, 362, 370,
-	371, 372, 373, 374, 375, 376, 380, 381, 382, 383,
-	391, 395, 409, 410, 420, 431, 435, 263, 417, 436,
-	0, 297, 0, 0, 299, 249, 265, 274, 0, 426,
-	392, 206, 364, 255, 196, 223, 210, 230, 244, 246,
-	278, 307, 313, 341, 344, 260, 241, 221, 361, 219,
-	378, 398, 399, 400, 402, 311, 237, 329, 0, 1203,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
-	0, 0, 287, 0, 0, 0, 342, 0, 379, 226,
-	296, 294, 406, 250, 243, 239, 225, 271, 302, 340,
-	397, 334, 0, 291, 0, 0, 388, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 277, 224, 194, 326, 389, 253, 0,
-	0, 0, 176, 177, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 216, 0, 222, 0, 0, 0, 0,
-	236, 275, 242, 235, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
-	0, 0, 0, 432, 0, 0, 0, 0, 0, 0,
-	0, 0, 286, 0, 283, 190, 204, 0, 0, 325,
-	363, 369, 0, 0, 0, 227, 0, 367, 338, 419,
-	212, 251, 360, 343, 365, 0, 0, 366, 292, 408,
-	355, 418, 433, 434, 234, 319, 425, 401, 430, 442,
-	205, 231, 332, 394, 422, 385, 312, 405, 282, 384,
-	259, 193, 290, 197, 396, 416, 217, 377, 0, 0,
-	0, 199, 414, 393, 309, 279, 280, 198, 0, 359,
-	238, 257, 229, 328, 411, 412, 228, 444, 207, 429,
-	201, 208, 428, 321, 407, 415, 310, 301, 200, 413,
-	308, 300, 285, 248, 267, 353, 295, 354, 268, 317,
-	316, 318, 0, 195, 0, 390, 423, 445, 214, 0,
-	0, 403, 438, 441, 0, 356, 215, 258, 247, 352,
-	256, 288, 437, 439, 440, 213, 350, 264, 320, 209,
-	270, 386, 284, 293, 0, 0, 337, 368, 218, 421,
-	387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 202, 289, 0, 357, 254, 443, 427, 424,
-	0, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 191, 192, 203, 211, 220,
-	232, 245, 252, 262, 266, 269, 272, 273, 276, 281,
-	298, 303, 304, 305, 306, 322, 323, 324, 327, 330,
-	331, 333, 335, 336, 339, 345, 346, 347, 348, 349,
-	351, 358, 362, 370, 371, 372, 373, 374, 375, 376,
-	380, 381, 382, 383, 391, 395, 409, 410, 420, 431,
-	435, 263, 417, 436, 0, 297, 0, 0, 299, 249,
-	265, 274, 0, 426, 392, 206, 364, 255, 196, 223,
-	210, 230, 244, 246, 278, 307, 313, 341, 344, 260,
-	241, 221, 361, 219, 378, 398, 399, 400, 402, 311,
-	237, 329, 0, 1201, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 0, 0, 0, 287, 0, 0, 0,
-	342, 0, 379, 226, 296, 294, 406, 250, 243, 239,
-	225, 271, 302, 340, 397, 334, 0, 291, 0, 0,
-	388, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 277, 224, 194,
-	326, 389, 253, 0, 0, 0, 176, 177, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 216, 0, 222,
-	0, 0, 0, 0, 236, 275, 242, 235, 404, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 315, 0, 0, 0, 432, 0, 0,
-	0, 0, 0, 0, 0, 0, 286, 0, 283, 190,
-	204, 0, 0, 325, 363, 369, 0, 0, 0, 227,
-	0, 367, 338, 419, 212, 251, 360, 343, 365, 0,
-	0, 366, 292, 408, 355, 418, 433, 434, 234, 319,
-	425, 401, 430, 442, 205, 231, 332, 394, 422, 385,
-	312, 405, 282, 384, 259, 193, 290, 197, 396, 416,
-	217, 377, 0, 0, 0, 199, 414, 393, 309, 279,
-	280, 198, 0, 359, 238, 257, 229, 328, 411, 412,
-	228, 444, 207, 429, 201, 208, 428, 321, 407, 415,
-	310, 301, 200, 413, 308, 300, 285, 248, 267, 353,
-	295, 354, 268, 317, 316, 318, 0, 195, 0, 390,
-	423, 445, 214, 0, 0, 403, 438, 441, 0, 356,
-	215, 258, 247, 352, 256, 288, 437, 439, 440, 213,
-	350, 264, 320, 209, 270, 386, 284, 293, 0, 0,
-	337, 368, 218, 421, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 202, 289, 0, 357,
-	254, 443, 427, 424, 0, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-	192, 203, 211, 220, 232, 245, 252, 262, 266, 269,
-	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
-	323, 324, 327, 330, 331, 333, 335, 336, 339, 345,
-	346, 347, 348, 349, 351, 358, 362, 370, 371, 372,
-	373, 374, 375, 376, 380, 381, 382, 383, 391, 395,
-	409, 410, 420, 431, 435, 263, 417, 436, 0, 297,
-	0, 0, 299, 249, 265, 274, 0, 426, 392, 206,
-	364, 255, 196, 223, 210, 230, 244, 246, 278, 307,
-	313, 341, 344, 260, 241, 221, 361, 219, 378, 398,
-	399, 400, 402, 311, 237, 329, 0, 1199, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 0, 0, 0,
-	287, 0, 0, 0, 342, 0, 379, 226, 296, 294,
-	406, 250, 243, 239, 225, 271, 302, 340, 397, 334,
-	0, 291, 0, 0, 388, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 224, 194, 326, 389, 253, 0, 0, 0,
-	176, 177, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 216, 0, 222, 0, 0, 0, 0, 236, 275,
-	242, 235, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
-	0, 432, 0, 0, 0, 0, 0, 0, 0, 0,
-	286, 0, 283, 190, 204, 0, 0, 325, 363, 369,
-	0, 0, 0, 227, 0, 367, 338, 419, 212, 251,
-	360, 343, 365, 0, 0, 366, 292, 408, 355, 418,
-	433, 434, 234, 319, 425, 401, 430, 442, 205, 231,
-	332, 394, 422, 385, 312, 405, 282, 384, 259, 193,
-	290, 197, 396, 416, 217, 377, 0, 0, 0, 199,
-	414, 393, 309, 279, 280, 198, 0, 359, 238, 257,
-	229, 328, 411, 412, 228, 444, 207, 429, 201, 208,
-	428, 321, 407, 415, 310, 301, 200, 413, 308, 300,
-	285, 248, 267, 353, 295, 354, 268, 317, 316, 318,
-	0, 195, 0, 390, 423, 445, 214, 0, 0, 403,
-	438, 441, 0, 356, 215, 258, 247, 352, 256, 288,
-	437, 439, 440, 213, 350, 264, 320, 209, 270, 386,
-	284, 293, 0, 0, 337, 368, 218, 421, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	202, 289, 0, 357, 254, 443, 427, 424, 0, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 191, 192, 203, 211, 220, 232, 245,
-	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
-	304, 305, 306, 322, 323, 324, 327, 330, 331, 333,
-	335, 336, 339, 345, 346, 347, 348, 349, 351, 358,
-	362, 370, 371, 372, 373, 374, 375, 376, 380, 381,
-	382, 383, 391, 395, 409, 410, 420, 431, 435, 263,
-	417, 436, 0, 297, 0, 0, 299, 249, 265, 274,
-	0, 426, 392, 206, 364, 255, 196, 223, 210, 230,
-	244, 246, 278, 307, 313, 341, 344, 260, 241, 221,
-	361, 219, 378, 398, 399, 400, 402, 311, 237, 329,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 0, 0, 0, 287, 0, 0, 0, 342, 0,
-	379, 226, 296, 294, 406, 250, 243, 239, 225, 271,
-	302, 340, 397, 334, 0, 291, 0, 0, 388, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 277, 224, 194, 326, 389,
-	253, 1174, 0, 0, 176, 177, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 216, 0, 222, 0, 0,
-	0, 0, 236, 275, 242, 235, 404, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 315, 0, 0, 0, 432, 0, 0, 0, 0,
-	0, 0, 0, 0, 286, 0, 283, 190, 204, 0,
-	0, 325, 363, 369, 0, 0, 0, 227, 0, 367,
-	338, 419, 212, 251, 360, 343, 365, 0, 0, 366,
-	292, 408, 355, 418, 433, 434, 234, 319, 425, 401,
-	430, 442, 205, 231, 332, 394, 422, 385, 312, 405,
-	282, 384, 259, 193, 290, 197, 396, 416, 217, 377,
-	0, 0, 0, 199, 414, 393, 309, 279, 280, 198,
-	0, 359, 238, 257, 229, 328, 411, 412, 228, 444,
-	207, 429, 201, 208, 428, 321, 407, 415, 310, 301,
-	200, 413, 308, 300, 285, 248, 267, 353, 295, 354,
-	268, 317, 316, 318, 0, 195, 0, 390, 423, 445,
-	214, 0, 0, 403, 438, 441, 0, 356, 215, 258,
-	247, 352, 256, 288, 437, 439, 440, 213, 350, 264,
-	320, 209, 270, 386, 284, 293, 0, 0, 337, 368,
-	218, 421, 387, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 202, 289, 0, 357, 254, 443,
-	427, 424, 0, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 191, 192, 203,
-	211, 220, 232, 245, 252, 262, 266, 269, 272, 273,
-	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
-	327, 330, 331, 333, 335, 336, 339, 345, 346, 347,
-	348, 349, 351, 358, 362, 370, 371, 372, 373, 374,
-	375, 376, 380, 381, 382, 383, 391, 395, 409, 410,
-	420, 431, 435, 263, 417, 436, 0, 297, 0, 0,
-	299, 249, 265, 274, 0, 426, 392, 206, 364, 255,
-	196, 223, 210, 230, 244, 246, 278, 307, 313, 341,
-	344, 260, 241, 221, 361, 219, 378, 398, 399, 400,
-	402, 311, 237, 1073, 0, 0, 0, 0, 0, 0,
-	329, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 0, 0, 0, 287, 0, 0, 0, 342,
-	0, 379, 226, 296, 294, 406, 250, 243, 239, 225,
-	271, 302, 340, 397, 334, 0, 291, 0, 0, 388,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 224, 194, 326,
-	389, 253, 0, 0, 0, 176, 177, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 216, 0, 222, 0,
-	0, 0, 0, 236, 275, 242, 235, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 0, 315, 0, 0, 0, 432, 0, 0, 0,
-	0, 0, 0, 0, 0, 286, 0, 283, 190, 204,
-	0, 0, 325, 363, 369, 0, 0, 0, 227, 0,
-	367, 338, 419, 212, 251, 360, 343, 365, 0, 0,
-	366, 292, 408, 355, 418, 433, 434, 234, 319, 425,
-	401, 430, 442, 205, 231, 332, 394, 422, 385, 312,
-	405, 282, 384, 259, 193, 290, 197, 396, 416, 217,
-	377, 0, 0, 0, 199, 414, 393, 309, 279, 280,
-	198, 0, 359, 238, 257, 229, 328, 411, 412, 228,
-	444, 207, 429, 201, 208, 428, 321, 407, 415, 310,
-	301, 200, 413, 308, 300, 285, 248, 267, 353, 295,
-	354, 268, 317, 316, 318, 0, 195, 0, 390, 423,
-	445, 214, 0, 0, 403, 438, 441, 0, 356, 215,
-	258, 247, 352, 256, 288, 437, 439, 440, 213, 350,
-	264, 320, 209, 270, 386, 284, 293, 0, 0, 337,
-	368, 218, 421, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 202, 289, 0, 357, 254,
-	443, 427, 424, 0, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	203, 211, 220, 232, 245, 252, 262, 266, 269, 272,
-	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
-	324, 327, 330, 331, 333, 335, 336, 339, 345, 346,
-	347, 348, 349, 351, 358, 362, 370, 371, 372, 373,
-	374, 375, 376, 380, 381, 382, 383, 391, 395, 409,
-	410, 420, 431, 435, 263, 417, 436, 0, 297, 0,
-	0, 299, 249, 265, 274, 0, 426, 392, 206, 364,
-	255, 196, 223, 210, 230, 244, 246, 278, 307, 313,
-	341, 344, 260, 241, 221, 361, 219, 378, 398, 399,
-	400, 402, 311, 237, 329, 0, 0, 0, 0, 0,
-	0, 0, 1064, 240, 0, 0, 0, 0, 0, 287,
-	0, 0, 0, 342, 0, 379, 226, 296, 294, 406,
-	250, 243, 239, 225, 271, 302, 340, 397, 334, 0,
-	291, 0, 0, 388, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	277, 224, 194, 326, 389, 253, 0, 0, 0, 176,
-	177, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	216, 0, 222, 0, 0, 0, 0, 236, 275, 242,
-	235, 404, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 315, 0, 0, 0,
-	432, 0, 0, 0, 0, 0, 0, 0, 0, 286,
-	0, 283, 190, 204, 0, 0, 325, 363, 369, 0,
-	0, 0, 227, 0, 367, 338, 419, 212, 251, 360,
-	343, 365, 0, 0, 366, 292, 408, 355, 418, 433,
-	434, 234, 319, 425, 401, 430, 442, 205, 231, 332,
-	394, 422, 385, 312, 405, 282, 384, 259, 193, 290,
-	197, 396, 416, 217, 377, 0, 0, 0, 199, 414,
-	393, 309, 279, 280, 198, 0, 359, 238, 257, 229,
-	328, 411, 412, 228, 444, 207, 429, 201, 208, 428,
-	321, 407, 415, 310, 301, 200, 413, 308, 300, 285,
-	248, 267, 353, 295, 354, 268, 317, 316, 318, 0,
-	195, 0, 390, 423, 445, 214, 0, 0, 403, 438,
-	441, 0, 356, 215, 258, 247, 352, 256, 288, 437,
-	439, 440, 213, 350, 264, 320, 209, 270, 386, 284,
-	293, 0, 0, 337, 368, 218, 421, 387, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 202,
-	289, 0, 357, 254, 443, 427, 424, 0, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 191, 192, 203, 211, 220, 232, 245, 252,
-	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
-	305, 306, 322, 323, 324, 327, 330, 331, 333, 335,
-	336, 339, 345, 346, 347, 348, 349, 351, 358, 362,
-	370, 371, 372, 373, 374, 375, 376, 380, 381, 382,
-	383, 391, 395, 409, 410, 420, 431, 435, 263, 417,
-	436, 0, 297, 0, 0, 299, 249, 265, 274, 0,
-	426, 392, 206, 364, 255, 196, 223, 210, 230, 244,
-	246, 278, 307, 313, 341, 344, 260, 241, 221, 361,
-	219, 378, 398, 399, 400, 402, 311, 237, 329, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	0, 0, 0, 287, 0, 0, 0, 342, 0, 379,
-	226, 296, 294, 406, 250, 243, 239, 225, 271, 302,
-	340, 397, 334, 0, 291, 0, 0, 388, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 277, 224, 194, 326, 389, 253,
-	0, 0, 0, 176, 177, 178, 0, 922, 0, 0,
-	0, 0, 0, 0, 216, 0, 222, 0, 0, 0,
-	0, 236, 275, 242, 235, 404, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	315, 0, 0, 0, 432, 0, 0, 0, 0, 0,
-	0, 0, 0, 286, 0, 283, 190, 204, 0, 0,
-	325, 363, 369, 0, 0, 0, 227, 0, 367, 338,
-	419, 212, 251, 360, 343, 365, 0, 0, 366, 292,
-	408, 355, 418, 433, 434, 234, 319, 425, 401, 430,
-	442, 205, 231, 332, 394, 422, 385, 312, 405, 282,
-	384, 259, 193, 290, 197, 396, 416, 217, 377, 0,
-	0, 0, 199, 414, 393, 309, 279, 280, 198, 0,
-	359, 238, 257, 229, 328, 411, 412, 228, 444, 207,
-	429, 201, 208, 428, 321, 407, 415, 310, 301, 200,
-	413, 308, 300, 285, 248, 267, 353, 295, 354, 268,
-	317, 316, 318, 0, 195, 0, 390, 423, 445, 214,
-	0, 0, 403, 438, 441, 0, 356, 215, 258, 247,
-	352, 256, 288, 437, 439, 440, 213, 350, 264, 320,
-	209, 270, 386, 284, 293, 0, 0, 337, 368, 218,
-	421, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 202, 289, 0, 357, 254, 443, 427,
-	424, 0, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 191, 192, 203, 211,
-	220, 232, 245, 252, 262, 266, 269, 272, 273, 276,
-	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
-	330, 331, 333, 335, 336, 339, 345, 346, 347, 348,
-	349, 351, 358, 362, 370, 371, 372, 373, 374, 375,
-	376, 380, 381, 382, 383, 391, 395, 409, 410, 420,
-	431, 435, 263, 417, 436, 0, 297, 0, 0, 299,
-	249, 265, 274, 0, 426, 392, 206, 364, 255, 196,
-	223, 210, 230, 244, 246, 278, 307, 313, 341, 344,
-	260, 241, 221, 361, 219, 378, 398, 399, 400, 402,
-	311, 237, 329, 0, 0, 0, 0, 0, 0, 0,
-	0, 240, 0, 0, 0, 0, 0, 287, 0, 0,
-	0, 342, 0, 379, 226, 296, 294, 406, 250, 243,
-	239, 225, 271, 302, 340, 397, 334, 0, 291, 0,
-	0, 388, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 224,
-	194, 326, 389, 253, 0, 0, 0, 176, 177, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 216, 0,
-	222, 0, 0, 0, 0, 236, 275, 242, 235, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	491, 0, 261, 0, 315, 0, 0, 0, 432, 0,
-	0, 0, 0, 0, 0, 0, 0, 286, 0, 283,
-	190, 204, 0, 0, 325, 363, 369, 0, 0, 0,
-	227, 0, 367, 338, 419, 212, 251, 360, 343, 365,
-	0, 0, 366, 292, 408, 355, 418, 433, 434, 234,
-	319, 425, 401, 430, 442, 205, 231, 332, 394, 422,
-	385, 312, 405, 282, 384, 259, 193, 290, 197, 396,
-	416, 217, 377, 0, 0, 0, 199, 414, 393, 309,
-	279, 280, 198, 0, 359, 238, 257, 229, 328, 411,
-	412, 228, 444, 207, 429, 201, 208, 428, 321, 407,
-	415, 310, 301, 200, 413, 308, 300, 285, 248, 267,
-	353, 295, 354, 268, 317, 316, 318, 0, 195, 0,
-	390, 423, 445, 214, 0, 0, 403, 438, 441, 0,
-	356, 215, 258, 247, 352, 256, 288, 437, 439, 440,
-	213, 350, 264, 320, 209, 270, 386, 284, 293, 0,
-	0, 337, 368, 218, 421, 387, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 202, 289, 0,
-	357, 254, 443, 427, 424, 0, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	191, 192, 203, 211, 220, 232, 245, 252, 262, 266,
-	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
-	322, 323, 324, 327, 330, 331, 333, 335, 336, 339,
-	345, 346, 347, 348, 349, 351, 358, 362, 370, 371,
-	372, 373, 374, 375, 376, 380, 381, 382, 383, 391,
-	395, 409, 410, 420, 431, 435, 490, 417, 436, 0,
-	297, 0, 0, 299, 249, 265, 274, 0, 426, 392,
-	206, 364, 255, 196, 223, 210, 230, 244, 246, 278,
-	307, 313, 341, 344, 260, 241, 221, 361, 219, 378,
-	398, 399, 400, 402, 311, 237, 329, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 0, 0,
-	0, 287, 0, 0, 0, 342, 0, 379, 226, 296,
-	294, 406, 250, 243, 239, 225, 271, 302, 340, 397,
-	334, 0, 291, 0, 0, 388, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 277, 224, 194, 326, 389, 253, 0, 0,
-	0, 176, 177, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 216, 0, 222, 0, 0, 0, 0, 236,
-	275, 242, 235, 404, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 0, 315, 0,
-	184, 0, 432, 0, 0, 0, 0, 0, 0, 0,
-	0, 286, 0, 283, 190, 204, 0, 0, 325, 363,
-	369, 0, 0, 0, 227, 0, 367, 338, 419, 212,
-	251, 360, 343, 365, 0, 0, 366, 292, 408, 355,
-	418, 433, 434, 234, 319, 425, 401, 430, 442, 205,
-	231, 332, 394, 422, 385, 312, 405, 282, 384, 259,
-	193, 290, 197, 396, 416, 217, 377, 0, 0, 0,
-	199, 414, 393, 309, 279, 280, 198, 0, 359, 238,
-	257, 229, 328, 411, 412, 228, 444, 207, 429, 201,
-	208, 428, 321, 407, 415, 310, 301, 200, 413, 308,
-	300, 285, 248, 267, 353, 295, 354, 268, 317, 316,
-	318, 0, 195, 0, 390, 423, 445, 214, 0, 0,
-	403, 438, 441, 0, 356, 215, 258, 247, 352, 256,
-	288, 437, 439, 440, 213, 350, 264, 320, 209, 270,
-	386, 284, 293, 0, 0, 337, 368, 218, 421, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 202, 289, 0, 357, 254, 443, 427, 424, 0,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 191, 192, 203, 211, 220, 232,
-	245, 252, 262, 266, 269, 272, 273, 276, 281, 298,
-	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
-	333, 335, 336, 339, 345, 346, 347, 348, 349, 351,
-	358, 362, 370, 371, 372, 373, 374, 375, 376, 380,
-	381, 382, 383, 391, 395, 409, 410, 420, 431, 435,
-	263, 417, 436, 0, 297, 0, 0, 299, 249, 265,
-	274, 0, 426, 392, 206, 364, 255, 196, 223, 210,
-	230, 244, 246, 278, 307, 313, 341, 344, 260, 241,
-	221, 361, 219, 378, 398, 399, 400, 402, 311, 237,
-	329, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 0, 0, 0, 287, 0, 0, 0, 342,
-	0, 379, 226, 296, 294, 406, 250, 243, 239, 225,
-	271, 302, 340, 397, 334, 0, 291, 0, 0, 388,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 224, 194, 326,
-	389, 253, 0, 0, 0, 176, 177, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 216, 0, 222, 0,
-	0, 0, 0, 236, 275, 242, 235, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 0, 315, 0, 0, 0, 432, 0, 0, 0,
-	0, 0, 0, 0, 0, 286, 0, 283, 190, 204,
-	0, 0, 325, 363, 369, 0, 0, 0, 227, 0,
-	367, 338, 419, 212, 251, 360, 343, 365, 0, 0,
-	366, 292, 408, 355, 418, 433, 434, 234, 319, 425,
-	401, 430, 442, 205, 231, 332, 394, 422, 385, 312,
-	405, 282, 384, 259, 193, 290, 197, 396, 416, 217,
-	377, 0, 0, 0, 199, 414, 393, 309, 279, 280,
-	198, 0, 359, 238, 257, 229, 328, 411, 412, 228,
-	444, 207, 429, 201, 208, 428, 321, 407, 415, 310,
-	301, 200, 413, 308, 300, 285, 248, 267, 353, 295,
-	354, 268, 317, 316, 318, 0, 195, 0, 390, 423,
-	445, 214, 0, 0, 403, 438, 441, 0, 356, 215,
-	258, 247, 352, 256, 288, 437, 439, 440, 213, 350,
-	264, 320, 209, 270, 386, 284, 293, 0, 0, 337,
-	368, 218, 421, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 202, 289, 0, 357, 254,
-	443, 427, 424, 0, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 191, 192,
-	203, 211, 220, 232, 245, 252, 262, 266, 269, 272,
-	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
-	324, 327, 330, 331, 333, 335, 336, 339, 345, 346,
-	347, 348, 349, 351, 358, 362, 370, 371, 372, 373,
-	374, 375, 376, 380, 381, 382, 383, 391, 395, 409,
-	410, 420, 431, 435, 263, 417, 436, 0, 297, 0,
-	0, 299, 249, 265, 274, 0, 426, 392, 206, 364,
-	255, 196, 223, 210, 230, 244, 246, 278, 307, 313,
-	341, 344, 260, 241, 221, 361, 219, 378, 398, 399,
-	400, 402, 311, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2010, 0, 0, 0, 0, 0, 0, 2016, 2017,
+	2018, 0, 0, 0, 0, 0, 1443, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1913, 0, 34, 0, 1913, 0, 0,
+	0, 0, 0, 0, 1209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 34, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1913,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 34, 2166, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 743, 730, 0, 0, 678, 746, 649, 667, 755,
+	669, 672, 712, 629, 691, 334, 664, 0, 653, 625,
+	660, 626, 651, 680, 245, 684, 648, 732, 694, 745,
+	292, 0, 631, 654, 347, 714, 386, 231, 301, 299,
+	413, 255, 248, 244, 229, 276, 307, 345, 404, 339,
+	752, 296, 701, 0, 395, 319, 0, 0, 2224, 682,
+	735, 689, 726, 677, 713, 638, 700, 747, 665, 709,
+	748, 282, 228, 198, 331, 396, 258, 385, 0, 0,
+	0, 0, 180, 181, 182, 0, 2173, 2174, 0, 0,
+	0, 0, 0, 220, 0, 226, 706, 742, 662, 708,
+	241, 280, 247, 240, 411, 711, 758, 624, 703, 0,
+	627, 630, 754, 738, 657, 658, 0, 0, 0, 0,
+	0, 0, 0, 681, 690, 723, 675, 0, 0, 0,
+	0, 0, 0, 0, 0, 655, 0, 699, 0, 0,
+	0, 634, 628, 0, 0, 0, 0, 679, 0, 0,
+	0, 637, 0, 656, 724, 0, 622, 266, 632, 320,
+	728, 737, 676, 439, 741, 674, 673, 744, 718, 635,
+	734, 668, 291, 633, 288, 194, 208, 0, 666, 330,
+	368, 374, 733, 652, 661, 232, 659, 372, 343, 426,
+	216, 256, 365, 348, 370, 698, 716, 371, 297, 415,
+	360, 425, 440, 441, 239, 324, 432, 408, 437, 449,
+	209, 236, 337, 401, 429, 392, 317, 412, 287, 391,
+	264, 197, 295, 201, 403, 423, 221, 382, 0, 0,
+	0, 203, 421, 400, 314, 284, 285, 202, 0, 364,
+	243, 262, 234, 333, 418, 419, 233, 451, 211, 436,
+	205, 212, 435, 326, 414, 422, 315, 306, 204, 420,
+	313, 305, 290, 253, 272, 358, 300, 359, 273, 322,
+	321, 323, 0, 199, 0, 397, 430, 452, 218, 647,
+	729, 410, 445, 448, 0, 361, 219, 263, 252, 357,
+	261, 293, 444, 446, 447, 217, 355, 269, 325, 213,
+	275, 393, 289, 298, 721, 757, 342, 373, 222, 428,
+	394, 642, 646, 640, 641, 692, 693, 643, 749, 750,
+	751, 725, 636, 0, 644, 645, 0, 731, 739, 740,
+	697, 193, 206, 294, 753, 362, 259, 450, 434, 431,
+	623, 639, 238, 650, 0, 0, 663, 670, 671, 683,
+	685, 686, 687, 688, 696, 704, 705, 707, 715, 717,
+	720, 722, 727, 736, 756, 195, 196, 207, 215, 224,
+	237, 250, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 338, 340, 341, 344, 350, 351, 352, 353, 354,
+	356, 363, 367, 375, 376, 377, 378, 379, 380, 381,
+	387, 388, 389, 390, 398, 402, 416, 417, 427, 438,
+	442, 230, 383, 719, 268, 424, 443, 0, 302, 695,
+	702, 304, 254, 270, 279, 710, 433, 399, 210, 369,
+	260, 200, 227, 214, 235, 249, 251, 283, 312, 318,
+	346, 349, 265, 246, 225, 366, 223, 384, 405, 406,
+	407, 409, 316, 242, 743, 730, 0, 0, 678, 746,
+	649, 667, 755, 669, 672, 712, 629, 691, 334, 664,
+	0, 653, 625, 660, 626, 651, 680, 245, 684, 648,
+	732, 694, 745, 292, 0, 631, 654, 347, 714, 386,
+	231, 301, 299, 413, 255, 248, 244, 229, 276, 307,
+	345, 404, 339, 752, 296, 701, 0, 395, 319, 0,
+	0, 0, 682, 735, 689, 726, 677, 713, 638, 700,
+	747, 665, 709, 748, 282, 228, 198, 331, 396, 258,
+	385, 0, 0, 0, 0, 180, 181, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 220, 0, 226, 706,
+	742, 662, 708, 241, 280, 247, 240, 411, 711, 758,
+	624, 703, 0, 627, 630, 754, 738, 657, 658, 0,
+	0, 0, 0, 0, 0, 0, 681, 690, 723, 675,
+	0, 0, 0, 0, 0, 0, 1905, 0, 655, 0,
+	699, 0, 0, 0, 634, 628, 0, 0, 0, 0,
+	679, 0, 0, 0, 637, 0, 656, 724, 0, 622,
+	266, 632, 320, 728, 737, 676, 439, 741, 674, 673,
+	744, 718, 635, 734, 668, 291, 633, 288, 194, 208,
+	0, 666, 330, 368, 374, 733, 652, 661, 232, 659,
+	372, 343, 426, 216, 256, 365, 348, 370, 698, 716,
+	371, 297, 415, 360, 425, 440, 441, 239, 324, 432,
+	408, 437, 449, 209, 236, 337, 401, 429, 392, 317,
+	412, 287, 391, 264, 197, 295, 201, 403, 423, 221,
+	382, 0, 0, 0, 203, 421, 400, 314, 284, 285,
+	202, 0, 364, 243, 262, 234, 333, 418, 419, 233,
+	451, 211, 436, 205, 212, 435, 326, 414, 422, 315,
+	306, 204, 420, 313, 305, 290, 253, 272, 358, 300,
+	359, 273, 322, 321, 323, 0, 199, 0, 397, 430,
+	452, 218, 647, 729, 410, 445, 448, 0, 361, 219,
+	263, 252, 357, 261, 293, 444, 446, 447, 217, 355,
+	269, 325, 213, 275, 393, 289, 298, 721, 757, 342,
+	373, 222, 428, 394, 642, 646, 640, 641, 692, 693,
+	643, 749, 750, 751, 725, 636, 0, 644, 645, 0,
+	731, 739, 740, 697, 193, 206, 294, 753, 362, 259,
+	450, 434, 431, 623, 639, 238, 650, 0, 0, 663,
+	670, 671, 683, 685, 686, 687, 688, 696, 704, 705,
+	707, 715, 717, 720, 722, 727, 736, 756, 195, 196,
+	207, 215, 224, 237, 250, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 338, 340, 341, 344, 350, 351,
+	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
+	379, 380, 381, 387, 388, 389, 390, 398, 402, 416,
+	417, 427, 438, 442, 230, 383, 719, 268, 424, 443,
+	0, 302, 695, 702, 304, 254, 270, 279, 710, 433,
+	399, 210, 369, 260, 200, 227, 214, 235, 249, 251,
+	283, 312, 318, 346, 349, 265, 246, 225, 366, 223,
+	384, 405, 406, 407, 409, 316, 242, 743, 730, 0,
+	0, 678, 746, 649, 667, 755, 669, 672, 712, 629,
+	691, 334, 664, 0, 653, 625, 660, 626, 651, 680,
+	245, 684, 648, 732, 694, 745, 292, 0, 631, 654,
+	347, 714, 386, 231, 301, 299, 413, 255, 248, 244,
+	229, 276, 307, 345, 404, 339, 752, 296, 701, 0,
+	395, 319, 0, 0, 0, 682, 735, 689, 726, 677,
+	713, 638, 700, 747, 665, 709, 748, 282, 228, 198,
+	331, 396, 258, 385, 0, 0, 0, 0, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	0, 226, 706, 742, 662, 708, 241, 280, 247, 240,
+	411, 711, 758, 624, 703, 0, 627, 630, 754, 738,
+	657, 658, 0, 0, 0, 0, 0, 0, 0, 681,
+	690, 723, 675, 0, 0, 0, 0, 0, 0, 1764,
+	0, 655, 0, 699, 0, 0, 0, 634, 628, 0,
+	0, 0, 0, 679, 0, 0, 0, 637, 0, 656,
+	724, 0, 622, 266, 632, 320, 728, 737, 676, 439,
+	741, 674, 673, 744, 718, 635, 734, 668, 291, 633,
+	288, 194, 208, 0, 666, 330, 368, 374, 733, 652,
+	661, 232, 659, 372, 343, 426, 216, 256, 365, 348,
+	370, 698, 716, 371, 297, 415, 360, 425, 440, 441,
+	239, 324, 432, 408, 437, 449, 209, 236, 337, 401,
+	429, 392, 317, 412, 287, 391, 264, 197, 295, 201,
+	403, 423, 221, 382, 0, 0, 0, 203, 421, 400,
+	314, 284, 285, 202, 0, 364, 243, 262, 234, 333,
+	418, 419, 233, 451, 211, 436, 205, 212, 435, 326,
+	414, 422, 315, 306, 204, 420, 313, 305, 290, 253,
+	272, 358, 300, 359, 273, 322, 321, 323, 0, 199,
+	0, 397, 430, 452, 218, 647, 729, 410, 445, 448,
+	0, 361, 219, 263, 252, 357, 261, 293, 444, 446,
+	447, 217, 355, 269, 325, 213, 275, 393, 289, 298,
+	721, 757, 342, 373, 222, 428, 394, 642, 646, 640,
+	641, 692, 693, 643, 749, 750, 751, 725, 636, 0,
+	644, 645, 0, 731, 739, 740, 697, 193, 206, 294,
+	753, 362, 259, 450, 434, 431, 623, 639, 238, 650,
+	0, 0, 663, 670, 671, 683, 685, 686, 687, 688,
+	696, 704, 705, 707, 715, 717, 720, 722, 727, 736,
+	756, 195, 196, 207, 215, 224, 237, 250, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 338, 340, 341,
+	344, 350, 351, 352, 353, 354, 356, 363, 367, 375,
+	376, 377, 378, 379, 380, 381, 387, 388, 389, 390,
+	398, 402, 416, 417, 427, 438, 442, 230, 383, 719,
+	268, 424, 443, 0, 302, 695, 702, 304, 254, 270,
+	279, 710, 433, 399, 210, 369, 260, 200, 227, 214,
+	235, 249, 251, 283, 312, 318, 346, 349, 265, 246,
+	225, 366, 223, 384, 405, 406, 407, 409, 316, 242,
+	743, 730, 0, 0, 678, 746, 649, 667, 755, 669,
+	672, 712, 629, 691, 334, 664, 0, 653, 625, 660,
+	626, 651, 680, 245, 684, 648, 732, 694, 745, 292,
+	0, 631, 654, 347, 714, 386, 231, 301, 299, 413,
+	255, 248, 244, 229, 276, 307, 345, 404, 339, 752,
+	296, 701, 0, 395, 319, 0, 0, 0, 682, 735,
+	689, 726, 677, 713, 638, 700, 747, 665, 709, 748,
+	282, 228, 198, 331, 396, 258, 385, 0, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 220, 0, 226, 706, 742, 662, 708, 241,
+	280, 247, 240, 411, 711, 758, 624, 703, 0, 627,
+	630, 754, 738, 657, 658, 0, 0, 0, 0, 0,
+	0, 0, 681, 690, 723, 675, 0, 0, 0, 0,
+	0, 0, 1475, 0, 655, 0, 699, 0, 0, 0,
+	634, 628, 0, 0, 0, 0, 679, 0, 0, 0,
+	637, 0, 656, 724, 0, 622, 266, 632, 320, 728,
+	737, 676, 439, 741, 674, 673, 744, 718, 635, 734,
+	668, 291, 633, 288, 194, 208, 0, 666, 330, 368,
+	374, 733, 652, 661, 232, 659, 372, 343, 426, 216,
+	256, 365, 348, 370, 698, 716, 371, 297, 415, 360,
+	425, 440, 441, 239, 324, 432, 408, 437, 449, 209,
+	236, 337, 401, 429, 392, 317, 412, 287, 391, 264,
+	197, 295, 201, 403, 423, 221, 382, 0, 0, 0,
+	203, 421, 400, 314, 284, 285, 202, 0, 364, 243,
+	262, 234, 333, 418, 419, 233, 451, 211, 436, 205,
+	212, 435, 326, 414, 422, 315, 306, 204, 420, 313,
+	305, 290, 253, 272, 358, 300, 359, 273, 322, 321,
+	323, 0, 199, 0, 397, 430, 452, 218, 647, 729,
+	410, 445, 448, 0, 361, 219, 263, 252, 357, 261,
+	293, 444, 446, 447, 217, 355, 269, 325, 213, 275,
+	393, 289, 298, 721, 757, 342, 373, 222, 428, 394,
+	642, 646, 640, 641, 692, 693, 643, 749, 750, 751,
+	725, 636, 0, 644, 645, 0, 731, 739, 740, 697,
+	193, 206, 294, 753, 362, 259, 450, 434, 431, 623,
+	639, 238, 650, 0, 0, 663, 670, 671, 683, 685,
+	686, 687, 688, 696, 704, 705, 707, 715, 717, 720,
+	722, 727, 736, 756, 195, 196, 207, 215, 224, 237,
+	250, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	338, 340, 341, 344, 350, 351, 352, 353, 354, 356,
+	363, 367, 375, 376, 377, 378, 379, 380, 381, 387,
+	388, 389, 390, 398, 402, 416, 417, 427, 438, 442,
+	230, 383, 719, 268, 424, 443, 0, 302, 695, 702,
+	304, 254, 270, 279, 710, 433, 399, 210, 369, 260,
+	200, 227, 214, 235, 249, 251, 283, 312, 318, 346,
+	349, 265, 246, 225, 366, 223, 384, 405, 406, 407,
+	409, 316, 242, 743, 730, 0, 0, 678, 746, 649,
+	667, 755, 669, 672, 712, 629, 691, 334, 664, 0,
+	653, 625, 660, 626, 651, 680, 245, 684, 648, 732,
+	694, 745, 292, 0, 631, 654, 347, 714, 386, 231,
+	301, 299, 413, 255, 248, 244, 229, 276, 307, 345,
+	404, 339, 752, 296, 701, 0, 395, 319, 0, 0,
+	0, 682, 735, 689, 726, 677, 713, 638, 700, 747,
+	665, 709, 748, 282, 228, 198, 331, 396, 258, 385,
+	0, 71, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 0, 226, 706, 742,
+	662, 708, 241, 280, 247, 240, 411, 711, 758, 624,
+	703, 0, 627, 630, 754, 738, 657, 658, 0, 0,
+	0, 0, 0, 0, 0, 681, 690, 723, 675, 0,
+	0, 0, 0, 0, 0, 0, 0, 655, 0, 699,
+	0, 0, 0, 634, 628, 0, 0, 0, 0, 679,
+	0, 0, 0, 637, 0, 656, 724, 0, 622, 266,
+	632, 320, 728, 737, 676, 439, 741, 674, 673, 744,
+	718, 635, 734, 668, 291, 633, 288, 194, 208, 0,
+	666, 330, 368, 374, 733, 652, 661, 232, 659, 372,
+	343, 426, 216, 256, 365, 348, 370, 698, 716, 371,
+	297, 415, 360, 425, 440, 441, 239, 324, 432, 408,
+	437, 449, 209, 236, 337, 401, 429, 392, 317, 412,
+	287, 391, 264, 197, 295, 201, 403, 423, 221, 382,
+	0, 0, 0, 203, 421, 400, 314, 284, 285, 202,
+	0, 364, 243, 262, 234, 333, 418, 419, 233, 451,
+	211, 436, 205, 212, 435, 326, 414, 422, 315, 306,
+	204, 420, 313, 305, 290, 253, 272, 358, 300, 359,
+	273, 322, 321, 323, 0, 199, 0, 397, 430, 452,
+	218, 647, 729, 410, 445, 448, 0, 361, 219, 263,
+	252, 357, 261, 293, 444, 446, 447, 217, 355, 269,
+	325, 213, 275, 393, 289, 298, 721, 757, 342, 373,
+	222, 428, 394, 642, 646, 640, 641, 692, 693, 643,
+	749, 750, 751, 725, 636, 0, 644, 645, 0, 731,
+	739, 740, 697, 193, 206, 294, 753, 362, 259, 450,
+	434, 431, 623, 639, 238, 650, 0, 0, 663, 670,
+	671, 683, 685, 686, 687, 688, 696, 704, 705, 707,
+	715, 717, 720, 722, 727, 736, 756, 195, 196, 207,
+	215, 224, 237, 250, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 338, 340, 341, 344, 350, 351, 352,
+	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
+	380, 381, 387, 388, 389, 390, 398, 402, 416, 417,
+	427, 438, 442, 230, 383, 719, 268, 424, 443, 0,
+	302, 695, 702, 304, 254, 270, 279, 710, 433, 399,
+	210, 369, 260, 200, 227, 214, 235, 249, 251, 283,
+	312, 318, 346, 349, 265, 246, 225, 366, 223, 384,
+	405, 406, 407, 409, 316, 242, 743, 730, 0, 0,
+	678, 746, 649, 667, 755, 669, 672, 712, 629, 691,
+	334, 664, 0, 653, 625, 660, 626, 651, 680, 245,
+	684, 648, 732, 694, 745, 292, 0, 631, 654, 347,
+	714, 386, 231, 301, 299, 413, 255, 248, 244, 229,
+	276, 307, 345, 404, 339, 752, 296, 701, 0, 395,
+	319, 0, 0, 0, 682, 735, 689, 726, 677, 713,
+	638, 700, 747, 665, 709, 748, 282, 228, 198, 331,
+	396, 258, 385, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 706, 742, 662, 708, 241, 280, 247, 240, 411,
+	711, 758, 624, 703, 0, 627, 630, 754, 738, 657,
+	658, 0, 0, 0, 0, 0, 0, 0, 681, 690,
+	723, 675, 0, 0, 0, 0, 0, 0, 0, 0,
+	655, 0, 699, 0, 0, 0, 634, 628, 0, 0,
+	0, 0, 679, 0, 0, 0, 637, 0, 656, 724,
+	0, 622, 266, 632, 320, 728, 737, 676, 439, 741,
+	674, 673, 744, 718, 635, 734, 668, 291, 633, 288,
+	194, 208, 0, 666, 330, 368, 374, 733, 652, 661,
+	232, 659, 372, 343, 426, 216, 256, 365, 348, 370,
+	698, 716, 371, 297, 415, 360, 425, 440, 441, 239,
+	324, 432, 408, 437, 449, 209, 236, 337, 401, 429,
+	392, 317, 412, 287, 391, 264, 197, 295, 201, 403,
+	423, 221, 382, 0, 0, 0, 203, 421, 400, 314,
+	284, 285, 202, 0, 364, 243, 262, 234, 333, 418,
+	419, 233, 451, 211, 436, 205, 212, 435, 326, 414,
+	422, 315, 306, 204, 420, 313, 305, 290, 253, 272,
+	358, 300, 359, 273, 322, 321, 323, 0, 199, 0,
+	397, 430, 452, 218, 647, 729, 410, 445, 448, 0,
+	361, 219, 263, 252, 357, 261, 293, 444, 446, 447,
+	217, 355, 269, 325, 213, 275, 393, 289, 298, 721,
+	757, 342, 373, 222, 428, 394, 642, 646, 640, 641,
+	692, 693, 643, 749, 750, 751, 725, 636, 0, 644,
+	645, 0, 731, 739, 740, 697, 193, 206, 294, 753,
+	362, 259, 450, 434, 431, 623, 639, 238, 650, 0,
+	0, 663, 670, 671, 683, 685, 686, 687, 688, 696,
+	704, 705, 707, 715, 717, 720, 722, 727, 736, 756,
+	195, 196, 207, 215, 224, 237, 250, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 338, 340, 341, 344,
+	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
+	377, 378, 379, 380, 381, 387, 388, 389, 390, 398,
+	402, 416, 417, 427, 438, 442, 230, 383, 719, 268,
+	424, 443, 0, 302, 695, 702, 304, 254, 270, 279,
+	710, 433, 399, 210, 369, 260, 200, 227, 214, 235,
+	249, 251, 283, 312, 318, 346, 349, 265, 246, 225,
+	366, 223, 384, 405, 406, 407, 409, 316, 242, 743,
+	730, 0, 0, 678, 746, 649, 667, 755, 669, 672,
+	712, 629, 691, 334, 664, 0, 653, 625, 660, 626,
+	651, 680, 245, 684, 648, 732, 694, 745, 292, 0,
+	631, 654, 347, 714, 386, 231, 301, 299, 413, 255,
+	248, 244, 229, 276, 307, 345, 404, 339, 752, 296,
+	701, 0, 395, 319, 0, 0, 0, 682, 735, 689,
+	726, 677, 713, 638, 700, 747, 665, 709, 748, 282,
+	228, 198, 331, 396, 258, 385, 0, 0, 0, 0,
+	180, 181, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 706, 742, 662, 708, 241, 280,
+	247, 240, 411, 711, 758, 624, 703, 0, 627, 630,
+	754, 738, 657, 658, 0, 0, 0, 0, 0, 0,
+	0, 681, 690, 723, 675, 0, 0, 0, 0, 0,
+	0, 0, 0, 655, 0, 699, 0, 0, 0, 634,
+	628, 0, 0, 0, 0, 679, 0, 0, 0, 637,
+	0, 656, 724, 0, 622, 266, 632, 320, 728, 737,
+	676, 439, 741, 674, 673, 744, 718, 635, 734, 668,
+	291, 633, 288, 194, 208, 0, 666, 330, 368, 374,
+	733, 652, 661, 232, 659, 372, 343, 426, 216, 256,
+	365, 348, 370, 698, 716, 371, 297, 415, 360, 425,
+	440, 441, 239, 324, 432, 408, 437, 449, 209, 236,
+	337, 401, 429, 392, 317, 412, 287, 391, 264, 197,
+	295, 201, 403, 423, 221, 382, 0, 0, 0, 203,
+	421, 400, 314, 284, 285, 202, 0, 364, 243, 262,
+	234, 333, 418, 419, 233, 451, 211, 436, 205, 760,
+	435, 326, 414, 422, 315, 306, 204, 420, 313, 305,
+	290, 253, 272, 358, 300, 359, 273, 322, 321, 323,
+	0, 199, 0, 397, 430, 452, 218, 647, 729, 410,
+	445, 448, 0, 361, 219, 263, 252, 357, 261, 293,
+	444, 446, 447, 217, 355, 269, 621, 759, 615, 614,
+	289, 298, 721, 757, 342, 373, 222, 428, 394, 642,
+	646, 640, 641, 692, 693, 643, 749, 750, 751, 725,
+	636, 0, 644, 645, 0, 731, 739, 740, 697, 193,
+	206, 294, 753, 362, 259, 450, 434, 431, 623, 639,
+	238, 650, 0, 0, 663, 670, 671, 683, 685, 686,
+	687, 688, 696, 704, 705, 707, 715, 717, 720, 722,
+	727, 736, 756, 195, 196, 207, 215, 224, 237, 250,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 338,
+	340, 341, 344, 350, 351, 352, 353, 354, 356, 363,
+	367, 375, 376, 377, 378, 379, 380, 381, 387, 388,
+	389, 390, 398, 402, 416, 417, 427, 438, 442, 230,
+	383, 719, 268, 424, 443, 0, 302, 695, 702, 304,
+	254, 270, 279, 710, 433, 399, 210, 369, 260, 200,
+	227, 214, 235, 249, 251, 283, 312, 318, 346, 349,
+	265, 246, 225, 366, 223, 384, 405, 406, 407, 409,
+	316, 242, 743, 730, 0, 0, 678, 746, 649, 667,
+	755, 669, 672, 712, 629, 691, 334, 664, 0, 653,
+	625, 660, 626, 651, 680, 245, 684, 648, 732, 694,
+	745, 292, 0, 631, 654, 347, 714, 386, 231, 301,
+	299, 413, 255, 248, 244, 229, 276, 307, 345, 404,
+	339, 752, 296, 701, 0, 395, 319, 0, 0, 0,
+	682, 735, 689, 726, 677, 713, 638, 700, 747, 665,
+	709, 748, 282, 228, 198, 331, 396, 258, 385, 0,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 220, 0, 226, 706, 742, 662,
+	708, 241, 280, 247, 240, 411, 711, 758, 624, 703,
+	0, 627, 630, 754, 738, 657, 658, 0, 0, 0,
+	0, 0, 0, 0, 681, 690, 723, 675, 0, 0,
+	0, 0, 0, 0, 0, 0, 655, 0, 699, 0,
+	0, 0, 634, 628, 0, 0, 0, 0, 679, 0,
+	0, 0, 637, 0, 656, 724, 0, 622, 266, 632,
+	320, 728, 737, 676, 439, 741, 674, 673, 744, 718,
+	635, 734, 668, 291, 633, 288, 194, 208, 0, 666,
+	330, 368, 374, 733, 652, 661, 232, 659, 372, 343,
+	426, 216, 256, 365, 348, 370, 698, 716, 371, 297,
+	415, 360, 425, 440, 441, 239, 324, 432, 408, 437,
+	449, 209, 236, 337, 401, 429, 392, 317, 412, 287,
+	391, 264, 197, 295, 201, 403, 1098, 221, 382, 0,
+	0, 0, 203, 421, 400, 314, 284, 285, 202, 0,
+	364, 243, 262, 234, 333, 418, 419, 233, 451, 211,
+	436, 205, 760, 435, 326, 414, 422, 315, 306, 204,
+	420, 313, 305, 290, 253, 272, 358, 300, 359, 273,
+	322, 321, 323, 0, 199, 0, 397, 430, 452, 218,
+	647, 729, 410, 445, 448, 0, 361, 219, 263, 252,
+	357, 261, 293, 444, 446, 447, 217, 355, 269, 621,
+	759, 615, 614, 289, 298, 721, 757, 342, 373, 222,
+	428, 394, 642, 646, 640, 641, 692, 693, 643, 749,
+	750, 751, 725, 636, 0, 644, 645, 0, 731, 739,
+	740, 697, 193, 206, 294, 753, 362, 259, 450, 434,
+	431, 623, 639, 238, 650, 0, 0, 663, 670, 671,
+	683, 685, 686, 687, 688, 696, 704, 705, 707, 715,
+	717, 720, 722, 727, 736, 756, 195, 196, 207, 215,
+	224, 237, 250, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 338, 340, 341, 344, 350, 351, 352, 353,
+	354, 356, 363, 367, 375, 376, 377, 378, 379, 380,
+	381, 387, 388, 389, 390, 398, 402, 416, 417, 427,
+	438, 442, 230, 383, 719, 268, 424, 443, 0, 302,
+	695, 702, 304, 254, 270, 279, 710, 433, 399, 210,
+	369, 260, 200, 227, 214, 235, 249, 251, 283, 312,
+	318, 346, 349, 265, 246, 225, 366, 223, 384, 405,
+	406, 407, 409, 316, 242, 743, 730, 0, 0, 678,
+	746, 649, 667, 755, 669, 672, 712, 629, 691, 334,
+	664, 0, 653, 625, 660, 626, 651, 680, 245, 684,
+	648, 732, 694, 745, 292, 0, 631, 654, 347, 714,
+	386, 231, 301, 299, 413, 255, 248, 244, 229, 276,
+	307, 345, 404, 339, 752, 296, 701, 0, 395, 319,
+	0, 0, 0, 682, 735, 689, 726, 677, 713, 638,
+	700, 747, 665, 709, 748, 282, 228, 198, 331, 396,
+	258, 385, 0, 0, 0, 0, 180, 181, 182, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	706, 742, 662, 708, 241, 280, 247, 240, 411, 711,
+	758, 624, 703, 0, 627, 630, 754, 738, 657, 658,
+	0, 0, 0, 0, 0, 0, 0, 681, 690, 723,
+	675, 0, 0, 0, 0, 0, 0, 0, 0, 655,
+	0, 699, 0, 0, 0, 634, 628, 0, 0, 0,
+	0, 679, 0, 0, 0, 637, 0, 656, 724, 0,
+	622, 266, 632, 320, 728, 737, 676, 439, 741, 674,
+	673, 744, 718, 635, 734, 668, 291, 633, 288, 194,
+	208, 0, 666, 330, 368, 374, 733, 652, 661, 232,
+	659, 372, 343, 426, 216, 256, 365, 348, 370, 698,
+	716, 371, 297, 415, 360, 425, 440, 441, 239, 324,
+	432, 408, 437, 449, 209, 236, 337, 401, 429, 392,
+	317, 412, 287, 391, 264, 197, 295, 201, 403, 612,
+	221, 382, 0, 0, 0, 203, 421, 400, 314, 284,
+	285, 202, 0, 364, 243, 262, 234, 333, 418, 419,
+	233, 451, 211, 436, 205, 760, 435, 326, 414, 422,
+	315, 306, 204, 420, 313, 305, 290, 253, 272, 358,
+	300, 359, 273, 322, 321, 323, 0, 199, 0, 397,
+	430, 452, 218, 647, 729, 410, 445, 448, 0, 361,
+	219, 263, 252, 357, 261, 293, 444, 446, 447, 217,
+	355, 269, 621, 759, 615, 614, 289, 298, 721, 757,
+	342, 373, 222, 428, 394, 642, 646, 640, 641, 692,
+	693, 643, 749, 750, 751, 725, 636, 0, 644, 645,
+	0, 731, 739, 740, 697, 193, 206, 294, 753, 362,
+	259, 450, 434, 431, 623, 639, 238, 650, 0, 0,
+	663, 670, 671, 683, 685, 686, 687, 688, 696, 704,
+	705, 707, 715, 717, 720, 722, 727, 736, 756, 195,
+	196, 207, 215, 224, 237, 250, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 338, 340, 341, 344, 350,
+	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
+	378, 379, 380, 381, 387, 388, 389, 390, 398, 402,
+	416, 417, 427, 438, 442, 230, 383, 719, 268, 424,
+	443, 0, 302, 695, 702, 304, 254, 270, 279, 710,
+	433, 399, 210, 369, 260, 200, 227, 214, 235, 249,
+	251, 283, 312, 318, 346, 349, 265, 246, 225, 366,
+	223, 384, 405, 406, 407, 409, 316, 242, 334, 0,
+	0, 1396, 0, 510, 0, 0, 0, 245, 0, 509,
+	0, 0, 0, 292, 0, 0, 1397, 347, 0, 386,
+	231, 301, 299, 413, 255, 248, 244, 229, 276, 307,
+	345, 404, 339, 553, 296, 0, 0, 395, 319, 0,
+	0, 0, 0, 0, 544, 545, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 228, 198, 331, 396, 258,
+	385, 0, 71, 0, 0, 180, 181, 182, 531, 530,
+	533, 534, 535, 536, 0, 0, 220, 532, 226, 537,
+	538, 539, 0, 241, 280, 247, 240, 411, 0, 0,
+	0, 507, 524, 0, 552, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 521, 522, 602, 0, 0, 0,
+	567, 0, 523, 0, 0, 516, 517, 519, 518, 520,
+	525, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 566, 0, 0, 439, 0, 0, 564,
+	0, 0, 0, 0, 0, 291, 0, 288, 194, 208,
+	0, 0, 330, 368, 374, 0, 0, 0, 232, 0,
+	372, 343, 426, 216, 256, 365, 348, 370, 0, 0,
+	371, 297, 415, 360, 425, 440, 441, 239, 324, 432,
+	408, 437, 449, 209, 236, 337, 401, 429, 392, 317,
+	412, 287, 391, 264, 197, 295, 201, 403, 423, 221,
+	382, 0, 0, 0, 203, 421, 400, 314, 284, 285,
+	202, 0, 364, 243, 262, 234, 333, 418, 419, 233,
+	451, 211, 436, 205, 212, 435, 326, 414, 422, 315,
+	306, 204, 420, 313, 305, 290, 253, 272, 358, 300,
+	359, 273, 322, 321, 323, 0, 199, 0, 397, 430,
+	452, 218, 0, 0, 410, 445, 448, 0, 361, 219,
+	263, 252, 357, 261, 293, 444, 446, 447, 217, 355,
+	269, 325, 213, 275, 393, 289, 298, 0, 0, 342,
+	373, 222, 428, 394, 554, 565, 560, 561, 558, 559,
+	0, 557, 556, 555, 568, 546, 547, 548, 549, 551,
+	0, 562, 563, 550, 193, 206, 294, 0, 362, 259,
+	450, 434, 431, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	207, 215, 224, 237, 250, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 338, 340, 341, 344, 350, 351,
+	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
+	379, 380, 381, 387, 388, 389, 390, 398, 402, 416,
+	417, 427, 438, 442, 230, 383, 0, 268, 424, 443,
+	0, 302, 0, 0, 304, 254, 270, 279, 0, 433,
+	399, 210, 369, 260, 200, 227, 214, 235, 249, 251,
+	283, 312, 318, 346, 349, 265, 246, 225, 366, 223,
+	384, 405, 406, 407, 409, 316, 242, 334, 0, 0,
+	0, 0, 510, 0, 0, 0, 245, 0, 509, 0,
+	0, 0, 292, 0, 0, 0, 347, 0, 386, 231,
+	301, 299, 413, 255, 248, 244, 229, 276, 307, 345,
+	404, 339, 553, 296, 0, 0, 395, 319, 0, 0,
+	0, 0, 0, 544, 545, 0, 0, 0, 0, 0,
+	0, 1514, 0, 282, 228, 198, 331, 396, 258, 385,
+	0, 71, 0, 0, 180, 181, 182, 531, 530, 533,
+	534, 535, 536, 0, 0, 220, 532, 226, 537, 538,
+	539, 1515, 241, 280, 247, 240, 411, 0, 0, 0,
+	507, 524, 0, 552, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 521, 522, 0, 0, 0, 0, 567,
+	0, 523, 0, 0, 516, 517, 519, 518, 520, 525,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 566, 0, 0, 439, 0, 0, 564, 0,
+	0, 0, 0, 0, 291, 0, 288, 194, 208, 0,
+	0, 330, 368, 374, 0, 0, 0, 232, 0, 372,
+	343, 426, 216, 256, 365, 348, 370, 0, 0, 371,
+	297, 415, 360, 425, 440, 441, 239, 324, 432, 408,
+	437, 449, 209, 236, 337, 401, 429, 392, 317, 412,
+	287, 391, 264, 197, 295, 201, 403, 423, 221, 382,
+	0, 0, 0, 203, 421, 400, 314, 284, 285, 202,
+	0, 364, 243, 262, 234, 333, 418, 419, 233, 451,
+	211, 436, 205, 212, 435, 326, 414, 422, 315, 306,
+	204, 420, 313, 305, 290, 253, 272, 358, 300, 359,
+	273, 322, 321, 323, 0, 199, 0, 397, 430, 452,
+	218, 0, 0, 410, 445, 448, 0, 361, 219, 263,
+	252, 357, 261, 293, 444, 446, 447, 217, 355, 269,
+	325, 213, 275, 393, 289, 298, 0, 0, 342, 373,
+	222, 428, 394, 554, 565, 560, 561, 558, 559, 0,
+	557, 556, 555, 568, 546, 547, 548, 549, 551, 0,
+	562, 563, 550, 193, 206, 294, 0, 362, 259, 450,
+	434, 431, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 207,
+	215, 224, 237, 250, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 338, 340, 341, 344, 350, 351, 352,
+	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
+	380, 381, 387, 388, 389, 390, 398, 402, 416, 417,
+	427, 438, 442, 230, 383, 0, 268, 424, 443, 0,
+	302, 0, 0, 304, 254, 270, 279, 0, 433, 399,
+	210, 369, 260, 200, 227, 214, 235, 249, 251, 283,
+	312, 318, 346, 349, 265, 246, 225, 366, 223, 384,
+	405, 406, 407, 409, 316, 242, 84, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 334,
+	0, 0, 0, 0, 510, 0, 0, 0, 245, 0,
+	509, 0, 0, 0, 292, 0, 0, 0, 347, 0,
+	386, 231, 301, 299, 413, 255, 248, 244, 229, 276,
+	307, 345, 404, 339, 553, 296, 0, 0, 395, 319,
+	0, 0, 0, 0, 0, 544, 545, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 198, 331, 396,
+	258, 385, 0, 71, 0, 0, 180, 181, 182, 531,
+	530, 533, 534, 535, 536, 0, 0, 220, 532, 226,
+	537, 538, 539, 0, 241, 280, 247, 240, 411, 0,
+	0, 0, 507, 524, 0, 552, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 521, 522, 0, 0, 0,
+	0, 567, 0, 523, 0, 0, 516, 517, 519, 518,
+	520, 525, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 566, 0, 0, 439, 0, 0,
+	564, 0, 0, 0, 0, 0, 291, 0, 288, 194,
+	208, 0, 0, 330, 368, 374, 0, 0, 0, 232,
+	0, 372, 343, 426, 216, 256, 365, 348, 370, 0,
+	0, 371, 297, 415, 360, 425, 440, 441, 239, 324,
+	432, 408, 437, 449, 209, 236, 337, 401, 429, 392,
+	317, 412, 287, 391, 264, 197, 295, 201, 403, 423,
+	221, 382, 0, 0, 0, 203, 421, 400, 314, 284,
+	285, 202, 0, 364, 243, 262, 234, 333, 418, 419,
+	233, 451, 211, 436, 205, 212, 435, 326, 414, 422,
+	315, 306, 204, 420, 313, 305, 290, 253, 272, 358,
+	300, 359, 273, 322, 321, 323, 0, 199, 0, 397,
+	430, 452, 218, 0, 0, 410, 445, 448, 0, 361,
+	219, 263, 252, 357, 261, 293, 444, 446, 447, 217,
+	355, 269, 325, 213, 275, 393, 289, 298, 0, 0,
+	342, 373, 222, 428, 394, 554, 565, 560, 561, 558,
+	559, 0, 557, 556, 555, 568, 546, 547, 548, 549,
+	551, 0, 562, 563, 550, 193, 206, 294, 70, 362,
+	259, 450, 434, 431, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
+	196, 207, 215, 224, 237, 250, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 338, 340, 341, 344, 350,
+	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
+	378, 379, 380, 381, 387, 388, 389, 390, 398, 402,
+	416, 417, 427, 438, 442, 230, 383, 0, 268, 424,
+	443, 0, 302, 0, 0, 304, 254, 270, 279, 0,
+	433, 399, 210, 369, 260, 200, 227, 214, 235, 249,
+	251, 283, 312, 318, 346, 349, 265, 246, 225, 366,
+	223, 384, 405, 406, 407, 409, 316, 242, 334, 0,
+	0, 0, 0, 510, 0, 0, 0, 245, 0, 509,
+	0, 0, 0, 292, 0, 0, 0, 347, 0, 386,
+	231, 301, 299, 413, 255, 248, 244, 229, 276, 307,
+	345, 404, 339, 553, 296, 0, 0, 395, 319, 0,
+	0, 0, 0, 0, 544, 545, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 228, 198, 331, 396, 258,
+	385, 0, 71, 0, 590, 180, 181, 182, 531, 530,
+	533, 534, 535, 536, 0, 0, 220, 532, 226, 537,
+	538, 539, 0, 241, 280, 247, 240, 411, 0, 0,
+	0, 507, 524, 0, 552, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 521, 522, 0, 0, 0, 0,
+	567, 0, 523, 0, 0, 516, 517, 519, 518, 520,
+	525, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 566, 0, 0, 439, 0, 0, 564,
+	0, 0, 0, 0, 0, 291, 0, 288, 194, 208,
+	0, 0, 330, 368, 374, 0, 0, 0, 232, 0,
+	372, 343, 426, 216, 256, 365, 348, 370, 0, 0,
+	371, 297, 415, 360, 425, 440, 441, 239, 324, 432,
+	408, 437, 449, 209, 236, 337, 401, 429, 392, 317,
+	412, 287, 391, 264, 197, 295, 201, 403, 423, 221,
+	382, 0, 0, 0, 203, 421, 400, 314, 284, 285,
+	202, 0, 364, 243, 262, 234, 333, 418, 419, 233,
+	451, 211, 436, 205, 212, 435, 326, 414, 422, 315,
+	306, 204, 420, 313, 305, 290, 253, 272, 358, 300,
+	359, 273, 322, 321, 323, 0, 199, 0, 397, 430,
+	452, 218, 0, 0, 410, 445, 448, 0, 361, 219,
+	263, 252, 357, 261, 293, 444, 446, 447, 217, 355,
+	269, 325, 213, 275, 393, 289, 298, 0, 0, 342,
+	373, 222, 428, 394, 554, 565, 560, 561, 558, 559,
+	0, 557, 556, 555, 568, 546, 547, 548, 549, 551,
+	0, 562, 563, 550, 193, 206, 294, 0, 362, 259,
+	450, 434, 431, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	207, 215, 224, 237, 250, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 338, 340, 341, 344, 350, 351,
+	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
+	379, 380, 381, 387, 388, 389, 390, 398, 402, 416,
+	417, 427, 438, 442, 230, 383, 0, 268, 424, 443,
+	0, 302, 0, 0, 304, 254, 270, 279, 0, 433,
+	399, 210, 369, 260, 200, 227, 214, 235, 249, 251,
+	283, 312, 318, 346, 349, 265, 246, 225, 366, 223,
+	384, 405, 406, 407, 409, 316, 242, 334, 0, 0,
+	0, 0, 510, 0, 0, 0, 245, 0, 509, 0,
+	0, 0, 292, 0, 0, 0, 347, 0, 386, 231,
+	301, 299, 413, 255, 248, 244, 229, 276, 307, 345,
+	404, 339, 553, 296, 0, 0, 395, 319, 0, 0,
+	0, 0, 0, 544, 545, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 198, 331, 396, 258, 385,
+	0, 71, 0, 0, 180, 181, 182, 531, 530, 533,
+	534, 535, 536, 0, 0, 220, 532, 226, 537, 538,
+	539, 0, 241, 280, 247, 240, 411, 0, 0, 0,
+	507, 524, 0, 552, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 521, 522, 602, 0, 0, 0, 567,
+	0, 523, 0, 0, 516, 517, 519, 518, 520, 525,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 566, 0, 0, 439, 0, 0, 564, 0,
+	0, 0, 0, 0, 291, 0, 288, 194, 208, 0,
+	0, 330, 368, 374, 0, 0, 0, 232, 0, 372,
+	343, 426, 216, 256, 365, 348, 370, 0, 0, 371,
+	297, 415, 360, 425, 440, 441, 239, 324, 432, 408,
+	437, 449, 209, 236, 337, 401, 429, 392, 317, 412,
+	287, 391, 264, 197, 295, 201, 403, 423, 221, 382,
+	0, 0, 0, 203, 421, 400, 314, 284, 285, 202,
+	0, 364, 243, 262, 234, 333, 418, 419, 233, 451,
+	211, 436, 205, 212, 435, 326, 414, 422, 315, 306,
+	204, 420, 313, 305, 290, 253, 272, 358, 300, 359,
+	273, 322, 321, 323, 0, 199, 0, 397, 430, 452,
+	218, 0, 0, 410, 445, 448, 0, 361, 219, 263,
+	252, 357, 261, 293, 444, 446, 447, 217, 355, 269,
+	325, 213, 275, 393, 289, 298, 0, 0, 342, 373,
+	222, 428, 394, 554, 565, 560, 561, 558, 559, 0,
+	557, 556, 555, 568, 546, 547, 548, 549, 551, 0,
+	562, 563, 550, 193, 206, 294, 0, 362, 259, 450,
+	434, 431, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 207,
+	215, 224, 237, 250, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 338, 340, 341, 344, 350, 351, 352,
+	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
+	380, 381, 387, 388, 389, 390, 398, 402, 416, 417,
+	427, 438, 442, 230, 383, 0, 268, 424, 443, 0,
+	302, 0, 0, 304, 254, 270, 279, 0, 433, 399,
+	210, 369, 260, 200, 227, 214, 235, 249, 251, 283,
+	312, 318, 346, 349, 265, 246, 225, 366, 223, 384,
+	405, 406, 407, 409, 316, 242, 334, 0, 0, 0,
+	0, 510, 0, 0, 0, 245, 0, 509, 0, 0,
+	0, 292, 0, 0, 0, 347, 0, 386, 231, 301,
+	299, 413, 255, 248, 244, 229, 276, 307, 345, 404,
+	339, 553, 296, 0, 0, 395, 319, 0, 0, 0,
+	0, 0, 544, 545, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 198, 331, 396, 258, 385, 0,
+	71, 0, 0, 180, 181, 182, 531, 1415, 533, 534,
+	535, 536, 0, 0, 220, 532, 226, 537, 538, 539,
+	0, 241, 280, 247, 240, 411, 0, 0, 0, 507,
+	524, 0, 552, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 521, 522, 602, 0, 0, 0, 567, 0,
+	523, 0, 0, 516, 517, 519, 518, 520, 525, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 0,
+	320, 566, 0, 0, 439, 0, 0, 564, 0, 0,
+	0, 0, 0, 291, 0, 288, 194, 208, 0, 0,
+	330, 368, 374, 0, 0, 0, 232, 0, 372, 343,
+	426, 216, 256, 365, 348, 370, 0, 0, 371, 297,
+	415, 360, 425, 440, 441, 239, 324, 432, 408, 437,
+	449, 209, 236, 337, 401, 429, 392, 317, 412, 287,
+	391, 264, 197, 295, 201, 403, 423, 221, 382, 0,
+	0, 0, 203, 421, 400, 314, 284, 285, 202, 0,
+	364, 243, 262, 234, 333, 418, 419, 233, 451, 211,
+	436, 205, 212, 435, 326, 414, 422, 315, 306, 204,
+	420, 313, 305, 290, 253, 272, 358, 300, 359, 273,
+	322, 321, 323, 0, 199, 0, 397, 430, 452, 218,
+	0, 0, 410, 445, 448, 0, 361, 219, 263, 252,
+	357, 261, 293, 444, 446, 447, 217, 355, 269, 325,
+	213, 275, 393, 289, 298, 0, 0, 342, 373, 222,
+	428, 394, 554, 565, 560, 561, 558, 559, 0, 557,
+	556, 555, 568, 546, 547, 548, 549, 551, 0, 562,
+	563, 550, 193, 206, 294, 0, 362, 259, 450, 434,
+	431, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 215,
+	224, 237, 250, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 338, 340, 341, 344, 350, 351, 352, 353,
+	354, 356, 363, 367, 375, 376, 377, 378, 379, 380,
+	381, 387, 388, 389, 390, 398, 402, 416, 417, 427,
+	438, 442, 230, 383, 0, 268, 424, 443, 0, 302,
+	0, 0, 304, 254, 270, 279, 0, 433, 399, 210,
+	369, 260, 200, 227, 214, 235, 249, 251, 283, 312,
+	318, 346, 349, 265, 246, 225, 366, 223, 384, 405,
+	406, 407, 409, 316, 242, 334, 0, 0, 0, 0,
+	510, 0, 0, 0, 245, 0, 509, 0, 0, 0,
+	292, 0, 0, 0, 347, 0, 386, 231, 301, 299,
+	413, 255, 248, 244, 229, 276, 307, 345, 404, 339,
+	553, 296, 0, 0, 395, 319, 0, 0, 0, 0,
+	0, 544, 545, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 198, 331, 396, 258, 385, 0, 71,
+	0, 0, 180, 181, 182, 531, 1412, 533, 534, 535,
+	536, 0, 0, 220, 532, 226, 537, 538, 539, 0,
+	241, 280, 247, 240, 411, 0, 0, 0, 507, 524,
+	0, 552, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 521, 522, 602, 0, 0, 0, 567, 0, 523,
+	0, 0, 516, 517, 519, 518, 520, 525, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 0, 320,
+	566, 0, 0, 439, 0, 0, 564, 0, 0, 0,
+	0, 0, 291, 0, 288, 194, 208, 0, 0, 330,
+	368, 374, 0, 0, 0, 232, 0, 372, 343, 426,
+	216, 256, 365, 348, 370, 0, 0, 371, 297, 415,
+	360, 425, 440, 441, 239, 324, 432, 408, 437, 449,
+	209, 236, 337, 401, 429, 392, 317, 412, 287, 391,
+	264, 197, 295, 201, 403, 423, 221, 382, 0, 0,
+	0, 203, 421, 400, 314, 284, 285, 202, 0, 364,
+	243, 262, 234, 333, 418, 419, 233, 451, 211, 436,
+	205, 212, 435, 326, 414, 422, 315, 306, 204, 420,
+	313, 305, 290, 253, 272, 358, 300, 359, 273, 322,
+	321, 323, 0, 199, 0, 397, 430, 452, 218, 0,
+	0, 410, 445, 448, 0, 361, 219, 263, 252, 357,
+	261, 293, 444, 446, 447, 217, 355, 269, 325, 213,
+	275, 393, 289, 298, 0, 0, 342, 373, 222, 428,
+	394, 554, 565, 560, 561, 558, 559, 0, 557, 556,
+	555, 568, 546, 547, 548, 549, 551, 0, 562, 563,
+	550, 193, 206, 294, 0, 362, 259, 450, 434, 431,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 195, 196, 207, 215, 224,
+	237, 250, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 338, 340, 341, 344, 350, 351, 352, 353, 354,
+	356, 363, 367, 375, 376, 377, 378, 379, 380, 381,
+	387, 388, 389, 390, 398, 402, 416, 417, 427, 438,
+	442, 230, 383, 0, 268, 424, 443, 0, 302, 0,
+	0, 304, 254, 270, 279, 0, 433, 399, 210, 369,
+	260, 200, 227, 214, 235, 249, 251, 283, 312, 318,
+	346, 349, 265, 246, 225, 366, 223, 384, 405, 406,
+	407, 409, 316, 242, 334, 0, 0, 0, 0, 510,
+	0, 0, 0, 245, 0, 509, 0, 0, 0, 292,
+	0, 0, 0, 347, 0, 386, 231, 301, 299, 413,
+	255, 248, 244, 229, 276, 307, 345, 404, 339, 553,
+	296, 0, 0, 395, 319, 0, 0, 0, 0, 0,
+	544, 545, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 228, 198, 331, 396, 258, 385, 0, 71, 0,
+	0, 180, 181, 182, 531, 530, 533, 534, 535, 536,
+	0, 0, 220, 532, 226, 537, 538, 539, 0, 241,
+	280, 247, 240, 411, 0, 0, 0, 507, 524, 0,
+	552, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	521, 522, 0, 0, 0, 0, 567, 0, 523, 0,
+	0, 516, 517, 519, 518, 520, 525, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 566,
+	0, 0, 439, 0, 0, 564, 0, 0, 0, 0,
+	0, 291, 0, 288, 194, 208, 0, 0, 330, 368,
+	374, 0, 0, 0, 232, 0, 372, 343, 426, 216,
+	256, 365, 348, 370, 0, 0, 371, 297, 415, 360,
+	425, 440, 441, 239, 324, 432, 408, 437, 449, 209,
+	236, 337, 401, 429, 392, 317, 412, 287, 391, 264,
+	197, 295, 201, 403, 423, 221, 382, 0, 0, 0,
+	203, 421, 400, 314, 284, 285, 202, 0, 364, 243,
+	262, 234, 333, 418, 419, 233, 451, 211, 436, 205,
+	212, 435, 326, 414, 422, 315, 306, 204, 420, 313,
+	305, 290, 253, 272, 358, 300, 359, 273, 322, 321,
+	323, 0, 199, 0, 397, 430, 452, 218, 0, 0,
+	410, 445, 448, 0, 361, 219, 263, 252, 357, 261,
+	293, 444, 446, 447, 217, 355, 269, 325, 213, 275,
+	393, 289, 298, 0, 0, 342, 373, 222, 428, 394,
+	554, 565, 560, 561, 558, 559, 0, 557, 556, 555,
+	568, 546, 547, 548, 549, 551, 0, 562, 563, 550,
+	193, 206, 294, 0, 362, 259, 450, 434, 431, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 207, 215, 224, 237,
+	250, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	338, 340, 341, 344, 350, 351, 352, 353, 354, 356,
+	363, 367, 375, 376, 377, 378, 379, 380, 381, 387,
+	388, 389, 390, 398, 402, 416, 417, 427, 438, 442,
+	230, 383, 0, 268, 424, 443, 0, 302, 0, 0,
+	304, 254, 270, 279, 0, 433, 399, 210, 369, 260,
+	200, 227, 214, 235, 249, 251, 283, 312, 318, 346,
+	349, 265, 246, 225, 366, 223, 384, 405, 406, 407,
+	409, 316, 242, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 347, 0, 386, 231, 301, 299, 413, 255,
+	248, 244, 229, 276, 307, 345, 404, 339, 553, 296,
+	0, 0, 395, 319, 0, 0, 0, 0, 0, 544,
+	545, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 198, 331, 396, 258, 385, 0, 71, 0, 0,
+	180, 181, 182, 531, 530, 533, 534, 535, 536, 0,
+	0, 220, 532, 226, 537, 538, 539, 0, 241, 280,
+	247, 240, 411, 0, 0, 0, 0, 524, 0, 552,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 521,
+	522, 0, 0, 0, 0, 567, 0, 523, 0, 0,
+	516, 517, 519, 518, 520, 525, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 566, 0,
+	0, 439, 0, 0, 564, 0, 0, 0, 0, 0,
+	291, 0, 288, 194, 208, 0, 0, 330, 368, 374,
+	0, 0, 0, 232, 0, 372, 343, 426, 216, 256,
+	365, 348, 370, 2198, 0, 371, 297, 415, 360, 425,
+	440, 441, 239, 324, 432, 408, 437, 449, 209, 236,
+	337, 401, 429, 392, 317, 412, 287, 391, 264, 197,
+	295, 201, 403, 423, 221, 382, 0, 0, 0, 203,
+	421, 400, 314, 284, 285, 202, 0, 364, 243, 262,
+	234, 333, 418, 419, 233, 451, 211, 436, 205, 212,
+	435, 326, 414, 422, 315, 306, 204, 420, 313, 305,
+	290, 253, 272, 358, 300, 359, 273, 322, 321, 323,
+	0, 199, 0, 397, 430, 452, 218, 0, 0, 410,
+	445, 448, 0, 361, 219, 263, 252, 357, 261, 293,
+	444, 446, 447, 217, 355, 269, 325, 213, 275, 393,
+	289, 298, 0, 0, 342, 373, 222, 428, 394, 554,
+	565, 560, 561, 558, 559, 0, 557, 556, 555, 568,
+	546, 547, 548, 549, 551, 0, 562, 563, 550, 193,
+	206, 294, 0, 362, 259, 450, 434, 431, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 207, 215, 224, 237, 250,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 338,
+	340, 341, 344, 350, 351, 352, 353, 354, 356, 363,
+	367, 375, 376, 377, 378, 379, 380, 381, 387, 388,
+	389, 390, 398, 402, 416, 417, 427, 438, 442, 230,
+	383, 0, 268, 424, 443, 0, 302, 0, 0, 304,
+	254, 270, 279, 0, 433, 399, 210, 369, 260, 200,
+	227, 214, 235, 249, 251, 283, 312, 318, 346, 349,
+	265, 246, 225, 366, 223, 384, 405, 406, 407, 409,
+	316, 242, 334, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 292, 0, 0,
+	0, 347, 0, 386, 231, 301, 299, 413, 255, 248,
+	244, 229, 276, 307, 345, 404, 339, 553, 296, 0,
+	0, 395, 319, 0, 0, 0, 0, 0, 544, 545,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	198, 331, 396, 258, 385, 0, 71, 0, 590, 180,
+	181, 182, 531, 530, 533, 534, 535, 536, 0, 0,
+	220, 532, 226, 537, 538, 539, 0, 241, 280, 247,
+	240, 411, 0, 0, 0, 0, 524, 0, 552, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 521, 522,
+	0, 0, 0, 0, 567, 0, 523, 0, 0, 516,
+	517, 519, 518, 520, 525, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 0, 320, 566, 0, 0,
+	439, 0, 0, 564, 0, 0, 0, 0, 0, 291,
+	0, 288, 194, 208, 0, 0, 330, 368, 374, 0,
+	0, 0, 232, 0, 372, 343, 426, 216, 256, 365,
+	348, 370, 0, 0, 371, 297, 415, 360, 425, 440,
+	441, 239, 324, 432, 408, 437, 449, 209, 236, 337,
+	401, 429, 392, 317, 412, 287, 391, 264, 197, 295,
+	201, 403, 423, 221, 382, 0, 0, 0, 203, 421,
+	400, 314, 284, 285, 202, 0, 364, 243, 262, 234,
+	333, 418, 419, 233, 451, 211, 436, 205, 212, 435,
+	326, 414, 422, 315, 306, 204, 420, 313, 305, 290,
+	253, 272, 358, 300, 359, 273, 322, 321, 323, 0,
+	199, 0, 397, 430, 452, 218, 0, 0, 410, 445,
+	448, 0, 361, 219, 263, 252, 357, 261, 293, 444,
+	446, 447, 217, 355, 269, 325, 213, 275, 393, 289,
+	298, 0, 0, 342, 373, 222, 428, 394, 554, 565,
+	560, 561, 558, 559, 0, 557, 556, 555, 568, 546,
+	547, 548, 549, 551, 0, 562, 563, 550, 193, 206,
+	294, 0, 362, 259, 450, 434, 431, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 195, 196, 207, 215, 224, 237, 250, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 338, 340,
+	341, 344, 350, 351, 352, 353, 354, 356, 363, 367,
+	375, 376, 377, 378, 379, 380, 381, 387, 388, 389,
+	390, 398, 402, 416, 417, 427, 438, 442, 230, 383,
+	0, 268, 424, 443, 0, 302, 0, 0, 304, 254,
+	270, 279, 0, 433, 399, 210, 369, 260, 200, 227,
+	214, 235, 249, 251, 283, 312, 318, 346, 349, 265,
+	246, 225, 366, 223, 384, 405, 406, 407, 409, 316,
+	242, 334, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	347, 0, 386, 231, 301, 299, 413, 255, 248, 244,
+	229, 276, 307, 345, 404, 339, 553, 296, 0, 0,
+	395, 319, 0, 0, 0, 0, 0, 544, 545, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 198,
+	331, 396, 258, 385, 0, 71, 0, 0, 180, 181,
+	182, 531, 530, 533, 534, 535, 536, 0, 0, 220,
+	532, 226, 537, 538, 539, 0, 241, 280, 247, 240,
+	411, 0, 0, 0, 0, 524, 0, 552, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 521, 522, 0,
+	0, 0, 0, 567, 0, 523, 0, 0, 516, 517,
+	519, 518, 520, 525, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 566, 0, 0, 439,
+	0, 0, 564, 0, 0, 0, 0, 0, 291, 0,
+	288, 194, 208, 0, 0, 330, 368, 374, 0, 0,
+	0, 232, 0, 372, 343, 426, 216, 256, 365, 348,
+	370, 0, 0, 371, 297, 415, 360, 425, 440, 441,
+	239, 324, 432, 408, 437, 449, 209, 236, 337, 401,
+	429, 392, 317, 412, 287, 391, 264, 197, 295, 201,
+	403, 423, 221, 382, 0, 0, 0, 203, 421, 400,
+	314, 284, 285, 202, 0, 364, 243, 262, 234, 333,
+	418, 419, 233, 451, 211, 436, 205, 212, 435, 326,
+	414, 422, 315, 306, 204, 420, 313, 305, 290, 253,
+	272, 358, 300, 359, 273, 322, 321, 323, 0, 199,
+	0, 397, 430, 452, 218, 0, 0, 410, 445, 448,
+	0, 361, 219, 263, 252, 357, 261, 293, 444, 446,
+	447, 217, 355, 269, 325, 213, 275, 393, 289, 298,
+	0, 0, 342, 373, 222, 428, 394, 554, 565, 560,
+	561, 558, 559, 0, 557, 556, 555, 568, 546, 547,
+	548, 549, 551, 0, 562, 563, 550, 193, 206, 294,
+	0, 362, 259, 450, 434, 431, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 196, 207, 215, 224, 237, 250, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 338, 340, 341,
+	344, 350, 351, 352, 353, 354, 356, 363, 367, 375,
+	376, 377, 378, 379, 380, 381, 387, 388, 389, 390,
+	398, 402, 416, 417, 427, 438, 442, 230, 383, 0,
+	268, 424, 443, 0, 302, 0, 0, 304, 254, 270,
+	279, 0, 433, 399, 210, 369, 260, 200, 227, 214,
+	235, 249, 251, 283, 312, 318, 346, 349, 265, 246,
+	225, 366, 223, 384, 405, 406, 407, 409, 316, 242,
+	334, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 292, 0, 0, 0, 347,
+	0, 386, 231, 301, 299, 413, 255, 248, 244, 229,
+	276, 307, 345, 404, 339, 0, 296, 0, 0, 395,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 228, 198, 331,
+	396, 258, 385, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 0, 0, 0, 0, 241, 280, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 970, 969, 979, 980, 972, 973, 974, 975,
+	976, 977, 978, 971, 0, 0, 981, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 0, 0, 0, 439, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	194, 208, 0, 0, 330, 368, 374, 0, 0, 0,
+	232, 0, 372, 343, 426, 216, 256, 365, 348, 370,
+	0, 0, 371, 297, 415, 360, 425, 440, 441, 239,
+	324, 432, 408, 437, 449, 209, 236, 337, 401, 429,
+	392, 317, 412, 287, 391, 264, 197, 295, 201, 403,
+	423, 221, 382, 0, 0, 0, 203, 421, 400, 314,
+	284, 285, 202, 0, 364, 243, 262, 234, 333, 418,
+	419, 233, 451, 211, 436, 205, 212, 435, 326, 414,
+	422, 315, 306, 204, 420, 313, 305, 290, 253, 272,
+	358, 300, 359, 273, 322, 321, 323, 0, 199, 0,
+	397, 430, 452, 218, 0, 0, 410, 445, 448, 0,
+	361, 219, 263, 252, 357, 261, 293, 444, 446, 447,
+	217, 355, 269, 325, 213, 275, 393, 289, 298, 0,
+	0, 342, 373, 222, 428, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 206, 294, 0,
+	362, 259, 450, 434, 431, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 207, 215, 224, 237, 250, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 338, 340, 341, 344,
+	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
+	377, 378, 379, 380, 381, 387, 388, 389, 390, 398,
+	402, 416, 417, 427, 438, 442, 230, 383, 0, 268,
+	424, 443, 0, 302, 0, 0, 304, 254, 270, 279,
+	0, 433, 399, 210, 369, 260, 200, 227, 214, 235,
+	249, 251, 283, 312, 318, 346, 349, 265, 246, 225,
+	366, 223, 384, 405, 406, 407, 409, 316, 242, 334,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 804,
+	0, 0, 0, 0, 292, 0, 0, 0, 347, 0,
+	386, 231, 301, 299, 413, 255, 248, 244, 229, 276,
+	307, 345, 404, 339, 0, 296, 0, 0, 395, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 198, 331, 396,
+	258, 385, 0, 0, 0, 0, 180, 181, 182, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 241, 280, 247, 240, 411, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 803, 439, 0, 0,
+	0, 0, 0, 0, 800, 801, 291, 768, 288, 194,
+	208, 794, 798, 330, 368, 374, 0, 0, 0, 232,
+	0, 372, 343, 426, 216, 256, 365, 348, 370, 0,
+	0, 371, 297, 415, 360, 425, 440, 441, 239, 324,
+	432, 408, 437, 449, 209, 236, 337, 401, 429, 392,
+	317, 412, 287, 391, 264, 197, 295, 201, 403, 423,
+	221, 382, 0, 0, 0, 203, 421, 400, 314, 284,
+	285, 202, 0, 364, 243, 262, 234, 333, 418, 419,
+	233, 451, 211, 436, 205, 212, 435, 326, 414, 422,
+	315, 306, 204, 420, 313, 305, 290, 253, 272, 358,
+	300, 359, 273, 322, 321, 323, 0, 199, 0, 397,
+	430, 452, 218, 0, 0, 410, 445, 448, 0, 361,
+	219, 263, 252, 357, 261, 293, 444, 446, 447, 217,
+	355, 269, 325, 213, 275, 393, 289, 298, 0, 0,
+	342, 373, 222, 428, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 193, 206, 294, 0, 362,
+	259, 450, 434, 431, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
+	196, 207, 215, 224, 237, 250, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 338, 340, 341, 344, 350,
+	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
+	378, 379, 380, 381, 387, 388, 389, 390, 398, 402,
+	416, 417, 427, 438, 442, 230, 383, 0, 268, 424,
+	443, 0, 302, 0, 0, 304, 254, 270, 279, 0,
+	433, 399, 210, 369, 260, 200, 227, 214, 235, 249,
+	251, 283, 312, 318, 346, 349, 265, 246, 225, 366,
+	223, 384, 405, 406, 407, 409, 316, 242, 334, 0,
+	0, 0, 1076, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 0, 292, 0, 0, 0, 347, 0, 386,
+	231, 301, 299, 413, 255, 248, 244, 229, 276, 307,
+	345, 404, 339, 0, 296, 0, 0, 395, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 228, 198, 331, 396, 258,
+	385, 0, 0, 0, 0, 180, 181, 182, 0, 1078,
+	0, 0, 0, 0, 0, 0, 220, 0, 226, 0,
+	0, 0, 0, 241, 280, 247, 240, 411, 959, 960,
+	958, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 961, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 0, 0, 0, 439, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 194, 208,
+	0, 0, 330, 368, 374, 0, 0, 0, 232, 0,
+	372, 343, 426, 216, 256, 365, 348, 370, 0, 0,
+	371, 297, 415, 360, 425, 440, 441, 239, 324, 432,
+	408, 437, 449, 209, 236, 337, 401, 429, 392, 317,
+	412, 287, 391, 264, 197, 295, 201, 403, 423, 221,
+	382, 0, 0, 0, 203, 421, 400, 314, 284, 285,
+	202, 0, 364, 243, 262, 234, 333, 418, 419, 233,
+	451, 211, 436, 205, 212, 435, 326, 414, 422, 315,
+	306, 204, 420, 313, 305, 290, 253, 272, 358, 300,
+	359, 273, 322, 321, 323, 0, 199, 0, 397, 430,
+	452, 218, 0, 0, 410, 445, 448, 0, 361, 219,
+	263, 252, 357, 261, 293, 444, 446, 447, 217, 355,
+	269, 325, 213, 275, 393, 289, 298, 0, 0, 342,
+	373, 222, 428, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 206, 294, 0, 362, 259,
+	450, 434, 431, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	207, 215, 224, 237, 250, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 338, 340, 341, 344, 350, 351,
+	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
+	379, 380, 381, 387, 388, 389, 390, 398, 402, 416,
+	417, 427, 438, 442, 230, 383, 0, 268, 424, 443,
+	0, 302, 0, 0, 304, 254, 270, 279, 0, 433,
+	399, 210, 369, 260, 200, 227, 214, 235, 249, 251,
+	283, 312, 318, 346, 349, 265, 246, 225, 366, 223,
+	384, 405, 406, 407, 409, 316, 242, 35, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	334, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 292, 0, 0, 0, 347,
+	0, 386, 231, 301, 299, 413, 255, 248, 244, 229,
+	276, 307, 345, 404, 339, 0, 296, 0, 0, 395,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 228, 198, 331,
+	396, 258, 385, 0, 71, 0, 590, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 0, 0, 0, 0, 241, 280, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 0, 0, 0, 439, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	194, 208, 0, 0, 330, 368, 374, 0, 0, 0,
+	232, 0, 372, 343, 426, 216, 256, 365, 348, 370,
+	0, 0, 371, 297, 415, 360, 425, 440, 441, 239,
+	324, 432, 408, 437, 449, 209, 236, 337, 401, 429,
+	392, 317, 412, 287, 391, 264, 197, 295, 201, 403,
+	423, 221, 382, 0, 0, 0, 203, 421, 400, 314,
+	284, 285, 202, 0, 364, 243, 262, 234, 333, 418,
+	419, 233, 451, 211, 436, 205, 212, 435, 326, 414,
+	422, 315, 306, 204, 420, 313, 305, 290, 253, 272,
+	358, 300, 359, 273, 322, 321, 323, 0, 199, 0,
+	397, 430, 452, 218, 0, 0, 410, 445, 448, 0,
+	361, 219, 263, 252, 357, 261, 293, 444, 446, 447,
+	217, 355, 269, 325, 213, 275, 393, 289, 298, 0,
+	0, 342, 373, 222, 428, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 206, 294, 70,
+	362, 259, 450, 434, 431, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 207, 215, 224, 237, 250, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 338, 340, 341, 344,
+	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
+	377, 378, 379, 380, 381, 387, 388, 389, 390, 398,
+	402, 416, 417, 427, 438, 442, 230, 383, 0, 268,
+	424, 443, 0, 302, 0, 0, 304, 254, 270, 279,
+	0, 433, 399, 210, 369, 260, 200, 227, 214, 235,
+	249, 251, 283, 312, 318, 346, 349, 265, 246, 225,
+	366, 223, 384, 405, 406, 407, 409, 316, 242, 35,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 334, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 292, 0, 0,
+	0, 347, 0, 386, 231, 301, 299, 413, 255, 248,
+	244, 229, 276, 307, 345, 404, 339, 0, 296, 0,
+	0, 395, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	198, 331, 396, 258, 385, 0, 71, 0, 0, 180,
+	181, 182, 0, 0, 0, 0, 0, 0, 0, 0,
+	220, 0, 226, 0, 0, 0, 0, 241, 280, 247,
+	240, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 0, 320, 0, 0, 0,
+	439, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 194, 208, 0, 0, 330, 368, 374, 0,
+	0, 0, 232, 0, 372, 343, 426, 216, 256, 365,
+	348, 370, 0, 0, 371, 297, 415, 360, 425, 440,
+	441, 239, 324, 432, 408, 437, 449, 209, 236, 337,
+	401, 429, 392, 317, 412, 287, 391, 264, 197, 295,
+	201, 403, 423, 221, 382, 0, 0, 0, 203, 421,
+	400, 314, 284, 285, 202, 0, 364, 243, 262, 234,
+	333, 418, 419, 233, 451, 211, 436, 205, 212, 435,
+	326, 414, 422, 315, 306, 204, 420, 313, 305, 290,
+	253, 272, 358, 300, 359, 273, 322, 321, 323, 0,
+	199, 0, 397, 430, 452, 218, 0, 0, 410, 445,
+	448, 0, 361, 219, 263, 252, 357, 261, 293, 444,
+	446, 447, 217, 355, 269, 325, 213, 275, 393, 289,
+	298, 0, 0, 342, 373, 222, 428, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 193, 206,
+	294, 70, 362, 259, 450, 434, 431, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 195, 196, 207, 215, 224, 237, 250, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 338, 340,
+	341, 344, 350, 351, 352, 353, 354, 356, 363, 367,
+	375, 376, 377, 378, 379, 380, 381, 387, 388, 389,
+	390, 398, 402, 416, 417, 427, 438, 442, 230, 383,
+	0, 268, 424, 443, 0, 302, 0, 0, 304, 254,
+	270, 279, 0, 433, 399, 210, 369, 260, 200, 227,
+	214, 235, 249, 251, 283, 312, 318, 346, 349, 265,
+	246, 225, 366, 223, 384, 405, 406, 407, 409, 316,
+	242, 334, 0, 0, 0, 1442, 0, 0, 0, 0,
+	245, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	347, 0, 386, 231, 301, 299, 413, 255, 248, 244,
+	229, 276, 307, 345, 404, 339, 0, 296, 0, 0,
+	395, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 198,
+	331, 396, 258, 385, 0, 0, 0, 0, 180, 181,
+	182, 0, 1444, 0, 0, 0, 0, 0, 0, 220,
+	0, 226, 0, 0, 0, 0, 241, 280, 247, 240,
+	411, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 0, 0, 0, 439,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 194, 208, 0, 0, 330, 368, 374, 0, 0,
+	0, 232, 0, 372, 343, 426, 216, 256, 365, 348,
+	370, 0, 1440, 371, 297, 415, 360, 425, 440, 441,
+	239, 324, 432, 408, 437, 449, 209, 236, 337, 401,
+	429, 392, 317, 412, 287, 391, 264, 197, 295, 201,
+	403, 423, 221, 382, 0, 0, 0, 203, 421, 400,
+	314, 284, 285, 202, 0, 364, 243, 262, 234, 333,
+	418, 419, 233, 451, 211, 436, 205, 212, 435, 326,
+	414, 422, 315, 306, 204, 420, 313, 305, 290, 253,
+	272, 358, 300, 359, 273, 322, 321, 323, 0, 199,
+	0, 397, 430, 452, 218, 0, 0, 410, 445, 448,
+	0, 361, 219, 263, 252, 357, 261, 293, 444, 446,
+	447, 217, 355, 269, 325, 213, 275, 393, 289, 298,
+	0, 0, 342, 373, 222, 428, 394, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 193, 206, 294,
+	0, 362, 259, 450, 434, 431, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 196, 207, 215, 224, 237, 250, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 338, 340, 341,
+	344, 350, 351, 352, 353, 354, 356, 363, 367, 375,
+	376, 377, 378, 379, 380, 381, 387, 388, 389, 390,
+	398, 402, 416, 417, 427, 438, 442, 230, 383, 0,
+	268, 424, 443, 0, 302, 0, 0, 304, 254, 270,
+	279, 0, 433, 399, 210, 369, 260, 200, 227, 214,
+	235, 249, 251, 283, 312, 318, 346, 349, 265, 246,
+	225, 366, 223, 384, 405, 406, 407, 409, 316, 242,
+	334, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 292, 0, 0, 0, 347,
+	0, 386, 231, 301, 299, 413, 255, 248, 244, 229,
+	276, 307, 345, 404, 339, 0, 296, 0, 0, 395,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 228, 198, 331,
+	396, 258, 385, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 0, 0, 0, 0, 241, 280, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 762,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 0, 0, 0, 439, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 768, 288,
+	194, 208, 766, 0, 330, 368, 374, 0, 0, 0,
+	232, 0, 372, 343, 426, 216, 256, 365, 348, 370,
+	0, 0, 371, 297, 415, 360, 425, 440, 441, 239,
+	324, 432, 408, 437, 449, 209, 236, 337, 401, 429,
+	392, 317, 412, 287, 391, 264, 197, 295, 201, 403,
+	423, 221, 382, 0, 0, 0, 203, 421, 400, 314,
+	284, 285, 202, 0, 364, 243, 262, 234, 333, 418,
+	419, 233, 451, 211, 436, 205, 212, 435, 326, 414,
+	422, 315, 306, 204, 420, 313, 305, 290, 253, 272,
+	358, 300, 359, 273, 322, 321, 323, 0, 199, 0,
+	397, 430, 452, 218, 0, 0, 410, 445, 448, 0,
+	361, 219, 263, 252, 357, 261, 293, 444, 446, 447,
+	217, 355, 269, 325, 213, 275, 393, 289, 298, 0,
+	0, 342, 373, 222, 428, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 206, 294, 0,
+	362, 259, 450, 434, 431, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 207, 215, 224, 237, 250, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 338, 340, 341, 344,
+	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
+	377, 378, 379, 380, 381, 387, 388, 389, 390, 398,
+	402, 416, 417, 427, 438, 442, 230, 383, 0, 268,
+	424, 443, 0, 302, 0, 0, 304, 254, 270, 279,
+	0, 433, 399, 210, 369, 260, 200, 227, 214, 235,
+	249, 251, 283, 312, 318, 346, 349, 265, 246, 225,
+	366, 223, 384, 405, 406, 407, 409, 316, 242, 334,
+	0, 0, 0, 1442, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 292, 0, 0, 0, 347, 0,
+	386, 231, 301, 299, 413, 255, 248, 244, 229, 276,
+	307, 345, 404, 339, 0, 296, 0, 0, 395, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 198, 331, 396,
+	258, 385, 0, 0, 0, 0, 180, 181, 182, 0,
+	1444, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 241, 280, 247, 240, 411, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 439, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 194,
+	208, 0, 0, 330, 368, 374, 0, 0, 0, 232,
+	0, 372, 343, 426, 216, 256, 365, 348, 370, 0,
+	0, 371, 297, 415, 360, 425, 440, 441, 239, 324,
+	432, 408, 437, 449, 209, 236, 337, 401, 429, 392,
+	317, 412, 287, 391, 264, 197, 295, 201, 403, 423,
+	221, 382, 0, 0, 0, 203, 421, 400, 314, 284,
+	285, 202, 0, 364, 243, 262, 234, 333, 418, 419,
+	233, 451, 211, 436, 205, 212, 435, 326, 414, 422,
+	315, 306, 204, 420, 313, 305, 290, 253, 272, 358,
+	300, 359, 273, 322, 321, 323, 0, 199, 0, 397,
+	430, 452, 218, 0, 0, 410, 445, 448, 0, 361,
+	219, 263, 252, 357, 261, 293, 444, 446, 447, 217,
+	355, 269, 325, 213, 275, 393, 289, 298, 0, 0,
+	342, 373, 222, 428, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 193, 206, 294, 0, 362,
+	259, 450, 434, 431, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
+	196, 207, 215, 224, 237, 250, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 338, 340, 341, 344, 350,
+	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
+	378, 379, 380, 381, 387, 388, 389, 390, 398, 402,
+	416, 417, 427, 438, 442, 230, 383, 0, 268, 424,
+	443, 0, 302, 0, 0, 304, 254, 270, 279, 0,
+	433, 399, 210, 369, 260, 200, 227, 214, 235, 249,
+	251, 283, 312, 318, 346, 349, 265, 246, 225, 366,
+	223, 384, 405, 406, 407, 409, 316, 242, 334, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 0, 292, 0, 0, 0, 347, 0, 386,
+	231, 301, 299, 413, 255, 248, 244, 229, 276, 307,
+	345, 404, 339, 0, 296, 0, 0, 395, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 228, 198, 331, 396, 258,
+	385, 0, 0, 0, 0, 180, 181, 182, 0, 0,
+	1467, 0, 0, 1468, 0, 0, 220, 0, 226, 0,
+	0, 0, 0, 241, 280, 247, 240, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 0, 0, 0, 439, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 194, 208,
+	0, 0, 330, 368, 374, 0, 0, 0, 232, 0,
+	372, 343, 426, 216, 256, 365, 348, 370, 0, 0,
+	371, 297, 415, 360, 425, 440, 441, 239, 324, 432,
+	408, 437, 449, 209, 236, 337, 401, 429, 392, 317,
+	412, 287, 391, 264, 197, 295, 201, 403, 423, 221,
+	382, 0, 0, 0, 203, 421, 400, 314, 284, 285,
+	202, 0, 364, 243, 262, 234, 333, 418, 419, 233,
+	451, 211, 436, 205, 212, 435, 326, 414, 422, 315,
+	306, 204, 420, 313, 305, 290, 253, 272, 358, 300,
+	359, 273, 322, 321, 323, 0, 199, 0, 397, 430,
+	452, 218, 0, 0, 410, 445, 448, 0, 361, 219,
+	263, 252, 357, 261, 293, 444, 446, 447, 217, 355,
+	269, 325, 213, 275, 393, 289, 298, 0, 0, 342,
+	373, 222, 428, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 206, 294, 0, 362, 259,
+	450, 434, 431, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	207, 215, 224, 237, 250, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 338, 340, 341, 344, 350, 351,
+	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
+	379, 380, 381, 387, 388, 389, 390, 398, 402, 416,
+	417, 427, 438, 442, 230, 383, 0, 268, 424, 443,
+	0, 302, 0, 0, 304, 254, 270, 279, 0, 433,
+	399, 210, 369, 260, 200, 227, 214, 235, 249, 251,
+	283, 312, 318, 346, 349, 265, 246, 225, 366, 223,
+	384, 405, 406, 407, 409, 316, 242, 334, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 1109, 0,
+	0, 0, 292, 0, 0, 0, 347, 0, 386, 231,
+	301, 299, 413, 255, 248, 244, 229, 276, 307, 345,
+	404, 339, 0, 296, 0, 0, 395, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 198, 331, 396, 258, 385,
+	0, 0, 0, 0, 180, 181, 182, 0, 1108, 0,
+	0, 0, 0, 0, 0, 220, 0, 226, 0, 0,
+	0, 0, 241, 280, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 0, 0, 0, 439, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 194, 208, 0,
+	0, 330, 368, 374, 0, 0, 0, 232, 0, 372,
+	343, 426, 216, 256, 365, 348, 370, 0, 0, 371,
+	297, 415, 360, 425, 440, 441, 239, 324, 432, 408,
+	437, 449, 209, 236, 337, 401, 429, 392, 317, 412,
+	287, 391, 264, 197, 295, 201, 403, 423, 221, 382,
+	0, 0, 0, 203, 421, 400, 314, 284, 285, 202,
+	0, 364, 243, 262, 234, 333, 418, 419, 233, 451,
+	211, 436, 205, 212, 435, 326, 414, 422, 315, 306,
+	204, 420, 313, 305, 290, 253, 272, 358, 300, 359,
+	273, 322, 321, 323, 0, 199, 0, 397, 430, 452,
+	218, 0, 0, 410, 445, 448, 0, 361, 219, 263,
+	252, 357, 261, 293, 444, 446, 447, 217, 355, 269,
+	325, 213, 275, 393, 289, 298, 0, 0, 342, 373,
+	222, 428, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 193, 206, 294, 0, 362, 259, 450,
+	434, 431, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 207,
+	215, 224, 237, 250, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 338, 340, 341, 344, 350, 351, 352,
+	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
+	380, 381, 387, 388, 389, 390, 398, 402, 416, 417,
+	427, 438, 442, 230, 383, 0, 268, 424, 443, 0,
+	302, 0, 0, 304, 254, 270, 279, 0, 433, 399,
+	210, 369, 260, 200, 227, 214, 235, 249, 251, 283,
+	312, 318, 346, 349, 265, 246, 225, 366, 223, 384,
+	405, 406, 407, 409, 316, 242, 334, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 292, 0, 0, 0, 347, 0, 386, 231, 301,
+	299, 413, 255, 248, 244, 229, 276, 307, 345, 404,
+	339, 0, 296, 0, 0, 395, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 198, 331, 396, 258, 385, 0,
+	0, 0, 590, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 220, 0, 226, 0, 0, 0,
+	0, 241, 280, 247, 240, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 0,
+	320, 0, 0, 0, 439, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 288, 194, 208, 0, 0,
+	330, 368, 374, 0, 0, 0, 232, 0, 372, 343,
+	426, 216, 256, 365, 348, 370, 0, 0, 371, 297,
+	415, 360, 425, 440, 441, 239, 324, 432, 408, 437,
+	449, 209, 236, 337, 401, 429, 392, 317, 412, 287,
+	391, 264, 197, 295, 201, 403, 423, 221, 382, 0,
+	0, 0, 203, 421, 400, 314, 284, 285, 202, 0,
+	364, 243, 262, 234, 333, 418, 419, 233, 451, 211,
+	436, 205, 212, 435, 326, 414, 422, 315, 306, 204,
+	420, 313, 305, 290, 253, 272, 358, 300, 359, 273,
+	322, 321, 323, 0, 199, 0, 397, 430, 452, 218,
+	0, 0, 410, 445, 448, 0, 361, 219, 263, 252,
+	357, 261, 293, 444, 446, 447, 217, 355, 269, 325,
+	213, 275, 393, 289, 298, 0, 0, 342, 373, 222,
+	428, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 294, 0, 362, 259, 450, 434,
+	431, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 215,
+	224, 237, 250, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 338, 340, 341, 344, 350, 351, 352, 353,
+	354, 356, 363, 367, 375, 376, 377, 378, 379, 380,
+	381, 387, 388, 389, 390, 398, 402, 416, 417, 427,
+	438, 442, 230, 383, 0, 268, 424, 443, 0, 302,
+	0, 0, 304, 254, 270, 279, 0, 433, 399, 210,
+	369, 260, 200, 227, 214, 235, 249, 251, 283, 312,
+	318, 346, 349, 265, 246, 225, 366, 223, 384, 405,
+	406, 407, 409, 316, 242, 334, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	292, 0, 0, 0, 347, 0, 386, 231, 301, 299,
+	413, 255, 248, 244, 229, 276, 307, 345, 404, 339,
+	0, 296, 0, 0, 395, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 198, 331, 396, 258, 385, 0, 71,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 0, 226, 0, 0, 0, 0,
+	241, 280, 247, 240, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 0, 320,
+	0, 0, 0, 439, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 194, 208, 0, 0, 330,
+	368, 374, 0, 0, 0, 232, 0, 372, 343, 426,
+	216, 256, 365, 348, 370, 0, 0, 371, 297, 415,
+	360, 425, 440, 441, 239, 324, 432, 408, 437, 449,
+	209, 236, 337, 401, 429, 392, 317, 412, 287, 391,
+	264, 197, 295, 201, 403, 423, 221, 382, 0, 0,
+	0, 203, 421, 400, 314, 284, 285, 202, 0, 364,
+	243, 262, 234, 333, 418, 419, 233, 451, 211, 436,
+	205, 212, 435, 326, 414, 422, 315, 306, 204, 420,
+	313, 305, 290, 253, 272, 358, 300, 359, 273, 322,
+	321, 323, 0, 199, 0, 397, 430, 452, 218, 0,
+	0, 410, 445, 448, 0, 361, 219, 263, 252, 357,
+	261, 293, 444, 446, 447, 217, 355, 269, 325, 213,
+	275, 393, 289, 298, 0, 0, 342, 373, 222, 428,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 193, 206, 294, 0, 362, 259, 450, 434, 431,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 195, 196, 207, 215, 224,
+	237, 250, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 338, 340, 341, 344, 350, 351, 352, 353, 354,
+	356, 363, 367, 375, 376, 377, 378, 379, 380, 381,
+	387, 388, 389, 390, 398, 402, 416, 417, 427, 438,
+	442, 230, 383, 0, 268, 424, 443, 0, 302, 0,
+	0, 304, 254, 270, 279, 0, 433, 399, 210, 369,
+	260, 200, 227, 214, 235, 249, 251, 283, 312, 318,
+	346, 349, 265, 246, 225, 366, 223, 384, 405, 406,
+	407, 409, 316, 242, 334, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 0, 292,
+	0, 0, 0, 347, 0, 386, 231, 301, 299, 413,
+	255, 248, 244, 229, 276, 307, 345, 404, 339, 0,
+	296, 0, 0, 395, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 228, 198, 331, 396, 258, 385, 0, 0, 0,
+	0, 180, 181, 182, 0, 1444, 0, 0, 0, 0,
+	0, 0, 220, 0, 226, 0, 0, 0, 0, 241,
+	280, 247, 240, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 0,
+	0, 0, 439, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 194, 208, 0, 0, 330, 368,
+	374, 0, 0, 0, 232, 0, 372, 343, 426, 216,
+	256, 365, 348, 370, 0, 0, 371, 297, 415, 360,
+	425, 440, 441, 239, 324, 432, 408, 437, 449, 209,
+	236, 337, 401, 429, 392, 317, 412, 287, 391, 264,
+	197, 295, 201, 403, 423, 221, 382, 0, 0, 0,
+	203, 421, 400, 314, 284, 285, 202, 0, 364, 243,
+	262, 234, 333, 418, 419, 233, 451, 211, 436, 205,
+	212, 435, 326, 414, 422, 315, 306, 204, 420, 313,
+	305, 290, 253, 272, 358, 300, 359, 273, 322, 321,
+	323, 0, 199, 0, 397, 430, 452, 218, 0, 0,
+	410, 445, 448, 0, 361, 219, 263, 252, 357, 261,
+	293, 444, 446, 447, 217, 355, 269, 325, 213, 275,
+	393, 289, 298, 0, 0, 342, 373, 222, 428, 394,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	193, 206, 294, 0, 362, 259, 450, 434, 431, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 207, 215, 224, 237,
+	250, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	338, 340, 341, 344, 350, 351, 352, 353, 354, 356,
+	363, 367, 375, 376, 377, 378, 379, 380, 381, 387,
+	388, 389, 390, 398, 402, 416, 417, 427, 438, 442,
+	230, 383, 0, 268, 424, 443, 0, 302, 0, 0,
+	304, 254, 270, 279, 0, 433, 399, 210, 369, 260,
+	200, 227, 214, 235, 249, 251, 283, 312, 318, 346,
+	349, 265, 246, 225, 366, 223, 384, 405, 406, 407,
+	409, 316, 242, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 347, 0, 386, 231, 301, 299, 413, 255,
+	248, 244, 229, 276, 307, 345, 404, 339, 0, 296,
+	0, 0, 395, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 198, 331, 396, 258, 385, 0, 0, 0, 0,
+	180, 181, 182, 0, 1078, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 0, 0, 0, 0, 241, 280,
+	247, 240, 411, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 0, 0,
+	0, 439, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 194, 208, 0, 0, 330, 368, 374,
+	0, 0, 0, 232, 0, 372, 343, 426, 216, 256,
+	365, 348, 370, 0, 0, 371, 297, 415, 360, 425,
+	440, 441, 239, 324, 432, 408, 437, 449, 209, 236,
+	337, 401, 429, 392, 317, 412, 287, 391, 264, 197,
+	295, 201, 403, 423, 221, 382, 0, 0, 0, 203,
+	421, 400, 314, 284, 285, 202, 0, 364, 243, 262,
+	234, 333, 418, 419, 233, 451, 211, 436, 205, 212,
+	435, 326, 414, 422, 315, 306, 204, 420, 313, 305,
+	290, 253, 272, 358, 300, 359, 273, 322, 321, 323,
+	0, 199, 0, 397, 430, 452, 218, 0, 0, 410,
+	445, 448, 0, 361, 219, 263, 252, 357, 261, 293,
+	444, 446, 447, 217, 355, 269, 325, 213, 275, 393,
+	289, 298, 0, 0, 342, 373, 222, 428, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	206, 294, 0, 362, 259, 450, 434, 431, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 207, 215, 224, 237, 250,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 338,
+	340, 341, 344, 350, 351, 352, 353, 354, 356, 363,
+	367, 375, 376, 377, 378, 379, 380, 381, 387, 388,
+	389, 390, 398, 402, 416, 417, 427, 438, 442, 230,
+	383, 0, 268, 424, 443, 0, 302, 0, 0, 304,
+	254, 270, 279, 0, 433, 399, 210, 369, 260, 200,
+	227, 214, 235, 249, 251, 283, 312, 318, 346, 349,
+	265, 246, 225, 366, 223, 384, 405, 406, 407, 409,
+	316, 242, 334, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 292, 0, 0,
+	0, 347, 0, 386, 231, 301, 299, 413, 255, 248,
+	244, 229, 276, 307, 345, 404, 339, 0, 296, 0,
+	0, 395, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	198, 331, 396, 258, 385, 0, 0, 0, 0, 180,
+	181, 182, 0, 0, 0, 0, 0, 0, 0, 0,
+	220, 0, 226, 0, 0, 0, 0, 241, 280, 247,
+	240, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 0, 320, 0, 0, 0,
+	439, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 194, 208, 0, 0, 330, 368, 374, 0,
+	0, 0, 232, 0, 372, 343, 426, 216, 256, 365,
+	348, 370, 0, 0, 371, 297, 415, 360, 425, 440,
+	441, 239, 324, 432, 408, 437, 449, 209, 236, 337,
+	401, 429, 392, 317, 412, 287, 391, 264, 197, 295,
+	201, 403, 423, 221, 382, 0, 0, 0, 203, 421,
+	400, 314, 284, 285, 202, 0, 364, 243, 262, 234,
+	333, 418, 419, 233, 451, 211, 436, 205, 212, 435,
+	326, 414, 422, 315, 306, 204, 420, 313, 305, 290,
+	253, 272, 358, 300, 359, 273, 322, 321, 323, 0,
+	199, 0, 397, 430, 452, 218, 0, 0, 410, 445,
+	448, 0, 361, 219, 263, 252, 357, 261, 293, 444,
+	446, 447, 217, 355, 269, 325, 213, 275, 393, 289,
+	298, 0, 0, 342, 373, 222, 428, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 193, 206,
+	294, 1346, 362, 259, 450, 434, 431, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 195, 196, 207, 215, 224, 237, 250, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 338, 340,
+	341, 344, 350, 351, 352, 353, 354, 356, 363, 367,
+	375, 376, 377, 378, 379, 380, 381, 387, 388, 389,
+	390, 398, 402, 416, 417, 427, 438, 442, 230, 383,
+	0, 268, 424, 443, 0, 302, 0, 0, 304, 254,
+	270, 279, 0, 433, 399, 210, 369, 260, 200, 227,
+	214, 235, 249, 251, 283, 312, 318, 346, 349, 265,
+	246, 225, 366, 223, 384, 405, 406, 407, 409, 316,
+	242, 334, 0, 1233, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	347, 0, 386, 231, 301, 299, 413, 255, 248, 244,
+	229, 276, 307, 345, 404, 339, 0, 296, 0, 0,
+	395, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 198,
+	331, 396, 258, 385, 0, 0, 0, 0, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	0, 226, 0, 0, 0, 0, 241, 280, 247, 240,
+	411, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 0, 0, 0, 439,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 194, 208, 0, 0, 330, 368, 374, 0, 0,
+	0, 232, 0, 372, 343, 426, 216, 256, 365, 348,
+	370, 0, 0, 371, 297, 415, 360, 425, 440, 441,
+	239, 324, 432, 408, 437, 449, 209, 236, 337, 401,
+	429, 392, 317, 412, 287, 391, 264, 197, 295, 201,
+	403, 423, 221, 382, 0, 0, 0, 203, 421, 400,
+	314, 284, 285, 202, 0, 364, 243, 262, 234, 333,
+	418, 419, 233, 451, 211, 436, 205, 212, 435, 326,
+	414, 422, 315, 306, 204, 420, 313, 305, 290, 253,
+	272, 358, 300, 359, 273, 322, 321, 323, 0, 199,
+	0, 397, 430, 452, 218, 0, 0, 410, 445, 448,
+	0, 361, 219, 263, 252, 357, 261, 293, 444, 446,
+	447, 217, 355, 269, 325, 213, 275, 393, 289, 298,
+	0, 0, 342, 373, 222, 428, 394, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 193, 206, 294,
+	0, 362, 259, 450, 434, 431, 0, 0, 238, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 196, 207, 215, 224, 237, 250, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 338, 340, 341,
+	344, 350, 351, 352, 353, 354, 356, 363, 367, 375,
+	376, 377, 378, 379, 380, 381, 387, 388, 389, 390,
+	398, 402, 416, 417, 427, 438, 442, 230, 383, 0,
+	268, 424, 443, 0, 302, 0, 0, 304, 254, 270,
+	279, 0, 433, 399, 210, 369, 260, 200, 227, 214,
+	235, 249, 251, 283, 312, 318, 346, 349, 265, 246,
+	225, 366, 223, 384, 405, 406, 407, 409, 316, 242,
+	334, 0, 1231, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 292, 0, 0, 0, 347,
+	0, 386, 231, 301, 299, 413, 255, 248, 244, 229,
+	276, 307, 345, 404, 339, 0, 296, 0, 0, 395,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 228, 198, 331,
+	396, 258, 385, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 0, 0, 0, 0, 241, 280, 247, 240, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 0, 0, 0, 439, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	194, 208, 0, 0, 330, 368, 374, 0, 0, 0,
+	232, 0, 372, 343, 426, 216, 256, 365, 348, 370,
+	0, 0, 371, 297, 415, 360, 425, 440, 441, 239,
+	324, 432, 408, 437, 449, 209, 236, 337, 401, 429,
+	392, 317, 412, 287, 391, 264, 197, 295, 201, 403,
+	423, 221, 382, 0, 0, 0, 203, 421, 400, 314,
+	284, 285, 202, 0, 364, 243, 262, 234, 333, 418,
+	419, 233, 451, 211, 436, 205, 212, 435, 326, 414,
+	422, 315, 306, 204, 420, 313, 305, 290, 253, 272,
+	358, 300, 359, 273, 322, 321, 323, 0, 199, 0,
+	397, 430, 452, 218, 0, 0, 410, 445, 448, 0,
+	361, 219, 263, 252, 357, 261, 293, 444, 446, 447,
+	217, 355, 269, 325, 213, 275, 393, 289, 298, 0,
+	0, 342, 373, 222, 428, 394, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 206, 294, 0,
+	362, 259, 450, 434, 431, 0, 0, 238, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	195, 196, 207, 215, 224, 237, 250, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 338, 340, 341, 344,
+	350, 351, 352, 353, 354, 356, 363, 367, 375, 376,
+	377, 378, 379, 380, 381, 387, 388, 389, 390, 398,
+	402, 416, 417, 427, 438, 442, 230, 383, 0, 268,
+	424, 443, 0, 302, 0, 0, 304, 254, 270, 279,
+	0, 433, 399, 210, 369, 260, 200, 227, 214, 235,
+	249, 251, 283, 312, 318, 346, 349, 265, 246, 225,
+	366, 223, 384, 405, 406, 407, 409, 316, 242, 334,
+	0, 1229, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 292, 0, 0, 0, 347, 0,
+	386, 231, 301, 299, 413, 255, 248, 244, 229, 276,
+	307, 345, 404, 339, 0, 296, 0, 0, 395, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 198, 331, 396,
+	258, 385, 0, 0, 0, 0, 180, 181, 182, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 241, 280, 247, 240, 411, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 439, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 194,
+	208, 0, 0, 330, 368, 374, 0, 0, 0, 232,
+	0, 372, 343, 426, 216, 256, 365, 348, 370, 0,
+	0, 371, 297, 415, 360, 425, 440, 441, 239, 324,
+	432, 408, 437, 449, 209, 236, 337, 401, 429, 392,
+	317, 412, 287, 391, 264, 197, 295, 201, 403, 423,
+	221, 382, 0, 0, 0, 203, 421, 400, 314, 284,
+	285, 202, 0, 364, 243, 262, 234, 333, 418, 419,
+	233, 451, 211, 436, 205, 212, 435, 326, 414, 422,
+	315, 306, 204, 420, 313, 305, 290, 253, 272, 358,
+	300, 359, 273, 322, 321, 323, 0, 199, 0, 397,
+	430, 452, 218, 0, 0, 410, 445, 448, 0, 361,
+	219, 263, 252, 357, 261, 293, 444, 446, 447, 217,
+	355, 269, 325, 213, 275, 393, 289, 298, 0, 0,
+	342, 373, 222, 428, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 193, 206, 294, 0, 362,
+	259, 450, 434, 431, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
+	196, 207, 215, 224, 237, 250, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 338, 340, 341, 344, 350,
+	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
+	378, 379, 380, 381, 387, 388, 389, 390, 398, 402,
+	416, 417, 427, 438, 442, 230, 383, 0, 268, 424,
+	443, 0, 302, 0, 0, 304, 254, 270, 279, 0,
+	433, 399, 210, 369, 260, 200, 227, 214, 235, 249,
+	251, 283, 312, 318, 346, 349, 265, 246, 225, 366,
+	223, 384, 405, 406, 407, 409, 316, 242, 334, 0,
+	1227, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 0, 292, 0, 0, 0, 347, 0, 386,
+	231, 301, 299, 413, 255, 248, 244, 229, 276, 307,
+	345, 404, 339, 0, 296, 0, 0, 395, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 228, 198, 331, 396, 258,
+	385, 0, 0, 0, 0, 180, 181, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 220, 0, 226, 0,
+	0, 0, 0, 241, 280, 247, 240, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 0, 0, 0, 439, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 194, 208,
+	0, 0, 330, 368, 374, 0, 0, 0, 232, 0,
+	372, 343, 426, 216, 256, 365, 348, 370, 0, 0,
+	371, 297, 415, 360, 425, 440, 441, 239, 324, 432,
+	408, 437, 449, 209, 236, 337, 401, 429, 392, 317,
+	412, 287, 391, 264, 197, 295, 201, 403, 423, 221,
+	382, 0, 0, 0, 203, 421, 400, 314, 284, 285,
+	202, 0, 364, 243, 262, 234, 333, 418, 419, 233,
+	451, 211, 436, 205, 212, 435, 326, 414, 422, 315,
+	306, 204, 420, 313, 305, 290, 253, 272, 358, 300,
+	359, 273, 322, 321, 323, 0, 199, 0, 397, 430,
+	452, 218, 0, 0, 410, 445, 448, 0, 361, 219,
+	263, 252, 357, 261, 293, 444, 446, 447, 217, 355,
+	269, 325, 213, 275, 393, 289, 298, 0, 0, 342,
+	373, 222, 428, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 206, 294, 0, 362, 259,
+	450, 434, 431, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	207, 215, 224, 237, 250, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 338, 340, 341, 344, 350, 351,
+	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
+	379, 380, 381, 387, 388, 389, 390, 398, 402, 416,
+	417, 427, 438, 442, 230, 383, 0, 268, 424, 443,
+	0, 302, 0, 0, 304, 254, 270, 279, 0, 433,
+	399, 210, 369, 260, 200, 227, 214, 235, 249, 251,
+	283, 312, 318, 346, 349, 265, 246, 225, 366, 223,
+	384, 405, 406, 407, 409, 316, 242, 334, 0, 1225,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 0, 292, 0, 0, 0, 347, 0, 386, 231,
+	301, 299, 413, 255, 248, 244, 229, 276, 307, 345,
+	404, 339, 0, 296, 0, 0, 395, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 198, 331, 396, 258, 385,
+	0, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 0, 226, 0, 0,
+	0, 0, 241, 280, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 0, 0, 0, 439, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 194, 208, 0,
+	0, 330, 368, 374, 0, 0, 0, 232, 0, 372,
+	343, 426, 216, 256, 365, 348, 370, 0, 0, 371,
+	297, 415, 360, 425, 440, 441, 239, 324, 432, 408,
+	437, 449, 209, 236, 337, 401, 429, 392, 317, 412,
+	287, 391, 264, 197, 295, 201, 403, 423, 221, 382,
+	0, 0, 0, 203, 421, 400, 314, 284, 285, 202,
+	0, 364, 243, 262, 234, 333, 418, 419, 233, 451,
+	211, 436, 205, 212, 435, 326, 414, 422, 315, 306,
+	204, 420, 313, 305, 290, 253, 272, 358, 300, 359,
+	273, 322, 321, 323, 0, 199, 0, 397, 430, 452,
+	218, 0, 0, 410, 445, 448, 0, 361, 219, 263,
+	252, 357, 261, 293, 444, 446, 447, 217, 355, 269,
+	325, 213, 275, 393, 289, 298, 0, 0, 342, 373,
+	222, 428, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 193, 206, 294, 0, 362, 259, 450,
+	434, 431, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 207,
+	215, 224, 237, 250, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 338, 340, 341, 344, 350, 351, 352,
+	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
+	380, 381, 387, 388, 389, 390, 398, 402, 416, 417,
+	427, 438, 442, 230, 383, 0, 268, 424, 443, 0,
+	302, 0, 0, 304, 254, 270, 279, 0, 433, 399,
+	210, 369, 260, 200, 227, 214, 235, 249, 251, 283,
+	312, 318, 346, 349, 265, 246, 225, 366, 223, 384,
+	405, 406, 407, 409, 316, 242, 334, 0, 1221, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 292, 0, 0, 0, 347, 0, 386, 231, 301,
+	299, 413, 255, 248, 244, 229, 276, 307, 345, 404,
+	339, 0, 296, 0, 0, 395, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 198, 331, 396, 258, 385, 0,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 220, 0, 226, 0, 0, 0,
+	0, 241, 280, 247, 240, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 0,
+	320, 0, 0, 0, 439, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 288, 194, 208, 0, 0,
+	330, 368, 374, 0, 0, 0, 232, 0, 372, 343,
+	426, 216, 256, 365, 348, 370, 0, 0, 371, 297,
+	415, 360, 425, 440, 441, 239, 324, 432, 408, 437,
+	449, 209, 236, 337, 401, 429, 392, 317, 412, 287,
+	391, 264, 197, 295, 201, 403, 423, 221, 382, 0,
+	0, 0, 203, 421, 400, 314, 284, 285, 202, 0,
+	364, 243, 262, 234, 333, 418, 419, 233, 451, 211,
+	436, 205, 212, 435, 326, 414, 422, 315, 306, 204,
+	420, 313, 305, 290, 253, 272, 358, 300, 359, 273,
+	322, 321, 323, 0, 199, 0, 397, 430, 452, 218,
+	0, 0, 410, 445, 448, 0, 361, 219, 263, 252,
+	357, 261, 293, 444, 446, 447, 217, 355, 269, 325,
+	213, 275, 393, 289, 298, 0, 0, 342, 373, 222,
+	428, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 294, 0, 362, 259, 450, 434,
+	431, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 215,
+	224, 237, 250, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 338, 340, 341, 344, 350, 351, 352, 353,
+	354, 356, 363, 367, 375, 376, 377, 378, 379, 380,
+	381, 387, 388, 389, 390, 398, 402, 416, 417, 427,
+	438, 442, 230, 383, 0, 268, 424, 443, 0, 302,
+	0, 0, 304, 254, 270, 279, 0, 433, 399, 210,
+	369, 260, 200, 227, 214, 235, 249, 251, 283, 312,
+	318, 346, 349, 265, 246, 225, 366, 223, 384, 405,
+	406, 407, 409, 316, 242, 334, 0, 1219, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	292, 0, 0, 0, 347, 0, 386, 231, 301, 299,
+	413, 255, 248, 244, 229, 276, 307, 345, 404, 339,
+	0, 296, 0, 0, 395, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 198, 331, 396, 258, 385, 0, 0,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 0, 226, 0, 0, 0, 0,
+	241, 280, 247, 240, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 0, 320,
+	0, 0, 0, 439, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 194, 208, 0, 0, 330,
+	368, 374, 0, 0, 0, 232, 0, 372, 343, 426,
+	216, 256, 365, 348, 370, 0, 0, 371, 297, 415,
+	360, 425, 440, 441, 239, 324, 432, 408, 437, 449,
+	209, 236, 337, 401, 429, 392, 317, 412, 287, 391,
+	264, 197, 295, 201, 403, 423, 221, 382, 0, 0,
+	0, 203, 421, 400, 314, 284, 285, 202, 0, 364,
+	243, 262, 234, 333, 418, 419, 233, 451, 211, 436,
+	205, 212, 435, 326, 414, 422, 315, 306, 204, 420,
+	313, 305, 290, 253, 272, 358, 300, 359, 273, 322,
+	321, 323, 0, 199, 0, 397, 430, 452, 218, 0,
+	0, 410, 445, 448, 0, 361, 219, 263, 252, 357,
+	261, 293, 444, 446, 447, 217, 355, 269, 325, 213,
+	275, 393, 289, 298, 0, 0, 342, 373, 222, 428,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 193, 206, 294, 0, 362, 259, 450, 434, 431,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 195, 196, 207, 215, 224,
+	237, 250, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 338, 340, 341, 344, 350, 351, 352, 353, 354,
+	356, 363, 367, 375, 376, 377, 378, 379, 380, 381,
+	387, 388, 389, 390, 398, 402, 416, 417, 427, 438,
+	442, 230, 383, 0, 268, 424, 443, 0, 302, 0,
+	0, 304, 254, 270, 279, 0, 433, 399, 210, 369,
+	260, 200, 227, 214, 235, 249, 251, 283, 312, 318,
+	346, 349, 265, 246, 225, 366, 223, 384, 405, 406,
+	407, 409, 316, 242, 334, 0, 1217, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 0, 292,
+	0, 0, 0, 347, 0, 386, 231, 301, 299, 413,
+	255, 248, 244, 229, 276, 307, 345, 404, 339, 0,
+	296, 0, 0, 395, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 228, 198, 331, 396, 258, 385, 0, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 220, 0, 226, 0, 0, 0, 0, 241,
+	280, 247, 240, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 0,
+	0, 0, 439, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 194, 208, 0, 0, 330, 368,
+	374, 0, 0, 0, 232, 0, 372, 343, 426, 216,
+	256, 365, 348, 370, 0, 0, 371, 297, 415, 360,
+	425, 440, 441, 239, 324, 432, 408, 437, 449, 209,
+	236, 337, 401, 429, 392, 317, 412, 287, 391, 264,
+	197, 295, 201, 403, 423, 221, 382, 0, 0, 0,
+	203, 421, 400, 314, 284, 285, 202, 0, 364, 243,
+	262, 234, 333, 418, 419, 233, 451, 211, 436, 205,
+	212, 435, 326, 414, 422, 315, 306, 204, 420, 313,
+	305, 290, 253, 272, 358, 300, 359, 273, 322, 321,
+	323, 0, 199, 0, 397, 430, 452, 218, 0, 0,
+	410, 445, 448, 0, 361, 219, 263, 252, 357, 261,
+	293, 444, 446, 447, 217, 355, 269, 325, 213, 275,
+	393, 289, 298, 0, 0, 342, 373, 222, 428, 394,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	193, 206, 294, 0, 362, 259, 450, 434, 431, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 207, 215, 224, 237,
+	250, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	338, 340, 341, 344, 350, 351, 352, 353, 354, 356,
+	363, 367, 375, 376, 377, 378, 379, 380, 381, 387,
+	388, 389, 390, 398, 402, 416, 417, 427, 438, 442,
+	230, 383, 0, 268, 424, 443, 0, 302, 0, 0,
+	304, 254, 270, 279, 0, 433, 399, 210, 369, 260,
+	200, 227, 214, 235, 249, 251, 283, 312, 318, 346,
+	349, 265, 246, 225, 366, 223, 384, 405, 406, 407,
+	409, 316, 242, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 347, 0, 386, 231, 301, 299, 413, 255,
+	248, 244, 229, 276, 307, 345, 404, 339, 0, 296,
+	0, 0, 395, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 198, 331, 396, 258, 385, 0, 1192, 0, 0,
+	180, 181, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 0, 0, 0, 0, 241, 280,
+	247, 240, 411, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 0, 0,
+	0, 439, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 194, 208, 0, 0, 330, 368, 374,
+	0, 0, 0, 232, 0, 372, 343, 426, 216, 256,
+	365, 348, 370, 0, 0, 371, 297, 415, 360, 425,
+	440, 441, 239, 324, 432, 408, 437, 449, 209, 236,
+	337, 401, 429, 392, 317, 412, 287, 391, 264, 197,
+	295, 201, 403, 423, 221, 382, 0, 0, 0, 203,
+	421, 400, 314, 284, 285, 202, 0, 364, 243, 262,
+	234, 333, 418, 419, 233, 451, 211, 436, 205, 212,
+	435, 326, 414, 422, 315, 306, 204, 420, 313, 305,
+	290, 253, 272, 358, 300, 359, 273, 322, 321, 323,
+	0, 199, 0, 397, 430, 452, 218, 0, 0, 410,
+	445, 448, 0, 361, 219, 263, 252, 357, 261, 293,
+	444, 446, 447, 217, 355, 269, 325, 213, 275, 393,
+	289, 298, 0, 0, 342, 373, 222, 428, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	206, 294, 0, 362, 259, 450, 434, 431, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 207, 215, 224, 237, 250,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 338,
+	340, 341, 344, 350, 351, 352, 353, 354, 356, 363,
+	367, 375, 376, 377, 378, 379, 380, 381, 387, 388,
+	389, 390, 398, 402, 416, 417, 427, 438, 442, 230,
+	383, 0, 268, 424, 443, 0, 302, 0, 0, 304,
+	254, 270, 279, 0, 433, 399, 210, 369, 260, 200,
+	227, 214, 235, 249, 251, 283, 312, 318, 346, 349,
+	265, 246, 225, 366, 223, 384, 405, 406, 407, 409,
+	316, 242, 1091, 0, 0, 0, 0, 0, 0, 334,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 292, 0, 0, 0, 347, 0,
+	386, 231, 301, 299, 413, 255, 248, 244, 229, 276,
+	307, 345, 404, 339, 0, 296, 0, 0, 395, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 198, 331, 396,
+	258, 385, 0, 0, 0, 0, 180, 181, 182, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 241, 280, 247, 240, 411, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 439, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 194,
+	208, 0, 0, 330, 368, 374, 0, 0, 0, 232,
+	0, 372, 343, 426, 216, 256, 365, 348, 370, 0,
+	0, 371, 297, 415, 360, 425, 440, 441, 239, 324,
+	432, 408, 437, 449, 209, 236, 337, 401, 429, 392,
+	317, 412, 287, 391, 264, 197, 295, 201, 403, 423,
+	221, 382, 0, 0, 0, 203, 421, 400, 314, 284,
+	285, 202, 0, 364, 243, 262, 234, 333, 418, 419,
+	233, 451, 211, 436, 205, 212, 435, 326, 414, 422,
+	315, 306, 204, 420, 313, 305, 290, 253, 272, 358,
+	300, 359, 273, 322, 321, 323, 0, 199, 0, 397,
+	430, 452, 218, 0, 0, 410, 445, 448, 0, 361,
+	219, 263, 252, 357, 261, 293, 444, 446, 447, 217,
+	355, 269, 325, 213, 275, 393, 289, 298, 0, 0,
+	342, 373, 222, 428, 394, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 193, 206, 294, 0, 362,
+	259, 450, 434, 431, 0, 0, 238, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
+	196, 207, 215, 224, 237, 250, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 338, 340, 341, 344, 350,
+	351, 352, 353, 354, 356, 363, 367, 375, 376, 377,
+	378, 379, 380, 381, 387, 388, 389, 390, 398, 402,
+	416, 417, 427, 438, 442, 230, 383, 0, 268, 424,
+	443, 0, 302, 0, 0, 304, 254, 270, 279, 0,
+	433, 399, 210, 369, 260, 200, 227, 214, 235, 249,
+	251, 283, 312, 318, 346, 349, 265, 246, 225, 366,
+	223, 384, 405, 406, 407, 409, 316, 242, 334, 0,
+	0, 0, 0, 0, 0, 0, 1082, 245, 0, 0,
+	0, 0, 0, 292, 0, 0, 0, 347, 0, 386,
+	231, 301, 299, 413, 255, 248, 244, 229, 276, 307,
+	345, 404, 339, 0, 296, 0, 0, 395, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 228, 198, 331, 396, 258,
+	385, 0, 0, 0, 0, 180, 181, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 220, 0, 226, 0,
+	0, 0, 0, 241, 280, 247, 240, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 0, 0, 0, 439, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 194, 208,
+	0, 0, 330, 368, 374, 0, 0, 0, 232, 0,
+	372, 343, 426, 216, 256, 365, 348, 370, 0, 0,
+	371, 297, 415, 360, 425, 440, 441, 239, 324, 432,
+	408, 437, 449, 209, 236, 337, 401, 429, 392, 317,
+	412, 287, 391, 264, 197, 295, 201, 403, 423, 221,
+	382, 0, 0, 0, 203, 421, 400, 314, 284, 285,
+	202, 0, 364, 243, 262, 234, 333, 418, 419, 233,
+	451, 211, 436, 205, 212, 435, 326, 414, 422, 315,
+	306, 204, 420, 313, 305, 290, 253, 272, 358, 300,
+	359, 273, 322, 321, 323, 0, 199, 0, 397, 430,
+	452, 218, 0, 0, 410, 445, 448, 0, 361, 219,
+	263, 252, 357, 261, 293, 444, 446, 447, 217, 355,
+	269, 325, 213, 275, 393, 289, 298, 0, 0, 342,
+	373, 222, 428, 394, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 193, 206, 294, 0, 362, 259,
+	450, 434, 431, 0, 0, 238, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 195, 196,
+	207, 215, 224, 237, 250, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 338, 340, 341, 344, 350, 351,
+	352, 353, 354, 356, 363, 367, 375, 376, 377, 378,
+	379, 380, 381, 387, 388, 389, 390, 398, 402, 416,
+	417, 427, 438, 442, 230, 383, 0, 268, 424, 443,
+	0, 302, 0, 0, 304, 254, 270, 279, 0, 433,
+	399, 210, 369, 260, 200, 227, 214, 235, 249, 251,
+	283, 312, 318, 346, 349, 265, 246, 225, 366, 223,
+	384, 405, 406, 407, 409, 316, 242, 334, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 0, 292, 0, 0, 0, 347, 0, 386, 231,
+	301, 299, 413, 255, 248, 244, 229, 276, 307, 345,
+	404, 339, 0, 296, 0, 0, 395, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 198, 331, 396, 258, 385,
+	0, 0, 0, 0, 180, 181, 182, 0, 935, 0,
+	0, 0, 0, 0, 0, 220, 0, 226, 0, 0,
+	0, 0, 241, 280, 247, 240, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 0, 0, 0, 439, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 194, 208, 0,
+	0, 330, 368, 374, 0, 0, 0, 232, 0, 372,
+	343, 426, 216, 256, 365, 348, 370, 0, 0, 371,
+	297, 415, 360, 425, 440, 441, 239, 324, 432, 408,
+	437, 449, 209, 236, 337, 401, 429, 392, 317, 412,
+	287, 391, 264, 197, 295, 201, 403, 423, 221, 382,
+	0, 0, 0, 203, 421, 400, 314, 284, 285, 202,
+	0, 364, 243, 262, 234, 333, 418, 419, 233, 451,
+	211, 436, 205, 212, 435, 326, 414, 422, 315, 306,
+	204, 420, 313, 305, 290, 253, 272, 358, 300, 359,
+	273, 322, 321, 323, 0, 199, 0, 397, 430, 452,
+	218, 0, 0, 410, 445, 448, 0, 361, 219, 263,
+	252, 357, 261, 293, 444, 446, 447, 217, 355, 269,
+	325, 213, 275, 393, 289, 298, 0, 0, 342, 373,
+	222, 428, 394, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 193, 206, 294, 0, 362, 259, 450,
+	434, 431, 0, 0, 238, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 195, 196, 207,
+	215, 224, 237, 250, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 338, 340, 341, 344, 350, 351, 352,
+	353, 354, 356, 363, 367, 375, 376, 377, 378, 379,
+	380, 381, 387, 388, 389, 390, 398, 402, 416, 417,
+	427, 438, 442, 230, 383, 0, 268, 424, 443, 0,
+	302, 0, 0, 304, 254, 270, 279, 0, 433, 399,
+	210, 369, 260, 200, 227, 214, 235, 249, 251, 283,
+	312, 318, 346, 349, 265, 246, 225, 366, 223, 384,
+	405, 406, 407, 409, 316, 242, 334, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 292, 0, 0, 0, 347, 0, 386, 231, 301,
+	299, 413, 255, 248, 244, 229, 276, 307, 345, 404,
+	339, 0, 296, 0, 0, 395, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 198, 331, 396, 258, 385, 0,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 220, 0, 226, 0, 0, 0,
+	0, 241, 280, 247, 240, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 0,
+	320, 0, 0, 0, 439, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 288, 194, 208, 0, 0,
+	330, 368, 374, 0, 0, 0, 232, 0, 372, 343,
+	426, 216, 256, 365, 348, 370, 0, 0, 371, 297,
+	415, 360, 425, 440, 441, 239, 324, 432, 408, 437,
+	449, 209, 236, 337, 401, 429, 392, 317, 412, 287,
+	391, 264, 197, 295, 201, 403, 423, 221, 382, 0,
+	0, 0, 203, 421, 400, 314, 284, 285, 202, 0,
+	364, 243, 262, 234, 333, 418, 419, 233, 451, 211,
+	436, 205, 212, 435, 326, 414, 422, 315, 306, 204,
+	420, 313, 305, 290, 253, 272, 358, 300, 359, 273,
+	322, 321, 323, 0, 199, 0, 397, 430, 452, 218,
+	0, 0, 410, 445, 448, 0, 361, 219, 263, 252,
+	357, 261, 293, 444, 446, 447, 217, 355, 269, 325,
+	213, 275, 393, 289, 298, 0, 0, 342, 373, 222,
+	428, 394, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 294, 0, 362, 259, 450, 434,
+	431, 0, 0, 238, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 576, 0, 0, 0, 195, 196, 207, 215,
+	224, 237, 250, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 338, 340, 341, 344, 350, 351, 352, 353,
+	354, 356, 363, 367, 375, 376, 377, 378, 379, 380,
+	381, 387, 388, 389, 390, 398, 402, 416, 417, 427,
+	438, 442, 230, 383, 0, 268, 424, 443, 0, 302,
+	0, 0, 304, 254, 270, 279, 0, 433, 399, 210,
+	369, 260, 200, 227, 214, 235, 249, 251, 283, 312,
+	318, 346, 349, 265, 246, 225, 366, 223, 384, 405,
+	406, 407, 409, 316, 242, 334, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	292, 0, 0, 0, 347, 0, 386, 231, 301, 299,
+	413, 255, 248, 244, 229, 276, 307, 345, 404, 339,
+	0, 296, 0, 0, 395, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 198, 331, 396, 258, 385, 0, 0,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 0, 226, 0, 0, 0, 0,
+	241, 280, 247, 240, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 498, 0, 266, 0, 320,
+	0, 0, 0, 439, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 194, 208, 0, 0, 330,
+	368, 374, 0, 0, 0, 232, 0, 372, 343, 426,
+	216, 256, 365, 348, 370, 0, 0, 371, 297, 415,
+	360, 425, 440, 441, 239, 324, 432, 408, 437, 449,
+	209, 236, 337, 401, 429, 392, 317, 412, 287, 391,
+	264, 197, 295, 201, 403, 423, 221, 382, 0, 0,
+	0, 203, 421, 400, 314, 284, 285, 202, 0, 364,
+	243, 262, 234, 333, 418, 419, 233, 451, 211, 436,
+	205, 212, 435, 326, 414, 422, 315, 306, 204, 420,
+	313, 305, 290, 253, 272, 358, 300, 359, 273, 322,
+	321, 323, 0, 199, 0, 397, 430, 452, 218, 0,
+	0, 410, 445, 448, 0, 361, 219, 263, 252, 357,
+	261, 293, 444, 446, 447, 217, 355, 269, 325, 213,
+	275, 393, 289, 298, 0, 0, 342, 373, 222, 428,
+	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 193, 206, 294, 0, 362, 259, 450, 434, 431,
+	0, 0, 238, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 195, 196, 207, 215, 224,
+	237, 250, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 338, 340, 341, 344, 350, 351, 352, 353, 354,
+	356, 363, 367, 375, 376, 377, 378, 379, 380, 381,
+	387, 388, 389, 390, 398, 402, 416, 417, 427, 438,
+	442, 230, 383, 0, 497, 424, 443, 0, 302, 0,
+	0, 304, 254, 270, 279, 0, 433, 399, 210, 369,
+	260, 200, 227, 214, 235, 249, 251, 283, 312, 318,
+	346, 349, 265, 246, 225, 366, 223, 384, 405, 406,
+	407, 409, 316, 242, 334, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 0, 292,
+	0, 0, 0, 347, 0, 386, 231, 301, 299, 413,
+	255, 248, 244, 229, 276, 307, 345, 404, 339, 0,
+	296, 0, 0, 395, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 228, 198, 331, 396, 258, 385, 0, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 220, 0, 226, 0, 0, 0, 0, 241,
+	280, 247, 240, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 0,
+	188, 0, 439, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 194, 208, 0, 0, 330, 368,
+	374, 0, 0, 0, 232, 0, 372, 343, 426, 216,
+	256, 365, 348, 370, 0, 0, 371, 297, 415, 360,
+	425, 440, 441, 239, 324, 432, 408, 437, 449, 209,
+	236, 337, 401, 429, 392, 317, 412, 287, 391, 264,
+	197, 295, 201, 403, 423, 221, 382, 0, 0, 0,
+	203, 421, 400, 314, 284, 285, 202, 0, 364, 243,
+	262, 234, 333, 418, 419, 233, 451, 211, 436, 205,
+	212, 435, 326, 414, 422, 315, 306, 204, 420, 313,
+	305, 290, 253, 272, 358, 300, 359, 273, 322, 321,
+	323, 0, 199, 0, 397, 430, 452, 218, 0, 0,
+	410, 445, 448, 0, 361, 219, 263, 252, 357, 261,
+	293, 444, 446, 447, 217, 355, 269, 325, 213, 275,
+	393, 289, 298, 0, 0, 342, 373, 222, 428, 394,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	193, 206, 294, 0, 362, 259, 450, 434, 431, 0,
+	0, 238, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 195, 196, 207, 215, 224, 237,
+	250, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	338, 340, 341, 344, 350, 351, 352, 353, 354, 356,
+	363, 367, 375, 376, 377, 378, 379, 380, 381, 387,
+	388, 389, 390, 398, 402, 416, 417, 427, 438, 442,
+	230, 383, 0, 268, 424, 443, 0, 302, 0, 0,
+	304, 254, 270, 279, 0, 433, 399, 210, 369, 260,
+	200, 227, 214, 235, 249, 251, 283, 312, 318, 346,
+	349, 265, 246, 225, 366, 223, 384, 405, 406, 407,
+	409, 316, 242, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 347, 0, 386, 231, 301, 299, 413, 255,
+	248, 244, 229, 276, 307, 345, 404, 339, 0, 296,
+	0, 0, 395, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 198, 331, 396, 258, 385, 0, 0, 0, 0,
+	180, 181, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 0, 0, 0, 0, 241, 280,
+	247, 240, 411, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 0, 0,
+	0, 439, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 194, 208, 0, 0, 330, 368, 374,
+	0, 0, 0, 232, 0, 372, 343, 426, 216, 256,
+	365, 348, 370, 0, 0, 371, 297, 415, 360, 425,
+	440, 441, 239, 324, 432, 408, 437, 449, 209, 236,
+	337, 401, 429, 392, 317, 412, 287, 391, 264, 197,
+	295, 201, 403, 423, 221, 382, 0, 0, 0, 203,
+	421, 400, 314, 284, 285, 202, 0, 364, 243, 262,
+	234, 333, 418, 419, 233, 451, 211, 436, 205, 212,
+	435, 326, 414, 422, 315, 306, 204, 420, 313, 305,
+	290, 253, 272, 358, 300, 359, 273, 322, 321, 323,
+	0, 199, 0, 397, 430, 452, 218, 0, 0, 410,
+	445, 448, 0, 361, 219, 263, 252, 357, 261, 293,
+	444, 446, 447, 217, 355, 269, 325, 213, 275, 393,
+	289, 298, 0, 0, 342, 373, 222, 428, 394, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 193,
+	206, 294, 0, 362, 259, 450, 434, 431, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 195, 196, 207, 215, 224, 237, 250,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 338,
+	340, 341, 344, 350, 351, 352, 353, 354, 356, 363,
+	367, 375, 376, 377, 378, 379, 380, 381, 387, 388,
+	389, 390, 398, 402, 416, 417, 427, 438, 442, 230,
+	383, 0, 268, 424, 443, 0, 302, 0, 0, 304,
+	254, 270, 279, 0, 433, 399, 210, 369, 260, 200,
+	227, 214, 235, 249, 251, 283, 312, 318, 346, 349,
+	265, 246, 225, 366, 223, 384, 405, 406, 407, 409,
+	316, 242, 334, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 292, 0, 0,
+	0, 347, 0, 386, 231, 301, 299, 413, 255, 248,
+	244, 229, 276, 307, 345, 404, 339, 0, 296, 0,
+	0, 395, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	198, 331, 396, 258, 0, 0, 0, 0, 0, 180,
+	181, 182, 0, 0, 0, 0, 0, 0, 0, 0,
+	220, 0, 226, 0, 0, 0, 0, 241, 280, 247,
+	240, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 0, 320, 0, 0, 0,
+	439, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 194, 208, 0, 0, 330, 368, 374, 0,
+	0, 0, 232, 0, 372, 343, 426, 216, 256, 365,
+	348, 370, 0, 0, 371, 297, 415, 360, 425, 440,
+	441, 239, 324, 432, 408, 437, 449, 209, 236, 337,
+	401, 429, 392, 317, 412, 287, 391, 264, 197, 295,
+	201, 403, 423, 221, 382, 0, 0, 0, 203, 421,
+	400, 314, 284, 285, 202, 0, 364, 243, 262, 234,
+	333, 418, 419, 233, 451, 211, 436, 205, 212, 435,
+	326, 414, 422, 315, 306, 204, 420, 313, 305, 290,
+	253, 272, 358, 300, 359, 273, 322, 321, 323, 0,
+	199, 0, 397, 430, 452, 218, 0, 0, 410, 445,
+	448, 0, 361, 219, 263, 252, 357, 261, 293, 444,
+	446, 447, 217, 355, 269, 325, 213, 275, 393, 289,
+	298, 0, 0, 342, 373, 222, 428, 394, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 193, 206,
+	294, 0, 362, 259, 450, 434, 431, 0, 0, 238,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 195, 196, 207, 215, 224, 237, 250, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 338, 340,
+	341, 344, 350, 351, 352, 353, 354, 356, 363, 367,
+	375, 376, 377, 378, 379, 380, 381, 387, 388, 389,
+	390, 398, 402, 416, 417, 427, 438, 442, 230, 383,
+	0, 268, 424, 443, 0, 302, 0, 0, 304, 254,
+	270, 279, 0, 433, 399, 210, 369, 260, 200, 227,
+	214, 235, 249, 251, 283, 312, 318, 346, 349, 265,
+	246, 225, 366, 223, 384, 405, 406, 407, 409, 316,
+	242,
 }
 
 var yyPact = [...]int{
-	3930, -1000, -328, 1714, -1000, -1000, -1000, -1000, -1000, -1000,
+	3281, -1000, -325, 1654, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1672, 1214, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 661, 1346, 196, 1584, 249, 160, 982, 427, 112,
-	26757, 426, 90, 27201, -1000, 102, -1000, 93, 27201, 98,
-	26313, -1000, -1000, -272, 12516, 1529, -3, -31, 27201, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1272, 1648, 1649,
-	1670, 1124, 1561, -1000, 10727, 10727, 366, 366, 366, 8951,
-	-1000, -1000, 16525, 27201, 27201, 1370, 355, 982, 417, 408,
-	401, 355, -87, -1000, -1000, -1000, 1584, -1000, -1000, 167,
-	-1000, 299, 1275, -1000, 1273, -1000, 458, 489, 294, 353,
-	350, 293, 288, 287, 281, 280, 279, 277, 268, 301,
-	-1000, 639, 639, -163, -169, 2124, 343, 343, 343, 379,
-	1551, 1545, -1000, 554, -1000, 639, 639, 159, 639, 639,
-	639, 639, 219, 212, 639, 639, 639, 639, 639, 639,
-	639, 639, 639, 639, 639, 639, 639, 639, 639, 27201,
-	-1000, 165, 598, 665, 1584, 191, -1000, -1000, -1000, 27201,
-	348, 982, 348, 348, 27201, -1000, 501, -1000, -1000, -1000,
+	-1000, -1000, 1593, 1651, 254, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 689, 1269, 217, 1511, 270, 441, 1009, 487,
+	165, 27375, 481, 111, 27824, -1000, 171, -1000, 153, 27824,
+	164, 26926, -1000, -1000, -265, 12525, 1459, 59, 34, 27824,
+	26477, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1265,
+	1573, 1574, 1614, 1593, -1000, 1116, 1592, -1000, 11178, 11178,
+	406, 406, 406, 8920, -1000, -1000, 17041, 27824, 27824, 1284,
+	404, 1009, 478, 476, 475, 404, -48, -1000, -1000, -1000,
+	1511, -1000, -1000, 201, -1000, 347, 1232, -1000, 1231, -1000,
+	447, 508, 344, 433, 395, 332, 330, 329, 317, 314,
+	313, 312, 305, 351, -1000, 653, 653, -146, -147, 2427,
+	398, 398, 398, 430, 1476, 1475, -1000, 608, -1000, 653,
+	653, 189, 653, 653, 653, 653, 259, 250, 653, 653,
+	653, 653, 653, 653, 653, 653, 653, 653, 653, 653,
+	653, 653, 653, 27824, -1000, 220, 604, 684, 1511, 233,
+	-1000, -1000, -1000, 27824, 402, 1009, 402, 402, 27824, -1000,
+	543, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3979,23 +4108,23 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 27201, 700, 700, 700,
-	700, 700, 700, 80, -1000, 69, 244, 242, 181, 128,
-	63, 199, -1000, 429, -1000, 177, 114, -1000, 700, 7119,
-	7119, 7119, -1000, 1570, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 378, -1000, -1000, -1000, -1000, 27201, 25869, 298,
-	657, -1000, -1000, -1000, -1, -1000, -1000, 1158, 663, -1000,
-	12516, 1246, 1279, 1279, -1000, -1000, 460, -1000, -1000, 13848,
-	13848, 13848, 13848, 13848, 13848, 13848, 13848, 13848, 13848, -1000,
+	-1000, -1000, -1000, 27824, 745, 745, 745, 745, 745, 745,
+	151, -1000, 125, 261, 237, 229, 128, 71, 335, -1000,
+	560, -1000, 225, 156, -1000, 745, 7068, 7068, 7068, -1000,
+	1496, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 420,
+	-1000, -1000, -1000, -1000, 27824, 26028, 262, 682, -1000, -1000,
+	-1000, 48, -1000, -1000, 1061, 681, -1000, 12525, 2372, 1234,
+	1234, -1000, -1000, 517, -1000, -1000, 13872, 13872, 13872, 13872,
+	13872, 13872, 13872, 13872, 13872, 13872, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1279, 500, -1000, 12072, 1279, 1279, 1279,
-	1279, 1279, 1279, 1279, 1279, 12516, 1279, 1279, 1279, 1279,
-	1279, 1279, 1279, 1279, 1279, 1279, 1279, 1279, 1279, 1279,
-	1279, 1279, -1000, -1000, -1000, 27201, -1000, 1279, 1672, -1000,
-	1214, -1000, -1000, -1000, 1576, 12516, 12516, 1672, -1000, 1464,
-	10727, -1000, -1000, 1527, -1000, -1000, -1000, -1000, 769, 1697,
-	-1000, 15180, 496, 1693, 25425, -1000, 19202, 24981, 1256, 8493,
-	-65, -1000, -1000, -1000, 646, 18314, -1000, -1000, -1000, -1000,
+	1234, 542, -1000, 10280, 1234, 1234, 1234, 1234, 1234, 1234,
+	1234, 1234, 12525, 1234, 1234, 1234, 1234, 1234, 1234, 1234,
+	1234, 1234, 1234, 1234, 1234, 1234, 1234, 1234, 1234, -1000,
+	-1000, -1000, 27824, -1000, 1234, 1199, 27824, -1000, 1237, 1593,
+	-1000, 254, -1000, -1000, 1492, 12525, 12525, 1574, 1415, 1593,
+	-1000, 1383, 11178, -1000, -1000, 1415, -1000, -1000, -1000, -1000,
+	800, 1634, -1000, 15219, 539, 1633, 25579, -1000, 19286, 25130,
+	1229, 8457, 8, -1000, -1000, -1000, 677, 18388, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4009,323 +4138,335 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1570, 1156, 27201,
-	-1000, -1000, 4172, 982, -1000, 1337, -1000, 1152, -1000, 1309,
-	165, 27201, 682, 1382, 982, 982, 982, 982, -1000, -1000,
-	-1000, 639, 639, 269, 249, 3944, -1000, -1000, -1000, 24530,
-	1336, 982, -1000, 1334, -1000, 1597, 367, 550, 550, 982,
-	-1000, -1000, 27201, 982, 1595, 1591, 27201, 27201, -1000, 24086,
-	-1000, 23642, 23198, 955, 27201, 22754, 22310, 21866, 21422, 20978,
-	-1000, 1421, -1000, 1257, -1000, -1000, -1000, 27201, 27201, 27201,
-	12, -1000, -1000, 27201, 982, -1000, -1000, 948, 939, 639,
-	639, 935, 1013, 1011, 1003, 639, 639, 933, 999, 1108,
-	176, 931, 908, 907, 959, 994, 116, 958, 926, 902,
-	27201, 1333, -1000, 146, 643, 245, 265, 233, 27201, 203,
-	1584, 1525, 1252, 377, 27201, 1619, 1418, 27201, 982, -1000,
-	7577, -1000, -1000, 993, 12516, -1000, -1000, -1000, -1000, -1000,
-	700, 27201, 700, 27201, 700, 700, 700, 700, 673, 690,
-	673, -1000, -1000, -1000, -1000, 7119, 7119, 7119, 27201, 7119,
-	7119, 27201, 7119, 7119, 690, -1000, -1000, -1000, 617, -1000,
-	1417, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 97, -1000,
-	-1000, -1000, -1000, -1000, 1714, -1000, -1000, -1000, -108, 1250,
-	20534, -1000, -276, -278, -279, -280, -1000, -1000, -1000, -290,
-	-291, -1000, -1000, -1000, 12516, 12516, 12516, 12516, 822, 623,
-	13848, 777, 589, 13848, 13848, 13848, 13848, 13848, 13848, 13848,
-	13848, 13848, 13848, 13848, 13848, 13848, 13848, 13848, 580, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 982, -1000, 1712,
-	1082, 1082, 529, 529, 529, 529, 529, 529, 529, 529,
-	529, 14292, 9395, 7577, 1124, 1148, 1672, 10727, 10727, 12516,
-	12516, 11615, 11171, 10727, 1568, 672, 663, 27201, -1000, -1000,
-	13404, -1000, -1000, -1000, -1000, -1000, 1020, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 27201, 27201, 10727, 10727, 10727, 10727,
-	10727, -1000, 1237, -1000, -162, 16081, 12516, 1649, 1124, 1527,
-	1613, 1706, 584, 730, 1218, -1000, 968, 1649, 17870, 1212,
-	-1000, 1527, -1000, -1000, -1000, 27201, -1000, -1000, 20090, -1000,
-	-1000, 6661, 27201, 267, 27201, -1000, 1210, 1511, -1000, -1000,
-	-1000, 1625, 17426, 27201, 1176, 1155, -1000, -1000, 495, 8035,
-	-65, -1000, 8035, 1183, -1000, -57, -21, 9839, 520, -1000,
-	-1000, -1000, 2124, 14736, 1067, 686, 18, -1000, -1000, -1000,
-	1309, -1000, 1309, 1309, 1309, 1309, 12, 12, 12, 12,
-	-1000, -1000, -1000, -1000, -1000, 1330, 1314, -1000, 1309, 1309,
-	1309, 1309, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1313,
-	1313, 1313, 1310, 1310, 337, -1000, 12516, 173, 27201, 1609,
-	901, 146, -1000, 1618, 1416, -1000, 27201, 1382, 1382, 1382,
-	-1000, 945, 899, -1000, 1217, -1000, -1000, 1669, -1000, -1000,
-	468, 713, 707, 575, 27201, 127, 260, -1000, 328, -1000,
-	27201, 1312, 1590, 550, 982, -1000, 982, -1000, -1000, -1000,
-	-1000, 486, -1000, -1000, 982, 1216, -1000, 1181, 750, 693,
-	729, 687, 1216, -1000, -1000, -137, 1216, -1000, 1216, -1000,
-	1216, -1000, 1216, -1000, 1216, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 586, 27201, 127, 580, -1000, 376, -1000,
-	-1000, 580, 580, -1000, -1000, -1000, -1000, 991, 990, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1496, 1169, 27824, -1000, -1000, 4391, 1009, -1000, 1268, -1000,
+	1164, -1000, 1249, 220, 27824, 702, 1302, 1009, 1009, 1009,
+	1009, -1000, -1000, -1000, 653, 653, 349, 270, 4058, -1000,
+	-1000, -1000, 24674, 1266, 1009, -1000, 1260, -1000, 1527, 397,
+	596, 596, 1009, -1000, -1000, 27824, 1009, 1526, 1523, 27824,
+	27824, -1000, 24225, -1000, 23776, 23327, 950, 27824, 22878, 22429,
+	21980, 21531, 21082, -1000, 1337, -1000, 1329, -1000, -1000, -1000,
+	27824, 27824, 27824, 70, -1000, -1000, 27824, 1009, -1000, -1000,
+	947, 946, 653, 653, 917, 1019, 1017, 1016, 653, 653,
+	916, 1015, 992, 227, 913, 911, 868, 981, 1012, 126,
+	951, 937, 862, 27824, 1259, -1000, 208, 674, 269, 309,
+	274, 27824, 239, 1511, 1455, 1218, 412, 27824, 1545, 1346,
+	27824, 1009, -1000, 7531, -1000, -1000, 1010, 12525, -1000, -1000,
+	-1000, -1000, -1000, 745, 27824, 745, 27824, 745, 745, 745,
+	745, 717, 718, 717, -1000, -1000, -1000, -1000, 7068, 7068,
+	7068, 27824, 7068, 7068, 27824, 7068, 7068, 718, -1000, -1000,
+	-1000, 622, -1000, 1343, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 158, -1000, -1000, -1000, -1000, -1000, 1654, -1000, -1000,
+	-1000, -90, 1210, 20633, -1000, -269, -270, -271, -273, -1000,
+	-1000, -1000, -274, -275, -1000, -1000, -1000, 12525, 12525, 12525,
+	12525, 864, 617, 13872, 837, 657, 13872, 13872, 13872, 13872,
+	13872, 13872, 13872, 13872, 13872, 13872, 13872, 13872, 13872, 13872,
+	13872, 655, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1009, -1000, 255, 1118, 1118, 569, 569, 569, 569, 569,
+	569, 569, 569, 569, 14321, 9369, 7531, 1116, 1162, 1593,
+	1651, 11178, 11178, 12525, 12525, 12076, 11627, 11178, 1483, 693,
+	681, 27824, -1000, -1000, 13423, -1000, -1000, -1000, -1000, -1000,
+	942, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 27824, 27824,
+	11178, 11178, 11178, 11178, 11178, -1000, 1200, -1000, -154, 16592,
+	12525, 27824, 1199, 1565, 27824, 1574, 1116, 1531, 1641, 605,
+	909, 1198, -1000, 830, 1492, -1000, -1000, 1574, 17939, 1160,
+	-1000, 1415, -1000, 27824, -1000, -1000, 20184, -1000, -1000, 6605,
+	27824, 301, 27824, -1000, 1228, 1381, -1000, -1000, -1000, 1562,
+	16143, 27824, 1240, 1192, -1000, -1000, 527, 7994, 8, -1000,
+	7994, 1174, -1000, 11, 3, 9818, 548, -1000, -1000, -1000,
+	2427, 14770, 1055, 751, 88, -1000, -1000, -1000, 1249, -1000,
+	1249, 1249, 1249, 1249, 70, 70, 70, 70, -1000, -1000,
+	-1000, -1000, -1000, 1258, 1255, -1000, 1249, 1249, 1249, 1249,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -324, 27201, 382, 129, 154,
-	27201, 27201, 27201, 27201, 27201, 436, -1000, -1000, -1000, 208,
-	27201, 27201, 425, -1000, 27201, 424, -1000, -1000, -1000, -1000,
-	-1000, -1000, 663, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 700, 27201, 27201, 27201, -1000, -1000, 700, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 27201, -1000, 976,
-	27201, 27201, -1000, -1000, -1000, -1000, -1000, 96, -35, 163,
-	-1000, -1000, -1000, -1000, 1629, -1000, 663, 623, 613, 638,
-	-1000, -1000, 860, -1000, -1000, 1270, -1000, -1000, -1000, -1000,
-	777, 13848, 13848, 13848, 597, 1270, 2247, 710, 2270, 529,
-	737, 737, 515, 515, 515, 515, 515, 837, 837, -1000,
-	-1000, -1000, -1000, 1020, -1000, -1000, -1000, 1020, 10727, 10727,
-	1194, 1279, 485, -1000, 1272, -1000, -1000, 1649, 1130, 1130,
-	670, 753, 622, 1686, 1130, 607, 1683, 1130, 1130, 10727,
-	-1000, -1000, 675, -1000, 12516, 1020, -1000, 1177, 1187, 1184,
-	1130, 1020, 1020, 1130, 1130, 27201, -1000, -266, -1000, -63,
-	503, 1279, -1000, 19646, -1000, -1000, 1020, 1158, 1576, -1000,
-	-1000, 1556, -1000, 1461, 12516, 12516, 12516, -1000, -1000, -1000,
-	1576, 1664, -1000, 1477, 1474, 1681, 10727, 19202, 1527, -1000,
-	-1000, -1000, 478, 1681, 1271, 1279, -1000, 27201, 19202, 19202,
-	19202, 19202, 19202, -1000, 1447, 1444, -1000, 1437, 1435, 1443,
-	27201, -1000, 1140, 1124, 17426, 267, 1074, 19202, 27201, -1000,
-	-1000, 19202, 27201, 6203, -1000, 1183, -65, -18, -1000, -1000,
-	-1000, -1000, 663, -1000, 877, -1000, 2100, -1000, 326, -1000,
-	-1000, -1000, -1000, 1583, -1000, 733, 14, -1000, -1000, 12,
-	12, -1000, -1000, 520, 625, 520, 520, 520, 974, 974,
-	-1000, -1000, -1000, -1000, -1000, 835, -1000, -1000, -1000, 824,
-	-1000, -1000, 989, 1396, 173, -1000, -1000, 639, 973, 1530,
-	-1000, -1000, 1063, 381, -1000, 27201, -1000, 1407, 1404, 1403,
-	-1000, -1000, -1000, -1000, 2319, 27201, 1136, -1000, 125, 27201,
-	1038, 27201, -1000, 1134, 27201, -1000, 982, -1000, -1000, 7577,
-	-1000, 27201, 1279, -1000, -1000, -1000, -1000, 423, 1581, 1574,
-	127, 125, 520, 982, -1000, -1000, -1000, -1000, -1000, -332,
-	1132, 27201, 143, -1000, 1311, 841, -1000, 1376, -1000, -1000,
-	-1000, -1000, 120, 232, 201, 374, -1000, -1000, -1000, -1000,
-	1396, 27201, -1000, -1000, -1000, -1000, 673, -1000, 673, -1000,
-	-1000, -1000, -1000, 1567, -50, -302, -1000, -299, -1000, -1000,
-	-1000, -1000, 597, 1270, 1522, -1000, 13848, 13848, -1000, -1000,
-	1130, 1130, 10727, 7577, 1672, 1576, -1000, -1000, 332, 580,
-	332, 13848, 13848, -1000, 13848, 13848, -1000, -102, 1248, 664,
-	-1000, 12516, 708, -1000, -1000, 13848, 13848, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 389, 388, 385, 27201,
-	-1000, -1000, -1000, 946, 970, 1459, 663, 663, -1000, -1000,
-	27201, -1000, -1000, -1000, -1000, 1679, 12516, -1000, 1180, -1000,
-	5745, 1649, 1399, 27201, 1279, 1714, 15637, 27201, 1215, -1000,
-	642, 1511, 1379, 1397, 1356, -1000, -1000, -1000, -1000, 1439,
-	-1000, 1438, -1000, -1000, -1000, -1000, -1000, 1124, 1681, 19202,
-	1128, -1000, 1128, -1000, 477, -1000, -1000, -1000, -60, -41,
-	-1000, -1000, -1000, 2124, -1000, -1000, 1395, 13848, -1000, -1000,
-	-1000, 520, 520, -1000, -1000, -1000, -1000, -1000, -1000, 1123,
-	-1000, 1121, 1179, 1115, 54, -1000, 1254, 1565, 639, 639,
-	-1000, 821, -1000, 982, -1000, 27201, -1000, 27201, 27201, 27201,
-	1666, 1164, -1000, 27201, -1000, -1000, 27201, -1000, -1000, 1472,
-	173, 1100, -1000, -1000, -1000, 260, 27201, -1000, 1082, 125,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1281, -1000, -1000, -1000,
-	1035, -1000, -138, 982, 27201, 27201, 27201, -1000, 27201, -1000,
-	-1000, 700, 700, -1000, 1531, -1000, 982, -1000, 13848, 1270,
-	1270, -1000, -1000, 1020, -1000, 1649, -1000, 1020, 1309, 1309,
-	-1000, 1309, 1310, -1000, 1309, 82, 1309, 77, 1020, 1020,
-	2146, 2102, 1610, 1306, 1279, -97, -1000, 663, 12516, 1211,
-	812, 1279, 1279, 1279, 1072, 969, 12, -1000, -1000, -1000,
-	1675, 1661, 663, -1000, -1000, -1000, 1604, 1169, 1102, -1000,
-	-1000, 10283, 1094, 1471, 475, 1072, 1672, 27201, 12516, -1000,
-	-1000, 12516, 1307, -1000, 12516, -1000, -1000, -1000, 1672, 1672,
-	1128, -1000, -1000, 547, -1000, -1000, -1000, -1000, 31, 1704,
-	1270, -1000, -1000, 12, 966, 12, 804, -1000, 799, -1000,
-	-1000, -207, -1000, -1000, 1249, 1357, -1000, -1000, 1281, -1000,
-	-1000, -1000, 27201, 27201, -1000, -1000, 246, -1000, 312, 1056,
-	-1000, -155, -1000, -1000, 1623, 27201, -1000, -1000, 7577, -1000,
-	-1000, 1280, 1380, -1000, -1000, -1000, -1000, -1000, -1000, 1270,
-	-1000, 1576, -1000, -1000, 214, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 13848, 13848, 13848, 13848, 13848, 1649, 965,
-	663, 13848, 13848, 18758, 27201, 27201, 16969, 12, 23, -1000,
-	12516, 12516, 1589, -1000, 1279, -1000, 1242, 27201, 1279, 27201,
-	-1000, 1649, -1000, 663, 663, 27201, 663, 1649, -1000, 492,
-	-1000, -58, 520, -1000, 520, 1032, 1022, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1614, 1164, -1000, 254, 27201,
-	-1000, 260, -1000, -171, -172, 1214, 1050, 1161, -1000, 605,
-	27201, 27201, -1000, -1000, -1000, 1177, 1177, 1177, 1177, 202,
-	1020, -1000, 1177, 1177, 1044, -1000, 1044, 1044, 503, -261,
-	-1000, 1518, 1508, 663, 1158, 1703, -1000, 1279, 1714, 473,
-	1102, -1000, -1000, 1041, -1000, 739, 1588, -1000, 1587, -1000,
-	-1000, -1000, -1000, -1000, 1214, 1279, 1243, -1000, -1000, -1000,
-	228, -1000, 7577, 5287, 1029, -1000, -1000, -1000, -1000, -1000,
-	1020, 156, -141, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	23, 266, -1000, 1486, 1479, 1660, 27201, 1102, 27201, -1000,
-	-1000, 962, -1000, -1000, 228, 12960, 27201, -1000, -54, -1000,
-	-1000, -1000, -1000, -1000, 1376, -1000, 1458, -132, -151, 1494,
-	1496, 1496, 1508, 1657, 1506, 1502, -1000, 947, 1097, -1000,
-	-1000, -1000, 1177, 1020, 1026, 336, -1000, -1000, -138, -1000,
-	1457, -1000, 1488, 838, -1000, -1000, -1000, -1000, 878, -1000,
-	1656, 1654, -1000, -1000, -1000, 1385, 153, -1000, -139, -1000,
-	814, -1000, -1000, -1000, 793, 778, 1384, -1000, 1692, -1000,
-	-142, -1000, -1000, -1000, -1000, -1000, 1694, 471, 471, -159,
-	-1000, -1000, -1000, 327, 792, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1254, 1254, 1254,
+	1250, 1250, 388, -1000, 12525, 204, 27824, 1534, 851, 208,
+	-1000, 1542, 1342, -1000, 27824, 1302, 1302, 1302, -1000, 971,
+	926, -1000, 1195, -1000, -1000, 1613, -1000, -1000, 574, 762,
+	752, 616, 27824, 196, 299, -1000, 374, -1000, 27824, 1252,
+	1521, 596, 1009, -1000, 1009, -1000, -1000, -1000, -1000, 526,
+	-1000, -1000, 1009, 1188, -1000, 1179, 766, 731, 750, 721,
+	1188, -1000, -1000, -76, 1188, -1000, 1188, -1000, 1188, -1000,
+	1188, -1000, 1188, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 626, 27824, 196, 655, -1000, 411, -1000, -1000, 655,
+	655, -1000, -1000, -1000, -1000, 1003, 1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -315, 27824, 448, 179, 213, 27824, 27824,
+	27824, 27824, 27824, 509, -1000, -1000, -1000, 244, 27824, 27824,
+	469, -1000, 27824, 468, -1000, -1000, -1000, -1000, -1000, -1000,
+	681, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 745,
+	27824, 27824, 27824, -1000, -1000, 745, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 27824, -1000, 999, 27824, 27824,
+	-1000, -1000, -1000, -1000, -1000, 85, 9, 221, -1000, -1000,
+	-1000, -1000, 1570, -1000, 681, 617, 776, 685, -1000, -1000,
+	834, -1000, -1000, 2412, -1000, -1000, -1000, -1000, 837, 13872,
+	13872, 13872, 1744, 2412, 2475, 1465, 1257, 569, 704, 704,
+	624, 624, 624, 624, 624, 547, 547, -1000, -1000, -1000,
+	-1000, 942, -1000, -1000, -1000, 942, 11178, 11178, 1186, 1234,
+	521, -1000, 1265, -1000, -1000, 1574, 1593, 1113, 1113, 845,
+	860, 652, 1631, 1113, 646, 1629, 1113, 1113, 11178, -1000,
+	-1000, 714, -1000, 12525, 942, -1000, 2163, 1181, 1178, 1113,
+	942, 942, 1113, 1113, 27824, -1000, -262, -1000, -28, 591,
+	1234, -1000, 19735, -1000, -1000, 942, 1061, -1000, 1234, 1157,
+	-1000, 1492, -1000, -1000, 1443, -1000, 1382, 12525, 12525, 12525,
+	-1000, -1000, -1000, 1531, 1492, 1601, -1000, 1403, 1395, 1621,
+	11178, 19286, 1415, -1000, -1000, -1000, 516, 1621, 289, 1234,
+	-1000, 27824, 19286, 19286, 19286, 19286, 19286, -1000, 1365, 1360,
+	-1000, 1358, 1357, 1364, 27824, -1000, 1155, 1116, 16143, 301,
+	1094, 19286, 27824, -1000, -1000, 19286, 27824, 6142, -1000, 1174,
+	8, -4, -1000, -1000, -1000, -1000, 681, -1000, 898, -1000,
+	2312, -1000, 377, -1000, -1000, -1000, -1000, 1510, -1000, 791,
+	84, -1000, -1000, 70, 70, -1000, -1000, 548, 739, 548,
+	548, 548, 996, 996, -1000, -1000, -1000, -1000, -1000, 847,
+	-1000, -1000, -1000, 840, -1000, -1000, 888, 1334, 204, -1000,
+	-1000, 653, 986, 1463, -1000, -1000, 1048, 435, -1000, 27824,
+	-1000, 1313, 1311, 1310, -1000, -1000, -1000, -1000, 2365, 27824,
+	1152, -1000, 176, 27824, 1040, 27824, -1000, 1149, 27824, -1000,
+	1009, -1000, -1000, 7531, -1000, 27824, 1234, -1000, -1000, -1000,
+	-1000, 449, 1508, 1498, 196, 176, 548, 1009, -1000, -1000,
+	-1000, -1000, -1000, -318, 1145, 27824, 216, -1000, 1251, 939,
+	-1000, 1289, -1000, -1000, -1000, -1000, 178, 264, 236, 410,
+	-1000, -1000, -1000, -1000, 1334, 27824, -1000, -1000, -1000, -1000,
+	717, -1000, 717, -1000, -1000, -1000, -1000, 1493, 1, -293,
+	-1000, -289, -1000, -1000, -1000, -1000, 1744, 2412, 2079, -1000,
+	13872, 13872, -1000, -172, 1113, 1113, 11178, 7531, 1593, 1492,
+	1574, -1000, -1000, 744, 655, 744, 13872, 13872, -1000, 13872,
+	13872, -1000, -70, 1133, 658, -1000, 12525, 936, -1000, -1000,
+	13872, 13872, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 466, 464, 457, 27824, -1000, -1000, -1000, -1000, 27824,
+	-1000, 935, 985, 1377, 681, 681, -1000, -1000, -1000, 27824,
+	-1000, -1000, -1000, -1000, 1604, 12525, -1000, 1173, -1000, 5679,
+	1574, 1309, 27824, 1234, 1654, 15681, 27824, 1213, -1000, 664,
+	1381, 1296, 1308, 1400, -1000, -1000, -1000, -1000, 1347, -1000,
+	1340, -1000, -1000, -1000, -1000, -1000, 1116, 1621, 19286, 1189,
+	-1000, 1189, -1000, 513, -1000, -1000, -1000, -14, -22, -1000,
+	-1000, -1000, 2427, -1000, -1000, 1307, 13872, -1000, -1000, -1000,
+	548, 548, -1000, -1000, -1000, -1000, -1000, -1000, 1092, -1000,
+	1080, 1172, 1076, 67, -1000, 1276, 1489, 653, 653, -1000,
+	839, -1000, 1009, -1000, 27824, -1000, 27824, 27824, 27824, 1610,
+	1171, 27824, -1000, -1000, 27824, -1000, -1000, 1388, 204, 1071,
+	-1000, -1000, -1000, 299, 27824, -1000, 1118, 176, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1237, -1000, -1000, -1000, 1038, -1000,
+	-85, 1009, 27824, 27824, 27824, -1000, 27824, -1000, -1000, 745,
+	745, -1000, 1485, -1000, 1009, -1000, 13872, 2412, 2412, -1000,
+	19286, -1000, -1000, 942, -1000, 1574, -1000, 1492, 942, 1249,
+	1249, -1000, 1249, 1250, -1000, 1249, 139, 1249, 131, 942,
+	942, 2851, 2646, 2536, 2496, 1234, -62, -1000, 681, 12525,
+	2431, 2223, 1234, 1234, 1234, 1058, -1000, 983, 70, -1000,
+	-1000, -1000, 1618, 1608, 681, -1000, -1000, -1000, 1529, 1074,
+	1090, -1000, -1000, 10729, 1069, 1387, 504, 1058, 1593, 27824,
+	12525, -1000, -1000, 12525, 1244, -1000, 12525, -1000, -1000, -1000,
+	1593, 1593, 1189, -1000, -1000, 590, -1000, -1000, -1000, -1000,
+	93, 1640, 2412, -1000, -1000, 70, 972, 70, 836, -1000,
+	810, -1000, -1000, -194, -1000, -1000, 1295, 1330, -1000, -1000,
+	1237, -1000, -1000, -1000, 27824, -1000, -1000, 292, -1000, 366,
+	1053, -1000, -111, -1000, -1000, 1560, -1000, -1000, 7531, -1000,
+	-1000, 1236, 1298, -1000, -1000, -1000, -1000, -1000, -1000, 2412,
+	-1000, 28273, -1000, 1492, -1000, -1000, -1000, 235, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 13872, 13872, 13872, 13872,
+	13872, 1574, 966, 681, 13872, 13872, 18837, 27824, 27824, 17490,
+	70, 37, -186, 12525, 12525, 1520, -1000, 1234, -1000, 252,
+	27824, 1234, 27824, -1000, 1574, -1000, 681, 681, 27824, 681,
+	1574, -1000, 541, -1000, -35, 548, -1000, 548, 1026, 1014,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1559, 1171,
+	279, 27824, -1000, 299, -1000, -149, -150, 254, 1111, -1000,
+	634, 27824, 27824, 942, 297, -1000, -1000, -1000, -1000, 2163,
+	2163, 2163, 2163, 219, 942, -1000, 2163, 2163, 1043, -1000,
+	1043, 1043, 591, -251, -1000, 1449, 1447, -1000, 27824, 681,
+	1061, 1639, -1000, 1234, 1654, 482, 1090, -1000, -1000, 1035,
+	-1000, 795, 1515, -1000, 1513, -1000, -1000, -1000, -1000, -1000,
+	254, 1234, 1235, -1000, -1000, -1000, 251, 7531, 5216, 1033,
+	-1000, -1000, 1593, 1607, -1000, -1000, -1000, -1000, 942, 200,
+	-95, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 37, 341,
+	-1000, 1406, 1401, 1606, 1105, -1000, 1558, 27824, 1090, 27824,
+	-1000, -1000, 964, -1000, -1000, 251, 12974, 27824, -1000, -46,
+	-1000, -1000, -1000, -1000, -1000, 1289, 104, 12525, -1000, 1375,
+	-73, -106, 1421, 1431, 1431, 1447, 1603, 1444, 1442, -1000,
+	959, 27824, 1234, 1082, -1000, -1000, -1000, 2163, 942, 1029,
+	385, -1000, -1000, -85, -1000, 141, -1000, -1000, 1061, -1000,
+	1372, -1000, 1417, 804, -1000, -1000, -1000, -1000, 958, -1000,
+	1602, 1598, -1000, -1000, 28273, -1000, -1000, 1306, 218, -1000,
+	-1000, 142, -248, -135, -139, -1000, -1000, 13872, -88, -1000,
+	803, -1000, -1000, -1000, 899, 889, 942, 1305, -1000, 1628,
+	-1000, 713, -1000, -1000, -1000, -1000, -1000, 14321, -110, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1637, 585, 585, 142, -1000,
+	-132, -1000, -1000, -1000, 376, 858, -1000, -1000, -1000, -1000,
+	-1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1990, 1989, 49, 89, 79, 1983, 1982, 1981, 1980,
-	128, 126, 125, 1979, 1978, 1977, 1976, 1975, 1974, 1971,
-	1970, 1969, 1968, 1967, 1966, 60, 132, 36, 33, 134,
-	1964, 1962, 52, 1961, 1960, 1958, 121, 118, 525, 1956,
-	120, 1955, 1953, 1952, 1951, 1948, 1947, 1936, 1932, 1931,
-	1929, 1927, 1926, 1924, 153, 1922, 1921, 7, 1920, 85,
-	1919, 1918, 1916, 1915, 1914, 84, 1913, 1911, 1905, 111,
-	1904, 1903, 46, 86, 42, 74, 1902, 1901, 73, 857,
-	1898, 105, 122, 1897, 2347, 1896, 45, 81, 97, 1895,
-	40, 1894, 1893, 92, 1891, 1890, 1889, 72, 1888, 1887,
-	3402, 1885, 67, 78, 14, 27, 1883, 1882, 1880, 1877,
-	35, 44, 1876, 1875, 16, 1874, 1872, 131, 1871, 83,
-	23, 1870, 13, 12, 18, 1869, 80, 1868, 11, 64,
-	28, 1867, 82, 1866, 1863, 1862, 1860, 30, 1859, 77,
-	102, 99, 1858, 6, 9, 1857, 1856, 1855, 1852, 1845,
-	1842, 4, 1840, 1837, 1836, 26, 1832, 17, 25, 71,
-	127, 29, 8, 1831, 119, 1830, 20, 110, 68, 108,
-	1826, 1822, 1820, 879, 1813, 47, 1812, 141, 1810, 1809,
-	31, 1808, 520, 821, 1806, 1805, 1802, 57, 1041, 2202,
-	24, 109, 1799, 1796, 2082, 58, 75, 22, 1795, 1794,
-	1793, 123, 142, 56, 864, 41, 1790, 1789, 1788, 1787,
-	1786, 1785, 1783, 39, 1782, 1777, 1773, 43, 19, 103,
-	21, 1770, 1769, 1768, 1767, 1766, 66, 34, 1765, 107,
-	106, 70, 117, 1764, 116, 100, 62, 1763, 55, 1762,
-	1761, 1759, 1757, 37, 1754, 1752, 1738, 1737, 104, 87,
-	65, 38, 1735, 32, 96, 101, 91, 1734, 15, 133,
-	10, 1733, 3, 0, 1732, 5, 113, 1559, 112, 1731,
-	1729, 1, 1728, 2, 1727, 1726, 76, 1724, 1723, 1722,
-	1721, 2772, 1751, 115, 1720, 124,
+	0, 1938, 1937, 19, 111, 90, 123, 121, 128, 1936,
+	3, 6, 1933, 1932, 1931, 1929, 1, 1928, 1927, 1926,
+	1925, 1924, 1923, 1922, 146, 142, 141, 1921, 1920, 1919,
+	1916, 1915, 1914, 1911, 1910, 1909, 1908, 1907, 1905, 63,
+	136, 36, 39, 137, 1904, 1903, 68, 1900, 1899, 1898,
+	132, 126, 560, 1896, 129, 1895, 1894, 1893, 1892, 1891,
+	1888, 1887, 1871, 1869, 1868, 1867, 1866, 1865, 1182, 1856,
+	1854, 13, 1852, 96, 1851, 1838, 1837, 1836, 1834, 92,
+	1832, 1831, 1830, 124, 1829, 1828, 58, 118, 42, 83,
+	1827, 1826, 80, 922, 1824, 108, 144, 1823, 73, 1822,
+	41, 81, 78, 1821, 53, 1820, 1819, 100, 1818, 1817,
+	1811, 82, 1810, 1806, 65, 1804, 77, 88, 17, 26,
+	1802, 1801, 1798, 1796, 35, 1767, 1795, 1794, 21, 1793,
+	1792, 147, 1791, 93, 9, 1790, 16, 18, 23, 1789,
+	86, 1787, 48, 66, 31, 1782, 89, 1780, 1779, 1777,
+	1776, 11, 1775, 85, 112, 47, 84, 8, 15, 1774,
+	1773, 1772, 1771, 1770, 1769, 5, 1768, 1766, 1765, 29,
+	1763, 37, 25, 79, 46, 30, 7, 1762, 134, 1761,
+	27, 130, 76, 116, 1757, 1756, 1755, 974, 1754, 59,
+	1753, 152, 1748, 1747, 51, 1746, 549, 889, 1745, 1744,
+	1743, 64, 1362, 2103, 28, 119, 1742, 1741, 2966, 61,
+	91, 24, 1739, 1738, 1735, 138, 274, 56, 923, 57,
+	1734, 1732, 1729, 1728, 1727, 1726, 1725, 207, 1724, 1723,
+	1722, 43, 33, 109, 32, 1721, 1719, 1717, 1716, 1714,
+	67, 38, 1713, 115, 113, 74, 103, 1712, 125, 106,
+	72, 1711, 50, 1708, 1707, 1706, 1704, 40, 1697, 1694,
+	1693, 1692, 107, 105, 69, 34, 1691, 45, 110, 114,
+	101, 1690, 22, 133, 14, 1689, 4, 0, 1688, 10,
+	131, 1478, 104, 1687, 1678, 12, 1676, 2, 1674, 1673,
+	87, 1672, 1667, 1665, 1664, 3085, 252, 120, 1662, 140,
 }
 
 var yyR1 = [...]int{
-	0, 279, 280, 280, 1, 1, 1, 1, 1, 1,
+	0, 293, 294, 294, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 263, 263, 263, 266, 266, 21,
-	50, 3, 3, 3, 3, 2, 2, 8, 9, 4,
-	5, 5, 10, 10, 61, 61, 11, 12, 12, 12,
-	12, 283, 283, 95, 95, 93, 93, 94, 94, 159,
-	159, 13, 14, 14, 169, 169, 168, 168, 168, 170,
-	170, 170, 170, 204, 204, 15, 15, 15, 15, 15,
-	70, 70, 265, 265, 264, 262, 262, 261, 261, 260,
-	23, 24, 33, 33, 33, 33, 34, 35, 267, 267,
-	239, 39, 39, 38, 38, 38, 38, 40, 40, 37,
-	37, 36, 36, 241, 241, 228, 228, 240, 240, 240,
-	240, 240, 240, 240, 227, 206, 206, 206, 206, 209,
-	209, 207, 207, 207, 207, 207, 207, 207, 207, 207,
-	208, 208, 208, 208, 208, 210, 210, 210, 210, 210,
-	211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 211, 211, 212, 212, 212, 212, 212,
-	212, 212, 212, 226, 226, 213, 213, 219, 219, 220,
-	220, 220, 222, 222, 223, 223, 184, 184, 184, 215,
-	215, 216, 216, 221, 221, 217, 217, 217, 218, 218,
-	218, 225, 225, 225, 225, 225, 214, 214, 229, 253,
-	253, 252, 252, 250, 250, 250, 250, 238, 238, 247,
-	247, 247, 247, 247, 237, 237, 233, 233, 233, 234,
-	234, 235, 235, 232, 232, 236, 236, 249, 249, 248,
-	230, 230, 231, 231, 255, 255, 255, 255, 256, 272,
-	273, 271, 271, 271, 271, 271, 59, 59, 59, 185,
-	185, 185, 245, 245, 244, 244, 244, 246, 246, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 179, 179,
-	179, 270, 270, 270, 270, 270, 270, 269, 269, 269,
-	242, 242, 242, 268, 268, 129, 129, 130, 130, 30,
-	30, 30, 30, 30, 30, 29, 29, 29, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	31, 31, 26, 26, 26, 26, 26, 26, 26, 26,
-	26, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 224, 224,
-	224, 257, 257, 258, 258, 17, 22, 22, 18, 18,
-	18, 18, 19, 19, 41, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 176, 176, 274, 274, 178, 178,
-	174, 174, 177, 177, 175, 175, 175, 180, 180, 180,
-	181, 181, 278, 278, 278, 43, 43, 45, 45, 46,
-	47, 47, 199, 199, 200, 200, 48, 49, 60, 60,
-	60, 60, 60, 60, 62, 62, 62, 7, 7, 7,
-	7, 56, 56, 56, 6, 6, 44, 44, 51, 275,
-	275, 276, 277, 277, 277, 277, 52, 20, 20, 20,
-	20, 20, 20, 77, 77, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 71, 71, 71,
-	66, 66, 284, 54, 55, 55, 69, 69, 69, 63,
-	63, 63, 68, 68, 68, 74, 74, 76, 76, 76,
-	76, 76, 78, 78, 78, 78, 78, 78, 73, 73,
-	75, 75, 75, 75, 192, 192, 192, 191, 191, 85,
-	85, 86, 86, 87, 87, 88, 88, 88, 127, 103,
-	103, 158, 158, 157, 157, 160, 160, 89, 89, 89,
-	89, 90, 90, 91, 91, 92, 92, 198, 198, 197,
-	197, 197, 196, 196, 96, 96, 96, 98, 97, 97,
-	97, 97, 99, 99, 101, 101, 100, 100, 102, 104,
-	104, 104, 104, 104, 105, 105, 84, 84, 84, 84,
-	84, 84, 84, 84, 172, 172, 107, 107, 106, 106,
-	106, 106, 106, 106, 106, 106, 106, 106, 118, 118,
-	118, 118, 118, 118, 108, 108, 108, 108, 108, 108,
-	108, 72, 72, 119, 119, 119, 126, 120, 120, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 115, 115, 115, 115, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 285, 285, 117, 116,
-	116, 116, 116, 116, 116, 116, 67, 67, 67, 67,
-	67, 203, 203, 203, 205, 205, 205, 205, 205, 205,
-	205, 205, 205, 205, 205, 205, 205, 133, 133, 64,
-	64, 131, 131, 132, 134, 134, 128, 128, 128, 110,
-	110, 110, 110, 110, 110, 110, 110, 112, 112, 112,
-	135, 135, 136, 136, 137, 137, 138, 138, 139, 140,
-	140, 140, 141, 141, 141, 141, 32, 32, 32, 32,
-	32, 27, 27, 27, 27, 28, 28, 28, 79, 79,
-	79, 79, 81, 81, 80, 80, 57, 57, 58, 58,
-	58, 82, 82, 83, 83, 83, 83, 155, 155, 155,
-	142, 142, 142, 142, 147, 147, 147, 143, 143, 145,
-	145, 145, 146, 146, 146, 144, 150, 150, 152, 152,
-	151, 151, 149, 149, 154, 154, 153, 153, 148, 148,
-	109, 109, 109, 109, 109, 156, 156, 156, 156, 161,
-	161, 122, 122, 124, 124, 123, 125, 162, 162, 166,
-	163, 163, 167, 167, 167, 167, 167, 164, 164, 165,
-	165, 193, 193, 193, 171, 171, 182, 182, 183, 183,
-	173, 173, 186, 186, 186, 53, 121, 121, 254, 254,
-	251, 189, 189, 190, 190, 194, 194, 195, 195, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 281, 282, 201, 202, 202, 202,
+	1, 1, 1, 1, 277, 277, 277, 280, 280, 35,
+	64, 3, 3, 3, 3, 3, 2, 2, 2, 6,
+	6, 8, 8, 7, 22, 23, 4, 5, 5, 24,
+	24, 75, 75, 25, 26, 26, 26, 26, 297, 297,
+	109, 109, 107, 107, 108, 108, 173, 173, 27, 28,
+	28, 183, 183, 182, 182, 182, 184, 184, 184, 184,
+	218, 218, 29, 29, 29, 29, 29, 84, 84, 279,
+	279, 278, 276, 276, 275, 275, 274, 37, 38, 47,
+	47, 47, 47, 48, 49, 281, 281, 253, 53, 53,
+	52, 52, 52, 52, 54, 54, 51, 51, 50, 50,
+	255, 255, 242, 242, 254, 254, 254, 254, 254, 254,
+	254, 241, 220, 220, 220, 220, 223, 223, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 222, 222, 222,
+	222, 222, 224, 224, 224, 224, 224, 225, 225, 225,
+	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
+	225, 225, 226, 226, 226, 226, 226, 226, 226, 226,
+	240, 240, 227, 227, 233, 233, 234, 234, 234, 236,
+	236, 237, 237, 198, 198, 198, 229, 229, 230, 230,
+	235, 235, 231, 231, 231, 232, 232, 232, 239, 239,
+	239, 239, 239, 228, 228, 243, 267, 267, 266, 266,
+	264, 264, 264, 264, 252, 252, 261, 261, 261, 261,
+	261, 251, 251, 247, 247, 247, 248, 248, 249, 249,
+	246, 246, 250, 250, 263, 263, 262, 244, 244, 245,
+	245, 269, 269, 269, 269, 270, 286, 287, 285, 285,
+	285, 285, 285, 73, 73, 73, 199, 199, 199, 259,
+	259, 258, 258, 258, 260, 260, 257, 257, 257, 257,
+	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
+	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
+	257, 257, 257, 257, 257, 193, 193, 193, 284, 284,
+	284, 284, 284, 284, 283, 283, 283, 256, 256, 256,
+	282, 282, 143, 143, 144, 144, 44, 44, 44, 44,
+	44, 44, 43, 43, 43, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 39, 39, 39, 45, 45, 40,
+	40, 40, 40, 40, 40, 40, 40, 40, 30, 30,
+	30, 30, 30, 30, 30, 30, 30, 30, 30, 30,
+	30, 30, 30, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 238, 238, 238, 271, 271,
+	272, 272, 31, 36, 36, 32, 32, 32, 32, 33,
+	33, 55, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
+	56, 190, 190, 288, 288, 192, 192, 188, 188, 191,
+	191, 189, 189, 189, 194, 194, 194, 195, 195, 292,
+	292, 292, 57, 57, 59, 59, 60, 61, 61, 213,
+	213, 214, 214, 62, 63, 74, 74, 74, 74, 74,
+	74, 76, 76, 76, 21, 21, 21, 21, 70, 70,
+	70, 20, 20, 58, 58, 65, 289, 289, 290, 291,
+	291, 291, 291, 66, 34, 34, 34, 34, 34, 34,
+	91, 91, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 85, 85, 85, 80, 80, 298,
+	68, 69, 69, 83, 83, 83, 77, 77, 77, 82,
+	82, 82, 88, 88, 90, 90, 90, 90, 90, 92,
+	92, 92, 92, 92, 92, 87, 87, 89, 89, 89,
+	89, 206, 206, 206, 205, 205, 99, 99, 100, 100,
+	101, 101, 102, 102, 102, 141, 117, 117, 172, 172,
+	171, 171, 174, 174, 103, 103, 103, 103, 104, 104,
+	105, 105, 106, 106, 212, 212, 211, 211, 211, 210,
+	210, 110, 110, 110, 112, 111, 111, 111, 111, 113,
+	113, 115, 115, 114, 114, 116, 118, 118, 118, 118,
+	118, 119, 119, 98, 98, 98, 98, 98, 98, 98,
+	98, 186, 186, 121, 121, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 132, 132, 132, 132, 132,
+	132, 122, 122, 122, 122, 122, 122, 122, 86, 86,
+	133, 133, 133, 140, 134, 134, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	129, 129, 129, 129, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 128, 128, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	128, 128, 128, 299, 299, 131, 130, 130, 130, 130,
+	130, 130, 130, 81, 81, 81, 81, 81, 217, 217,
+	217, 219, 219, 219, 219, 219, 219, 219, 219, 219,
+	219, 219, 219, 219, 147, 147, 78, 78, 145, 145,
+	146, 148, 148, 142, 142, 142, 124, 124, 124, 124,
+	124, 124, 124, 124, 126, 126, 126, 149, 149, 150,
+	150, 9, 9, 9, 10, 19, 19, 18, 18, 14,
+	14, 14, 15, 15, 16, 16, 16, 16, 16, 17,
+	17, 17, 12, 12, 13, 13, 11, 151, 151, 152,
+	152, 153, 154, 154, 154, 155, 155, 155, 155, 46,
+	46, 46, 46, 46, 41, 41, 41, 41, 42, 42,
+	42, 93, 93, 93, 93, 95, 95, 94, 94, 71,
+	71, 72, 72, 72, 96, 96, 97, 97, 97, 97,
+	169, 169, 169, 156, 156, 156, 156, 161, 161, 161,
+	157, 157, 159, 159, 159, 160, 160, 160, 158, 164,
+	164, 166, 166, 165, 165, 163, 163, 168, 168, 167,
+	167, 162, 162, 123, 123, 123, 123, 123, 170, 170,
+	170, 170, 175, 175, 136, 136, 138, 138, 137, 139,
+	176, 176, 180, 177, 177, 181, 181, 181, 181, 181,
+	178, 178, 179, 179, 207, 207, 207, 185, 185, 196,
+	196, 197, 197, 187, 187, 200, 200, 200, 67, 135,
+	135, 268, 268, 265, 203, 203, 204, 204, 208, 208,
+	209, 209, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 295,
+	296, 215, 216, 216, 216,
 }
 
 var yyR2 = [...]int{
@@ -4333,97 +4474,101 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 0, 1, 1, 1, 0, 1, 2,
-	3, 5, 6, 6, 7, 4, 6, 5, 7, 8,
-	1, 3, 7, 8, 1, 1, 9, 9, 8, 7,
-	7, 1, 1, 1, 3, 1, 3, 1, 3, 0,
-	4, 3, 5, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 2, 6, 11, 2,
-	0, 2, 0, 2, 1, 0, 2, 1, 3, 3,
-	4, 3, 6, 7, 7, 7, 4, 2, 1, 1,
-	4, 0, 1, 1, 1, 2, 2, 0, 1, 4,
-	4, 4, 4, 2, 4, 1, 3, 1, 1, 3,
-	4, 3, 3, 3, 8, 3, 1, 1, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 2, 2, 2, 1, 2, 2, 2, 2,
-	4, 4, 2, 2, 3, 3, 3, 3, 1, 1,
-	1, 1, 1, 6, 6, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 0, 3, 0, 5, 0,
-	3, 5, 0, 1, 0, 1, 0, 1, 2, 0,
-	2, 0, 3, 0, 1, 0, 2, 2, 0, 2,
-	2, 0, 2, 1, 2, 1, 0, 2, 5, 0,
-	1, 1, 2, 1, 3, 2, 3, 0, 1, 3,
-	3, 3, 4, 2, 0, 2, 1, 1, 1, 1,
-	1, 0, 1, 1, 1, 0, 1, 1, 3, 3,
-	3, 1, 3, 1, 10, 11, 11, 12, 5, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 1, 0,
-	1, 2, 0, 1, 1, 3, 2, 1, 2, 3,
-	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	3, 5, 6, 6, 6, 7, 4, 5, 6, 2,
+	3, 1, 3, 4, 5, 7, 9, 1, 3, 7,
+	8, 1, 1, 9, 9, 8, 7, 7, 1, 1,
+	1, 3, 1, 3, 1, 3, 0, 4, 3, 5,
+	4, 1, 3, 3, 2, 2, 2, 2, 2, 1,
+	1, 1, 2, 2, 6, 11, 2, 0, 2, 0,
+	2, 1, 0, 2, 1, 3, 3, 4, 3, 6,
+	7, 7, 7, 4, 2, 1, 1, 4, 0, 1,
+	1, 1, 2, 2, 0, 1, 4, 4, 4, 4,
+	2, 4, 1, 3, 1, 1, 3, 4, 3, 3,
+	3, 8, 3, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 2, 1, 2, 2, 2, 2, 4, 4, 2,
+	2, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	6, 6, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
+	1, 0, 1, 0, 1, 2, 0, 2, 0, 3,
+	0, 1, 0, 2, 2, 0, 2, 2, 0, 2,
+	1, 2, 1, 0, 2, 5, 0, 1, 1, 2,
+	1, 3, 2, 3, 0, 1, 3, 3, 3, 4,
+	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
+	1, 10, 11, 11, 12, 5, 3, 3, 1, 1,
+	2, 2, 2, 0, 1, 1, 0, 1, 2, 0,
+	1, 1, 3, 2, 1, 2, 3, 3, 4, 4,
+	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 4, 5, 0, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 0, 2, 0, 2, 0,
-	1, 5, 1, 3, 7, 1, 3, 3, 1, 2,
-	2, 2, 5, 5, 5, 6, 6, 5, 5, 2,
-	2, 2, 2, 3, 3, 3, 4, 1, 3, 5,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 4, 4, 2, 10, 3, 6, 7, 5,
-	5, 5, 12, 7, 5, 9, 5, 3, 7, 4,
-	4, 4, 4, 3, 3, 3, 7, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 2, 0, 2,
-	2, 1, 3, 8, 8, 3, 3, 5, 5, 6,
-	5, 4, 3, 2, 3, 3, 3, 3, 3, 3,
-	3, 4, 2, 4, 4, 4, 4, 4, 5, 7,
-	4, 4, 4, 4, 4, 4, 4, 4, 4, 2,
-	4, 7, 2, 4, 5, 4, 3, 3, 5, 2,
-	3, 3, 3, 3, 1, 1, 1, 1, 0, 1,
-	0, 1, 1, 1, 0, 2, 2, 0, 2, 2,
-	0, 2, 0, 1, 1, 2, 1, 1, 2, 1,
-	1, 5, 0, 1, 0, 1, 2, 3, 0, 3,
-	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 1, 1, 3, 3, 2, 2, 3, 1,
-	3, 2, 1, 2, 1, 2, 2, 3, 3, 6,
-	4, 7, 6, 1, 3, 2, 2, 2, 2, 1,
-	1, 1, 3, 2, 1, 1, 1, 0, 1, 1,
-	0, 3, 0, 2, 0, 2, 1, 2, 2, 0,
-	1, 1, 0, 1, 1, 0, 1, 0, 1, 2,
-	3, 4, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
-	2, 1, 3, 1, 1, 1, 3, 3, 3, 3,
-	7, 0, 3, 1, 3, 1, 3, 4, 4, 4,
-	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
-	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
-	2, 3, 2, 2, 2, 1, 1, 3, 3, 0,
-	5, 4, 5, 5, 0, 2, 1, 3, 3, 3,
-	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
-	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
-	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 3, 1,
-	1, 1, 1, 4, 5, 5, 6, 4, 4, 6,
-	6, 6, 8, 8, 8, 8, 9, 8, 5, 4,
+	3, 3, 3, 4, 5, 0, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 0, 2, 0, 2, 0, 1, 5, 1,
+	3, 7, 1, 3, 3, 1, 2, 2, 2, 5,
+	5, 5, 6, 6, 5, 5, 2, 2, 2, 2,
+	3, 3, 3, 4, 1, 3, 5, 1, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 4,
+	4, 2, 10, 3, 6, 7, 5, 5, 5, 12,
+	7, 5, 9, 5, 3, 7, 4, 4, 4, 4,
+	3, 3, 3, 7, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 2, 0, 2, 2, 1, 3,
+	8, 8, 3, 3, 5, 5, 6, 5, 4, 3,
+	2, 3, 3, 3, 3, 3, 3, 3, 4, 2,
+	4, 4, 4, 4, 4, 5, 7, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 2, 4, 7, 2,
+	4, 5, 4, 3, 3, 5, 2, 3, 3, 3,
+	3, 1, 1, 1, 1, 0, 1, 0, 1, 1,
+	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
+	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
+	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
+	1, 3, 3, 2, 2, 3, 1, 3, 2, 1,
+	2, 1, 2, 2, 3, 3, 6, 4, 7, 6,
+	1, 3, 2, 2, 2, 2, 1, 1, 1, 3,
+	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
+	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 1, 0, 1, 0, 1, 2, 3, 4, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
+	1, 1, 1, 3, 3, 3, 3, 7, 0, 3,
+	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
+	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
+	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
+	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
+	5, 0, 2, 1, 3, 3, 3, 2, 3, 1,
+	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
+	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
+	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
+	5, 5, 5, 6, 4, 4, 6, 6, 6, 8,
+	8, 8, 8, 9, 8, 5, 4, 2, 2, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 8, 8, 0, 2, 3, 4,
-	4, 4, 4, 4, 4, 4, 0, 3, 4, 7,
-	3, 1, 1, 1, 2, 3, 3, 1, 2, 2,
-	1, 2, 1, 2, 2, 1, 2, 0, 1, 0,
-	2, 1, 2, 4, 0, 2, 1, 3, 5, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	0, 3, 0, 2, 0, 3, 1, 3, 2, 0,
-	1, 1, 0, 2, 4, 4, 0, 2, 2, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 0, 3,
-	3, 3, 0, 3, 1, 1, 0, 4, 0, 1,
-	1, 0, 3, 1, 3, 2, 1, 0, 2, 4,
-	0, 9, 3, 5, 0, 3, 3, 0, 1, 0,
-	2, 2, 0, 2, 2, 2, 0, 3, 0, 3,
-	0, 3, 0, 4, 0, 3, 0, 4, 0, 1,
-	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
-	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
-	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 1, 0, 1, 1, 5, 0, 1, 0, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 8, 8, 0, 2, 3, 4, 4, 4, 4,
+	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
+	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
+	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
+	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
+	2, 0, 2, 4, 4, 0, 1, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 3, 0, 2, 1, 3, 5, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
+	2, 2, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 0, 3, 3, 3, 0, 3, 1, 1, 0,
+	4, 0, 1, 1, 0, 3, 1, 3, 2, 1,
+	0, 2, 4, 0, 9, 3, 5, 0, 3, 3,
+	0, 1, 0, 2, 2, 0, 2, 2, 2, 0,
+	3, 0, 3, 0, 3, 0, 4, 0, 3, 0,
+	4, 0, 1, 2, 1, 5, 4, 4, 1, 3,
+	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
+	1, 3, 3, 1, 3, 3, 3, 3, 3, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 0, 3, 0, 1, 0, 1, 1, 5, 0,
+	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4463,257 +4608,262 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 0, 1, 1,
+	1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -279, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
-	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -4, -281, 6, 7, 8, -61, 10, 11,
-	31, -23, -33, 153, -34, -24, 154, -35, 156, 155,
-	191, 157, 184, 71, 225, 226, 228, 229, 230, 231,
-	-62, 189, 190, 159, 35, 42, 32, 33, 36, 81,
-	9, 323, 186, 185, 26, -280, 463, -69, 5, -137,
-	16, -3, -54, -284, -54, -54, -54, -54, -54, -54,
-	-239, -241, 81, 126, 81, -70, 163, 164, 173, 172,
-	169, -267, 107, 314, 162, -39, -38, -37, -36, -40,
-	30, -30, -31, -259, -29, -26, 158, 155, 199, 102,
-	103, 191, 192, 193, 157, 175, 190, 194, 189, 208,
-	-25, 77, 32, 336, 339, -246, 154, 160, 161, 324,
-	105, 104, 72, 156, -243, 275, 440, -40, 442, 95,
-	97, 441, 41, 164, 443, 444, 445, 446, 174, 447,
-	448, 449, 450, 456, 457, 458, 459, 106, 5, 163,
-	-267, -79, 285, 77, -266, -263, 84, 85, 86, 163,
-	163, 164, 165, -267, 163, -100, -194, -263, -188, 333,
-	177, 367, 368, 223, 77, 275, 440, 225, 239, 233,
-	260, 252, 334, 369, 178, 212, 437, 250, 253, 301,
-	442, 370, 192, 297, 280, 288, 95, 228, 310, 455,
-	371, 453, 97, 441, 76, 48, 41, 187, 248, 244,
-	443, 213, 372, 344, 206, 105, 102, 462, 242, 47,
-	28, 452, 104, 46, 444, 373, 445, 290, 265, 431,
-	45, 193, 374, 80, 338, 439, 292, 243, 289, 222,
-	451, 159, 375, 423, 299, 432, 376, 266, 270, 377,
-	302, 49, 378, 379, 433, 103, 380, 75, 446, 237,
-	238, 381, 220, 176, 304, 264, 174, 34, 293, 335,
-	224, 55, 200, 305, 43, 268, 42, 427, 382, 430,
-	263, 259, 50, 383, 384, 385, 386, 447, 262, 236,
-	258, 461, 218, 448, 59, 161, 272, 271, 273, 207,
-	300, 255, 387, 388, 389, 181, 78, 390, 245, 19,
-	391, 392, 214, 393, 53, 394, 395, 308, 190, 396,
-	51, 449, 38, 195, 450, 397, 398, 399, 400, 401,
-	298, 402, 291, 267, 269, 202, 287, 337, 403, 241,
-	194, 454, 404, 182, 438, 196, 199, 189, 309, 183,
-	405, 406, 407, 408, 409, 410, 411, 229, 456, 40,
-	412, 413, 414, 415, 221, 217, 303, 312, 58, 79,
-	277, 416, 436, 235, 215, 417, 226, 52, 457, 458,
-	459, 209, 460, 283, 106, 219, 44, 256, 201, 418,
-	419, 246, 247, 261, 234, 257, 227, 424, 203, 191,
-	420, 311, 216, 278, 341, 208, 435, 340, 254, 251,
-	210, 421, 165, 204, 205, 422, 425, 294, 284, 295,
-	296, 285, 211, 339, 249, 279, 163, -164, 280, 281,
-	282, 293, 294, 299, 298, 202, -278, 302, 163, -174,
-	144, 153, 290, -178, 291, 284, 285, 211, -274, -263,
-	445, 460, 301, 253, 303, 427, 286, 292, 296, 295,
-	-194, 227, -199, 232, -189, -263, -188, 230, -100, -60,
-	423, 157, -201, -201, -71, 427, 429, -120, -84, -106,
-	110, -111, 30, 24, -110, -107, -128, -125, -126, 144,
-	145, 147, 146, 148, 133, 134, 141, 111, 149, -115,
-	-113, -114, -116, 88, 87, 96, 89, 90, 91, 92,
-	98, 99, 100, -189, -194, -123, -281, 65, 66, 324,
-	325, 326, 327, 332, 328, 113, 54, 313, 322, 321,
-	320, 317, 318, 315, 316, 330, 331, 168, 314, 162,
-	139, 323, -263, -188, 41, 283, 283, -100, -5, -4,
-	-281, 6, 21, 22, -141, 18, 17, -282, 83, -63,
-	-76, 60, 61, -78, 22, 37, 64, 62, -55, -75,
-	135, -84, -194, -75, -173, 167, -173, -173, -163, -204,
-	227, -167, 303, 302, -190, -165, -189, -187, -164, 300,
-	158, 342, 109, 23, 25, 112, 144, 17, 113, 36,
-	160, 175, 143, 171, 324, 153, 69, 343, 315, 316,
-	313, 319, 326, 327, 314, 281, 30, 11, 345, 26,
-	185, 22, 37, 137, 155, 116, 117, 188, 24, 186,
-	100, 348, 20, 72, 180, 12, 173, 14, 349, 350,
-	15, 168, 167, 128, 164, 67, 9, 149, 27, 125,
-	63, 351, 29, 352, 353, 354, 355, 65, 126, 18,
-	317, 318, 32, 428, 356, 332, 197, 139, 70, 56,
-	429, 110, 357, 358, 98, 359, 101, 73, 434, 107,
-	16, 68, 39, 360, 198, 361, 170, 362, 306, 363,
-	127, 156, 323, 66, 364, 162, 282, 6, 329, 31,
-	184, 172, 64, 365, 163, 115, 330, 331, 166, 99,
-	5, 169, 33, 10, 71, 74, 320, 321, 322, 54,
-	336, 114, 13, 366, 307, 108, 301, 253, -240, 126,
-	-227, -231, -189, 179, -256, 175, -100, -249, -248, -189,
-	-79, -183, 168, -263, 164, 164, 164, -183, 323, -36,
-	-37, -164, 143, 196, 82, 82, -231, -230, -229, -268,
-	198, 179, -255, -247, 171, 180, -237, 172, 173, -232,
-	164, 29, -268, -232, 170, 180, 198, 198, 106, 198,
-	106, 198, 198, 198, 198, 198, 198, 198, 198, 198,
-	195, -238, 118, -238, 340, 340, -243, -268, -268, -268,
-	166, 34, 34, -186, -232, 166, 23, -238, -238, -164,
-	143, -238, -238, -238, -238, 206, 206, -238, -238, -238,
-	-238, -238, -238, -238, -238, -238, -238, -238, -238, -238,
-	-238, -238, -100, -82, 213, 153, 155, 158, 73, 118,
-	-38, 208, -22, -100, -182, 168, -263, -182, -182, -100,
-	150, -100, -180, 126, 13, -180, -180, -180, -180, -180,
-	209, 297, 209, 297, 209, 210, 209, 210, 209, -177,
-	-176, 288, 289, 283, 287, -263, 430, 314, 299, -263,
-	202, 163, 203, 165, -233, 164, 34, 176, 210, 283,
-	205, -180, -202, -281, -190, -202, -202, 31, 166, -189,
-	-56, -189, 88, -7, -3, -11, -10, -12, 118, -77,
-	283, -65, 144, 445, 431, 432, 433, 430, 298, 438,
-	436, 434, 209, 435, 82, 109, 107, 108, 125, -84,
-	-108, 128, 110, 126, 127, 112, 130, 129, 140, 133,
-	134, 135, 136, 137, 138, 139, 131, 132, 143, 118,
-	119, 120, 121, 122, 123, 124, -172, -281, -126, -281,
-	151, 152, -111, -111, -111, -111, -111, -111, -111, -111,
-	-111, -111, -281, 150, -2, -120, -4, -281, -281, -281,
-	-281, -281, -281, -281, -281, -133, -84, -281, -285, -117,
-	-281, -285, -117, -285, -117, -285, -281, -285, -117, -285,
-	-117, -285, -285, -117, -281, -281, -281, -281, -281, -281,
-	-281, -201, -275, -276, -103, -100, -281, -137, -3, -54,
-	-155, 20, 32, -84, -138, -139, -84, -137, 56, -73,
-	-75, -78, 60, 61, 94, 12, -192, -191, 23, -189,
-	88, 150, 12, -101, 27, -100, -86, -87, -88, -89,
-	-103, -127, -281, 12, -93, -94, -100, -102, -194, 82,
-	227, -167, -204, -169, -168, 304, 306, 118, -193, -189,
-	88, 30, 83, 82, -100, -206, -209, -211, -210, -212,
-	-207, -208, 250, 251, 144, 254, 256, 257, 258, 259,
-	260, 261, 262, 263, 264, 265, 31, 187, 246, 247,
-	248, 249, 266, 267, 268, 269, 270, 271, 272, 273,
-	233, 252, 334, 234, 235, 236, 237, 238, 239, 241,
-	242, 243, 244, 245, -266, -263, 81, 83, 82, -213,
-	81, -82, -100, 110, -254, -251, 74, -263, -263, -263,
-	-263, -238, -238, 195, -29, -26, -259, 16, -25, -26,
-	158, 102, 103, 155, 81, -227, 81, -236, -266, -263,
-	81, 29, 170, 169, -235, -232, -235, -236, -263, -128,
-	-189, -194, -263, 29, 29, -160, -189, -160, -160, 21,
-	-160, 21, -160, 21, 89, -189, -160, 21, -160, 21,
-	-160, 21, -160, 21, -160, 21, 30, 75, 76, 30,
-	78, 79, 80, -128, -128, -227, -164, -100, -263, 89,
-	89, -238, -238, 89, 88, 88, 88, -238, -238, 89,
-	88, -263, 88, -269, 181, 222, 224, 89, 89, 89,
-	89, 30, 88, -270, 30, 452, 451, 453, 454, 455,
-	89, 30, 89, 30, 89, -189, 81, -81, 215, 118,
-	204, 204, 163, 163, 217, -100, 216, 218, 219, 41,
-	82, 166, -93, 24, 73, -95, -100, -263, -195, -194,
-	-187, 88, -84, -180, -100, -180, -100, -180, -180, -180,
-	-180, -175, 12, 128, -234, 12, 128, -175, -202, -202,
-	-202, -100, -202, -202, -100, -202, -202, -234, -181, 126,
-	73, -200, 230, 264, 424, 425, 426, 82, 336, -93,
-	430, 430, 430, 430, 430, 430, -84, -84, -84, -84,
-	-118, 98, 110, 99, 100, -111, -119, -123, -126, 93,
-	128, 126, 127, 112, -111, -111, -111, -111, -111, -111,
-	-111, -111, -111, -111, -111, -111, -111, -111, -111, -203,
-	-263, 88, 144, -263, -110, -110, -189, -74, 22, 37,
-	-73, -190, -195, -187, -69, -282, -282, -137, -73, -73,
-	-84, -84, -128, 88, -73, -128, 88, -73, -73, -68,
-	22, 37, -131, -132, 114, -128, -282, -111, -189, -189,
-	-73, -74, -74, -73, -73, 82, -277, 306, 307, 428,
-	-197, 198, -196, 23, -194, 88, -121, -120, -141, -282,
-	-142, 27, 10, 128, 82, 19, 82, -140, 25, 26,
-	-141, -112, -189, 89, 92, -85, 82, 12, -78, -100,
-	-191, 135, -195, -100, -159, 198, -100, 31, 82, -96,
-	-98, -97, -99, 63, 67, 69, 64, 65, 66, 70,
-	-198, 23, -86, -3, -281, -100, -93, -283, 82, 12,
-	74, -283, 82, 150, -167, -169, 82, 305, 307, 308,
-	73, 101, -84, -218, 143, -245, -244, -243, -227, -229,
-	-230, -231, 83, -184, 98, 110, -222, 278, -213, -213,
-	-213, -213, -213, -217, -164, -217, -217, -217, 81, 81,
-	-213, -213, -213, -213, -219, 81, -219, -219, -220, 81,
-	-220, -256, -84, -253, -252, -250, -251, 174, 95, 336,
-	-248, -140, 89, -81, 24, 73, -189, -254, -254, -254,
-	-263, 88, -263, 88, 82, 17, -228, -227, -129, 222,
-	-258, 198, -255, -249, 81, 29, -235, -236, -236, 150,
-	-263, 82, 27, 106, 106, 106, 106, 336, 155, 31,
-	-227, -129, -203, 166, -203, -203, 88, 88, -179, 460,
-	-93, 165, 221, -83, 319, 88, 84, -100, -100, -100,
-	-100, -100, 158, 155, 206, -100, -100, -59, 183, 178,
-	-100, 82, -59, -180, -194, -194, -100, -180, -100, 88,
-	-100, -189, -65, 306, 336, 20, -66, 20, 98, 99,
-	100, -119, -111, -111, -111, -72, 188, 109, -282, -282,
-	-73, -73, -281, 150, -5, -141, -282, -282, 82, 74,
-	23, 12, 12, -282, 12, 12, -282, -282, -73, -134,
-	-132, 116, -84, -282, -282, 82, 82, -282, -282, -282,
-	-282, -282, -276, 427, 307, -104, 71, 167, 72, -281,
-	-196, -282, -155, 39, 47, 58, -84, -84, -139, -155,
-	-171, 20, 12, 54, 54, -105, 13, -75, -86, -78,
-	150, -105, -109, 31, 54, -3, -281, -281, -162, -166,
-	-128, -87, -88, -88, -87, -88, 63, 63, 63, 68,
-	63, 68, 63, -97, -194, -282, -282, -3, -159, 74,
-	-86, -100, -86, -102, -194, 135, -168, -170, 309, 306,
-	312, -263, 88, 82, -243, -231, -215, 30, 98, -223,
-	279, -217, -217, -218, -263, 144, -218, -218, -218, -226,
-	88, -226, 89, 89, 83, -32, -27, -28, 32, 77,
-	-250, -238, 88, 38, 83, 165, -100, 73, 73, 73,
-	16, -157, -189, 82, 83, -130, 223, -128, 83, -189,
-	83, -157, -236, -190, -189, -281, 163, 30, 30, -129,
-	-130, -218, -263, 462, 461, 83, -100, -80, 213, 220,
-	81, 85, -265, 74, 204, 275, 204, 207, 166, -32,
-	-100, -175, -175, 32, 306, 439, 437, -72, 109, -111,
-	-111, -282, -282, -74, -190, -137, -155, -205, 144, 250,
-	187, 248, 244, 264, 255, 277, 246, 278, -203, -205,
-	-111, -111, -111, -111, 333, -137, 117, -84, 115, -111,
-	-111, 164, 164, 164, -160, 40, 88, 88, 59, -100,
-	-135, 14, -84, 135, -141, -161, 73, -162, -122, -124,
-	-123, -281, -156, -282, -189, -160, -105, 82, 118, -91,
-	-90, 73, 74, -92, 73, -90, 63, 63, -282, -105,
-	-86, -105, -105, 150, 306, 310, 311, -243, -216, 73,
-	-111, -218, -218, 83, 82, 83, 82, 83, 82, -185,
-	373, 110, -28, -27, -238, -238, 89, -263, -100, -100,
-	-100, -100, 17, 82, -227, -128, 54, -253, 83, -257,
-	-258, -100, -110, -130, -158, 81, 83, -262, 336, -264,
-	-263, -189, -189, -189, -100, -180, -180, 32, -263, -111,
-	-282, -141, -282, -213, -213, -213, -220, -213, 238, -213,
-	238, -282, -282, 20, 20, 20, 20, -281, -64, 329,
-	-84, 82, 82, -281, -281, -281, -282, 88, -217, -136,
-	15, 17, 28, -161, 82, -282, -282, 82, 54, 150,
-	-282, -137, -166, -84, -84, 81, -84, -137, -105, -221,
-	275, 10, -217, 88, -217, 89, 89, 373, 30, 78,
-	79, 80, 30, 75, 76, -158, -157, -189, 200, 182,
-	-282, 82, -224, 336, 339, 23, -157, -261, -260, -190,
-	81, 74, -155, -217, -263, -111, -111, -111, -111, -111,
-	-141, 88, -111, -111, -157, -282, -157, -157, -197, -217,
-	-144, -149, -177, -84, -120, 29, -124, 54, -3, -189,
-	-122, -189, -141, -157, -141, -225, 170, 29, 169, -114,
-	-218, -218, 83, 83, 23, 201, -100, -258, 340, 340,
-	-3, 83, 82, 118, -157, -100, -282, -282, -282, -282,
-	-67, 128, 336, -282, -282, -282, -282, -282, -282, -104,
-	-147, 423, -150, 43, -151, 44, 10, -122, 150, 83,
-	-214, 95, 29, 29, -3, -281, 81, -57, 336, -260,
-	-242, -190, 88, 89, 83, -282, 334, 70, 337, -144,
-	48, 256, -152, 52, -153, -148, 53, 17, -162, -189,
-	88, -57, -111, 197, -157, -58, 212, 427, -265, 59,
-	335, 338, -145, 50, -143, 49, -143, -151, 17, -154,
-	45, 46, 88, -282, -282, 83, 175, -262, 59, -146,
-	51, 73, 101, 88, 17, 17, -272, -273, 73, 214,
-	336, 73, 101, 88, 88, -273, 73, 11, 10, 337,
-	-271, 183, 178, 181, 31, -271, 338, 177, 30, 98,
+	-1000, -293, -1, -3, -22, -23, -24, -25, -26, -27,
+	-28, -29, -30, -31, -32, -33, -55, -56, -57, -59,
+	-60, -61, -62, -63, -20, -58, -34, -35, -64, -65,
+	-66, -67, -4, -6, -295, 6, 7, 8, -75, 10,
+	11, 31, -37, -47, 155, -48, -38, 156, -49, 158,
+	157, 193, 159, 186, 71, 227, 228, 230, 231, 232,
+	233, -76, 191, 192, 161, 35, 42, 32, 33, 36,
+	338, 83, 9, 325, 188, 187, 26, -294, 468, -83,
+	5, -151, 16, -4, 6, -3, -68, -298, -68, -68,
+	-68, -68, -68, -68, -253, -255, 83, 128, 83, -84,
+	165, 166, 175, 174, 171, -281, 109, 316, 164, -53,
+	-52, -51, -50, -54, 30, -44, -45, -273, -43, -40,
+	160, 157, 201, 104, 105, 193, 194, 195, 159, 177,
+	192, 196, 191, 210, -39, 77, 32, 338, 341, -260,
+	156, 162, 163, 326, 107, 106, 72, 158, -257, 277,
+	445, -54, 447, 97, 99, 446, 41, 166, 448, 449,
+	450, 451, 176, 452, 453, 454, 455, 461, 462, 463,
+	464, 108, 5, 165, -281, -93, 287, 77, -280, -277,
+	86, 87, 88, 165, 165, 166, 167, -281, 165, -114,
+	-208, -277, -202, 335, 179, 369, 370, 225, 77, 277,
+	445, 227, 241, 235, 262, 254, 336, 371, 180, 214,
+	442, 252, 255, 303, 447, 372, 194, 299, 282, 290,
+	97, 230, 312, 460, 373, 458, 99, 446, 76, 48,
+	425, 41, 189, 250, 246, 448, 215, 374, 346, 208,
+	107, 104, 467, 244, 47, 28, 457, 106, 46, 449,
+	375, 450, 292, 267, 436, 45, 195, 376, 80, 340,
+	444, 294, 245, 291, 224, 456, 161, 377, 428, 301,
+	437, 378, 268, 272, 379, 304, 49, 380, 381, 438,
+	105, 382, 75, 451, 239, 240, 383, 222, 178, 306,
+	266, 176, 34, 295, 337, 226, 55, 202, 307, 43,
+	270, 42, 432, 384, 435, 265, 261, 50, 385, 386,
+	387, 388, 452, 264, 238, 260, 466, 220, 453, 59,
+	163, 274, 273, 275, 209, 302, 257, 389, 390, 391,
+	183, 78, 392, 247, 19, 393, 394, 216, 395, 53,
+	396, 397, 310, 192, 398, 51, 454, 38, 197, 455,
+	399, 400, 401, 402, 403, 300, 404, 293, 269, 271,
+	204, 289, 339, 405, 243, 196, 459, 406, 184, 443,
+	198, 201, 191, 311, 185, 407, 408, 409, 410, 411,
+	412, 413, 231, 426, 461, 81, 40, 414, 415, 416,
+	417, 223, 219, 305, 314, 58, 79, 279, 418, 441,
+	237, 217, 419, 228, 52, 462, 463, 464, 211, 465,
+	285, 108, 221, 44, 258, 203, 420, 421, 248, 249,
+	263, 236, 259, 229, 429, 205, 193, 422, 313, 218,
+	280, 343, 210, 440, 342, 256, 253, 212, 423, 167,
+	206, 207, 424, 430, 296, 286, 297, 298, 287, 213,
+	341, 251, 281, 165, -178, 282, 283, 284, 295, 296,
+	301, 300, 204, -292, 304, 165, -188, 146, 155, 292,
+	-192, 293, 286, 287, 213, -288, -277, 450, 465, 303,
+	255, 305, 432, 288, 294, 298, 297, -208, 229, -213,
+	234, -203, -277, -202, 232, -114, -74, 428, 159, -215,
+	-215, -85, 432, 434, -134, -98, -120, 112, -125, 30,
+	24, -124, -121, -142, -139, -140, 146, 147, 149, 148,
+	150, 135, 136, 143, 113, 151, -129, -127, -128, -130,
+	90, 89, 98, 91, 92, 93, 94, 100, 101, 102,
+	-203, -208, -137, -295, 65, 66, 326, 327, 328, 329,
+	334, 330, 115, 54, 315, 324, 323, 322, 319, 320,
+	317, 318, 332, 333, 170, 316, 164, 141, 325, -277,
+	-202, 41, 285, 285, -114, -8, 365, -7, -208, -5,
+	-4, -295, 21, 22, -155, 18, 17, -151, -68, -296,
+	85, -77, -90, 60, 61, -92, 22, 37, 64, 62,
+	-69, -89, 137, -98, -208, -89, -187, 169, -187, -187,
+	-177, -218, 229, -181, 305, 304, -204, -179, -203, -201,
+	-178, 302, 160, 344, 111, 23, 25, 114, 146, 17,
+	115, 36, 162, 177, 145, 173, 326, 155, 69, 345,
+	317, 318, 315, 321, 328, 329, 316, 283, 30, 11,
+	347, 26, 187, 22, 37, 139, 157, 118, 119, 190,
+	24, 188, 102, 350, 20, 72, 182, 12, 175, 14,
+	351, 352, 15, 170, 169, 130, 166, 67, 9, 151,
+	27, 127, 63, 353, 29, 354, 355, 356, 357, 65,
+	128, 18, 319, 320, 32, 433, 358, 334, 199, 141,
+	70, 56, 434, 112, 359, 360, 100, 361, 103, 73,
+	439, 109, 16, 68, 39, 362, 200, 363, 172, 427,
+	364, 308, 365, 129, 158, 325, 66, 366, 164, 284,
+	6, 331, 31, 186, 174, 64, 367, 165, 117, 332,
+	333, 168, 101, 5, 171, 33, 10, 71, 74, 322,
+	323, 324, 54, 338, 116, 13, 368, 309, 110, 303,
+	255, -254, 128, -241, -245, -203, 181, -270, 177, -114,
+	-263, -262, -203, -93, -197, 170, -277, 166, 166, 166,
+	-197, 325, -50, -51, -178, 145, 198, 84, 84, -245,
+	-244, -243, -282, 200, 181, -269, -261, 173, 182, -251,
+	174, 175, -246, 166, 29, -282, -246, 172, 182, 200,
+	200, 108, 200, 108, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 197, -252, 120, -252, 342, 342, -257,
+	-282, -282, -282, 168, 34, 34, -200, -246, 168, 23,
+	-252, -252, -178, 145, -252, -252, -252, -252, 208, 208,
+	-252, -252, -252, -252, -252, -252, -252, -252, -252, -252,
+	-252, -252, -252, -252, -252, -114, -96, 215, 155, 157,
+	160, 73, 120, -52, 210, -36, -114, -196, 170, -277,
+	-196, -196, -114, 152, -114, -194, 128, 13, -194, -194,
+	-194, -194, -194, 211, 299, 211, 299, 211, 212, 211,
+	212, 211, -191, -190, 290, 291, 285, 289, -277, 435,
+	316, 301, -277, 204, 165, 205, 167, -247, 166, 34,
+	178, 212, 285, 207, -194, -216, -295, -204, -216, -216,
+	31, 168, -203, -70, -203, 90, -21, -3, -25, -24,
+	-26, 120, -91, 285, -79, 146, 450, 436, 437, 438,
+	435, 300, 443, 441, 439, 211, 440, 84, 111, 109,
+	110, 127, -98, -122, 130, 112, 128, 129, 114, 132,
+	131, 142, 135, 136, 137, 138, 139, 140, 141, 133,
+	134, 145, 120, 121, 122, 123, 124, 125, 126, -186,
+	-295, -140, -295, 153, 154, -125, -125, -125, -125, -125,
+	-125, -125, -125, -125, -125, -295, 152, -2, -134, -4,
+	-6, -295, -295, -295, -295, -295, -295, -295, -295, -147,
+	-98, -295, -299, -131, -295, -299, -131, -299, -131, -299,
+	-295, -299, -131, -299, -131, -299, -299, -131, -295, -295,
+	-295, -295, -295, -295, -295, -215, -289, -290, -117, -114,
+	-295, 84, -8, -172, 83, -151, -3, -169, 20, 32,
+	-98, -152, -153, -98, -155, 60, 61, -151, 56, -87,
+	-89, -92, 96, 12, -206, -205, 23, -203, 90, 152,
+	12, -115, 27, -114, -100, -101, -102, -103, -117, -141,
+	-295, 12, -107, -108, -114, -116, -208, 84, 229, -181,
+	-218, -183, -182, 306, 308, 120, -207, -203, 90, 30,
+	85, 84, -114, -220, -223, -225, -224, -226, -221, -222,
+	252, 253, 146, 256, 258, 259, 260, 261, 262, 263,
+	264, 265, 266, 267, 31, 189, 248, 249, 250, 251,
+	268, 269, 270, 271, 272, 273, 274, 275, 235, 254,
+	336, 236, 237, 238, 239, 240, 241, 243, 244, 245,
+	246, 247, -280, -277, 83, 85, 84, -227, 83, -96,
+	-114, 112, -268, -265, 74, -277, -277, -277, -277, -252,
+	-252, 197, -43, -40, -273, 16, -39, -40, 160, 104,
+	105, 157, 83, -241, 83, -250, -280, -277, 83, 29,
+	172, 171, -249, -246, -249, -250, -277, -142, -203, -208,
+	-277, 29, 29, -174, -203, -174, -174, 21, -174, 21,
+	-174, 21, 91, -203, -174, 21, -174, 21, -174, 21,
+	-174, 21, -174, 21, 30, 75, 76, 30, 78, 79,
+	80, -142, -142, -241, -178, -114, -277, 91, 91, -252,
+	-252, 91, 90, 90, 90, -252, -252, 91, 90, -277,
+	90, -283, 183, 224, 226, 91, 91, 91, 91, 30,
+	90, -284, 30, 457, 456, 458, 459, 460, 91, 30,
+	91, 30, 91, -203, 83, -95, 217, 120, 206, 206,
+	165, 165, 219, -114, 218, 220, 221, 41, 84, 168,
+	-107, 24, 73, -109, -114, -277, -209, -208, -201, 90,
+	-98, -194, -114, -194, -114, -194, -194, -194, -194, -189,
+	12, 130, -248, 12, 130, -189, -216, -216, -216, -114,
+	-216, -216, -114, -216, -216, -248, -195, 128, 73, -214,
+	232, 266, 429, 430, 431, 84, 338, -107, 435, 435,
+	435, 435, 435, 435, -98, -98, -98, -98, -132, 100,
+	112, 101, 102, -125, -133, -137, -140, 95, 130, 128,
+	129, 114, -125, -125, -125, -125, -125, -125, -125, -125,
+	-125, -125, -125, -125, -125, -125, -125, -217, -277, 90,
+	146, -277, -124, -124, -203, -88, 22, 37, -87, -204,
+	-209, -201, -83, -296, -296, -151, -4, -87, -87, -98,
+	-98, -142, 90, -87, -142, 90, -87, -87, -82, 22,
+	37, -145, -146, 116, -142, -296, -125, -203, -203, -87,
+	-88, -88, -87, -87, 84, -291, 308, 309, 433, -211,
+	200, -210, 23, -208, 90, -135, -134, -7, 23, -171,
+	-203, -155, -296, -156, 27, 10, 130, 84, 19, 84,
+	-154, 25, 26, -169, -155, -126, -203, 91, 94, -99,
+	84, 12, -92, -114, -205, 137, -209, -114, -173, 200,
+	-114, 31, 84, -110, -112, -111, -113, 63, 67, 69,
+	64, 65, 66, 70, -212, 23, -100, -3, -295, -114,
+	-107, -297, 84, 12, 74, -297, 84, 152, -181, -183,
+	84, 307, 309, 310, 73, 103, -98, -232, 145, -259,
+	-258, -257, -241, -243, -244, -245, 85, -198, 100, 112,
+	-236, 280, -227, -227, -227, -227, -227, -231, -178, -231,
+	-231, -231, 83, 83, -227, -227, -227, -227, -233, 83,
+	-233, -233, -234, 83, -234, -270, -98, -267, -266, -264,
+	-265, 176, 97, 338, -262, -154, 91, -95, 24, 73,
+	-203, -268, -268, -268, -277, 90, -277, 90, 84, 17,
+	-242, -241, -143, 224, -272, 200, -269, -263, 83, 29,
+	-249, -250, -250, 152, -277, 84, 27, 108, 108, 108,
+	108, 338, 157, 31, -241, -143, -217, 168, -217, -217,
+	90, 90, -193, 465, -107, 167, 223, -97, 321, 90,
+	86, -114, -114, -114, -114, -114, 160, 157, 208, -114,
+	-114, -73, 185, 180, -114, 84, -73, -194, -208, -208,
+	-114, -194, -114, 90, -114, -203, -79, 308, 338, 20,
+	-80, 20, 100, 101, 102, -133, -125, -125, -125, -86,
+	190, 111, -296, -296, -87, -87, -295, 152, -5, -155,
+	-151, -296, -296, 84, 74, 23, 12, 12, -296, 12,
+	12, -296, -296, -87, -148, -146, 118, -98, -296, -296,
+	84, 84, -296, -296, -296, -296, -296, -290, 432, 309,
+	-118, 71, 169, 72, -295, -210, -296, -140, 85, 84,
+	-169, 39, 47, 58, -98, -98, -153, -156, -169, -185,
+	20, 12, 54, 54, -119, 13, -89, -100, -92, 152,
+	-119, -123, 31, 54, -3, -295, -295, -176, -180, -142,
+	-101, -102, -102, -101, -102, 63, 63, 63, 68, 63,
+	68, 63, -111, -208, -296, -296, -3, -173, 74, -100,
+	-114, -100, -116, -208, 137, -182, -184, 311, 308, 314,
+	-277, 90, 84, -257, -245, -229, 30, 100, -237, 281,
+	-231, -231, -232, -277, 146, -232, -232, -232, -240, 90,
+	-240, 91, 91, 85, -46, -41, -42, 32, 77, -264,
+	-252, 90, 38, 85, 167, -114, 73, 73, 73, 16,
+	-171, 84, 85, -144, 225, -142, 85, -203, 85, -171,
+	-250, -204, -203, -295, 165, 30, 30, -143, -144, -232,
+	-277, 467, 466, 85, -114, -94, 215, 222, 83, 87,
+	-279, 74, 206, 277, 206, 209, 168, -46, -114, -189,
+	-189, 32, 308, 444, 442, -86, 111, -125, -125, -9,
+	362, -296, -296, -88, -204, -151, -169, -155, -219, 146,
+	252, 189, 250, 246, 266, 257, 279, 248, 280, -217,
+	-219, -125, -125, -125, -125, 335, -151, 119, -98, 117,
+	-125, -125, 166, 166, 166, -174, -203, 40, 90, 90,
+	59, -114, -149, 14, -98, 137, -155, -175, 73, -176,
+	-136, -138, -137, -295, -170, -296, -203, -174, -119, 84,
+	120, -105, -104, 73, 74, -106, 73, -104, 63, 63,
+	-296, -119, -100, -119, -119, 152, 308, 312, 313, -257,
+	-230, 73, -125, -232, -232, 85, 84, 85, 84, 85,
+	84, -199, 375, 112, -42, -41, -252, -252, 91, -277,
+	-114, -114, -114, -114, 17, -241, -142, 54, -267, 85,
+	-271, -272, -114, -124, -144, -172, 85, -276, 338, -278,
+	-277, -203, -203, -203, -114, -194, -194, 32, -277, -125,
+	-203, -295, -296, -155, -169, -296, -227, -227, -227, -234,
+	-227, 240, -227, 240, -296, -296, 20, 20, 20, 20,
+	-295, -78, 331, -98, 84, 84, -295, -295, -295, -296,
+	90, -231, -150, 15, 17, 28, -175, 84, -296, -296,
+	84, 54, 152, -296, -151, -180, -98, -98, 83, -98,
+	-151, -119, -235, 277, 10, -231, 90, -231, 91, 91,
+	375, 30, 78, 79, 80, 30, 75, 76, -172, -171,
+	202, 184, -296, 84, -238, 338, 341, 23, -275, -274,
+	-204, 83, 74, -10, -19, -203, -169, -231, -277, -125,
+	-125, -125, -125, -125, -155, 90, -125, -125, -171, -296,
+	-171, -171, -211, -231, -158, -163, -191, -12, 368, -98,
+	-134, 29, -138, 54, -3, -203, -136, -203, -155, -171,
+	-155, -239, 172, 29, 171, -128, -232, -232, 85, 85,
+	23, 203, -114, -272, 342, 342, -3, 84, 120, -171,
+	-114, -296, -18, 200, -296, -296, -296, -296, -81, 130,
+	338, -296, -296, -296, -296, -296, -296, -118, -161, 428,
+	-164, 43, -165, 44, -13, -11, -203, 10, -136, 152,
+	85, -228, 97, 29, 29, -3, -295, 83, -71, 338,
+	-274, -256, -204, 90, 91, 85, -151, 17, -296, 336,
+	70, 339, -158, 48, 258, -166, 52, -167, -162, 53,
+	17, 84, 23, -176, -203, 90, -71, -125, 199, -171,
+	-72, 214, 432, -279, -14, -15, 81, 427, -134, 59,
+	337, 340, -159, 50, -157, 49, -157, -165, 17, -168,
+	45, 46, 90, -11, -295, -296, -296, 85, 177, -276,
+	-16, 114, 425, 422, -17, 91, 94, 151, 59, -160,
+	51, 73, 103, 90, 17, 17, -10, -286, -287, 73,
+	216, -16, 426, 402, 377, 402, 377, -125, 338, 73,
+	103, 90, 90, -296, -287, 73, 11, 10, 111, -203,
+	339, -285, 185, 180, 183, 31, -285, -16, 340, 179,
+	30, 100,
 }
 
 var yyDef = [...]int{
 	33, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 814, 0, 552, 552, 552, 552, 552, 552,
-	552, 0, 0, 90, -2, -2, 838, 37, 0, 0,
-	0, 0, -2, 486, 487, 0, 489, -2, 0, 0,
-	498, 1344, 1344, 547, 0, 0, 0, 0, 0, 1342,
-	54, 55, 504, 505, 506, 1, 3, 0, 556, 822,
-	0, 0, -2, 554, 0, 0, 930, 930, 930, 0,
-	85, 86, 0, 0, 0, 838, 928, 0, 0, 0,
-	0, 928, 0, 108, 109, 89, -2, 113, 114, 0,
-	118, 371, 332, 374, 330, 360, -2, 323, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	335, 227, 227, 0, 0, -2, 323, 323, 323, 0,
-	0, 0, 357, 932, 277, 227, 227, 0, 227, 227,
-	227, 227, 0, 0, 227, 227, 227, 227, 227, 227,
-	227, 227, 227, 227, 227, 227, 227, 227, 227, 0,
-	107, 851, 0, 0, 117, 38, 34, 35, 36, 0,
-	926, 0, 926, 926, 0, 423, 636, 945, 946, 1085,
-	1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095,
-	1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105,
-	1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115,
-	1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125,
+	31, 32, 847, 0, 0, 559, 559, 559, 559, 559,
+	559, 559, 0, 0, 97, -2, -2, 871, 37, 0,
+	0, 0, 0, -2, 493, 494, 0, 496, -2, 0,
+	0, 505, 1381, 1381, 554, 0, 0, 0, 0, 0,
+	0, 1379, 61, 62, 511, 512, 513, 1, 3, 0,
+	563, 855, 0, 847, 559, 0, -2, 561, 0, 0,
+	963, 963, 963, 0, 92, 93, 0, 0, 0, 871,
+	961, 0, 0, 0, 0, 961, 0, 115, 116, 96,
+	-2, 120, 121, 0, 125, 378, 339, 381, 337, 367,
+	-2, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 342, 234, 234, 0, 0, -2,
+	330, 330, 330, 0, 0, 0, 364, 965, 284, 234,
+	234, 0, 234, 234, 234, 234, 0, 0, 234, 234,
+	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+	234, 234, 234, 0, 114, 884, 0, 0, 124, 38,
+	34, 35, 36, 0, 959, 0, 959, 959, 0, 430,
+	643, 978, 979, 1119, 1120, 1121, 1122, 1123, 1124, 1125,
 	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
 	1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145,
 	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155,
@@ -4735,199 +4885,210 @@ var yyDef = [...]int{
 	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
 	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
 	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 1337, 1338, 1339, 1340, 1341, 0, 477, 477, 477,
-	477, 477, 477, 0, 432, 0, 0, 0, 0, 0,
-	0, 0, 449, 0, 452, 0, 0, 459, 477, 1345,
-	1345, 1345, 917, 0, 483, 484, 471, 469, 466, 467,
-	485, 488, 0, 493, 496, 941, 942, 0, 511, 0,
-	1159, 503, 516, 517, 0, 548, 549, 39, 687, 646,
-	0, 652, 654, 0, 689, 690, 691, 692, 693, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 719,
-	720, 721, 722, 799, 800, 801, 802, 803, 804, 805,
-	806, 656, 657, 796, 0, 906, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 787, 0, 756, 756, 756,
-	756, 756, 756, 756, 756, 0, 0, 0, 0, 0,
-	0, 0, -2, -2, 1344, 0, 526, 0, 814, 50,
-	0, 552, 557, 558, 857, 0, 0, 814, 1343, 0,
-	0, -2, -2, 568, 574, 575, 576, 577, 553, 0,
-	580, 584, 0, 0, 0, 931, 0, 0, 71, 0,
-	1312, 910, -2, -2, 0, 0, 943, 944, 919, -2,
-	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
-	959, 960, 961, 962, 963, 964, 965, 966, 967, 968,
-	969, 970, 971, 972, 973, 974, 975, 976, 977, 978,
-	979, 980, 981, 982, 983, 984, 985, 986, 987, 988,
-	989, 990, 991, 992, 993, 994, 995, 996, 997, 998,
-	999, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008,
-	1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018,
-	1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028,
-	1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038,
-	1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048,
-	1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
-	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
-	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
-	1079, 1080, 1081, 1082, 1083, 1084, -2, 1104, 0, 0,
-	127, 128, 0, 37, 253, 0, 123, 0, 247, 185,
-	851, 0, 0, 938, 0, 0, 0, 0, 91, 115,
-	116, 227, 227, 0, 117, 117, 339, 340, 341, 0,
-	0, -2, 251, 0, 324, 0, 0, 241, 241, 245,
-	243, 244, 0, 0, 0, 0, 0, 0, 351, 0,
-	352, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	407, 0, 228, 0, 369, 370, 278, 0, 0, 0,
-	0, 349, 350, 0, 0, 933, 934, 0, 0, 227,
-	227, 0, 0, 0, 0, 227, 227, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 842, 0, 0, 0, 0, 0, 0,
-	-2, 0, 415, 0, 0, 0, 0, 0, 0, 422,
-	0, 424, 425, 0, 0, 426, 427, 428, 429, 430,
-	477, 0, 477, 0, 477, 477, 477, 477, 474, 0,
-	474, 472, 473, 464, 465, 1345, 1345, 1345, 0, 1345,
-	1345, 0, 1345, 1345, 0, 236, 237, 238, 480, 456,
-	457, 460, 461, 1346, 1347, 462, 463, 918, 494, 497,
-	514, 512, 513, 515, 507, 508, 509, 510, 0, 527,
-	528, 533, 0, 0, 0, 0, 539, 540, 541, 0,
-	0, 544, 545, 546, 0, 0, 0, 0, 0, 650,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
-	675, 676, 677, 678, 679, 680, 653, 0, 667, 0,
-	0, 0, 709, 710, 711, 712, 713, 714, 715, 716,
-	717, 0, 565, 0, 0, 0, 814, 0, 0, 0,
-	0, 0, 0, 0, 562, 0, 788, 0, 740, 748,
-	0, 741, 749, 742, 750, 743, 0, 744, 751, 745,
-	752, 746, 747, 753, 0, 0, 0, 565, 565, 0,
-	0, 40, 518, 519, 0, 619, 936, 822, 0, 567,
-	860, 0, 0, 823, 815, 816, 819, 822, 0, 589,
-	578, 569, 572, 573, 555, 0, 581, 585, 0, 587,
-	588, 0, 0, 69, 0, 635, 0, 591, 593, 594,
-	595, 617, 0, 0, 0, 0, 65, 67, 636, 0,
-	1312, 916, 0, 73, 74, 0, 0, 0, 208, 921,
-	922, 923, -2, 234, 0, 196, 192, 136, 137, 138,
-	185, 140, 185, 185, 185, 185, 205, 205, 205, 205,
-	168, 169, 170, 171, 172, 0, 0, 155, 185, 185,
-	185, 185, 175, 176, 177, 178, 179, 180, 181, 182,
-	141, 142, 143, 144, 145, 146, 147, 148, 149, 187,
-	187, 187, 189, 189, 0, 38, 0, 219, 0, 819,
-	0, 842, 100, 0, 0, 939, 0, 938, 938, 938,
-	106, 0, 0, 372, 333, 361, 373, 0, 336, 337,
-	-2, 0, 0, 323, 0, 325, 0, 235, 0, -2,
-	0, 0, 0, 241, 245, 242, 245, 233, 246, 353,
-	796, 0, 354, 355, 0, 387, 605, 0, 0, 0,
-	0, 0, 393, 394, 395, 0, 397, 398, 399, 400,
-	401, 402, 403, 404, 405, 406, 362, 363, 364, 365,
-	366, 367, 368, 0, 0, 325, 0, 358, 0, 279,
-	280, 0, 0, 283, 284, 285, 286, 0, 0, 289,
-	290, 291, 292, 293, 317, 318, 319, 294, 295, 296,
-	297, 298, 299, 300, 311, 312, 313, 314, 315, 316,
-	301, 302, 303, 304, 305, 308, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 839, 840, 841, 0,
-	0, 0, 266, 927, 0, 266, 63, 421, 637, 947,
-	948, 478, 479, 431, 450, 433, 453, 434, 436, 435,
-	437, 477, 0, 0, 0, 239, 240, 477, 440, 441,
-	442, 443, 444, 445, 446, 447, 448, 0, 455, 0,
-	0, 0, 495, 499, 500, 501, 502, 0, 0, 530,
-	535, 536, 537, 538, 550, 543, 688, 647, 648, 649,
-	651, 668, 0, 670, 672, 658, 659, 683, 684, 685,
-	0, 0, 0, 0, 681, 663, 0, 694, 695, 696,
-	697, 698, 699, 700, 701, 702, 703, 704, 705, 708,
-	771, 772, 773, 0, 706, 707, 718, 0, 0, 0,
-	566, 797, 0, -2, 0, 686, 905, 822, 0, 0,
-	0, 0, 691, 799, 0, 691, 799, 0, 0, 0,
-	563, 564, 794, 791, 0, 0, 757, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 521, 522, 524, 0,
-	639, 0, 620, 0, 622, 623, 0, 937, 857, 51,
-	41, 0, 858, 0, 0, 0, 0, 818, 820, 821,
-	857, 0, 807, 0, 0, 644, 0, 0, 570, 47,
-	586, 582, 0, 644, 0, 0, 634, 0, 0, 0,
-	0, 0, 0, 624, 0, 0, 627, 0, 0, 0,
-	0, 618, 0, 0, 0, -2, 0, 0, 0, 61,
-	62, 0, 0, 0, 911, 72, 0, 0, 77, 78,
-	912, 913, 914, 915, 0, 110, -2, 274, 129, 131,
-	132, 133, 124, 199, 197, 0, 194, 193, 139, 205,
-	205, 162, 163, 208, 0, 208, 208, 208, 0, 0,
-	156, 157, 158, 159, 150, 0, 151, 152, 153, 0,
-	154, 252, 0, 826, 220, 221, 223, 227, 0, 0,
-	248, 249, 0, 0, 929, 0, 940, 0, 0, 0,
-	119, 120, 121, 122, 117, 0, 0, 125, 327, 0,
-	0, 0, 250, 0, 0, 229, 245, 230, 231, 0,
-	356, 0, 0, 389, 390, 391, 392, 0, 0, 0,
-	325, 327, 208, 0, 281, 282, 287, 288, 306, 0,
-	0, 0, 0, 852, 853, 0, 856, 92, 379, 381,
-	380, 384, 0, 0, 0, 0, 416, 418, 267, 268,
-	826, 0, 420, 438, 475, 476, 474, 454, 474, 481,
-	458, 491, 534, 0, 0, 0, 542, 0, 669, 671,
-	673, 660, 681, 664, 0, 661, 0, 0, 655, 723,
-	0, 0, 565, 0, 814, 857, 727, 728, 0, 0,
-	0, 0, 0, 764, 0, 0, 765, 0, 814, 0,
-	792, 0, 0, 739, 758, 0, 0, 759, 760, 761,
-	762, 763, 520, 523, 525, 599, 0, 0, 0, 0,
-	621, 935, 43, 0, 0, 0, 824, 825, 817, 42,
-	0, 924, 925, 808, 809, 810, 0, 579, 590, 571,
-	0, 822, 899, 0, 0, 891, 0, 0, 644, 907,
-	0, 592, 613, 615, 0, 610, 625, 626, 628, 0,
-	630, 0, 632, 633, 596, 597, 598, 0, 644, 0,
-	644, 66, 644, 68, 0, 638, 75, 76, 0, 0,
-	82, 209, 210, 117, 276, 130, 201, 0, 198, 135,
-	195, 208, 208, 164, 206, 207, 165, 166, 167, 0,
-	183, 0, 0, 0, 269, 87, 830, 829, 227, 227,
-	222, 0, 225, 0, 186, 0, 102, 0, 0, 0,
-	0, 331, 603, 0, 342, 343, 0, 326, 386, 0,
-	219, 0, 232, 797, 606, 0, 0, 344, 0, 327,
-	347, 348, 359, 309, 310, 307, 601, 843, 844, 845,
-	0, 855, 95, 0, 0, 0, 0, 377, 0, 419,
-	64, 477, 477, 529, 0, 532, 0, 662, 0, 682,
-	665, 724, 725, 0, 798, 822, 45, 0, 185, 185,
-	777, 185, 189, 780, 185, 782, 185, 785, 0, 0,
-	0, 0, 0, 0, 0, 789, 738, 795, 0, 0,
-	0, 0, 0, 0, 0, 0, 205, 862, 859, 44,
-	812, 0, 645, 583, 48, 52, 0, 899, 890, 901,
-	903, 0, 0, 0, 895, 0, 814, 0, 0, 607,
-	614, 0, 0, 608, 0, 609, 629, 631, -2, 814,
-	644, 59, 60, 0, 79, 80, 81, 275, 203, 0,
-	200, 160, 161, 205, 0, 205, 0, 190, 0, 258,
-	270, 0, 827, 828, 0, 0, 224, 226, 601, 103,
-	104, 105, 0, 0, 126, 328, 0, 218, 0, 0,
-	411, 408, 345, 346, 0, 0, 854, 378, 0, 93,
-	94, 0, 0, 383, 417, 439, 451, 531, 551, 666,
-	726, 857, 729, 774, 205, 778, 779, 781, 783, 784,
-	786, 731, 730, 0, 0, 0, 0, 0, 822, 0,
-	793, 0, 0, 0, 0, 0, 619, 205, 882, 49,
-	0, 0, 0, 53, 0, 904, 0, 0, 0, 0,
-	70, 822, 908, 909, 611, 0, 616, 822, 58, 211,
-	204, 0, 208, 184, 208, 0, 0, 271, 831, 832,
-	833, 834, 835, 836, 837, 0, 334, 604, 0, 0,
-	388, 0, 396, 0, 0, 0, 0, 96, 97, 0,
-	0, 0, 46, 775, 776, 0, 0, 0, 0, 766,
-	0, 790, 0, 0, 0, 641, 0, 0, 639, 864,
-	863, 876, 880, 813, 811, 0, 902, 0, 894, 897,
-	893, 896, 56, 0, 57, 216, 0, 213, 215, 202,
-	173, 174, 188, 191, 0, 0, 0, 412, 409, 410,
-	846, 602, 0, 0, 0, 385, 732, 734, 733, 735,
-	0, 0, 0, 737, 754, 755, 640, 642, 643, 600,
-	882, 0, 875, 878, -2, 0, 0, 892, 0, 612,
-	134, 0, 212, 214, 846, 0, 0, 375, 848, 98,
-	99, 320, 321, 322, 92, 736, 0, 0, 0, 869,
-	867, 867, 880, 0, 884, 0, 889, 0, 900, 898,
-	217, 88, 0, 0, 0, 0, 849, 850, 95, 767,
-	0, 770, 872, 0, 865, 868, 866, 877, 0, 883,
-	0, 0, 881, 413, 414, 254, 0, 382, 768, 861,
-	0, 870, 871, 879, 0, 0, 255, 256, 0, 847,
-	0, 873, 874, 885, 887, 257, 0, 0, 0, 0,
-	259, 261, 262, 0, 0, 260, 769, 263, 264, 265,
+	1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
+	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
+	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
+	1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375,
+	1376, 1377, 1378, 0, 484, 484, 484, 484, 484, 484,
+	0, 439, 0, 0, 0, 0, 0, 0, 0, 456,
+	0, 459, 0, 0, 466, 484, 1382, 1382, 1382, 950,
+	0, 490, 491, 478, 476, 473, 474, 492, 495, 0,
+	500, 503, 974, 975, 0, 518, 0, 1194, 510, 523,
+	524, 0, 555, 556, 39, 694, 653, 0, 659, 661,
+	0, 696, 697, 698, 699, 700, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 726, 727, 728, 729,
+	806, 807, 808, 809, 810, 811, 812, 813, 663, 664,
+	803, 0, 939, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 794, 0, 763, 763, 763, 763, 763, 763,
+	763, 763, 0, 0, 0, 0, 0, 0, 0, -2,
+	-2, 1381, 0, 533, 0, 49, 0, 51, 608, 847,
+	57, 0, 564, 565, 890, 0, 0, 855, 574, 847,
+	1380, 0, 0, -2, -2, 575, 581, 582, 583, 584,
+	560, 0, 587, 591, 0, 0, 0, 964, 0, 0,
+	78, 0, 1349, 943, -2, -2, 0, 0, 976, 977,
+	952, -2, 982, 983, 984, 985, 986, 987, 988, 989,
+	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
+	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
+	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
+	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
+	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
+	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
+	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
+	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
+	1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089,
+	1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109,
+	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, -2,
+	1138, 0, 0, 134, 135, 0, 37, 260, 0, 130,
+	0, 254, 192, 884, 0, 0, 971, 0, 0, 0,
+	0, 98, 122, 123, 234, 234, 0, 124, 124, 346,
+	347, 348, 0, 0, -2, 258, 0, 331, 0, 0,
+	248, 248, 252, 250, 251, 0, 0, 0, 0, 0,
+	0, 358, 0, 359, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 414, 0, 235, 0, 376, 377, 285,
+	0, 0, 0, 0, 356, 357, 0, 0, 966, 967,
+	0, 0, 234, 234, 0, 0, 0, 0, 234, 234,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 108, 875, 0, 0, 0,
+	0, 0, 0, -2, 0, 422, 0, 0, 0, 0,
+	0, 0, 429, 0, 431, 432, 0, 0, 433, 434,
+	435, 436, 437, 484, 0, 484, 0, 484, 484, 484,
+	484, 481, 0, 481, 479, 480, 471, 472, 1382, 1382,
+	1382, 0, 1382, 1382, 0, 1382, 1382, 0, 243, 244,
+	245, 487, 463, 464, 467, 468, 1383, 1384, 469, 470,
+	951, 501, 504, 521, 519, 520, 522, 514, 515, 516,
+	517, 0, 534, 535, 540, 0, 0, 0, 0, 546,
+	547, 548, 0, 0, 551, 552, 553, 0, 0, 0,
+	0, 0, 657, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 681, 682, 683, 684, 685, 686, 687, 660,
+	0, 674, 0, 0, 0, 716, 717, 718, 719, 720,
+	721, 722, 723, 724, 0, 572, 0, 0, 0, 847,
+	0, 0, 0, 0, 0, 0, 0, 0, 569, 0,
+	795, 0, 747, 755, 0, 748, 756, 749, 757, 750,
+	0, 751, 758, 752, 759, 753, 754, 760, 0, 0,
+	0, 572, 572, 0, 0, 40, 525, 526, 0, 626,
+	969, 0, 50, 0, 0, 855, 0, 893, 0, 0,
+	856, 848, 849, 852, 890, 579, 580, 855, 0, 596,
+	585, 576, 562, 0, 588, 592, 0, 594, 595, 0,
+	0, 76, 0, 642, 0, 598, 600, 601, 602, 624,
+	0, 0, 0, 0, 72, 74, 643, 0, 1349, 949,
+	0, 80, 81, 0, 0, 0, 215, 954, 955, 956,
+	-2, 241, 0, 203, 199, 143, 144, 145, 192, 147,
+	192, 192, 192, 192, 212, 212, 212, 212, 175, 176,
+	177, 178, 179, 0, 0, 162, 192, 192, 192, 192,
+	182, 183, 184, 185, 186, 187, 188, 189, 148, 149,
+	150, 151, 152, 153, 154, 155, 156, 194, 194, 194,
+	196, 196, 0, 38, 0, 226, 0, 852, 0, 875,
+	107, 0, 0, 972, 0, 971, 971, 971, 113, 0,
+	0, 379, 340, 368, 380, 0, 343, 344, -2, 0,
+	0, 330, 0, 332, 0, 242, 0, -2, 0, 0,
+	0, 248, 252, 249, 252, 240, 253, 360, 803, 0,
+	361, 362, 0, 394, 612, 0, 0, 0, 0, 0,
+	400, 401, 402, 0, 404, 405, 406, 407, 408, 409,
+	410, 411, 412, 413, 369, 370, 371, 372, 373, 374,
+	375, 0, 0, 332, 0, 365, 0, 286, 287, 0,
+	0, 290, 291, 292, 293, 0, 0, 296, 297, 298,
+	299, 300, 324, 325, 326, 301, 302, 303, 304, 305,
+	306, 307, 318, 319, 320, 321, 322, 323, 308, 309,
+	310, 311, 312, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 872, 873, 874, 0, 0, 0,
+	273, 960, 0, 273, 70, 428, 644, 980, 981, 485,
+	486, 438, 457, 440, 460, 441, 443, 442, 444, 484,
+	0, 0, 0, 246, 247, 484, 447, 448, 449, 450,
+	451, 452, 453, 454, 455, 0, 462, 0, 0, 0,
+	502, 506, 507, 508, 509, 0, 0, 537, 542, 543,
+	544, 545, 557, 550, 695, 654, 655, 656, 658, 675,
+	0, 677, 679, 665, 666, 690, 691, 692, 0, 0,
+	0, 0, 688, 670, 0, 701, 702, 703, 704, 705,
+	706, 707, 708, 709, 710, 711, 712, 715, 778, 779,
+	780, 0, 713, 714, 725, 0, 0, 0, 573, 804,
+	0, -2, 0, 693, 938, 855, 847, 0, 0, 0,
+	0, 698, 806, 0, 698, 806, 0, 0, 0, 570,
+	571, 801, 798, 0, 0, 764, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 528, 529, 531, 0, 646,
+	0, 627, 0, 629, 630, 0, 970, 52, 0, 0,
+	610, 890, 58, 41, 0, 891, 0, 0, 0, 0,
+	851, 853, 854, 893, 890, 0, 814, 0, 0, 651,
+	0, 0, 577, 54, 593, 589, 0, 651, 0, 0,
+	641, 0, 0, 0, 0, 0, 0, 631, 0, 0,
+	634, 0, 0, 0, 0, 625, 0, 0, 0, -2,
+	0, 0, 0, 68, 69, 0, 0, 0, 944, 79,
+	0, 0, 84, 85, 945, 946, 947, 948, 0, 117,
+	-2, 281, 136, 138, 139, 140, 131, 206, 204, 0,
+	201, 200, 146, 212, 212, 169, 170, 215, 0, 215,
+	215, 215, 0, 0, 163, 164, 165, 166, 157, 0,
+	158, 159, 160, 0, 161, 259, 0, 859, 227, 228,
+	230, 234, 0, 0, 255, 256, 0, 0, 962, 0,
+	973, 0, 0, 0, 126, 127, 128, 129, 124, 0,
+	0, 132, 334, 0, 0, 0, 257, 0, 0, 236,
+	252, 237, 238, 0, 363, 0, 0, 396, 397, 398,
+	399, 0, 0, 0, 332, 334, 215, 0, 288, 289,
+	294, 295, 313, 0, 0, 0, 0, 885, 886, 0,
+	889, 99, 386, 388, 387, 391, 0, 0, 0, 0,
+	423, 425, 274, 275, 859, 0, 427, 445, 482, 483,
+	481, 461, 481, 488, 465, 498, 541, 0, 0, 0,
+	549, 0, 676, 678, 680, 667, 688, 671, 0, 668,
+	0, 0, 662, 821, 0, 0, 572, 0, 847, 890,
+	855, 734, 735, 0, 0, 0, 0, 0, 771, 0,
+	0, 772, 0, 847, 0, 799, 0, 0, 746, 765,
+	0, 0, 766, 767, 768, 769, 770, 527, 530, 532,
+	606, 0, 0, 0, 0, 628, 968, 53, 609, 0,
+	44, 0, 0, 0, 857, 858, 850, 42, 43, 0,
+	957, 958, 815, 816, 817, 0, 586, 597, 578, 0,
+	855, 932, 0, 0, 924, 0, 0, 651, 940, 0,
+	599, 620, 622, 0, 617, 632, 633, 635, 0, 637,
+	0, 639, 640, 603, 604, 605, 0, 651, 0, 651,
+	73, 651, 75, 0, 645, 82, 83, 0, 0, 89,
+	216, 217, 124, 283, 137, 208, 0, 205, 142, 202,
+	215, 215, 171, 213, 214, 172, 173, 174, 0, 190,
+	0, 0, 0, 276, 94, 863, 862, 234, 234, 229,
+	0, 232, 0, 193, 0, 109, 0, 0, 0, 0,
+	338, 0, 349, 350, 0, 333, 393, 0, 226, 0,
+	239, 804, 613, 0, 0, 351, 0, 334, 354, 355,
+	366, 316, 317, 314, 608, 876, 877, 878, 0, 888,
+	102, 0, 0, 0, 0, 384, 0, 426, 71, 484,
+	484, 536, 0, 539, 0, 669, 0, 689, 672, 730,
+	0, 731, 732, 0, 805, 855, 46, 890, 0, 192,
+	192, 784, 192, 196, 787, 192, 789, 192, 792, 0,
+	0, 0, 0, 0, 0, 0, 796, 745, 802, 0,
+	0, 0, 0, 0, 0, 0, 611, 0, 212, 895,
+	892, 45, 819, 0, 652, 590, 55, 59, 0, 932,
+	923, 934, 936, 0, 0, 0, 928, 0, 847, 0,
+	0, 614, 621, 0, 0, 615, 0, 616, 636, 638,
+	-2, 847, 651, 66, 67, 0, 86, 87, 88, 282,
+	210, 0, 207, 167, 168, 212, 0, 212, 0, 197,
+	0, 265, 277, 0, 860, 861, 0, 0, 231, 233,
+	608, 110, 111, 112, 0, 133, 335, 0, 225, 0,
+	0, 418, 415, 352, 353, 0, 887, 385, 0, 100,
+	101, 0, 0, 390, 424, 446, 458, 538, 558, 673,
+	822, 825, 733, 890, 47, 736, 781, 212, 785, 786,
+	788, 790, 791, 793, 738, 737, 0, 0, 0, 0,
+	0, 855, 0, 800, 0, 0, 0, 0, 0, 626,
+	212, 915, 842, 0, 0, 0, 60, 0, 937, 0,
+	0, 0, 0, 77, 855, 941, 942, 618, 0, 623,
+	855, 65, 218, 211, 0, 215, 191, 215, 0, 0,
+	278, 864, 865, 866, 867, 868, 869, 870, 0, 341,
+	0, 0, 395, 0, 403, 0, 0, 0, 103, 104,
+	0, 0, 0, 0, 827, 826, 48, 782, 783, 0,
+	0, 0, 0, 773, 0, 797, 0, 0, 0, 648,
+	0, 0, 646, 897, 896, 909, 913, 56, 0, 820,
+	818, 0, 935, 0, 927, 930, 926, 929, 63, 0,
+	64, 223, 0, 220, 222, 209, 180, 181, 195, 198,
+	0, 0, 0, 419, 416, 417, 879, 0, 0, 0,
+	392, 823, 847, 0, 739, 741, 740, 742, 0, 0,
+	0, 744, 761, 762, 647, 649, 650, 607, 915, 0,
+	908, 911, -2, 0, 843, 844, 0, 0, 925, 0,
+	619, 141, 0, 219, 221, 879, 0, 0, 382, 881,
+	105, 106, 327, 328, 329, 99, 829, 0, 743, 0,
+	0, 0, 902, 900, 900, 913, 0, 917, 0, 922,
+	0, 0, 0, 933, 931, 224, 95, 0, 0, 0,
+	0, 882, 883, 102, 824, 0, 832, 833, 828, 774,
+	0, 777, 905, 0, 898, 901, 899, 910, 0, 916,
+	0, 0, 914, 845, 825, 420, 421, 261, 0, 389,
+	830, 0, 0, 0, 0, 839, 840, 0, 775, 894,
+	0, 903, 904, 912, 0, 0, 0, 262, 263, 0,
+	880, 0, 834, 835, 836, 837, 838, 0, 0, 906,
+	907, 918, 920, 846, 264, 0, 0, 0, 0, 841,
+	0, 266, 268, 269, 0, 0, 267, 831, 776, 270,
+	271, 272,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
-	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 463,
-	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 113, 3, 3, 3, 140, 132, 3,
+	83, 85, 137, 135, 84, 136, 152, 138, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 468,
+	121, 120, 122, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 140, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 142, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 129, 3, 141,
+	3, 3, 3, 3, 131, 3, 143,
 }
 
 var yyTok2 = [...]int{
@@ -4938,13 +5099,13 @@ var yyTok2 = [...]int{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75, 76, 77, 78, 79, 80, 84,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 86, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 112, 113, 114, 115,
-	116, 117, 121, 122, 123, 124, 125, 126, 127, 128,
-	131, 132, 137, 139, 142, 143, 144, 145, 146, 147,
-	148, 149, 151, 152, 153, 154, 155, 156, 157, 158,
+	105, 106, 107, 108, 109, 110, 111, 112, 114, 115,
+	116, 117, 118, 119, 123, 124, 125, 126, 127, 128,
+	129, 130, 133, 134, 139, 141, 144, 145, 146, 147,
+	148, 149, 150, 151, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
@@ -4997,7 +5158,8 @@ var yyTok3 = [...]int{
 	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
 	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
 	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
-	57785, 460, 57786, 461, 57787, 462, 0,
+	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
+	57790, 465, 57791, 466, 57792, 467, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5339,77 +5501,77 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:409
+//line sql.y:437
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:414
+//line sql.y:442
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:415
+//line sql.y:443
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:419
+//line sql.y:447
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:451
+//line sql.y:479
 		{
 			setParseTree(yylex, nil)
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:457
+//line sql.y:485
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:461
+//line sql.y:489
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:465
+//line sql.y:493
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:470
+//line sql.y:498
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:474
+//line sql.y:502
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 39:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:480
+//line sql.y:508
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
 	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:486
+//line sql.y:514
 		{
 			yyVAL.statement = &Load{}
 		}
 	case 41:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:492
+//line sql.y:520
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5420,25 +5582,37 @@ yydefault:
 		}
 	case 42:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:501
+//line sql.y:529
+		{
+			sel := yyDollar[2].selStmt.(*Select)
+			sel.With = yyDollar[1].with
+			sel.OrderBy = yyDollar[3].orderBy
+			sel.Limit = yyDollar[4].limit
+			sel.Lock = yyDollar[5].lock
+			sel.Into = yyDollar[6].selectInto
+			yyVAL.selStmt = sel
+		}
+	case 43:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:539
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].lock}
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:505
+//line sql.y:543
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:509
+//line sql.y:547
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:532
+//line sql.y:570
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5446,45 +5620,88 @@ yydefault:
 			sel.Lock = yyDollar[4].lock
 			yyVAL.selStmt = sel
 		}
-	case 46:
+	case 47:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:578
+		{
+			sel := yyDollar[2].selStmt.(*Select)
+			sel.With = yyDollar[1].with
+			sel.OrderBy = yyDollar[3].orderBy
+			sel.Limit = yyDollar[4].limit
+			sel.Lock = yyDollar[5].lock
+			yyVAL.selStmt = sel
+		}
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:540
+//line sql.y:587
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 47:
+	case 49:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:593
+		{
+			yyVAL.with = &With{Ctes: yyDollar[2].ctes}
+		}
+	case 50:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:597
+		{
+			yyVAL.with = &With{Ctes: yyDollar[3].ctes, Recursive: true}
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:603
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 52:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:607
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 53:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:613
+		{
+			yyVAL.cte = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
+		}
+	case 54:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:546
+//line sql.y:619
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 48:
+	case 55:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:552
+//line sql.y:625
 		{
 			yyVAL.statement = &VStream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].expr), Limit: yyDollar[7].limit}
 		}
-	case 49:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:560
+	case 56:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:633
 		{
-			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			sel := NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			sel.Windows = yyDollar[9].windowDefs
+			yyVAL.selStmt = sel
 		}
-	case 50:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:641
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 51:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:645
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 52:
+	case 59:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:577
+//line sql.y:652
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -5496,9 +5713,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 53:
+	case 60:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:589
+//line sql.y:664
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -5508,210 +5725,210 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].insertAction, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 54:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:601
+//line sql.y:676
 		{
 			yyVAL.insertAction = InsertAct
 		}
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:605
+//line sql.y:680
 		{
 			yyVAL.insertAction = ReplaceAct
 		}
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:611
+//line sql.y:686
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 57:
+	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:617
+//line sql.y:692
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 58:
+	case 65:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:621
+//line sql.y:696
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereClause, yyDollar[8].expr)}
 		}
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:625
+//line sql.y:700
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:629
+//line sql.y:704
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:634
+//line sql.y:709
 		{
 		}
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:635
+//line sql.y:710
 		{
 		}
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:639
+//line sql.y:714
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:643
+//line sql.y:718
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
 		}
-	case 65:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:649
+//line sql.y:724
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 66:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:653
+//line sql.y:728
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:659
+//line sql.y:734
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 68:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:663
+//line sql.y:738
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:668
+//line sql.y:743
 		{
 			yyVAL.partitions = nil
 		}
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:672
+//line sql.y:747
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:678
+//line sql.y:753
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:684
+//line sql.y:759
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].scope, Characteristics: yyDollar[5].characteristics}
 		}
-	case 73:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:688
+//line sql.y:763
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics, Scope: ImplicitScope}
 		}
-	case 74:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:694
+//line sql.y:769
 		{
 			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
-	case 75:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:698
+//line sql.y:773
 		{
 			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
-	case 76:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:704
+//line sql.y:779
 		{
 			yyVAL.characteristic = yyDollar[3].isolationLevel
 		}
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:708
+//line sql.y:783
 		{
 			yyVAL.characteristic = ReadWrite
 		}
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:712
+//line sql.y:787
 		{
 			yyVAL.characteristic = ReadOnly
 		}
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:718
+//line sql.y:793
 		{
 			yyVAL.isolationLevel = RepeatableRead
 		}
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:722
+//line sql.y:797
 		{
 			yyVAL.isolationLevel = ReadCommitted
 		}
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:726
+//line sql.y:801
 		{
 			yyVAL.isolationLevel = ReadUncommitted
 		}
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:730
+//line sql.y:805
 		{
 			yyVAL.isolationLevel = Serializable
 		}
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:811
 		{
 			yyVAL.scope = SessionScope
 		}
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:740
+//line sql.y:815
 		{
 			yyVAL.scope = GlobalScope
 		}
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:746
+//line sql.y:821
 		{
 			yyDollar[1].createTable.TableSpec = yyDollar[2].TableSpec
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:752
+//line sql.y:827
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTable.OptLike = yyDollar[2].optLike
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:759
+//line sql.y:834
 		{
 			indexDef := yyDollar[1].alterTable.AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumns
@@ -5720,289 +5937,289 @@ yydefault:
 			yyDollar[1].alterTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:768
+//line sql.y:843
 		{
 			yyVAL.statement = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].boolean, Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columns, Select: yyDollar[10].selStmt, CheckOption: yyDollar[11].str}
 		}
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:772
+//line sql.y:847
 		{
 			yyDollar[1].createDatabase.FullyParsed = true
 			yyDollar[1].createDatabase.CreateOptions = yyDollar[2].collateAndCharsets
 			yyVAL.statement = yyDollar[1].createDatabase
 		}
-	case 90:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:779
+//line sql.y:854
 		{
 			yyVAL.boolean = false
 		}
-	case 91:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:858
 		{
 			yyVAL.boolean = true
 		}
-	case 92:
+	case 99:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:788
+//line sql.y:863
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 93:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:792
+//line sql.y:867
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 94:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:873
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 95:
+	case 102:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:803
+//line sql.y:878
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 96:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:883
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 97:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:814
+//line sql.y:889
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 98:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:819
+//line sql.y:894
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 99:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:825
+//line sql.y:900
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 100:
+	case 107:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:831
+//line sql.y:906
 		{
 			yyVAL.createTable = &CreateTable{Table: yyDollar[4].tableName, IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createTable)
 		}
-	case 101:
+	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:838
+//line sql.y:913
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 102:
+	case 109:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:845
+//line sql.y:920
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[6].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[3].colIdent, Type: string(yyDollar[2].bytes)}, Options: yyDollar[4].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 103:
+	case 110:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:850
+//line sql.y:925
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Fulltext: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 104:
+	case 111:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:855
+//line sql.y:930
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Spatial: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 105:
+	case 112:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:860
+//line sql.y:935
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Unique: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 106:
+	case 113:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:867
+//line sql.y:942
 		{
 			yyVAL.createDatabase = &CreateDatabase{DBName: string(yyDollar[4].colIdent.String()), IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createDatabase)
 		}
-	case 107:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:949
 		{
 			yyVAL.alterDatabase = &AlterDatabase{}
 			setDDL(yylex, yyVAL.alterDatabase)
 		}
-	case 110:
+	case 117:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:885
+//line sql.y:960
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
 		}
-	case 111:
+	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:891
+//line sql.y:966
 		{
 			yyVAL.collateAndCharsets = nil
 		}
-	case 112:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:895
+//line sql.y:970
 		{
 			yyVAL.collateAndCharsets = yyDollar[1].collateAndCharsets
 		}
-	case 113:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:901
+//line sql.y:976
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 114:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:905
+//line sql.y:980
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 115:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:909
+//line sql.y:984
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 116:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:913
+//line sql.y:988
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 117:
+	case 124:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:918
+//line sql.y:993
 		{
 			yyVAL.boolean = false
 		}
-	case 118:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:922
+//line sql.y:997
 		{
 			yyVAL.boolean = true
 		}
-	case 119:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:928
+//line sql.y:1003
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 120:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:932
+//line sql.y:1007
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 121:
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:938
+//line sql.y:1013
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 122:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:942
+//line sql.y:1017
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 123:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:949
+//line sql.y:1024
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 124:
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:953
+//line sql.y:1028
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 125:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:959
+//line sql.y:1034
 		{
 			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
 		}
-	case 126:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:963
+//line sql.y:1038
 		{
 			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
 		}
-	case 127:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:969
+//line sql.y:1044
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 128:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:974
+//line sql.y:1049
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
 		}
-	case 129:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:979
+//line sql.y:1054
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 130:
+	case 137:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:983
+//line sql.y:1058
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
 		}
-	case 131:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:988
+//line sql.y:1063
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 132:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:992
+//line sql.y:1067
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 133:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:996
+//line sql.y:1071
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 134:
+	case 141:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1002
+//line sql.y:1077
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolean
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -6012,1428 +6229,1428 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].literal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 135:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1013
+//line sql.y:1088
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolean
 			yyVAL.columnType.Zerofill = yyDollar[3].boolean
 		}
-	case 139:
+	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1024
+//line sql.y:1099
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literal
 		}
-	case 140:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1029
+//line sql.y:1104
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 141:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1035
+//line sql.y:1110
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1039
+//line sql.y:1114
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 143:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1043
+//line sql.y:1118
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 144:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1047
+//line sql.y:1122
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 145:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1051
+//line sql.y:1126
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 146:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1055
+//line sql.y:1130
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 147:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1059
+//line sql.y:1134
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 148:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1063
+//line sql.y:1138
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 149:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1067
+//line sql.y:1142
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 150:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1073
+//line sql.y:1148
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 151:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1079
+//line sql.y:1154
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 152:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1085
+//line sql.y:1160
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 153:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1091
+//line sql.y:1166
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 154:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1097
+//line sql.y:1172
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 155:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1105
+//line sql.y:1180
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 156:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1109
+//line sql.y:1184
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 157:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1113
+//line sql.y:1188
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 158:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1117
+//line sql.y:1192
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 159:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1121
+//line sql.y:1196
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 160:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1127
+//line sql.y:1202
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 161:
+	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1131
+//line sql.y:1206
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 162:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1135
+//line sql.y:1210
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 163:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1139
+//line sql.y:1214
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 164:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1143
+//line sql.y:1218
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 165:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1147
+//line sql.y:1222
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 166:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1151
+//line sql.y:1226
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 167:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1155
+//line sql.y:1230
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 168:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1159
+//line sql.y:1234
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 169:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1163
+//line sql.y:1238
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 170:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1167
+//line sql.y:1242
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 171:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1246
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 172:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1175
+//line sql.y:1250
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 173:
+	case 180:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1179
+//line sql.y:1254
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 174:
+	case 181:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1184
+//line sql.y:1259
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 175:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1190
+//line sql.y:1265
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 176:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1194
+//line sql.y:1269
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 177:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1273
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 178:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1277
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 179:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1281
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 180:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1285
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 181:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1289
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 182:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1218
+//line sql.y:1293
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 183:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1224
+//line sql.y:1299
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 184:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1229
+//line sql.y:1304
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 185:
+	case 192:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1234
+//line sql.y:1309
 		{
 			yyVAL.literal = nil
 		}
-	case 186:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1238
+//line sql.y:1313
 		{
 			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
 		}
-	case 187:
+	case 194:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1243
+//line sql.y:1318
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 188:
+	case 195:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1247
+//line sql.y:1322
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 189:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1255
+//line sql.y:1330
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 190:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1259
+//line sql.y:1334
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 			}
 		}
-	case 191:
+	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1265
+//line sql.y:1340
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 192:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1273
+//line sql.y:1348
 		{
 			yyVAL.boolean = false
 		}
-	case 193:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1277
+//line sql.y:1352
 		{
 			yyVAL.boolean = true
 		}
-	case 194:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1282
+//line sql.y:1357
 		{
 			yyVAL.boolean = false
 		}
-	case 195:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1286
+//line sql.y:1361
 		{
 			yyVAL.boolean = true
 		}
-	case 196:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1292
+//line sql.y:1367
 		{
 			yyVAL.boolean = false
 		}
-	case 197:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1296
+//line sql.y:1371
 		{
 			yyVAL.boolean = false
 		}
-	case 198:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1300
+//line sql.y:1375
 		{
 			yyVAL.boolean = true
 		}
-	case 199:
+	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1305
+//line sql.y:1380
 		{
 			yyVAL.optVal = nil
 		}
-	case 200:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1309
+//line sql.y:1384
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 201:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1314
+//line sql.y:1389
 		{
 			yyVAL.optVal = nil
 		}
-	case 202:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1318
+//line sql.y:1393
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 203:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1323
+//line sql.y:1398
 		{
 			yyVAL.boolean = false
 		}
-	case 204:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1327
+//line sql.y:1402
 		{
 			yyVAL.boolean = true
 		}
-	case 205:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1332
+//line sql.y:1407
 		{
 			yyVAL.str = ""
 		}
-	case 206:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1336
+//line sql.y:1411
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 207:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1340
+//line sql.y:1415
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 208:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1345
+//line sql.y:1420
 		{
 			yyVAL.str = ""
 		}
-	case 209:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1349
+//line sql.y:1424
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 210:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1353
+//line sql.y:1428
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 211:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1358
+//line sql.y:1433
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 212:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1362
+//line sql.y:1437
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 213:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1366
+//line sql.y:1441
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 214:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1370
+//line sql.y:1445
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 215:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1374
+//line sql.y:1449
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 216:
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1379
+//line sql.y:1454
 		{
 			yyVAL.literal = nil
 		}
-	case 217:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1383
+//line sql.y:1458
 		{
 			yyVAL.literal = NewStrLiteral(yyDollar[2].bytes)
 		}
-	case 218:
+	case 225:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1389
+//line sql.y:1464
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 219:
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1394
+//line sql.y:1469
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 220:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1398
+//line sql.y:1473
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
-	case 221:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1404
+//line sql.y:1479
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 222:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1408
+//line sql.y:1483
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 223:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1414
+//line sql.y:1489
 		{
 			yyVAL.indexOption = yyDollar[1].indexOption
 		}
-	case 224:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1418
+//line sql.y:1493
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 225:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1423
+//line sql.y:1498
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[2].bytes)}
 		}
-	case 226:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1427
+//line sql.y:1502
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 227:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1433
+//line sql.y:1508
 		{
 			yyVAL.str = ""
 		}
-	case 228:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1437
+//line sql.y:1512
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 229:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1443
+//line sql.y:1518
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 230:
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1447
+//line sql.y:1522
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 231:
+	case 238:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1451
+//line sql.y:1526
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
-	case 232:
+	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1455
+//line sql.y:1530
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
-	case 233:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1459
+//line sql.y:1534
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 234:
+	case 241:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1464
+//line sql.y:1539
 		{
 			yyVAL.str = ""
 		}
-	case 235:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1468
+//line sql.y:1543
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 236:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1549
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 237:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1478
+//line sql.y:1553
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 238:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1482
+//line sql.y:1557
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 239:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1489
+//line sql.y:1564
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 240:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1493
+//line sql.y:1568
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 241:
+	case 248:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1498
+//line sql.y:1573
 		{
 			yyVAL.str = "key"
 		}
-	case 242:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1502
+//line sql.y:1577
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 243:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1508
+//line sql.y:1583
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 244:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1512
+//line sql.y:1587
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 245:
+	case 252:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1517
+//line sql.y:1592
 		{
 			yyVAL.str = ""
 		}
-	case 246:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1521
+//line sql.y:1596
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 247:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1527
+//line sql.y:1602
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 248:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1531
+//line sql.y:1606
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 249:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1537
+//line sql.y:1612
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal, Direction: yyDollar[3].orderDirection}
 		}
-	case 250:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1543
+//line sql.y:1618
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 251:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1547
+//line sql.y:1622
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 252:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1553
+//line sql.y:1628
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 253:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1557
+//line sql.y:1632
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 254:
+	case 261:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1563
+//line sql.y:1638
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 255:
+	case 262:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1567
+//line sql.y:1642
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 256:
+	case 263:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1571
+//line sql.y:1646
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 257:
+	case 264:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1575
+//line sql.y:1650
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 258:
+	case 265:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1581
+//line sql.y:1656
 		{
 			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
 		}
-	case 259:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1587
+//line sql.y:1662
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 260:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1593
+//line sql.y:1668
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 261:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1599
+//line sql.y:1674
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 262:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1603
+//line sql.y:1678
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 263:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1607
+//line sql.y:1682
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 264:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1611
+//line sql.y:1686
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 265:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1615
+//line sql.y:1690
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 266:
+	case 273:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1620
+//line sql.y:1695
 		{
 			yyVAL.str = ""
 		}
-	case 267:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1624
+//line sql.y:1699
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 268:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1628
+//line sql.y:1703
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 269:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1633
+//line sql.y:1708
 		{
 			yyVAL.boolean = true
 		}
-	case 270:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1637
+//line sql.y:1712
 		{
 			yyVAL.boolean = true
 		}
-	case 271:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1641
+//line sql.y:1716
 		{
 			yyVAL.boolean = false
 		}
-	case 272:
+	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1646
+//line sql.y:1721
 		{
 			yyVAL.tableOptions = nil
 		}
-	case 273:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1650
+//line sql.y:1725
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 		}
-	case 274:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1656
+//line sql.y:1731
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 275:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1660
+//line sql.y:1735
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[3].tableOption)
 		}
-	case 276:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1664
+//line sql.y:1739
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 277:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1670
+//line sql.y:1745
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 278:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1674
+//line sql.y:1749
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 279:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1680
+//line sql.y:1755
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1684
+//line sql.y:1759
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 281:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1688
+//line sql.y:1763
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[2].bytes)), String: yyDollar[4].str}
 		}
-	case 282:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1692
+//line sql.y:1767
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[2].bytes), String: yyDollar[4].str}
 		}
-	case 283:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1696
+//line sql.y:1771
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 284:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1700
+//line sql.y:1775
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1704
+//line sql.y:1779
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 286:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1708
+//line sql.y:1783
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 287:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1712
+//line sql.y:1787
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 288:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1716
+//line sql.y:1791
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 289:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1720
+//line sql.y:1795
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 290:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1724
+//line sql.y:1799
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 291:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1728
+//line sql.y:1803
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 292:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1732
+//line sql.y:1807
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 293:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1736
+//line sql.y:1811
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 294:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1740
+//line sql.y:1815
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 295:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1744
+//line sql.y:1819
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 296:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1748
+//line sql.y:1823
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 297:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1752
+//line sql.y:1827
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 298:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1756
+//line sql.y:1831
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 299:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1760
+//line sql.y:1835
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 300:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1764
+//line sql.y:1839
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 301:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1768
+//line sql.y:1843
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 302:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1772
+//line sql.y:1847
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 303:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1776
+//line sql.y:1851
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 304:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1780
+//line sql.y:1855
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 305:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1784
+//line sql.y:1859
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 306:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1788
+//line sql.y:1863
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
-	case 307:
+	case 314:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1792
+//line sql.y:1867
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[4].tableNames}
 		}
-	case 308:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1797
+//line sql.y:1872
 		{
 			yyVAL.str = ""
 		}
-	case 309:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1801
+//line sql.y:1876
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 310:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1805
+//line sql.y:1880
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 320:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1824
+//line sql.y:1899
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 321:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1828
+//line sql.y:1903
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 322:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1832
+//line sql.y:1907
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 323:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1837
+//line sql.y:1912
 		{
 			yyVAL.bytes = []byte("")
 		}
-	case 325:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1843
+//line sql.y:1918
 		{
 			yyVAL.colName = nil
 		}
-	case 326:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1847
+//line sql.y:1922
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 327:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1852
+//line sql.y:1927
 		{
 			yyVAL.colName = nil
 		}
-	case 328:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1856
+//line sql.y:1931
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 329:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1861
+//line sql.y:1936
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 330:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1865
+//line sql.y:1940
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 331:
+	case 338:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1869
+//line sql.y:1944
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, &OrderByOption{Cols: yyDollar[5].columns})
 		}
-	case 332:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1873
+//line sql.y:1948
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 333:
+	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1877
+//line sql.y:1952
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...)
 		}
-	case 334:
+	case 341:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1881
+//line sql.y:1956
 		{
 			yyVAL.alterOptions = append(append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...), &OrderByOption{Cols: yyDollar[7].columns})
 		}
-	case 335:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1887
+//line sql.y:1962
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 336:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1891
+//line sql.y:1966
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 337:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1895
+//line sql.y:1970
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 338:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1901
+//line sql.y:1976
 		{
 			yyVAL.alterOption = yyDollar[1].tableOptions
 		}
-	case 339:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1905
+//line sql.y:1980
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 340:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1909
+//line sql.y:1984
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 341:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1913
+//line sql.y:1988
 		{
 			yyVAL.alterOption = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinition}
 		}
-	case 342:
+	case 349:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1917
+//line sql.y:1992
 		{
 			yyVAL.alterOption = &AddColumns{Columns: yyDollar[4].columnDefinitions}
 		}
-	case 343:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1921
+//line sql.y:1996
 		{
 			yyVAL.alterOption = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinition}, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 344:
+	case 351:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1925
+//line sql.y:2000
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: true}
 		}
-	case 345:
+	case 352:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1929
+//line sql.y:2004
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: false, DefaultVal: yyDollar[6].expr}
 		}
-	case 346:
+	case 353:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1933
+//line sql.y:2008
 		{
 			yyVAL.alterOption = &ChangeColumn{OldColumn: yyDollar[3].colName, NewColDefinition: yyDollar[4].columnDefinition, First: yyDollar[5].colName, After: yyDollar[6].colName}
 		}
-	case 347:
+	case 354:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1937
+//line sql.y:2012
 		{
 			yyVAL.alterOption = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinition, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 348:
+	case 355:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1941
+//line sql.y:2016
 		{
 			yyVAL.alterOption = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 349:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1945
+//line sql.y:2020
 		{
 			yyVAL.alterOption = &KeyState{Enable: false}
 		}
-	case 350:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1949
+//line sql.y:2024
 		{
 			yyVAL.alterOption = &KeyState{Enable: true}
 		}
-	case 351:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1953
+//line sql.y:2028
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: false}
 		}
-	case 352:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1957
+//line sql.y:2032
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: true}
 		}
-	case 353:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1961
+//line sql.y:2036
 		{
 			yyVAL.alterOption = &DropColumn{Name: yyDollar[3].colName}
 		}
-	case 354:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1965
+//line sql.y:2040
 		{
 			yyVAL.alterOption = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}
 		}
-	case 355:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1969
+//line sql.y:2044
 		{
 			yyVAL.alterOption = &DropKey{Type: PrimaryKeyType}
 		}
-	case 356:
+	case 363:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1973
+//line sql.y:2048
 		{
 			yyVAL.alterOption = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent.String()}
 		}
-	case 357:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1977
+//line sql.y:2052
 		{
 			yyVAL.alterOption = &Force{}
 		}
-	case 358:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1981
+//line sql.y:2056
 		{
 			yyVAL.alterOption = &RenameTableName{Table: yyDollar[3].tableName}
 		}
-	case 359:
+	case 366:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1985
+//line sql.y:2060
 		{
 			yyVAL.alterOption = &RenameIndex{OldName: yyDollar[3].colIdent.String(), NewName: yyDollar[5].colIdent.String()}
 		}
-	case 360:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1991
+//line sql.y:2066
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 361:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1995
+//line sql.y:2070
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 362:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2001
+//line sql.y:2076
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 363:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2005
+//line sql.y:2080
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 364:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2009
+//line sql.y:2084
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 365:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2013
+//line sql.y:2088
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 366:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2017
+//line sql.y:2092
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 367:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2021
+//line sql.y:2096
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 368:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2025
+//line sql.y:2100
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 369:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2029
+//line sql.y:2104
 		{
 			yyVAL.alterOption = &Validation{With: true}
 		}
-	case 370:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2033
+//line sql.y:2108
 		{
 			yyVAL.alterOption = &Validation{With: false}
 		}
-	case 371:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2039
+//line sql.y:2114
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 372:
+	case 379:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2045
+//line sql.y:2120
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = &PartitionSpec{Action: RemoveAction}
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 373:
+	case 380:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2052
+//line sql.y:2127
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[4].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 374:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2059
+//line sql.y:2134
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[2].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 375:
+	case 382:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2065
+//line sql.y:2140
 		{
 			yyVAL.statement = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columns, Select: yyDollar[9].selStmt, CheckOption: yyDollar[10].str}
 		}
-	case 376:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2069
+//line sql.y:2144
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.AlterOptions = yyDollar[3].collateAndCharsets
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 377:
+	case 384:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2076
+//line sql.y:2151
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.UpdateDataDirectory = true
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 378:
+	case 385:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2083
+//line sql.y:2158
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -7445,9 +7662,9 @@ yydefault:
 				},
 			}
 		}
-	case 379:
+	case 386:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2095
+//line sql.y:2170
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -7457,21 +7674,21 @@ yydefault:
 				},
 			}
 		}
-	case 380:
+	case 387:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2105
+//line sql.y:2180
 		{
 			yyVAL.statement = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 381:
+	case 388:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2109
+//line sql.y:2184
 		{
 			yyVAL.statement = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 382:
+	case 389:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2113
+//line sql.y:2188
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -7484,9 +7701,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 383:
+	case 390:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2126
+//line sql.y:2201
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -7496,15 +7713,15 @@ yydefault:
 				},
 			}
 		}
-	case 384:
+	case 391:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2136
+//line sql.y:2211
 		{
 			yyVAL.statement = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 385:
+	case 392:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2140
+//line sql.y:2215
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -7515,207 +7732,207 @@ yydefault:
 				},
 			}
 		}
-	case 386:
+	case 393:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2153
+//line sql.y:2228
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDef}}
 		}
-	case 387:
+	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2157
+//line sql.y:2232
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitions}
 		}
-	case 388:
+	case 395:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2161
+//line sql.y:2236
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitions, Definitions: yyDollar[6].partDefs}
 		}
-	case 389:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2165
+//line sql.y:2240
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitions}
 		}
-	case 390:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2169
+//line sql.y:2244
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
-	case 391:
+	case 398:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2173
+//line sql.y:2248
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitions}
 		}
-	case 392:
+	case 399:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2177
+//line sql.y:2252
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
-	case 393:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2181
+//line sql.y:2256
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitions}
 		}
-	case 394:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2185
+//line sql.y:2260
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
-	case 395:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2189
+//line sql.y:2264
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 396:
+	case 403:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2193
+//line sql.y:2268
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].boolean}
 		}
-	case 397:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2197
+//line sql.y:2272
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitions}
 		}
-	case 398:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2201
+//line sql.y:2276
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
-	case 399:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2205
+//line sql.y:2280
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitions}
 		}
-	case 400:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2209
+//line sql.y:2284
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
-	case 401:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2213
+//line sql.y:2288
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitions}
 		}
-	case 402:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2217
+//line sql.y:2292
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
-	case 403:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2221
+//line sql.y:2296
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitions}
 		}
-	case 404:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2225
+//line sql.y:2300
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
-	case 405:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2229
+//line sql.y:2304
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitions}
 		}
-	case 406:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2233
+//line sql.y:2308
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
-	case 407:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2237
+//line sql.y:2312
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: UpgradeAction}
 		}
-	case 408:
+	case 415:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2242
+//line sql.y:2317
 		{
 			yyVAL.boolean = false
 		}
-	case 409:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2246
+//line sql.y:2321
 		{
 			yyVAL.boolean = false
 		}
-	case 410:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2250
+//line sql.y:2325
 		{
 			yyVAL.boolean = true
 		}
-	case 411:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2257
+//line sql.y:2332
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 412:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2261
+//line sql.y:2336
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 413:
+	case 420:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2267
+//line sql.y:2342
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 414:
+	case 421:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2271
+//line sql.y:2346
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 415:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2277
+//line sql.y:2352
 		{
 			yyVAL.statement = &RenameTable{TablePairs: yyDollar[3].renameTablePairs}
 		}
-	case 416:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2283
+//line sql.y:2358
 		{
 			yyVAL.renameTablePairs = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
-	case 417:
+	case 424:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2287
+//line sql.y:2362
 		{
 			yyVAL.renameTablePairs = append(yyDollar[1].renameTablePairs, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 418:
+	case 425:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2293
+//line sql.y:2368
 		{
 			yyVAL.statement = &DropTable{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
-	case 419:
+	case 426:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2297
+//line sql.y:2372
 		{
 			// Change this to an alter statement
 			if yyDollar[3].colIdent.Lowered() == "primary" {
@@ -7724,214 +7941,214 @@ yydefault:
 				yyVAL.statement = &AlterTable{Table: yyDollar[5].tableName, AlterOptions: append([]AlterOption{&DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}}, yyDollar[6].alterOptions...)}
 			}
 		}
-	case 420:
+	case 427:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2306
+//line sql.y:2381
 		{
 			yyVAL.statement = &DropView{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
-	case 421:
+	case 428:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2310
+//line sql.y:2385
 		{
 			yyVAL.statement = &DropDatabase{DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
 		}
-	case 422:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2316
+//line sql.y:2391
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[3].tableName}
 		}
-	case 423:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2320
+//line sql.y:2395
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[2].tableName}
 		}
-	case 424:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2325
+//line sql.y:2400
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 425:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2331
+//line sql.y:2406
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
 		}
-	case 426:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2335
+//line sql.y:2410
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
 		}
-	case 427:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2339
+//line sql.y:2414
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 428:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2343
+//line sql.y:2418
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 429:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2347
+//line sql.y:2422
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
-	case 430:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2351
+//line sql.y:2426
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
-	case 431:
+	case 438:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2355
+//line sql.y:2430
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
 		}
-	case 432:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2359
+//line sql.y:2434
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
 		}
-	case 433:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2363
+//line sql.y:2438
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
 		}
-	case 434:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2367
+//line sql.y:2442
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 435:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2371
+//line sql.y:2446
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 436:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2375
+//line sql.y:2450
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 437:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2379
+//line sql.y:2454
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 438:
+	case 445:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2383
+//line sql.y:2458
 		{
 			yyVAL.statement = &Show{&ShowTableStatus{DatabaseName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 439:
+	case 446:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2387
+//line sql.y:2462
 		{
 			yyVAL.statement = &Show{&ShowColumns{Full: yyDollar[2].str, Table: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 440:
+	case 447:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2391
+//line sql.y:2466
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 441:
+	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2395
+//line sql.y:2470
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 442:
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2399
+//line sql.y:2474
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 443:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2403
+//line sql.y:2478
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 444:
+	case 451:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2408
+//line sql.y:2483
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 445:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2412
+//line sql.y:2487
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 446:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2416
+//line sql.y:2491
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 447:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2420
+//line sql.y:2495
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 448:
+	case 455:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2424
+//line sql.y:2499
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 449:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2428
+//line sql.y:2503
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 450:
+	case 457:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2432
+//line sql.y:2507
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 451:
+	case 458:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2436
+//line sql.y:2511
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Extended: string(yyDollar[2].str), Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 452:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2441
+//line sql.y:2516
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 453:
+	case 460:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2445
+//line sql.y:2520
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 454:
+	case 461:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2449
+//line sql.y:2524
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -7941,1058 +8158,1058 @@ yydefault:
 				yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 			}
 		}
-	case 455:
+	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2459
+//line sql.y:2534
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}}
 		}
-	case 456:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2464
+//line sql.y:2539
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 457:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2468
+//line sql.y:2543
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 458:
+	case 465:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2472
+//line sql.y:2547
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 459:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2476
+//line sql.y:2551
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 460:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2481
+//line sql.y:2556
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
-	case 461:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2495
+//line sql.y:2570
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 462:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2499
+//line sql.y:2574
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 463:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2503
+//line sql.y:2578
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 464:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2509
+//line sql.y:2584
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 465:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2513
+//line sql.y:2588
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 466:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2519
+//line sql.y:2594
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 467:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2523
+//line sql.y:2598
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 468:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2529
+//line sql.y:2604
 		{
 			yyVAL.str = ""
 		}
-	case 469:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2533
+//line sql.y:2608
 		{
 			yyVAL.str = "extended "
 		}
-	case 470:
+	case 477:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2539
+//line sql.y:2614
 		{
 			yyVAL.str = ""
 		}
-	case 471:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2543
+//line sql.y:2618
 		{
 			yyVAL.str = "full "
 		}
-	case 472:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2549
+//line sql.y:2624
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 473:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2553
+//line sql.y:2628
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 474:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2559
+//line sql.y:2634
 		{
 			yyVAL.str = ""
 		}
-	case 475:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2563
+//line sql.y:2638
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 476:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2567
+//line sql.y:2642
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 477:
+	case 484:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2573
+//line sql.y:2648
 		{
 			yyVAL.showFilter = nil
 		}
-	case 478:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2577
+//line sql.y:2652
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 479:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2581
+//line sql.y:2656
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 480:
+	case 487:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2587
+//line sql.y:2662
 		{
 			yyVAL.showFilter = nil
 		}
-	case 481:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2591
+//line sql.y:2666
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 482:
+	case 489:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2597
+//line sql.y:2672
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 483:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2601
+//line sql.y:2676
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 484:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2605
+//line sql.y:2680
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 485:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2611
+//line sql.y:2686
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 486:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2615
+//line sql.y:2690
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 487:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2621
+//line sql.y:2696
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 488:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2625
+//line sql.y:2700
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 489:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2631
+//line sql.y:2706
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 490:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2637
+//line sql.y:2712
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 491:
+	case 498:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2641
+//line sql.y:2716
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 492:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2646
+//line sql.y:2721
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 493:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2648
+//line sql.y:2723
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 494:
+	case 501:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2651
+//line sql.y:2726
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 495:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2653
+//line sql.y:2728
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 496:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2658
+//line sql.y:2733
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 497:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2664
+//line sql.y:2739
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 498:
+	case 505:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2669
+//line sql.y:2744
 		{
 			yyVAL.explainType = EmptyType
 		}
-	case 499:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2673
+//line sql.y:2748
 		{
 			yyVAL.explainType = JSONType
 		}
-	case 500:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2677
+//line sql.y:2752
 		{
 			yyVAL.explainType = TreeType
 		}
-	case 501:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2681
+//line sql.y:2756
 		{
 			yyVAL.explainType = VitessType
 		}
-	case 502:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2685
+//line sql.y:2760
 		{
 			yyVAL.explainType = TraditionalType
 		}
-	case 503:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2689
+//line sql.y:2764
 		{
 			yyVAL.explainType = AnalyzeType
 		}
-	case 504:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2695
+//line sql.y:2770
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 505:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2699
+//line sql.y:2774
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 506:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2703
+//line sql.y:2778
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 507:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2709
+//line sql.y:2784
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 508:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2713
+//line sql.y:2788
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 509:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2717
+//line sql.y:2792
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 510:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2721
+//line sql.y:2796
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 511:
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2726
+//line sql.y:2801
 		{
 			yyVAL.str = ""
 		}
-	case 512:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2730
+//line sql.y:2805
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 513:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2734
+//line sql.y:2809
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 514:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2740
+//line sql.y:2815
 		{
 			yyVAL.statement = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
-	case 515:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2744
+//line sql.y:2819
 		{
 			yyVAL.statement = &ExplainStmt{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
 		}
-	case 516:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2750
+//line sql.y:2825
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 517:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2754
+//line sql.y:2829
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 518:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2760
+//line sql.y:2835
 		{
 			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
 		}
-	case 519:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2766
+//line sql.y:2841
 		{
 			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
 		}
-	case 520:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2770
+//line sql.y:2845
 		{
 			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
 		}
-	case 521:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2776
+//line sql.y:2851
 		{
 			yyVAL.tableAndLockType = &TableAndLockType{Table: yyDollar[1].aliasedTableName, Lock: yyDollar[2].lockType}
 		}
-	case 522:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2782
+//line sql.y:2857
 		{
 			yyVAL.lockType = Read
 		}
-	case 523:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2786
+//line sql.y:2861
 		{
 			yyVAL.lockType = ReadLocal
 		}
-	case 524:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2790
+//line sql.y:2865
 		{
 			yyVAL.lockType = Write
 		}
-	case 525:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2794
+//line sql.y:2869
 		{
 			yyVAL.lockType = LowPriorityWrite
 		}
-	case 526:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2800
+//line sql.y:2875
 		{
 			yyVAL.statement = &UnlockTables{}
 		}
-	case 527:
+	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2806
+//line sql.y:2881
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, FlushOptions: yyDollar[3].strs}
 		}
-	case 528:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2810
+//line sql.y:2885
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean}
 		}
-	case 529:
+	case 536:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2814
+//line sql.y:2889
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, WithLock: true}
 		}
-	case 530:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2818
+//line sql.y:2893
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames}
 		}
-	case 531:
+	case 538:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2822
+//line sql.y:2897
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, WithLock: true}
 		}
-	case 532:
+	case 539:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2826
+//line sql.y:2901
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, ForExport: true}
 		}
-	case 533:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2832
+//line sql.y:2907
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 534:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2836
+//line sql.y:2911
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 535:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2842
+//line sql.y:2917
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 536:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2846
+//line sql.y:2921
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 537:
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2850
+//line sql.y:2925
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 538:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2854
+//line sql.y:2929
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 539:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2858
+//line sql.y:2933
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 540:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2862
+//line sql.y:2937
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 541:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2866
+//line sql.y:2941
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 542:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2870
+//line sql.y:2945
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + yyDollar[3].str
 		}
-	case 543:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2874
+//line sql.y:2949
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 544:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2878
+//line sql.y:2953
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 545:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2882
+//line sql.y:2957
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 546:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2886
+//line sql.y:2961
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 547:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2891
+//line sql.y:2966
 		{
 			yyVAL.boolean = false
 		}
-	case 548:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2895
+//line sql.y:2970
 		{
 			yyVAL.boolean = true
 		}
-	case 549:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2899
+//line sql.y:2974
 		{
 			yyVAL.boolean = true
 		}
-	case 550:
+	case 557:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2904
+//line sql.y:2979
 		{
 			yyVAL.str = ""
 		}
-	case 551:
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2908
+//line sql.y:2983
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[3].colIdent.String()
 		}
-	case 552:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2913
+//line sql.y:2988
 		{
 			setAllowComments(yylex, true)
 		}
-	case 553:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2917
+//line sql.y:2992
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 554:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2923
+//line sql.y:2998
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 555:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2927
+//line sql.y:3002
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 556:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2933
+//line sql.y:3008
 		{
 			yyVAL.boolean = true
 		}
-	case 557:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2937
+//line sql.y:3012
 		{
 			yyVAL.boolean = false
 		}
-	case 558:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2941
+//line sql.y:3016
 		{
 			yyVAL.boolean = true
 		}
-	case 559:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2946
+//line sql.y:3021
 		{
 			yyVAL.str = ""
 		}
-	case 560:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2950
+//line sql.y:3025
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 561:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2954
+//line sql.y:3029
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 562:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2959
+//line sql.y:3034
 		{
 			yyVAL.boolean = false
 		}
-	case 563:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2963
+//line sql.y:3038
 		{
 			yyVAL.boolean = true
 		}
-	case 564:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2967
+//line sql.y:3042
 		{
 			yyVAL.boolean = true
 		}
-	case 565:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2972
+//line sql.y:3047
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 566:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2976
+//line sql.y:3051
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 567:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2981
+//line sql.y:3056
 		{
 			yyVAL.strs = nil
 		}
-	case 568:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2985
+//line sql.y:3060
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 569:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2989
+//line sql.y:3064
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 570:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2993
+//line sql.y:3068
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 571:
+	case 578:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2997
+//line sql.y:3072
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 572:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3003
+//line sql.y:3078
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 573:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3007
+//line sql.y:3082
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 574:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3011
+//line sql.y:3086
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 575:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3015
+//line sql.y:3090
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 576:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3019
+//line sql.y:3094
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 577:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3023
+//line sql.y:3098
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 578:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3029
+//line sql.y:3104
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 579:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3033
+//line sql.y:3108
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 580:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3039
+//line sql.y:3114
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 581:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3043
+//line sql.y:3118
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 582:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3047
+//line sql.y:3122
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 583:
+	case 590:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3051
+//line sql.y:3126
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 584:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3056
+//line sql.y:3131
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 585:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3060
+//line sql.y:3135
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 586:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3064
+//line sql.y:3139
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 588:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3071
+//line sql.y:3146
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 589:
+	case 596:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3076
+//line sql.y:3151
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 590:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3080
+//line sql.y:3155
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 591:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3086
+//line sql.y:3161
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 592:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3090
+//line sql.y:3165
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 595:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3100
+//line sql.y:3175
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 596:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3104
+//line sql.y:3179
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].derivedTable, As: yyDollar[3].tableIdent}
 		}
-	case 597:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3108
+//line sql.y:3183
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 598:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3114
+//line sql.y:3189
 		{
 			yyVAL.derivedTable = &DerivedTable{yyDollar[2].selStmt}
 		}
-	case 599:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3120
+//line sql.y:3195
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 600:
+	case 607:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3124
+//line sql.y:3199
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 601:
+	case 608:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3129
+//line sql.y:3204
 		{
 			yyVAL.columns = nil
 		}
-	case 602:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3133
+//line sql.y:3208
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
-	case 603:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3139
+//line sql.y:3214
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 604:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3143
+//line sql.y:3218
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 605:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3149
+//line sql.y:3224
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 606:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3153
+//line sql.y:3228
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 607:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3166
+//line sql.y:3241
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 608:
+	case 615:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3170
+//line sql.y:3245
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 609:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3174
+//line sql.y:3249
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 610:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3178
+//line sql.y:3253
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 611:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3184
+//line sql.y:3259
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 612:
+	case 619:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3186
+//line sql.y:3261
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 613:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3190
+//line sql.y:3265
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 614:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3192
+//line sql.y:3267
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 615:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3196
+//line sql.y:3271
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 616:
+	case 623:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3198
+//line sql.y:3273
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 617:
+	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3201
+//line sql.y:3276
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 618:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3203
+//line sql.y:3278
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 619:
+	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3206
+//line sql.y:3281
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 620:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3210
+//line sql.y:3285
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 621:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3214
+//line sql.y:3289
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 623:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3221
+//line sql.y:3296
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 624:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3227
+//line sql.y:3302
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 625:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3231
+//line sql.y:3306
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 626:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3235
+//line sql.y:3310
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 627:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3241
+//line sql.y:3316
 		{
 			yyVAL.joinType = StraightJoinType
 		}
-	case 628:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3247
+//line sql.y:3322
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 629:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3251
+//line sql.y:3326
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 630:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3255
+//line sql.y:3330
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 631:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3259
+//line sql.y:3334
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 632:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3265
+//line sql.y:3340
 		{
 			yyVAL.joinType = NaturalJoinType
 		}
-	case 633:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3269
+//line sql.y:3344
 		{
 			if yyDollar[2].joinType == LeftJoinType {
 				yyVAL.joinType = NaturalLeftJoinType
@@ -9000,489 +9217,489 @@ yydefault:
 				yyVAL.joinType = NaturalRightJoinType
 			}
 		}
-	case 634:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3279
+//line sql.y:3354
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 635:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3283
+//line sql.y:3358
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 636:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3289
+//line sql.y:3364
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 637:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3293
+//line sql.y:3368
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 638:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3299
+//line sql.y:3374
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 639:
+	case 646:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3304
+//line sql.y:3379
 		{
 			yyVAL.indexHints = nil
 		}
-	case 640:
+	case 647:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3308
+//line sql.y:3383
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columns}
 		}
-	case 641:
+	case 648:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3312
+//line sql.y:3387
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp}
 		}
-	case 642:
+	case 649:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3316
+//line sql.y:3391
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columns}
 		}
-	case 643:
+	case 650:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3320
+//line sql.y:3395
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columns}
 		}
-	case 644:
+	case 651:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3325
+//line sql.y:3400
 		{
 			yyVAL.expr = nil
 		}
-	case 645:
+	case 652:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3329
+//line sql.y:3404
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 646:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3335
+//line sql.y:3410
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 647:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3339
+//line sql.y:3414
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 648:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3343
+//line sql.y:3418
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 649:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3347
+//line sql.y:3422
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 650:
+	case 657:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3351
+//line sql.y:3426
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 651:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3355
+//line sql.y:3430
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
 		}
-	case 652:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3359
+//line sql.y:3434
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 653:
+	case 660:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3363
+//line sql.y:3438
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 654:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3369
+//line sql.y:3444
 		{
 			yyVAL.str = ""
 		}
-	case 655:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3373
+//line sql.y:3448
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 656:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3379
+//line sql.y:3454
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 657:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3383
+//line sql.y:3458
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 658:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3389
+//line sql.y:3464
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
 		}
-	case 659:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3393
+//line sql.y:3468
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
 		}
-	case 660:
+	case 667:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3397
+//line sql.y:3472
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
 		}
-	case 661:
+	case 668:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3401
+//line sql.y:3476
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 662:
+	case 669:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3405
+//line sql.y:3480
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 663:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3409
+//line sql.y:3484
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
 		}
-	case 664:
+	case 671:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3413
+//line sql.y:3488
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
 		}
-	case 665:
+	case 672:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3417
+//line sql.y:3492
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 666:
+	case 673:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3421
+//line sql.y:3496
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 667:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3425
+//line sql.y:3500
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 668:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3431
+//line sql.y:3506
 		{
 			yyVAL.isExprOperator = IsNullOp
 		}
-	case 669:
+	case 676:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3435
+//line sql.y:3510
 		{
 			yyVAL.isExprOperator = IsNotNullOp
 		}
-	case 670:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3439
+//line sql.y:3514
 		{
 			yyVAL.isExprOperator = IsTrueOp
 		}
-	case 671:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3443
+//line sql.y:3518
 		{
 			yyVAL.isExprOperator = IsNotTrueOp
 		}
-	case 672:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3447
+//line sql.y:3522
 		{
 			yyVAL.isExprOperator = IsFalseOp
 		}
-	case 673:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3451
+//line sql.y:3526
 		{
 			yyVAL.isExprOperator = IsNotFalseOp
 		}
-	case 674:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3457
+//line sql.y:3532
 		{
 			yyVAL.comparisonExprOperator = EqualOp
 		}
-	case 675:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3461
+//line sql.y:3536
 		{
 			yyVAL.comparisonExprOperator = LessThanOp
 		}
-	case 676:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3465
+//line sql.y:3540
 		{
 			yyVAL.comparisonExprOperator = GreaterThanOp
 		}
-	case 677:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3469
+//line sql.y:3544
 		{
 			yyVAL.comparisonExprOperator = LessEqualOp
 		}
-	case 678:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3473
+//line sql.y:3548
 		{
 			yyVAL.comparisonExprOperator = GreaterEqualOp
 		}
-	case 679:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3477
+//line sql.y:3552
 		{
 			yyVAL.comparisonExprOperator = NotEqualOp
 		}
-	case 680:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3481
+//line sql.y:3556
 		{
 			yyVAL.comparisonExprOperator = NullSafeEqualOp
 		}
-	case 681:
+	case 688:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3486
+//line sql.y:3561
 		{
 			yyVAL.expr = nil
 		}
-	case 682:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3490
+//line sql.y:3565
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 683:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3496
+//line sql.y:3571
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 684:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3500
+//line sql.y:3575
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 685:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3504
+//line sql.y:3579
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 686:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3510
+//line sql.y:3585
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 687:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3516
+//line sql.y:3591
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 688:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3520
+//line sql.y:3595
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 689:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3526
+//line sql.y:3601
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 690:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3530
+//line sql.y:3605
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 691:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3534
+//line sql.y:3609
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 692:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3538
+//line sql.y:3613
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 693:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3542
+//line sql.y:3617
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 694:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3546
+//line sql.y:3621
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
 		}
-	case 695:
+	case 702:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3550
+//line sql.y:3625
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
 		}
-	case 696:
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3554
+//line sql.y:3629
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
 		}
-	case 697:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3558
+//line sql.y:3633
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
 		}
-	case 698:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3562
+//line sql.y:3637
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
 		}
-	case 699:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3566
+//line sql.y:3641
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
 		}
-	case 700:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3570
+//line sql.y:3645
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
 		}
-	case 701:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3574
+//line sql.y:3649
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
 		}
-	case 702:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3578
+//line sql.y:3653
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 703:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3582
+//line sql.y:3657
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 704:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3586
+//line sql.y:3661
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
 		}
-	case 705:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3590
+//line sql.y:3665
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
 		}
-	case 706:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3594
+//line sql.y:3669
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 707:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3598
+//line sql.y:3673
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 708:
+	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3602
+//line sql.y:3677
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 709:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3606
+//line sql.y:3681
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 710:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3610
+//line sql.y:3685
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 711:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3614
+//line sql.y:3689
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
 		}
-	case 712:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3618
+//line sql.y:3693
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
 		}
-	case 713:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3622
+//line sql.y:3697
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
 		}
-	case 714:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3626
+//line sql.y:3701
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -9490,9 +9707,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 715:
+	case 722:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3634
+//line sql.y:3709
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -9506,21 +9723,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 716:
+	case 723:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3648
+//line sql.y:3723
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].expr}
 		}
-	case 717:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3652
+//line sql.y:3727
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].expr}
 		}
-	case 718:
+	case 725:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3656
+//line sql.y:3731
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -9528,503 +9745,503 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 723:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3674
+	case 730:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3749
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 724:
+	case 731:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3678
+//line sql.y:3753
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 725:
+	case 732:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3682
+//line sql.y:3757
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 726:
+	case 733:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3686
+//line sql.y:3761
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 727:
+	case 734:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3696
+//line sql.y:3771
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 728:
+	case 735:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3700
+//line sql.y:3775
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 729:
+	case 736:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3704
+//line sql.y:3779
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 730:
+	case 737:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3708
+//line sql.y:3783
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 731:
+	case 738:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3712
+//line sql.y:3787
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 732:
+	case 739:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3716
+//line sql.y:3791
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 733:
+	case 740:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3720
+//line sql.y:3795
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 734:
+	case 741:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3724
+//line sql.y:3799
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 735:
+	case 742:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3728
+//line sql.y:3803
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 736:
+	case 743:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3732
+//line sql.y:3807
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
 		}
-	case 737:
+	case 744:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3736
+//line sql.y:3811
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 738:
+	case 745:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3740
+//line sql.y:3815
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 739:
+	case 746:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3744
+//line sql.y:3819
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 740:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3754
+//line sql.y:3829
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 741:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3758
+//line sql.y:3833
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 742:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3762
+//line sql.y:3837
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 743:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3767
+//line sql.y:3842
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 744:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3772
+//line sql.y:3847
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 745:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3777
+//line sql.y:3852
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 746:
+	case 753:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3783
+//line sql.y:3858
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 747:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3788
+//line sql.y:3863
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 748:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3793
+//line sql.y:3868
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 749:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3797
+//line sql.y:3872
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 750:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3801
+//line sql.y:3876
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 751:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3806
+//line sql.y:3881
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 752:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3811
+//line sql.y:3886
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 753:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3816
+//line sql.y:3891
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 754:
+	case 761:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3820
+//line sql.y:3895
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 755:
+	case 762:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3824
+//line sql.y:3899
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 758:
+	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3834
+//line sql.y:3909
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 759:
+	case 766:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3844
+//line sql.y:3919
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 760:
+	case 767:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3848
+//line sql.y:3923
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 761:
+	case 768:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3852
+//line sql.y:3927
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 762:
+	case 769:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3856
+//line sql.y:3931
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 763:
+	case 770:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3860
+//line sql.y:3935
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 764:
+	case 771:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3864
+//line sql.y:3939
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 765:
+	case 772:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3868
+//line sql.y:3943
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 766:
+	case 773:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3874
+//line sql.y:3949
 		{
 			yyVAL.matchExprOption = NoOption
 		}
-	case 767:
+	case 774:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3878
+//line sql.y:3953
 		{
 			yyVAL.matchExprOption = BooleanModeOpt
 		}
-	case 768:
+	case 775:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3882
+//line sql.y:3957
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeOpt
 		}
-	case 769:
+	case 776:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3886
+//line sql.y:3961
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
 		}
-	case 770:
+	case 777:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3890
+//line sql.y:3965
 		{
 			yyVAL.matchExprOption = QueryExpansionOpt
 		}
-	case 771:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3896
+//line sql.y:3971
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 772:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3900
+//line sql.y:3975
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 773:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3904
+//line sql.y:3979
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 774:
+	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3910
+//line sql.y:3985
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 775:
+	case 782:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3914
+//line sql.y:3989
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
-	case 776:
+	case 783:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3918
+//line sql.y:3993
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 777:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3922
+//line sql.y:3997
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 778:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3926
+//line sql.y:4001
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 779:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3930
+//line sql.y:4005
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 780:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3936
+//line sql.y:4011
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 781:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3940
+//line sql.y:4015
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 782:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3944
+//line sql.y:4019
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 783:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3948
+//line sql.y:4023
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 784:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3952
+//line sql.y:4027
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 785:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3956
+//line sql.y:4031
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 786:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3960
+//line sql.y:4035
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 787:
+	case 794:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3965
+//line sql.y:4040
 		{
 			yyVAL.expr = nil
 		}
-	case 788:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3969
+//line sql.y:4044
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 789:
+	case 796:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3974
+//line sql.y:4049
 		{
 			yyVAL.str = string("")
 		}
-	case 790:
+	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3978
+//line sql.y:4053
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 791:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3984
+//line sql.y:4059
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 792:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3988
+//line sql.y:4063
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 793:
+	case 800:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3994
+//line sql.y:4069
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 794:
+	case 801:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3999
+//line sql.y:4074
 		{
 			yyVAL.expr = nil
 		}
-	case 795:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4003
+//line sql.y:4078
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 796:
+	case 803:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4009
+//line sql.y:4084
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 797:
+	case 804:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4013
+//line sql.y:4088
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 798:
+	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4017
+//line sql.y:4092
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 799:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4023
+//line sql.y:4098
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 800:
+	case 807:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4027
+//line sql.y:4102
 		{
 			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
-	case 801:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4031
+//line sql.y:4106
 		{
 			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
-	case 802:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4035
+//line sql.y:4110
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 803:
+	case 810:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4039
+//line sql.y:4114
 		{
 			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
-	case 804:
+	case 811:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4043
+//line sql.y:4118
 		{
 			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
-	case 805:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4047
+//line sql.y:4122
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 806:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4051
+//line sql.y:4126
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 807:
+	case 814:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4057
+//line sql.y:4132
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -10033,597 +10250,753 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 808:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4066
+//line sql.y:4141
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 809:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4070
+//line sql.y:4145
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 810:
+	case 817:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4075
+//line sql.y:4150
 		{
 			yyVAL.exprs = nil
 		}
-	case 811:
+	case 818:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4079
+//line sql.y:4154
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 812:
+	case 819:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4084
+//line sql.y:4159
 		{
 			yyVAL.expr = nil
 		}
-	case 813:
+	case 820:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4088
+//line sql.y:4163
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 814:
+	case 821:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4168
+		{
+			yyVAL.overClause = nil
+		}
+	case 822:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4172
+		{
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+	case 823:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4176
+		{
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[3].windowSpec}
+		}
+	case 824:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4182
+		{
+			yyVAL.windowSpec = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionClause: yyDollar[2].exprs, OrderClause: yyDollar[3].orderBy, FrameClause: yyDollar[4].frameClause}
+		}
+	case 825:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4195
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 826:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4199
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 827:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4204
+		{
+			yyVAL.exprs = nil
+		}
+	case 828:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4208
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 829:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4093
+//line sql.y:4213
+		{
+			yyVAL.frameClause = nil
+		}
+	case 830:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4217
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[2].framePoint}
+		}
+	case 831:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4221
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 832:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4227
+		{
+			yyVAL.frameUnit = FrameRowsType
+		}
+	case 833:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4231
+		{
+			yyVAL.frameUnit = FrameRangeType
+		}
+	case 834:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4237
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowType}
+		}
+	case 835:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4241
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingType}
+		}
+	case 836:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4245
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingType}
+		}
+	case 837:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4249
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].expr}
+		}
+	case 838:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4253
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].expr}
+		}
+	case 839:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4259
+		{
+			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
+		}
+	case 840:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4263
+		{
+			yyVAL.expr = NewArgument(yyDollar[1].bytes)
+		}
+	case 841:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4267
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 842:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4272
+		{
+			yyVAL.windowDefs = nil
+		}
+	case 843:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4276
+		{
+			yyVAL.windowDefs = yyDollar[2].windowDefs
+		}
+	case 844:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4282
+		{
+			yyVAL.windowDefs = WindowDefinitions{yyDollar[1].windowDef}
+		}
+	case 845:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4286
+		{
+			yyVAL.windowDefs = append(yyDollar[1].windowDefs, yyDollar[3].windowDef)
+		}
+	case 846:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4292
+		{
+			yyVAL.windowDef = &WindowDefinition{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpec}
+		}
+	case 847:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4297
 		{
 			yyVAL.orderBy = nil
 		}
-	case 815:
+	case 848:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4097
+//line sql.y:4301
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 816:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4103
+//line sql.y:4307
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 817:
+	case 850:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4107
+//line sql.y:4311
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 818:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4113
+//line sql.y:4317
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].orderDirection}
 		}
-	case 819:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4118
+//line sql.y:4322
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 820:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4122
+//line sql.y:4326
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 821:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4126
+//line sql.y:4330
 		{
 			yyVAL.orderDirection = DescOrder
 		}
-	case 822:
+	case 855:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4131
+//line sql.y:4335
 		{
 			yyVAL.limit = nil
 		}
-	case 823:
+	case 856:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4135
+//line sql.y:4339
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 824:
+	case 857:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4139
+//line sql.y:4343
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 825:
+	case 858:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4143
+//line sql.y:4347
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 826:
+	case 859:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4148
+//line sql.y:4352
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 827:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4152
+//line sql.y:4356
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 828:
+	case 861:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4156
+//line sql.y:4360
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 829:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4160
+//line sql.y:4364
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 830:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4164
+//line sql.y:4368
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 831:
+	case 864:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4171
+//line sql.y:4375
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 832:
+	case 865:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4175
+//line sql.y:4379
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 833:
+	case 866:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4179
+//line sql.y:4383
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 834:
+	case 867:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4183
+//line sql.y:4387
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 835:
+	case 868:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4189
+//line sql.y:4393
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 836:
+	case 869:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4193
+//line sql.y:4397
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 837:
+	case 870:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4197
+//line sql.y:4401
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 838:
+	case 871:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4202
+//line sql.y:4406
 		{
 			yyVAL.str = ""
 		}
-	case 839:
+	case 872:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4206
+//line sql.y:4410
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 840:
+	case 873:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4210
+//line sql.y:4414
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 841:
+	case 874:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4214
+//line sql.y:4418
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 842:
+	case 875:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4219
+//line sql.y:4423
 		{
 			yyVAL.str = ""
 		}
-	case 843:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4223
+//line sql.y:4427
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 844:
+	case 877:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4229
+//line sql.y:4433
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 845:
+	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4233
+//line sql.y:4437
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 846:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4238
+//line sql.y:4442
 		{
 			yyVAL.str = ""
 		}
-	case 847:
+	case 880:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4242
+//line sql.y:4446
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 848:
+	case 881:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4247
+//line sql.y:4451
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 849:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4251
+//line sql.y:4455
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 850:
+	case 883:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4255
+//line sql.y:4459
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 851:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4260
+//line sql.y:4464
 		{
 			yyVAL.str = ""
 		}
-	case 852:
+	case 885:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4264
+//line sql.y:4468
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 853:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4270
+//line sql.y:4474
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 854:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4274
+//line sql.y:4478
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 855:
+	case 888:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4278
+//line sql.y:4482
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'@" + string(yyDollar[2].bytes)
 		}
-	case 856:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4282
+//line sql.y:4486
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 857:
+	case 890:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4287
+//line sql.y:4491
 		{
 			yyVAL.lock = NoLock
 		}
-	case 858:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4291
+//line sql.y:4495
 		{
 			yyVAL.lock = ForUpdateLock
 		}
-	case 859:
+	case 892:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4295
+//line sql.y:4499
 		{
 			yyVAL.lock = ShareModeLock
 		}
-	case 860:
+	case 893:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4300
+//line sql.y:4504
 		{
 			yyVAL.selectInto = nil
 		}
-	case 861:
+	case 894:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:4304
+//line sql.y:4508
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfileS3, FileName: string(yyDollar[4].bytes), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
-	case 862:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4308
+//line sql.y:4512
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoDumpfile, FileName: string(yyDollar[3].bytes), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
-	case 863:
+	case 896:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4312
+//line sql.y:4516
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfile, FileName: string(yyDollar[3].bytes), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
-	case 864:
+	case 897:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4317
+//line sql.y:4521
 		{
 			yyVAL.str = ""
 		}
-	case 865:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4321
+//line sql.y:4525
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 866:
+	case 899:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4325
+//line sql.y:4529
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 867:
+	case 900:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4330
+//line sql.y:4534
 		{
 			yyVAL.str = ""
 		}
-	case 868:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4334
+//line sql.y:4538
 		{
 			yyVAL.str = " header"
 		}
-	case 869:
+	case 902:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4339
+//line sql.y:4543
 		{
 			yyVAL.str = ""
 		}
-	case 870:
+	case 903:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4343
+//line sql.y:4547
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 871:
+	case 904:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4347
+//line sql.y:4551
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 872:
+	case 905:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4352
+//line sql.y:4556
 		{
 			yyVAL.str = ""
 		}
-	case 873:
+	case 906:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4356
+//line sql.y:4560
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 874:
+	case 907:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4360
+//line sql.y:4564
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 875:
+	case 908:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4366
+//line sql.y:4570
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 876:
+	case 909:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4371
+//line sql.y:4575
 		{
 			yyVAL.str = ""
 		}
-	case 877:
+	case 910:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4375
+//line sql.y:4579
 		{
 			yyVAL.str = " lines" + yyDollar[2].str + yyDollar[3].str
 		}
-	case 878:
+	case 911:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4380
+//line sql.y:4584
 		{
 			yyVAL.str = ""
 		}
-	case 879:
+	case 912:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4384
+//line sql.y:4588
 		{
 			yyVAL.str = " starting by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 880:
+	case 913:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4389
+//line sql.y:4593
 		{
 			yyVAL.str = ""
 		}
-	case 881:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4393
+//line sql.y:4597
 		{
 			yyVAL.str = " terminated by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 882:
+	case 915:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4398
+//line sql.y:4602
 		{
 			yyVAL.str = ""
 		}
-	case 883:
+	case 916:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4402
+//line sql.y:4606
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str + yyDollar[3].str + yyDollar[4].str
 		}
-	case 884:
+	case 917:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4407
+//line sql.y:4611
 		{
 			yyVAL.str = ""
 		}
-	case 885:
+	case 918:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4411
+//line sql.y:4615
 		{
 			yyVAL.str = " escaped by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 886:
+	case 919:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4416
+//line sql.y:4620
 		{
 			yyVAL.str = ""
 		}
-	case 887:
+	case 920:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4420
+//line sql.y:4624
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by '" + string(yyDollar[4].bytes) + "'"
 		}
-	case 888:
+	case 921:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4425
+//line sql.y:4629
 		{
 			yyVAL.str = ""
 		}
-	case 889:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4429
+//line sql.y:4633
 		{
 			yyVAL.str = " optionally"
 		}
-	case 890:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4442
+//line sql.y:4646
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 891:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4446
+//line sql.y:4650
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 892:
+	case 925:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4450
+//line sql.y:4654
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 893:
+	case 926:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4454
+//line sql.y:4658
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[4].values}
 		}
-	case 894:
+	case 927:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4458
+//line sql.y:4662
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 895:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4464
+//line sql.y:4668
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 896:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4468
+//line sql.y:4672
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 897:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4472
+//line sql.y:4676
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 898:
+	case 931:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4476
+//line sql.y:4680
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 899:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4481
+//line sql.y:4685
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 900:
+	case 933:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4485
+//line sql.y:4689
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 901:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4491
+//line sql.y:4695
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 902:
+	case 935:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4495
+//line sql.y:4699
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 903:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4501
+//line sql.y:4705
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 904:
+	case 937:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4505
+//line sql.y:4709
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 905:
+	case 938:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4511
+//line sql.y:4715
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 906:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4517
+//line sql.y:4721
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -10631,253 +11004,253 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 907:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4527
+//line sql.y:4731
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 908:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4531
+//line sql.y:4735
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 909:
+	case 942:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4537
+//line sql.y:4741
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 910:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4543
+//line sql.y:4747
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 911:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4547
+//line sql.y:4751
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 912:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4553
+//line sql.y:4757
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
 		}
-	case 913:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4557
+//line sql.y:4761
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
 		}
-	case 914:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4561
+//line sql.y:4765
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
 		}
-	case 915:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4565
+//line sql.y:4769
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
 		}
-	case 916:
+	case 949:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4569
+//line sql.y:4773
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].scope
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 918:
+	case 951:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4577
+//line sql.y:4781
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 921:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4587
+//line sql.y:4791
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 922:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4591
+//line sql.y:4795
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 923:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4595
+//line sql.y:4799
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 926:
+	case 959:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4604
+//line sql.y:4808
 		{
 			yyVAL.boolean = false
 		}
-	case 927:
+	case 960:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4606
+//line sql.y:4810
 		{
 			yyVAL.boolean = true
 		}
-	case 928:
+	case 961:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4609
+//line sql.y:4813
 		{
 			yyVAL.boolean = false
 		}
-	case 929:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4611
+//line sql.y:4815
 		{
 			yyVAL.boolean = true
 		}
-	case 930:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4614
+//line sql.y:4818
 		{
 			yyVAL.ignore = false
 		}
-	case 931:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4616
+//line sql.y:4820
 		{
 			yyVAL.ignore = true
 		}
-	case 932:
+	case 965:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4619
+//line sql.y:4823
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 933:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4621
+//line sql.y:4825
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 934:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4623
+//line sql.y:4827
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 935:
+	case 968:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4627
+//line sql.y:4831
 		{
 			yyVAL.statement = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprs}
 		}
-	case 936:
+	case 969:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4632
+//line sql.y:4836
 		{
 			yyVAL.exprs = nil
 		}
-	case 937:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4636
+//line sql.y:4840
 		{
 			yyVAL.exprs = yyDollar[1].exprs
 		}
-	case 938:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4641
+//line sql.y:4845
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 939:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4643
+//line sql.y:4847
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 940:
+	case 973:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4647
+//line sql.y:4851
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[2].colIdent.String())}
 		}
-	case 941:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4653
+//line sql.y:4857
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 942:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4657
+//line sql.y:4861
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 944:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4664
+//line sql.y:4868
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 945:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4670
+//line sql.y:4874
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 946:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4674
+//line sql.y:4878
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 948:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4681
+//line sql.y:4885
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 1342:
+	case 1379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5099
+//line sql.y:5307
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1343:
+	case 1380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5108
+//line sql.y:5316
 		{
 			decNesting(yylex)
 		}
-	case 1344:
+	case 1381:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5113
+//line sql.y:5321
 		{
 			skipToEnd(yylex)
 		}
-	case 1345:
+	case 1382:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5118
+//line sql.y:5326
 		{
 			skipToEnd(yylex)
 		}
-	case 1346:
+	case 1383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5122
+//line sql.y:5330
 		{
 			skipToEnd(yylex)
 		}
-	case 1347:
+	case 1384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5126
+//line sql.y:5334
 		{
 			skipToEnd(yylex)
 		}
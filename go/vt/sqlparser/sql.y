@@ -146,6 +146,16 @@ func skipToEnd(yylex interface{}) {
   tableOption      *TableOption
   tableOptions     TableOptions
   renameTablePairs []*RenameTablePair
+  with             *With
+  cte              *CommonTableExpr
+  ctes             []*CommonTableExpr
+  overClause       *OverClause
+  windowSpec       *WindowSpecification
+  windowDef        *WindowDefinition
+  windowDefs       WindowDefinitions
+  frameClause      *FrameClause
+  frameUnit        FrameUnitType
+  framePoint       *FramePoint
 }
 
 %token LEX_ERROR
@@ -160,6 +170,8 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> SQL_NO_CACHE SQL_CACHE SQL_CALC_FOUND_ROWS
 %left <bytes> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %left <bytes> ON USING INPLACE COPY ALGORITHM NONE SHARED EXCLUSIVE
+%nonassoc <bytes> ROWS
+%nonassoc <bytes> EMPTY_WINDOW_NAME
 %token <empty> '(' ',' ')'
 %token <bytes> ID AT_ID AT_AT_ID HEX STRING INTEGRAL FLOAT HEXNUM VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD BIT_LITERAL COMPRESSION
 %token <bytes> NULL TRUE FALSE OFF
@@ -247,6 +259,9 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
 %token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
+// Window function tokens
+%token <bytes> CURRENT ROW RANGE
+
 // Explain tokens
 %token <bytes> FORMAT TREE VITESS TRADITIONAL
 
@@ -262,6 +277,19 @@ func skipToEnd(yylex interface{}) {
 
 %type <statement> command
 %type <selStmt> simple_select select_statement base_select union_rhs
+%type <with> with_clause
+%type <cte> common_table_expr
+%type <ctes> with_list
+%type <overClause> over_clause_opt
+%type <windowSpec> window_spec
+%type <windowDef> window_definition
+%type <windowDefs> window_clause_opt window_definition_list
+%type <frameClause> frame_clause_opt
+%type <frameUnit> frame_units
+%type <framePoint> frame_point
+%type <expr> frame_expr
+%type <exprs> partition_by_opt
+%type <colIdent> window_name_opt
 %type <statement> explain_statement explainable_statement
 %type <statement> stream_statement vstream_statement insert_statement update_statement delete_statement set_statement set_transaction_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement
@@ -497,6 +525,16 @@ select_statement:
     sel.Into = $5
     $$ = sel
   }
+| with_clause base_select order_by_opt limit_opt lock_opt into_option
+  {
+    sel := $2.(*Select)
+    sel.With = $1
+    sel.OrderBy = $3
+    sel.Limit = $4
+    sel.Lock = $5
+    sel.Into = $6
+    $$ = sel
+  }
 | openb select_statement closeb order_by_opt limit_opt lock_opt
   {
     $$ = &Union{FirstStatement: &ParenSelect{Select: $2}, OrderBy: $4, Limit:$5, Lock:$6}
@@ -536,11 +574,46 @@ simple_select:
     sel.Lock = $4
     $$ = sel
   }
+| with_clause base_select order_by_opt limit_opt lock_opt
+  {
+    sel := $2.(*Select)
+    sel.With = $1
+    sel.OrderBy = $3
+    sel.Limit = $4
+    sel.Lock = $5
+    $$ = sel
+  }
 | simple_select union_op union_rhs order_by_opt limit_opt lock_opt
   {
     $$ = Unionize($1, $3, $2, $4, $5, $6)
   }
 
+with_clause:
+  WITH with_list
+  {
+    $$ = &With{Ctes: $2}
+  }
+| WITH RECURSIVE with_list
+  {
+    $$ = &With{Ctes: $3, Recursive: true}
+  }
+
+with_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| with_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{TableID: $1, Columns: $2, Subquery: $4}
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -555,10 +628,12 @@ vstream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8          9
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 union_rhs:
@@ -3670,9 +3745,9 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -4089,6 +4164,135 @@ having_opt:
     $$ = $2
   }
 
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+| OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+  }
+
+// There is a grammar conflict here:
+// 1: OVER (ROWS UNBOUNDED PRECEDING)
+// 2: OVER (rows ORDER BY a)
+// ROWS is a non-reserved keyword, so it could also be the name of the window
+// the specification is based on. The %prec override below makes the parser
+// choose the first construct. This is the same behavior as MySQL, which
+// reserves ROWS.
+window_name_opt:
+%prec EMPTY_WINDOW_NAME
+  {
+    $$ = ColIdent{}
+  }
+| sql_id
+  {
+    $$ = $1
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_units frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_units BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_units:
+  ROWS
+  {
+    $$ = FrameRowsType
+  }
+| RANGE
+  {
+    $$ = FrameRangeType
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expr PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expr FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+frame_expr:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1)
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -4788,6 +4992,7 @@ reserved_keyword:
 | PARTITION
 | PERCENT_RANK
 | PRIMARY
+| RANGE
 | RANK
 | READ
 | RECURSIVE
@@ -4873,6 +5078,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -5025,7 +5231,9 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
 | ROW_FORMAT
+| ROWS
 | S3
 | SECONDARY
 | SECONDARY_ENGINE
@@ -149,6 +149,7 @@ var keywords = map[string]int{
 	"create":              CREATE,
 	"cross":               CROSS,
 	"csv":                 CSV,
+	"current":             CURRENT,
 	"current_date":        CURRENT_DATE,
 	"current_time":        CURRENT_TIME,
 	"current_timestamp":   CURRENT_TIMESTAMP,
@@ -218,6 +219,7 @@ var keywords = map[string]int{
 	"float4":              UNUSED,
 	"float8":              UNUSED,
 	"flush":               FLUSH,
+	"following":           FOLLOWING,
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
@@ -345,6 +347,7 @@ var keywords = map[string]int{
 	"out":                 UNUSED,
 	"outer":               OUTER,
 	"outfile":             OUTFILE,
+	"over":                OVER,
 	"overwrite":           OVERWRITE,
 	"pack_keys":           PACK_KEYS,
 	"parser":              PARSER,
@@ -354,18 +357,20 @@ var keywords = map[string]int{
 	"plugins":             PLUGINS,
 	"point":               POINT,
 	"polygon":             POLYGON,
+	"preceding":           PRECEDING,
 	"precision":           UNUSED,
 	"primary":             PRIMARY,
 	"privileges":          PRIVILEGES,
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
 	"rebuild":             REBUILD,
+	"recursive":           RECURSIVE,
 	"redundant":           REDUNDANT,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
@@ -386,7 +391,9 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"row":                 ROW,
 	"row_format":          ROW_FORMAT,
+	"rows":                ROWS,
 	"s3":                  S3,
 	"savepoint":           SAVEPOINT,
 	"schema":              SCHEMA,
@@ -455,6 +462,7 @@ var keywords = map[string]int{
 	"truncate":            TRUNCATE,
 	"uncommitted":         UNCOMMITTED,
 	"undefined":           UNDEFINED,
+	"unbounded":           UNBOUNDED,
 	"undo":                UNUSED,
 	"union":               UNION,
 	"unique":              UNIQUE,
@@ -490,6 +498,7 @@ var keywords = map[string]int{
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"without":             WITHOUT,
 	"work":                WORK,
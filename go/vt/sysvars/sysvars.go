@@ -37,6 +37,10 @@ type SystemVariable struct {
 	Name string
 }
 
+// ConsistentSnapshot is the value of the transaction isolation
+// settings that makes VTGate use consistent snapshots.
+const ConsistentSnapshot = "consistent_snapshot"
+
 // System Settings
 var (
 	on   = "1"
@@ -49,6 +53,7 @@ var (
 	TxReadOnly                  = SystemVariable{Name: "tx_read_only", IsBoolean: true, Default: off}
 	TransactionReadOnly         = SystemVariable{Name: "transaction_read_only", IsBoolean: true, Default: off}
 	SQLSelectLimit              = SystemVariable{Name: "sql_select_limit", Default: off}
+	MaxExecutionTime            = SystemVariable{Name: "max_execution_time", Default: off}
 	TransactionMode             = SystemVariable{Name: "transaction_mode", IdentifierAsString: true}
 	Workload                    = SystemVariable{Name: "workload", IdentifierAsString: true}
 	Charset                     = SystemVariable{Name: "charset", Default: utf8, IdentifierAsString: true}
@@ -60,6 +65,12 @@ var (
 	Version        = SystemVariable{Name: "version"}
 	VersionComment = SystemVariable{Name: "version_comment"}
 
+	// Transaction isolation settings. They are set on a reserved connection, except for
+	// the consistent snapshots of VTGate, which are started at a common cut on the shards.
+	TransactionIsolation  = SystemVariable{Name: "transaction_isolation", IdentifierAsString: true}
+	TxIsolation           = SystemVariable{Name: "tx_isolation", IdentifierAsString: true}
+	TransactionIsolations = []SystemVariable{TransactionIsolation, TxIsolation}
+
 	// Read After Write settings
 	ReadAfterWriteGTID    = SystemVariable{Name: "read_after_write_gtid"}
 	ReadAfterWriteTimeOut = SystemVariable{Name: "read_after_write_timeout"}
@@ -72,6 +83,7 @@ var (
 		TxReadOnly,
 		TransactionReadOnly,
 		SQLSelectLimit,
+		MaxExecutionTime,
 		TransactionMode,
 		DDLStrategy,
 		Workload,
@@ -173,8 +185,6 @@ var (
 		{Name: "optimizer_trace_features"},
 		{Name: "optimizer_trace_limit"},
 		{Name: "optimizer_trace_max_mem_size"},
-		{Name: "transaction_isolation"},
-		{Name: "tx_isolation"},
 		{Name: "optimizer_trace_offset"},
 		{Name: "parser_max_mem_size"},
 		{Name: "profiling", IsBoolean: true},
@@ -222,7 +232,6 @@ var (
 		{Name: "lock_wait_timeout"},
 		{Name: "max_allowed_packet"},
 		{Name: "max_error_count"},
-		{Name: "max_execution_time"},
 		{Name: "max_join_size"},
 		{Name: "max_length_for_sort_data"},
 		{Name: "max_sort_length"},
@@ -39,10 +39,12 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/topotools/events"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -109,6 +111,38 @@ func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.Ch
 	}, nil
 }
 
+// ConcludeTransaction is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ConcludeTransaction(ctx context.Context, req *vtctldatapb.ConcludeTransactionRequest) (*vtctldatapb.ConcludeTransactionResponse, error) {
+	mm, _, transaction, err := s.readTransaction(ctx, req.Dtid)
+	if err != nil {
+		return nil, err
+	}
+	if transaction.Dtid == "" {
+		log.Infof("Distributed transaction %v was already concluded", req.Dtid)
+		return &vtctldatapb.ConcludeTransactionResponse{}, nil
+	}
+
+	switch transaction.State {
+	case querypb.TransactionState_COMMIT:
+		err = s.resolveTransaction(ctx, mm, transaction, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.CommitPrepared(ctx, target, transaction.Dtid)
+		})
+	case querypb.TransactionState_ROLLBACK:
+		err = s.resolveTransaction(ctx, mm, transaction, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.RollbackPrepared(ctx, target, transaction.Dtid, 0)
+		})
+	default:
+		// A transaction that is still being prepared has no decision to carry
+		// out. Concluding it would leave its participants prepared forever.
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "distributed transaction %v is in state %v and has no commit decision; roll it back instead", req.Dtid, transaction.State)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ConcludeTransactionResponse{}, nil
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	switch req.Type {
@@ -611,6 +645,23 @@ func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTable
 	}, nil
 }
 
+// GetUnresolvedTransactions is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetUnresolvedTransactions(ctx context.Context, req *vtctldatapb.GetUnresolvedTransactionsRequest) (*vtctldatapb.GetUnresolvedTransactionsResponse, error) {
+	abandonAge, _, err := protoutil.DurationFromProto(req.AbandonAge)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.getKeyspaceUnresolvedTransactions(ctx, req.Keyspace, time.Now().Add(-abandonAge))
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetUnresolvedTransactionsResponse{
+		Transactions: transactions,
+	}, nil
+}
+
 // GetVSchema is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetVSchema(ctx context.Context, req *vtctldatapb.GetVSchemaRequest) (*vtctldatapb.GetVSchemaResponse, error) {
 	vschema, err := s.ts.GetVSchema(ctx, req.Keyspace)
@@ -897,6 +948,42 @@ func (s *VtctldServer) RemoveShardCell(ctx context.Context, req *vtctldatapb.Rem
 	return &vtctldatapb.RemoveShardCellResponse{}, nil
 }
 
+// RollbackTransaction is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RollbackTransaction(ctx context.Context, req *vtctldatapb.RollbackTransactionRequest) (*vtctldatapb.RollbackTransactionResponse, error) {
+	mm, transactionID, transaction, err := s.readTransaction(ctx, req.Dtid)
+	if err != nil {
+		return nil, err
+	}
+	if transaction.Dtid == "" {
+		log.Infof("Distributed transaction %v was already concluded", req.Dtid)
+		return &vtctldatapb.RollbackTransactionResponse{}, nil
+	}
+
+	switch transaction.State {
+	case querypb.TransactionState_PREPARE:
+		// Record the decision to roll back first, so that a coordinator that
+		// is still running can't commit it concurrently.
+		err = s.withPrimaryQueryService(ctx, mm, func(qs queryservice.QueryService) error {
+			return qs.SetRollback(ctx, mm, transaction.Dtid, transactionID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	case querypb.TransactionState_ROLLBACK:
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "distributed transaction %v is in state %v and can't be rolled back; conclude it instead", req.Dtid, transaction.State)
+	}
+
+	err = s.resolveTransaction(ctx, mm, transaction, func(qs queryservice.QueryService, target *querypb.Target) error {
+		return qs.RollbackPrepared(ctx, target, transaction.Dtid, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RollbackTransactionResponse{}, nil
+}
+
 // StartServer registers a VtctldServer for RPCs on the given gRPC server.
 func StartServer(s *grpc.Server, ts *topo.Server) {
 	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
@@ -26,12 +26,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
@@ -46,6 +50,7 @@ import (
 func init() {
 	*tmclient.TabletManagerProtocol = testutil.TabletManagerClientProtocol
 	*backupstorage.BackupStorageImplementation = testutil.BackupStorageImplementation
+	*tabletconn.TabletProtocol = testutil.TabletProtocol
 }
 
 func TestChangeTabletType(t *testing.T) {
@@ -270,6 +275,141 @@ func TestChangeTabletType(t *testing.T) {
 	})
 }
 
+// addTwoPCShards adds a keyspace with two shards to the topo, and returns the
+// query services of their primaries, by shard.
+func addTwoPCShards(ctx context.Context, t *testing.T, ts *topo.Server) map[string]*sandboxconn.SandboxConn {
+	t.Helper()
+
+	sbcs := map[string]*sandboxconn.SandboxConn{}
+	for i, shard := range []string{"-80", "80-"} {
+		tablet := &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  uint32(100 * (i + 1)),
+			},
+			Keyspace: "testkeyspace",
+			Shard:    shard,
+			Type:     topodatapb.TabletType_MASTER,
+		}
+		testutil.AddTablet(ctx, t, ts, tablet)
+		_, err := ts.UpdateShardFields(ctx, tablet.Keyspace, tablet.Shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = tablet.Alias
+			return nil
+		})
+		require.NoError(t, err)
+
+		sbcs[shard] = sandboxconn.NewSandboxConn(tablet)
+		testutil.QueryServices[topoproto.TabletAliasString(tablet.Alias)] = sbcs[shard]
+	}
+
+	return sbcs
+}
+
+func TestConcludeTransaction(t *testing.T) {
+	participants := []*querypb.Target{
+		{
+			Keyspace:   "testkeyspace",
+			Shard:      "80-",
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		dtid                 string
+		transaction          *querypb.TransactionMetadata
+		failParticipants     bool
+		commitPrepared       int64
+		rollbackPrepared     int64
+		concludeTransactions int64
+		shouldErr            bool
+	}{
+		{
+			name: "commit decision",
+			dtid: "testkeyspace:-80:1234",
+			transaction: &querypb.TransactionMetadata{
+				Dtid:         "testkeyspace:-80:1234",
+				State:        querypb.TransactionState_COMMIT,
+				Participants: participants,
+			},
+			commitPrepared:       1,
+			concludeTransactions: 1,
+		},
+		{
+			name: "rollback decision",
+			dtid: "testkeyspace:-80:1234",
+			transaction: &querypb.TransactionMetadata{
+				Dtid:         "testkeyspace:-80:1234",
+				State:        querypb.TransactionState_ROLLBACK,
+				Participants: participants,
+			},
+			rollbackPrepared:     1,
+			concludeTransactions: 1,
+		},
+		{
+			name: "no decision",
+			dtid: "testkeyspace:-80:1234",
+			transaction: &querypb.TransactionMetadata{
+				Dtid:         "testkeyspace:-80:1234",
+				State:        querypb.TransactionState_PREPARE,
+				Participants: participants,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "already concluded",
+			dtid: "testkeyspace:-80:1234",
+		},
+		{
+			name: "participant failure",
+			dtid: "testkeyspace:-80:1234",
+			transaction: &querypb.TransactionMetadata{
+				Dtid:         "testkeyspace:-80:1234",
+				State:        querypb.TransactionState_COMMIT,
+				Participants: participants,
+			},
+			failParticipants: true,
+			commitPrepared:   1,
+			shouldErr:        true,
+		},
+		{
+			name:      "invalid dtid",
+			dtid:      "1234",
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ts := memorytopo.NewServer("zone1")
+			vtctld := NewVtctldServer(ts)
+			sbcs := addTwoPCShards(ctx, t, ts)
+
+			if tt.transaction != nil {
+				sbcs["-80"].ReadTransactionResults = []*querypb.TransactionMetadata{tt.transaction}
+			}
+			if tt.failParticipants {
+				sbcs["80-"].MustFailCommitPrepared = 1
+			}
+
+			resp, err := vtctld.ConcludeTransaction(ctx, &vtctldatapb.ConcludeTransactionRequest{Dtid: tt.dtid})
+			assert.Equal(t, tt.commitPrepared, sbcs["80-"].CommitPreparedCount.Get(), "CommitPrepared")
+			assert.Equal(t, tt.rollbackPrepared, sbcs["80-"].RollbackPreparedCount.Get(), "RollbackPrepared")
+			assert.Equal(t, tt.concludeTransactions, sbcs["-80"].ConcludeTransactionCount.Get(), "ConcludeTransaction")
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, &vtctldatapb.ConcludeTransactionResponse{}, resp)
+		})
+	}
+}
+
 func TestCreateKeyspace(t *testing.T) {
 	cells := []string{"zone1", "zone2", "zone3"}
 	tests := []struct {
@@ -2885,6 +3025,68 @@ func TestGetTablets(t *testing.T) {
 	}
 }
 
+func TestGetUnresolvedTransactions(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := NewVtctldServer(ts)
+	addTwoPCShards(ctx, t, ts)
+
+	fields := sqltypes.MakeTestFields("dtid|state|time_created|keyspace|shard", "varbinary|int64|int64|varchar|varchar")
+	testutil.TabletManagerClient.ExecuteFetchAsDbaResults["zone1-0000000100"] = sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+		"testkeyspace:-80:1|1|300|testkeyspace|80-",
+		"testkeyspace:-80:2|2|100|testkeyspace|-80",
+		"testkeyspace:-80:2|2|100|testkeyspace|80-",
+	))
+	testutil.TabletManagerClient.ExecuteFetchAsDbaResults["zone1-0000000200"] = sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields,
+		"testkeyspace:80-:1|3|200|testkeyspace|-80",
+	))
+	defer func() {
+		testutil.TabletManagerClient.ExecuteFetchAsDbaResults = map[string]*querypb.QueryResult{}
+	}()
+
+	participant := func(shard string) *querypb.Target {
+		return &querypb.Target{
+			Keyspace:   "testkeyspace",
+			Shard:      shard,
+			TabletType: topodatapb.TabletType_MASTER,
+		}
+	}
+	expected := &vtctldatapb.GetUnresolvedTransactionsResponse{
+		Transactions: []*querypb.TransactionMetadata{
+			{
+				Dtid:         "testkeyspace:-80:2",
+				State:        querypb.TransactionState_COMMIT,
+				TimeCreated:  100,
+				Participants: []*querypb.Target{participant("-80"), participant("80-")},
+			},
+			{
+				Dtid:         "testkeyspace:80-:1",
+				State:        querypb.TransactionState_ROLLBACK,
+				TimeCreated:  200,
+				Participants: []*querypb.Target{participant("-80")},
+			},
+			{
+				Dtid:         "testkeyspace:-80:1",
+				State:        querypb.TransactionState_PREPARE,
+				TimeCreated:  300,
+				Participants: []*querypb.Target{participant("80-")},
+			},
+		},
+	}
+
+	resp, err := vtctld.GetUnresolvedTransactions(ctx, &vtctldatapb.GetUnresolvedTransactionsRequest{
+		Keyspace:   "testkeyspace",
+		AbandonAge: protoutil.DurationToProto(time.Minute),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expected, resp)
+
+	_, err = vtctld.GetUnresolvedTransactions(ctx, &vtctldatapb.GetUnresolvedTransactionsRequest{
+		Keyspace: "nonexistent",
+	})
+	assert.Error(t, err)
+}
+
 func TestGetVSchema(t *testing.T) {
 	ctx := context.Background()
 	ts := memorytopo.NewServer("zone1")
@@ -3408,3 +3610,83 @@ func TestRemoveShardCell(t *testing.T) {
 		})
 	}
 }
+
+func TestRollbackTransaction(t *testing.T) {
+	participants := []*querypb.Target{
+		{
+			Keyspace:   "testkeyspace",
+			Shard:      "80-",
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		transaction          *querypb.TransactionMetadata
+		setRollbacks         int64
+		rollbackPrepared     int64
+		concludeTransactions int64
+		shouldErr            bool
+	}{
+		{
+			name: "no decision",
+			transaction: &querypb.TransactionMetadata{
+				Dtid:         "testkeyspace:-80:1234",
+				State:        querypb.TransactionState_PREPARE,
+				Participants: participants,
+			},
+			setRollbacks:         1,
+			rollbackPrepared:     1,
+			concludeTransactions: 1,
+		},
+		{
+			name: "rollback decision",
+			transaction: &querypb.TransactionMetadata{
+				Dtid:         "testkeyspace:-80:1234",
+				State:        querypb.TransactionState_ROLLBACK,
+				Participants: participants,
+			},
+			rollbackPrepared:     1,
+			concludeTransactions: 1,
+		},
+		{
+			name: "commit decision",
+			transaction: &querypb.TransactionMetadata{
+				Dtid:         "testkeyspace:-80:1234",
+				State:        querypb.TransactionState_COMMIT,
+				Participants: participants,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "already concluded",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ts := memorytopo.NewServer("zone1")
+			vtctld := NewVtctldServer(ts)
+			sbcs := addTwoPCShards(ctx, t, ts)
+
+			if tt.transaction != nil {
+				sbcs["-80"].ReadTransactionResults = []*querypb.TransactionMetadata{tt.transaction}
+			}
+
+			resp, err := vtctld.RollbackTransaction(ctx, &vtctldatapb.RollbackTransactionRequest{Dtid: "testkeyspace:-80:1234"})
+			assert.Equal(t, tt.setRollbacks, sbcs["-80"].SetRollbackCount.Get(), "SetRollback")
+			assert.Equal(t, tt.rollbackPrepared, sbcs["80-"].RollbackPreparedCount.Get(), "RollbackPrepared")
+			assert.Equal(t, tt.concludeTransactions, sbcs["-80"].ConcludeTransactionCount.Get(), "ConcludeTransaction")
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, &vtctldatapb.RollbackTransactionResponse{}, resp)
+		})
+	}
+}
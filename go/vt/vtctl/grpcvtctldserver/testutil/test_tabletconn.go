@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// TabletProtocol is the protocol this package registers its test tablet
+// dialer under. Users should set *tabletconn.TabletProtocol to this value
+// before use.
+const TabletProtocol = "grpcvtctldserver.testutil"
+
+// QueryServices are the query services that the test tablet dialer returns,
+// by tablet alias. It is public to allow tests to mutate and verify the state
+// of the query services.
+var QueryServices = map[string]queryservice.QueryService{}
+
+func init() {
+	tabletconn.RegisterDialer(TabletProtocol, func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		key := topoproto.TabletAliasString(tablet.Alias)
+
+		qs, ok := QueryServices[key]
+		if !ok {
+			return nil, fmt.Errorf("no query service for %s", key)
+		}
+
+		return qs, nil
+	})
+}
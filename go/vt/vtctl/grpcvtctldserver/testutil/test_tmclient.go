@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/proto/vttime"
@@ -38,6 +39,9 @@ type tabletManagerClient struct {
 	tmclient.TabletManagerClient
 	Topo    *topo.Server
 	Schemas map[string]*tabletmanagerdatapb.SchemaDefinition
+	// ExecuteFetchAsDbaResults are the results of the queries run on
+	// tablets, by tablet alias.
+	ExecuteFetchAsDbaResults map[string]*querypb.QueryResult
 }
 
 // ChangeType is part of the tmclient.TabletManagerClient interface.
@@ -50,6 +54,18 @@ func (c *tabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb
 	return err
 }
 
+// ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
+func (c *tabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error) {
+	key := topoproto.TabletAliasString(tablet.Alias)
+
+	result, ok := c.ExecuteFetchAsDbaResults[key]
+	if !ok {
+		return nil, fmt.Errorf("no query results for %s", key)
+	}
+
+	return result, nil
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (c *tabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tablets []string, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	key := topoproto.TabletAliasString(tablet.Alias)
@@ -70,7 +86,8 @@ const TabletManagerClientProtocol = "grpcvtctldserver.testutil"
 // TabletManagerClient is the singleton test client instance. It is public and
 // singleton to allow tests to mutate and verify its state.
 var TabletManagerClient = &tabletManagerClient{
-	Schemas: map[string]*tabletmanagerdatapb.SchemaDefinition{},
+	Schemas:                  map[string]*tabletmanagerdatapb.SchemaDefinition{},
+	ExecuteFetchAsDbaResults: map[string]*querypb.QueryResult{},
 }
 
 func init() {
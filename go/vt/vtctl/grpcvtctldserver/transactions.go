@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// sqlReadUnresolvedTransactions reads the oldest distributed transactions
+// that a shard is the metadata manager of, along with their participants.
+// It matches the 2PC tables that the tablets create in the _vt database.
+const sqlReadUnresolvedTransactions = `select t.dtid, t.state, t.time_created, p.keyspace, p.shard
+	from (select dtid, state, time_created from _vt.dt_state
+		where time_created < %d order by time_created, dtid limit %d) t
+	join _vt.dt_participant p on t.dtid = p.dtid
+	order by t.time_created, t.dtid, p.id`
+
+// maxUnresolvedTransactions caps the transactions that are read from a shard,
+// so that a backlog of transactions is resolved in batches, oldest first.
+const maxUnresolvedTransactions = 1000
+
+// maxUnresolvedTransactionRows caps the rows that are read from a shard,
+// which are the participants of the transactions. It is never reached
+// unless the transactions have 100 participants on average.
+const maxUnresolvedTransactionRows = 100 * maxUnresolvedTransactions
+
+// getUnresolvedTransactions returns the oldest distributed transactions of a
+// shard that were created before createdBefore, up to maxUnresolvedTransactions.
+func (s *VtctldServer) getUnresolvedTransactions(ctx context.Context, keyspace, shard string, createdBefore time.Time) ([]*querypb.TransactionMetadata, error) {
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if !si.HasMaster() {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "shard %v/%v has no primary", keyspace, shard)
+	}
+	ti, err := s.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(sqlReadUnresolvedTransactions, createdBefore.UnixNano(), maxUnresolvedTransactions)
+	p3qr, err := s.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, false, []byte(query), maxUnresolvedTransactionRows, false, false)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read the distributed transactions of %v/%v", keyspace, shard)
+	}
+
+	var transactions []*querypb.TransactionMetadata
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+		dtid := row[0].ToString()
+		if len(transactions) == 0 || transactions[len(transactions)-1].Dtid != dtid {
+			state, err := row[1].ToInt64()
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "invalid state of distributed transaction %v", dtid)
+			}
+			timeCreated, err := row[2].ToInt64()
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "invalid creation time of distributed transaction %v", dtid)
+			}
+			transactions = append(transactions, &querypb.TransactionMetadata{
+				Dtid:        dtid,
+				State:       querypb.TransactionState(state),
+				TimeCreated: timeCreated,
+			})
+		}
+		transaction := transactions[len(transactions)-1]
+		transaction.Participants = append(transaction.Participants, &querypb.Target{
+			Keyspace:   row[3].ToString(),
+			Shard:      row[4].ToString(),
+			TabletType: topodatapb.TabletType_MASTER,
+		})
+	}
+	return transactions, nil
+}
+
+// getKeyspaceUnresolvedTransactions returns the distributed transactions of
+// all the shards of a keyspace, oldest first.
+func (s *VtctldServer) getKeyspaceUnresolvedTransactions(ctx context.Context, keyspace string, createdBefore time.Time) ([]*querypb.TransactionMetadata, error) {
+	shards, err := s.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		m            sync.Mutex
+		wg           sync.WaitGroup
+		rec          concurrency.AllErrorRecorder
+		transactions []*querypb.TransactionMetadata
+	)
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+			shardTransactions, err := s.getUnresolvedTransactions(ctx, keyspace, shard, createdBefore)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+			m.Lock()
+			defer m.Unlock()
+			transactions = append(transactions, shardTransactions...)
+		}(shard)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	sort.Slice(transactions, func(i, j int) bool {
+		if transactions[i].TimeCreated != transactions[j].TimeCreated {
+			return transactions[i].TimeCreated < transactions[j].TimeCreated
+		}
+		return transactions[i].Dtid < transactions[j].Dtid
+	})
+	return transactions, nil
+}
+
+// readTransaction returns the session of the metadata manager of a distributed
+// transaction, and its metadata. The metadata is empty if the transaction was
+// already concluded.
+func (s *VtctldServer) readTransaction(ctx context.Context, dtid string) (*querypb.Target, int64, *querypb.TransactionMetadata, error) {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return nil, 0, nil, vterrors.Wrapf(err, "invalid dtid %v", dtid)
+	}
+
+	var transaction *querypb.TransactionMetadata
+	err = s.withPrimaryQueryService(ctx, mmShard.Target, func(qs queryservice.QueryService) error {
+		var err error
+		transaction, err = qs.ReadTransaction(ctx, mmShard.Target, dtid)
+		return err
+	})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if transaction == nil {
+		transaction = &querypb.TransactionMetadata{}
+	}
+	return mmShard.Target, mmShard.TransactionId, transaction, nil
+}
+
+// resolveTransaction runs the decision of a distributed transaction on all its
+// participants, and concludes it on its metadata manager once they succeed.
+func (s *VtctldServer) resolveTransaction(ctx context.Context, mm *querypb.Target, transaction *querypb.TransactionMetadata, action func(queryservice.QueryService, *querypb.Target) error) error {
+	var (
+		wg  sync.WaitGroup
+		rec concurrency.AllErrorRecorder
+	)
+	for _, participant := range transaction.Participants {
+		wg.Add(1)
+		go func(target *querypb.Target) {
+			defer wg.Done()
+			rec.RecordError(s.withPrimaryQueryService(ctx, target, func(qs queryservice.QueryService) error {
+				return action(qs, target)
+			}))
+		}(participant)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return rec.AggrError(vterrors.Aggregate)
+	}
+
+	return s.withPrimaryQueryService(ctx, mm, func(qs queryservice.QueryService) error {
+		return qs.ConcludeTransaction(ctx, mm, transaction.Dtid)
+	})
+}
+
+// withPrimaryQueryService calls f with a connection to the query service of
+// the primary tablet of the target's shard.
+func (s *VtctldServer) withPrimaryQueryService(ctx context.Context, target *querypb.Target, f func(queryservice.QueryService) error) error {
+	si, err := s.ts.GetShard(ctx, target.Keyspace, target.Shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "shard %v/%v has no primary", target.Keyspace, target.Shard)
+	}
+	ti, err := s.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return err
+	}
+
+	conn, err := tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot connect to tablet %v", topoproto.TabletAliasString(si.MasterAlias))
+	}
+	defer conn.Close(ctx)
+
+	return f(conn)
+}
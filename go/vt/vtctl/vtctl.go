@@ -115,6 +115,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -320,6 +321,9 @@ var commands = []commandGroup{
 			{"Materialize", commandMaterialize,
 				`<json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
+			{"MaterializeReferenceTables", commandMaterializeReferenceTables,
+				"<keyspace>",
+				"Copies the reference tables of the keyspace that have a source keyspace to all its shards, creating the missing workflows, and prints the state of their streams. vtctld does it periodically, see -reference_tables_check_interval."},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -329,6 +333,9 @@ var commands = []commandGroup{
 			{"VDiff", commandVDiff,
 				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
 				"Perform a diff of all tables in the workflow"},
+			{"VDiffJob", commandVDiffJob,
+				"[-source_cell=<cell>] [-tablet_types=<types>] [-tables=<table1>,<table2>,...] [-max_concurrency=4] [-max_report_keys=100] [-filtered_replication_wait_time=30s] <keyspace.workflow> start|list|show|stop|resume [<uuid>]",
+				"Runs a diff of the tables of the workflow as a job on the target primaries, which persists its progress and resumes after restarts. start prints the uuid of the vdiff, show <uuid> prints its JSON report, list lists the vdiffs of the workflow, and stop and resume stop and resume the vdiff with the given uuid, or all the vdiffs of the workflow."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -355,6 +362,9 @@ var commands = []commandGroup{
 				"Blocks until no new queries were observed on all tablets with the given tablet type in the specified keyspace. " +
 					" This can be used as sanity check to ensure that the tablets were drained after running vtctl MigrateServedTypes " +
 					" and vtgate is no longer using them. If -timeout is set, it fails when the timeout is reached."},
+			{"MessageDeadLetters", commandMessageDeadLetters,
+				"[-json] <keyspace> <message table> list|requeue|purge [<id> ...]",
+				"Operates on the messages that were moved to the dead-letter table of a message table after its vt_max_attempts, on the masters of all the shards of the keyspace: list prints them, requeue moves them back to the message table to be sent again, and purge deletes them. Acts on the messages with the given ids, or on all of them."},
 		},
 	},
 	{
@@ -398,7 +408,7 @@ var commands = []commandGroup{
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
 				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. ddl_strategy is used to intruct migrations via gh-ost or pt-osc with optional parameters"},
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. ddl_strategy is used to intruct migrations via vitess, gh-ost or pt-osc with optional parameters"},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -411,7 +421,8 @@ var commands = []commandGroup{
 					" \nvtctl OnlineDDL test_keyspace show complete" +
 					" \nvtctl OnlineDDL test_keyspace show failed" +
 					" \nvtctl OnlineDDL test_keyspace retry 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
-					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
+					" \nvtctl OnlineDDL test_keyspace cancel 82fa54ac_e83e_11ea_96b7_f875a4d24e90" +
+					" \nvtctl OnlineDDL test_keyspace revert 82fa54ac_e83e_11ea_96b7_f875a4d24e90",
 			},
 
 			{"ValidateVersionShard", commandValidateVersionShard,
@@ -2282,6 +2293,20 @@ func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	return wr.Materialize(ctx, ms)
 }
 
+func commandMaterializeReferenceTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the MaterializeReferenceTables command")
+	}
+	streams, err := wr.MaterializeReferenceTables(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), streams)
+}
+
 func commandSplitClone(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2341,6 +2366,67 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return err
 }
 
+func commandVDiffJob(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	options := vdiff.NewOptions()
+	subFlags.StringVar(&options.SourceCell, "source_cell", "", "The source cell to compare from, defaults to the cell of the target primary")
+	subFlags.StringVar(&options.TabletTypes, "tablet_types", options.TabletTypes, "Tablet types for the sources")
+	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
+	subFlags.IntVar(&options.MaxConcurrency, "max_concurrency", options.MaxConcurrency, "Number of tables that are diffed in parallel on each target primary")
+	subFlags.IntVar(&options.MaxReportKeys, "max_report_keys", options.MaxReportKeys, "Maximum number of keys of mismatched and extra rows that are reported per table")
+	subFlags.DurationVar(&options.FilteredReplicationWaitTime, "filtered_replication_wait_time", options.FilteredReplicationWaitTime, "Specifies the maximum time to wait, in seconds, for the sources and the target to reach the same position before a table is diffed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 || subFlags.NArg() > 3 {
+		return fmt.Errorf("<keyspace.workflow> and <command> are required")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	command := subFlags.Arg(1)
+	uuid := subFlags.Arg(2)
+	if *tables != "" {
+		options.Tables = strings.Split(*tables, ",")
+	}
+
+	var qr *sqltypes.Result
+	switch command {
+	case "start":
+		if uuid != "" {
+			return fmt.Errorf("UUID not allowed in %s", command)
+		}
+		uuid, err := wr.StartVDiff(ctx, keyspace, workflow, options)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("VDiff %s started\n", uuid)
+		return nil
+	case "show":
+		if uuid == "" {
+			return fmt.Errorf("UUID required")
+		}
+		report, err := wr.ShowVDiff(ctx, keyspace, workflow, uuid)
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), report)
+	case "list":
+		qr, err = wr.ListVDiffs(ctx, keyspace, workflow)
+	case "stop":
+		qr, err = wr.StopVDiff(ctx, keyspace, workflow, uuid)
+	case "resume":
+		qr, err = wr.ResumeVDiff(ctx, keyspace, workflow, uuid)
+	default:
+		return fmt.Errorf("Unknown VDiffJob command: %s", command)
+	}
+	if err != nil {
+		return err
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
@@ -2776,7 +2862,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
-	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'vitess', 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2844,7 +2930,8 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 				string(schema.OnlineDDLStatusReady),
 				string(schema.OnlineDDLStatusRunning),
 				string(schema.OnlineDDLStatusComplete),
-				string(schema.OnlineDDLStatusFailed):
+				string(schema.OnlineDDLStatusFailed),
+				string(schema.OnlineDDLStatusReverted):
 				condition, bindErr = sqlparser.ParseAndBind("migration_status=%a", sqltypes.StringBindVariable(arg))
 			default:
 				if schema.IsOnlineDDLUUID(arg) {
@@ -2855,7 +2942,7 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 				}
 			}
 			query = fmt.Sprintf(`select
-				shard, mysql_schema, mysql_table, ddl_action, migration_uuid, strategy, started_timestamp, completed_timestamp, migration_status
+				shard, mysql_schema, mysql_table, ddl_action, migration_uuid, strategy, started_timestamp, completed_timestamp, migration_status, progress
 				from _vt.schema_migrations where %s`, condition)
 		}
 	case "retry":
@@ -2874,6 +2961,14 @@ func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 			uuid = arg
 			query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='cancel' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
 		}
+	case "revert":
+		{
+			if arg == "" {
+				return fmt.Errorf("UUID required")
+			}
+			uuid = arg
+			query, bindErr = sqlparser.ParseAndBind(`update _vt.schema_migrations set migration_status='revert' where migration_uuid=%a`, sqltypes.StringBindVariable(arg))
+		}
 	case "cancel-all":
 		{
 			if arg != "" {
@@ -3349,6 +3444,34 @@ func commandVExec(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return nil
 }
 
+func commandMessageDeadLetters(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	json := subFlags.Bool("json", false, "Output JSON instead of human-readable table")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 3 {
+		return fmt.Errorf("the <keyspace>, <message table> and <action> arguments are required for the MessageDeadLetters command")
+	}
+	keyspace := subFlags.Arg(0)
+	table := subFlags.Arg(1)
+	action := strings.ToLower(subFlags.Arg(2))
+	results, err := wr.MessageDeadLetters(ctx, keyspace, table, action, subFlags.Args()[3:])
+	if err != nil {
+		return err
+	}
+	var qr *sqltypes.Result
+	if action == wrangler.DeadLettersList {
+		qr = wr.QueryResultForTabletResults(results)
+	} else {
+		qr = wr.QueryResultForRowsAffected(results)
+	}
+	if *json {
+		return printJSON(wr.Logger(), qr)
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
 func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of Workflow and only reports the final query and list of masters on which the operation will be applied")
 	if err := subFlags.Parse(args); err != nil {
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	referenceTablesCheckInterval = flag.Duration("reference_tables_check_interval", time.Minute, "interval at which the reference tables that have a source keyspace are checked, and the missing workflows copying them created. 0 disables the check")
+
+	referenceTableStreamsNotRunning = stats.NewGaugesWithMultiLabels(
+		"ReferenceTableStreamsNotRunning",
+		"Number of shards a reference table is not being copied to",
+		[]string{"Keyspace", "Table"})
+)
+
+func initReferenceTables(ts *topo.Server) {
+	if *referenceTablesCheckInterval == 0 {
+		return
+	}
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	ticks := timer.NewTimer(*referenceTablesCheckInterval)
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks.Start(func() { checkReferenceTables(ctx, wr) })
+
+	servenv.OnTermSync(func() {
+		cancel()
+		ticks.Stop()
+	})
+}
+
+// checkReferenceTables materializes the reference tables of all
+// the keyspaces, and reports the streams that are not running.
+func checkReferenceTables(ctx context.Context, wr *wrangler.Wrangler) {
+	keyspaces, err := wr.TopoServer().GetKeyspaces(ctx)
+	if err != nil {
+		log.Errorf("Error listing the keyspaces to check their reference tables: %v", err)
+		return
+	}
+	referenceTableStreamsNotRunning.ResetAll()
+	for _, keyspace := range keyspaces {
+		if err := checkKeyspaceReferenceTables(ctx, wr, keyspace); err != nil {
+			log.Errorf("Error checking the reference tables of keyspace %v: %v", keyspace, err)
+		}
+	}
+}
+
+func checkKeyspaceReferenceTables(ctx context.Context, wr *wrangler.Wrangler, keyspace string) (err error) {
+	vschema, err := wr.TopoServer().GetVSchema(ctx, keyspace)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil
+		}
+		return err
+	}
+	hasSource := false
+	for _, table := range vschema.Tables {
+		hasSource = hasSource || table.Source != ""
+	}
+	if !hasSource {
+		// The keyspace is not locked needlessly.
+		return nil
+	}
+
+	// The lock prevents two vtctlds from creating the same workflows.
+	ctx, unlock, lockErr := wr.TopoServer().LockKeyspace(ctx, keyspace, "MaterializeReferenceTables")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	streams, err := wr.MaterializeReferenceTables(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	notRunning := make(map[string]int64)
+	for _, stream := range streams {
+		switch stream.State {
+		case binlogplayer.VReplicationInit, binlogplayer.VReplicationCopying, binlogplayer.BlpRunning:
+			continue
+		}
+		log.Warningf("Reference table %v.%v is not being copied to shard %v: state: %q, message: %v", keyspace, stream.Table, stream.Shard, stream.State, stream.Message)
+		notRunning[stream.Table]++
+	}
+	for table, count := range notRunning {
+		referenceTableStreamsNotRunning.Set([]string{keyspace, table}, count)
+	}
+	return nil
+}
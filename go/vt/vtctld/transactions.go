@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+)
+
+var (
+	transactionResolverInterval   = flag.Duration("transaction_resolver_interval", 0, "interval at which the abandoned distributed transactions of all the keyspaces are resolved. 0 disables the resolver")
+	transactionResolverAbandonAge = flag.Duration("transaction_resolver_abandon_age", 5*time.Minute, "age after which a distributed transaction that is not concluded is considered abandoned by the resolver")
+
+	abandonedTransactions = stats.NewGaugesWithSingleLabel(
+		"AbandonedTransactions",
+		"Number of abandoned distributed transactions found by the resolver",
+		"Keyspace")
+	transactionResolutions = stats.NewCountersWithMultiLabels(
+		"TransactionResolutions",
+		"Number of abandoned distributed transactions resolved, by decision and result",
+		[]string{"Decision", "Result"})
+)
+
+func initTransactionResolver(ts *topo.Server) {
+	if *transactionResolverInterval == 0 {
+		return
+	}
+	vtctld := grpcvtctldserver.NewVtctldServer(ts)
+	ticks := timer.NewTimer(*transactionResolverInterval)
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks.Start(func() { resolveTransactions(ctx, ts, vtctld) })
+
+	servenv.OnTermSync(func() {
+		cancel()
+		ticks.Stop()
+	})
+}
+
+// resolveTransactions resolves the abandoned distributed transactions of all
+// the keyspaces. The ones that have a commit decision are committed, and the
+// others rolled back, the way the tablet watchdog asks VTGate to resolve them.
+// It's safe to run concurrently with VTGate, as the tablets only accept the
+// decision that was recorded.
+func resolveTransactions(ctx context.Context, ts *topo.Server, vtctld vtctlservicepb.VtctldServer) {
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		log.Errorf("Error listing the keyspaces to resolve their abandoned transactions: %v", err)
+		return
+	}
+	abandonedTransactions.ResetAll()
+	for _, keyspace := range keyspaces {
+		if err := resolveKeyspaceTransactions(ctx, vtctld, keyspace); err != nil {
+			log.Errorf("Error resolving the abandoned transactions of keyspace %v: %v", keyspace, err)
+		}
+	}
+}
+
+func resolveKeyspaceTransactions(ctx context.Context, vtctld vtctlservicepb.VtctldServer, keyspace string) error {
+	resp, err := vtctld.GetUnresolvedTransactions(ctx, &vtctldatapb.GetUnresolvedTransactionsRequest{
+		Keyspace:   keyspace,
+		AbandonAge: protoutil.DurationToProto(*transactionResolverAbandonAge),
+	})
+	if err != nil {
+		return err
+	}
+	abandonedTransactions.Set(keyspace, int64(len(resp.Transactions)))
+
+	for _, transaction := range resp.Transactions {
+		decision := "Conclude"
+		if transaction.State == querypb.TransactionState_PREPARE {
+			decision = "Rollback"
+			_, err = vtctld.RollbackTransaction(ctx, &vtctldatapb.RollbackTransactionRequest{Dtid: transaction.Dtid})
+		} else {
+			_, err = vtctld.ConcludeTransaction(ctx, &vtctldatapb.ConcludeTransactionRequest{Dtid: transaction.Dtid})
+		}
+		if err != nil {
+			log.Warningf("Error resolving abandoned distributed transaction %v in state %v: %v", transaction.Dtid, transaction.State, err)
+			transactionResolutions.Add([]string{decision, "Failure"}, 1)
+			continue
+		}
+		log.Infof("Resolved abandoned distributed transaction %v in state %v", transaction.Dtid, transaction.State)
+		transactionResolutions.Add([]string{decision, "Success"}, 1)
+	}
+	return nil
+}
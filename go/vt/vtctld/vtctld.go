@@ -183,6 +183,12 @@ func InitVtctld(ts *topo.Server) {
 	// Init online DDL schema manager
 	initSchemaManager(ts)
 
+	// Init the materialization of the reference tables
+	initReferenceTables(ts)
+
+	// Init the resolution of the abandoned distributed transactions
+	initTransactionResolver(ts)
+
 	// Setup reverse proxy for all vttablets through /vttablet/.
 	initVTTabletRedirection(ts)
 }
@@ -0,0 +1,284 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	// The commits on the masters of the shards wait while they are locked,
+	// which is from the Lock phase to the Unlock phase. The Begin phase
+	// includes the time that the replicas take to reach the cut.
+	consistentSnapshotTimings = stats.NewTimings("ConsistentSnapshotTimings", "Time spent starting consistent snapshots at a common cut on the shards of a transaction, by phase", "Phase")
+	consistentSnapshotShards  = stats.NewCounter("ConsistentSnapshotShards", "Number of shards on which consistent snapshots were started at a common cut")
+	consistentSnapshotWaits   = stats.NewCounter("ConsistentSnapshotWaits", "Number of consistent snapshots that waited for their replica to reach the common cut")
+	consistentSnapshotErrors  = stats.NewCountersWithSingleLabel("ConsistentSnapshotErrors", "Number of failures to start consistent snapshots at a common cut, by phase", "Phase")
+)
+
+const (
+	// cutQuery returns 1 if a tablet has executed every transaction
+	// of the cut, which is the GTID set of the master of its shard.
+	cutQuery = "select gtid_subset(:cut, @@global.gtid_executed)"
+	// waitCutQuery waits until a replica has executed every transaction of the cut.
+	// It returns 0 if it did before the timeout.
+	waitCutQuery = "select wait_for_executed_gtid_set(:cut, :timeout)"
+)
+
+// consistentSnapshot is the snapshot that a transaction starts on a shard.
+type consistentSnapshot struct {
+	rs   *srvtopo.ResolvedShard
+	info *shardActionInfo
+	// master is the target of the master of the shard,
+	// which is locked while the snapshots are started.
+	master *querypb.Target
+	// cut is the GTID set that the master executed when it was locked.
+	cut string
+
+	lockID    int64
+	lockAlias *topodatapb.TabletAlias
+
+	transactionID, reservedID int64
+	alias                     *topodatapb.TabletAlias
+}
+
+// startConsistentSnapshots starts the transactions of a session in consistent
+// snapshot mode on the shards that it reads, at a common cut. It follows how
+// vstreamer copies a table at a GTID position: the masters of all the shards
+// take a global read lock, which holds back their commits, and record the GTID
+// sets they executed. The snapshots are started on the masters while they are
+// locked. A replica starts its snapshot once it executed the GTID set of its
+// master, which it can't go past until the master is unlocked. Every snapshot
+// then has the transactions that committed on its shard before the cut, and
+// none of the ones after it.
+//
+// The snapshots of a transaction must all be started at the same cut, so the
+// shards are only coordinated when the transaction starts, and a transaction
+// that already has snapshots can't start one on another shard. A transaction
+// that commits on several shards is not atomic across them: if it is between
+// the commits of its shards when they are locked, the snapshots see it on some
+// of them only.
+func (stc *ScatterConn) startConsistentSnapshots(ctx context.Context, rss []*srvtopo.ResolvedShard, session *SafeSession) error {
+	var snapshots []*consistentSnapshot
+	for _, rs := range rss {
+		info := actionInfo(rs.Target, session, false /* autocommit */)
+		if info.actionNeeded != begin && info.actionNeeded != reserveBegin {
+			continue
+		}
+		master := &querypb.Target{Keyspace: rs.Target.Keyspace, Shard: rs.Target.Shard, TabletType: topodatapb.TabletType_MASTER, Cell: rs.Target.Cell}
+		snapshots = append(snapshots, &consistentSnapshot{rs: rs, info: info, master: master, reservedID: info.reservedID, alias: info.alias})
+	}
+	if len(snapshots) == 0 {
+		return nil
+	}
+	if session.HasShardTransactions() {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "a consistent snapshot transaction can only read the shards it started on, it can't start a snapshot on %s/%s", snapshots[0].rs.Target.Keyspace, snapshots[0].rs.Target.Shard)
+	}
+	if len(snapshots) < 2 {
+		// The snapshot of a single shard is consistent by itself.
+		return nil
+	}
+	for _, snapshot := range snapshots {
+		if _, ok := snapshot.rs.Gateway.(*DiscoveryGateway); ok {
+			return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "consistent snapshots are not supported on old gen gateway")
+		}
+	}
+
+	defer stc.unlockConsistentSnapshots(snapshots)
+	if err := stc.consistentSnapshotPhase("Lock", snapshots, func(snapshot *consistentSnapshot) error {
+		return snapshot.lock(ctx)
+	}); err != nil {
+		return err
+	}
+	return stc.consistentSnapshotPhase("Begin", snapshots, func(snapshot *consistentSnapshot) error {
+		err := snapshot.start(ctx, session)
+		if snapshot.transactionID != 0 || snapshot.reservedID != snapshot.info.reservedID {
+			if appendErr := session.AppendOrUpdate(&vtgatepb.Session_ShardSession{
+				Target:        snapshot.rs.Target,
+				TransactionId: snapshot.transactionID,
+				ReservedId:    snapshot.reservedID,
+				TabletAlias:   snapshot.alias,
+			}, stc.txConn.mode); appendErr != nil && err == nil {
+				err = appendErr
+			}
+		}
+		if err == nil {
+			consistentSnapshotShards.Add(1)
+		}
+		return err
+	})
+}
+
+// consistentSnapshotPhase runs a phase of the snapshots on all their shards.
+func (stc *ScatterConn) consistentSnapshotPhase(phase string, snapshots []*consistentSnapshot, f func(*consistentSnapshot) error) error {
+	defer consistentSnapshotTimings.Record(phase, time.Now())
+	allErrors := new(concurrency.AllErrorRecorder)
+	var wg sync.WaitGroup
+	for _, snapshot := range snapshots {
+		wg.Add(1)
+		go func(snapshot *consistentSnapshot) {
+			defer wg.Done()
+			allErrors.RecordError(f(snapshot))
+		}(snapshot)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		consistentSnapshotErrors.Add(phase, 1)
+		return vterrors.Wrap(allErrors.AggrError(vterrors.Aggregate), "failed to start consistent snapshots")
+	}
+	return nil
+}
+
+// lock takes a global read lock on the master of the shard, on a new
+// reserved connection, and records the GTID set it executed.
+func (snapshot *consistentSnapshot) lock(ctx context.Context) error {
+	lockWaitTimeout := fmt.Sprintf("set @@session.lock_wait_timeout = %d", consistentSnapshotTimeoutSeconds())
+	var err error
+	_, snapshot.lockID, snapshot.lockAlias, err = snapshot.rs.Gateway.ReserveExecute(ctx, snapshot.master, []string{lockWaitTimeout}, "flush tables with read lock", nil, 0 /* transactionID */, nil)
+	if err != nil {
+		return err
+	}
+	qs, err := snapshot.rs.Gateway.QueryServiceByAlias(snapshot.lockAlias)
+	if err != nil {
+		return err
+	}
+	qr, err := qs.Execute(ctx, snapshot.master, "select @@global.gtid_executed", nil, 0 /* transactionID */, snapshot.lockID, nil)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for the GTID set of %v: %v", snapshot.master, qr.Rows)
+	}
+	snapshot.cut = qr.Rows[0][0].ToString()
+	return nil
+}
+
+// start starts the transaction of the snapshot at the cut. A master starts
+// it on the tablet that is locked. A replica that hasn't reached the cut
+// rolls its transaction back, waits until it does, and starts it again.
+func (snapshot *consistentSnapshot) start(ctx context.Context, session *SafeSession) error {
+	var qs queryservice.QueryService
+	var err error
+	if snapshot.rs.Target.TabletType == topodatapb.TabletType_MASTER {
+		qs, err = snapshot.rs.Gateway.QueryServiceByAlias(snapshot.lockAlias)
+	} else {
+		qs, err = getQueryService(snapshot.rs, snapshot.info)
+	}
+	if err != nil {
+		return err
+	}
+	reached, err := snapshot.begin(ctx, qs, session)
+	if err != nil || reached {
+		return err
+	}
+
+	consistentSnapshotWaits.Add(1)
+	target := snapshot.rs.Target
+	if qs, err = snapshot.rs.Gateway.QueryServiceByAlias(snapshot.alias); err != nil {
+		return err
+	}
+	if snapshot.reservedID, err = qs.Rollback(ctx, target, snapshot.transactionID); err != nil {
+		return err
+	}
+	snapshot.transactionID = 0
+	bindVars := map[string]*querypb.BindVariable{
+		"cut":     sqltypes.StringBindVariable(snapshot.cut),
+		"timeout": sqltypes.Int64BindVariable(consistentSnapshotTimeoutSeconds()),
+	}
+	qr, err := qs.Execute(ctx, target, waitCutQuery, bindVars, 0 /* transactionID */, snapshot.reservedID, nil)
+	if err != nil {
+		return err
+	}
+	if firstValue(qr) != "0" {
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "tablet %v did not reach the GTID set of its master in time", topoproto.TabletAliasString(snapshot.alias))
+	}
+	if reached, err = snapshot.begin(ctx, qs, session); err == nil && !reached {
+		err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "tablet %v did not start its snapshot at the GTID set of its master", topoproto.TabletAliasString(snapshot.alias))
+	}
+	return err
+}
+
+// begin starts the transaction of the snapshot with qs, and returns
+// whether the tablet it started on has executed the cut.
+func (snapshot *consistentSnapshot) begin(ctx context.Context, qs queryservice.QueryService, session *SafeSession) (bool, error) {
+	bindVars := map[string]*querypb.BindVariable{"cut": sqltypes.StringBindVariable(snapshot.cut)}
+	opts := session.Session.Options
+	var qr *sqltypes.Result
+	var err error
+	if snapshot.info.actionNeeded == reserveBegin && snapshot.reservedID == 0 {
+		qr, snapshot.transactionID, snapshot.reservedID, snapshot.alias, err = qs.ReserveBeginExecute(ctx, snapshot.rs.Target, session.SetPreQueries(), cutQuery, bindVars, opts)
+	} else {
+		qr, snapshot.transactionID, snapshot.alias, err = qs.BeginExecute(ctx, snapshot.rs.Target, session.Savepoints, cutQuery, bindVars, snapshot.reservedID, opts)
+	}
+	if err != nil {
+		return false, err
+	}
+	return firstValue(qr) == "1", nil
+}
+
+// firstValue returns the first value of a result that has a single row.
+func firstValue(qr *sqltypes.Result) string {
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) == 0 {
+		return ""
+	}
+	return qr.Rows[0][0].ToString()
+}
+
+// unlockConsistentSnapshots releases the connections that lock the masters
+// of the snapshots. It does not use the context of the statement, as the
+// commits on the masters wait until they are unlocked.
+func (stc *ScatterConn) unlockConsistentSnapshots(snapshots []*consistentSnapshot) {
+	ctx, cancel := context.WithTimeout(context.Background(), *consistentSnapshotTimeout)
+	defer cancel()
+	_ = stc.consistentSnapshotPhase("Unlock", snapshots, func(snapshot *consistentSnapshot) error {
+		if snapshot.lockID == 0 {
+			return nil
+		}
+		qs, err := snapshot.rs.Gateway.QueryServiceByAlias(snapshot.lockAlias)
+		if err == nil {
+			err = qs.Release(ctx, snapshot.master, 0 /* transactionID */, snapshot.lockID)
+		}
+		if err != nil {
+			log.Warningf("Failed to unlock the master of a consistent snapshot on %v, its connection is closed when it times out: %v", snapshot.master, err)
+		}
+		return err
+	})
+}
+
+// consistentSnapshotTimeoutSeconds returns the timeout of the
+// consistent snapshots in seconds, for MySQL.
+func consistentSnapshotTimeoutSeconds() int64 {
+	return int64(math.Ceil(consistentSnapshotTimeout.Seconds()))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestExecutorConsistentSnapshot(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+	session := NewAutocommitSession(&vtgatepb.Session{TargetString: "@master", TransactionMode: vtgatepb.TransactionMode_MULTI})
+	session.SetConsistentSnapshot(true)
+
+	// The autocommit reads that aren't part of a transaction don't start snapshots.
+	_, err := executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	require.NoError(t, err)
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2} {
+		assert.EqualValues(t, 0, sbc.ReserveCount.Get())
+		assert.EqualValues(t, 0, sbc.BeginCount.Get())
+	}
+
+	// A transaction that reads a single shard doesn't lock it, and can't read
+	// other shards later.
+	_, err = executor.Execute(ctx, "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbc1.ReserveCount.Get())
+	assert.EqualValues(t, 1, sbc1.BeginCount.Get())
+	assert.EqualValues(t, 0, sbc2.BeginCount.Get())
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	require.EqualError(t, err, "a consistent snapshot transaction can only read the shards it started on, it can't start a snapshot on TestExecutor/20-40")
+	_, err = executor.Execute(ctx, "TestExecute", session, "rollback", nil)
+	require.NoError(t, err)
+
+	// A transaction that reads several shards starts their snapshots at a
+	// common cut, while only those shards are locked.
+	_, err = executor.Execute(ctx, "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from user", nil)
+	require.NoError(t, err)
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc1, sbc2} {
+		assert.EqualValues(t, 1, sbc.ReserveCount.Get())
+		assert.EqualValues(t, 1, sbc.ReleaseCount.Get())
+		assert.Equal(t, "flush tables with read lock", sbc.Queries[1].Sql)
+	}
+	assert.EqualValues(t, 2, sbc1.BeginCount.Get())
+	assert.EqualValues(t, 1, sbc2.BeginCount.Get())
+	_, err = executor.Execute(ctx, "TestExecute", session, "select id from TestUnsharded.user", nil)
+	require.EqualError(t, err, "a consistent snapshot transaction can only read the shards it started on, it can't start a snapshot on TestUnsharded/0")
+	assert.EqualValues(t, 0, sbclookup.BeginCount.Get())
+	_, err = executor.Execute(ctx, "TestExecute", session, "commit", nil)
+	require.NoError(t, err)
+
+	// The streams of several shards don't run in the snapshots.
+	session = NewAutocommitSession(&vtgatepb.Session{TargetString: "@master"})
+	session.SetConsistentSnapshot(true)
+	err = executor.StreamExecute(ctx, "TestExecute", session, "select id from user", nil, querypb.Target{TabletType: topodatapb.TabletType_MASTER}, func(*sqltypes.Result) error { return nil })
+	require.EqualError(t, err, "consistent snapshots are not supported for streaming reads of several shards")
+}
@@ -33,10 +33,12 @@ func (cached *AggregateParams) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(32)
+		size += int64(48)
 	}
 	// field Alias string
 	size += int64(len(cached.Alias))
+	// field Separator string
+	size += int64(len(cached.Separator))
 	return size
 }
 func (cached *AlterVSchema) CachedSize(alloc bool) int64 {
@@ -72,6 +74,40 @@ func (cached *Concatenate) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *CorrelatedSubquery) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Vars map[string]int
+	if cached.Vars != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.Vars)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += int64(numOldBuckets * 208)
+		if len(cached.Vars) > 0 || numBuckets > 1 {
+			size += int64(numBuckets * 208)
+		}
+		for k := range cached.Vars {
+			size += int64(len(k))
+		}
+	}
+	// field ListVar string
+	size += int64(len(cached.ListVar))
+	// field Outer vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Outer.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Subquery vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Subquery.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *DDL) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -100,7 +136,7 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(176)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -110,7 +146,7 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	}
 	// field Query string
 	size += int64(len(cached.Query))
-	// field Vindex vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	// field Vindex vitess.io/vitess/go/vt/vtgate/vindexes.Vindex
 	if cc, ok := cached.Vindex.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
@@ -121,7 +157,7 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
-	// field KsidVindex vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	// field KsidVindex vitess.io/vitess/go/vt/vtgate/vindexes.Vindex
 	if cc, ok := cached.KsidVindex.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
@@ -129,6 +165,10 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	size += cached.Table.CachedSize(true)
 	// field OwnedVindexQuery string
 	size += int64(len(cached.OwnedVindexQuery))
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
 	return size
 }
 func (cached *Delete) CachedSize(alloc bool) int64 {
@@ -137,7 +177,7 @@ func (cached *Delete) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(176)
 	}
 	// field DML vitess.io/vitess/go/vt/vtgate/engine.DML
 	size += cached.DML.CachedSize(false)
@@ -157,13 +197,31 @@ func (cached *Distinct) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Filter) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Predicate vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Predicate.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Generate) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(120)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -173,13 +231,35 @@ func (cached *Generate) CachedSize(alloc bool) int64 {
 	size += cached.Values.CachedSize(false)
 	return size
 }
+func (cached *HashJoin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Right.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Cols []int
+	{
+		size += int64(cap(cached.Cols)) * int64(8)
+	}
+	return size
+}
 func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(144)
+		size += int64(256)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -207,6 +287,35 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	}
 	// field Suffix string
 	size += int64(len(cached.Suffix))
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field VindexValueOffset [][]int
+	{
+		size += int64(cap(cached.VindexValueOffset)) * int64(24)
+		for _, elem := range cached.VindexValueOffset {
+			{
+				size += int64(cap(elem)) * int64(8)
+			}
+		}
+	}
+	// field OwnedVindexQuery string
+	size += int64(len(cached.OwnedVindexQuery))
+	// field ReplaceColumns []string
+	{
+		size += int64(cap(cached.ReplaceColumns)) * int64(16)
+		for _, elem := range cached.ReplaceColumns {
+			size += int64(len(elem))
+		}
+	}
+	// field ReplaceValues []vitess.io/vitess/go/sqltypes.PlanValue
+	{
+		size += int64(cap(cached.ReplaceValues)) * int64(88)
+		for _, elem := range cached.ReplaceValues {
+			size += elem.CachedSize(false)
+		}
+	}
 	return size
 }
 
@@ -358,7 +467,7 @@ func (cached *OrderedAggregate) CachedSize(alloc bool) int64 {
 	}
 	// field Aggregates []vitess.io/vitess/go/vt/vtgate/engine.AggregateParams
 	{
-		size += int64(cap(cached.Aggregates)) * int64(32)
+		size += int64(cap(cached.Aggregates)) * int64(48)
 		for _, elem := range cached.Aggregates {
 			size += elem.CachedSize(false)
 		}
@@ -379,7 +488,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(152)
 	}
 	// field Original string
 	size += int64(len(cached.Original))
@@ -389,6 +498,15 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	// field BindVarNeeds *vitess.io/vitess/go/vt/sqlparser.BindVarNeeds
 	size += cached.BindVarNeeds.CachedSize(true)
+	// field ResultCache *vitess.io/vitess/go/vt/vtgate/engine.ResultCache
+	size += cached.ResultCache.CachedSize(true)
+	// field TableStats []string
+	{
+		size += int64(cap(cached.TableStats)) * int64(16)
+		for _, elem := range cached.TableStats {
+			size += int64(len(elem))
+		}
+	}
 	return size
 }
 func (cached *Projection) CachedSize(alloc bool) int64 {
@@ -443,13 +561,30 @@ func (cached *PulloutSubquery) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ResultCache) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Tables []string
+	{
+		size += int64(cap(cached.Tables)) * int64(16)
+		for _, elem := range cached.Tables {
+			size += int64(len(elem))
+		}
+	}
+	return size
+}
 func (cached *Route) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(208)
+		size += int64(224)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -463,7 +598,7 @@ func (cached *Route) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.TableName))
 	// field FieldQuery string
 	size += int64(len(cached.FieldQuery))
-	// field Vindex vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	// field Vindex vitess.io/vitess/go/vt/vtgate/vindexes.Vindex
 	if cc, ok := cached.Vindex.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
@@ -582,18 +717,41 @@ func (cached *Subquery) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(40)
+		size += int64(64)
 	}
 	// field Cols []int
 	{
 		size += int64(cap(cached.Cols)) * int64(8)
 	}
+	// field Exprs []vitess.io/vitess/go/vt/vtgate/engine.SubqueryExpr
+	{
+		size += int64(cap(cached.Exprs)) * int64(32)
+		for _, elem := range cached.Exprs {
+			size += elem.CachedSize(false)
+		}
+	}
 	// field Subquery vitess.io/vitess/go/vt/vtgate/engine.Primitive
 	if cc, ok := cached.Subquery.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
 	return size
 }
+func (cached *SubqueryExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Expr vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *SysVarCheckAndIgnore) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -672,7 +830,7 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(152)
+		size += int64(184)
 	}
 	// field DML vitess.io/vitess/go/vt/vtgate/engine.DML
 	size += cached.DML.CachedSize(false)
@@ -777,6 +935,51 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 }
 
 //go:nocheckptr
+func (cached *Window) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field PartitionBy []int
+	{
+		size += int64(cap(cached.PartitionBy)) * int64(8)
+	}
+	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.OrderbyParams
+	{
+		size += int64(cap(cached.OrderBy)) * int64(9)
+	}
+	// field Functions []vitess.io/vitess/go/vt/vtgate/engine.WindowFuncParams
+	{
+		size += int64(cap(cached.Functions)) * int64(240)
+		for _, elem := range cached.Functions {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *WindowFuncParams) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(240)
+	}
+	// field N vitess.io/vitess/go/sqltypes.PlanValue
+	size += cached.N.CachedSize(false)
+	// field Default vitess.io/vitess/go/sqltypes.PlanValue
+	size += cached.Default.CachedSize(false)
+	// field Alias string
+	size += int64(len(cached.Alias))
+	return size
+}
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
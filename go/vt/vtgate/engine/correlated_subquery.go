@@ -0,0 +1,410 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*CorrelatedSubquery)(nil)
+
+// CorrelatedSubquery evaluates a subquery that references columns
+// of its outer query. The subquery is executed with the correlated
+// columns of the outer rows bound as bind variables, and its result
+// is appended to every outer row as an extra column:
+// the value for PulloutValue, 1 or 0 for PulloutExists, and
+// the result of the comparison for PulloutIn and PulloutNotIn.
+type CorrelatedSubquery struct {
+	Opcode PulloutOpcode
+
+	// Vars maps the bind variables of the subquery to the
+	// outer column that supplies their value.
+	Vars map[string]int
+	// Left is the outer column that is compared with the
+	// values returned by an IN or NOT IN subquery.
+	Left int
+
+	// ListVar is set if the subquery is executed once for all the
+	// outer rows: ListVar is bound to the distinct values of outer
+	// column ListColumn, and the first column of the subquery rows
+	// is the value they correlate with.
+	ListVar    string
+	ListColumn int
+
+	Outer    Primitive
+	Subquery Primitive
+}
+
+// Inputs returns the input primitives for this subquery
+func (cs *CorrelatedSubquery) Inputs() []Primitive {
+	return []Primitive{cs.Outer, cs.Subquery}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (cs *CorrelatedSubquery) RouteType() string {
+	return cs.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (cs *CorrelatedSubquery) GetKeyspaceName() string {
+	return cs.Outer.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (cs *CorrelatedSubquery) GetTableName() string {
+	return cs.Outer.GetTableName()
+}
+
+// Execute satisfies the Primitive interface.
+func (cs *CorrelatedSubquery) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := cs.Outer.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return cs.appendSubqueryColumn(vcursor, bindVars, result.Fields, result)
+}
+
+// StreamExecute performs a streaming exec.
+func (cs *CorrelatedSubquery) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	return cs.Outer.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+		}
+		result, err := cs.appendSubqueryColumn(vcursor, bindVars, fields, qr)
+		if err != nil {
+			return err
+		}
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (cs *CorrelatedSubquery) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := cs.Outer.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	field, err := cs.field(vcursor, bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: appendField(qr.Fields, field)}, nil
+}
+
+// NeedsTransaction implements the Primitive interface.
+func (cs *CorrelatedSubquery) NeedsTransaction() bool {
+	return cs.Outer.NeedsTransaction() || cs.Subquery.NeedsTransaction()
+}
+
+// appendSubqueryColumn evaluates the subquery for the outer rows of qr.
+// fields are the fields of the outer rows, which are only sent with
+// the first result of a stream.
+func (cs *CorrelatedSubquery) appendSubqueryColumn(vcursor VCursor, bindVars map[string]*querypb.BindVariable, fields []*querypb.Field, qr *sqltypes.Result) (*sqltypes.Result, error) {
+	wantSubqueryFields := len(qr.Fields) != 0 && cs.Opcode == PulloutValue
+	var subqueryRows [][][]sqltypes.Value
+	var subqueryFields []*querypb.Field
+	var err error
+	if cs.ListVar != "" {
+		subqueryRows, subqueryFields, err = cs.executeBatch(vcursor, bindVars, fields, qr.Rows, wantSubqueryFields)
+	} else {
+		subqueryRows, subqueryFields, err = cs.executePerRow(vcursor, bindVars, qr.Rows, wantSubqueryFields)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{
+		Rows:         make([][]sqltypes.Value, len(qr.Rows)),
+		RowsAffected: qr.RowsAffected,
+	}
+	if len(qr.Fields) != 0 {
+		field, err := cs.field(vcursor, bindVars, subqueryFields)
+		if err != nil {
+			return nil, err
+		}
+		result.Fields = appendField(qr.Fields, field)
+	}
+	for i, row := range qr.Rows {
+		value, err := cs.evaluate(fields, row, subqueryFields, subqueryRows[i])
+		if err != nil {
+			return nil, err
+		}
+		// The outer row is copied, as appending to it could overwrite
+		// a row that shares its array.
+		newRow := make([]sqltypes.Value, len(row), len(row)+1)
+		copy(newRow, row)
+		result.Rows[i] = append(newRow, value)
+	}
+	return result, nil
+}
+
+// appendField returns a copy of fields with field appended.
+func appendField(fields []*querypb.Field, field *querypb.Field) []*querypb.Field {
+	newFields := make([]*querypb.Field, len(fields), len(fields)+1)
+	copy(newFields, fields)
+	return append(newFields, field)
+}
+
+// executePerRow executes the subquery with the correlated values of every
+// outer row, and returns the subquery rows of each outer row. The subquery
+// is only executed once for every distinct set of correlated values.
+func (cs *CorrelatedSubquery) executePerRow(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value, wantfields bool) ([][][]sqltypes.Value, []*querypb.Field, error) {
+	vars := make([]string, 0, len(cs.Vars))
+	for name := range cs.Vars {
+		vars = append(vars, name)
+	}
+	sort.Strings(vars)
+
+	var fields []*querypb.Field
+	results := make([][][]sqltypes.Value, len(rows))
+	seen := make(map[string][][]sqltypes.Value)
+	inMemory := 0
+	for i, row := range rows {
+		var key strings.Builder
+		for _, name := range vars {
+			key.WriteString(row[cs.Vars[name]].String())
+			key.WriteByte(0)
+		}
+		subqueryRows, ok := seen[key.String()]
+		if !ok {
+			combinedVars := make(map[string]*querypb.BindVariable, len(bindVars)+len(vars))
+			for k, v := range bindVars {
+				combinedVars[k] = v
+			}
+			for _, name := range vars {
+				combinedVars[name] = sqltypes.ValueBindVariable(row[cs.Vars[name]])
+			}
+			qr, err := cs.Subquery.Execute(vcursor, combinedVars, wantfields && fields == nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			if fields == nil {
+				fields = qr.Fields
+			}
+			inMemory += len(qr.Rows)
+			if vcursor.ExceedsMaxMemoryRows(inMemory) {
+				return nil, nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			}
+			subqueryRows = qr.Rows
+			seen[key.String()] = subqueryRows
+		}
+		results[i] = subqueryRows
+	}
+	return results, fields, nil
+}
+
+// executeBatch executes the subquery once with the distinct values of the
+// correlated column, and matches the subquery rows with the outer rows
+// using the first column of the subquery.
+func (cs *CorrelatedSubquery) executeBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, fields []*querypb.Field, rows [][]sqltypes.Value, wantfields bool) ([][][]sqltypes.Value, []*querypb.Field, error) {
+	values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		value := row[cs.ListColumn]
+		// NULL is not equal to any value, so it can't correlate with any row.
+		if value.IsNull() || seen[value.String()] {
+			continue
+		}
+		seen[value.String()] = true
+		values.Values = append(values.Values, sqltypes.ValueToProto(value))
+	}
+	results := make([][][]sqltypes.Value, len(rows))
+	if len(values.Values) == 0 {
+		return results, nil, nil
+	}
+
+	combinedVars := make(map[string]*querypb.BindVariable, len(bindVars)+1)
+	for k, v := range bindVars {
+		combinedVars[k] = v
+	}
+	combinedVars[cs.ListVar] = values
+	qr, err := cs.Subquery.Execute(vcursor, combinedVars, wantfields)
+	if err != nil {
+		return nil, nil, err
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(qr.Rows)) {
+		return nil, nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+
+	// The buckets group values that are likely to be equal. Whether they
+	// are is decided by evalengine, which compares them like MySQL does.
+	env := evalengine.ExpressionEnv{Row: make([]sqltypes.Value, 2)}
+	collation := evalengine.CollationGeneralCI
+	if len(fields) != 0 && len(qr.Fields) != 0 {
+		env.Fields = []*querypb.Field{fields[cs.ListColumn], qr.Fields[0]}
+		collation = keyCollation(env.Fields[0], env.Fields[1])
+	}
+	numeric := isNumericColumn(fields, cs.ListColumn, rows) || isNumericColumn(qr.Fields, 0, qr.Rows)
+	buckets := newRowBuckets(numeric, collation)
+	for i, row := range qr.Rows {
+		if !row[0].IsNull() {
+			buckets.add(i, row[0])
+		}
+	}
+	equals := &evalengine.BinaryOp{
+		Expr:  &evalengine.Equals{},
+		Left:  evalengine.NewColumn(0),
+		Right: evalengine.NewColumn(1),
+	}
+	matches := make(map[string][][]sqltypes.Value)
+	for i, row := range rows {
+		value := row[cs.ListColumn]
+		if value.IsNull() {
+			continue
+		}
+		subqueryRows, ok := matches[value.String()]
+		if !ok {
+			for _, j := range buckets.candidates(value) {
+				candidate := qr.Rows[j]
+				env.Row[0], env.Row[1] = value, candidate[0]
+				equal, err := equals.Evaluate(env)
+				if err != nil {
+					return nil, nil, err
+				}
+				if equal.ToBoolean() {
+					subqueryRows = append(subqueryRows, candidate[1:])
+				}
+			}
+			matches[value.String()] = subqueryRows
+		}
+		results[i] = subqueryRows
+	}
+
+	var subqueryFields []*querypb.Field
+	if len(qr.Fields) != 0 {
+		subqueryFields = qr.Fields[1:]
+	}
+	return results, subqueryFields, nil
+}
+
+// evaluate returns the value of the subquery for an outer row.
+func (cs *CorrelatedSubquery) evaluate(fields []*querypb.Field, row []sqltypes.Value, subqueryFields []*querypb.Field, subqueryRows [][]sqltypes.Value) (sqltypes.Value, error) {
+	if len(subqueryRows) != 0 && len(subqueryRows[0]) != 1 && cs.Opcode != PulloutExists {
+		return sqltypes.NULL, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "subquery returned more than one column")
+	}
+	switch cs.Opcode {
+	case PulloutValue:
+		switch len(subqueryRows) {
+		case 0:
+			return sqltypes.NULL, nil
+		case 1:
+			return subqueryRows[0][0], nil
+		default:
+			return sqltypes.NULL, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "subquery returned more than one row")
+		}
+	case PulloutExists:
+		if len(subqueryRows) == 0 {
+			return sqltypes.NewInt64(0), nil
+		}
+		return sqltypes.NewInt64(1), nil
+	}
+
+	// An empty subquery makes IN false and NOT IN true, even for NULL.
+	if len(subqueryRows) == 0 {
+		if cs.Opcode == PulloutIn {
+			return sqltypes.NewInt64(0), nil
+		}
+		return sqltypes.NewInt64(1), nil
+	}
+	env := evalengine.ExpressionEnv{Row: make([]sqltypes.Value, 0, len(subqueryRows)+1)}
+	env.Row = append(env.Row, row[cs.Left])
+	in := &evalengine.InExpr{
+		Left:   evalengine.NewColumn(0),
+		Right:  make([]evalengine.Expr, 0, len(subqueryRows)),
+		Negate: cs.Opcode == PulloutNotIn,
+	}
+	for i, subqueryRow := range subqueryRows {
+		env.Row = append(env.Row, subqueryRow[0])
+		in.Right = append(in.Right, evalengine.NewColumn(i+1))
+	}
+	if len(fields) != 0 && len(subqueryFields) != 0 {
+		env.Fields = make([]*querypb.Field, 0, len(env.Row))
+		env.Fields = append(env.Fields, fields[cs.Left])
+		for range subqueryRows {
+			env.Fields = append(env.Fields, subqueryFields[0])
+		}
+	}
+	result, err := in.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return result.Value(), nil
+}
+
+// field returns the field of the column appended to the outer rows.
+func (cs *CorrelatedSubquery) field(vcursor VCursor, bindVars map[string]*querypb.BindVariable, subqueryFields []*querypb.Field) (*querypb.Field, error) {
+	if cs.Opcode != PulloutValue {
+		return &querypb.Field{Name: cs.Opcode.String(), Type: sqltypes.Int64}, nil
+	}
+	if len(subqueryFields) == 0 {
+		combinedVars := make(map[string]*querypb.BindVariable, len(bindVars)+len(cs.Vars)+1)
+		for k, v := range bindVars {
+			combinedVars[k] = v
+		}
+		for name := range cs.Vars {
+			combinedVars[name] = sqltypes.NullBindVariable
+		}
+		if cs.ListVar != "" {
+			// Add a bogus value. It will not be checked.
+			combinedVars[cs.ListVar] = &querypb.BindVariable{
+				Type:   querypb.Type_TUPLE,
+				Values: []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(0))},
+			}
+		}
+		qr, err := cs.Subquery.GetFields(vcursor, combinedVars)
+		if err != nil {
+			return nil, err
+		}
+		subqueryFields = qr.Fields
+		if cs.ListVar != "" && len(subqueryFields) != 0 {
+			subqueryFields = subqueryFields[1:]
+		}
+	}
+	if len(subqueryFields) == 0 {
+		return &querypb.Field{Name: cs.Opcode.String(), Type: sqltypes.Null}, nil
+	}
+	return &querypb.Field{Name: cs.Opcode.String(), Type: subqueryFields[0].Type}, nil
+}
+
+func (cs *CorrelatedSubquery) description() PrimitiveDescription {
+	other := map[string]interface{}{}
+	if len(cs.Vars) != 0 {
+		other["Vars"] = cs.Vars
+	}
+	if cs.Opcode == PulloutIn || cs.Opcode == PulloutNotIn {
+		other["Left"] = cs.Left
+	}
+	if cs.ListVar != "" {
+		other["ListVar"] = cs.ListVar
+		other["ListColumn"] = cs.ListColumn
+	}
+	return PrimitiveDescription{
+		OperatorType: "CorrelatedSubquery",
+		Variant:      cs.Opcode.String(),
+		Other:        other,
+	}
+}
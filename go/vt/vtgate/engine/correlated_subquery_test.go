@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestCorrelatedSubqueryValue(t *testing.T) {
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|foo", "int64|varchar"),
+			"1|a",
+			"2|b",
+			"3|a",
+		)},
+	}
+	fields := sqltypes.MakeTestFields("name", "varchar")
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "x"),
+			sqltypes.MakeTestResult(fields),
+		},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:   PulloutValue,
+		Vars:     map[string]int{"u_foo": 1},
+		Outer:    outer,
+		Subquery: subquery,
+	}
+
+	result, err := cs.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// The subquery is only executed once for 'a'.
+	subquery.ExpectLog(t, []string{
+		`Execute u_foo: type:VARCHAR value:"a"  true`,
+		`Execute u_foo: type:VARCHAR value:"b"  false`,
+	})
+	expectResult(t, "cs.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|foo|PulloutValue", "int64|varchar|varchar"),
+		"1|a|x",
+		"2|b|null",
+		"3|a|x",
+	))
+}
+
+func TestCorrelatedSubqueryValueTooManyRows(t *testing.T) {
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id", "int64"),
+			"1",
+		)},
+	}
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("name", "varchar"),
+			"x",
+			"y",
+		)},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:   PulloutValue,
+		Vars:     map[string]int{"u_id": 0},
+		Outer:    outer,
+		Subquery: subquery,
+	}
+
+	_, err := cs.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "subquery returned more than one row")
+}
+
+func TestCorrelatedSubqueryBatchIn(t *testing.T) {
+	outerResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|col|foo", "int64|int64|int64"),
+		"1|10|1",
+		"2|20|1",
+		"3|30|2",
+		"4|null|2",
+		"5|40|null",
+		"6|50|3",
+	)
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("foo|col", "int64|int64"),
+			"1|10",
+			"2|30",
+			"2|null",
+		)},
+	}
+	for _, tcase := range []struct {
+		opcode PulloutOpcode
+		// values are the results of the comparison, with "" for NULL.
+		values []string
+	}{{
+		opcode: PulloutIn,
+		values: []string{"1", "0", "1", "", "0", "0"},
+	}, {
+		opcode: PulloutNotIn,
+		values: []string{"0", "1", "0", "", "1", "1"},
+	}} {
+		t.Run(tcase.opcode.String(), func(t *testing.T) {
+			subquery.rewind()
+			cs := &CorrelatedSubquery{
+				Opcode:     tcase.opcode,
+				Left:       1,
+				ListVar:    "__sq1",
+				ListColumn: 2,
+				Outer:      &fakePrimitive{results: []*sqltypes.Result{outerResult}},
+				Subquery:   subquery,
+			}
+
+			result, err := cs.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+			require.NoError(t, err)
+			subquery.ExpectLog(t, []string{
+				`Execute __sq1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > values:<type:INT64 value:"3" >  false`,
+			})
+			var got []string
+			for _, row := range result.Rows {
+				got = append(got, row[3].ToString())
+			}
+			require.Equal(t, tcase.values, got)
+		})
+	}
+}
+
+func TestCorrelatedSubqueryBatchExists(t *testing.T) {
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|foo", "int64|varchar"),
+			"1|a",
+			"2|B",
+			"3|c",
+		)},
+	}
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("foo", "varchar"),
+			"A",
+			"b",
+		)},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:     PulloutExists,
+		ListVar:    "__sq1",
+		ListColumn: 1,
+		Outer:      outer,
+		Subquery:   subquery,
+	}
+
+	result, err := cs.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// The values are matched with a case-insensitive collation.
+	expectResult(t, "cs.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|foo|PulloutExists", "int64|varchar|int64"),
+		"1|a|1",
+		"2|B|1",
+		"3|c|0",
+	))
+}
+
+func TestCorrelatedSubqueryBatchTrailingSpaces(t *testing.T) {
+	outerResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|foo", "int64|varchar"),
+		"1|x",
+		"2|Y",
+		"3|z",
+	)
+	subqueryResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("foo|bar", "varchar|int64"),
+		"X|10",
+		"y|20",
+	)
+	// MakeTestResult trims the spaces around the values.
+	outerResult.Rows[1][1] = sqltypes.NewVarChar("Y ")
+	subqueryResult.Rows[0][0] = sqltypes.NewVarChar("X  ")
+	cs := &CorrelatedSubquery{
+		Opcode:     PulloutValue,
+		ListVar:    "__sq1",
+		ListColumn: 1,
+		Outer:      &fakePrimitive{results: []*sqltypes.Result{outerResult}},
+		Subquery:   &fakePrimitive{results: []*sqltypes.Result{subqueryResult}},
+	}
+
+	result, err := cs.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// The values are matched with a case-insensitive collation,
+	// which ignores trailing spaces.
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|foo|PulloutValue", "int64|varchar|int64"),
+		"1|x|10",
+		"2|Y|20",
+		"3|z|null",
+	)
+	want.Rows[1][1] = sqltypes.NewVarChar("Y ")
+	expectResult(t, "cs.Execute", result, want)
+}
+
+func TestCorrelatedSubqueryBatchMixedTypes(t *testing.T) {
+	// The rows share an array with spare capacity.
+	values := make([]sqltypes.Value, 0, 4)
+	values = append(values, sqltypes.NewInt64(1), sqltypes.NewInt64(2))
+	outerResult := &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("foo", "int64"),
+		Rows:   [][]sqltypes.Value{values[:1], values[1:2]},
+	}
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("foo", "varchar"),
+			"1",
+			"2.0",
+		)},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:     PulloutExists,
+		ListVar:    "__sq1",
+		ListColumn: 0,
+		Outer:      &fakePrimitive{results: []*sqltypes.Result{outerResult}},
+		Subquery:   subquery,
+	}
+
+	result, err := cs.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// The numbers are equal to the strings of their digits.
+	expectResult(t, "cs.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("foo|PulloutExists", "int64|int64"),
+		"1|1",
+		"2|1",
+	))
+	require.Equal(t, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}, values[:2])
+}
+
+func TestCorrelatedSubqueryMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id", "int64"),
+			"1",
+			"2",
+		)},
+	}
+	fields := sqltypes.MakeTestFields("col", "int64")
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "1", "2"),
+			sqltypes.MakeTestResult(fields, "3"),
+		},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:   PulloutIn,
+		Vars:     map[string]int{"u_id": 0},
+		Outer:    outer,
+		Subquery: subquery,
+	}
+
+	_, err := cs.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
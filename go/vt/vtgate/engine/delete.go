@@ -37,9 +37,6 @@ var _ Primitive = (*Delete)(nil)
 // Delete represents the instructions to perform a delete.
 type Delete struct {
 	DML
-
-	// Delete does not take inputs
-	noInputs
 }
 
 var delName = map[DMLOpcode]string{
@@ -75,6 +72,19 @@ func (del *Delete) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		defer cancel()
 	}
 
+	if del.Input != nil && del.Opcode != Limited {
+		batchBindVars, err := del.bindInput(vcursor, bindVars)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execDeleteWithInput")
+		}
+		return execInputBatches(batchBindVars, func(bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+			return del.execute(vcursor, bindVars)
+		})
+	}
+	return del.execute(vcursor, bindVars)
+}
+
+func (del *Delete) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	switch del.Opcode {
 	case Unsharded:
 		return del.execDeleteUnsharded(vcursor, bindVars)
@@ -86,6 +96,8 @@ func (del *Delete) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return del.execDeleteByDestination(vcursor, bindVars, key.DestinationAllShards{})
 	case ByDestination:
 		return del.execDeleteByDestination(vcursor, bindVars, del.TargetDestination)
+	case Limited:
+		return del.execDeleteLimited(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", del)
@@ -118,11 +130,11 @@ func (del *Delete) execDeleteUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := del.Values[0].ResolveValue(bindVars)
+	rowsColValues, err := resolveRowsColValues(del.Values, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execDeleteEqual")
 	}
-	rs, ksid, err := resolveSingleShard(vcursor, del.Vindex, del.Keyspace, key)
+	rs, ksid, err := resolveSingleShard(vcursor, del.Vindex, del.Keyspace, rowsColValues[0])
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execDeleteEqual")
 	}
@@ -140,11 +152,11 @@ func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb
 			return nil, vterrors.Wrap(err, "execDeleteEqual")
 		}
 	}
-	return execShard(vcursor, del.Query, bindVars, rs, true /* rollbackOnError */, true /* canAutocommit */)
+	return execShard(vcursor, del.Query, bindVars, rs, true /* rollbackOnError */, del.canAutocommit())
 }
 
 func (del *Delete) execDeleteIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	rss, queries, err := resolveMultiValueShards(vcursor, del.Keyspace, del.Query, bindVars, del.Values[0], del.Vindex)
+	rss, queries, err := resolveMultiValueShards(vcursor, del.Keyspace, del.Query, bindVars, del.Values, del.Vindex)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +170,7 @@ func (del *Delete) execDeleteIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 			return nil, vterrors.Wrap(err, "execDeleteIn")
 		}
 	}
-	return execMultiShard(vcursor, rss, queries, del.MultiShardAutocommit)
+	return execMultiShard(vcursor, rss, queries, del.MultiShardAutocommit, del.canAutocommit())
 }
 
 func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]*querypb.BindVariable, dest key.Destination) (*sqltypes.Result, error) {
@@ -184,7 +196,35 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 			return nil, err
 		}
 	}
-	return execMultiShard(vcursor, rss, queries, del.MultiShardAutocommit)
+	return execMultiShard(vcursor, rss, queries, del.MultiShardAutocommit, del.canAutocommit())
+}
+
+func (del *Delete) execDeleteLimited(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, shardBindVars, err := del.resolveLimitedShards(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteLimited")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	err = allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i, rs := range rss {
+		if len(del.Table.Owned) > 0 {
+			if err := del.deleteVindexEntries(vcursor, shardBindVars[i], []*srvtopo.ResolvedShard{rs}); err != nil {
+				return nil, vterrors.Wrap(err, "execDeleteLimited")
+			}
+		}
+		queries[i] = &querypb.BoundQuery{
+			Sql:           del.Query,
+			BindVariables: shardBindVars[i],
+		}
+	}
+	return execMultiShard(vcursor, rss, queries, del.MultiShardAutocommit, del.canAutocommit())
 }
 
 // deleteVindexEntries performs an delete if table owns vindex.
@@ -207,8 +247,8 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 	}
 
 	for _, row := range subQueryResults.Rows {
-		colnum := 1
-		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[0])
+		colnum := del.KsidLength
+		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[:del.KsidLength])
 		if err != nil {
 			return err
 		}
@@ -152,6 +152,7 @@ func TestDeleteOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -223,6 +224,47 @@ func TestDeleteOwnedVindex(t *testing.T) {
 	})
 }
 
+func TestDeleteInMultiColOwnedVindex(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	multicol, _ := vindexes.NewMultiCol("multicol", map[string]string{"column_count": "2"})
+	del := &Delete{
+		DML: DML{
+			Opcode:   In,
+			Keyspace: ks.Keyspace,
+			Query:    "dummy_delete",
+			Vindex:   multicol,
+			Values: []sqltypes.PlanValue{
+				{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}}},
+				{Value: sqltypes.NewInt64(2)},
+			},
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       multicol,
+			KsidLength:       2,
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|id2|c1|c2|c3",
+			"int64|int64|int64|int64|int64",
+		),
+		"1|2|4|5|6",
+	)}
+
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b406e7ea22),DestinationKeyspaceID(06e7ea2206e7ea22)`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} false false`,
+		// The keyspace id of the rows is mapped from their first two columns.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264\006\347\352\""  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264\006\347\352\""  true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} true true`,
+	})
+}
+
 func TestDeleteSharded(t *testing.T) {
 	ks := buildTestVSchema().Keyspaces["sharded"]
 	del := &Delete{
@@ -264,6 +306,7 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -335,3 +378,180 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 		`ExecuteMultiShard sharded.-20: dummy_delete {} sharded.20-: dummy_delete {} true false`,
 	})
 }
+
+func TestDeleteWithInput(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	input := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"user_id|col",
+			"int64|int64",
+		),
+		"1|10",
+		"1|10",
+		"3|null",
+		"3|30",
+	)}}
+	del := &Delete{
+		DML: DML{
+			Opcode: In,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_delete",
+			Vindex: vindex.(vindexes.SingleColumn),
+			Values: []sqltypes.PlanValue{{ListKey: DMLVindexVarName}},
+			Input:  input,
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ks.-20: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"30" > __dml_vindex_vals: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"3" > } true false`,
+	})
+
+	// Input returns no rows: nothing is sent.
+	input.results = []*sqltypes.Result{{}}
+	input.rewind()
+	vc = newDMLTestVCursor("-20", "20-")
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, nil)
+
+	// Input fails.
+	input.results = nil
+	input.sendErr = errors.New("input failed")
+	input.rewind()
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteWithInput: input failed")
+}
+
+func TestDeleteWithInputBatches(t *testing.T) {
+	saveBatchSize := dmlInputBatchSize
+	dmlInputBatchSize = 1
+	defer func() {
+		dmlInputBatchSize = saveBatchSize
+	}()
+
+	vindex, _ := vindexes.NewHash("", nil)
+	del := &Delete{
+		DML: DML{
+			Opcode: In,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_delete",
+			Vindex: vindex.(vindexes.SingleColumn),
+			Values: []sqltypes.PlanValue{{ListKey: DMLVindexVarName}},
+			Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"user_id|col",
+					"int64|int64",
+				),
+				"1|10",
+				"3|30",
+				"1|11",
+			)}},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+	result, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	// The rows of a Vindex value are all deleted by the same batch.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ks.-20: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"11" > __dml_vindex_vals: type:TUPLE values:<type:INT64 value:"1" > } true false`,
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ks.-20: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"30" > __dml_vindex_vals: type:TUPLE values:<type:INT64 value:"3" > } true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+}
+
+func TestDeleteWithInputMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	vindex, _ := vindexes.NewHash("", nil)
+	del := &Delete{
+		DML: DML{
+			Opcode: In,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_delete",
+			Vindex: vindex.(vindexes.SingleColumn),
+			Values: []sqltypes.PlanValue{{ListKey: DMLVindexVarName}},
+			Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"user_id|col",
+					"int64|int64",
+				),
+				"1|10",
+				"2|20",
+				"3|30",
+			)}},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteWithInput: in-memory row count exceeded allowed limit of 2")
+	vc.ExpectLog(t, nil)
+}
+
+func TestDeleteLimited(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	input := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"2",
+		"3",
+	)}}
+	del := &Delete{
+		DML: DML{
+			Opcode:           Limited,
+			Keyspace:         ks.Keyspace,
+			Query:            "dummy_delete",
+			Vindex:           ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
+			Input:            input,
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20"}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [type:INT64 value:"1"  type:INT64 value:"2"  type:INT64 value:"3" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		// Each shard is limited to the number of rows selected on it.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {__dml_limit: type:INT64 value:"2" } false false`,
+		`ExecuteMultiShard sharded.20-: dummy_subquery {__dml_limit: type:INT64 value:"1" } false false`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {__dml_limit: type:INT64 value:"2" } ` +
+			`sharded.20-: dummy_delete {__dml_limit: type:INT64 value:"1" } true false`,
+	})
+
+	// Nothing is selected: nothing is deleted.
+	input.results = []*sqltypes.Result{{}}
+	input.rewind()
+	vc = newDMLTestVCursor("-20", "20-")
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, nil)
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -40,14 +42,18 @@ type DML struct {
 	Query string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 
-	// Values specifies the vindex values to use for routing.
-	// For now, only one value is specified.
+	// Values specifies the vindex values to use for routing:
+	// one value, or one per column of a MultiColumn vindex.
 	Values []sqltypes.PlanValue
 
 	// Keyspace Id Vindex
-	KsidVindex vindexes.SingleColumn
+	KsidVindex vindexes.Vindex
+
+	// KsidLength is the number of columns of KsidVindex, which
+	// are the first columns selected by OwnedVindexQuery.
+	KsidLength int
 
 	// Table specifies the table for the update.
 	Table *vindexes.Table
@@ -62,9 +68,28 @@ type DML struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
+	// Input is set for a multi-table DML whose tables are not all on the same shard.
+	// It returns one row per joined row: the Vindex column of the target table followed
+	// by the target column the join depends on. Their distinct values are bound to
+	// DMLVindexVarName and DMLKeyVarName before the DML is executed, in batches.
+	// For the Limited opcode, Input selects the Vindex column of the rows to modify.
+	Input Primitive
+
 	txNeeded
 }
 
+const (
+	// DMLVindexVarName is a reserved bind var name for the
+	// vindex values selected by the Input of a DML.
+	DMLVindexVarName = "__dml_vindex_vals"
+	// DMLKeyVarName is a reserved bind var name for the
+	// join key values selected by the Input of a DML.
+	DMLKeyVarName = "__dml_keys"
+	// DMLLimitVarName is a reserved bind var name for the
+	// number of rows a Limited DML modifies on a shard.
+	DMLLimitVarName = "__dml_limit"
+)
+
 // DMLOpcode is a number representing the opcode
 // for the Update or Delete primitve.
 type DMLOpcode int
@@ -86,6 +111,10 @@ const (
 	// Is used when the query explicitly sets a target destination:
 	// in the clause e.g: UPDATE `keyspace[-]`.x1 SET foo=1
 	ByDestination
+	// Limited is for a multi shard dml statement with a LIMIT.
+	// Requires: A Vindex, and an Input that selects the Vindex
+	// column of the rows to modify, in order and limited.
+	Limited
 )
 
 var opcodeName = map[DMLOpcode]string{
@@ -94,18 +123,19 @@ var opcodeName = map[DMLOpcode]string{
 	In:            "In",
 	Scatter:       "Scatter",
 	ByDestination: "ByDestination",
+	Limited:       "Limited",
 }
 
 func (op DMLOpcode) String() string {
 	return opcodeName[op]
 }
 
-func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.SingleColumn) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
-	keys, err := pv.ResolveList(bindVars)
+func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, values []sqltypes.PlanValue, vindex vindexes.Vindex) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	rowsColValues, err := resolveRowsColValues(values, bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "execDeleteIn")
 	}
-	rss, err := resolveMultiShard(vcursor, vindex, keyspace, keys)
+	rss, err := resolveMultiShard(vcursor, vindex, keyspace, rowsColValues)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "execDeleteIn")
 	}
@@ -119,8 +149,147 @@ func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query
 	return rss, queries, nil
 }
 
-func execMultiShard(vcursor VCursor, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, multiShardAutoCommit bool) (*sqltypes.Result, error) {
-	autocommit := (len(rss) == 1 || multiShardAutoCommit) && vcursor.AutocommitApproval()
+func execMultiShard(vcursor VCursor, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, multiShardAutoCommit, canAutocommit bool) (*sqltypes.Result, error) {
+	autocommit := canAutocommit && (len(rss) == 1 || multiShardAutoCommit) && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
 	return result, vterrors.Aggregate(errs)
 }
+
+// Inputs implements the Primitive interface
+func (dml *DML) Inputs() []Primitive {
+	if dml.Input == nil {
+		return nil
+	}
+	return []Primitive{dml.Input}
+}
+
+// canAutocommit returns false if the DML reads rows before
+// modifying them: both must happen in the same transaction.
+func (dml *DML) canAutocommit() bool {
+	return dml.Input == nil
+}
+
+// dmlInputBatchSize is the maximum number of distinct Vindex values
+// selected by the Input of a DML that are modified by one query.
+var dmlInputBatchSize = 500
+
+// bindInput executes the Input of the DML and returns the bind variables of
+// the batches it must be executed in: copies of bindVars with the keys found
+// by the Input bound. The rows of the Input are held in memory, so they are
+// limited by max_memory_rows. The rows of a Vindex value are all in the same
+// batch, so that no row of the table is modified by two batches.
+func (dml *DML) bindInput(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]map[string]*querypb.BindVariable, error) {
+	qr, err := dml.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(qr.Rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+
+	var batches [][][]sqltypes.Value
+	batchOf := make(map[string]int)
+	batchVindexVals := 0
+	for _, row := range qr.Rows {
+		if row[0].IsNull() || row[1].IsNull() {
+			continue
+		}
+		vindexVal := row[0].String()
+		batch, ok := batchOf[vindexVal]
+		if !ok {
+			if len(batches) == 0 || batchVindexVals == dmlInputBatchSize {
+				batches = append(batches, nil)
+				batchVindexVals = 0
+			}
+			batch = len(batches) - 1
+			batchOf[vindexVal] = batch
+			batchVindexVals++
+		}
+		batches[batch] = append(batches[batch], row)
+	}
+
+	batchBindVars := make([]map[string]*querypb.BindVariable, len(batches))
+	for i, rows := range batches {
+		newBindVars := make(map[string]*querypb.BindVariable, len(bindVars)+2)
+		for k, v := range bindVars {
+			newBindVars[k] = v
+		}
+		newBindVars[DMLVindexVarName] = distinctColumnValues(rows, 0)
+		newBindVars[DMLKeyVarName] = distinctColumnValues(rows, 1)
+		batchBindVars[i] = newBindVars
+	}
+	return batchBindVars, nil
+}
+
+// execInputBatches executes a DML once for each batch of the keys
+// selected by its Input, and adds up the rows they affected.
+func execInputBatches(batchBindVars []map[string]*querypb.BindVariable, execute func(map[string]*querypb.BindVariable) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for _, bindVars := range batchBindVars {
+		qr, err := execute(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
+// resolveLimitedShards executes the Input of a Limited DML, and returns the
+// shards of the rows it selected along with the bind variables to use on each
+// of them: the DML is limited to the number of rows selected on the shard.
+func (dml *DML) resolveLimitedShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	qr, err := dml.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil, nil
+	}
+	keys := make([]sqltypes.Value, len(qr.Rows))
+	for i, row := range qr.Rows {
+		keys[i] = row[0]
+	}
+	destinations, err := dml.Vindex.(vindexes.SingleColumn).Map(vcursor, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The keys are passed in as ids to count the rows of each shard.
+	ids := make([]*querypb.Value, len(keys))
+	for i, key := range keys {
+		ids[i] = sqltypes.ValueToProto(key)
+	}
+	rss, idsPerShard, err := vcursor.ResolveDestinations(dml.Keyspace.Name, ids, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
+	shardBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		shardBindVars[i] = make(map[string]*querypb.BindVariable, len(bindVars)+1)
+		for k, v := range bindVars {
+			shardBindVars[i][k] = v
+		}
+		shardBindVars[i][DMLLimitVarName] = sqltypes.Int64BindVariable(int64(len(idsPerShard[i])))
+	}
+	return rss, shardBindVars, nil
+}
+
+// distinctColumnValues returns the distinct non-NULL values of
+// a column as a list bind variable.
+func distinctColumnValues(rows [][]sqltypes.Value, col int) *querypb.BindVariable {
+	bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		val := row[col]
+		if val.IsNull() {
+			continue
+		}
+		key := val.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		bv.Values = append(bv.Values, sqltypes.ValueToProto(val))
+	}
+	return bv
+}
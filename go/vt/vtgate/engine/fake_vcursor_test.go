@@ -97,10 +97,18 @@ func (t noopVCursor) SetFoundRows(u uint64) {
 	panic("implement me")
 }
 
+func (t noopVCursor) InTransaction() bool {
+	return false
+}
+
 func (t noopVCursor) InTransactionAndIsDML() bool {
 	panic("implement me")
 }
 
+func (t noopVCursor) TabletType() topodatapb.TabletType {
+	return topodatapb.TabletType_MASTER
+}
+
 func (t noopVCursor) FindRoutedTable(sqlparser.TableName) (*vindexes.Table, error) {
 	panic("implement me")
 }
@@ -152,6 +160,10 @@ func (t noopVCursor) SetSQLSelectLimit(int64) error {
 	panic("implement me")
 }
 
+func (t noopVCursor) SetQueryTimeout(int64) {
+	panic("implement me")
+}
+
 func (t noopVCursor) SetTransactionMode(vtgatepb.TransactionMode) {
 	panic("implement me")
 }
@@ -160,6 +172,10 @@ func (t noopVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
 
+func (t noopVCursor) SetConsistentSnapshot(bool) {
+	panic("implement me")
+}
+
 func (t noopVCursor) SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion) {
 	panic("implement me")
 }
@@ -269,6 +285,10 @@ func (f *loggingVCursor) SetFoundRows(u uint64) {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) InTransaction() bool {
+	return false
+}
+
 func (f *loggingVCursor) InTransactionAndIsDML() bool {
 	return false
 }
@@ -487,6 +507,10 @@ func (f *loggingVCursor) SetSQLSelectLimit(int64) error {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) SetQueryTimeout(int64) {
+	panic("implement me")
+}
+
 func (f *loggingVCursor) SetTransactionMode(vtgatepb.TransactionMode) {
 	panic("implement me")
 }
@@ -495,6 +519,10 @@ func (f *loggingVCursor) SetWorkload(querypb.ExecuteOptions_Workload) {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) SetConsistentSnapshot(enable bool) {
+	f.log = append(f.log, fmt.Sprintf("ConsistentSnapshot set to %v", enable))
+}
+
 func (f *loggingVCursor) SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion) {
 	panic("implement me")
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that returns the rows of its input
+// for which the predicate is true. It's used to evaluate
+// the HAVING clause of aggregations done at the vtgate level.
+type Filter struct {
+	Predicate evalengine.Expr
+	Input     Primitive
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// Execute satisfies the Primitive interface.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := f.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result.Rows, err = f.filter(result.Fields, result.Rows, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	return f.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+		}
+		rows, err := f.filter(fields, qr.Rows, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(&sqltypes.Result{Fields: qr.Fields, Rows: rows})
+	})
+}
+
+func (f *Filter) filter(fields []*querypb.Field, rows [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+		Fields:   fields,
+	}
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		env.Row = row
+		result, err := f.Predicate.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if result.ToBoolean() {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+// GetFields implements the Primitive interface.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return f.Input.GetFields(vcursor, bindVars)
+}
+
+// Inputs returns the input of the filter.
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+// NeedsTransaction implements the Primitive interface.
+func (f *Filter) NeedsTransaction() bool {
+	return f.Input.NeedsTransaction()
+}
+
+func (f *Filter) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "Filter",
+		Other: map[string]interface{}{
+			"Predicate": f.Predicate.String(),
+		},
+	}
+}
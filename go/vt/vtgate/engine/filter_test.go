@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestFilterExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|count(*)",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|12",
+			"c|null",
+			"d|10",
+		)},
+	}
+
+	// count(*) >= 10
+	filter := &Filter{
+		Predicate: &evalengine.BinaryOp{
+			Expr:  &evalengine.GreaterThanOrEqual{},
+			Left:  evalengine.NewColumn(1),
+			Right: evalengine.NewLiteralInt(10),
+		},
+		Input: fp,
+	}
+
+	want := sqltypes.MakeTestResult(
+		fields,
+		"b|12",
+		"d|10",
+	)
+
+	result, err := filter.Execute(nil, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(filter, nil, nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, result)
+}
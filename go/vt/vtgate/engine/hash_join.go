@@ -0,0 +1,394 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin specifies the parameters for a join primitive that
+// executes both of its inputs only once. The rows of the LHS are
+// loaded into a hash table, which is probed with the rows of the RHS.
+// Only the rows with equal values in the LHSKey and RHSKey columns
+// are joined. The hash table can't hold more rows than the maximum
+// number of in-memory rows: the join fails if the LHS returns more.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result, with the same convention as Join.
+	Cols []int `json:",omitempty"`
+
+	// LHSKey and RHSKey are the columns of the LHS and RHS
+	// results that are compared by the join predicate.
+	LHSKey, RHSKey int
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	table, err := hj.buildTable(vcursor, lresult.Fields, lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	table.rfields = rresult.Fields
+	for _, rrow := range rresult.Rows {
+		lrows, err := table.probe(rrow)
+		if err != nil {
+			return nil, err
+		}
+		for _, lrow := range lrows {
+			result.Rows = append(result.Rows, joinRows(lrow, rrow, hj.Cols))
+		}
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	result.Rows = append(result.Rows, table.unmatched()...)
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var lfields []*querypb.Field
+	var lrows [][]sqltypes.Value
+	err := hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if len(lresult.Fields) != 0 {
+			lfields = lresult.Fields
+		}
+		lrows = append(lrows, lresult.Rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(lrows)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	table, err := hj.buildTable(vcursor, lfields, lrows)
+	if err != nil {
+		return err
+	}
+
+	err = hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if len(rresult.Fields) != 0 {
+			table.rfields = rresult.Fields
+			if wantfields {
+				wantfields = false
+				result.Fields = joinFields(lfields, rresult.Fields, hj.Cols)
+			}
+		}
+		for _, rrow := range rresult.Rows {
+			lrows, err := table.probe(rrow)
+			if err != nil {
+				return err
+			}
+			for _, lrow := range lrows {
+				result.Rows = append(result.Rows, joinRows(lrow, rrow, hj.Cols))
+			}
+		}
+		return callback(result)
+	})
+	if err != nil {
+		return err
+	}
+	if unmatched := table.unmatched(); len(unmatched) != 0 {
+		return callback(&sqltypes.Result{Rows: unmatched})
+	}
+	return nil
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (hj *HashJoin) NeedsTransaction() bool {
+	return hj.Right.NeedsTransaction() || hj.Left.NeedsTransaction()
+}
+
+func (hj *HashJoin) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":         hj.GetTableName(),
+		"JoinColumnIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(hj.Cols)), ","), "[]"),
+		"Predicate":         fmt.Sprintf("left column %d = right column %d", hj.LHSKey, hj.RHSKey),
+	}
+	return PrimitiveDescription{
+		OperatorType: "HashJoin",
+		Variant:      hj.Opcode.String(),
+		Other:        other,
+	}
+}
+
+// hashTable holds the rows of the LHS of a HashJoin.
+type hashTable struct {
+	hj *HashJoin
+	// buckets is only built when the first RHS key is probed,
+	// as its keys depend on the types of both sides of the join.
+	buckets *rowBuckets
+	rows    [][]sqltypes.Value
+	// matched is only used by left joins, to return
+	// the LHS rows that didn't match any RHS row.
+	matched []bool
+
+	env    evalengine.ExpressionEnv
+	equals evalengine.Expr
+	// lfields and rfields are the fields of the LHS and RHS.
+	lfields, rfields []*querypb.Field
+}
+
+func (hj *HashJoin) buildTable(vcursor VCursor, fields []*querypb.Field, rows [][]sqltypes.Value) (*hashTable, error) {
+	if vcursor.ExceedsMaxMemoryRows(len(rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	table := &hashTable{
+		hj:   hj,
+		rows: rows,
+		env:  evalengine.ExpressionEnv{Row: make([]sqltypes.Value, 2)},
+		equals: &evalengine.BinaryOp{
+			Expr:  &evalengine.Equals{},
+			Left:  evalengine.NewColumn(0),
+			Right: evalengine.NewColumn(1),
+		},
+		lfields: fields,
+	}
+	if hj.Opcode == LeftJoin {
+		table.matched = make([]bool, len(rows))
+	}
+	return table, nil
+}
+
+// index loads the LHS rows in the buckets. They are keyed as numbers
+// if either side of the join is a number: the type of the RHS is the
+// one of its field, or of value, the first non-NULL RHS key, when the
+// fields are not known.
+func (table *hashTable) index(value sqltypes.Value) {
+	hj := table.hj
+	numeric := isNumericColumn(table.lfields, hj.LHSKey, table.rows)
+	if !numeric {
+		if len(table.rfields) != 0 {
+			numeric = sqltypes.IsNumber(table.rfields[hj.RHSKey].Type)
+		} else {
+			numeric = sqltypes.IsNumber(value.Type())
+		}
+	}
+	collation := evalengine.CollationGeneralCI
+	if len(table.env.Fields) != 0 {
+		collation = keyCollation(table.env.Fields[0], table.env.Fields[1])
+	}
+	table.buckets = newRowBuckets(numeric, collation)
+	for i, row := range table.rows {
+		// NULL is not equal to any value, so it can't match any row.
+		if row[hj.LHSKey].IsNull() {
+			continue
+		}
+		table.buckets.add(i, row[hj.LHSKey])
+	}
+}
+
+// probe returns the LHS rows that match an RHS row.
+func (table *hashTable) probe(rrow []sqltypes.Value) ([][]sqltypes.Value, error) {
+	value := rrow[table.hj.RHSKey]
+	if value.IsNull() {
+		return nil, nil
+	}
+	if len(table.lfields) != 0 && len(table.rfields) != 0 && table.env.Fields == nil {
+		table.env.Fields = []*querypb.Field{table.lfields[table.hj.LHSKey], table.rfields[table.hj.RHSKey]}
+	}
+	if table.buckets == nil {
+		table.index(value)
+	}
+	var lrows [][]sqltypes.Value
+	for _, i := range table.buckets.candidates(value) {
+		table.env.Row[0], table.env.Row[1] = table.rows[i][table.hj.LHSKey], value
+		equal, err := table.equals.Evaluate(table.env)
+		if err != nil {
+			return nil, err
+		}
+		if !equal.ToBoolean() {
+			continue
+		}
+		lrows = append(lrows, table.rows[i])
+		if table.matched != nil {
+			table.matched[i] = true
+		}
+	}
+	return lrows, nil
+}
+
+// unmatched returns the joined rows for the LHS rows that
+// didn't match any RHS row, if the join is a left join.
+func (table *hashTable) unmatched() [][]sqltypes.Value {
+	var rows [][]sqltypes.Value
+	for i, matched := range table.matched {
+		if !matched {
+			rows = append(rows, joinRows(table.rows[i], nil, table.hj.Cols))
+		}
+	}
+	return rows
+}
+
+// isNumericColumn returns true if a column of rows has numbers,
+// by its field, or by its values when the fields are not known.
+func isNumericColumn(fields []*querypb.Field, column int, rows [][]sqltypes.Value) bool {
+	if len(fields) != 0 {
+		return sqltypes.IsNumber(fields[column].Type)
+	}
+	for _, row := range rows {
+		if !row[column].IsNull() {
+			return sqltypes.IsNumber(row[column].Type())
+		}
+	}
+	return false
+}
+
+// rowBuckets groups rows by the hashKey of their join key, so that the rows
+// that a key may be equal to are found without comparing it to all of them.
+type rowBuckets struct {
+	numeric   bool
+	collation evalengine.Collation
+	buckets   map[string][]int
+	// unkeyed are the rows whose keys can't be hashed as numbers.
+	// They are candidates for every key.
+	unkeyed []int
+	// all are all the rows, the candidates of a key that can't be hashed.
+	all []int
+}
+
+func newRowBuckets(numeric bool, collation evalengine.Collation) *rowBuckets {
+	return &rowBuckets{
+		numeric:   numeric,
+		collation: collation,
+		buckets:   make(map[string][]int),
+	}
+}
+
+// add adds row i, whose join key is value. Rows must be added in order.
+func (rb *rowBuckets) add(i int, value sqltypes.Value) {
+	rb.all = append(rb.all, i)
+	key, ok := hashKey(value, rb.numeric, rb.collation)
+	if !ok {
+		rb.unkeyed = append(rb.unkeyed, i)
+		return
+	}
+	rb.buckets[key] = append(rb.buckets[key], i)
+}
+
+// candidates returns the rows that may be equal to a key, in order.
+func (rb *rowBuckets) candidates(value sqltypes.Value) []int {
+	key, ok := hashKey(value, rb.numeric, rb.collation)
+	if !ok {
+		return rb.all
+	}
+	bucket := rb.buckets[key]
+	if len(rb.unkeyed) == 0 {
+		return bucket
+	}
+	rows := make([]int, 0, len(bucket)+len(rb.unkeyed))
+	rows = append(rows, bucket...)
+	rows = append(rows, rb.unkeyed...)
+	sort.Ints(rows)
+	return rows
+}
+
+// hashKey returns the same key for values that MySQL considers equal.
+// The values are compared as numbers if either side is a number, so
+// they are then keyed by their numeric value: 1 and '1' must share a
+// key. Otherwise they are keyed by their text normalized like the
+// collation compares it. Values with the same key still have to be
+// compared to know if they are equal. Text is keyed by the number that
+// MySQL casts it to, so 'abc' is keyed as 0 and '1abc' as 1. It returns
+// false if the value can't be hashed as a number.
+func hashKey(value sqltypes.Value, numeric bool, coll evalengine.Collation) (string, bool) {
+	if numeric {
+		hash, err := evalengine.NumericHashcode(value)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatInt(hash, 10), true
+	}
+	return "'" + coll.Hashcode(value.Raw()), true
+}
+
+// keyCollation returns the collation that the text keys of two columns
+// are hashed with. It is only known if the fields of both columns are:
+// otherwise the keys are case-insensitive, as the values that are equal
+// in any collation are then in the same bucket.
+func keyCollation(lfield, rfield *querypb.Field) evalengine.Collation {
+	if lfield == nil || rfield == nil {
+		return evalengine.CollationGeneralCI
+	}
+	return evalengine.MergeCollations(evalengine.CollationFromField(lfield), evalengine.CollationFromField(rfield))
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func hashJoinInputs() (*fakePrimitive, *fakePrimitive) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("col1|col2", "int64|varchar"),
+			"1|a",
+			"2|b",
+			"3|c",
+			"null|d",
+		)},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("col3|col4", "int64|varchar"),
+			"1|x",
+			"3|y",
+			"3|z",
+			"null|w",
+		)},
+	}
+	return leftPrim, rightPrim
+}
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2, 2},
+		LHSKey: 0,
+		RHSKey: 0,
+	}
+
+	result, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// Each side is executed once, without any join variable.
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	expectResult(t, "hj.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("col1|col2|col4", "int64|varchar|varchar"),
+		"1|a|x",
+		"3|c|y",
+		"3|c|z",
+	))
+}
+
+func TestHashJoinExecuteLeftJoin(t *testing.T) {
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode: LeftJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2, 2},
+		LHSKey: 0,
+		RHSKey: 0,
+	}
+
+	result, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("col1|col2|col4", "int64|varchar|varchar"),
+		"1|a|x",
+		"3|c|y",
+		"3|c|z",
+		"2|b|null",
+		"null|d|null",
+	))
+}
+
+func TestHashJoinExecuteCollation(t *testing.T) {
+	leftResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|name", "int64|varchar"),
+		"1|Alice",
+		"2|bob",
+		"3|dave",
+	)
+	// MakeTestResult trims the spaces around the values.
+	leftResult.Rows[2][1] = sqltypes.NewVarChar("dave ")
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("name|id", "varchar|int64"),
+			"alice|10",
+			"BOB|20",
+			"carol|30",
+			"Dave|40",
+		)},
+	}
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   &fakePrimitive{results: []*sqltypes.Result{leftResult}},
+		Right:  rightPrim,
+		Cols:   []int{-1, 2},
+		LHSKey: 1,
+		RHSKey: 0,
+	}
+
+	result, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// The values are matched with a case-insensitive collation,
+	// which ignores trailing spaces.
+	expectResult(t, "hj.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|id", "int64|int64"),
+		"1|10",
+		"2|20",
+		"3|40",
+	))
+}
+
+func TestHashJoinExecuteMixedTypes(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|name", "int64|varchar"),
+			"1|a",
+			"2|b",
+			"3|c",
+			"0|d",
+		)},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|col", "varchar|varchar"),
+			"1|x",
+			"2.0|y",
+			"abc|z",
+		)},
+	}
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-2, 2},
+		LHSKey: 0,
+		RHSKey: 0,
+	}
+
+	result, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// An int is compared to a varchar as a number, and 'abc' is 0.
+	expectResult(t, "hj.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("name|col", "varchar|varchar"),
+		"a|x",
+		"b|y",
+		"d|z",
+	))
+}
+
+func TestHashJoinExecuteNumericText(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|name", "varchar|varchar"),
+			"1abc|a",
+			" 2|b",
+			"x|c",
+			"3|d",
+		)},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|col", "int64|varchar"),
+			"1|x",
+			"2|y",
+			"0|z",
+		)},
+	}
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-2, 2},
+		LHSKey: 0,
+		RHSKey: 0,
+	}
+
+	result, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// Text is keyed by the number MySQL casts it to: '1abc' is 1, ' 2' is 2 and 'x' is 0.
+	expectResult(t, "hj.Execute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("name|col", "varchar|varchar"),
+		"a|x",
+		"b|y",
+		"c|z",
+	))
+
+	// A key that can't be hashed as a number is still compared to the
+	// other side, rather than silently matching nothing.
+	leftPrim.rewind()
+	rightPrim.results = []*sqltypes.Result{{
+		Fields: sqltypes.MakeTestFields("id|col", "int64|varchar"),
+		Rows:   [][]sqltypes.Value{{sqltypes.MakeTrusted(sqltypes.Int64, []byte("1x")), sqltypes.NewVarChar("x")}},
+	}}
+	rightPrim.rewind()
+	_, err = hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, `strconv.ParseInt: parsing "1x": invalid syntax`)
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode: LeftJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2, 2},
+		LHSKey: 0,
+		RHSKey: 0,
+	}
+
+	result, err := wrapStreamExecute(hj, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// The RHS rows are joined as they are streamed, and the unmatched
+	// LHS rows are only sent once all of them have been received.
+	expectResult(t, "hj.StreamExecute", result, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("col1|col2|col4", "int64|varchar|varchar"),
+		"1|a|x",
+		"3|c|y",
+		"3|c|z",
+		"2|b|null",
+		"null|d|null",
+	))
+}
+
+func TestHashJoinMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 3
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	for _, tcase := range []struct {
+		name      string
+		streaming bool
+	}{{
+		name: "execute",
+	}, {
+		name:      "stream",
+		streaming: true,
+	}} {
+		t.Run(tcase.name, func(t *testing.T) {
+			leftPrim, rightPrim := hashJoinInputs()
+			hj := &HashJoin{
+				Opcode: NormalJoin,
+				Left:   leftPrim,
+				Right:  rightPrim,
+				Cols:   []int{-1, 1},
+			}
+
+			var err error
+			if tcase.streaming {
+				_, err = wrapStreamExecute(hj, &noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+			} else {
+				_, err = hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+			}
+			require.EqualError(t, err, "in-memory row count exceeded allowed limit of 3")
+			// The RHS isn't executed when the LHS doesn't fit in memory.
+			rightPrim.ExpectLog(t, nil)
+		})
+	}
+}
+
+func TestHashJoinGetFields(t *testing.T) {
+	leftPrim, rightPrim := hashJoinInputs()
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2, 2},
+	}
+
+	result, err := hj.GetFields(&noopVCursor{}, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	expectResult(t, "hj.GetFields", result, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("col1|col2|col4", "int64|varchar|varchar"),
+	})
+}
@@ -84,8 +84,33 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
+	// Input is set for an INSERT ... SELECT into a sharded table. The rows it
+	// returns take the place of the VALUES clause, and the tuples that would
+	// otherwise be in Mid are built from them at execution time.
+	Input Primitive
+
+	// VindexValueOffset is used instead of VindexValues when Input is set.
+	// VindexValueOffset[i][j] is the position, within the rows returned by
+	// Input, of the j'th column of the i'th colVindex. Columns that are not
+	// selected are positioned past the end of the row, and are inserted as NULL.
+	VindexValueOffset [][]int
+
+	// OwnedVindexQuery is set for a REPLACE into a table with owned vindexes.
+	// It selects the primary vindex columns and the owned vindex columns
+	// of the table, and is completed with a where clause that matches the
+	// existing rows that have the same unique key as a new row. REPLACE
+	// deletes those rows, so their lookup entries are deleted before the
+	// entries for the new rows are created.
+	OwnedVindexQuery string
+
+	// ReplaceColumns is set with OwnedVindexQuery. It contains the
+	// columns of the rows inserted by the REPLACE.
+	ReplaceColumns []string
+
+	// ReplaceValues contains the values of the rows inserted by the
+	// REPLACE when they are supplied in a VALUES clause. ReplaceValues[i]
+	// holds the values of ReplaceColumns[i], in the order of the rows.
+	ReplaceValues []sqltypes.PlanValue
 
 	// Insert needs tx handling
 	txNeeded
@@ -132,8 +157,15 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Offset is the position of the column in the rows of
+	// an insert with an Input. Values is unused in that case.
+	Offset int
 }
 
+// uniqueKeysQuery returns the columns of the unique keys of a table,
+// which are the keys through which a REPLACE overwrites rows.
+const uniqueKeysQuery = "select index_name, column_name from information_schema.statistics where table_schema = database() and table_name = :table_name and non_unique = 0 order by index_name, seq_in_index"
+
 // InsertOpcode is a number representing the opcode
 // for the Insert primitive.
 type InsertOpcode int
@@ -248,6 +280,9 @@ func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	if ins.Input != nil {
+		return ins.execInsertSelect(vcursor, bindVars)
+	}
 	insertID, err := ins.processGenerate(vcursor, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertSharded")
@@ -273,6 +308,148 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+// insertSelectBatchSize is the maximum number of rows of an
+// INSERT ... SELECT that are inserted by one query to each shard.
+var insertSelectBatchSize = 500
+
+// execInsertSelect runs the select of an INSERT ... SELECT, and routes
+// the rows it returns as if they had been supplied in a VALUES clause.
+// The rows are held in memory, so they are limited by max_memory_rows,
+// and they are inserted in batches of insertSelectBatchSize rows.
+// The select runs in the same transaction as the inserts, so the insert
+// never autocommits.
+func (ins *Insert) execInsertSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := ins.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(qr.Rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	if len(qr.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	rows := ins.padSelectRows(qr.Rows)
+
+	insertID, err := ins.processGenerateForRows(vcursor, rows)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+
+	result := &sqltypes.Result{}
+	for start := 0; start < len(rows); start += insertSelectBatchSize {
+		end := start + insertSelectBatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		qr, err := ins.insertSelectBatch(vcursor, bindVars, rows[start:end])
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+		// Like in MySQL, the insert id is the first one that was generated.
+		if result.InsertID == 0 {
+			result.InsertID = qr.InsertID
+		}
+	}
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// insertSelectBatch inserts a batch of the rows of an INSERT ... SELECT.
+// Every batch gets its own bind variables, so that the queries sent
+// to the shards don't grow with the number of rows already inserted.
+func (ins *Insert) insertSelectBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) (*sqltypes.Result, error) {
+	batchVars := make(map[string]*querypb.BindVariable, len(bindVars))
+	for name, bv := range bindVars {
+		batchVars[name] = bv
+	}
+
+	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValueOffset))
+	for vIdx, offsets := range ins.VindexValueOffset {
+		vindexRowsValues[vIdx] = make([][]sqltypes.Value, len(rows))
+		for rowNum, row := range rows {
+			for _, offset := range offsets {
+				vindexRowsValues[vIdx][rowNum] = append(vindexRowsValues[vIdx][rowNum], row[offset])
+			}
+		}
+	}
+
+	// Every value is passed as a bind variable. Vindex columns use the
+	// names filled in by routeRows, which may hold reverse-mapped values.
+	vindexCols := make(map[int]sqlparser.ColIdent)
+	for vIdx, offsets := range ins.VindexValueOffset {
+		for colIdx, offset := range offsets {
+			vindexCols[offset] = ins.Table.ColumnVindexes[vIdx].Columns[colIdx]
+		}
+	}
+	mids := make([]string, len(rows))
+	for rowNum, row := range rows {
+		args := make([]string, len(row))
+		for colNum, val := range row {
+			if col, ok := vindexCols[colNum]; ok {
+				args[colNum] = ":" + InsertVarName(col, rowNum)
+				continue
+			}
+			name := insertSelectVarName(rowNum, colNum)
+			batchVars[name] = sqltypes.ValueBindVariable(val)
+			args[colNum] = ":" + name
+		}
+		mids[rowNum] = "(" + strings.Join(args, ", ") + ")"
+	}
+
+	var replaceRows [][]sqltypes.Value
+	if ins.OwnedVindexQuery != "" {
+		replaceRows = rows
+	}
+	rss, queries, err := ins.routeRows(vcursor, batchVars, vindexRowsValues, mids, replaceRows)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	err = allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, false /* autocommit */)
+	if errs != nil {
+		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "execInsertSelect")
+	}
+	return result, nil
+}
+
+// padSelectRows extends the rows returned by the select of an
+// INSERT ... SELECT with NULLs for the vindex and auto-inc columns
+// that were added to the column list by the planner.
+func (ins *Insert) padSelectRows(rows [][]sqltypes.Value) [][]sqltypes.Value {
+	width := len(rows[0])
+	for _, offsets := range ins.VindexValueOffset {
+		for _, offset := range offsets {
+			if offset >= width {
+				width = offset + 1
+			}
+		}
+	}
+	if ins.Generate != nil && ins.Generate.Offset >= width {
+		width = ins.Generate.Offset + 1
+	}
+	padded := make([][]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		padded[i] = make([]sqltypes.Value, width)
+		copy(padded[i], row)
+	}
+	return padded
+}
+
+func insertSelectVarName(rowNum, colNum int) string {
+	return fmt.Sprintf("__ins_r%d_c%d", rowNum, colNum)
+}
+
 // shouldGenerate determines if a sequence value should be generated for a given value
 func shouldGenerate(v sqltypes.Value) bool {
 	if v.IsNull() {
@@ -303,6 +480,53 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return 0, vterrors.Wrap(err, "processGenerate")
 	}
+	insertID, err = ins.nextSequenceValues(vcursor, resolved)
+	if err != nil {
+		return 0, err
+	}
+
+	// Fill the holes where no value was supplied.
+	cur := insertID
+	for i, v := range resolved {
+		if shouldGenerate(v) {
+			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.Int64BindVariable(cur)
+			cur++
+		} else {
+			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
+		}
+	}
+	return insertID, nil
+}
+
+// processGenerateForRows is the counterpart of processGenerate for
+// the rows of an INSERT ... SELECT. Generated values are written
+// directly into the rows.
+func (ins *Insert) processGenerateForRows(vcursor VCursor, rows [][]sqltypes.Value) (insertID int64, err error) {
+	if ins.Generate == nil {
+		return 0, nil
+	}
+	supplied := make([]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		supplied[i] = row[ins.Generate.Offset]
+	}
+	insertID, err = ins.nextSequenceValues(vcursor, supplied)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "processGenerate")
+	}
+	cur := insertID
+	for i, v := range supplied {
+		if shouldGenerate(v) {
+			rows[i][ins.Generate.Offset] = sqltypes.NewInt64(cur)
+			cur++
+		}
+	}
+	return insertID, nil
+}
+
+// nextSequenceValues reserves one sequence value for every value that
+// was not supplied, and returns the first one. It returns 0 if no
+// value needs to be generated.
+func (ins *Insert) nextSequenceValues(vcursor VCursor, resolved []sqltypes.Value) (insertID int64, err error) {
 	count := int64(0)
 	for _, val := range resolved {
 		if shouldGenerate(val) {
@@ -331,17 +555,6 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 			return 0, err
 		}
 	}
-
-	// Fill the holes where no value was supplied.
-	cur := insertID
-	for i, v := range resolved {
-		if shouldGenerate(v) {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.Int64BindVariable(cur)
-			cur++
-		} else {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
-		}
-	}
 	return insertID, nil
 }
 
@@ -389,6 +602,44 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 			}
 		}
 	}
+	var replaceRows [][]sqltypes.Value
+	if ins.OwnedVindexQuery != "" {
+		var err error
+		if replaceRows, err = ins.resolveReplaceRows(bindVars); err != nil {
+			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		}
+	}
+	return ins.routeRows(vcursor, bindVars, vindexRowsValues, ins.Mid, replaceRows)
+}
+
+// resolveReplaceRows returns the rows inserted by the REPLACE, whose
+// values are in the order of ReplaceColumns.
+func (ins *Insert) resolveReplaceRows(bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for colNum, colValues := range ins.ReplaceValues {
+		values, err := colValues.ResolveList(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if colNum == 0 {
+			rows = make([][]sqltypes.Value, len(values))
+		}
+		if len(values) != len(rows) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: uneven row values for replace: %d %d", len(rows), len(values))
+		}
+		for rowNum, val := range values {
+			rows[rowNum] = append(rows[rowNum], val)
+		}
+	}
+	return rows, nil
+}
+
+// routeRows computes the keyspace ids of the rows described by
+// vindexRowsValues, whose indexes are colVindex, row, col, and
+// returns the queries to send to every shard. mids holds the value
+// tuple of every row, and replaceRows the values of every row, in the
+// order of ReplaceColumns, for a REPLACE into a table with owned vindexes.
+func (ins *Insert) routeRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, mids []string, replaceRows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
 
 	// The output from the following 'process' functions is a list of
 	// keyspace ids. For regular inserts, a failure to find a route
@@ -399,6 +650,12 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
 	}
 
+	if ins.OwnedVindexQuery != "" {
+		if err := ins.deleteReplacedVindexEntries(vcursor, replaceRows, keyspaceIDs); err != nil {
+			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		}
+	}
+
 	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
 		colVindex := ins.Table.ColumnVindexes[vIdx]
 		var err error
@@ -454,14 +711,14 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
-		var mids []string
+		var shardMids []string
 		for _, indexValue := range indexesPerRss[i] {
 			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
 			if keyspaceIDs[index] != nil {
-				mids = append(mids, ins.Mid[index])
+				shardMids = append(shardMids, mids[index])
 			}
 		}
-		rewritten := ins.Prefix + strings.Join(mids, ",") + ins.Suffix
+		rewritten := ins.Prefix + strings.Join(shardMids, ",") + ins.Suffix
 		queries[i] = &querypb.BoundQuery{
 			Sql:           rewritten,
 			BindVariables: bindVars,
@@ -471,6 +728,161 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	return rss, queries, nil
 }
 
+// deleteReplacedVindexEntries deletes the lookup entries of the rows that
+// a REPLACE is about to overwrite. Those are the rows that have the same
+// value as a new row for one of the unique keys of the table. A row can
+// only overwrite the rows of the shard it is inserted into, so every shard
+// is only sent the keys of its own rows.
+func (ins *Insert) deleteReplacedVindexEntries(vcursor VCursor, rows [][]sqltypes.Value, ksids [][]byte) error {
+	var indexes []*querypb.Value
+	var destinations []key.Destination
+	for rowNum, ksid := range ksids {
+		if ksid == nil {
+			continue
+		}
+		indexes = append(indexes, &querypb.Value{
+			Value: strconv.AppendInt(nil, int64(rowNum), 10),
+		})
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+	}
+	if len(destinations) == 0 {
+		return nil
+	}
+	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return err
+	}
+	uniqueKeys, err := ins.loadUniqueKeys(vcursor, rss[0])
+	if err != nil {
+		return err
+	}
+
+	var shards []*srvtopo.ResolvedShard
+	var queries []*querypb.BoundQuery
+	for i, rs := range rss {
+		rowNums := make([]int, 0, len(indexesPerRss[i]))
+		for _, indexValue := range indexesPerRss[i] {
+			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
+			rowNums = append(rowNums, int(index))
+		}
+		if query := ins.replacedRowsQuery(uniqueKeys, rows, rowNums); query != nil {
+			shards = append(shards, rs)
+			queries = append(queries, query)
+		}
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	qr, errs := vcursor.ExecuteMultiShard(shards, queries, false, false)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return err
+	}
+
+	ksidVindex := ins.Table.ColumnVindexes[0]
+	for _, row := range qr.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, ksidVindex.Vindex, row[:len(ksidVindex.Columns)])
+		if err != nil {
+			return err
+		}
+		colnum := len(ksidVindex.Columns)
+		for _, colVindex := range ins.Table.Owned {
+			fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			for range colVindex.Columns {
+				fromIds = append(fromIds, row[colnum])
+				colnum++
+			}
+			if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// loadUniqueKeys loads the unique keys of the table from the shard rs.
+// Every key is returned as the positions of its columns in ReplaceColumns.
+// A REPLACE that doesn't supply all the columns of a unique key is
+// rejected, as the rows it overwrites can't be known.
+func (ins *Insert) loadUniqueKeys(vcursor VCursor, rs *srvtopo.ResolvedShard) ([][]int, error) {
+	query := &querypb.BoundQuery{
+		Sql: uniqueKeysQuery,
+		BindVariables: map[string]*querypb.BindVariable{
+			"table_name": sqltypes.StringBindVariable(ins.Table.Name.String()),
+		},
+	}
+	qr, errs := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, []*querypb.BoundQuery{query}, false, false)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+
+	var uniqueKeys [][]int
+	var keyName string
+	for _, row := range qr.Rows {
+		name, column := row[0].ToString(), row[1].ToString()
+		pos := -1
+		for i, col := range ins.ReplaceColumns {
+			if strings.EqualFold(col, column) {
+				pos = i
+				break
+			}
+		}
+		if pos == -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: replace into %s without a value for column %s of unique key %s", ins.Table.Name.String(), column, name)
+		}
+		if len(uniqueKeys) == 0 || name != keyName {
+			uniqueKeys = append(uniqueKeys, nil)
+			keyName = name
+		}
+		uniqueKeys[len(uniqueKeys)-1] = append(uniqueKeys[len(uniqueKeys)-1], pos)
+	}
+	return uniqueKeys, nil
+}
+
+// replacedRowsQuery completes OwnedVindexQuery into the query that selects
+// the rows overwritten by the rows rowNums. A row with a NULL in a unique
+// key doesn't overwrite any row through that key. It returns nil if the
+// rows can't overwrite any row.
+func (ins *Insert) replacedRowsQuery(uniqueKeys [][]int, rows [][]sqltypes.Value, rowNums []int) *querypb.BoundQuery {
+	bindVars := make(map[string]*querypb.BindVariable)
+	var conditions []string
+	for _, positions := range uniqueKeys {
+		cols := make([]string, len(positions))
+		for i, pos := range positions {
+			cols[i] = sqlparser.String(sqlparser.NewColIdent(ins.ReplaceColumns[pos]))
+		}
+		var tuples []string
+	nextRow:
+		for _, rowNum := range rowNums {
+			for _, pos := range positions {
+				if rows[rowNum][pos].IsNull() {
+					continue nextRow
+				}
+			}
+			args := make([]string, len(positions))
+			for i, pos := range positions {
+				name := replaceVarName(rowNum, pos)
+				bindVars[name] = sqltypes.ValueBindVariable(rows[rowNum][pos])
+				args[i] = ":" + name
+			}
+			tuples = append(tuples, "("+strings.Join(args, ", ")+")")
+		}
+		if len(tuples) != 0 {
+			conditions = append(conditions, fmt.Sprintf("(%s) in (%s)", strings.Join(cols, ", "), strings.Join(tuples, ", ")))
+		}
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+	return &querypb.BoundQuery{
+		Sql:           ins.OwnedVindexQuery + " where " + strings.Join(conditions, " or ") + " for update",
+		BindVariables: bindVars,
+	}
+}
+
+func replaceVarName(rowNum, colNum int) string {
+	return fmt.Sprintf("__replace_r%d_c%d", rowNum, colNum)
+}
+
 // processPrimary maps the primary vindex values to the keyspace ids.
 func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex) ([][]byte, error) {
 	destinations, err := vindexes.Map(colVindex.Vindex, vcursor, vindexColumnsKeys)
@@ -612,13 +1024,31 @@ func InsertVarName(col sqlparser.ColIdent, rowNum int) string {
 	return fmt.Sprintf("_%s_%d", col.CompliantName(), rowNum)
 }
 
+// Inputs implements the Primitive interface
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 func (ins *Insert) description() PrimitiveDescription {
 	other := map[string]interface{}{
 		"Query":                ins.Query,
 		"TableName":            ins.GetTableName(),
+		"OwnedVindexQuery":     ins.OwnedVindexQuery,
 		"MultiShardAutocommit": ins.MultiShardAutocommit,
 		"QueryTimeout":         ins.QueryTimeout,
 	}
+	if len(ins.ReplaceColumns) != 0 {
+		other["ReplaceColumns"] = ins.ReplaceColumns
+	}
+	if ins.Input != nil {
+		other["VindexOffsetFromSelect"] = ins.VindexValueOffset
+		if ins.Generate != nil {
+			other["AutoIncrement"] = fmt.Sprintf("%s:%d", ins.Table.AutoIncrement.Column.String(), ins.Generate.Offset)
+		}
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
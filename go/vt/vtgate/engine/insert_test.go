@@ -1058,7 +1058,7 @@ func TestInsertShardedIgnoreOwnedWithNull(t *testing.T) {
 		"\x00",
 	)
 	vc := newDMLTestVCursor("-20", "20-")
-	vc.shardForKsid = []string{"-20", "20-"}
+	vc.shardForKsid = []string{"-20", "20-", "-20", "20-"}
 	vc.results = []*sqltypes.Result{
 		ksid0,
 		ksid0,
@@ -1621,3 +1621,427 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertSelectGenerate(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.Suffix = " suffix"
+	// The select returns (c, id). The id column is a vindex and an auto-inc column.
+	ins.VindexValueOffset = [][]int{{1}}
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query:  "dummy_generate",
+		Offset: 1,
+	}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"c|id",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|null",
+				"c|2",
+			),
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"10",
+		),
+		{InsertID: 1},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 -20`,
+		`ResolveDestinations sharded [value:"0"  value:"1"  value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(594764e1a2b2d98e),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		// The generated value is used for the second row.
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:__ins_r0_c0, :_id_0),(:__ins_r2_c0, :_id_2) suffix ` +
+			`{__ins_r0_c0: type:VARCHAR value:"a" __ins_r1_c0: type:VARCHAR value:"b" __ins_r2_c0: type:VARCHAR value:"c" ` +
+			`_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"10" _id_2: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix (:__ins_r1_c0, :_id_1) suffix ` +
+			`{__ins_r0_c0: type:VARCHAR value:"a" __ins_r1_c0: type:VARCHAR value:"b" __ins_r2_c0: type:VARCHAR value:"c" ` +
+			`_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"10" _id_2: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+
+	// The insert id returned by ExecuteMultiShard should be overwritten by the generated one.
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 10})
+}
+
+func TestInsertSelectOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.Suffix = " suffix"
+	ins.VindexValueOffset = [][]int{{0}, {1}}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c3",
+					"int64|int64",
+				),
+				"1|10",
+				"2|11",
+			),
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20"}
+
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0), (:from_1, :toc_1) ` +
+			`from_0: type:INT64 value:"10" from_1: type:INT64 value:"11" ` +
+			`toc_0: type:VARBINARY value:"\026k@\264J\272K\326" toc_1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_id_0, :_c3_0) suffix ` +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix (:_id_1, :_c3_1) suffix ` +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+}
+
+func TestInsertSelectBatches(t *testing.T) {
+	saveBatchSize := insertSelectBatchSize
+	insertSelectBatchSize = 2
+	defer func() {
+		insertSelectBatchSize = saveBatchSize
+	}()
+
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewSimpleInsert(InsertSharded, ks.Tables["t1"], ks.Keyspace)
+	ins.Prefix = "prefix "
+	ins.Suffix = " suffix"
+	ins.VindexValueOffset = [][]int{{0}}
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "20-"}
+	vc.results = []*sqltypes.Result{
+		{RowsAffected: 2, InsertID: 5},
+		{RowsAffected: 1, InsertID: 7},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every batch is inserted with its own bind variables.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: prefix (:_id_0, :__ins_r0_c1) suffix ` +
+			`{__ins_r0_c1: type:VARCHAR value:"a" __ins_r1_c1: type:VARCHAR value:"b" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`sharded.20-: prefix (:_id_1, :__ins_r1_c1) suffix ` +
+			`{__ins_r0_c1: type:VARCHAR value:"a" __ins_r1_c1: type:VARCHAR value:"b" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`true false`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_id_0, :__ins_r0_c1) suffix ` +
+			`{__ins_r0_c1: type:VARCHAR value:"c" _id_0: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 5})
+}
+
+func TestInsertSelectMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+	}()
+
+	ins := NewSimpleInsert(InsertSharded, nil, &vindexes.Keyspace{Name: "sharded", Sharded: true})
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+				"2",
+				"3",
+			),
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "in-memory row count exceeded allowed limit of 2")
+	vc.ExpectLog(t, nil)
+}
+
+func TestInsertSelectEmpty(t *testing.T) {
+	ins := NewSimpleInsert(InsertSharded, nil, &vindexes.Keyspace{Name: "sharded", Sharded: true})
+	ins.Input = &fakePrimitive{
+		results: []*sqltypes.Result{{}},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, nil)
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+}
+
+func TestInsertReplaceOwned(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}},
+			}},
+		}, {
+			// colVindex columns: c1, c2
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(4)}, {Value: sqltypes.NewInt64(7)}},
+			}, {
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(5)}, {Value: sqltypes.NewInt64(8)}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(6)}, {Value: sqltypes.NewInt64(9)}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "select id, c1, c2, c3 from t1"
+	ins.ReplaceColumns = []string{"id", "c1", "c2", "c3", "name"}
+	ins.ReplaceValues = []sqltypes.PlanValue{
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}}},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(4)}, {Value: sqltypes.NewInt64(7)}}},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(5)}, {Value: sqltypes.NewInt64(8)}}},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(6)}, {Value: sqltypes.NewInt64(9)}}},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewVarChar("a")}, {Value: sqltypes.NULL}}},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{
+		// The unique keys of the table.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"index_name|column_name",
+				"varchar|varchar",
+			),
+			"PRIMARY|id",
+			"uk_name|c1",
+			"uk_name|name",
+		),
+		// The row being replaced.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|c1|c2|c3",
+				"int64|int64|int64|int64",
+			),
+			"3|14|15|16",
+		),
+	}
+
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every shard is only sent the keys of its own rows, and the second
+	// row can't overwrite a row through uk_name as its name is NULL.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: ` + uniqueKeysQuery + ` {table_name: type:VARBINARY value:"t1" } false false`,
+		"ExecuteMultiShard " +
+			"sharded.-20: select id, c1, c2, c3 from t1 where (id) in ((:__replace_r0_c0)) or (c1, `name`) in ((:__replace_r0_c1, :__replace_r0_c4)) for update " +
+			`{__replace_r0_c0: type:INT64 value:"1" __replace_r0_c1: type:INT64 value:"4" __replace_r0_c4: type:VARCHAR value:"a" } ` +
+			"sharded.20-: select id, c1, c2, c3 from t1 where (id) in ((:__replace_r1_c0)) for update " +
+			`{__replace_r1_c0: type:INT64 value:"2" } false false`,
+		// The lookup entries of the replaced row are deleted first.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"14" from2: type:INT64 value:"15" toc: type:VARBINARY value:"N\261\220\311\242\372\026\234"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"16" toc: type:VARBINARY value:"N\261\220\311\242\372\026\234"  true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from1_0, :from2_0, :toc_0), (:from1_1, :from2_1, :toc_1) from1_0: type:INT64 value:"4" from1_1: type:INT64 value:"7" from2_0: type:INT64 value:"5" from2_1: type:INT64 value:"8" toc_0: type:VARBINARY value:"\026k@\264J\272K\326" toc_1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0), (:from_1, :toc_1) from_0: type:INT64 value:"6" from_1: type:INT64 value:"9" toc_0: type:VARBINARY value:"\026k@\264J\272K\326" toc_1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: prefix mid1 suffix {_c1_0: type:INT64 value:"4" _c1_1: type:INT64 value:"7" _c2_0: type:INT64 value:"5" _c2_1: type:INT64 value:"8" _c3_0: type:INT64 value:"6" _c3_1: type:INT64 value:"9" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`sharded.20-: prefix mid2 suffix {_c1_0: type:INT64 value:"4" _c1_1: type:INT64 value:"7" _c2_0: type:INT64 value:"5" _c2_1: type:INT64 value:"8" _c3_0: type:INT64 value:"6" _c3_1: type:INT64 value:"9" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } true false`,
+	})
+}
+
+func TestInsertReplaceOwnedMissingKeyColumn(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+			}},
+		}, {
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(4)}},
+			}, {
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(5)}},
+			}},
+		}, {
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(6)}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "select id, c1, c2, c3 from t1"
+	ins.ReplaceColumns = []string{"id", "c1", "c2", "c3"}
+	ins.ReplaceValues = []sqltypes.PlanValue{
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}}},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(4)}}},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(5)}}},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(6)}}},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"index_name|column_name",
+			"varchar|varchar",
+		),
+		"PRIMARY|pk",
+	)}
+
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: unsupported: replace into t1 without a value for column pk of unique key PRIMARY")
+}
@@ -64,18 +64,36 @@ type AggregateParams struct {
 	Col    int
 	// Alias is set only for distinct opcodes.
 	Alias string `json:",omitempty"`
+	// Separator is set only for group_concat.
+	Separator string `json:",omitempty"`
+	// WCol is set only for distinct opcodes over text columns. It is the
+	// column of the weight_string of Col, by which the values are compared.
+	// It is never 0, as the weight_string column comes after Col.
+	WCol int `json:",omitempty"`
 }
 
 func (ap AggregateParams) isDistinct() bool {
 	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct
 }
 
+// distinctCol returns the column by which the distinct values are compared.
+func (ap AggregateParams) distinctCol() int {
+	if ap.WCol != 0 {
+		return ap.WCol
+	}
+	return ap.Col
+}
+
 func (ap AggregateParams) String() string {
+	col := strconv.Itoa(ap.Col)
+	if ap.WCol != 0 {
+		col += "|" + strconv.Itoa(ap.WCol)
+	}
 	if ap.Alias != "" {
-		return fmt.Sprintf("%s(%d) AS %s", ap.Opcode.String(), ap.Col, ap.Alias)
+		return fmt.Sprintf("%s(%s) AS %s", ap.Opcode.String(), col, ap.Alias)
 	}
 
-	return fmt.Sprintf("%s(%d)", ap.Opcode.String(), ap.Col)
+	return fmt.Sprintf("%s(%s)", ap.Opcode.String(), col)
 }
 
 // AggregateOpcode is the aggregation Opcode.
@@ -89,6 +107,7 @@ const (
 	AggregateMax
 	AggregateCountDistinct
 	AggregateSumDistinct
+	AggregateGroupConcat
 )
 
 var (
@@ -113,6 +132,7 @@ var SupportedAggregates = map[string]AggregateOpcode{
 	// to display the plan.
 	"count_distinct": AggregateCountDistinct,
 	"sum_distinct":   AggregateSumDistinct,
+	"group_concat":   AggregateGroupConcat,
 }
 
 func (code AggregateOpcode) String() string {
@@ -170,10 +190,10 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	}
 	// This code is similar to the one in StreamExecute.
 	var current []sqltypes.Value
-	var curDistinct sqltypes.Value
+	var distinct *distinctValues
 	for _, row := range result.Rows {
 		if current == nil {
-			current, curDistinct = oa.convertRow(row)
+			current, distinct = oa.convertRow(row)
 			continue
 		}
 
@@ -183,20 +203,20 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		}
 
 		if equal {
-			current, curDistinct, err = oa.merge(result.Fields, current, row, curDistinct)
+			current, err = oa.merge(result.Fields, current, row, distinct)
 			if err != nil {
 				return nil, err
 			}
 			continue
 		}
 		out.Rows = append(out.Rows, current)
-		current, curDistinct = oa.convertRow(row)
+		current, distinct = oa.convertRow(row)
 	}
 
 	if len(result.Rows) == 0 && len(oa.Keys) == 0 {
 		// When doing aggregation without grouping keys, we need to produce a single row containing zero-value for the
 		// different aggregation functions
-		row, err := oa.createEmptyRow()
+		row, err := oa.createEmptyRow(len(result.Fields))
 		if err != nil {
 			return nil, err
 		}
@@ -212,7 +232,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 // StreamExecute is a Primitive function.
 func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	var current []sqltypes.Value
-	var curDistinct sqltypes.Value
+	var distinct *distinctValues
 	var fields []*querypb.Field
 
 	cb := func(qr *sqltypes.Result) error {
@@ -229,7 +249,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 		// This code is similar to the one in Execute.
 		for _, row := range qr.Rows {
 			if current == nil {
-				current, curDistinct = oa.convertRow(row)
+				current, distinct = oa.convertRow(row)
 				continue
 			}
 
@@ -239,7 +259,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 			}
 
 			if equal {
-				current, curDistinct, err = oa.merge(fields, current, row, curDistinct)
+				current, err = oa.merge(fields, current, row, distinct)
 				if err != nil {
 					return err
 				}
@@ -248,7 +268,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
 				return err
 			}
-			current, curDistinct = oa.convertRow(row)
+			current, distinct = oa.convertRow(row)
 		}
 		return nil
 	})
@@ -256,6 +276,16 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 		return err
 	}
 
+	if current == nil && len(oa.Keys) == 0 {
+		// Like in Execute, aggregating an empty input without grouping
+		// keys produces a single row.
+		row, err := oa.createEmptyRow(len(fields))
+		if err != nil {
+			return err
+		}
+		return cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}})
+	}
+
 	if current != nil {
 		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
 			return err
@@ -281,15 +311,18 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 	return fields
 }
 
-func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
+func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, distinct *distinctValues) {
 	if !oa.HasDistinct {
-		return row, sqltypes.NULL
+		return row, nil
 	}
+	distinct = oa.newDistinctValues()
 	newRow = append(newRow, row...)
-	for _, aggr := range oa.Aggregates {
+	for i, aggr := range oa.Aggregates {
+		if aggr.isDistinct() {
+			distinct.add(i, row[aggr.distinctCol()])
+		}
 		switch aggr.Opcode {
 		case AggregateCountDistinct:
-			curDistinct = row[aggr.Col]
 			// Type is int64. Ok to call MakeTrusted.
 			if row[aggr.Col].IsNull() {
 				newRow[aggr.Col] = countZero
@@ -297,7 +330,6 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 				newRow[aggr.Col] = countOne
 			}
 		case AggregateSumDistinct:
-			curDistinct = row[aggr.Col]
 			var err error
 			newRow[aggr.Col], err = evalengine.Cast(row[aggr.Col], opcodeType[aggr.Opcode])
 			if err != nil {
@@ -305,7 +337,56 @@ func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.
 			}
 		}
 	}
-	return newRow, curDistinct
+	return newRow, distinct
+}
+
+// distinctValues keeps track of the values that the distinct aggregates
+// have already aggregated in the current group. If there's a single
+// distinct aggregate, the input is sorted by its column within a group,
+// and comparing with the last value is enough. Otherwise, the values of
+// each distinct aggregate are kept in a set. Text values are compared by
+// their weight_string, so that they follow the collation of their column.
+type distinctValues struct {
+	last []sqltypes.Value
+	seen []map[string]bool
+}
+
+func (oa *OrderedAggregate) newDistinctValues() *distinctValues {
+	count := 0
+	for _, aggr := range oa.Aggregates {
+		if aggr.isDistinct() {
+			count++
+		}
+	}
+	dv := &distinctValues{last: make([]sqltypes.Value, len(oa.Aggregates))}
+	if count > 1 {
+		dv.seen = make([]map[string]bool, len(oa.Aggregates))
+		for i, aggr := range oa.Aggregates {
+			if aggr.isDistinct() {
+				dv.seen[i] = make(map[string]bool)
+			}
+		}
+	}
+	return dv
+}
+
+// add records the value for the distinct aggregate at index i.
+// It returns false if the value was already aggregated.
+func (dv *distinctValues) add(i int, v sqltypes.Value) (bool, error) {
+	if dv.seen != nil {
+		key := v.Type().String() + ":" + v.ToString()
+		if dv.seen[i][key] {
+			return false, nil
+		}
+		dv.seen[i][key] = true
+		return true, nil
+	}
+	cmp, err := evalengine.NullsafeCompare(dv.last[i], v)
+	if err != nil {
+		return false, err
+	}
+	dv.last[i] = v
+	return cmp != 0, nil
 }
 
 // GetFields is a Primitive function.
@@ -341,21 +422,20 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error)
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, distinct *distinctValues) ([]sqltypes.Value, error) {
 	result := sqltypes.CopyRow(row1)
-	for _, aggr := range oa.Aggregates {
+	for i, aggr := range oa.Aggregates {
 		if aggr.isDistinct() {
 			if row2[aggr.Col].IsNull() {
 				continue
 			}
-			cmp, err := evalengine.NullsafeCompare(curDistinct, row2[aggr.Col])
+			added, err := distinct.add(i, row2[aggr.distinctCol()])
 			if err != nil {
-				return nil, sqltypes.NULL, err
+				return nil, err
 			}
-			if cmp == 0 {
+			if !added {
 				continue
 			}
-			curDistinct = row2[aggr.Col]
 		}
 		var err error
 		switch aggr.Opcode {
@@ -369,25 +449,49 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], countOne, opcodeType[aggr.Opcode])
 		case AggregateSumDistinct:
 			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], opcodeType[aggr.Opcode])
+		case AggregateGroupConcat:
+			result[aggr.Col] = concatWithSeparator(row1[aggr.Col], row2[aggr.Col], aggr.Separator)
 		default:
-			return nil, sqltypes.NULL, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
+			return nil, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
 		}
 		if err != nil {
-			return nil, sqltypes.NULL, err
+			return nil, err
 		}
 	}
-	return result, curDistinct, nil
+	return result, nil
 }
 
-// creates the empty row for the case when we are missing grouping keys and have empty input table
-func (oa *OrderedAggregate) createEmptyRow() ([]sqltypes.Value, error) {
-	out := make([]sqltypes.Value, len(oa.Aggregates))
-	for i, aggr := range oa.Aggregates {
+// concatWithSeparator merges two partial results of group_concat.
+// Like group_concat, it ignores NULL values.
+func concatWithSeparator(v1, v2 sqltypes.Value, separator string) sqltypes.Value {
+	switch {
+	case v1.IsNull():
+		return v2
+	case v2.IsNull():
+		return v1
+	}
+	buf := make([]byte, 0, len(v1.Raw())+len(separator)+len(v2.Raw()))
+	buf = append(buf, v1.Raw()...)
+	buf = append(buf, separator...)
+	buf = append(buf, v2.Raw()...)
+	return sqltypes.MakeTrusted(v1.Type(), buf)
+}
+
+// creates the empty row for the case when we are missing grouping keys and have empty input table.
+// The columns that are not aggregated are NULL.
+func (oa *OrderedAggregate) createEmptyRow(columns int) ([]sqltypes.Value, error) {
+	for _, aggr := range oa.Aggregates {
+		if aggr.Col >= columns {
+			columns = aggr.Col + 1
+		}
+	}
+	out := make([]sqltypes.Value, columns)
+	for _, aggr := range oa.Aggregates {
 		value, err := createEmptyValueFor(aggr.Opcode)
 		if err != nil {
 			return nil, err
 		}
-		out[i] = value
+		out[aggr.Col] = value
 	}
 	return out, nil
 }
@@ -402,7 +506,8 @@ func createEmptyValueFor(opcode AggregateOpcode) (sqltypes.Value, error) {
 		AggregateSumDistinct,
 		AggregateSum,
 		AggregateMin,
-		AggregateMax:
+		AggregateMax,
+		AggregateGroupConcat:
 		return sqltypes.NULL, nil
 
 	}
@@ -651,13 +651,13 @@ func TestMerge(t *testing.T) {
 		"1|3|2.8|2|bc",
 	)
 
-	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
+	merged, err := oa.merge(fields, r.Rows[0], r.Rows[1], nil)
 	assert.NoError(err)
-	want := sqltypes.MakeTestResult(fields, "1|5|6|2|bc").Rows[0]
+	want := sqltypes.MakeTestResult(fields, "1|5|6.0|2|bc").Rows[0]
 	assert.Equal(want, merged)
 
 	// swap and retry
-	merged, _, err = oa.merge(fields, r.Rows[1], r.Rows[0], sqltypes.NULL)
+	merged, err = oa.merge(fields, r.Rows[1], r.Rows[0], nil)
 	assert.NoError(err)
 	assert.Equal(want, merged)
 }
@@ -738,3 +738,157 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 		})
 	}
 }
+
+func TestOrderedAggregateMultipleCountDistinct(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|a|b|count(*)",
+				"varbinary|int64|varbinary|int64",
+			),
+			"x|1|p|1",
+			"x|1|q|2",
+			"x|2|p|1",
+			"y|null|p|1",
+			"y|3|null|1",
+			"y|3|p|1",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct a)",
+		}, {
+			Opcode: AggregateCountDistinct,
+			Col:    2,
+			Alias:  "count(distinct b)",
+		}, {
+			Opcode: AggregateCount,
+			Col:    3,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(distinct a)|count(distinct b)|count(*)",
+			"varbinary|int64|int64|int64",
+		),
+		"x|2|2|4",
+		"y|1|1|3",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestOrderedAggregateCountDistinctWeightString(t *testing.T) {
+	input := func() *fakePrimitive {
+		return &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col|name|id|weight_string(name)",
+					"varbinary|varchar|int64|varbinary",
+				),
+				"x|a|1|A",
+				"x|A|2|A",
+				"x|b|2|B",
+				"y|c|3|C",
+			)},
+		}
+	}
+	nameAggr := AggregateParams{
+		Opcode: AggregateCountDistinct,
+		Col:    1,
+		Alias:  "count(distinct name)",
+		WCol:   3,
+	}
+	idAggr := AggregateParams{
+		Opcode: AggregateCountDistinct,
+		Col:    2,
+		Alias:  "count(distinct id)",
+	}
+
+	// 'a' and 'A' have the same weight_string, so they are the same value.
+	oa := &OrderedAggregate{
+		HasDistinct:         true,
+		Aggregates:          []AggregateParams{nameAggr},
+		Keys:                []int{0},
+		TruncateColumnCount: 2,
+		Input:               input(),
+	}
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(distinct name)",
+			"varbinary|int64",
+		),
+		"x|2",
+		"y|1",
+	), result)
+
+	// With several distinct aggregates, the values are kept in sets.
+	oa = &OrderedAggregate{
+		HasDistinct:         true,
+		Aggregates:          []AggregateParams{nameAggr, idAggr},
+		Keys:                []int{0},
+		TruncateColumnCount: 3,
+		Input:               input(),
+	}
+	result, err = oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|count(distinct name)|count(distinct id)",
+			"varbinary|int64|int64",
+		),
+		"x|2|2",
+		"y|1|1",
+	), result)
+}
+
+func TestOrderedAggregateGroupConcat(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|group_concat(name)",
+				"varbinary|varbinary",
+			),
+			"a|x,y",
+			"a|null",
+			"a|z",
+			"b|null",
+			"c|w",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateGroupConcat,
+			Col:       1,
+			Separator: ",",
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|group_concat(name)",
+			"varbinary|varbinary",
+		),
+		"a|x,y,z",
+		"b|null",
+		"c|w",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
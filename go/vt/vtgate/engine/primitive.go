@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
@@ -93,10 +94,14 @@ type (
 
 		ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error)
 
+		InTransaction() bool
+
 		InTransactionAndIsDML() bool
 
 		LookupRowLockShardSession() vtgatepb.CommitOrder
 
+		TabletType() topodatapb.TabletType
+
 		FindRoutedTable(tablename sqlparser.TableName) (*vindexes.Table, error)
 	}
 
@@ -124,8 +129,14 @@ type (
 		SetClientFoundRows(bool) error
 		SetSkipQueryPlanCache(bool) error
 		SetSQLSelectLimit(int64) error
+		// SetQueryTimeout sets the max_execution_time of the
+		// session, in milliseconds. 0 means no timeout.
+		SetQueryTimeout(int64)
 		SetTransactionMode(vtgatepb.TransactionMode)
 		SetWorkload(querypb.ExecuteOptions_Workload)
+		// SetConsistentSnapshot sets whether the transactions of the session are
+		// consistent snapshots, started at a common cut on the shards that are read.
+		SetConsistentSnapshot(bool)
 		SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion)
 		SetFoundRows(uint64)
 
@@ -153,6 +164,13 @@ type (
 		Original     string                  // Original is the original query.
 		Instructions Primitive               // Instructions contains the instructions needed to fulfil the query.
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
+		ResultCache  *ResultCache            // ResultCache is set if the results of the query can be cached.
+		// MaxExecutionTime is the timeout in milliseconds of a select that has
+		// the MAX_EXECUTION_TIME optimizer hint, or 0.
+		MaxExecutionTime int
+		// TableStats contains the tables, qualified by their keyspace,
+		// whose statistics were used to build the plan.
+		TableStats []string
 
 		mu           sync.Mutex    // Mutex to protect the fields below
 		ExecCount    uint64        // Count of times this plan was executed
@@ -161,6 +179,15 @@ type (
 		RowsReturned uint64        // Total number of rows
 		RowsAffected uint64        // Total number of rows
 		Errors       uint64        // Total number of errors
+		stale        bool          // Set when the plan has to be built again
+	}
+
+	// ResultCache describes how the results of a plan are cached. They are
+	// cached for TTL, and until one of Tables, qualified by their keyspace,
+	// changes.
+	ResultCache struct {
+		TTL    time.Duration
+		Tables []string
 	}
 
 	// Match is used to check if a Primitive matches
@@ -222,6 +249,21 @@ func (p *Plan) Stats() (execCount uint64, execTime time.Duration, shardQueries,
 	return
 }
 
+// MarkStale marks the plan as stale, so that
+// it is built again instead of being reused.
+func (p *Plan) MarkStale() {
+	p.mu.Lock()
+	p.stale = true
+	p.mu.Unlock()
+}
+
+// IsStale returns true if the plan has to be built again.
+func (p *Plan) IsStale() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stale
+}
+
 // Find will return the first Primitive that matches the evaluate function. If no match is found, nil will be returned
 func Find(isMatch Match, start Primitive) Primitive {
 	if isMatch(start) {
@@ -250,25 +292,29 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 	}
 
 	marshalPlan := struct {
-		QueryType    string
-		Original     string                `json:",omitempty"`
-		Instructions *PrimitiveDescription `json:",omitempty"`
-		ExecCount    uint64                `json:",omitempty"`
-		ExecTime     time.Duration         `json:",omitempty"`
-		ShardQueries uint64                `json:",omitempty"`
-		RowsAffected uint64                `json:",omitempty"`
-		RowsReturned uint64                `json:",omitempty"`
-		Errors       uint64                `json:",omitempty"`
+		QueryType        string
+		Original         string                `json:",omitempty"`
+		Instructions     *PrimitiveDescription `json:",omitempty"`
+		ResultCache      *ResultCache          `json:",omitempty"`
+		MaxExecutionTime int                   `json:",omitempty"`
+		ExecCount        uint64                `json:",omitempty"`
+		ExecTime         time.Duration         `json:",omitempty"`
+		ShardQueries     uint64                `json:",omitempty"`
+		RowsAffected     uint64                `json:",omitempty"`
+		RowsReturned     uint64                `json:",omitempty"`
+		Errors           uint64                `json:",omitempty"`
 	}{
-		QueryType:    p.Type.String(),
-		Original:     p.Original,
-		Instructions: instructions,
-		ExecCount:    p.ExecCount,
-		ExecTime:     p.ExecTime,
-		ShardQueries: p.ShardQueries,
-		RowsAffected: p.RowsAffected,
-		RowsReturned: p.RowsReturned,
-		Errors:       p.Errors,
+		QueryType:        p.Type.String(),
+		Original:         p.Original,
+		Instructions:     instructions,
+		ResultCache:      p.ResultCache,
+		MaxExecutionTime: p.MaxExecutionTime,
+		ExecCount:        p.ExecCount,
+		ExecTime:         p.ExecTime,
+		ShardQueries:     p.ShardQueries,
+		RowsAffected:     p.RowsAffected,
+		RowsReturned:     p.RowsReturned,
+		Errors:           p.Errors,
 	}
 	return json.Marshal(marshalPlan)
 }
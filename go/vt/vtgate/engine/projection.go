@@ -8,6 +8,8 @@ import (
 
 var _ Primitive = (*Projection)(nil)
 
+// Projection evaluates the expressions on every row of its input.
+// The result has one column for each expression.
 type Projection struct {
 	Cols  []string
 	Exprs []evalengine.Expr
@@ -28,67 +30,56 @@ func (p *Projection) GetTableName() string {
 }
 
 func (p *Projection) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	result, err := p.Input.Execute(vcursor, bindVars, wantfields)
+	result, err := p.Input.Execute(vcursor, bindVars, true)
 	if err != nil {
 		return nil, err
 	}
+	return p.project(result, bindVars, wantfields)
+}
 
-	env := evalengine.ExpressionEnv{
-		BindVars: bindVars,
-	}
-
-	if wantfields {
-		err := p.addFields(result, bindVars)
-		if err != nil {
-			return nil, err
+func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	return p.Input.StreamExecute(vcursor, bindVars, true, func(qr *sqltypes.Result) error {
+		if fields == nil {
+			fields = qr.Fields
+		} else {
+			qr.Fields = fields
+			wantfields = false
 		}
-	}
-	var rows [][]sqltypes.Value
-	for _, row := range result.Rows {
-		env.Row = row
-		for _, exp := range p.Exprs {
-			result, err := exp.Evaluate(env)
-			if err != nil {
-				return nil, err
-			}
-			row = append(row, result.Value())
+		out, err := p.project(qr, bindVars, wantfields)
+		if err != nil {
+			return err
 		}
-		rows = append(rows, row)
-	}
-	result.Rows = rows
-	return result, nil
+		return callback(out)
+	})
 }
 
-func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantields bool, callback func(*sqltypes.Result) error) error {
-	result, err := p.Input.Execute(vcursor, bindVars, wantields)
-	if err != nil {
-		return err
-	}
-
+func (p *Projection) project(input *sqltypes.Result, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
 	env := evalengine.ExpressionEnv{
 		BindVars: bindVars,
+		Fields:   input.Fields,
 	}
-
-	if wantields {
-		err = p.addFields(result, bindVars)
+	result := &sqltypes.Result{}
+	if wantfields {
+		fields, err := p.fields(input.Fields, bindVars)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		result.Fields = fields
 	}
-	var rows [][]sqltypes.Value
-	for _, row := range result.Rows {
+	for _, row := range input.Rows {
 		env.Row = row
+		newRow := make([]sqltypes.Value, 0, len(p.Exprs))
 		for _, exp := range p.Exprs {
-			result, err := exp.Evaluate(env)
+			value, err := exp.Evaluate(env)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			row = append(row, result.Value())
+			newRow = append(newRow, value.Value())
 		}
-		rows = append(rows, row)
+		result.Rows = append(result.Rows, newRow)
 	}
-	result.Rows = rows
-	return callback(result)
+	return result, nil
 }
 
 func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
@@ -96,26 +87,27 @@ func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bin
 	if err != nil {
 		return nil, err
 	}
-	err = p.addFields(qr, bindVars)
+	fields, err := p.fields(qr.Fields, bindVars)
 	if err != nil {
 		return nil, err
 	}
-	return qr, nil
+	return &sqltypes.Result{Fields: fields}, nil
 }
 
-func (p *Projection) addFields(qr *sqltypes.Result, bindVars map[string]*querypb.BindVariable) error {
-	env := evalengine.ExpressionEnv{BindVars: bindVars}
+func (p *Projection) fields(inputFields []*querypb.Field, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: inputFields}
+	fields := make([]*querypb.Field, 0, len(p.Cols))
 	for i, col := range p.Cols {
 		q, err := p.Exprs[i].Type(env)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		qr.Fields = append(qr.Fields, &querypb.Field{
+		fields = append(fields, &querypb.Field{
 			Name: col,
 			Type: q,
 		})
 	}
-	return nil
+	return fields, nil
 }
 
 func (p *Projection) Inputs() []Primitive {
@@ -73,9 +73,14 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a MultiColumn vindex, they are the values of its first
+	// columns, and the query is routed by their combinations.
 	Values []sqltypes.PlanValue
+	// ExcludeStart and ExcludeEnd specify if the bounds
+	// of a SelectRange are excluded from the range.
+	ExcludeStart, ExcludeEnd bool
 
 	// OrderBy specifies the key order for merge sorting. This will be
 	// set only for scatter queries that need the results to be
@@ -150,11 +155,13 @@ const (
 	SelectUnsharded = RouteOpcode(iota)
 	// SelectEqualUnique is for routing a query to
 	// a single shard. Requires: A Unique Vindex, and
-	// a single Value.
+	// a single Value, or one per column of a MultiColumn
+	// Vindex.
 	SelectEqualUnique
 	// SelectEqual is for routing a query using a
-	// non-unique vindex. Requires: A Vindex, and
-	// a single Value.
+	// non-unique vindex, or the first columns of a
+	// MultiColumn vindex. Requires: A Vindex, and
+	// a single Value per column.
 	SelectEqual
 	// SelectIN is for routing a query that has an IN
 	// clause using a Vindex. Requires: A Vindex,
@@ -163,7 +170,8 @@ const (
 	// SelectMultiEqual is the opcode for routing a query
 	// based on multiple vindex input values, similar to
 	// SelectIN, but the query sent to each shard is the
-	// same.
+	// same. The values of each column of a MultiColumn
+	// vindex can be a single value or a list.
 	SelectMultiEqual
 	// SelectScatter is for routing a scatter query
 	// to all shards of a keyspace.
@@ -176,6 +184,11 @@ const (
 	SelectReference
 	// SelectNone is used for queries that always return empty values
 	SelectNone
+	// SelectRange is for routing a query that has range
+	// conditions on the column of a vindex that maps values
+	// monotonically. Requires: A RangeMapper Vindex, and
+	// the start and end Values, which can be NULL.
+	SelectRange
 	// NumRouteOpcodes is the number of opcodes
 	NumRouteOpcodes
 )
@@ -191,6 +204,7 @@ var routeName = map[RouteOpcode]string{
 	SelectDBA:         "SelectDBA",
 	SelectReference:   "SelectReference",
 	SelectNone:        "SelectNone",
+	SelectRange:       "SelectRange",
 }
 
 var (
@@ -253,6 +267,8 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -318,6 +334,8 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
 		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+	case SelectRange:
+		rss, bvs, err = route.paramsSelectRange(vcursor, bindVars)
 	case SelectNone:
 		rss, bvs, err = nil, nil, nil
 	default:
@@ -512,11 +530,14 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if _, ok := route.Vindex.(vindexes.MultiColumn); ok {
+		return route.paramsSelectMultiCol(vcursor, bindVars)
+	}
 	key, err := route.Values[0].ResolveValue(bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
+	rss, _, err := resolveShards(vcursor, route.Vindex.(vindexes.SingleColumn), route.Keyspace, []sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
 	}
@@ -532,7 +553,7 @@ func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
-	rss, values, err := resolveShards(vcursor, route.Vindex, route.Keyspace, keys)
+	rss, values, err := resolveShards(vcursor, route.Vindex.(vindexes.SingleColumn), route.Keyspace, keys)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
@@ -540,11 +561,14 @@ func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if _, ok := route.Vindex.(vindexes.MultiColumn); ok {
+		return route.paramsSelectMultiCol(vcursor, bindVars)
+	}
 	keys, err := route.Values[0].ResolveList(bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, keys)
+	rss, _, err := resolveShards(vcursor, route.Vindex.(vindexes.SingleColumn), route.Keyspace, keys)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
@@ -555,6 +579,83 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
+// paramsSelectMultiCol routes a query by the values of the columns of a
+// MultiColumn vindex. The query sent to each shard is the same.
+func (route *Route) paramsSelectMultiCol(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	rowsColValues, err := resolveRowsColValues(route.Values, bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectMultiCol")
+	}
+	destinations, err := vindexes.Map(route.Vindex, vcursor, rowsColValues)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectMultiCol")
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectMultiCol")
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
+func (route *Route) paramsSelectRange(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	start, err := route.Values[0].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	end, err := route.Values[1].ResolveValue(bindVars)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	destination, err := route.Vindex.(vindexes.RangeMapper).MapRange(vcursor, start, end, route.ExcludeStart, route.ExcludeEnd)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{destination})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "paramsSelectRange")
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
+// resolveRowsColValues returns the rows of values to map with a vindex:
+// every combination of the values of its columns, each of which can be
+// a single value or a list.
+func resolveRowsColValues(values []sqltypes.PlanValue, bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	rowsColValues := [][]sqltypes.Value{nil}
+	for _, pv := range values {
+		var colValues []sqltypes.Value
+		if pv.IsList() {
+			list, err := pv.ResolveList(bindVars)
+			if err != nil {
+				return nil, err
+			}
+			colValues = list
+		} else {
+			value, err := pv.ResolveValue(bindVars)
+			if err != nil {
+				return nil, err
+			}
+			colValues = []sqltypes.Value{value}
+		}
+		rows := make([][]sqltypes.Value, 0, len(rowsColValues)*len(colValues))
+		for _, row := range rowsColValues {
+			for _, value := range colValues {
+				rows = append(rows, append(append(make([]sqltypes.Value, 0, len(values)), row...), value))
+			}
+		}
+		rowsColValues = rows
+	}
+	return rowsColValues, nil
+}
+
 func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
@@ -613,8 +714,8 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	return out, err
 }
 
-func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveSingleShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -637,8 +738,8 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 	return rss[0], ksid, nil
 }
 
-func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
-	destinations, err := vindex.Map(vcursor, vindexKey)
+func resolveMultiShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, rowsColValues [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, rowsColValues)
 	if err != nil {
 		return nil, err
 	}
@@ -649,8 +750,8 @@ func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *
 	return rss, nil
 }
 
-func resolveKeyspaceID(vcursor VCursor, vindex vindexes.SingleColumn, vindexKey sqltypes.Value) ([]byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveKeyspaceID(vcursor VCursor, vindex vindexes.Vindex, vindexKey []sqltypes.Value) ([]byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, err
 	}
@@ -723,6 +824,12 @@ func (route *Route) description() PrimitiveDescription {
 	if len(route.Values) > 0 {
 		other["Values"] = route.Values
 	}
+	if route.ExcludeStart {
+		other["ExcludeStart"] = true
+	}
+	if route.ExcludeEnd {
+		other["ExcludeEnd"] = true
+	}
 	if route.SysTableTableSchema != nil {
 		other["SysTableTableSchema"] = route.SysTableTableSchema.String()
 	}
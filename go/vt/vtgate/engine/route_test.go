@@ -592,6 +592,113 @@ func TestSelectMultiEqual(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectRange(t *testing.T) {
+	vindex, _ := vindexes.NewRange("", map[string]string{"boundaries": "100,200"})
+	sel := NewRoute(
+		SelectRange,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{Key: "start"}, {Value: sqltypes.NewInt64(200)}}
+	sel.ExcludeEnd = true
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	bv := map[string]*querypb.BindVariable{"start": sqltypes.Int64BindVariable(100)}
+	result, err := sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(00018000000000000064-0002)`,
+		`ExecuteMultiShard ks.-20: dummy_select {start: type:INT64 value:"100" } ks.20-: dummy_select {start: type:INT64 value:"100" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(00018000000000000064-0002)`,
+		`StreamExecuteMulti dummy_select ks.-20: {start: type:INT64 value:"100" } ks.20-: {start: type:INT64 value:"100" } `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+
+	// The range is empty.
+	vc.Rewind()
+	bv = map[string]*querypb.BindVariable{"start": sqltypes.Int64BindVariable(200)}
+	result, err = sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationNone()`,
+	})
+	expectResult(t, "sel.Execute", result, &sqltypes.Result{})
+}
+
+func TestSelectMultiCol(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{"column_count": "2"})
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Key: "col2"}}
+	bv := map[string]*querypb.BindVariable{"col2": sqltypes.Int64BindVariable(2)}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b406e7ea22)`,
+		`ExecuteMultiShard ks.-20: dummy_select {col2: type:INT64 value:"2" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// The first column maps to a key range.
+	vc.Rewind()
+	sel.Opcode = SelectEqual
+	sel.Values = sel.Values[:1]
+	result, err = wrapStreamExecute(sel, vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(166b40b4-166b40b5)`,
+		`StreamExecuteMulti dummy_select ks.-20: {col2: type:INT64 value:"2" } ks.20-: {col2: type:INT64 value:"2" } `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+
+	// Every combination of the values of the columns is mapped.
+	vc.Rewind()
+	sel.Opcode = SelectMultiEqual
+	sel.Values = []sqltypes.PlanValue{
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}}},
+		{Key: "col2"},
+	}
+	result, err = sel.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b406e7ea22),DestinationKeyspaceID(06e7ea2206e7ea22)`,
+		`ExecuteMultiShard ks.-20: dummy_select {col2: type:INT64 value:"2" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	_, err = sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "paramsSelectMultiCol: missing bind var col2")
+}
+
 func TestSelectNext(t *testing.T) {
 	sel := NewRoute(
 		SelectNext,
@@ -236,6 +236,7 @@ func (svci *SysVarCheckAndIgnore) VariableName() string {
 
 //Execute implements the SetOp interface method
 func (svci *SysVarCheckAndIgnore) Execute(vcursor VCursor, env evalengine.ExpressionEnv) error {
+	endConsistentSnapshot(vcursor, svci.Name)
 	rss, _, err := vcursor.ResolveDestinations(svci.Keyspace.Name, nil, []key.Destination{svci.TargetDestination})
 	if err != nil {
 		return vterrors.Wrap(err, "SysVarCheckAndIgnore")
@@ -276,6 +277,7 @@ func (svs *SysVarReservedConn) VariableName() string {
 
 //Execute implements the SetOp interface method
 func (svs *SysVarReservedConn) Execute(vcursor VCursor, env evalengine.ExpressionEnv) error {
+	endConsistentSnapshot(vcursor, svs.Name)
 	// For those running on advanced vitess settings.
 	if svs.TargetDestination != nil {
 		rss, _, err := vcursor.ResolveDestinations(svs.Keyspace.Name, nil, []key.Destination{svs.TargetDestination})
@@ -343,6 +345,14 @@ func (svs *SysVarReservedConn) checkAndUpdateSysVar(vcursor VCursor, res evaleng
 	return true, nil
 }
 
+// endConsistentSnapshot makes the session stop using the consistent
+// snapshots of VTGate when an isolation level of MySQL is set.
+func endConsistentSnapshot(vcursor VCursor, name string) {
+	if name == sysvars.TransactionIsolation.Name || name == sysvars.TxIsolation.Name {
+		vcursor.Session().SetConsistentSnapshot(false)
+	}
+}
+
 var _ SetOp = (*SysVarSetAware)(nil)
 
 //MarshalJSON marshals all the json
@@ -379,6 +389,15 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return vterrors.Wrapf(err, "failed to evaluate value for %s", sysvars.SQLSelectLimit.Name)
 		}
 		vcursor.Session().SetSQLSelectLimit(intValue)
+	case sysvars.MaxExecutionTime.Name:
+		intValue, err := svss.evalAsInt64(env)
+		if err != nil {
+			return vterrors.Wrapf(err, "failed to evaluate value for %s", sysvars.MaxExecutionTime.Name)
+		}
+		if intValue < 0 {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid max_execution_time: %d", intValue)
+		}
+		vcursor.Session().SetQueryTimeout(intValue)
 	case sysvars.TransactionMode.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -408,6 +427,15 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid DDL strategy: %s", str)
 		}
 		vcursor.Session().SetDDLStrategy(str)
+	case sysvars.TransactionIsolation.Name, sysvars.TxIsolation.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(str, sysvars.ConsistentSnapshot) {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid transaction isolation: %s", str)
+		}
+		vcursor.Session().SetConsistentSnapshot(true)
 	case sysvars.SessionEnableSystemSettings.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableSystemSettings)
 	case sysvars.Charset.Name, sysvars.Names.Name:
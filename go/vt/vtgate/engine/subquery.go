@@ -19,6 +19,7 @@ package engine
 import (
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ Primitive = (*Subquery)(nil)
@@ -26,11 +27,19 @@ var _ Primitive = (*Subquery)(nil)
 // Subquery specifies the parameters for a subquery primitive.
 type Subquery struct {
 	// Cols defines the column numbers from the underlying primitive
-	// to be returned.
+	// to be returned. A column number of -1 is a computed column:
+	// its value is the result of the next expression of Exprs.
 	Cols     []int
+	Exprs    []SubqueryExpr
 	Subquery Primitive
 }
 
+// SubqueryExpr is an expression evaluated on the rows of the subquery.
+type SubqueryExpr struct {
+	Name string
+	Expr evalengine.Expr
+}
+
 func (sq *Subquery) NeedsTransaction() bool {
 	return sq.Subquery.NeedsTransaction()
 }
@@ -56,13 +65,23 @@ func (sq *Subquery) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 	if err != nil {
 		return nil, err
 	}
-	return sq.buildResult(inner), nil
+	return sq.buildResult(inner, inner.Fields, bindVars)
 }
 
 // StreamExecute performs a streaming exec.
 func (sq *Subquery) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// The fields are only sent with the first result, but
+	// the computed columns need them for every row.
+	var fields []*querypb.Field
 	return sq.Subquery.StreamExecute(vcursor, bindVars, wantfields, func(inner *sqltypes.Result) error {
-		return callback(sq.buildResult(inner))
+		if len(inner.Fields) != 0 {
+			fields = inner.Fields
+		}
+		result, err := sq.buildResult(inner, fields, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(result)
 	})
 }
 
@@ -72,7 +91,11 @@ func (sq *Subquery) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bind
 	if err != nil {
 		return nil, err
 	}
-	return &sqltypes.Result{Fields: sq.buildFields(inner)}, nil
+	resultFields, err := sq.buildFields(inner, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: resultFields}, nil
 }
 
 // Inputs returns the input to this primitive
@@ -81,36 +104,70 @@ func (sq *Subquery) Inputs() []Primitive {
 }
 
 // buildResult builds a new result by pulling the necessary columns from
-// the subquery in the requested order.
-func (sq *Subquery) buildResult(inner *sqltypes.Result) *sqltypes.Result {
-	result := &sqltypes.Result{Fields: sq.buildFields(inner)}
+// the subquery in the requested order, and by computing the others.
+func (sq *Subquery) buildResult(inner *sqltypes.Result, fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	resultFields, err := sq.buildFields(inner, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: resultFields}
 	result.Rows = make([][]sqltypes.Value, 0, len(inner.Rows))
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
 	for _, innerRow := range inner.Rows {
+		env.Row = innerRow
 		row := make([]sqltypes.Value, 0, len(sq.Cols))
+		exprs := sq.Exprs
 		for _, col := range sq.Cols {
-			row = append(row, innerRow[col])
+			if col >= 0 {
+				row = append(row, innerRow[col])
+				continue
+			}
+			value, err := exprs[0].Expr.Evaluate(env)
+			if err != nil {
+				return nil, err
+			}
+			row = append(row, value.Value())
+			exprs = exprs[1:]
 		}
 		result.Rows = append(result.Rows, row)
 	}
 	result.RowsAffected = inner.RowsAffected
-	return result
+	return result, nil
 }
 
-func (sq *Subquery) buildFields(inner *sqltypes.Result) []*querypb.Field {
+func (sq *Subquery) buildFields(inner *sqltypes.Result, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
 	if len(inner.Fields) == 0 {
-		return nil
+		return nil, nil
 	}
 	fields := make([]*querypb.Field, 0, len(sq.Cols))
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: inner.Fields}
+	exprs := sq.Exprs
 	for _, col := range sq.Cols {
-		fields = append(fields, inner.Fields[col])
+		if col >= 0 {
+			fields = append(fields, inner.Fields[col])
+			continue
+		}
+		typ, err := exprs[0].Expr.Type(env)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, &querypb.Field{Name: exprs[0].Name, Type: typ})
+		exprs = exprs[1:]
 	}
-	return fields
+	return fields, nil
 }
 
 func (sq *Subquery) description() PrimitiveDescription {
 	other := map[string]interface{}{
 		"Columns": sq.Cols,
 	}
+	if len(sq.Exprs) > 0 {
+		var exprs []string
+		for _, expr := range sq.Exprs {
+			exprs = append(exprs, expr.Expr.String())
+		}
+		other["Expressions"] = exprs
+	}
 	return PrimitiveDescription{
 		OperatorType: "Subquery",
 		Other:        other,
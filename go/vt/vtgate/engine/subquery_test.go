@@ -23,6 +23,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 func TestSubqueryExecute(t *testing.T) {
@@ -169,3 +170,44 @@ func TestSubqueryGetFields(t *testing.T) {
 	_, err = sq.GetFields(nil, bv)
 	expectError(t, "sq.Execute", err, "err")
 }
+
+func TestSubqueryComputedColumns(t *testing.T) {
+	prim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varbinary",
+				),
+				"1|a|a",
+				"2|B|b",
+			),
+		},
+	}
+
+	sq := &Subquery{
+		Cols: []int{0, -1, -1},
+		Exprs: []SubqueryExpr{{
+			Name: "col1 + 1",
+			Expr: &evalengine.BinaryOp{Expr: &evalengine.Addition{}, Left: evalengine.NewColumn(0), Right: evalengine.NewLiteralInt(1)},
+		}, {
+			Name: "col2 = col3",
+			Expr: &evalengine.BinaryOp{Expr: &evalengine.Equals{}, Left: evalengine.NewColumn(1), Right: evalengine.NewColumn(2)},
+		}},
+		Subquery: prim,
+	}
+
+	r, err := sq.Execute(nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// col3 is binary, so the comparison is case-sensitive
+	expectResult(t, "sq.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col1 + 1|col2 = col3",
+			"int64|int64|int64",
+		),
+		"1|2|1",
+		"2|3|0",
+	))
+}
@@ -49,9 +49,6 @@ type Update struct {
 
 	// ChangedVindexValues contains values for updated Vindexes during an update statement.
 	ChangedVindexValues map[string]*VindexValues
-
-	// Update does not take inputs
-	noInputs
 }
 
 var updName = map[DMLOpcode]string{
@@ -87,6 +84,19 @@ func (upd *Update) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		defer cancel()
 	}
 
+	if upd.Input != nil && upd.Opcode != Limited {
+		batchBindVars, err := upd.bindInput(vcursor, bindVars)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateWithInput")
+		}
+		return execInputBatches(batchBindVars, func(bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+			return upd.execute(vcursor, bindVars)
+		})
+	}
+	return upd.execute(vcursor, bindVars)
+}
+
+func (upd *Update) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	switch upd.Opcode {
 	case Unsharded:
 		return upd.execUpdateUnsharded(vcursor, bindVars)
@@ -98,6 +108,8 @@ func (upd *Update) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return upd.execUpdateByDestination(vcursor, bindVars, key.DestinationAllShards{})
 	case ByDestination:
 		return upd.execUpdateByDestination(vcursor, bindVars, upd.TargetDestination)
+	case Limited:
+		return upd.execUpdateLimited(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", upd)
@@ -130,11 +142,11 @@ func (upd *Update) execUpdateUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := upd.Values[0].ResolveValue(bindVars)
+	rowsColValues, err := resolveRowsColValues(upd.Values, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execUpdateEqual")
 	}
-	rs, ksid, err := resolveSingleShard(vcursor, upd.Vindex, upd.Keyspace, key)
+	rs, ksid, err := resolveSingleShard(vcursor, upd.Vindex, upd.Keyspace, rowsColValues[0])
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execUpdateEqual")
 	}
@@ -150,11 +162,11 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 			return nil, vterrors.Wrap(err, "execUpdateEqual")
 		}
 	}
-	return execShard(vcursor, upd.Query, bindVars, rs, true /* rollbackOnError */, true /* canAutocommit */)
+	return execShard(vcursor, upd.Query, bindVars, rs, true /* rollbackOnError */, upd.canAutocommit())
 }
 
 func (upd *Update) execUpdateIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	rss, queries, err := resolveMultiValueShards(vcursor, upd.Keyspace, upd.Query, bindVars, upd.Values[0], upd.Vindex)
+	rss, queries, err := resolveMultiValueShards(vcursor, upd.Keyspace, upd.Query, bindVars, upd.Values, upd.Vindex)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +179,7 @@ func (upd *Update) execUpdateIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 			return nil, vterrors.Wrap(err, "execUpdateIn")
 		}
 	}
-	return execMultiShard(vcursor, rss, queries, upd.MultiShardAutocommit)
+	return execMultiShard(vcursor, rss, queries, upd.MultiShardAutocommit, upd.canAutocommit())
 }
 
 func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]*querypb.BindVariable, dest key.Destination) (*sqltypes.Result, error) {
@@ -194,7 +206,35 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 			return nil, vterrors.Wrap(err, "execUpdateByDestination")
 		}
 	}
-	return execMultiShard(vcursor, rss, queries, upd.MultiShardAutocommit)
+	return execMultiShard(vcursor, rss, queries, upd.MultiShardAutocommit, upd.canAutocommit())
+}
+
+func (upd *Update) execUpdateLimited(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, shardBindVars, err := upd.resolveLimitedShards(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateLimited")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	err = allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i, rs := range rss {
+		if len(upd.ChangedVindexValues) != 0 {
+			if err := upd.updateVindexEntries(vcursor, shardBindVars[i], []*srvtopo.ResolvedShard{rs}); err != nil {
+				return nil, vterrors.Wrap(err, "execUpdateLimited")
+			}
+		}
+		queries[i] = &querypb.BoundQuery{
+			Sql:           upd.Query,
+			BindVariables: shardBindVars[i],
+		}
+	}
+	return execMultiShard(vcursor, rss, queries, upd.MultiShardAutocommit, upd.canAutocommit())
 }
 
 // updateVindexEntries performs an update when a vindex is being modified
@@ -225,7 +265,7 @@ func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*que
 	}
 
 	for _, row := range subQueryResult.Rows {
-		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[0])
+		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[:upd.KsidLength])
 		if err != nil {
 			return err
 		}
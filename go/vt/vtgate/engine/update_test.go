@@ -204,6 +204,7 @@ func TestUpdateEqualChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -343,6 +344,7 @@ func TestUpdateScatterChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -484,6 +486,7 @@ func TestUpdateInChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -662,3 +665,34 @@ func buildTestVSchema() *vindexes.VSchema {
 func newDMLTestVCursor(shards ...string) *loggingVCursor {
 	return &loggingVCursor{shards: shards, resolvedTargetTabletType: topodatapb.TabletType_MASTER}
 }
+
+func TestUpdateWithInput(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{DML: DML{
+		Opcode:   In,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_update",
+		Vindex:   ks.Vindexes["hash"].(vindexes.SingleColumn),
+		Values:   []sqltypes.PlanValue{{ListKey: DMLKeyVarName}},
+		Input: &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|id",
+				"int64|int64",
+			),
+			"1|1",
+			"2|2",
+		)}},
+	}}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	bindVars := map[string]*querypb.BindVariable{}
+	_, err := upd.Execute(vc, bindVars, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		// The update can't autocommit: it must share a transaction with the input.
+		`ExecuteMultiShard sharded.-20: dummy_update {__dml_keys: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > __dml_vindex_vals: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > } true false`,
+	})
+	// The caller's bind variables are left untouched.
+	require.Empty(t, bindVars)
+}
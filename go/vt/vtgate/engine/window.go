@@ -0,0 +1,696 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that computes window functions over the rows
+// of the underlying primitive. The rows must be fed sorted by the
+// PartitionBy columns, followed by the OrderBy columns. The assumption
+// is that the underlying primitive is a scatter select with merge-sorted
+// rows. Like for OrderedAggregate, the value of every window function
+// replaces the input column it's computed from. The rows of a partition
+// are buffered until the first row of the next partition is received.
+type Window struct {
+	// PartitionBy specifies the input columns that split
+	// the rows into partitions.
+	PartitionBy []int `json:",omitempty"`
+
+	// OrderBy specifies the ordering of the rows within a partition.
+	// Rows that are equal according to it are peers.
+	OrderBy []OrderbyParams `json:",omitempty"`
+
+	// Functions specifies the window functions to compute.
+	Functions []WindowFuncParams
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowFuncParams specify the parameters of a window function.
+type WindowFuncParams struct {
+	Opcode WindowOpcode
+	// Col is the input column that holds the argument of the function.
+	// Its value is replaced by the result of the function.
+	Col int
+	// N is the number of buckets of NTILE, the row number of NTH_VALUE,
+	// or the offset of LAG and LEAD.
+	N sqltypes.PlanValue
+	// Default is the value returned by LAG and LEAD if there's no row
+	// at the requested offset.
+	Default sqltypes.PlanValue
+	// Frame is the frame of the aggregate functions and
+	// of FIRST_VALUE, LAST_VALUE and NTH_VALUE.
+	Frame WindowFrame
+	// Alias is the name of the result column.
+	Alias string
+}
+
+func (wf WindowFuncParams) String() string {
+	out := fmt.Sprintf("%s(%d)", wf.Opcode.String(), wf.Col)
+	if wf.Opcode.usesFrame() && wf.Frame != DefaultWindowFrame {
+		out += " " + wf.Frame.String()
+	}
+	if wf.Alias != "" {
+		out += " AS " + wf.Alias
+	}
+	return out
+}
+
+// WindowFrame specifies the rows of the partition that a window function
+// is computed over, relative to the current row. For RANGE frames, the
+// current row stands for itself and all its peers, and only the CURRENT ROW
+// and UNBOUNDED bounds are supported. For ROWS frames, Start and End are
+// offsets from the current row; negative values point to preceding rows.
+type WindowFrame struct {
+	Range          bool
+	UnboundedStart bool
+	Start          int64
+	UnboundedEnd   bool
+	End            int64
+}
+
+// DefaultWindowFrame is the frame of a window that doesn't specify one:
+// all the rows from the start of the partition to the last peer of the
+// current row. Without ordering, all the rows are peers.
+var DefaultWindowFrame = WindowFrame{Range: true, UnboundedStart: true}
+
+func (frame WindowFrame) String() string {
+	unit := "rows"
+	if frame.Range {
+		unit = "range"
+	}
+	bound := func(unbounded bool, offset int64, unboundedStr string) string {
+		switch {
+		case unbounded:
+			return unboundedStr
+		case offset < 0:
+			return fmt.Sprintf("%d preceding", -offset)
+		case offset > 0:
+			return fmt.Sprintf("%d following", offset)
+		}
+		return "current row"
+	}
+	return fmt.Sprintf("%s between %s and %s", unit,
+		bound(frame.UnboundedStart, frame.Start, "unbounded preceding"),
+		bound(frame.UnboundedEnd, frame.End, "unbounded following"))
+}
+
+// bounds returns the rows of the partition that are in the frame of
+// the current row, as a half-open interval. The peer bounds of the
+// current row are only used by RANGE frames.
+func (frame WindowFrame) bounds(current, peerStart, peerEnd, count int) (start, end int) {
+	switch {
+	case frame.UnboundedStart:
+		start = 0
+	case frame.Range:
+		start = peerStart
+	default:
+		start = current + int(frame.Start)
+	}
+	switch {
+	case frame.UnboundedEnd:
+		end = count
+	case frame.Range:
+		end = peerEnd
+	default:
+		end = current + int(frame.End) + 1
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end > count {
+		end = count
+	}
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowPercentRank
+	WindowCumeDist
+	WindowNtile
+	WindowLag
+	WindowLead
+	WindowFirstValue
+	WindowLastValue
+	WindowNthValue
+	WindowCount
+	WindowSum
+	WindowAvg
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFuncs maps the list of window functions
+// that can be computed by vtgate to their opcodes.
+var SupportedWindowFuncs = map[string]WindowOpcode{
+	"row_number":   WindowRowNumber,
+	"rank":         WindowRank,
+	"dense_rank":   WindowDenseRank,
+	"percent_rank": WindowPercentRank,
+	"cume_dist":    WindowCumeDist,
+	"ntile":        WindowNtile,
+	"lag":          WindowLag,
+	"lead":         WindowLead,
+	"first_value":  WindowFirstValue,
+	"last_value":   WindowLastValue,
+	"nth_value":    WindowNthValue,
+	"count":        WindowCount,
+	"sum":          WindowSum,
+	"avg":          WindowAvg,
+	"min":          WindowMin,
+	"max":          WindowMax,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFuncs {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// usesFrame returns true if the function is computed over the
+// frame of the current row instead of the whole partition.
+func (code WindowOpcode) usesFrame() bool {
+	switch code {
+	case WindowFirstValue, WindowLastValue, WindowNthValue, WindowCount, WindowSum, WindowAvg, WindowMin, WindowMax:
+		return true
+	}
+	return false
+}
+
+// resultType returns the type of the function result, given the type of its argument.
+func (code WindowOpcode) resultType(argType querypb.Type) querypb.Type {
+	switch code {
+	case WindowRowNumber, WindowRank, WindowDenseRank, WindowNtile, WindowCount:
+		return sqltypes.Int64
+	case WindowPercentRank, WindowCumeDist:
+		return sqltypes.Float64
+	case WindowAvg:
+		// Like evalengine.AverageExpr, the average of exact values is a DECIMAL.
+		if sqltypes.IsIntegral(argType) || argType == sqltypes.Decimal {
+			return sqltypes.Decimal
+		}
+		return sqltypes.Float64
+	case WindowSum:
+		if sqltypes.IsFloat(argType) {
+			return sqltypes.Float64
+		}
+		return sqltypes.Decimal
+	}
+	return argType
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (w *Window) SetTruncateColumnCount(count int) {
+	w.TruncateColumnCount = count
+}
+
+// Execute is a Primitive function.
+func (w *Window) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	args, err := w.resolveArgs(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	result, err := w.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields: w.convertFields(result.Fields),
+		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
+	}
+	// This code is similar to the one in StreamExecute.
+	start := 0
+	for i := 1; i <= len(result.Rows); i++ {
+		if i < len(result.Rows) {
+			equal, err := w.samePartition(result.Rows[start], result.Rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				continue
+			}
+		}
+		if vcursor.ExceedsMaxMemoryRows(i - start) {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		rows, err := w.computePartition(result.Fields, result.Rows[start:i], args)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, rows...)
+		start = i
+	}
+	return out.Truncate(w.TruncateColumnCount), nil
+}
+
+// StreamExecute is a Primitive function.
+func (w *Window) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	args, err := w.resolveArgs(bindVars)
+	if err != nil {
+		return err
+	}
+
+	var fields []*querypb.Field
+	var partition [][]sqltypes.Value
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(w.TruncateColumnCount))
+	}
+	flush := func() error {
+		if len(partition) == 0 {
+			return nil
+		}
+		rows, err := w.computePartition(fields, partition, args)
+		if err != nil {
+			return err
+		}
+		partition = nil
+		return cb(&sqltypes.Result{Rows: rows})
+	}
+
+	err = w.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+			if err := cb(&sqltypes.Result{Fields: w.convertFields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		// This code is similar to the one in Execute.
+		for _, row := range qr.Rows {
+			if len(partition) != 0 {
+				equal, err := w.samePartition(partition[0], row)
+				if err != nil {
+					return err
+				}
+				if !equal {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+			partition = append(partition, row)
+		}
+		if vcursor.ExceedsMaxMemoryRows(len(partition)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: w.convertFields(qr.Fields)}
+	return qr.Truncate(w.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this window.
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	fields = append([]*querypb.Field(nil), fields...)
+	for _, wf := range w.Functions {
+		fields[wf.Col] = &querypb.Field{
+			Name: wf.Alias,
+			Type: wf.Opcode.resultType(fields[wf.Col].Type),
+		}
+	}
+	return fields
+}
+
+// windowArgs holds the arguments of the window functions,
+// resolved against the bind variables of the execution.
+type windowArgs struct {
+	n        []int
+	defaults []sqltypes.Value
+}
+
+func (w *Window) resolveArgs(bindVars map[string]*querypb.BindVariable) (*windowArgs, error) {
+	args := &windowArgs{
+		n:        make([]int, len(w.Functions)),
+		defaults: make([]sqltypes.Value, len(w.Functions)),
+	}
+	for i, wf := range w.Functions {
+		switch wf.Opcode {
+		case WindowNtile, WindowNthValue, WindowLag, WindowLead:
+		default:
+			continue
+		}
+		n, err := wf.N.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if n.IsNull() {
+			// LAG and LEAD default to the adjacent row, while
+			// NTILE and NTH_VALUE need a value.
+			if wf.Opcode != WindowLag && wf.Opcode != WindowLead {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to %s", wf.Opcode.String())
+			}
+			args.n[i] = 1
+		} else {
+			num, err := evalengine.ToInt64(n)
+			if err != nil {
+				return nil, err
+			}
+			args.n[i] = int(num)
+		}
+		if args.n[i] < 0 || (args.n[i] == 0 && wf.Opcode != WindowLag && wf.Opcode != WindowLead) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to %s", wf.Opcode.String())
+		}
+		args.defaults[i], err = wf.Default.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+func (w *Window) samePartition(row1, row2 []sqltypes.Value) (bool, error) {
+	for _, col := range w.PartitionBy {
+		cmp, err := evalengine.NullsafeCompare(row1[col], row2[col])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (w *Window) arePeers(row1, row2 []sqltypes.Value) (bool, error) {
+	for _, order := range w.OrderBy {
+		cmp, err := evalengine.NullsafeCompare(row1[order.Col], row2[order.Col])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// computePartition computes the window functions for all the rows
+// of a partition, and returns new rows that contain the results.
+func (w *Window) computePartition(fields []*querypb.Field, rows [][]sqltypes.Value, args *windowArgs) ([][]sqltypes.Value, error) {
+	count := len(rows)
+
+	// peerStart and peerEnd hold the half-open interval of the peers
+	// of every row. peerGroup numbers the groups of peers.
+	peerStart := make([]int, count)
+	peerEnd := make([]int, count)
+	peerGroup := make([]int, count)
+	for i := 1; i <= count; i++ {
+		if i < count {
+			peers, err := w.arePeers(rows[i-1], rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if peers {
+				peerStart[i] = peerStart[i-1]
+				peerGroup[i] = peerGroup[i-1]
+				continue
+			}
+			peerStart[i] = i
+			peerGroup[i] = peerGroup[i-1] + 1
+		}
+		for j := peerStart[i-1]; j < i; j++ {
+			peerEnd[j] = i
+		}
+	}
+
+	out := make([][]sqltypes.Value, count)
+	for i, row := range rows {
+		out[i] = sqltypes.CopyRow(row)
+	}
+	for f, wf := range w.Functions {
+		var argField *querypb.Field
+		var argType querypb.Type
+		if fields != nil {
+			argField = fields[wf.Col]
+			argType = argField.Type
+		}
+		resultType := wf.Opcode.resultType(argType)
+		n := args.n[f]
+
+		if wf.Opcode.usesFrame() {
+			if err := w.computeFrames(wf, argField, resultType, n, rows, out, peerStart, peerEnd); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for i := range rows {
+			var value sqltypes.Value
+			switch wf.Opcode {
+			case WindowRowNumber:
+				value = sqltypes.NewInt64(int64(i + 1))
+			case WindowRank:
+				value = sqltypes.NewInt64(int64(peerStart[i] + 1))
+			case WindowDenseRank:
+				value = sqltypes.NewInt64(int64(peerGroup[i] + 1))
+			case WindowPercentRank:
+				rank := 0.0
+				if count > 1 {
+					rank = float64(peerStart[i]) / float64(count-1)
+				}
+				value = sqltypes.NewFloat64(rank)
+			case WindowCumeDist:
+				value = sqltypes.NewFloat64(float64(peerEnd[i]) / float64(count))
+			case WindowNtile:
+				value = sqltypes.NewInt64(int64(ntile(i, count, n)))
+			case WindowLag, WindowLead:
+				offset := i - n
+				if wf.Opcode == WindowLead {
+					offset = i + n
+				}
+				if offset >= 0 && offset < count {
+					value = rows[offset][wf.Col]
+				} else {
+					value = args.defaults[f]
+				}
+			default:
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected window function opcode: %v", wf.Opcode)
+			}
+			out[i][wf.Col] = value
+		}
+	}
+	return out, nil
+}
+
+// ntile returns the bucket of the row, when count rows are spread over
+// n buckets. The first count%n buckets get one more row than the others.
+func ntile(row, count, n int) int {
+	size := count / n
+	larger := count % n
+	if row < larger*(size+1) {
+		return row/(size+1) + 1
+	}
+	return larger + (row-larger*(size+1))/size + 1
+}
+
+// computeFrames computes a function that is computed over the frame of
+// every row. If the frame starts at the start of the partition, frames
+// only grow from one row to the next, so the aggregates are computed
+// incrementally. Otherwise, they are recomputed for every row.
+func (w *Window) computeFrames(wf WindowFuncParams, argField *querypb.Field, resultType querypb.Type, n int, rows, out [][]sqltypes.Value, peerStart, peerEnd []int) error {
+	acc := &windowAccumulator{opcode: wf.Opcode, argField: argField, resultType: resultType}
+	next := 0
+	for i := range rows {
+		start, end := wf.Frame.bounds(i, peerStart[i], peerEnd[i], len(rows))
+		switch wf.Opcode {
+		case WindowFirstValue:
+			out[i][wf.Col] = sqltypes.NULL
+			if start < end {
+				out[i][wf.Col] = rows[start][wf.Col]
+			}
+			continue
+		case WindowLastValue:
+			out[i][wf.Col] = sqltypes.NULL
+			if start < end {
+				out[i][wf.Col] = rows[end-1][wf.Col]
+			}
+			continue
+		case WindowNthValue:
+			out[i][wf.Col] = sqltypes.NULL
+			if start+n-1 < end {
+				out[i][wf.Col] = rows[start+n-1][wf.Col]
+			}
+			continue
+		}
+
+		if !wf.Frame.UnboundedStart {
+			acc.reset()
+			next = start
+		}
+		for ; next < end; next++ {
+			if err := acc.add(rows[next][wf.Col]); err != nil {
+				return err
+			}
+		}
+		value, err := acc.result()
+		if err != nil {
+			return err
+		}
+		out[i][wf.Col] = value
+	}
+	return nil
+}
+
+// windowAccumulator computes an aggregate function over the rows of a frame.
+type windowAccumulator struct {
+	opcode WindowOpcode
+	// argField is the field of the argument, if it is known.
+	argField   *querypb.Field
+	resultType querypb.Type
+	count      int64
+	value      sqltypes.Value
+}
+
+func (acc *windowAccumulator) reset() {
+	acc.count = 0
+	acc.value = sqltypes.NULL
+}
+
+func (acc *windowAccumulator) add(v sqltypes.Value) error {
+	if v.IsNull() {
+		return nil
+	}
+	acc.count++
+	var err error
+	switch acc.opcode {
+	case WindowCount:
+	case WindowSum, WindowAvg:
+		acc.value = evalengine.NullsafeAdd(acc.value, v, sqltypes.Decimal)
+	case WindowMin:
+		acc.value, err = evalengine.Min(acc.value, v)
+	case WindowMax:
+		acc.value, err = evalengine.Max(acc.value, v)
+	default:
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected window aggregate opcode: %v", acc.opcode)
+	}
+	return err
+}
+
+func (acc *windowAccumulator) result() (sqltypes.Value, error) {
+	switch acc.opcode {
+	case WindowCount:
+		return sqltypes.NewInt64(acc.count), nil
+	case WindowSum:
+		return evalengine.Cast(acc.value, acc.resultType)
+	case WindowAvg:
+		env := evalengine.ExpressionEnv{Row: []sqltypes.Value{acc.value, sqltypes.NewInt64(acc.count)}}
+		if acc.argField != nil {
+			env.Fields = []*querypb.Field{acc.argField}
+		}
+		avg, err := windowAverage.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return avg.Value(), nil
+	}
+	return acc.value, nil
+}
+
+// windowAverage computes an AVG from the SUM and the COUNT of a frame.
+var windowAverage = &evalengine.AverageExpr{Sum: 0, Count: 1}
+
+func windowFuncParamsToString(in interface{}) string {
+	return in.(WindowFuncParams).String()
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions": GenericJoin(w.Functions, windowFuncParamsToString),
+	}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, intToString)
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, orderByParamsToString)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
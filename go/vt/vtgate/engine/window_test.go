@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestWindowExecuteRanking(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|id|rn|rk|drk|s",
+				"varbinary|int64|int64|int64|int64|int64",
+			),
+			"a|1|1|1|1|1",
+			"a|2|1|1|1|2",
+			"a|2|1|1|1|2",
+			"b|5|1|1|1|5",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []int{0},
+		OrderBy:     []OrderbyParams{{Col: 1}},
+		Functions: []WindowFuncParams{
+			{Opcode: WindowRowNumber, Col: 2, Frame: DefaultWindowFrame, Alias: "rn"},
+			{Opcode: WindowRank, Col: 3, Frame: DefaultWindowFrame, Alias: "rk"},
+			{Opcode: WindowDenseRank, Col: 4, Frame: DefaultWindowFrame, Alias: "drk"},
+			{Opcode: WindowSum, Col: 5, Frame: DefaultWindowFrame, Alias: "s"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.Execute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|id|rn|rk|drk|s",
+			"varbinary|int64|int64|int64|int64|decimal",
+		),
+		"a|1|1|1|1|1",
+		"a|2|2|2|2|5",
+		"a|2|3|2|2|5",
+		"b|5|1|1|1|5",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteOffsetsAndFrames(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|id|lg|ld|nt|fv|a",
+				"varbinary|int64|int64|int64|int64|int64|int64",
+			),
+			"x|1|1|1|1|1|1",
+			"x|2|2|2|2|2|2",
+			"x|3|3|3|3|3|3",
+			"x|4|4|4|4|4|4",
+			"x|5|5|5|5|5|5",
+		)},
+	}
+
+	slidingFrame := WindowFrame{Start: -1, End: 1}
+	w := &Window{
+		OrderBy: []OrderbyParams{{Col: 1}},
+		Functions: []WindowFuncParams{{
+			Opcode:  WindowLag,
+			Col:     2,
+			N:       sqltypes.PlanValue{Value: sqltypes.NewInt64(1)},
+			Default: sqltypes.PlanValue{Value: sqltypes.NewInt64(0)},
+			Alias:   "lg",
+		}, {
+			Opcode: WindowLead,
+			Col:    3,
+			N:      sqltypes.PlanValue{Key: "n"},
+			Alias:  "ld",
+		}, {
+			Opcode: WindowNtile,
+			Col:    4,
+			N:      sqltypes.PlanValue{Value: sqltypes.NewInt64(2)},
+			Alias:  "nt",
+		}, {
+			Opcode: WindowFirstValue,
+			Col:    5,
+			Frame:  slidingFrame,
+			Alias:  "fv",
+		}, {
+			Opcode: WindowAvg,
+			Col:    6,
+			Frame:  slidingFrame,
+			Alias:  "a",
+		}},
+		Input: fp,
+	}
+
+	bv := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(2)}
+	result, err := w.Execute(&noopVCursor{}, bv, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|id|lg|ld|nt|fv|a",
+			"varbinary|int64|int64|int64|int64|int64|decimal",
+		),
+		"x|1|0|3|1|1|1.5000",
+		"x|2|1|4|1|1|2.0000",
+		"x|3|2|5|1|2|3.0000",
+		"x|4|3|null|2|3|4.0000",
+		"x|5|4|null|2|4|4.5000",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteAvg(t *testing.T) {
+	input := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|d|f",
+			"int64|decimal|float64",
+		),
+		"1|1.25|1.5",
+		"2|2.50|2",
+		"3|null|null",
+	)
+	input.Fields[1].Decimals = 2
+	fp := &fakePrimitive{results: []*sqltypes.Result{input}}
+
+	w := &Window{
+		OrderBy: []OrderbyParams{{Col: 0}},
+		Functions: []WindowFuncParams{{
+			Opcode: WindowAvg,
+			Col:    1,
+			Frame:  WindowFrame{UnboundedStart: true},
+			Alias:  "d",
+		}, {
+			Opcode: WindowAvg,
+			Col:    2,
+			Frame:  WindowFrame{UnboundedStart: true},
+			Alias:  "f",
+		}},
+		Input: fp,
+	}
+
+	result, err := w.Execute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	// The average of a DECIMAL has 4 more digits after the
+	// decimal point, the one of a DOUBLE is a DOUBLE.
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|d|f",
+			"int64|decimal|float64",
+		),
+		"1|1.250000|1.5",
+		"2|1.875000|1.75",
+		"3|1.875000|1.75",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowStreamExecuteTruncate(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|pr|cd|weight_string(id)",
+				"varbinary|int64|int64|varbinary",
+			),
+			"a|0|0|1",
+			"a|0|0|2",
+			"a|0|0|2",
+			"b|0|0|1",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []int{0},
+		OrderBy:     []OrderbyParams{{Col: 3}},
+		Functions: []WindowFuncParams{
+			{Opcode: WindowPercentRank, Col: 1, Frame: DefaultWindowFrame, Alias: "pr"},
+			{Opcode: WindowCumeDist, Col: 2, Frame: DefaultWindowFrame, Alias: "cd"},
+		},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+
+	result, err := wrapStreamExecute(w, &noopVCursor{}, nil, true)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|pr|cd",
+			"varbinary|float64|float64",
+		),
+		"a|0|0.3333333333333333",
+		"a|0.5|1",
+		"a|0.5|1",
+		"b|0|1",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowGetFields(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|x|weight_string(col)",
+				"varchar|varchar|varbinary",
+			),
+		)},
+	}
+
+	w := &Window{
+		PartitionBy:         []int{2},
+		Functions:           []WindowFuncParams{{Opcode: WindowCount, Col: 1, Frame: DefaultWindowFrame, Alias: "count(x) over (partition by col)"}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	got, err := w.GetFields(nil, nil)
+	require.NoError(t, err)
+	want := &sqltypes.Result{Fields: []*querypb.Field{
+		{Name: "col", Type: sqltypes.VarChar},
+		{Name: "count(x) over (partition by col)", Type: sqltypes.Int64},
+	}}
+	assert.Equal(t, want, got)
+}
+
+func TestWindowNtileError(t *testing.T) {
+	w := &Window{
+		Functions: []WindowFuncParams{{Opcode: WindowNtile, N: sqltypes.PlanValue{Value: sqltypes.NewInt64(0)}}},
+		Input:     &fakePrimitive{},
+	}
+
+	_, err := w.Execute(&noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "Incorrect arguments to ntile")
+}
+
+func TestWindowNullArgs(t *testing.T) {
+	null := sqltypes.PlanValue{Value: sqltypes.NULL}
+	tcases := []struct {
+		opcode WindowOpcode
+		err    string
+	}{
+		{opcode: WindowNtile, err: "Incorrect arguments to ntile"},
+		{opcode: WindowNthValue, err: "Incorrect arguments to nth_value"},
+		{opcode: WindowLag},
+		{opcode: WindowLead},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.opcode.String(), func(t *testing.T) {
+			w := &Window{
+				Functions: []WindowFuncParams{{Opcode: tcase.opcode, N: null}},
+				Input:     &fakePrimitive{results: []*sqltypes.Result{{}}},
+			}
+			_, err := w.Execute(&noopVCursor{}, nil, false)
+			if tcase.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
+func TestWindowMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("col|rn", "varbinary|int64"),
+			"a|1",
+			"a|1",
+			"b|1",
+			"b|1",
+			"b|1",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{0},
+		Functions:   []WindowFuncParams{{Opcode: WindowRowNumber, Col: 1, Frame: DefaultWindowFrame, Alias: "rn"}},
+		Input:       fp,
+	}
+
+	// The first partition fits, but the second one doesn't.
+	_, err := w.Execute(&noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestWindowFuncParamsString(t *testing.T) {
+	params := []WindowFuncParams{
+		{Opcode: WindowRowNumber, Col: 1, Frame: DefaultWindowFrame},
+		{Opcode: WindowSum, Col: 2, Frame: DefaultWindowFrame, Alias: "s"},
+		{Opcode: WindowSum, Col: 3, Frame: WindowFrame{Start: -2, UnboundedEnd: true}},
+		{Opcode: WindowMax, Col: 4, Frame: WindowFrame{Range: true, UnboundedEnd: true}},
+	}
+	want := []string{
+		"row_number(1)",
+		"sum(2) AS s",
+		"sum(3) rows between 2 preceding and unbounded following",
+		"max(4) range between current row and unbounded following",
+	}
+	for i, param := range params {
+		assert.Equal(t, want[i], param.String())
+	}
+}
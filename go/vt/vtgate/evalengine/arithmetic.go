@@ -223,6 +223,20 @@ func NullsafeHashcode(v sqltypes.Value) (int64, error) {
 	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "types does not support hashcode yet: %v", v.Type())
 }
 
+// NumericHashcode returns an int64 hashcode that is the same for two values
+// that are equal when they are compared as numbers, which they are when one
+// of them is a number: 1, 1.0 and '1' have the same hashcode.
+func NumericHashcode(v sqltypes.Value) (int64, error) {
+	if v.IsNull() {
+		return math.MaxInt64, nil
+	}
+	result, err := newEvalResult(v)
+	if err != nil {
+		return 0, err
+	}
+	return hashCode(EvalResult{typ: sqltypes.Float64, fval: result.toFloat()}), nil
+}
+
 // isByteComparable returns true if the type is binary or date/time.
 func isByteComparable(v sqltypes.Value) bool {
 	if v.IsBinary() {
@@ -271,6 +285,11 @@ func minmax(v1, v2 sqltypes.Value, min bool) (sqltypes.Value, error) {
 }
 
 func addNumeric(v1, v2 EvalResult) EvalResult {
+	if d1, d2, ok := decimalOperands(v1, v2); ok {
+		if result, err := addDecimal(d1, d2, v1, v2); err == nil {
+			return result
+		}
+	}
 	v1, v2 = makeNumericAndprioritize(v1, v2)
 	switch v1.typ {
 	case sqltypes.Int64:
@@ -289,6 +308,9 @@ func addNumeric(v1, v2 EvalResult) EvalResult {
 }
 
 func addNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
+	if d1, d2, ok := decimalOperands(v1, v2); ok {
+		return addDecimal(d1, d2, v1, v2)
+	}
 	v1, v2 = makeNumericAndprioritize(v1, v2)
 	switch v1.typ {
 	case sqltypes.Int64:
@@ -308,6 +330,9 @@ func addNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 }
 
 func subtractNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
+	if d1, d2, ok := decimalOperands(i1, i2); ok {
+		return subtractDecimal(d1, d2, i1, i2)
+	}
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
 	switch v1.typ {
@@ -336,6 +361,9 @@ func subtractNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 }
 
 func multiplyNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
+	if d1, d2, ok := decimalOperands(v1, v2); ok {
+		return multiplyDecimal(d1, d2, v1, v2)
+	}
 	v1, v2 = makeNumericAndprioritize(v1, v2)
 	switch v1.typ {
 	case sqltypes.Int64:
@@ -354,7 +382,12 @@ func multiplyNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 
 }
 
+// divideNumericWithError divides two numbers. Like in MySQL, the quotient
+// of integers and DECIMALs is a DECIMAL, and the one of doubles is a double.
 func divideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
+	if d1, d2, ok := exactOperands(i1, i2); ok {
+		return divideDecimal(d1, d2, i1, i2)
+	}
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
 	switch v1.typ {
@@ -389,6 +422,9 @@ func makeNumericAndprioritize(i1, i2 EvalResult) (EvalResult, EvalResult) {
 }
 
 func makeNumeric(v EvalResult) EvalResult {
+	if v.typ == sqltypes.Decimal {
+		return v.toNumeric()
+	}
 	if sqltypes.IsNumber(v.typ) {
 		return v
 	}
@@ -569,3 +605,83 @@ func anyMinusFloat(v1 EvalResult, v2 float64) EvalResult {
 	}
 	return EvalResult{typ: sqltypes.Float64, fval: v1.fval - v2}
 }
+
+func integerDivideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
+	if d1, d2, ok := decimalOperands(i1, i2); ok {
+		return integerDivideDecimal(d1, d2, i1, i2)
+	}
+	v1 := i1.toNumeric()
+	v2 := i2.toNumeric()
+	switch {
+	case v1.typ == sqltypes.Int64 && v2.typ == sqltypes.Int64:
+		if v1.ival == math.MinInt64 && v2.ival == -1 {
+			break
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: v1.ival / v2.ival}, nil
+	case v1.typ == sqltypes.Uint64 && v2.typ == sqltypes.Uint64:
+		return EvalResult{typ: sqltypes.Uint64, uval: v1.uval / v2.uval}, nil
+	default:
+		result := math.Trunc(v1.toFloat() / v2.toFloat())
+		if result >= math.MinInt64 && result <= math.MaxInt64 {
+			return EvalResult{typ: sqltypes.Int64, ival: int64(result)}, nil
+		}
+	}
+	return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "BIGINT value is out of range in %s DIV %s", i1.Value().String(), i2.Value().String())
+}
+
+// moduloNumeric computes the remainder of the division.
+// Like in MySQL, the result has the sign of the dividend.
+func moduloNumeric(i1, i2 EvalResult) EvalResult {
+	if d1, d2, ok := decimalOperands(i1, i2); ok {
+		if result, err := moduloDecimal(d1, d2, i1, i2); err == nil {
+			return result
+		}
+	}
+	v1 := i1.toNumeric()
+	v2 := i2.toNumeric()
+	switch {
+	case v1.typ == sqltypes.Int64 && v2.typ == sqltypes.Int64:
+		if v2.ival == -1 {
+			return EvalResult{typ: sqltypes.Int64}
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: v1.ival % v2.ival}
+	case v1.typ == sqltypes.Uint64 && v2.typ == sqltypes.Uint64:
+		return EvalResult{typ: sqltypes.Uint64, uval: v1.uval % v2.uval}
+	case v1.typ == sqltypes.Uint64 && v2.typ == sqltypes.Int64:
+		return EvalResult{typ: sqltypes.Uint64, uval: v1.uval % absInt64(v2.ival)}
+	case v1.typ == sqltypes.Int64 && v2.typ == sqltypes.Uint64:
+		if v1.ival < 0 {
+			return EvalResult{typ: sqltypes.Int64, ival: -int64(absInt64(v1.ival) % v2.uval)}
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: int64(uint64(v1.ival) % v2.uval)}
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: math.Mod(v1.toFloat(), v2.toFloat())}
+}
+
+// negateNumeric implements the unary minus operator.
+func negateNumeric(i EvalResult) (EvalResult, error) {
+	if d, ok := i.toDecimal(); ok && i.isDecimal() {
+		return negateDecimal(d), nil
+	}
+	v := i.toNumeric()
+	switch v.typ {
+	case sqltypes.Int64:
+		if v.ival == math.MinInt64 {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "BIGINT value is out of range in -(%d)", v.ival)
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: -v.ival}, nil
+	case sqltypes.Uint64:
+		if v.uval > math.MaxInt64+1 {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "BIGINT value is out of range in -(%d)", v.uval)
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: -int64(v.uval)}, nil
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: -v.fval}, nil
+}
+
+func absInt64(i int64) uint64 {
+	if i < 0 {
+		return uint64(-i)
+	}
+	return uint64(i)
+}
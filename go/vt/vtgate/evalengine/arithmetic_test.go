@@ -292,7 +292,7 @@ func TestArithmetics(t *testing.T) {
 			// case with negative value
 			v1:  NewInt64(-1),
 			v2:  NewInt64(-2),
-			out: NewFloat64(0.5000),
+			out: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0.5000")),
 		}, {
 			// float64 division by zero
 			v1:  NewFloat64(2),
@@ -302,12 +302,12 @@ func TestArithmetics(t *testing.T) {
 			// Lower bound for int64
 			v1:  NewInt64(math.MinInt64),
 			v2:  NewInt64(1),
-			out: NewFloat64(math.MinInt64),
+			out: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-9223372036854775808.0000")),
 		}, {
 			// upper bound for uint64
 			v1:  NewUint64(math.MaxUint64),
 			v2:  NewUint64(1),
-			out: NewFloat64(math.MaxUint64),
+			out: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("18446744073709551615.0000")),
 		}, {
 			// testing for error in types
 			v1:  TestValue(querypb.Type_INT64, "1.2"),
@@ -322,12 +322,12 @@ func TestArithmetics(t *testing.T) {
 			// testing for uint/int
 			v1:  NewUint64(4),
 			v2:  NewInt64(5),
-			out: NewFloat64(0.8),
+			out: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0.8000")),
 		}, {
 			// testing for uint/uint
 			v1:  NewUint64(1),
 			v2:  NewUint64(2),
-			out: NewFloat64(0.5),
+			out: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0.5000")),
 		}, {
 			// testing for float64/int64
 			v1:  TestValue(querypb.Type_FLOAT64, "1.2"),
@@ -1340,6 +1340,18 @@ func TestHashCodes(t *testing.T) {
 	num := TestValue(querypb.Type_INT64, "123")
 	_, err = NullsafeHashcode(num)
 	require.NoError(t, err)
+
+	h1, err = NumericHashcode(num)
+	require.NoError(t, err)
+	for _, v := range []sqltypes.Value{
+		TestValue(querypb.Type_VARCHAR, "123"),
+		TestValue(querypb.Type_FLOAT64, "123.0"),
+		TestValue(querypb.Type_DECIMAL, "123.00"),
+	} {
+		h2, err = NumericHashcode(v)
+		require.NoError(t, err)
+		assert.Equal(t, h1, h2, "%v", v)
+	}
 }
 
 func printValue(v sqltypes.Value) string {
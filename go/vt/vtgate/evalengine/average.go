@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// divPrecisionIncrement is the number of digits that MySQL adds to the
+// scale of a DECIMAL when it is divided, by default.
+const divPrecisionIncrement = 4
+
+// AverageExpr computes an AVG from the columns that hold the SUM and the
+// COUNT of its values. Like in MySQL, the average of exact values is a
+// DECIMAL, which is computed exactly, while the one of approximate values
+// is a DOUBLE.
+type AverageExpr struct {
+	Sum, Count int
+}
+
+var _ Expr = (*AverageExpr)(nil)
+
+// Evaluate implements the Expr interface
+func (a *AverageExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	sum, count := env.Row[a.Sum], env.Row[a.Count]
+	if sum.IsNull() || count.IsNull() {
+		return resultNull, nil
+	}
+	n, err := ToInt64(count)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if n == 0 {
+		return resultNull, nil
+	}
+	typ, err := a.Type(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if typ == sqltypes.Float64 {
+		fval, err := ToFloat64(sum)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		return EvalResult{typ: sqltypes.Float64, fval: fval / float64(n)}, nil
+	}
+
+	rat, ok := new(big.Rat).SetString(sum.ToString())
+	if !ok {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid DECIMAL value: %s", sum.ToString())
+	}
+	rat.Quo(rat, new(big.Rat).SetInt64(n))
+	str := rat.FloatString(a.scale(env, sum) + divPrecisionIncrement)
+	fval, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+	return EvalResult{typ: sqltypes.Decimal, fval: fval, bytes: []byte(str)}, nil
+}
+
+// scale returns the number of digits after the decimal point of the sum,
+// from its field if it is known.
+func (a *AverageExpr) scale(env ExpressionEnv, sum sqltypes.Value) int {
+	if a.Sum < len(env.Fields) && env.Fields[a.Sum].Type == sqltypes.Decimal {
+		return int(env.Fields[a.Sum].Decimals)
+	}
+	str := sum.ToString()
+	if dot := strings.IndexByte(str, '.'); dot >= 0 {
+		return len(str) - dot - 1
+	}
+	return 0
+}
+
+// Type implements the Expr interface
+func (a *AverageExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	if a.Sum < len(env.Fields) {
+		if typ := env.Fields[a.Sum].Type; !sqltypes.IsIntegral(typ) && typ != sqltypes.Decimal {
+			return sqltypes.Float64, nil
+		}
+		return sqltypes.Decimal, nil
+	}
+	if a.Sum < len(env.Row) && sqltypes.IsFloat(env.Row[a.Sum].Type()) {
+		return sqltypes.Float64, nil
+	}
+	return sqltypes.Decimal, nil
+}
+
+// String implements the Expr interface
+func (a *AverageExpr) String() string {
+	return fmt.Sprintf("avg(column %d from the input / column %d from the input)", a.Sum, a.Count)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestAverageExpr(t *testing.T) {
+	tcases := []struct {
+		sum, count sqltypes.Value
+		field      *querypb.Field
+		want       sqltypes.Value
+	}{{
+		// the average of integers has 4 digits after the decimal point
+		sum:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("3")),
+		count: sqltypes.NewInt64(2),
+		field: &querypb.Field{Type: sqltypes.Decimal},
+		want:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5000")),
+	}, {
+		sum:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("10.00")),
+		count: sqltypes.NewInt64(3),
+		field: &querypb.Field{Type: sqltypes.Decimal, Decimals: 2},
+		want:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("3.333333")),
+	}, {
+		// without fields, the scale is the one of the sum
+		sum:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-2.5")),
+		count: sqltypes.NewInt64(3),
+		want:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-0.83333")),
+	}, {
+		// exact values are not rounded like doubles
+		sum:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("12345678901234567890")),
+		count: sqltypes.NewInt64(1),
+		field: &querypb.Field{Type: sqltypes.Decimal},
+		want:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("12345678901234567890.0000")),
+	}, {
+		sum:   sqltypes.NewFloat64(3),
+		count: sqltypes.NewInt64(2),
+		field: &querypb.Field{Type: sqltypes.Float64},
+		want:  sqltypes.NewFloat64(1.5),
+	}, {
+		sum:   sqltypes.NULL,
+		count: sqltypes.NewInt64(0),
+		field: &querypb.Field{Type: sqltypes.Decimal},
+		want:  sqltypes.NULL,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.sum.String(), func(t *testing.T) {
+			env := ExpressionEnv{Row: []sqltypes.Value{tcase.sum, tcase.count}}
+			if tcase.field != nil {
+				env.Fields = []*querypb.Field{tcase.field, {Type: sqltypes.Int64}}
+			}
+			avg := &AverageExpr{Sum: 0, Count: 1}
+			result, err := avg.Evaluate(env)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, result.Value())
+			typ, err := avg.Type(env)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want.Type() == sqltypes.Float64, typ == sqltypes.Float64)
+		})
+	}
+}
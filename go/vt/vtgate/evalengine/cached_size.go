@@ -21,6 +21,16 @@ type cachedObject interface {
 	CachedSize(alloc bool) int64
 }
 
+func (cached *AverageExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	return size
+}
 func (cached *BinaryOp) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -55,6 +65,68 @@ func (cached *BindVariable) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.Key))
 	return size
 }
+func (cached *CallExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field Arguments []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += int64(cap(cached.Arguments)) * int64(16)
+		for _, elem := range cached.Arguments {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field F *vitess.io/vitess/go/vt/vtgate/evalengine.builtin
+	size += cached.F.CachedSize(true)
+	return size
+}
+func (cached *CaseExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Base vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Base.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Whens []vitess.io/vitess/go/vt/vtgate/evalengine.When
+	{
+		size += int64(cap(cached.Whens)) * int64(32)
+		for _, elem := range cached.Whens {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Else.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *CollateExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Column) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -65,19 +137,108 @@ func (cached *Column) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ConvertExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Expr vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Target string
+	size += int64(len(cached.Target))
+	return size
+}
+func (cached *DateArithmetic) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Date vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Date.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Interval vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Interval.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Unit vitess.io/vitess/go/vt/vtgate/evalengine.IntervalUnit
+	size += cached.Unit.CachedSize(false)
+	return size
+}
 func (cached *EvalResult) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(56)
+		size += int64(64)
 	}
 	// field bytes []byte
 	size += int64(cap(cached.bytes))
 	return size
 }
-func (cached *Literal) CachedSize(alloc bool) int64 {
+func (cached *InExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Right []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += int64(cap(cached.Right)) * int64(16)
+		for _, elem := range cached.Right {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	return size
+}
+func (cached *IntervalUnit) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Name string
+	size += int64(len(cached.Name))
+	// field parts []vitess.io/vitess/go/vt/vtgate/evalengine.intervalPart
+	{
+		size += int64(cap(cached.parts))
+	}
+	return size
+}
+func (cached *IsExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *LikeExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
@@ -85,7 +246,85 @@ func (cached *Literal) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(56)
 	}
+	// field Left vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Left.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Pattern vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Pattern.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Escape vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Escape.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *Literal) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
 	// field Val vitess.io/vitess/go/vt/vtgate/evalengine.EvalResult
 	size += cached.Val.CachedSize(false)
 	return size
 }
+func (cached *NegateExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Expr vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *NotExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field Expr vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *When) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field Cond vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Cond.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Val vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Val.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *builtin) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	return size
+}
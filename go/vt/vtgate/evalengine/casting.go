@@ -17,13 +17,23 @@ limitations under the License.
 package evalengine
 
 import (
+	"bytes"
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
+//ToBoolean returns the truth value of the result, like a WHERE clause uses it:
+//NULL and zero are false, everything else is true
+func (e *EvalResult) ToBoolean() bool {
+	b, _ := e.truthValue()
+	return b
+}
+
 //ToBooleanStrict is used when the casting to a boolean has to be minimally forgiving,
 //such as when assigning to a system variable that is expected to be a boolean
 func (e *EvalResult) ToBooleanStrict() (bool, error) {
@@ -56,3 +66,122 @@ func (e *EvalResult) ToBooleanStrict() (bool, error) {
 	}
 	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "is not a boolean")
 }
+
+type (
+	// ConvertExpr is a CAST(expr AS type) or CONVERT(expr, type) expression
+	ConvertExpr struct {
+		Expr Expr
+		// Target is one of SIGNED, UNSIGNED, CHAR, BINARY, DATE, DATETIME and DOUBLE
+		Target string
+		// Length is the length of a CHAR or BINARY type, or -1 if it was not given
+		Length int
+	}
+
+	// CollateExpr is an expression with a COLLATE clause
+	CollateExpr struct {
+		Expr      Expr
+		Collation Collation
+	}
+)
+
+var _ Expr = (*ConvertExpr)(nil)
+var _ Expr = (*CollateExpr)(nil)
+
+var convertTypes = map[string]querypb.Type{
+	"SIGNED":   sqltypes.Int64,
+	"UNSIGNED": sqltypes.Uint64,
+	"CHAR":     sqltypes.VarChar,
+	"BINARY":   sqltypes.VarBinary,
+	"DATE":     sqltypes.Date,
+	"DATETIME": sqltypes.Datetime,
+	"DOUBLE":   sqltypes.Float64,
+}
+
+// NewConvertExpr returns an expression that converts expr to the given type.
+func NewConvertExpr(expr Expr, typ string, length int) (*ConvertExpr, error) {
+	typ = strings.ToUpper(typ)
+	if typ == "SIGNED INTEGER" || typ == "UNSIGNED INTEGER" {
+		typ = strings.TrimSuffix(typ, " INTEGER")
+	}
+	if _, ok := convertTypes[typ]; !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: conversion to %s", typ)
+	}
+	return &ConvertExpr{Expr: expr, Target: typ, Length: length}, nil
+}
+
+//Evaluate implements the Expr interface
+func (c *ConvertExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := c.Expr.Evaluate(env)
+	if err != nil || val.isNull() {
+		return resultNull, err
+	}
+	switch c.Target {
+	case "SIGNED":
+		return EvalResult{typ: sqltypes.Int64, ival: val.toInt64()}, nil
+	case "UNSIGNED":
+		if num := val.toNumeric(); num.typ == sqltypes.Uint64 {
+			return num, nil
+		}
+		return EvalResult{typ: sqltypes.Uint64, uval: uint64(val.toInt64())}, nil
+	case "DOUBLE":
+		return EvalResult{typ: sqltypes.Float64, fval: val.toFloat()}, nil
+	case "CHAR":
+		chars := runes(newResultString(val.toRawBytes(), CollationGeneralCI))
+		if c.Length >= 0 && c.Length < len(chars) {
+			chars = chars[:c.Length]
+		}
+		return newResultString(bytes.Join(chars, nil), CollationGeneralCI), nil
+	case "BINARY":
+		str := val.toRawBytes()
+		if c.Length >= 0 {
+			// BINARY(N) pads the value with 0x00 bytes
+			padded := make([]byte, c.Length)
+			copy(padded, str)
+			str = padded
+		}
+		return newResultString(str, CollationBinary), nil
+	}
+
+	t, _, ok := parseTemporal(val)
+	if !ok {
+		return resultNull, nil
+	}
+	if c.Target == "DATE" {
+		return EvalResult{typ: sqltypes.Date, bytes: formatDate(t)}, nil
+	}
+	return EvalResult{typ: sqltypes.Datetime, bytes: formatDatetime(t)}, nil
+}
+
+//Type implements the Expr interface
+func (c *ConvertExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return convertTypes[c.Target], nil
+}
+
+//String implements the Expr interface
+func (c *ConvertExpr) String() string {
+	typ := c.Target
+	if c.Length >= 0 {
+		typ += "(" + strconv.Itoa(c.Length) + ")"
+	}
+	return "convert(" + c.Expr.String() + ", " + typ + ")"
+}
+
+//Evaluate implements the Expr interface
+func (c *CollateExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := c.Expr.Evaluate(env)
+	if err != nil || !val.isString() {
+		return val, err
+	}
+	val.collation = c.Collation
+	return val, nil
+}
+
+//Type implements the Expr interface
+func (c *CollateExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	return c.Expr.Type(env)
+}
+
+//String implements the Expr interface
+func (c *CollateExpr) String() string {
+	return c.Expr.String() + " collate " + c.Collation.String()
+}
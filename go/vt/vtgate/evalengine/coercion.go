@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// This file contains the conversions MySQL applies implicitly
+// when a value is used in a context that expects another type.
+
+var resultNull = EvalResult{typ: sqltypes.Null}
+
+func newResultBool(b bool) EvalResult {
+	if b {
+		return EvalResult{typ: sqltypes.Int64, ival: 1}
+	}
+	return EvalResult{typ: sqltypes.Int64}
+}
+
+// newResultString returns a string in the given collation.
+// Binary strings are VARBINARY, all the others are VARCHAR.
+func newResultString(b []byte, collation Collation) EvalResult {
+	typ := sqltypes.VarChar
+	if collation == CollationBinary {
+		typ = sqltypes.VarBinary
+	}
+	return EvalResult{typ: typ, bytes: b, collation: collation}
+}
+
+func (e EvalResult) isNull() bool {
+	return e.typ == sqltypes.Null
+}
+
+func (e EvalResult) isString() bool {
+	return sqltypes.IsText(e.typ) || sqltypes.IsBinary(e.typ)
+}
+
+func (e EvalResult) isTemporal() bool {
+	switch e.typ {
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time:
+		return true
+	}
+	return false
+}
+
+// isIntegral returns true for the values that are compared as integers.
+func (e EvalResult) isIntegral() bool {
+	return sqltypes.IsIntegral(e.typ)
+}
+
+// toNumeric converts the value to an Int64, Uint64 or Float64 result.
+// Strings are converted by parsing their longest numeric prefix,
+// the way MySQL does: '12abc' is 12, and 'abc' is 0.
+// Temporal values are converted to numbers such as 20210102103000.
+func (e EvalResult) toNumeric() EvalResult {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return EvalResult{typ: sqltypes.Int64, ival: e.ival}
+	case sqltypes.IsUnsigned(e.typ):
+		return EvalResult{typ: sqltypes.Uint64, uval: e.uval}
+	case sqltypes.IsFloat(e.typ) || e.typ == sqltypes.Decimal:
+		return EvalResult{typ: sqltypes.Float64, fval: e.fval}
+	case e.isTemporal():
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' || r == '.' {
+				return r
+			}
+			return -1
+		}, string(e.bytes))
+		fval, _ := strconv.ParseFloat(digits, 64)
+		return EvalResult{typ: sqltypes.Float64, fval: fval}
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: parseFloatPrefix(string(e.bytes))}
+}
+
+// toFloat returns the value as a float64.
+func (e EvalResult) toFloat() float64 {
+	num := e.toNumeric()
+	switch num.typ {
+	case sqltypes.Int64:
+		return float64(num.ival)
+	case sqltypes.Uint64:
+		return float64(num.uval)
+	}
+	return num.fval
+}
+
+// toInt64 returns the value as an int64. Floats are rounded, strings
+// are truncated to their integer prefix, like MySQL does when casting.
+func (e EvalResult) toInt64() int64 {
+	if e.isString() {
+		str := numericPrefix(string(e.bytes))
+		if dot := strings.IndexAny(str, ".eE"); dot >= 0 {
+			str = str[:dot]
+		}
+		ival, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			if strings.HasPrefix(str, "-") {
+				return math.MinInt64
+			}
+			return math.MaxInt64
+		}
+		return ival
+	}
+	num := e.toNumeric()
+	switch num.typ {
+	case sqltypes.Int64:
+		return num.ival
+	case sqltypes.Uint64:
+		return int64(num.uval)
+	}
+	return int64(math.Round(num.fval))
+}
+
+// truthValue evaluates the value as a boolean condition.
+// The second return value is false if the value is NULL.
+func (e EvalResult) truthValue() (bool, bool) {
+	if e.isNull() {
+		return false, false
+	}
+	return e.toFloat() != 0, true
+}
+
+// toRawBytes returns the string representation of the value.
+func (e EvalResult) toRawBytes() []byte {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return strconv.AppendInt(nil, e.ival, 10)
+	case sqltypes.IsUnsigned(e.typ):
+		return strconv.AppendUint(nil, e.uval, 10)
+	case e.typ == sqltypes.Decimal && e.bytes != nil:
+		return e.bytes
+	case sqltypes.IsFloat(e.typ) || e.typ == sqltypes.Decimal:
+		return formatFloat(e.fval)
+	}
+	return e.bytes
+}
+
+// formatFloat formats a float the way MySQL converts doubles to strings:
+// with the shortest representation, using an exponent only for very
+// large or very small numbers.
+func formatFloat(f float64) []byte {
+	abs := math.Abs(f)
+	if abs == 0 || (abs >= 1e-4 && abs < 1e15) {
+		return strconv.AppendFloat(nil, f, 'f', -1, 64)
+	}
+	str := strconv.FormatFloat(f, 'e', -1, 64)
+	return []byte(strings.Replace(str, "e+", "e", 1))
+}
+
+func isZero(e EvalResult) bool {
+	return !e.isNull() && e.toFloat() == 0
+}
+
+// numericPrefix returns the longest prefix of the string that is a number.
+func numericPrefix(str string) string {
+	str = strings.TrimLeft(str, " \t\n\r")
+	i := 0
+	if i < len(str) && (str[i] == '-' || str[i] == '+') {
+		i++
+	}
+	digits := func() int {
+		start := i
+		for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+			i++
+		}
+		return i - start
+	}
+	mantissa := digits()
+	if i < len(str) && str[i] == '.' {
+		i++
+		mantissa += digits()
+	}
+	if mantissa == 0 {
+		return ""
+	}
+	if i < len(str) && (str[i] == 'e' || str[i] == 'E') {
+		end := i
+		i++
+		if i < len(str) && (str[i] == '-' || str[i] == '+') {
+			i++
+		}
+		if digits() == 0 {
+			i = end
+		}
+	}
+	return str[:i]
+}
+
+func parseFloatPrefix(str string) float64 {
+	fval, _ := strconv.ParseFloat(numericPrefix(str), 64)
+	return fval
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Collation determines how two strings are compared.
+// Only a handful of collations are supported. Every case-insensitive
+// collation is evaluated like utf8mb4_general_ci.
+type Collation int8
+
+const (
+	// CollationGeneralCI compares strings case-insensitively, ignoring trailing spaces.
+	// It is the collation of string literals and bind variables.
+	CollationGeneralCI Collation = iota
+	// CollationBin compares the characters of the strings, ignoring trailing spaces.
+	CollationBin
+	// CollationBinary compares the raw bytes of the strings.
+	CollationBinary
+)
+
+var collationNames = map[string]Collation{
+	"utf8_general_ci":    CollationGeneralCI,
+	"utf8mb4_general_ci": CollationGeneralCI,
+	"utf8_bin":           CollationBin,
+	"utf8mb4_bin":        CollationBin,
+	"binary":             CollationBinary,
+}
+
+// collationIDs maps the collation ids sent by MySQL in the field metadata
+// to the collations they are evaluated with. Unknown ids are case-insensitive.
+var collationIDs = map[uint32]Collation{
+	46:  CollationBin, // utf8mb4_bin
+	47:  CollationBin, // latin1_bin
+	63:  CollationBinary,
+	83:  CollationBin, // utf8_bin
+	309: CollationBin, // utf8mb4_0900_bin
+}
+
+// CollationFromName returns the collation with the given name.
+func CollationFromName(name string) (Collation, bool) {
+	coll, ok := collationNames[strings.ToLower(name)]
+	return coll, ok
+}
+
+// CollationFromField returns the collation of a column, by the charset
+// of its field.
+func CollationFromField(field *querypb.Field) Collation {
+	return collationIDs[field.Charset]
+}
+
+func (c Collation) String() string {
+	switch c {
+	case CollationBin:
+		return "utf8mb4_bin"
+	case CollationBinary:
+		return "binary"
+	}
+	return "utf8mb4_general_ci"
+}
+
+// MergeCollations returns the collation used to compare two strings.
+// A binary string makes the comparison binary, and a case-sensitive
+// collation wins over a case-insensitive one.
+func MergeCollations(c1, c2 Collation) Collation {
+	if c1 > c2 {
+		return c1
+	}
+	return c2
+}
+
+// compare compares two strings according to the collation.
+func (c Collation) compare(s1, s2 []byte) int {
+	if c == CollationBinary {
+		return bytes.Compare(s1, s2)
+	}
+	s1 = bytes.TrimRight(s1, " ")
+	s2 = bytes.TrimRight(s2, " ")
+	if c == CollationBin {
+		return bytes.Compare(s1, s2)
+	}
+	for len(s1) > 0 && len(s2) > 0 {
+		r1, size1 := utf8.DecodeRune(s1)
+		r2, size2 := utf8.DecodeRune(s2)
+		if w1, w2 := unicode.ToUpper(r1), unicode.ToUpper(r2); w1 != w2 {
+			if w1 < w2 {
+				return -1
+			}
+			return 1
+		}
+		s1, s2 = s1[size1:], s2[size2:]
+	}
+	switch {
+	case len(s1) > 0:
+		return 1
+	case len(s2) > 0:
+		return -1
+	}
+	return 0
+}
+
+// Hashcode returns the same key for the strings that are equal in the
+// collation: the trailing spaces are trimmed and every rune is upper-cased
+// like compare does, unless the collation is case-sensitive. Binary strings
+// keep their raw bytes. Strings with the same key must still be compared to
+// know if they are equal.
+func (c Collation) Hashcode(s []byte) string {
+	if c == CollationBinary {
+		return string(s)
+	}
+	s = bytes.TrimRight(s, " ")
+	if c == CollationBin {
+		return string(s)
+	}
+	var key strings.Builder
+	key.Grow(len(s))
+	for len(s) > 0 {
+		r, size := utf8.DecodeRune(s)
+		key.WriteRune(unicode.ToUpper(r))
+		s = s[size:]
+	}
+	return key.String()
+}
+
+// foldRune returns the rune that represents r when matching patterns.
+func (c Collation) foldRune(r rune) rune {
+	if c == CollationGeneralCI {
+		return unicode.ToUpper(r)
+	}
+	return r
+}
+
+// like reports whether the string matches the LIKE pattern.
+// '%' matches any sequence of characters, '_' matches a single
+// character, and the escape character makes the next one literal.
+// Binary strings are matched byte by byte.
+func (c Collation) like(str, pattern []byte, escape rune) bool {
+	decode := utf8.DecodeRune
+	if c == CollationBinary {
+		decode = func(b []byte) (rune, int) {
+			return rune(b[0]), 1
+		}
+	}
+
+	var matchFrom func(str, pattern []byte) bool
+	matchFrom = func(str, pattern []byte) bool {
+		for len(pattern) > 0 {
+			p, size := decode(pattern)
+			pattern = pattern[size:]
+			switch {
+			case p == '%':
+				for len(pattern) > 0 {
+					if p, size = decode(pattern); p != '%' {
+						break
+					}
+					pattern = pattern[size:]
+				}
+				if len(pattern) == 0 {
+					return true
+				}
+				for {
+					if matchFrom(str, pattern) {
+						return true
+					}
+					if len(str) == 0 {
+						return false
+					}
+					_, size = decode(str)
+					str = str[size:]
+				}
+			case p == '_':
+				if len(str) == 0 {
+					return false
+				}
+				_, size = decode(str)
+				str = str[size:]
+			default:
+				if p == escape && len(pattern) > 0 {
+					p, size = decode(pattern)
+					pattern = pattern[size:]
+				}
+				if len(str) == 0 {
+					return false
+				}
+				s, size := decode(str)
+				if c.foldRune(s) != c.foldRune(p) {
+					return false
+				}
+				str = str[size:]
+			}
+		}
+		return len(str) == 0
+	}
+	return matchFrom(str, pattern)
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// Comparison ops
+	Equals             struct{}
+	NotEquals          struct{}
+	NullSafeEquals     struct{}
+	LessThan           struct{}
+	LessThanOrEqual    struct{}
+	GreaterThan        struct{}
+	GreaterThanOrEqual struct{}
+
+	// InExpr is an IN or NOT IN expression with a list of values
+	InExpr struct {
+		Left   Expr
+		Right  []Expr
+		Negate bool
+	}
+
+	// LikeExpr is a LIKE or NOT LIKE expression.
+	// The default escape character is used if Escape is nil.
+	LikeExpr struct {
+		Left, Pattern, Escape Expr
+		Negate                bool
+	}
+)
+
+var _ BinaryExpr = (*Equals)(nil)
+var _ BinaryExpr = (*NotEquals)(nil)
+var _ BinaryExpr = (*NullSafeEquals)(nil)
+var _ BinaryExpr = (*LessThan)(nil)
+var _ BinaryExpr = (*LessThanOrEqual)(nil)
+var _ BinaryExpr = (*GreaterThan)(nil)
+var _ BinaryExpr = (*GreaterThanOrEqual)(nil)
+
+var _ Expr = (*InExpr)(nil)
+var _ Expr = (*LikeExpr)(nil)
+
+// compareValues compares two non-NULL values using the MySQL rules for
+// comparisons: strings are compared using their collations, temporal
+// values are compared as dates when the other side is a string or a date,
+// integers are compared as integers, and everything else as doubles.
+func compareValues(v1, v2 EvalResult) int {
+	switch {
+	case v1.isString() && v2.isString():
+		return MergeCollations(v1.collation, v2.collation).compare(v1.bytes, v2.bytes)
+	case v1.isTemporal() && (v2.isTemporal() || v2.isString()), v2.isTemporal() && v1.isString():
+		if cmp, ok := compareTemporal(v1, v2); ok {
+			return cmp
+		}
+		return MergeCollations(v1.collation, v2.collation).compare(v1.bytes, v2.bytes)
+	case v1.isIntegral() && v2.isIntegral():
+		cmp, _ := compareNumeric(v1.toNumeric(), v2.toNumeric())
+		return cmp
+	}
+	if d1, d2, ok := decimalOperands(v1, v2); ok {
+		return compareDecimal(d1, d2)
+	}
+	f1, f2 := v1.toFloat(), v2.toFloat()
+	switch {
+	case f1 < f2:
+		return -1
+	case f1 > f2:
+		return 1
+	}
+	return 0
+}
+
+//Evaluate implements the BinaryExpr interface
+func (e *Equals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return newResultBool(compareValues(left, right) == 0), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (n *NotEquals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return newResultBool(compareValues(left, right) != 0), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (n *NullSafeEquals) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return newResultBool(left.isNull() && right.isNull()), nil
+	}
+	return newResultBool(compareValues(left, right) == 0), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (l *LessThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return newResultBool(compareValues(left, right) < 0), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (l *LessThanOrEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return newResultBool(compareValues(left, right) <= 0), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (g *GreaterThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return newResultBool(compareValues(left, right) > 0), nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (g *GreaterThanOrEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return newResultBool(compareValues(left, right) >= 0), nil
+}
+
+func (n *NullSafeEquals) nullSafe() {}
+
+//Type implements the BinaryExpr interface
+func (e *Equals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (n *NotEquals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (n *NullSafeEquals) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (l *LessThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (l *LessThanOrEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (g *GreaterThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (g *GreaterThanOrEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the BinaryExpr interface
+func (e *Equals) String() string {
+	return "="
+}
+
+//String implements the BinaryExpr interface
+func (n *NotEquals) String() string {
+	return "!="
+}
+
+//String implements the BinaryExpr interface
+func (n *NullSafeEquals) String() string {
+	return "<=>"
+}
+
+//String implements the BinaryExpr interface
+func (l *LessThan) String() string {
+	return "<"
+}
+
+//String implements the BinaryExpr interface
+func (l *LessThanOrEqual) String() string {
+	return "<="
+}
+
+//String implements the BinaryExpr interface
+func (g *GreaterThan) String() string {
+	return ">"
+}
+
+//String implements the BinaryExpr interface
+func (g *GreaterThanOrEqual) String() string {
+	return ">="
+}
+
+//Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil || left.isNull() {
+		return resultNull, err
+	}
+	foundNull := false
+	for _, expr := range i.Right {
+		right, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if right.isNull() {
+			foundNull = true
+			continue
+		}
+		if compareValues(left, right) == 0 {
+			return newResultBool(!i.Negate), nil
+		}
+	}
+	if foundNull {
+		// the value might be equal to the NULL one
+		return resultNull, nil
+	}
+	return newResultBool(i.Negate), nil
+}
+
+//Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (i *InExpr) String() string {
+	var values []string
+	for _, expr := range i.Right {
+		values = append(values, expr.String())
+	}
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + "(" + strings.Join(values, ", ") + ")"
+}
+
+//Evaluate implements the Expr interface
+func (l *LikeExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := l.Left.Evaluate(env)
+	if err != nil || left.isNull() {
+		return resultNull, err
+	}
+	pattern, err := l.Pattern.Evaluate(env)
+	if err != nil || pattern.isNull() {
+		return resultNull, err
+	}
+	escape := '\\'
+	if l.Escape != nil {
+		esc, err := l.Escape.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if r, size := utf8.DecodeRune(esc.toRawBytes()); size > 0 {
+			escape = r
+		}
+	}
+
+	collation := CollationGeneralCI
+	if left.isString() && pattern.isString() {
+		collation = MergeCollations(left.collation, pattern.collation)
+	}
+	matches := collation.like(left.toRawBytes(), pattern.toRawBytes(), escape)
+	return newResultBool(matches != l.Negate), nil
+}
+
+//Type implements the Expr interface
+func (l *LikeExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (l *LikeExpr) String() string {
+	op := " like "
+	if l.Negate {
+		op = " not like "
+	}
+	str := l.Left.String() + op + l.Pattern.String()
+	if l.Escape != nil {
+		str += " escape " + l.Escape.String()
+	}
+	return str
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// The conformance test evaluates every expression of the fixture file, and
+// compares the result with the one mysqld returned for "SELECT <expression>".
+// To add an expression, add it to the fixture file and record the results with:
+//
+//   go test ./go/vt/vtgate/evalengine -run TestMySQLConformance -record-conformance -mysql-socket /path/to/mysql.sock
+var (
+	recordConformance = flag.Bool("record-conformance", false, "run the conformance expressions against mysqld and overwrite the expected results")
+	mysqlHost         = flag.String("mysql-host", "127.0.0.1", "host of the mysqld used to record the conformance results")
+	mysqlPort         = flag.Int("mysql-port", 3306, "port of the mysqld used to record the conformance results")
+	mysqlSocket       = flag.String("mysql-socket", "", "unix socket of the mysqld used to record the conformance results")
+	mysqlUser         = flag.String("mysql-user", "root", "user used to record the conformance results")
+	mysqlPassword     = flag.String("mysql-password", "", "password used to record the conformance results")
+)
+
+const conformanceFixtures = "testdata/mysql_conformance.json"
+
+type conformanceCase struct {
+	Expression string `json:"expression"`
+	// Type is the type of the column returned by mysqld
+	Type string `json:"type"`
+	// Value is nil if mysqld returned NULL
+	Value *string `json:"value,omitempty"`
+}
+
+func TestMySQLConformance(t *testing.T) {
+	data, err := ioutil.ReadFile(conformanceFixtures)
+	require.NoError(t, err)
+	var cases []*conformanceCase
+	require.NoError(t, json.Unmarshal(data, &cases))
+
+	if *recordConformance {
+		recordConformanceResults(t, cases)
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tc.Expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := sqlparser.Convert(astExpr)
+			require.NoError(t, err)
+
+			result, err := expr.Evaluate(evalengine.ExpressionEnv{})
+			require.NoError(t, err)
+			got := result.Value()
+			if tc.Value == nil {
+				assert.True(t, got.IsNull(), "expected NULL, got %v", got)
+				return
+			}
+			typ := querypb.Type(querypb.Type_value[tc.Type])
+			assert.Equal(t, typeClass(typ), typeClass(got.Type()), "expected a %s, got %v", tc.Type, got)
+			assert.Equal(t, *tc.Value, got.ToString())
+		})
+	}
+}
+
+// typeClass groups the types that the evaluation engine doesn't distinguish:
+// it doesn't track the width of numbers, nor the type of string literals.
+// DECIMAL is a class of its own, as an exact value can't be a float.
+func typeClass(typ querypb.Type) string {
+	switch {
+	case sqltypes.IsSigned(typ):
+		return "signed"
+	case sqltypes.IsUnsigned(typ):
+		return "unsigned"
+	case typ == sqltypes.Decimal:
+		return "decimal"
+	case sqltypes.IsFloat(typ):
+		return "float"
+	case sqltypes.IsText(typ) || sqltypes.IsBinary(typ):
+		return "string"
+	}
+	return typ.String()
+}
+
+func recordConformanceResults(t *testing.T, cases []*conformanceCase) {
+	conn, err := mysql.Connect(context.Background(), &mysql.ConnParams{
+		Host:       *mysqlHost,
+		Port:       *mysqlPort,
+		UnixSocket: *mysqlSocket,
+		Uname:      *mysqlUser,
+		Pass:       *mysqlPassword,
+		Charset:    "utf8mb4",
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	for _, tc := range cases {
+		qr, err := conn.ExecuteFetch("select "+tc.Expression, 1, true)
+		require.NoError(t, err, tc.Expression)
+		tc.Type = qr.Fields[0].Type.String()
+		tc.Value = nil
+		if value := qr.Rows[0][0]; !value.IsNull() {
+			str := value.ToString()
+			tc.Value = &str
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	require.NoError(t, enc.Encode(cases))
+	require.NoError(t, ioutil.WriteFile(conformanceFixtures, buf.Bytes(), 0644))
+}
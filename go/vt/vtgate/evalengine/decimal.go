@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file implements the arithmetic of the DECIMAL values, which MySQL
+// computes exactly. A DECIMAL EvalResult holds its digits in bytes, and
+// an approximation in fval for the contexts that evaluate it as a double.
+
+const (
+	// maxDecimalScale is the maximum number of digits
+	// after the decimal point of a DECIMAL.
+	maxDecimalScale = 30
+	// maxDecimalPrecision is the maximum number of digits of a DECIMAL.
+	maxDecimalPrecision = 65
+)
+
+// decimal is the exact value unscaled / 10^scale.
+type decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+var bigTen = big.NewInt(10)
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// parseDecimal parses a decimal number without an exponent, such as "-1.50".
+func parseDecimal(str string) (decimal, bool) {
+	scale := 0
+	if dot := strings.IndexByte(str, '.'); dot >= 0 {
+		scale = len(str) - dot - 1
+		str = str[:dot] + str[dot+1:]
+	}
+	unscaled, ok := new(big.Int).SetString(str, 10)
+	if !ok {
+		return decimal{}, false
+	}
+	return decimal{unscaled: unscaled, scale: scale}, true
+}
+
+// String returns the digits of the decimal, with scale
+// digits after the decimal point.
+func (d decimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// rescale returns the unscaled value of d at a larger scale.
+func (d decimal) rescale(scale int) *big.Int {
+	if scale == d.scale {
+		return d.unscaled
+	}
+	return new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+}
+
+// round rounds d to the given scale, with the halves rounded
+// away from zero like MySQL does.
+func (d decimal) round(scale int) decimal {
+	if d.scale <= scale {
+		return d
+	}
+	return decimal{unscaled: quoRound(d.unscaled, pow10(d.scale-scale)), scale: scale}
+}
+
+// quoRound returns x / y rounded to the nearest
+// integer, with the halves rounded away from zero.
+func quoRound(x, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() != 0 && new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(y)) >= 0 {
+		if x.Sign() == y.Sign() {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// isDecimal returns true for the DECIMAL values.
+func (e EvalResult) isDecimal() bool {
+	return e.typ == sqltypes.Decimal
+}
+
+// toDecimal returns the exact value of a DECIMAL or an integer.
+// The second return value is false for the other types.
+func (e EvalResult) toDecimal() (decimal, bool) {
+	switch {
+	case e.isDecimal():
+		return parseDecimal(string(e.toRawBytes()))
+	case sqltypes.IsSigned(e.typ):
+		return decimal{unscaled: big.NewInt(e.ival)}, true
+	case sqltypes.IsUnsigned(e.typ):
+		return decimal{unscaled: new(big.Int).SetUint64(e.uval)}, true
+	}
+	return decimal{}, false
+}
+
+// decimalOperands returns the exact values of the operands of an arithmetic
+// operator, if they are computed as DECIMALs: when one of them is a DECIMAL
+// and the other one is a DECIMAL or an integer. The operations on doubles
+// and strings are computed as doubles.
+func decimalOperands(v1, v2 EvalResult) (decimal, decimal, bool) {
+	if !v1.isDecimal() && !v2.isDecimal() {
+		return decimal{}, decimal{}, false
+	}
+	return exactOperands(v1, v2)
+}
+
+// exactOperands returns the exact values of the operands
+// if both of them are DECIMALs or integers.
+func exactOperands(v1, v2 EvalResult) (decimal, decimal, bool) {
+	d1, ok1 := v1.toDecimal()
+	d2, ok2 := v2.toDecimal()
+	return d1, d2, ok1 && ok2
+}
+
+// newDecimalResult returns the DECIMAL result of an operation. Like in
+// MySQL, the digits after the decimal point that don't fit are rounded,
+// and it is an error if the integer part doesn't fit.
+func newDecimalResult(d decimal, op string, v1, v2 EvalResult) (EvalResult, error) {
+	d = d.round(maxDecimalScale)
+	intDigits := len(new(big.Int).Abs(d.unscaled).String()) - d.scale
+	if intDigits > maxDecimalPrecision {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "DECIMAL value is out of range in %s %s %s", v1.toRawBytes(), op, v2.toRawBytes())
+	}
+	if intDigits+d.scale > maxDecimalPrecision {
+		d = d.round(maxDecimalPrecision - intDigits)
+	}
+	return d.toResult(), nil
+}
+
+func (d decimal) toResult() EvalResult {
+	str := d.String()
+	fval, _ := strconv.ParseFloat(str, 64)
+	return EvalResult{typ: sqltypes.Decimal, fval: fval, bytes: []byte(str)}
+}
+
+func addDecimal(d1, d2 decimal, v1, v2 EvalResult) (EvalResult, error) {
+	scale := max(d1.scale, d2.scale)
+	sum := new(big.Int).Add(d1.rescale(scale), d2.rescale(scale))
+	return newDecimalResult(decimal{unscaled: sum, scale: scale}, "+", v1, v2)
+}
+
+func subtractDecimal(d1, d2 decimal, v1, v2 EvalResult) (EvalResult, error) {
+	scale := max(d1.scale, d2.scale)
+	diff := new(big.Int).Sub(d1.rescale(scale), d2.rescale(scale))
+	return newDecimalResult(decimal{unscaled: diff, scale: scale}, "-", v1, v2)
+}
+
+func multiplyDecimal(d1, d2 decimal, v1, v2 EvalResult) (EvalResult, error) {
+	product := new(big.Int).Mul(d1.unscaled, d2.unscaled)
+	return newDecimalResult(decimal{unscaled: product, scale: d1.scale + d2.scale}, "*", v1, v2)
+}
+
+// divideDecimal divides two exact values. Like in MySQL, the quotient has
+// divPrecisionIncrement more digits after the decimal point than the
+// dividend, and it is rounded. The divisor must not be zero.
+func divideDecimal(d1, d2 decimal, v1, v2 EvalResult) (EvalResult, error) {
+	scale := min(d1.scale+divPrecisionIncrement, maxDecimalScale)
+	// d1 / d2 = (u1 * 10^(s2 + scale - s1) / u2) / 10^scale
+	dividend := new(big.Int).Mul(d1.unscaled, pow10(d2.scale+scale-d1.scale))
+	quo := quoRound(dividend, d2.unscaled)
+	return newDecimalResult(decimal{unscaled: quo, scale: scale}, "/", v1, v2)
+}
+
+// moduloDecimal returns the remainder of the division of two exact values,
+// which has the sign of the dividend. The divisor must not be zero.
+func moduloDecimal(d1, d2 decimal, v1, v2 EvalResult) (EvalResult, error) {
+	scale := max(d1.scale, d2.scale)
+	rem := new(big.Int).Rem(d1.rescale(scale), d2.rescale(scale))
+	return newDecimalResult(decimal{unscaled: rem, scale: scale}, "%", v1, v2)
+}
+
+// integerDivideDecimal returns the integer part of the
+// quotient of two exact values. The divisor must not be zero.
+func integerDivideDecimal(d1, d2 decimal, v1, v2 EvalResult) (EvalResult, error) {
+	scale := max(d1.scale, d2.scale)
+	quo := new(big.Int).Quo(d1.rescale(scale), d2.rescale(scale))
+	if !quo.IsInt64() {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "BIGINT value is out of range in %s DIV %s", v1.toRawBytes(), v2.toRawBytes())
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: quo.Int64()}, nil
+}
+
+func negateDecimal(d decimal) EvalResult {
+	return decimal{unscaled: new(big.Int).Neg(d.unscaled), scale: d.scale}.toResult()
+}
+
+func absDecimal(d decimal) EvalResult {
+	return decimal{unscaled: new(big.Int).Abs(d.unscaled), scale: d.scale}.toResult()
+}
+
+func compareDecimal(d1, d2 decimal) int {
+	scale := max(d1.scale, d2.scale)
+	return d1.rescale(scale).Cmp(d2.rescale(scale))
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -102,7 +102,7 @@ func ToFloat64(v sqltypes.Value) (float64, error) {
 		return float64(num.ival), nil
 	case sqltypes.Uint64:
 		return float64(num.uval), nil
-	case sqltypes.Float64:
+	case sqltypes.Float64, sqltypes.Decimal:
 		return num.fval, nil
 	}
 
@@ -143,7 +143,9 @@ func ToNative(v sqltypes.Value) (interface{}, error) {
 func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 	raw := v.Raw()
 	switch {
-	case v.IsBinary() || v.IsText():
+	case v.IsBinary():
+		return EvalResult{bytes: raw, typ: sqltypes.VarBinary, collation: CollationBinary}, nil
+	case v.IsText():
 		return EvalResult{bytes: raw, typ: sqltypes.VarBinary}, nil
 	case v.IsSigned():
 		ival, err := strconv.ParseInt(string(raw), 10, 64)
@@ -157,12 +159,18 @@ func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return EvalResult{uval: uval, typ: sqltypes.Uint64}, nil
-	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+	case v.IsFloat():
 		fval, err := strconv.ParseFloat(string(raw), 64)
 		if err != nil {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return EvalResult{fval: fval, typ: sqltypes.Float64}, nil
+	case v.Type() == sqltypes.Decimal:
+		fval, err := strconv.ParseFloat(string(raw), 64)
+		if err != nil {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		return EvalResult{fval: fval, typ: sqltypes.Decimal, bytes: raw}, nil
 	default:
 		return EvalResult{typ: v.Type(), bytes: raw}, nil
 	}
@@ -204,7 +212,7 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.ival), 10))
 		case sqltypes.Uint64, sqltypes.Uint32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.uval), 10))
-		case sqltypes.Float64, sqltypes.Float32:
+		case sqltypes.Float64, sqltypes.Float32, sqltypes.Decimal:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.fval), 10))
 		}
 	case sqltypes.IsUnsigned(resultType):
@@ -213,7 +221,7 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.uval), 10))
 		case sqltypes.Int64, sqltypes.Int32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.ival), 10))
-		case sqltypes.Float64, sqltypes.Float32:
+		case sqltypes.Float64, sqltypes.Float32, sqltypes.Decimal:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.fval), 10))
 		}
 	case sqltypes.IsFloat(resultType) || resultType == sqltypes.Decimal:
@@ -222,6 +230,8 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.ival), 10))
 		case sqltypes.Uint64, sqltypes.Uint32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.uval), 10))
+		case sqltypes.Decimal:
+			return sqltypes.MakeTrusted(resultType, v.bytes)
 		case sqltypes.Float64, sqltypes.Float32:
 			format := byte('g')
 			if resultType == sqltypes.Decimal {
@@ -243,7 +253,7 @@ func hashCode(v EvalResult) int64 {
 		val = float64(v.ival)
 	case sqltypes.Uint64:
 		val = float64(v.uval)
-	case sqltypes.Float64:
+	case sqltypes.Float64, sqltypes.Decimal:
 		val = v.fval
 	}
 
@@ -253,6 +263,17 @@ func hashCode(v EvalResult) int64 {
 }
 
 func compareNumeric(v1, v2 EvalResult) (int, error) {
+	if d1, d2, ok := decimalOperands(v1, v2); ok {
+		return compareDecimal(d1, d2), nil
+	}
+	// DECIMALs are compared with doubles as doubles.
+	if v1.isDecimal() {
+		v1 = v1.toNumeric()
+	}
+	if v2.isDecimal() {
+		v2 = v2.toNumeric()
+	}
+
 	// Equalize the types.
 	switch v1.typ {
 	case sqltypes.Int64:
@@ -29,17 +29,20 @@ import (
 
 type (
 	EvalResult struct {
-		typ   querypb.Type
-		ival  int64
-		uval  uint64
-		fval  float64
-		bytes []byte
+		typ       querypb.Type
+		ival      int64
+		uval      uint64
+		fval      float64
+		bytes     []byte
+		collation Collation
 	}
 	//ExpressionEnv contains the environment that the expression
 	//evaluates in, such as the current row and bindvars
 	ExpressionEnv struct {
 		BindVars map[string]*querypb.BindVariable
 		Row      []sqltypes.Value
+		// Fields describes the columns of Row, if known
+		Fields []*querypb.Field
 	}
 
 	// Expr is the interface that all evaluating expressions must implement
@@ -64,12 +67,21 @@ type (
 		Expr        BinaryExpr
 		Left, Right Expr
 	}
+	NegateExpr struct{ Expr Expr }
 
 	// Binary ops
-	Addition       struct{}
-	Subtraction    struct{}
-	Multiplication struct{}
-	Division       struct{}
+	Addition        struct{}
+	Subtraction     struct{}
+	Multiplication  struct{}
+	Division        struct{}
+	IntegerDivision struct{}
+	Modulo          struct{}
+
+	// nullSafe is implemented by the binary expressions that handle NULL
+	// operands themselves, instead of evaluating to NULL.
+	nullSafe interface {
+		nullSafe()
+	}
 )
 
 //Value allows for retrieval of the value we expose for public consumption
@@ -100,11 +112,25 @@ func NewLiteralFloat(val []byte) (Expr, error) {
 	return &Literal{EvalResult{typ: sqltypes.Float64, fval: fval}}, nil
 }
 
+//NewLiteralDecimal returns a DECIMAL literal expression, such as 1.50
+func NewLiteralDecimal(val []byte) (Expr, error) {
+	d, ok := parseDecimal(string(val))
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid DECIMAL value: %s", val)
+	}
+	return &Literal{d.toResult()}, nil
+}
+
 //NewLiteralFloat returns a literal expression
 func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
 
+//NewLiteralNull returns a NULL literal
+func NewLiteralNull() Expr {
+	return &Literal{resultNull}
+}
+
 //NewBindVar returns a bind variable
 func NewBindVar(key string) Expr {
 	return &BindVariable{Key: key}
@@ -121,11 +147,14 @@ var _ Expr = (*Literal)(nil)
 var _ Expr = (*BindVariable)(nil)
 var _ Expr = (*BinaryOp)(nil)
 var _ Expr = (*Column)(nil)
+var _ Expr = (*NegateExpr)(nil)
 
 var _ BinaryExpr = (*Addition)(nil)
 var _ BinaryExpr = (*Subtraction)(nil)
 var _ BinaryExpr = (*Multiplication)(nil)
 var _ BinaryExpr = (*Division)(nil)
+var _ BinaryExpr = (*IntegerDivision)(nil)
+var _ BinaryExpr = (*Modulo)(nil)
 
 //Evaluate implements the Expr interface
 func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
@@ -137,9 +166,21 @@ func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	if err != nil {
 		return EvalResult{}, err
 	}
+	if _, ok := b.Expr.(nullSafe); !ok && (lVal.isNull() || rVal.isNull()) {
+		return resultNull, nil
+	}
 	return b.Expr.Evaluate(lVal, rVal)
 }
 
+//Evaluate implements the Expr interface
+func (n *NegateExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := n.Expr.Evaluate(env)
+	if err != nil || val.isNull() {
+		return val, err
+	}
+	return negateNumeric(val)
+}
+
 //Evaluate implements the Expr interface
 func (l *Literal) Evaluate(ExpressionEnv) (EvalResult, error) {
 	return l.Val, nil
@@ -158,6 +199,10 @@ func (b *BindVariable) Evaluate(env ExpressionEnv) (EvalResult, error) {
 func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	value := env.Row[c.Offset]
 	numeric, err := newEvalResult(value)
+	if err == nil && c.Offset < len(env.Fields) && numeric.isString() && numeric.collation != CollationBinary {
+		// text columns may have a case-sensitive collation
+		numeric.collation = CollationFromField(env.Fields[c.Offset])
+	}
 	return numeric, err
 }
 
@@ -178,9 +223,28 @@ func (m *Multiplication) Evaluate(left, right EvalResult) (EvalResult, error) {
 
 //Evaluate implements the BinaryOp interface
 func (d *Division) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if isZero(right) {
+		return resultNull, nil
+	}
 	return divideNumericWithError(left, right)
 }
 
+//Evaluate implements the BinaryOp interface
+func (d *IntegerDivision) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if isZero(right) {
+		return resultNull, nil
+	}
+	return integerDivideNumericWithError(left, right)
+}
+
+//Evaluate implements the BinaryOp interface
+func (m *Modulo) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if isZero(right) {
+		return resultNull, nil
+	}
+	return moduloNumeric(left, right), nil
+}
+
 //Type implements the BinaryExpr interface
 func (a *Addition) Type(left querypb.Type) querypb.Type {
 	return left
@@ -192,8 +256,11 @@ func (m *Multiplication) Type(left querypb.Type) querypb.Type {
 }
 
 //Type implements the BinaryExpr interface
-func (d *Division) Type(querypb.Type) querypb.Type {
-	return sqltypes.Float64
+func (d *Division) Type(left querypb.Type) querypb.Type {
+	if sqltypes.IsFloat(left) {
+		return sqltypes.Float64
+	}
+	return sqltypes.Decimal
 }
 
 //Type implements the BinaryExpr interface
@@ -201,6 +268,16 @@ func (s *Subtraction) Type(left querypb.Type) querypb.Type {
 	return left
 }
 
+//Type implements the BinaryExpr interface
+func (d *IntegerDivision) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (m *Modulo) Type(left querypb.Type) querypb.Type {
+	return left
+}
+
 //Type implements the Expr interface
 func (b *BinaryOp) Type(env ExpressionEnv) (querypb.Type, error) {
 	ltype, err := b.Left.Type(env)
@@ -211,10 +288,22 @@ func (b *BinaryOp) Type(env ExpressionEnv) (querypb.Type, error) {
 	if err != nil {
 		return 0, err
 	}
-	typ := mergeNumericalTypes(ltype, rtype)
+	typ := mergeNumericalTypes(numericalType(ltype), numericalType(rtype))
 	return b.Expr.Type(typ), nil
 }
 
+//Type implements the Expr interface
+func (n *NegateExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	typ, err := n.Expr.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	if sqltypes.IsUnsigned(typ) {
+		return sqltypes.Int64, nil
+	}
+	return numericalType(typ), nil
+}
+
 //Type implements the Expr interface
 func (b *BindVariable) Type(env ExpressionEnv) (querypb.Type, error) {
 	e := env.BindVars
@@ -231,7 +320,10 @@ func (l *Literal) Type(ExpressionEnv) (querypb.Type, error) {
 }
 
 //Type implements the Expr interface
-func (c *Column) Type(ExpressionEnv) (querypb.Type, error) {
+func (c *Column) Type(env ExpressionEnv) (querypb.Type, error) {
+	if c.Offset < len(env.Fields) {
+		return env.Fields[c.Offset].Type, nil
+	}
 	return sqltypes.Float64, nil
 }
 
@@ -255,11 +347,26 @@ func (a *Addition) String() string {
 	return "+"
 }
 
+//String implements the BinaryExpr interface
+func (d *IntegerDivision) String() string {
+	return "div"
+}
+
+//String implements the BinaryExpr interface
+func (m *Modulo) String() string {
+	return "%"
+}
+
 //String implements the Expr interface
 func (b *BinaryOp) String() string {
 	return b.Left.String() + " " + b.Expr.String() + " " + b.Right.String()
 }
 
+//String implements the Expr interface
+func (n *NegateExpr) String() string {
+	return "-" + n.Expr.String()
+}
+
 //String implements the Expr interface
 func (b *BindVariable) String() string {
 	return ":" + b.Key
@@ -275,13 +382,26 @@ func (c *Column) String() string {
 	return fmt.Sprintf("column %d from the input", c.Offset)
 }
 
+// numericalType returns the type a value is converted to in arithmetic:
+// strings and temporal values are evaluated as doubles.
+func numericalType(typ querypb.Type) querypb.Type {
+	if typ == sqltypes.Null || sqltypes.IsNumber(typ) {
+		return typ
+	}
+	return sqltypes.Float64
+}
+
 func mergeNumericalTypes(ltype, rtype querypb.Type) querypb.Type {
 	switch ltype {
 	case sqltypes.Int64:
-		if rtype == sqltypes.Uint64 || rtype == sqltypes.Float64 {
+		if rtype == sqltypes.Uint64 || rtype == sqltypes.Decimal || rtype == sqltypes.Float64 {
 			return rtype
 		}
 	case sqltypes.Uint64:
+		if rtype == sqltypes.Decimal || rtype == sqltypes.Float64 {
+			return rtype
+		}
+	case sqltypes.Decimal:
 		if rtype == sqltypes.Float64 {
 			return rtype
 		}
@@ -315,6 +435,12 @@ func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 			fval = 0
 		}
 		return EvalResult{typ: sqltypes.Float64, fval: fval}, nil
+	case sqltypes.Decimal:
+		d, ok := parseDecimal(string(val.Value))
+		if !ok {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid DECIMAL value: %s", val.Value)
+		}
+		return d.toResult(), nil
 	case sqltypes.VarChar, sqltypes.Text, sqltypes.VarBinary:
 		return EvalResult{typ: sqltypes.VarBinary, bytes: val.Value}, nil
 	case sqltypes.Null:
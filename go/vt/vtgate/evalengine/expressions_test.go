@@ -82,14 +82,16 @@ func TestBinaryOpTypes(t *testing.T) {
 		}, {
 			op: &Division{},
 			testcases: []testcase{
-				{sqltypes.Int64, sqltypes.Int64, sqltypes.Float64},
-				{sqltypes.Uint64, sqltypes.Int64, sqltypes.Float64},
+				{sqltypes.Int64, sqltypes.Int64, sqltypes.Decimal},
+				{sqltypes.Uint64, sqltypes.Int64, sqltypes.Decimal},
+				{sqltypes.Decimal, sqltypes.Int64, sqltypes.Decimal},
 				{sqltypes.Float64, sqltypes.Int64, sqltypes.Float64},
-				{sqltypes.Int64, sqltypes.Uint64, sqltypes.Float64},
-				{sqltypes.Uint64, sqltypes.Uint64, sqltypes.Float64},
+				{sqltypes.Int64, sqltypes.Uint64, sqltypes.Decimal},
+				{sqltypes.Uint64, sqltypes.Uint64, sqltypes.Decimal},
+				{sqltypes.Decimal, sqltypes.Uint64, sqltypes.Decimal},
 				{sqltypes.Float64, sqltypes.Uint64, sqltypes.Float64},
-				{sqltypes.Int64, sqltypes.Float64, sqltypes.Float64},
-				{sqltypes.Uint64, sqltypes.Float64, sqltypes.Float64},
+				{sqltypes.Decimal, sqltypes.Decimal, sqltypes.Decimal},
+				{sqltypes.Float64, sqltypes.Decimal, sqltypes.Float64},
 				{sqltypes.Float64, sqltypes.Float64, sqltypes.Float64},
 			},
 		},
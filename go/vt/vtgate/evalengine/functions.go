@@ -0,0 +1,475 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// CallExpr is a call to one of the builtin functions
+	CallExpr struct {
+		Name      string
+		Arguments []Expr
+		F         *builtin
+	}
+
+	// CaseExpr is a CASE expression. If Base is nil, the first WHEN condition
+	// that is true is picked. Otherwise, the first WHEN value that is equal to Base.
+	CaseExpr struct {
+		Base  Expr
+		Whens []When
+		Else  Expr
+	}
+
+	// When is a WHEN clause of a CaseExpr
+	When struct {
+		Cond, Val Expr
+	}
+
+	// builtin is a function that can be called from a CallExpr.
+	// Its arguments are evaluated before it is called.
+	builtin struct {
+		// minArgs and maxArgs are the number of arguments the function
+		// accepts. maxArgs is -1 for functions with a variable number of arguments.
+		minArgs, maxArgs int
+		call             func(args []EvalResult) (EvalResult, error)
+		typeOf           func(args []querypb.Type) querypb.Type
+	}
+)
+
+var _ Expr = (*CallExpr)(nil)
+var _ Expr = (*CaseExpr)(nil)
+
+var builtinFunctions = map[string]*builtin{
+	"coalesce":         {minArgs: 1, maxArgs: -1, call: builtinCoalesce, typeOf: mergeTypes},
+	"ifnull":           {minArgs: 2, maxArgs: 2, call: builtinCoalesce, typeOf: mergeTypes},
+	"if":               {minArgs: 3, maxArgs: 3, call: builtinIf, typeOf: typeOfIf},
+	"nullif":           {minArgs: 2, maxArgs: 2, call: builtinNullIf, typeOf: typeOfFirst},
+	"concat":           {minArgs: 1, maxArgs: -1, call: builtinConcat, typeOf: typeOfString},
+	"concat_ws":        {minArgs: 2, maxArgs: -1, call: builtinConcatWs, typeOf: typeOfString},
+	"lower":            {minArgs: 1, maxArgs: 1, call: builtinLower, typeOf: typeOfString},
+	"lcase":            {minArgs: 1, maxArgs: 1, call: builtinLower, typeOf: typeOfString},
+	"upper":            {minArgs: 1, maxArgs: 1, call: builtinUpper, typeOf: typeOfString},
+	"ucase":            {minArgs: 1, maxArgs: 1, call: builtinUpper, typeOf: typeOfString},
+	"length":           {minArgs: 1, maxArgs: 1, call: builtinLength, typeOf: typeOfInt},
+	"octet_length":     {minArgs: 1, maxArgs: 1, call: builtinLength, typeOf: typeOfInt},
+	"char_length":      {minArgs: 1, maxArgs: 1, call: builtinCharLength, typeOf: typeOfInt},
+	"character_length": {minArgs: 1, maxArgs: 1, call: builtinCharLength, typeOf: typeOfInt},
+	"substring":        {minArgs: 2, maxArgs: 3, call: builtinSubstring, typeOf: typeOfString},
+	"substr":           {minArgs: 2, maxArgs: 3, call: builtinSubstring, typeOf: typeOfString},
+	"mid":              {minArgs: 3, maxArgs: 3, call: builtinSubstring, typeOf: typeOfString},
+	"left":             {minArgs: 2, maxArgs: 2, call: builtinLeft, typeOf: typeOfString},
+	"right":            {minArgs: 2, maxArgs: 2, call: builtinRight, typeOf: typeOfString},
+	"trim":             {minArgs: 1, maxArgs: 1, call: builtinTrim(true, true), typeOf: typeOfString},
+	"ltrim":            {minArgs: 1, maxArgs: 1, call: builtinTrim(true, false), typeOf: typeOfString},
+	"rtrim":            {minArgs: 1, maxArgs: 1, call: builtinTrim(false, true), typeOf: typeOfString},
+	"replace":          {minArgs: 3, maxArgs: 3, call: builtinReplace, typeOf: typeOfString},
+	"abs":              {minArgs: 1, maxArgs: 1, call: builtinAbs, typeOf: typeOfFirst},
+}
+
+// IsBuiltin returns true if the function can be evaluated by a CallExpr.
+func IsBuiltin(name string) bool {
+	_, ok := builtinFunctions[strings.ToLower(name)]
+	return ok
+}
+
+// NewCallExpr returns a call to the builtin function with the given name.
+func NewCallExpr(name string, args []Expr) (*CallExpr, error) {
+	name = strings.ToLower(name)
+	f, ok := builtinFunctions[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", name)
+	}
+	if len(args) < f.minArgs || (f.maxArgs >= 0 && len(args) > f.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", name)
+	}
+	return &CallExpr{Name: name, Arguments: args, F: f}, nil
+}
+
+//Evaluate implements the Expr interface
+func (c *CallExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	args := make([]EvalResult, 0, len(c.Arguments))
+	for _, arg := range c.Arguments {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		args = append(args, val)
+	}
+	return c.F.call(args)
+}
+
+//Type implements the Expr interface
+func (c *CallExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	types := make([]querypb.Type, 0, len(c.Arguments))
+	for _, arg := range c.Arguments {
+		typ, err := arg.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	return c.F.typeOf(types), nil
+}
+
+//String implements the Expr interface
+func (c *CallExpr) String() string {
+	var args []string
+	for _, arg := range c.Arguments {
+		args = append(args, arg.String())
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+//Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		if base, err = c.Base.Evaluate(env); err != nil {
+			return EvalResult{}, err
+		}
+	}
+	for _, when := range c.Whens {
+		cond, err := when.Cond.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		var matches bool
+		if c.Base != nil {
+			matches = !base.isNull() && !cond.isNull() && compareValues(base, cond) == 0
+		} else {
+			matches, _ = cond.truthValue()
+		}
+		if matches {
+			return when.Val.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return resultNull, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+//Type implements the Expr interface
+func (c *CaseExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	var types []querypb.Type
+	for _, when := range c.Whens {
+		typ, err := when.Val.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	if c.Else != nil {
+		typ, err := c.Else.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	return mergeTypes(types), nil
+}
+
+//String implements the Expr interface
+func (c *CaseExpr) String() string {
+	str := "case"
+	if c.Base != nil {
+		str += " " + c.Base.String()
+	}
+	for _, when := range c.Whens {
+		str += " when " + when.Cond.String() + " then " + when.Val.String()
+	}
+	if c.Else != nil {
+		str += " else " + c.Else.String()
+	}
+	return str + " end"
+}
+
+// mergeTypes returns the type of an expression that evaluates to one of the
+// arguments, like COALESCE or CASE: numbers are widened to fit all the
+// arguments, and anything mixed with a string is a string.
+func mergeTypes(types []querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	for _, typ := range types {
+		switch {
+		case typ == sqltypes.Null || typ == result:
+		case result == sqltypes.Null:
+			result = typ
+		case sqltypes.IsNumber(result) && sqltypes.IsNumber(typ):
+			result = mergeNumericalTypes(numericalType(result), numericalType(typ))
+		case sqltypes.IsBinary(result) || sqltypes.IsBinary(typ):
+			result = sqltypes.VarBinary
+		default:
+			result = sqltypes.VarChar
+		}
+	}
+	return result
+}
+
+func typeOfIf(types []querypb.Type) querypb.Type {
+	return mergeTypes(types[1:])
+}
+
+func typeOfFirst(types []querypb.Type) querypb.Type {
+	return types[0]
+}
+
+func typeOfInt([]querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+func typeOfString(types []querypb.Type) querypb.Type {
+	for _, typ := range types {
+		if sqltypes.IsBinary(typ) {
+			return sqltypes.VarBinary
+		}
+	}
+	return sqltypes.VarChar
+}
+
+// stringCollation returns the collation of the string built from the arguments.
+func stringCollation(args []EvalResult) Collation {
+	collation := CollationGeneralCI
+	for _, arg := range args {
+		if arg.isString() {
+			collation = MergeCollations(collation, arg.collation)
+		}
+	}
+	return collation
+}
+
+func anyNull(args []EvalResult) bool {
+	for _, arg := range args {
+		if arg.isNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// runes returns the characters of a string. The characters
+// of a binary string are its bytes.
+func runes(arg EvalResult) [][]byte {
+	str := arg.toRawBytes()
+	var chars [][]byte
+	for len(str) > 0 {
+		size := 1
+		if arg.collation != CollationBinary {
+			_, size = utf8.DecodeRune(str)
+		}
+		chars = append(chars, str[:size])
+		str = str[size:]
+	}
+	return chars
+}
+
+func builtinCoalesce(args []EvalResult) (EvalResult, error) {
+	for _, arg := range args {
+		if !arg.isNull() {
+			return arg, nil
+		}
+	}
+	return resultNull, nil
+}
+
+func builtinIf(args []EvalResult) (EvalResult, error) {
+	if cond, _ := args[0].truthValue(); cond {
+		return args[1], nil
+	}
+	return args[2], nil
+}
+
+func builtinNullIf(args []EvalResult) (EvalResult, error) {
+	if !anyNull(args) && compareValues(args[0], args[1]) == 0 {
+		return resultNull, nil
+	}
+	return args[0], nil
+}
+
+func builtinConcat(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	var buf bytes.Buffer
+	for _, arg := range args {
+		buf.Write(arg.toRawBytes())
+	}
+	return newResultString(buf.Bytes(), stringCollation(args)), nil
+}
+
+func builtinConcatWs(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	var parts [][]byte
+	for _, arg := range args[1:] {
+		// NULL arguments are skipped
+		if !arg.isNull() {
+			parts = append(parts, arg.toRawBytes())
+		}
+	}
+	return newResultString(bytes.Join(parts, args[0].toRawBytes()), stringCollation(args)), nil
+}
+
+func builtinLower(args []EvalResult) (EvalResult, error) {
+	return changeCase(args[0], bytes.ToLower)
+}
+
+func builtinUpper(args []EvalResult) (EvalResult, error) {
+	return changeCase(args[0], bytes.ToUpper)
+}
+
+// changeCase changes the case of a string. Binary strings are left as is.
+func changeCase(arg EvalResult, f func([]byte) []byte) (EvalResult, error) {
+	switch {
+	case arg.isNull():
+		return resultNull, nil
+	case arg.isString() && arg.collation == CollationBinary:
+		return arg, nil
+	}
+	return newResultString(f(arg.toRawBytes()), arg.collation), nil
+}
+
+func builtinLength(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: int64(len(args[0].toRawBytes()))}, nil
+}
+
+func builtinCharLength(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: int64(len(runes(args[0])))}, nil
+}
+
+// builtinSubstring implements SUBSTRING(str, pos[, len]). Positions start at 1,
+// and a negative position counts from the end of the string.
+func builtinSubstring(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	chars := runes(args[0])
+	pos := args[1].toInt64()
+	length := int64(len(chars))
+	if len(args) == 3 {
+		length = args[2].toInt64()
+	}
+	switch {
+	case pos < 0:
+		pos += int64(len(chars))
+	case pos > 0:
+		pos--
+	default:
+		pos = int64(len(chars))
+	}
+	if pos < 0 || pos >= int64(len(chars)) || length <= 0 {
+		return newResultString([]byte{}, args[0].collation), nil
+	}
+	end := int64(len(chars))
+	if length < end-pos {
+		end = pos + length
+	}
+	return newResultString(bytes.Join(chars[pos:end], nil), args[0].collation), nil
+}
+
+func builtinLeft(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	chars := runes(args[0])
+	n := args[1].toInt64()
+	switch {
+	case n < 0:
+		n = 0
+	case n > int64(len(chars)):
+		n = int64(len(chars))
+	}
+	return newResultString(bytes.Join(chars[:n], nil), args[0].collation), nil
+}
+
+func builtinRight(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	chars := runes(args[0])
+	n := args[1].toInt64()
+	switch {
+	case n < 0:
+		n = 0
+	case n > int64(len(chars)):
+		n = int64(len(chars))
+	}
+	return newResultString(bytes.Join(chars[int64(len(chars))-n:], nil), args[0].collation), nil
+}
+
+func builtinTrim(leading, trailing bool) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		if args[0].isNull() {
+			return resultNull, nil
+		}
+		str := args[0].toRawBytes()
+		if leading {
+			str = bytes.TrimLeft(str, " ")
+		}
+		if trailing {
+			str = bytes.TrimRight(str, " ")
+		}
+		return newResultString(str, args[0].collation), nil
+	}
+}
+
+// builtinReplace replaces all the occurrences of a string. Like in MySQL,
+// the search is case-sensitive, whatever the collation of the arguments.
+func builtinReplace(args []EvalResult) (EvalResult, error) {
+	if anyNull(args) {
+		return resultNull, nil
+	}
+	from := args[1].toRawBytes()
+	str := args[0].toRawBytes()
+	if len(from) > 0 {
+		str = bytes.ReplaceAll(str, from, args[2].toRawBytes())
+	}
+	return newResultString(str, stringCollation(args)), nil
+}
+
+func builtinAbs(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	if d, ok := args[0].toDecimal(); ok && args[0].isDecimal() {
+		return absDecimal(d), nil
+	}
+	num := args[0].toNumeric()
+	switch {
+	case num.typ == sqltypes.Int64 && num.ival < 0:
+		return negateNumeric(num)
+	case num.typ == sqltypes.Float64 && num.fval < 0:
+		num.fval = -num.fval
+	}
+	return num, nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// Logical ops. They follow the SQL three-valued logic,
+	// where NULL stands for an unknown truth value.
+	And struct{}
+	Or  struct{}
+	Xor struct{}
+
+	// NotExpr is the NOT operator
+	NotExpr struct {
+		Expr Expr
+	}
+
+	// IsExpr is an IS [NOT] NULL/TRUE/FALSE expression
+	IsExpr struct {
+		Expr Expr
+		Op   IsOp
+	}
+
+	// IsOp is the check done by an IsExpr
+	IsOp int8
+)
+
+// IsOp values
+const (
+	IsNull IsOp = iota
+	IsNotNull
+	IsTrue
+	IsNotTrue
+	IsFalse
+	IsNotFalse
+)
+
+var _ BinaryExpr = (*And)(nil)
+var _ BinaryExpr = (*Or)(nil)
+var _ BinaryExpr = (*Xor)(nil)
+
+var _ Expr = (*NotExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+
+//Evaluate implements the BinaryExpr interface
+func (a *And) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lKnown := left.truthValue()
+	r, rKnown := right.truthValue()
+	switch {
+	case lKnown && !l, rKnown && !r:
+		return newResultBool(false), nil
+	case lKnown && rKnown:
+		return newResultBool(true), nil
+	}
+	return resultNull, nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (o *Or) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lKnown := left.truthValue()
+	r, rKnown := right.truthValue()
+	switch {
+	case lKnown && l, rKnown && r:
+		return newResultBool(true), nil
+	case lKnown && rKnown:
+		return newResultBool(false), nil
+	}
+	return resultNull, nil
+}
+
+//Evaluate implements the BinaryExpr interface
+func (x *Xor) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, _ := left.truthValue()
+	r, _ := right.truthValue()
+	return newResultBool(l != r), nil
+}
+
+func (a *And) nullSafe() {}
+func (o *Or) nullSafe()  {}
+
+//Type implements the BinaryExpr interface
+func (a *And) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (o *Or) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//Type implements the BinaryExpr interface
+func (x *Xor) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+//String implements the BinaryExpr interface
+func (a *And) String() string {
+	return "and"
+}
+
+//String implements the BinaryExpr interface
+func (o *Or) String() string {
+	return "or"
+}
+
+//String implements the BinaryExpr interface
+func (x *Xor) String() string {
+	return "xor"
+}
+
+//Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := n.Expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	b, known := val.truthValue()
+	if !known {
+		return resultNull, nil
+	}
+	return newResultBool(!b), nil
+}
+
+//Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Expr.String()
+}
+
+//Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := i.Expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	b, known := val.truthValue()
+	var result bool
+	switch i.Op {
+	case IsNull:
+		result = !known
+	case IsNotNull:
+		result = known
+	case IsTrue:
+		result = known && b
+	case IsNotTrue:
+		result = !known || !b
+	case IsFalse:
+		result = known && !b
+	case IsNotFalse:
+		result = !known || b
+	}
+	return newResultBool(result), nil
+}
+
+//Type implements the Expr interface
+func (i *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (i *IsExpr) String() string {
+	return i.Expr.String() + " " + i.Op.String()
+}
+
+func (op IsOp) String() string {
+	switch op {
+	case IsNull:
+		return "is null"
+	case IsNotNull:
+		return "is not null"
+	case IsTrue:
+		return "is true"
+	case IsNotTrue:
+		return "is not true"
+	case IsFalse:
+		return "is false"
+	}
+	return "is not false"
+}
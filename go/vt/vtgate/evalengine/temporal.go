@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	dateFormat     = "2006-01-02"
+	datetimeFormat = "2006-01-02 15:04:05"
+	microFormat    = "2006-01-02 15:04:05.000000"
+)
+
+var dateFormats = []string{dateFormat, "20060102"}
+var datetimeFormats = []string{datetimeFormat, "2006-01-02T15:04:05", "2006-01-02 15:04", "20060102150405"}
+
+type (
+	// DateArithmetic adds an interval to a date, or subtracts it from the date,
+	// like DATE_ADD, DATE_SUB and the + and - operators with an INTERVAL operand do.
+	DateArithmetic struct {
+		Date, Interval Expr
+		Unit           IntervalUnit
+		Subtract       bool
+	}
+
+	// IntervalUnit is the unit of an INTERVAL expression
+	IntervalUnit struct {
+		Name string
+		// parts are the unit of each number in the interval, from the largest
+		// to the smallest. Simple units have one part, and compound units
+		// like DAY_HOUR have one for each unit they are made of.
+		parts []intervalPart
+	}
+
+	intervalPart int8
+)
+
+const (
+	partYear intervalPart = iota
+	partQuarter
+	partMonth
+	partWeek
+	partDay
+	partHour
+	partMinute
+	partSecond
+	partMicrosecond
+)
+
+var intervalUnits = map[string][]intervalPart{
+	"year":               {partYear},
+	"quarter":            {partQuarter},
+	"month":              {partMonth},
+	"week":               {partWeek},
+	"day":                {partDay},
+	"hour":               {partHour},
+	"minute":             {partMinute},
+	"second":             {partSecond},
+	"microsecond":        {partMicrosecond},
+	"year_month":         {partYear, partMonth},
+	"day_hour":           {partDay, partHour},
+	"day_minute":         {partDay, partHour, partMinute},
+	"day_second":         {partDay, partHour, partMinute, partSecond},
+	"day_microsecond":    {partDay, partHour, partMinute, partSecond, partMicrosecond},
+	"hour_minute":        {partHour, partMinute},
+	"hour_second":        {partHour, partMinute, partSecond},
+	"hour_microsecond":   {partHour, partMinute, partSecond, partMicrosecond},
+	"minute_second":      {partMinute, partSecond},
+	"minute_microsecond": {partMinute, partSecond, partMicrosecond},
+	"second_microsecond": {partSecond, partMicrosecond},
+}
+
+var _ Expr = (*DateArithmetic)(nil)
+
+// NewIntervalUnit returns the interval unit with the given name, such as DAY or DAY_HOUR.
+func NewIntervalUnit(name string) (IntervalUnit, error) {
+	parts, ok := intervalUnits[strings.ToLower(name)]
+	if !ok {
+		return IntervalUnit{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown interval unit: %s", name)
+	}
+	return IntervalUnit{Name: strings.ToUpper(name), parts: parts}, nil
+}
+
+// dateOnly returns true if the unit doesn't change the time of a date.
+func (u IntervalUnit) dateOnly() bool {
+	return u.parts[len(u.parts)-1] <= partDay
+}
+
+// parseTemporal parses a date or a datetime value.
+// The second return value is true if the value has no time part.
+func parseTemporal(e EvalResult) (t time.Time, dateOnly bool, ok bool) {
+	var str string
+	switch {
+	case e.isNull():
+		return time.Time{}, false, false
+	case e.isTemporal() || e.isString():
+		str = strings.TrimSpace(string(e.bytes))
+	default:
+		str = string(e.toNumeric().toRawBytes())
+	}
+	if e.typ != sqltypes.Datetime && e.typ != sqltypes.Timestamp {
+		for _, format := range dateFormats {
+			if t, err := time.Parse(format, str); err == nil {
+				return t, true, true
+			}
+		}
+	}
+	for _, format := range datetimeFormats {
+		if t, err := time.Parse(format, trimFraction(str)); err == nil {
+			return t.Add(parseFraction(str)), false, true
+		}
+	}
+	return time.Time{}, false, false
+}
+
+// trimFraction removes the fractional seconds of a datetime string.
+func trimFraction(str string) string {
+	if dot := strings.LastIndexByte(str, '.'); dot >= 0 && strings.IndexByte(str, ':') < dot {
+		return str[:dot]
+	}
+	return str
+}
+
+func parseFraction(str string) time.Duration {
+	dot := strings.LastIndexByte(str, '.')
+	if dot < 0 || strings.IndexByte(str, ':') > dot {
+		return 0
+	}
+	digits := (str[dot+1:] + "000000")[:6]
+	micros, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(micros) * time.Microsecond
+}
+
+// compareTemporal compares two values as datetimes.
+// It returns false if either value is not a valid date.
+func compareTemporal(v1, v2 EvalResult) (int, bool) {
+	t1, _, ok := parseTemporal(v1)
+	if !ok {
+		return 0, false
+	}
+	t2, _, ok := parseTemporal(v2)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case t1.Before(t2):
+		return -1, true
+	case t1.After(t2):
+		return 1, true
+	}
+	return 0, true
+}
+
+func formatDate(t time.Time) []byte {
+	return []byte(t.Format(dateFormat))
+}
+
+func formatDatetime(t time.Time) []byte {
+	if t.Nanosecond() != 0 {
+		return []byte(t.Format(microFormat))
+	}
+	return []byte(t.Format(datetimeFormat))
+}
+
+// parseInterval parses the value of an INTERVAL expression.
+// Every number found in a string value is assigned to a part of the unit,
+// aligning the last number with the smallest part: with DAY_SECOND, '1:30'
+// is one minute and thirty seconds.
+func parseInterval(val EvalResult, unit IntervalUnit) ([]int64, bool) {
+	if !val.isString() {
+		if len(unit.parts) > 1 {
+			return []int64{val.toInt64()}, true
+		}
+		return []int64{int64(math.Round(val.toFloat()))}, true
+	}
+	str := strings.TrimSpace(string(val.bytes))
+	negative := strings.HasPrefix(str, "-")
+	fields := strings.FieldsFunc(str, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if len(fields) == 0 || len(fields) > len(unit.parts) {
+		return nil, false
+	}
+	values := make([]int64, len(unit.parts))
+	offset := len(unit.parts) - len(fields)
+	for i, field := range fields {
+		v, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		if negative {
+			v = -v
+		}
+		values[offset+i] = v
+	}
+	return values, true
+}
+
+// addMonths adds months to a date. Like MySQL, the day is clamped
+// to the last day of the resulting month.
+func addMonths(t time.Time, months int64) time.Time {
+	year, month, day := t.Date()
+	total := int64(year)*12 + int64(month-1) + months
+	newYear, newMonth := int(total/12), time.Month(total%12+1)
+	if lastDay := time.Date(newYear, newMonth+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > lastDay {
+		day = lastDay
+	}
+	return time.Date(newYear, newMonth, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func addInterval(t time.Time, values []int64, parts []intervalPart) time.Time {
+	for i, v := range values {
+		switch parts[len(parts)-len(values)+i] {
+		case partYear:
+			t = addMonths(t, v*12)
+		case partQuarter:
+			t = addMonths(t, v*3)
+		case partMonth:
+			t = addMonths(t, v)
+		case partWeek:
+			t = t.AddDate(0, 0, int(v*7))
+		case partDay:
+			t = t.AddDate(0, 0, int(v))
+		case partHour:
+			t = t.Add(time.Duration(v) * time.Hour)
+		case partMinute:
+			t = t.Add(time.Duration(v) * time.Minute)
+		case partSecond:
+			t = t.Add(time.Duration(v) * time.Second)
+		case partMicrosecond:
+			t = t.Add(time.Duration(v) * time.Microsecond)
+		}
+	}
+	return t
+}
+
+//Evaluate implements the Expr interface
+func (d *DateArithmetic) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	date, err := d.Date.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	interval, err := d.Interval.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if date.isNull() || interval.isNull() {
+		return resultNull, nil
+	}
+
+	// Invalid dates and intervals evaluate to NULL, with a warning in MySQL.
+	t, dateOnly, ok := parseTemporal(date)
+	if !ok {
+		return resultNull, nil
+	}
+	values, ok := parseInterval(interval, d.Unit)
+	if !ok {
+		return resultNull, nil
+	}
+	if d.Subtract {
+		for i := range values {
+			values[i] = -values[i]
+		}
+	}
+	t = addInterval(t, values, d.Unit.parts)
+	if t.Year() < 0 || t.Year() > 9999 {
+		return resultNull, nil
+	}
+
+	dateOnly = dateOnly && d.Unit.dateOnly()
+	var result []byte
+	if dateOnly {
+		result = formatDate(t)
+	} else {
+		result = formatDatetime(t)
+	}
+	switch {
+	case date.typ == sqltypes.Date && dateOnly:
+		return EvalResult{typ: sqltypes.Date, bytes: result}, nil
+	case date.isTemporal():
+		return EvalResult{typ: sqltypes.Datetime, bytes: result}, nil
+	}
+	return newResultString(result, CollationGeneralCI), nil
+}
+
+//Type implements the Expr interface
+func (d *DateArithmetic) Type(env ExpressionEnv) (querypb.Type, error) {
+	typ, err := d.Date.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	switch typ {
+	case sqltypes.Date:
+		if d.Unit.dateOnly() {
+			return sqltypes.Date, nil
+		}
+		return sqltypes.Datetime, nil
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return sqltypes.Datetime, nil
+	}
+	return sqltypes.VarChar, nil
+}
+
+//String implements the Expr interface
+func (d *DateArithmetic) String() string {
+	name := "date_add"
+	if d.Subtract {
+		name = "date_sub"
+	}
+	return name + "(" + d.Date.String() + ", interval " + d.Interval.String() + " " + d.Unit.Name + ")"
+}
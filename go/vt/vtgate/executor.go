@@ -52,6 +52,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/quota"
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
@@ -105,6 +107,17 @@ type Executor struct {
 	vschemaStats *VSchemaStats
 
 	vm *VSchemaManager
+
+	// tableStats is nil if the table statistics are not tracked.
+	tableStats *vtschema.Tracker
+	// lookupCaches is nil if the caches of the lookup
+	// vindexes are not invalidated by their lookup tables.
+	lookupCaches *lookupCaches
+	// resultCache is nil if the results of
+	// the selects are not cached.
+	resultCache *resultCache
+	// quotas is nil if the queries are not limited by quotas.
+	quotas *quota.Quotas
 }
 
 var executorOnce sync.Once
@@ -184,6 +197,12 @@ func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType
 }
 
 func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+	release, err := e.acquireQuota(ctx, sql)
+	if err != nil {
+		return sqlparser.Preview(sql), nil, err
+	}
+	defer release()
+
 	stmtType, qr, err := e.newExecute(ctx, safeSession, sql, bindVars, logStats)
 	if err == planbuilder.ErrPlanNotSupported {
 		return e.legacyExecute(ctx, safeSession, sql, bindVars, logStats)
@@ -284,6 +303,8 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = options.SqlSelectLimit
 			})
 			bindVars[key] = sqltypes.Int64BindVariable(v)
+		case sysvars.MaxExecutionTime.Name:
+			bindVars[key] = sqltypes.Int64BindVariable(session.QueryTimeout)
 		case sysvars.TransactionMode.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.TransactionMode.String())
 		case sysvars.Workload.Name:
@@ -1078,6 +1099,13 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	logStats.StmtType = stmtType.String()
 	defer logStats.Send()
 
+	release, err := e.acquireQuota(ctx, sql)
+	if err != nil {
+		logStats.Error = err
+		return err
+	}
+	defer release()
+
 	if bindVars == nil {
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
@@ -1122,6 +1150,9 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 
+	qt := startQueryTimeout(vcursor, plan, safeSession)
+	defer qt.stop()
+
 	// Some of the underlying primitives may send results one row at a time.
 	// So, we need the ability to consolidate those into reasonable chunks.
 	// The callback wrapper below accumulates rows and sends them as chunks
@@ -1131,6 +1162,9 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	seenResults := false
 	var foundRows uint64
 	err = plan.Instructions.StreamExecute(vcursor, bindVars, true, func(qr *sqltypes.Result) error {
+		if qt.expired() {
+			return errQueryTimeout
+		}
 		// If the row has field info, send it separately.
 		// TODO(sougou): this behavior is for handling tests because
 		// the framework currently sends all results as one packet.
@@ -1162,6 +1196,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		}
 		return nil
 	})
+	err = qt.check(err)
 
 	// Send left-over rows if there is no error on execution.
 	if err == nil {
@@ -1238,6 +1273,12 @@ func (e *Executor) VSchema() *vindexes.VSchema {
 func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
+	if e.lookupCaches != nil {
+		e.lookupCaches.setVSchema(vschema)
+	}
+	if e.resultCache != nil {
+		e.resultCache.setVSchema(vschema)
+	}
 	e.vschema = vschema
 	e.vschemaStats = stats
 	e.plans.Clear()
@@ -1248,6 +1289,73 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 
 }
 
+// setLookupCaches makes the lookup vindex caches of
+// the current and future vschemas invalidated by lc.
+func (e *Executor) setLookupCaches(lc *lookupCaches) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lookupCaches = lc
+	if e.vschema != nil {
+		lc.setVSchema(e.vschema)
+	}
+}
+
+// acquireQuota checks that a query is within the quotas of its callers
+// and its query tag. The release function must be called once it has
+// been executed. Commits and rollbacks are not limited, so that the
+// transactions that are open can always be ended.
+func (e *Executor) acquireQuota(ctx context.Context, sql string) (release func(), err error) {
+	if e.quotas == nil {
+		return func() {}, nil
+	}
+	switch sqlparser.Preview(sql) {
+	case sqlparser.StmtCommit, sqlparser.StmtRollback:
+		return func() {}, nil
+	}
+	_, comments := sqlparser.SplitMarginComments(sql)
+	return e.quotas.Acquire(quota.Query{
+		ImmediateCaller: callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx)),
+		EffectiveCaller: callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)),
+		Tag:             sqlparser.QueryTag(comments),
+	})
+}
+
+// setResultCache makes the results of the selects on the
+// tables of the current and future vschemas cached in rc.
+func (e *Executor) setResultCache(rc *resultCache) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.resultCache = rc
+	if e.vschema != nil {
+		rc.setVSchema(e.vschema)
+	}
+}
+
+// TableStats returns the statistics of a table, or nil if they are not known.
+func (e *Executor) TableStats(keyspace, table string) *vtschema.TableStats {
+	return e.tableStats.Stats(keyspace, table)
+}
+
+// tableStatsChanged marks the cached plans that were built with the
+// statistics of the tables of a keyspace as stale, so that they are
+// built again with the new statistics.
+func (e *Executor) tableStatsChanged(keyspace string, tables []string) {
+	changed := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		changed[keyspace+"."+table] = true
+	}
+	e.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*engine.Plan)
+		for _, table := range plan.TableStats {
+			if changed[table] {
+				plan.MarkStale()
+				break
+			}
+		}
+		return true
+	})
+}
+
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
 func (e *Executor) ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, dest, err := topoproto.ParseDestination(targetString, defaultTabletType)
@@ -1303,7 +1411,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 
 	planKey := vcursor.planPrefixKey() + ":" + query
-	if plan, ok := e.plans.Get(planKey); ok {
+	if plan, ok := e.plans.Get(planKey); ok && !plan.(*engine.Plan).IsStale() {
 		return plan.(*engine.Plan), nil
 	}
 
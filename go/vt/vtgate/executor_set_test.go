@@ -165,6 +165,15 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set sql_select_limit = 'asdfasfd'",
 		err: "failed to evaluate value for sql_select_limit: expected int, unexpected value type: string",
+	}, {
+		in:  "set max_execution_time = 100",
+		out: &vtgatepb.Session{Autocommit: true, QueryTimeout: 100},
+	}, {
+		in:  "set max_execution_time = DEFAULT",
+		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set max_execution_time = -1",
+		err: "invalid max_execution_time: -1",
 	}, {
 		in:  "set autocommit = 1+1",
 		err: "System setting 'autocommit' can't be set to this value: 2 is not a boolean",
@@ -219,6 +228,12 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set transaction isolation level serializable",
 		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set transaction_isolation = 'consistent_snapshot'",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY}},
+	}, {
+		in:  "set @@tx_isolation = CONSISTENT_SNAPSHOT",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY}},
 	}, {
 		in:  "set transaction read only",
 		out: &vtgatepb.Session{Autocommit: true},
@@ -363,6 +378,25 @@ func TestExecutorSetOp(t *testing.T) {
 	}
 }
 
+func TestExecutorSetConsistentSnapshot(t *testing.T) {
+	executor, _, _, sbclookup := createLegacyExecutorEnv()
+	*sysVarSetEnabled = true
+	session := NewAutocommitSession(masterSession)
+	session.TargetString = KsTestUnsharded
+	session.EnableSystemSettings = true
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set transaction_isolation = 'consistent_snapshot'", nil)
+	require.NoError(t, err)
+	assert.True(t, session.InConsistentSnapshot())
+
+	// An isolation level of MySQL ends the consistent snapshots.
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("transaction_isolation", "varchar"), "READ-COMMITTED")})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set transaction_isolation = 'read-committed'", nil)
+	require.NoError(t, err)
+	assert.False(t, session.InConsistentSnapshot())
+	assert.Equal(t, map[string]string{"transaction_isolation": "'READ-COMMITTED'"}, session.SystemVariables)
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -435,7 +469,7 @@ func TestPlanExecutorSetUDV(t *testing.T) {
 		out: &vtgatepb.Session{UserDefinedVariables: createMap([]string{"foo"}, []interface{}{2}), Autocommit: true},
 	}, {
 		in:  "set @foo = 2.1, @bar = 'baz'",
-		out: &vtgatepb.Session{UserDefinedVariables: createMap([]string{"foo", "bar"}, []interface{}{2.1, "baz"}), Autocommit: true},
+		out: &vtgatepb.Session{UserDefinedVariables: createMap([]string{"foo", "bar"}, []interface{}{sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.1")), "baz"}), Autocommit: true},
 	}}
 	for _, tcase := range testcases {
 		t.Run(tcase.in, func(t *testing.T) {
@@ -465,7 +499,7 @@ func TestSetUDVFromTabletInput(t *testing.T) {
 	defer func() {
 		masterSession.TargetString = ""
 	}()
-	_, err := executorExec(executor, "set @foo = concat('a','b','c')", nil)
+	_, err := executorExec(executor, "set @foo = md5('a')", nil)
 	require.NoError(t, err)
 
 	want := map[string]*querypb.BindVariable{"foo": sqltypes.StringBindVariable("abc")}
@@ -1488,6 +1488,31 @@ func getPlanCached(t *testing.T, e *Executor, vcursor *vcursorImpl, sql string,
 	return plan, logStats
 }
 
+func TestGetPlanTableStatsChanged(t *testing.T) {
+	r, _, _, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{
+		TargetString: "@master",
+		Options:      &querypb.ExecuteOptions{PlannerVersion: querypb.ExecuteOptions_Gen4},
+	})
+	vc, _ := newVCursorImpl(ctx, session, makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil)
+
+	query := "select * from user where id = 1"
+	plan1, _ := getPlanCached(t, r, vc, query, makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.Equal(t, []string{"TestExecutor.user"}, plan1.TableStats)
+
+	// The plans are only built again when the statistics of their tables change.
+	r.tableStatsChanged("TestExecutor", []string{"music"})
+	r.tableStatsChanged(KsTestUnsharded, []string{"user"})
+	plan2, _ := getPlanCached(t, r, vc, query, makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.True(t, plan1 == plan2, "plans must be equal")
+
+	r.tableStatsChanged("TestExecutor", []string{"music", "user"})
+	plan3, _ := getPlanCached(t, r, vc, query, makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.True(t, plan1 != plan3, "plans must not be equal")
+	plan4, _ := getPlanCached(t, r, vc, query, makeComments(""), map[string]*querypb.BindVariable{}, false)
+	assert.True(t, plan3 == plan4, "plans must be equal")
+}
+
 func TestGetPlanCacheUnnormalized(t *testing.T) {
 	r, _, _, _ := createLegacyExecutorEnv()
 	emptyvc, _ := newVCursorImpl(ctx, NewSafeSession(&vtgatepb.Session{TargetString: "@unknown"}), makeComments(""), r, nil, r.vm, r.VSchema(), r.resolver.resolver, nil)
@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// vstreamFunc streams the events of vgtid that match filter.
+type vstreamFunc func(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func(events []*binlogdatapb.VEvent) error) error
+
+// lookupCaches invalidates the caches of the lookup vindexes when their
+// lookup tables change. It streams the changes of every lookup table from
+// the master tablets, which has the writes of the other VTGates too, and
+// invalidates the ids of the rows that changed. The vindexes that are
+// unchanged when the vschema is rebuilt keep their cached lookups.
+type lookupCaches struct {
+	vstream    vstreamFunc
+	retryDelay time.Duration
+
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	// caches are the caches of the current vschema, by keyspace and vindex.
+	caches map[string]*vindexes.LookupCache
+	// streams are the streams of the lookup tables.
+	streams map[keyspaceTable]*lookupTableStream
+}
+
+// keyspaceTable is the keyspace and the name of a table.
+type keyspaceTable struct {
+	keyspace string
+	name     string
+}
+
+func (table keyspaceTable) String() string {
+	return table.keyspace + "." + table.name
+}
+
+func newLookupCaches(vstream vstreamFunc) *lookupCaches {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &lookupCaches{
+		vstream:    vstream,
+		retryDelay: 5 * time.Second,
+		ctx:        ctx,
+		cancel:     cancel,
+		caches:     make(map[string]*vindexes.LookupCache),
+		streams:    make(map[keyspaceTable]*lookupTableStream),
+	}
+}
+
+// setVSchema must be called with every new vschema, before it is used.
+// It makes the caches of the vindexes that did not change reuse their
+// cached lookups, and streams the lookup tables of the new vschema.
+func (lc *lookupCaches) setVSchema(vschema *vindexes.VSchema) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if lc.ctx.Err() != nil {
+		return
+	}
+
+	caches := make(map[string]*vindexes.LookupCache)
+	tableCaches := make(map[keyspaceTable][]*vindexes.LookupCache)
+	for ksName, ks := range vschema.Keyspaces {
+		for name, vindex := range ks.Vindexes {
+			cached, ok := vindex.(vindexes.CachedLookup)
+			if !ok || cached.LookupCache() == nil {
+				continue
+			}
+			cache := cached.LookupCache()
+			key := ksName + "." + name
+			table, err := findLookupTable(vschema, cache.Table())
+			if err != nil {
+				// The changes of the other VTGates would not be seen.
+				log.Warningf("The lookups of vindex %s are not cached, as its lookup table can't be streamed to invalidate them: %v", key, err)
+				cache.Disable()
+				cache.Close()
+				continue
+			}
+			if old := lc.caches[key]; cache.Reuse(old) {
+				delete(lc.caches, key)
+			}
+			caches[key] = cache
+			tableCaches[table] = append(tableCaches[table], cache)
+		}
+	}
+	for _, cache := range lc.caches {
+		cache.Close()
+	}
+	lc.caches = caches
+
+	for table, stream := range lc.streams {
+		if _, ok := tableCaches[table]; !ok {
+			stream.cancel()
+			delete(lc.streams, table)
+		}
+	}
+	for table, caches := range tableCaches {
+		stream := lc.streams[table]
+		if stream == nil {
+			ctx, cancel := context.WithCancel(lc.ctx)
+			stream = &lookupTableStream{table: table, cancel: cancel}
+			lc.streams[table] = stream
+			go lc.run(ctx, stream)
+		}
+		stream.setCaches(caches)
+	}
+}
+
+// stop stops streaming the lookup tables.
+func (lc *lookupCaches) stop() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.cancel()
+}
+
+// run streams the changes of a lookup table until ctx is canceled.
+func (lc *lookupCaches) run(ctx context.Context, stream *lookupTableStream) {
+	streamTable(ctx, lc.vstream, lc.retryDelay, stream.table, stream.send, stream.restart)
+}
+
+// streamTable streams the changes of table to send until ctx is canceled.
+// The stream starts from the current position, and is retried after
+// retryDelay when it fails. restart is called before it is retried, as the
+// changes are missed until then.
+func streamTable(ctx context.Context, vstream vstreamFunc, retryDelay time.Duration, table keyspaceTable, send func(events []*binlogdatapb.VEvent) error, restart func()) {
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: table.keyspace, Gtid: "current"}},
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: table.name}},
+	}
+	for {
+		err := vstream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, send)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warningf("Streaming table %v failed, retrying in %v: %v", table, retryDelay, err)
+		restart()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+// lookupTableStream invalidates the caches of the vindexes
+// of a lookup table with the changes streamed from it.
+type lookupTableStream struct {
+	table  keyspaceTable
+	cancel context.CancelFunc
+
+	mu        sync.Mutex
+	caches    []*vindexes.LookupCache
+	streaming bool
+	fields    []*querypb.Field
+}
+
+func (stream *lookupTableStream) setCaches(caches []*vindexes.LookupCache) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	stream.caches = caches
+}
+
+// restart clears the caches, and marks the stream as not started,
+// so that they are cleared again once it is.
+func (stream *lookupTableStream) restart() {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	stream.streaming = false
+	stream.fields = nil
+	for _, cache := range stream.caches {
+		cache.Clear()
+	}
+}
+
+func (stream *lookupTableStream) send(events []*binlogdatapb.VEvent) error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	if !stream.streaming {
+		// The caches can have lookups from before the
+		// position that the stream started from.
+		stream.streaming = true
+		for _, cache := range stream.caches {
+			cache.Clear()
+		}
+	}
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_FIELD:
+			stream.fields = event.FieldEvent.Fields
+		case binlogdatapb.VEventType_ROW:
+			for _, change := range event.RowEvent.RowChanges {
+				stream.invalidate(change.Before)
+				stream.invalidate(change.After)
+			}
+		}
+	}
+	return nil
+}
+
+func (stream *lookupTableStream) invalidate(row *querypb.Row) {
+	if row == nil {
+		return
+	}
+	values := sqltypes.MakeRowTrusted(stream.fields, row)
+	for _, cache := range stream.caches {
+		found := false
+		for i, field := range stream.fields {
+			if i < len(values) && strings.EqualFold(field.Name, cache.Column()) {
+				cache.Invalidate(values[i])
+				found = true
+			}
+		}
+		if !found {
+			cache.Clear()
+		}
+	}
+}
+
+// findLookupTable returns the lookup table of a vindex, which is
+// found in the vschema if it is not qualified by its keyspace.
+func findLookupTable(vschema *vindexes.VSchema, name string) (keyspaceTable, error) {
+	keyspace, table, err := sqlparser.ParseTable(name)
+	if err != nil || keyspace != "" {
+		return keyspaceTable{keyspace: keyspace, name: table}, err
+	}
+	t, err := vschema.FindTable("", table)
+	if err != nil {
+		return keyspaceTable{}, err
+	}
+	return keyspaceTable{keyspace: t.Keyspace.Name, name: table}, nil
+}
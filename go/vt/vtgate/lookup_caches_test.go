@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeTableStreams serves the streams of the tables.
+type fakeTableStreams struct {
+	started chan string
+	sends   map[string]func([]*binlogdatapb.VEvent) error
+	errs    chan error
+}
+
+func newFakeTableStreams() *fakeTableStreams {
+	return &fakeTableStreams{
+		started: make(chan string, 10),
+		sends:   make(map[string]func([]*binlogdatapb.VEvent) error),
+		errs:    make(chan error),
+	}
+}
+
+func (f *fakeTableStreams) vstream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func(events []*binlogdatapb.VEvent) error) error {
+	table := vgtid.ShardGtids[0].Keyspace + "." + filter.Rules[0].Match
+	f.sends[table] = send
+	f.started <- table
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-f.errs:
+		return err
+	}
+}
+
+func (f *fakeTableStreams) waitForStream(t *testing.T) string {
+	t.Helper()
+	select {
+	case table := <-f.started:
+		return table
+	case <-time.After(5 * time.Second):
+		t.Fatal("the table is not streamed")
+	}
+	return ""
+}
+
+func lookupCachesVSchema(t *testing.T, cacheSize string) *vindexes.VSchema {
+	t.Helper()
+	return lookupCachesTableVSchema(t, "name_lkp", cacheSize)
+}
+
+func lookupCachesTableVSchema(t *testing.T, table, cacheSize string) *vindexes.VSchema {
+	t.Helper()
+	vschema, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+					"name_lkp": {
+						Type:   "lookup_unique",
+						Params: map[string]string{"table": table, "from": "name", "to": "keyspace_id", "cache_size": cacheSize},
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"name_lkp": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "name", Name: "hash"}}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return vschema
+}
+
+func lookupRowEvent(names ...string) []*binlogdatapb.VEvent {
+	fields := sqltypes.MakeTestFields("keyspace_id|name", "varbinary|varchar")
+	event := &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "ks.name_lkp"}}
+	for _, name := range names {
+		row := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarBinary("\x01"), sqltypes.NewVarChar(name)})
+		event.RowEvent.RowChanges = append(event.RowEvent.RowChanges, &binlogdatapb.RowChange{After: row})
+	}
+	return []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.name_lkp", Fields: fields}},
+		event,
+	}
+}
+
+func cachedNames(vindex vindexes.SingleColumn, names ...string) []string {
+	var cached []string
+	vc := &lookupCachesVCursor{}
+	for _, name := range names {
+		vc.queries = 0
+		if _, err := vindex.Map(vc, []sqltypes.Value{sqltypes.NewVarChar(name)}); err == nil && vc.queries == 0 {
+			cached = append(cached, name)
+		}
+	}
+	return cached
+}
+
+func TestLookupCaches(t *testing.T) {
+	streams := newFakeTableStreams()
+	lc := newLookupCaches(streams.vstream)
+	lc.retryDelay = time.Millisecond
+	defer lc.stop()
+
+	vschema := lookupCachesVSchema(t, "10")
+	lc.setVSchema(vschema)
+	assert.Equal(t, "ks.name_lkp", streams.waitForStream(t))
+	vindex := vschema.Keyspaces["ks"].Vindexes["name_lkp"].(vindexes.SingleColumn)
+
+	// The names are cached once they are looked up.
+	names := []string{"a", "b", "c"}
+	require.Empty(t, cachedNames(vindex, names...))
+	require.Equal(t, names, cachedNames(vindex, names...))
+
+	// The first events clear the cache.
+	require.NoError(t, streams.sends["ks.name_lkp"](nil))
+	require.Empty(t, cachedNames(vindex, names...))
+
+	// The names of the rows that changed are invalidated.
+	require.NoError(t, streams.sends["ks.name_lkp"](lookupRowEvent("a", "c")))
+	assert.Equal(t, []string{"b"}, cachedNames(vindex, names...))
+
+	// A new vschema with the same vindex keeps the cached names.
+	vschema = lookupCachesVSchema(t, "10")
+	lc.setVSchema(vschema)
+	vindex = vschema.Keyspaces["ks"].Vindexes["name_lkp"].(vindexes.SingleColumn)
+	assert.Equal(t, names, cachedNames(vindex, names...))
+	require.NoError(t, streams.sends["ks.name_lkp"](lookupRowEvent("b")))
+	assert.Equal(t, []string{"a", "c"}, cachedNames(vindex, names...))
+
+	// The cache is cleared when the stream fails.
+	streams.errs <- fmt.Errorf("stream failed")
+	assert.Equal(t, "ks.name_lkp", streams.waitForStream(t))
+	assert.Empty(t, cachedNames(vindex, "a"))
+
+	// The lookup table is not streamed when its lookups are not cached anymore.
+	lc.setVSchema(lookupCachesVSchema(t, ""))
+	assert.Empty(t, lc.streams)
+	assert.Empty(t, lc.caches)
+}
+
+func TestLookupCachesUnknownTable(t *testing.T) {
+	streams := newFakeTableStreams()
+	lc := newLookupCaches(streams.vstream)
+	defer lc.stop()
+
+	// The lookup table is not in the vschema, so it can't be streamed.
+	vschema := lookupCachesTableVSchema(t, "other_lkp", "10")
+	lc.setVSchema(vschema)
+	assert.Empty(t, lc.streams)
+	assert.Empty(t, lc.caches)
+
+	// The lookups are not cached, as they would not be invalidated.
+	vindex := vschema.Keyspaces["ks"].Vindexes["name_lkp"].(vindexes.SingleColumn)
+	names := []string{"a", "b"}
+	require.Empty(t, cachedNames(vindex, names...))
+	assert.Empty(t, cachedNames(vindex, names...))
+
+	// The lookups are cached once the lookup table can be streamed.
+	vschema = lookupCachesVSchema(t, "10")
+	lc.setVSchema(vschema)
+	assert.Equal(t, "ks.name_lkp", streams.waitForStream(t))
+	vindex = vschema.Keyspaces["ks"].Vindexes["name_lkp"].(vindexes.SingleColumn)
+	require.Empty(t, cachedNames(vindex, names...))
+	assert.Equal(t, names, cachedNames(vindex, names...))
+}
+
+// lookupCachesVCursor counts the lookup queries,
+// and finds every name that is looked up.
+type lookupCachesVCursor struct {
+	vindexes.VCursor
+	queries int
+}
+
+func (vc *lookupCachesVCursor) InTransaction() bool {
+	return false
+}
+
+func (vc *lookupCachesVCursor) InTransactionAndIsDML() bool {
+	return false
+}
+
+func (vc *lookupCachesVCursor) TabletType() topodatapb.TabletType {
+	return topodatapb.TabletType_MASTER
+}
+
+func (vc *lookupCachesVCursor) Execute(method string, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
+	vc.queries++
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("name|keyspace_id", "varchar|varbinary"), "x|\x01"), nil
+}
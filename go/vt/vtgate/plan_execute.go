@@ -112,14 +112,53 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 		return 0, nil, err
 	}
 
+	qt := startQueryTimeout(vcursor, plan, safeSession)
+	defer qt.stop()
+	stmtType, qr, err := e.executeWithPlan(ctx, plan, vcursor, bindVars, execStart, logStats, safeSession)
+	if err = qt.check(err); err != nil {
+		return stmtType, nil, err
+	}
+	return stmtType, qr, nil
+}
+
+func (e *Executor) executeWithPlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time, logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
 	if plan.Instructions.NeedsTransaction() {
 		return e.insideTransaction(ctx, safeSession, logStats,
 			e.executePlan(ctx, plan, vcursor, bindVars, execStart))
 	}
 
+	if plan.ResultCache != nil && e.resultCache != nil && !safeSession.InTransaction() && !safeSession.InReservedConn() {
+		return e.executeCachedPlan(ctx, plan, vcursor, bindVars, execStart, logStats, safeSession)
+	}
+
 	return e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
 }
 
+// executeCachedPlan serves the result of a plan from the result cache, or
+// executes it and caches its result.
+func (e *Executor) executeCachedPlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time, logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
+	key := resultCacheKey(ctx, vcursor.planPrefixKey(), plan.Original, bindVars)
+	if qr, ok := e.resultCache.get(key, plan.ResultCache); ok {
+		logStats.CachedResult = true
+		logStats.Keyspace = plan.Instructions.GetKeyspaceName()
+		logStats.Table = plan.Instructions.GetTableName()
+		logStats.TabletType = vcursor.TabletType().String()
+		e.logExecutionEnd(logStats, execStart, plan, nil, qr)
+		plan.AddStats(1, time.Since(logStats.StartTime), 0, logStats.RowsAffected, logStats.RowsReturned, 0)
+		return plan.Type, qr, nil
+	}
+
+	// The generations of the tables are read before the plan is executed,
+	// so that its result is not used if they change in the meantime.
+	generations, cacheable := e.resultCache.generations(plan.ResultCache.Tables)
+	stmtType, qr, err := e.executePlan(ctx, plan, vcursor, bindVars, execStart)(logStats, safeSession)
+	// The results that have warnings can be partial.
+	if err == nil && cacheable && len(safeSession.GetWarnings()) == 0 {
+		e.resultCache.set(key, plan.ResultCache, generations, qr)
+	}
+	return stmtType, qr, err
+}
+
 func (e *Executor) startTxIfNecessary(ctx context.Context, safeSession *SafeSession) error {
 	if !safeSession.Autocommit && !safeSession.InTransaction() {
 		if err := e.txConn.Begin(ctx, safeSession); err != nil {
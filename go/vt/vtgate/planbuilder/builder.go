@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -54,6 +55,13 @@ type ContextVSchema interface {
 	AllKeyspace() ([]*vindexes.Keyspace, error)
 	GetSemTable() *semantics.SemTable
 	Planner() PlannerVersion
+
+	// TableStats returns the statistics of a table, or nil if they are not known.
+	TableStats(table *vindexes.Table) *schema.TableStats
+
+	// MaxMemoryRows returns the maximum number of rows
+	// that vtgate can hold in memory for a query.
+	MaxMemoryRows() int
 }
 
 // PlannerVersion is an alias here to make the code more readable
@@ -96,20 +104,66 @@ var ErrPlanNotSupported = errors.New("plan building not supported")
 
 // BuildFromStmt builds a plan based on the AST provided.
 func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchema, bindVarNeeds *sqlparser.BindVarNeeds) (*engine.Plan, error) {
-
-	instruction, err := createInstructionFor(query, stmt, vschema)
+	// The planners can rewrite stmt.
+	resultCache := resultCacheFor(stmt, vschema, bindVarNeeds)
+	maxExecutionTime := maxExecutionTimeFor(stmt)
+	recorder := &tableStatsRecorder{ContextVSchema: vschema, tables: make(map[string]bool)}
+	instruction, err := createInstructionFor(query, stmt, recorder)
 	if err != nil {
 		return nil, err
 	}
 	plan := &engine.Plan{
-		Type:         sqlparser.ASTToStatementType(stmt),
-		Original:     query,
-		Instructions: instruction,
-		BindVarNeeds: bindVarNeeds,
+		Type:             sqlparser.ASTToStatementType(stmt),
+		Original:         query,
+		Instructions:     instruction,
+		BindVarNeeds:     bindVarNeeds,
+		ResultCache:      resultCache,
+		MaxExecutionTime: maxExecutionTime,
+		TableStats:       recorder.tableNames(),
 	}
 	return plan, nil
 }
 
+// tableStatsRecorder records the tables whose statistics are used to
+// build a plan, so that the plan can be built again when they change.
+type tableStatsRecorder struct {
+	ContextVSchema
+	tables map[string]bool
+}
+
+// TableStats implements the ContextVSchema interface.
+func (r *tableStatsRecorder) TableStats(table *vindexes.Table) *schema.TableStats {
+	r.tables[table.Keyspace.Name+"."+table.Name.String()] = true
+	return r.ContextVSchema.TableStats(table)
+}
+
+// tableNames returns the recorded tables, qualified by their keyspace.
+func (r *tableStatsRecorder) tableNames() []string {
+	if len(r.tables) == 0 {
+		return nil
+	}
+	tables := make([]string, 0, len(r.tables))
+	for table := range r.tables {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// maxExecutionTimeFor returns the MAX_EXECUTION_TIME optimizer hint of stmt.
+// Like in MySQL, it is only read from the first select of a statement.
+func maxExecutionTimeFor(stmt sqlparser.Statement) int {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return sqlparser.MaxExecutionTime(stmt.Comments)
+	case *sqlparser.Union:
+		return maxExecutionTimeFor(stmt.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return maxExecutionTimeFor(stmt.Select)
+	}
+	return 0
+}
+
 func getConfiguredPlanner(vschema ContextVSchema) (selectPlanner, error) {
 	switch vschema.Planner() {
 	case V3:
@@ -143,7 +197,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 		if err != nil {
 			return nil, err
 		}
-		return buildRoutePlan(stmt, vschema, configuredPlanner(query))
+		return buildRoutePlan(stmt, vschema, buildCTEPlan(configuredPlanner(query)))
 	case *sqlparser.Insert:
 		return buildRoutePlan(stmt, vschema, buildInsertPlan)
 	case *sqlparser.Update:
@@ -151,7 +205,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 	case *sqlparser.Delete:
 		return buildRoutePlan(stmt, vschema, buildDeletePlan)
 	case *sqlparser.Union:
-		return buildRoutePlan(stmt, vschema, buildUnionPlan)
+		return buildRoutePlan(stmt, vschema, buildCTEPlan(buildUnionPlan))
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, vschema)
 	case *sqlparser.AlterVschema:
@@ -0,0 +1,438 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var errCorrelatedSubquery = errors.New("unsupported: cross-shard correlated subquery")
+
+// correlatedSubquery is a subquery of the WHERE clause or of the
+// select list that references columns of the outer query.
+type correlatedSubquery struct {
+	// construct is the expression that the result of the subquery
+	// stands for: an EXISTS, an IN or NOT IN comparison, or the
+	// subquery itself.
+	construct sqlparser.Expr
+	opcode    engine.PulloutOpcode
+	sel       *sqlparser.Select
+	// left is the left side of an IN or NOT IN comparison.
+	left sqlparser.Expr
+	// refs are the columns of the outer query referenced by the subquery.
+	refs []*sqlparser.ColName
+}
+
+// buildCorrelatedSubqueryPlan plans a select with correlated subqueries
+// that can't be merged with the route of the outer query. The outer query
+// is sent without the expressions that contain them, but with the columns
+// they need. The subqueries are then evaluated for every outer row by
+// engine.CorrelatedSubquery, and the expressions are evaluated by a Filter
+// and a Projection.
+func buildCorrelatedSubqueryPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	jt := newJointab(sqlparser.GetBindvars(sel))
+	outerTables := tableAliases(sel.From, false)
+
+	var pushed, deferred []sqlparser.Expr
+	var subqueries []*correlatedSubquery
+	if sel.Where != nil {
+		for _, expr := range splitAndExpression(nil, sel.Where.Expr) {
+			found, err := findCorrelatedSubqueries(expr, outerTables)
+			if err != nil {
+				return nil, err
+			}
+			if len(found) == 0 {
+				pushed = append(pushed, expr)
+				continue
+			}
+			deferred = append(deferred, expr)
+			subqueries = append(subqueries, found...)
+		}
+	}
+	projected := make(map[int]sqlparser.Expr)
+	for i, selectExpr := range sel.SelectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		found, err := findCorrelatedSubqueries(aliased.Expr, outerTables)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) != 0 {
+			projected[i] = aliased.Expr
+			subqueries = append(subqueries, found...)
+		}
+	}
+	if len(subqueries) == 0 {
+		return nil, errCorrelatedSubquery
+	}
+	if err := checkCorrelatedOuterSelect(sel, projected, outerTables); err != nil {
+		return nil, err
+	}
+
+	// The outer query returns the original select expressions, followed by the
+	// columns needed to evaluate the subqueries and the expressions that contain them.
+	columns := make([]string, len(sel.SelectExprs))
+	offsets := make(map[string]int)
+	for i, selectExpr := range sel.SelectExprs {
+		aliased := selectExpr.(*sqlparser.AliasedExpr)
+		columns[i] = aliased.As.String()
+		if columns[i] == "" {
+			if col, ok := aliased.Expr.(*sqlparser.ColName); ok {
+				columns[i] = col.Name.String()
+			} else {
+				columns[i] = sqlparser.String(aliased.Expr)
+			}
+		}
+		if _, ok := projected[i]; ok {
+			sel.SelectExprs[i] = &sqlparser.AliasedExpr{Expr: &sqlparser.NullVal{}, As: aliased.As}
+			continue
+		}
+		if aliased.As.IsEmpty() {
+			offsets[sqlparser.String(aliased.Expr)] = i
+		}
+	}
+	addColumn := func(expr sqlparser.Expr) int {
+		key := sqlparser.String(expr)
+		if offset, ok := offsets[key]; ok {
+			return offset
+		}
+		offset := len(sel.SelectExprs)
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: expr})
+		offsets[key] = offset
+		return offset
+	}
+
+	constructs := make(map[sqlparser.Expr]bool, len(subqueries))
+	for _, sq := range subqueries {
+		constructs[sq.construct] = true
+	}
+	outerColumns := func(expr sqlparser.Expr) {
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case *sqlparser.ColName:
+				addColumn(node)
+			case *sqlparser.Subquery:
+				return false, nil
+			case sqlparser.Expr:
+				return !constructs[node], nil
+			}
+			return true, nil
+		}, expr)
+	}
+	for _, expr := range deferred {
+		outerColumns(expr)
+	}
+	for i := range sel.SelectExprs {
+		if expr, ok := projected[i]; ok {
+			outerColumns(expr)
+		}
+	}
+
+	csqs := make([]*engine.CorrelatedSubquery, 0, len(subqueries))
+	for _, sq := range subqueries {
+		csq := &engine.CorrelatedSubquery{Opcode: sq.opcode}
+		if sq.left != nil {
+			csq.Left = addColumn(sq.left)
+		}
+		if !sq.batch(csq, jt, addColumn) {
+			csq.Vars = make(map[string]int, len(sq.refs))
+			argNames := make(map[*sqlparser.ColName]string, len(sq.refs))
+			for _, ref := range sq.refs {
+				argNames[ref] = correlatedVarName(jt, ref)
+				csq.Vars[argNames[ref]] = addColumn(ref)
+			}
+			sqlparser.Rewrite(sq.sel, func(cursor *sqlparser.Cursor) bool {
+				if col, ok := cursor.Node().(*sqlparser.ColName); ok && argNames[col] != "" {
+					cursor.Replace(sqlparser.NewArgument([]byte(":" + argNames[col])))
+				}
+				return true
+			}, nil)
+		}
+		subqueryPlan, err := buildSelectPlan(sqlparser.String(sq.sel))(sq.sel, vschema)
+		if err != nil {
+			return nil, err
+		}
+		csq.Subquery = subqueryPlan
+		csqs = append(csqs, csq)
+	}
+
+	// The LIMIT can only be sent with the outer query if
+	// none of its rows are filtered out by the vtgate.
+	limit := sel.Limit
+	if len(deferred) != 0 {
+		sel.Limit = nil
+	}
+	sel.Where = nil
+	for _, expr := range pushed {
+		sel.AddWhere(expr)
+	}
+	outerPlan, err := buildSelectPlan(sqlparser.String(sel))(sel, vschema)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := outerPlan
+	offset := len(sel.SelectExprs)
+	columnOf := make(map[sqlparser.Expr]int, len(subqueries))
+	for i, csq := range csqs {
+		csq.Outer = plan
+		plan = csq
+		columnOf[subqueries[i].construct] = offset + i
+	}
+	convert := func(expr sqlparser.Expr) (evalengine.Expr, error) {
+		evalExpr, err := sqlparser.ConvertWithResolver(expr, func(e sqlparser.Expr) (evalengine.Expr, error) {
+			if offset, ok := columnOf[e]; ok {
+				return evalengine.NewColumn(offset), nil
+			}
+			if col, ok := e.(*sqlparser.ColName); ok {
+				return evalengine.NewColumn(offsets[sqlparser.String(col)]), nil
+			}
+			return nil, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%v in expression: %s", errCorrelatedSubquery, sqlparser.String(expr))
+		}
+		return evalExpr, nil
+	}
+
+	if len(deferred) != 0 {
+		predicate := deferred[0]
+		for _, expr := range deferred[1:] {
+			predicate = &sqlparser.AndExpr{Left: predicate, Right: expr}
+		}
+		evalExpr, err := convert(predicate)
+		if err != nil {
+			return nil, err
+		}
+		plan = &engine.Filter{Predicate: evalExpr, Input: plan}
+	}
+	projection := &engine.Projection{Cols: columns, Input: plan}
+	for i := range columns {
+		expr, ok := projected[i]
+		if !ok {
+			projection.Exprs = append(projection.Exprs, evalengine.NewColumn(i))
+			continue
+		}
+		evalExpr, err := convert(expr)
+		if err != nil {
+			return nil, err
+		}
+		projection.Exprs = append(projection.Exprs, evalExpr)
+	}
+	plan = projection
+
+	if len(deferred) != 0 && limit != nil {
+		elimit := &engine.Limit{Input: plan}
+		if elimit.Count, err = sqlparser.NewPlanValue(limit.Rowcount); err != nil {
+			return nil, err
+		}
+		if limit.Offset != nil {
+			if elimit.Offset, err = sqlparser.NewPlanValue(limit.Offset); err != nil {
+				return nil, err
+			}
+		}
+		plan = elimit
+	}
+	return plan, nil
+}
+
+// checkCorrelatedOuterSelect returns an error if the outer query
+// can't be evaluated once its correlated subqueries are taken out.
+func checkCorrelatedOuterSelect(sel *sqlparser.Select, projected map[int]sqlparser.Expr, outerTables map[string]bool) error {
+	if sel.Distinct || len(sel.GroupBy) != 0 || sel.Having != nil || nodeHasAggregates(sel.SelectExprs) || len(windowFuncs(sel.SelectExprs)) != 0 {
+		return fmt.Errorf("%v with aggregation", errCorrelatedSubquery)
+	}
+	if sel.SQLCalcFoundRows || sel.Into != nil {
+		return errCorrelatedSubquery
+	}
+	for _, selectExpr := range sel.SelectExprs {
+		if _, ok := selectExpr.(*sqlparser.AliasedExpr); !ok {
+			return fmt.Errorf("%v with '%s'", errCorrelatedSubquery, sqlparser.String(selectExpr))
+		}
+	}
+	for _, order := range sel.OrderBy {
+		found, err := findCorrelatedSubqueries(order.Expr, outerTables)
+		if err != nil {
+			return err
+		}
+		refersTo := -1
+		switch expr := order.Expr.(type) {
+		case *sqlparser.Literal:
+			if expr.Type == sqlparser.IntVal {
+				if n, err := strconv.Atoi(string(expr.Val)); err == nil {
+					refersTo = n - 1
+				}
+			}
+		case *sqlparser.ColName:
+			if expr.Qualifier.IsEmpty() {
+				for i, selectExpr := range sel.SelectExprs {
+					if selectExpr.(*sqlparser.AliasedExpr).As.Equal(expr.Name) {
+						refersTo = i
+					}
+				}
+			}
+		}
+		if _, ok := projected[refersTo]; ok || len(found) != 0 {
+			return fmt.Errorf("%v in order by", errCorrelatedSubquery)
+		}
+	}
+	return nil
+}
+
+// findCorrelatedSubqueries returns the subqueries of expr that
+// reference the tables of the outer query.
+func findCorrelatedSubqueries(expr sqlparser.Expr, outerTables map[string]bool) ([]*correlatedSubquery, error) {
+	var found []*correlatedSubquery
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		sq := &correlatedSubquery{}
+		var subquery *sqlparser.Subquery
+		switch node := node.(type) {
+		case *sqlparser.ExistsExpr:
+			sq.construct, sq.opcode, subquery = node, engine.PulloutExists, node.Subquery
+		case *sqlparser.ComparisonExpr:
+			right, ok := node.Right.(*sqlparser.Subquery)
+			if !ok || (node.Operator != sqlparser.InOp && node.Operator != sqlparser.NotInOp) {
+				return true, nil
+			}
+			sq.construct, sq.opcode, sq.left, subquery = node, engine.PulloutIn, node.Left, right
+			if node.Operator == sqlparser.NotInOp {
+				sq.opcode = engine.PulloutNotIn
+			}
+		case *sqlparser.Subquery:
+			sq.construct, sq.opcode, subquery = node, engine.PulloutValue, node
+		default:
+			return true, nil
+		}
+
+		innerTables := tableAliases(subquery, true)
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if col, ok := node.(*sqlparser.ColName); ok && !col.Qualifier.IsEmpty() {
+				name := col.Qualifier.Name.String()
+				if outerTables[name] && !innerTables[name] {
+					sq.refs = append(sq.refs, col)
+				}
+			}
+			return true, nil
+		}, subquery)
+		if len(sq.refs) == 0 {
+			return true, nil
+		}
+		sel, ok := subquery.Select.(*sqlparser.Select)
+		if !ok {
+			return false, fmt.Errorf("%v with union", errCorrelatedSubquery)
+		}
+		sq.sel = sel
+		found = append(found, sq)
+		return false, nil
+	}, expr)
+	return found, err
+}
+
+// batch rewrites the subquery so that it's executed once for all the outer
+// rows, if it correlates with the outer query through a single equality:
+// 'select e.col from e where e.id = t.id' becomes
+// 'select e.id, e.col from e where e.id in ::__sq1'.
+// It returns false if the subquery can't be batched.
+func (sq *correlatedSubquery) batch(csq *engine.CorrelatedSubquery, jt *jointab, addColumn func(sqlparser.Expr) int) bool {
+	sel := sq.sel
+	if len(sq.refs) != 1 || sel.Where == nil || len(sel.GroupBy) != 0 || sel.Having != nil || sel.Limit != nil ||
+		nodeHasAggregates(sel.SelectExprs) || len(windowFuncs(sel.SelectExprs)) != 0 {
+		return false
+	}
+	ref := sq.refs[0]
+	filters := splitAndExpression(nil, sel.Where.Expr)
+	for i, filter := range filters {
+		cmp, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		var inner sqlparser.Expr
+		switch {
+		case cmp.Left == ref:
+			inner = cmp.Right
+		case cmp.Right == ref:
+			inner = cmp.Left
+		default:
+			continue
+		}
+		if hasSubquery(inner) {
+			return false
+		}
+
+		csq.ListVar, _ = jt.GenerateSubqueryVars()
+		csq.ListColumn = addColumn(ref)
+		filters[i] = &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InOp,
+			Left:     inner,
+			Right:    sqlparser.ListArg("::" + csq.ListVar),
+		}
+		sel.Where = nil
+		for _, filter := range filters {
+			sel.AddWhere(filter)
+		}
+		selectExprs := sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: inner}}
+		if sq.opcode != engine.PulloutExists {
+			selectExprs = append(selectExprs, sel.SelectExprs...)
+		}
+		sel.SelectExprs = selectExprs
+		sel.OrderBy = nil
+		return true
+	}
+	return false
+}
+
+// correlatedVarName returns a bind variable name for
+// an outer column that's not used by the query.
+func correlatedVarName(jt *jointab, col *sqlparser.ColName) string {
+	suffix := ""
+	for i := 1; ; i++ {
+		name := col.CompliantName(suffix)
+		if !jt.containsAny(name) {
+			return name
+		}
+		suffix = strconv.Itoa(i)
+	}
+}
+
+// tableAliases returns the names by which the tables of node can be
+// referenced. The tables of subqueries are only included if nested is set.
+func tableAliases(node sqlparser.SQLNode, nested bool) map[string]bool {
+	aliases := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if !node.As.IsEmpty() {
+				aliases[node.As.String()] = true
+			} else if name, ok := node.Expr.(sqlparser.TableName); ok {
+				aliases[name.Name.String()] = true
+			}
+			return nested, nil
+		case *sqlparser.Subquery:
+			return nested, nil
+		}
+		return true, nil
+	}, node)
+	return aliases
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"math"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// The cost model estimates the cost of a plan as the number of rows
+// read by the tablets, plus a fixed cost for every query sent to a
+// tablet. It uses the statistics of the tables, which are loaded from
+// the tablets by vtgate. When they are not known for all the tables of
+// the plans being compared, the planner falls back to joinTree.cost().
+const (
+	// queryCost is the cost of sending a query to a tablet,
+	// in number of rows.
+	queryCost = 100
+
+	// equalSelectivity is the fraction of the rows that are assumed to
+	// match an equality when the cardinality of the column is unknown.
+	equalSelectivity = 0.1
+
+	// rangeSelectivity is the fraction of the rows that are assumed to
+	// match the other predicates, like a range comparison.
+	rangeSelectivity = 1.0 / 3
+)
+
+type planEstimate struct {
+	// cost is the estimated cost of executing the plan once.
+	cost float64
+
+	// rows is the estimated number of rows returned by the plan.
+	rows float64
+}
+
+// cheaper returns true if the joinTree a is cheaper than b.
+func cheaper(a, b joinTree, semTable *semantics.SemTable) bool {
+	aEstimate, aOK := estimate(a, semTable)
+	bEstimate, bOK := estimate(b, semTable)
+	if aOK && bOK {
+		return aEstimate.cost < bEstimate.cost
+	}
+	return a.cost() < b.cost()
+}
+
+// estimate returns the estimated cost of a joinTree and the number of
+// rows it returns. It returns false if the statistics of any of the
+// tables are not known.
+func estimate(tree joinTree, semTable *semantics.SemTable) (planEstimate, bool) {
+	switch node := tree.(type) {
+	case *routePlan:
+		return node.estimate(semTable)
+	case *joinPlan:
+		lhs, ok := estimate(node.lhs, semTable)
+		if !ok {
+			return planEstimate{}, false
+		}
+		rhs, ok := estimate(node.rhs, semTable)
+		if !ok {
+			return planEstimate{}, false
+		}
+		if node.hashJoin {
+			// Both sides are executed once, and the rows
+			// of the LHS are loaded in a hash table.
+			return planEstimate{
+				cost: lhs.cost + rhs.cost + lhs.rows,
+				rows: math.Max(1, lhs.rows*rhs.rows*selectivity(node.hashPredicate, tree, semTable)),
+			}, true
+		}
+		// The RHS is executed once for every row of the LHS,
+		// and the join predicates have already been pushed to it.
+		return planEstimate{
+			cost: lhs.cost + lhs.rows*rhs.cost,
+			rows: lhs.rows * rhs.rows,
+		}, true
+	}
+	return planEstimate{}, false
+}
+
+func (rp *routePlan) estimate(semTable *semantics.SemTable) (planEstimate, bool) {
+	rows := 1.0
+	shards := 1
+	for _, table := range rp._tables {
+		if table.stats == nil {
+			return planEstimate{}, false
+		}
+		rows *= math.Max(1, float64(table.stats.Rows))
+		if table.stats.Shards > shards {
+			shards = table.stats.Shards
+		}
+	}
+	for _, predicate := range rp.predicates {
+		rows *= selectivity(predicate, rp, semTable)
+	}
+
+	queries := 1
+	switch rp.routeOpCode {
+	case engine.SelectScatter:
+		queries = shards
+	case engine.SelectIN, engine.SelectMultiEqual:
+		queries = shards
+		if len(rp.vindexValues) == 1 && len(rp.vindexValues[0].Values) != 0 && len(rp.vindexValues[0].Values) < shards {
+			queries = len(rp.vindexValues[0].Values)
+		}
+	case engine.SelectRange:
+		// The shards that the range covers aren't known before execution.
+		queries = (shards + 1) / 2
+	}
+	return planEstimate{
+		cost: float64(queries*queryCost) + rows,
+		rows: math.Max(1, rows),
+	}, true
+}
+
+// selectivity returns the estimated fraction of
+// the rows of a joinTree that match a predicate.
+func selectivity(expr sqlparser.Expr, tree joinTree, semTable *semantics.SemTable) float64 {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return selectivity(expr.Left, tree, semTable) * selectivity(expr.Right, tree, semTable)
+	case *sqlparser.OrExpr:
+		return math.Min(1, selectivity(expr.Left, tree, semTable)+selectivity(expr.Right, tree, semTable))
+	case *sqlparser.NotExpr:
+		return 1 - selectivity(expr.Expr, tree, semTable)
+	case *sqlparser.ComparisonExpr:
+		switch expr.Operator {
+		case sqlparser.EqualOp, sqlparser.NullSafeEqualOp:
+			return equalitySelectivity(expr.Left, expr.Right, tree, semTable)
+		case sqlparser.NotEqualOp:
+			return 1 - equalitySelectivity(expr.Left, expr.Right, tree, semTable)
+		case sqlparser.InOp, sqlparser.NotInOp:
+			values := 1
+			if tuple, ok := expr.Right.(sqlparser.ValTuple); ok {
+				values = len(tuple)
+			}
+			in := math.Min(1, float64(values)*equalitySelectivity(expr.Left, nil, tree, semTable))
+			if expr.Operator == sqlparser.NotInOp {
+				return 1 - in
+			}
+			return in
+		}
+	case *sqlparser.IsExpr:
+		return equalSelectivity
+	}
+	return rangeSelectivity
+}
+
+// equalitySelectivity returns the estimated fraction of the rows for
+// which left = right. right can be nil if it is not a column.
+func equalitySelectivity(left, right sqlparser.Expr, tree joinTree, semTable *semantics.SemTable) float64 {
+	distinct := 0.0
+	for _, expr := range []sqlparser.Expr{left, right} {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			continue
+		}
+		// With a column on each side, the values of the column with
+		// the most distinct values are the most likely not to match.
+		distinct = math.Max(distinct, cardinality(col, tree, semTable))
+	}
+	if distinct == 0 {
+		return equalSelectivity
+	}
+	return 1 / distinct
+}
+
+// cardinality returns the estimated number of distinct values of
+// a column, or 0 if it is unknown.
+func cardinality(col *sqlparser.ColName, tree joinTree, semTable *semantics.SemTable) float64 {
+	table := findRouteTable(tree, semTable.Dependencies(col))
+	if table == nil || table.stats == nil {
+		return 0
+	}
+	if cardinality, ok := table.stats.Cardinality[col.Name.Lowered()]; ok && cardinality > 0 {
+		return float64(cardinality)
+	}
+	if isUniqueVindexColumn(table.vtable, col.Name) {
+		return math.Max(1, float64(table.stats.Rows))
+	}
+	return 0
+}
+
+func findRouteTable(tree joinTree, deps semantics.TableSet) *routeTable {
+	switch node := tree.(type) {
+	case *routePlan:
+		for _, table := range node._tables {
+			if deps != 0 && deps.IsSolvedBy(table.qtable.tableID) {
+				return table
+			}
+		}
+	case *joinPlan:
+		if table := findRouteTable(node.lhs, deps); table != nil {
+			return table
+		}
+		return findRouteTable(node.rhs, deps)
+	}
+	return nil
+}
+
+func isUniqueVindexColumn(table *vindexes.Table, col sqlparser.ColIdent) bool {
+	for _, columnVindex := range table.ColumnVindexes {
+		if len(columnVindex.Columns) == 1 && columnVindex.Columns[0].Equal(col) && columnVindex.Vindex.IsUnique() {
+			return true
+		}
+	}
+	return false
+}
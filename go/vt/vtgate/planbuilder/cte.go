@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/mysql"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// This file has functions to handle common table expressions (the WITH clause).
+
+// buildCTEPlan wraps a planner for SELECT and UNION statements.
+// Non-recursive common table expressions are inlined as derived tables
+// before the statement is handed to the wrapped planner. This way, the
+// regular derived table planning takes care of merging them into a single
+// route whenever possible. Recursive common table expressions can't be
+// inlined. They are only supported if the whole statement can be sent
+// as is to a single unsharded keyspace.
+func buildCTEPlan(f func(sqlparser.Statement, ContextVSchema) (engine.Primitive, error)) func(sqlparser.Statement, ContextVSchema) (engine.Primitive, error) {
+	return func(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
+		sel, ok := stmt.(sqlparser.SelectStatement)
+		if !ok || !hasCTEs(sel) {
+			return f(stmt, vschema)
+		}
+		if hasRecursiveCTEs(sel) {
+			return buildRecursiveCTEPlan(sel, vschema)
+		}
+		if err := inlineCTEs(sel); err != nil {
+			return nil, err
+		}
+		return f(stmt, vschema)
+	}
+}
+
+// hasCTEs returns true if the statement, or any of its subqueries, has a WITH clause.
+func hasCTEs(stmt sqlparser.SelectStatement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if _, ok := node.(*sqlparser.With); ok {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// hasRecursiveCTEs returns true if the statement, or any of its subqueries, has a WITH RECURSIVE clause.
+func hasRecursiveCTEs(stmt sqlparser.SelectStatement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if with, ok := node.(*sqlparser.With); ok && with.Recursive {
+			found = true
+			return false, nil
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// inlineCTEs replaces every reference to a common table expression with a derived table
+// built from its definition, and removes the WITH clauses from the statement.
+// WITH clauses are processed inner first. This way, a CTE defined in a subquery shadows
+// a CTE of the same name defined by an outer query, and an outer CTE referenced from
+// within an inner CTE definition is replaced when the outer WITH clause is processed.
+func inlineCTEs(stmt sqlparser.SelectStatement) error {
+	var err error
+	sqlparser.Rewrite(stmt, nil, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			if with := node.With; with != nil {
+				node.With = nil
+				err = inlineWith(with, node)
+			}
+		case *sqlparser.Union:
+			if with := node.With; with != nil {
+				node.With = nil
+				err = inlineWith(with, node)
+			}
+		}
+		return err == nil
+	})
+	return err
+}
+
+// inlineWith inlines the CTEs of the WITH clause into the statement it belongs to.
+// A CTE can reference the ones defined before it in the same WITH clause, so each
+// definition is inlined into the definitions that follow it as well.
+func inlineWith(with *sqlparser.With, stmt sqlparser.SelectStatement) error {
+	for i, cte := range with.Ctes {
+		for _, other := range with.Ctes[:i] {
+			if other.TableID == cte.TableID {
+				return mysql.NewSQLError(mysql.ERNonUniqTable, "42000", "Not unique table/alias: '%s'", cte.TableID.String())
+			}
+		}
+		definition, err := cteDefinition(cte)
+		if err != nil {
+			return err
+		}
+		for _, next := range with.Ctes[i+1:] {
+			if err := replaceCTERefs(next.Subquery, cte.TableID, definition); err != nil {
+				return err
+			}
+		}
+		if err := replaceCTERefs(stmt, cte.TableID, definition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cteDefinition returns the SQL of the statement that defines the CTE,
+// with its select expressions renamed after the CTE column list, if any.
+func cteDefinition(cte *sqlparser.CommonTableExpr) (string, error) {
+	if len(cte.Columns) == 0 {
+		return sqlparser.String(cte.Subquery.Select), nil
+	}
+	sel := firstSelect(cte.Subquery.Select)
+	if len(sel.SelectExprs) != len(cte.Columns) {
+		return "", mysql.NewSQLError(mysql.ERViewWrongList, "HY000", "In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
+	}
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s in common table expression with a column list", sqlparser.String(expr))
+		}
+		aliased.As = cte.Columns[i]
+	}
+	return sqlparser.String(cte.Subquery.Select), nil
+}
+
+// firstSelect returns the left-most SELECT of a statement. Its select
+// expressions name the columns of the statement.
+func firstSelect(stmt sqlparser.SelectStatement) *sqlparser.Select {
+	switch node := stmt.(type) {
+	case *sqlparser.Union:
+		return firstSelect(node.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return firstSelect(node.Select)
+	}
+	return stmt.(*sqlparser.Select)
+}
+
+// replaceCTERefs replaces all the unqualified references to the table name in the
+// node with a derived table parsed from the definition. Every reference gets
+// its own copy of the definition, because the planner modifies the AST it plans.
+func replaceCTERefs(node sqlparser.SQLNode, name sqlparser.TableIdent, definition string) error {
+	var err error
+	sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		tableExpr, ok := cursor.Node().(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok || !tableName.Qualifier.IsEmpty() || tableName.Name != name {
+			return true
+		}
+		var stmt sqlparser.Statement
+		stmt, err = sqlparser.Parse(definition)
+		if err != nil {
+			return false
+		}
+		if tableExpr.As.IsEmpty() {
+			tableExpr.As = name
+		}
+		tableExpr.Expr = &sqlparser.DerivedTable{Select: stmt.(sqlparser.SelectStatement)}
+		return false
+	}, nil)
+	return err
+}
+
+// buildRecursiveCTEPlan builds a route that sends the statement as is
+// to the unsharded keyspace that all the referenced tables belong to.
+func buildRecursiveCTEPlan(stmt sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	cteNames := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if cte, ok := node.(*sqlparser.CommonTableExpr); ok {
+			cteNames[cte.TableID.String()] = true
+		}
+		return true, nil
+	}, stmt)
+
+	// All the tables must live in the same keyspace. dual is available in every
+	// keyspace, so it only decides where the statement goes if it's the only table.
+	var dual, routed *vindexes.Table
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		name, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok || (name.Qualifier.IsEmpty() && cteNames[name.Name.String()]) {
+			return true, nil
+		}
+		table, _, _, _, err := vschema.FindTable(name)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case table.Keyspace.Sharded && table.Type != vindexes.TypeReference:
+			return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on sharded table %s", name.Name.String())
+		case table.Name.String() == "dual":
+			if dual == nil {
+				dual = table
+			}
+		case routed == nil:
+			routed = table
+		case routed.Keyspace.Name != table.Keyspace.Name:
+			return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression across keyspaces")
+		}
+		return true, nil
+	}, stmt)
+	if err != nil {
+		return nil, err
+	}
+	if routed == nil {
+		routed = dual
+	}
+	if routed == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: no table found in recursive common table expression")
+	}
+
+	opcode := engine.SelectUnsharded
+	if routed.Keyspace.Sharded {
+		// Only reference tables are involved.
+		opcode = engine.SelectReference
+	}
+	eroute := engine.NewSimpleRoute(opcode, routed.Keyspace)
+	eroute.TableName = routed.Name.String()
+
+	// Keyspace qualifiers are stripped, like for any other route.
+	formatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if name, ok := node.(sqlparser.TableName); ok && !sqlparser.SystemSchema(name.Qualifier.String()) {
+			name.Name.Format(buf)
+			return
+		}
+		node.Format(buf)
+	}
+	eroute.Query = sqlparser.NewTrackedBuffer(formatter).WriteNode(stmt).ParsedQuery().Query
+	fieldFormatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if name, ok := node.(sqlparser.TableName); ok && !sqlparser.SystemSchema(name.Qualifier.String()) {
+			name.Name.Format(buf)
+			return
+		}
+		sqlparser.FormatImpossibleQuery(buf, node)
+	}
+	eroute.FieldQuery = sqlparser.NewTrackedBuffer(fieldFormatter).WriteNode(stmt).ParsedQuery().Query
+	return eroute, nil
+}
@@ -26,7 +26,16 @@ import (
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	del := stmt.(*sqlparser.Delete)
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
+	if isMultiTableDML(del.TableExprs) {
+		m, err := analyzeMultiTableDML(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit)
+		if err != nil {
+			return nil, err
+		}
+		if m != nil {
+			return buildMultiTableDeletePlan(del, m)
+		}
+	}
+	dml, ksidVindex, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
 	}
@@ -47,9 +56,57 @@ func buildDeletePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 	}
 
 	if len(edel.Table.Owned) > 0 {
-		edel.OwnedVindexQuery = generateDMLSubquery(del.Where, del.OrderBy, del.Limit, edel.Table, ksidCol)
-		edel.KsidVindex = ksidVindex
+		edel.OwnedVindexQuery = generateDMLSubquery(del.Where, del.OrderBy, del.Limit, edel.Table, ksidColumnList(ksidVindex))
+		edel.KsidVindex = ksidVindex.Vindex
+		edel.KsidLength = len(ksidColumns(ksidVindex))
+	}
+
+	return edel, nil
+}
+
+// buildMultiTableDeletePlan builds the instructions for a DELETE that joins
+// tables of a sharded keyspace. See multiTableDML for details.
+func buildMultiTableDeletePlan(del *sqlparser.Delete, m *multiTableDML) (engine.Primitive, error) {
+	if len(del.Targets) != 1 {
+		return nil, vterrors.New(vtrpc.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement in sharded keyspace")
+	}
+	target := m.findTable(del.Targets[0].Name)
+	if target == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "Unknown table '%s' in MULTI DELETE", del.Targets[0].Name.String())
+	}
+
+	// Lookup vindex entries are deleted by the single-table plan.
+	rb, merged := m.mergedRoute()
+	merged = merged && len(target.vschemaTable.Owned) == 0
+	if err := m.setTarget(target, !merged); err != nil {
+		return nil, err
+	}
+	if merged {
+		dml, err := m.buildMergedPlan(rb, del, del.Comments)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.Delete{DML: *dml}, nil
 	}
 
+	ksidCol, keyCol, err := m.keyColumns()
+	if err != nil {
+		return nil, err
+	}
+	buf := sqlparser.NewTrackedBuffer(unqualifiedFormatter)
+	buf.Myprintf("delete %vfrom %v", del.Comments, m.targetName)
+	m.formatTargetWhere(buf, keyCol)
+	stmt, err := sqlparser.Parse(buf.String())
+	if err != nil {
+		return nil, err
+	}
+	plan, err := buildDeletePlan(stmt, m.vschema)
+	if err != nil {
+		return nil, err
+	}
+	edel := plan.(*engine.Delete)
+	if err := m.buildInput(&edel.DML, ksidCol, keyCol); err != nil {
+		return nil, err
+	}
 	return edel, nil
 }
@@ -27,38 +27,93 @@ import (
 )
 
 // getDMLRouting returns the vindex and values for the DML,
+// along with the unique vindex that provides the keyspace ids of its rows.
 // If it cannot find a unique vindex match, it returns an error.
-func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.SingleColumn, string, vindexes.SingleColumn, []sqltypes.PlanValue, error) {
-	var ksidVindex vindexes.SingleColumn
-	var ksidCol string
+// The values of the first columns of a MultiColumn vindex route the DML
+// to the shards of their key range, unless another vindex matches.
+func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, *vindexes.ColumnVindex, vindexes.Vindex, []sqltypes.PlanValue, error) {
+	var ksidVindex *vindexes.ColumnVindex
+	var partialVindex vindexes.Vindex
+	var partialValues []sqltypes.PlanValue
 	for _, index := range table.Ordered {
 		if !index.Vindex.IsUnique() {
 			continue
 		}
-		single, ok := index.Vindex.(vindexes.SingleColumn)
-		if !ok {
+		switch index.Vindex.(type) {
+		case vindexes.SingleColumn, vindexes.MultiColumn:
+		default:
 			continue
 		}
-		if ksidCol == "" {
-			ksidCol = sqlparser.String(index.Columns[0])
-			ksidVindex = single
+		if ksidVindex == nil {
+			ksidVindex = index
 		}
 		if where == nil {
-			return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+			return engine.Scatter, ksidVindex, nil, nil, nil
 		}
 
-		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
-			opcode := engine.Equal
-			if pv.IsList() {
-				opcode = engine.In
+		switch vindex := index.Vindex.(type) {
+		case vindexes.SingleColumn:
+			if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
+				opcode := engine.Equal
+				if pv.IsList() {
+					opcode = engine.In
+				}
+				return opcode, ksidVindex, vindex, []sqltypes.PlanValue{pv}, nil
+			}
+		case vindexes.MultiColumn:
+			values, hasList := getMultiColMatch(where.Expr, index.Columns)
+			switch {
+			case len(values) == len(index.Columns) && !hasList:
+				return engine.Equal, ksidVindex, vindex, values, nil
+			case len(values) == len(index.Columns):
+				return engine.In, ksidVindex, vindex, values, nil
+			case len(values) > 0 && vindex.PartialVindex() && partialVindex == nil:
+				partialVindex, partialValues = vindex, values
 			}
-			return opcode, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
 		}
 	}
 	if ksidVindex == nil {
-		return engine.Scatter, nil, "", nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
+		return engine.Scatter, nil, nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
+	}
+	if partialVindex != nil {
+		return engine.In, ksidVindex, partialVindex, partialValues, nil
+	}
+	return engine.Scatter, ksidVindex, nil, nil, nil
+}
+
+// getMultiColMatch returns the matched values of the first columns of a
+// MultiColumn vindex, and whether any of them is a list.
+func getMultiColMatch(node sqlparser.Expr, columns []sqlparser.ColIdent) (values []sqltypes.PlanValue, hasList bool) {
+	for _, col := range columns {
+		pv, ok := getMatch(node, col)
+		if !ok {
+			break
+		}
+		values = append(values, pv)
+		hasList = hasList || pv.IsList()
+	}
+	return values, hasList
+}
+
+// ksidColumns returns the columns that the vindex providing the keyspace
+// ids of the rows maps. A SingleColumn vindex only maps its first column.
+func ksidColumns(ksidVindex *vindexes.ColumnVindex) []sqlparser.ColIdent {
+	if _, ok := ksidVindex.Vindex.(vindexes.SingleColumn); ok {
+		return ksidVindex.Columns[:1]
+	}
+	return ksidVindex.Columns
+}
+
+// ksidColumnList returns the comma-separated list of the ksidColumns.
+func ksidColumnList(ksidVindex *vindexes.ColumnVindex) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, col := range ksidColumns(ksidVindex) {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", col)
 	}
-	return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+	return buf.String()
 }
 
 // getMatch returns the matched value if there is an equality
@@ -100,12 +155,12 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, *vindexes.ColumnVindex, error) {
 	edml := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
 	rb, err := pb.processDMLTable(tableExprs, nil)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, err
 	}
 	edml.Keyspace = rb.eroute.Keyspace
 	if !edml.Keyspace.Sharded {
@@ -114,17 +169,17 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 		subqueryArgs = append(subqueryArgs, nodes...)
 		subqueryArgs = append(subqueryArgs, where, orderBy, limit)
 		if !pb.finalizeUnshardedDMLSubqueries(subqueryArgs...) {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		edml.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		edml.Query = generateQuery(stmt)
-		return edml, nil, "", nil
+		return edml, nil, nil
 	}
 
 	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -139,38 +194,83 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	edml.QueryTimeout = queryTimeout(directives)
 
 	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
 	}
 	for _, tval := range pb.st.tables {
 		// There is only one table.
 		edml.Table = tval.vschemaTable
 	}
 
-	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, edml.Table)
+	routingType, ksidVindex, vindex, values, err := getDMLRouting(where, edml.Table)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, err
 	}
 
 	if rb.eroute.TargetDestination != nil {
 		if rb.eroute.TargetTabletType != topodatapb.TabletType_MASTER {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
 		}
 		edml.Opcode = engine.ByDestination
 		edml.TargetDestination = rb.eroute.TargetDestination
-		return edml, ksidVindex, ksidCol, nil
+		return edml, ksidVindex, nil
 	}
 
 	edml.Opcode = routingType
-	if routingType == engine.Scatter {
-		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
+	if routingType != engine.Equal && limit != nil {
+		if err := buildLimitedDML(edml, vschema, dmlType, stmt, tableExprs, where, orderBy, limit, ksidVindex); err != nil {
+			return nil, nil, err
 		}
-	} else {
+		return edml, ksidVindex, nil
+	}
+	if routingType != engine.Scatter {
 		edml.Vindex = vindex
 		edml.Values = values
 	}
 
-	return edml, ksidVindex, ksidCol, nil
+	return edml, ksidVindex, nil
+}
+
+// buildLimitedDML plans a DML with a LIMIT that can modify rows on more than one shard.
+// The primary vindex values of the rows to modify are selected, merge-sorted and limited
+// across the shards first. The DML then sends each shard its own limit: the number of
+// rows selected on that shard.
+// The limit of stmt is replaced by a bind variable, so that the queries of the owned
+// vindexes that are generated from it afterwards are limited in the same way.
+func buildLimitedDML(edml *engine.DML, vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, ksidVindex *vindexes.ColumnVindex) error {
+	if limit.Offset != nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: offset in multi shard %s", dmlType)
+	}
+	if _, ok := ksidVindex.Vindex.(vindexes.SingleColumn); !ok {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: limit in multi shard %s of a table with a multi-column primary vindex", dmlType)
+	}
+	ksidCol := ksidColumnList(ksidVindex)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %s", ksidCol)
+	for _, order := range orderBy {
+		// The ordering columns must be selected for the merge-sort.
+		if col, ok := order.Expr.(*sqlparser.ColName); ok && col.Name.EqualString(ksidCol) {
+			continue
+		}
+		buf.Myprintf(", %v", order.Expr)
+	}
+	buf.Myprintf(" from %v%v%v%v for update", tableExprs, where, orderBy, limit)
+	query := buf.String()
+	sel, err := sqlparser.Parse(query)
+	if err != nil {
+		return err
+	}
+	input, err := buildSelectPlan(query)(sel, vschema)
+	if err != nil {
+		return err
+	}
+
+	edml.Opcode = engine.Limited
+	edml.Vindex = ksidVindex.Vindex
+	edml.Values = nil
+	edml.Input = input
+	limit.Rowcount = sqlparser.NewArgument([]byte(":" + engine.DMLLimitVarName))
+	edml.Query = generateQuery(stmt)
+	return nil
 }
 
 func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
@@ -149,7 +149,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
-			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			return nil, nil, nil, errCorrelatedSubquery
 		}
 
 		sqName, hasValues := pb.jt.GenerateSubqueryVars()
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ logicalPlan = (*filter)(nil)
+
+// filter is the logicalPlan for engine.Filter.
+// It evaluates a predicate that can't be pushed down to
+// a route, such as a HAVING on the results of a
+// cross-shard aggregation.
+type filter struct {
+	logicalPlanCommon
+	efilter *engine.Filter
+}
+
+// Primitive implements the logicalPlan interface
+func (f *filter) Primitive() engine.Primitive {
+	f.efilter.Input = f.input.Primitive()
+	return f.efilter
+}
+
+// Rewrite implements the logicalPlan interface
+func (f *filter) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "filter: wrong number of inputs")
+	}
+	f.input = inputs[0]
+	return nil
+}
+
+// Inputs implements the logicalPlan interface
+func (f *filter) Inputs() []logicalPlan {
+	return []logicalPlan{f.input}
+}
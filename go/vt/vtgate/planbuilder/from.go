@@ -40,6 +40,14 @@ func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs, whe
 	if !ok {
 		return nil, errors.New("unsupported: multi-shard or vindex write statement")
 	}
+	// The DMLs on a copy of a reference table are sent to its source.
+	if rb.reference != nil && rb.reference.Source != nil {
+		rb.switchReference(rb.reference.Source.Keyspace.Name)
+		for _, t := range pb.st.tables {
+			// There is only one table.
+			t.vschemaTable = rb.reference
+		}
+	}
 	for _, sub := range rb.substitutions {
 		*sub.oldExpr = *sub.newExpr
 	}
@@ -132,6 +140,7 @@ func (pb *primitiveBuilder) processAliasedTable(tableExpr *sqlparser.AliasedTabl
 		rb, st := newRoute(&sqlparser.Select{From: []sqlparser.TableExpr{tableExpr}})
 		rb.substitutions = subroute.substitutions
 		rb.condition = subroute.condition
+		rb.multiColumn, rb.multiColumnCondition = subroute.multiColumn, subroute.multiColumnCondition
 		rb.eroute = subroute.eroute
 		subroute.Redirect = rb
 
@@ -261,6 +270,9 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 	}
 	eroute.TableName = vschemaTable.Name.String()
 	rb.eroute = eroute
+	if vschemaTable.Source != nil || len(vschemaTable.ReferencedBy) > 0 {
+		rb.reference = vschemaTable
+	}
 
 	return nil
 }
@@ -316,6 +328,14 @@ func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTab
 		return newJoin(pb, rpb, ajoin)
 	}
 
+	// A table with copies is read from the one that is
+	// in the keyspace of the other route, if there is one.
+	if lRoute.eroute.Keyspace.Name != rRoute.eroute.Keyspace.Name && lRoute.eroute.Opcode != engine.SelectDBA && rRoute.eroute.Opcode != engine.SelectDBA {
+		if !rRoute.switchReference(lRoute.eroute.Keyspace.Name) {
+			lRoute.switchReference(rRoute.eroute.Keyspace.Name)
+		}
+	}
+
 	// Try merging the routes.
 	if !lRoute.JoinCanMerge(pb, rRoute, ajoin, where) {
 		return newJoin(pb, rpb, ajoin)
@@ -324,9 +344,12 @@ func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTab
 	if lRoute.eroute.Opcode == engine.SelectReference {
 		// Swap the conditions & eroutes, and then merge.
 		lRoute.condition, rRoute.condition = rRoute.condition, lRoute.condition
+		lRoute.multiColumn, rRoute.multiColumn = rRoute.multiColumn, lRoute.multiColumn
+		lRoute.multiColumnCondition, rRoute.multiColumnCondition = rRoute.multiColumnCondition, lRoute.multiColumnCondition
 		lRoute.eroute, rRoute.eroute = rRoute.eroute, lRoute.eroute
 	}
 	lRoute.substitutions = append(lRoute.substitutions, rRoute.substitutions...)
+	lRoute.reference = nil
 	rRoute.Redirect = lRoute
 
 	// Merge the AST.
@@ -0,0 +1,878 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+// planHorizon plans the part of the query that works on the rows produced by
+// the FROM and WHERE clauses: the select list, the grouping, HAVING and ORDER BY.
+// When the aggregation can't be pushed down to a single route, every shard
+// computes partial aggregates that vtgate merges into the final result.
+func planHorizon(sel *sqlparser.Select, tree joinTree, plan logicalPlan, semTable *semantics.SemTable) (logicalPlan, error) {
+	hasAggregates := nodeHasAggregates(sel.SelectExprs) || nodeHasAggregates(sel.Having) || nodeHasAggregates(sel.OrderBy)
+	if funcs := windowFuncs(sel.SelectExprs); len(funcs) > 0 {
+		specs, err := resolveWindowSpecs(funcs, sel.Windows)
+		if err != nil {
+			return nil, err
+		}
+		rb, isRoute := plan.(*route)
+		if !isRoute {
+			return nil, errors.New("unsupported: window functions in cross-shard query")
+		}
+		if !rb.isSingleShard() && !partitionsHaveUniqueVindex(specs, tree, semTable) {
+			// The partitions span multiple shards.
+			if hasAggregates || len(sel.GroupBy) > 0 || sel.Distinct || sel.Having != nil {
+				return nil, errors.New("unsupported: in scatter query: window functions with aggregates")
+			}
+			spec, err := commonWindowSpec(funcs, specs)
+			if err != nil {
+				return nil, err
+			}
+			return newWindowPlanner(sel, rb, tree, semTable).plan(spec)
+		}
+	}
+	if !hasAggregates && len(sel.GroupBy) == 0 && !sel.Distinct {
+		return plan, planProjections(sel, plan, semTable)
+	}
+
+	rb, isRoute := plan.(*route)
+	if !isRoute {
+		if hasAggregates || len(sel.GroupBy) > 0 {
+			return nil, errors.New("unsupported: cross-shard query with aggregates")
+		}
+		if err := planProjections(sel, plan, semTable); err != nil {
+			return nil, err
+		}
+		return newDistinct(plan), nil
+	}
+	if rb.isSingleShard() || canPushDownAggregation(sel, hasAggregates, tree, semTable) {
+		return plan, planProjections(sel, plan, semTable)
+	}
+	return newAggregationPlanner(sel, rb, tree, semTable).plan()
+}
+
+// canPushDownAggregation returns true if every group lives in a single shard,
+// because the rows are grouped by a unique vindex.
+func canPushDownAggregation(sel *sqlparser.Select, hasAggregates bool, tree joinTree, semTable *semantics.SemTable) bool {
+	rp, ok := tree.(*routePlan)
+	if !ok {
+		return false
+	}
+	hasUniqueVindex := func(expr sqlparser.Expr) bool {
+		vindex := findColumnVindex(rp, expr, semTable)
+		return vindex != nil && vindex.IsUnique()
+	}
+	if sel.Distinct && !hasAggregates {
+		for _, selectExpr := range sel.SelectExprs {
+			if expr, ok := selectExpr.(*sqlparser.AliasedExpr); ok && hasUniqueVindex(expr.Expr) {
+				return true
+			}
+		}
+	}
+	for _, expr := range sel.GroupBy {
+		if expr, err := resolveSelectReference(expr, sel.SelectExprs); err == nil && hasUniqueVindex(expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionsHaveUniqueVindex returns true if the partitioning of every window
+// references a unique vindex of the route, so that the shards can compute the
+// window functions.
+func partitionsHaveUniqueVindex(specs []*sqlparser.WindowSpecification, tree joinTree, semTable *semantics.SemTable) bool {
+	rp, ok := tree.(*routePlan)
+	if !ok {
+		return false
+	}
+	for _, spec := range specs {
+		found := false
+		for _, expr := range spec.PartitionClause {
+			if vindex := findColumnVindex(rp, expr, semTable); vindex != nil && vindex.IsUnique() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveSelectReference returns the select expression referenced by an
+// alias or a column number in a GROUP BY or ORDER BY clause.
+// Other expressions are returned as is.
+func resolveSelectReference(expr sqlparser.Expr, selects sqlparser.SelectExprs) (sqlparser.Expr, error) {
+	switch node := expr.(type) {
+	case *sqlparser.ColName:
+		if aliased := findAlias(node, selects); aliased != nil {
+			return aliased, nil
+		}
+	case *sqlparser.Literal:
+		if node.Type != sqlparser.IntVal {
+			return expr, nil
+		}
+		num, err := strconv.ParseInt(string(node.Val), 0, 64)
+		if err != nil {
+			return nil, err
+		}
+		if num < 1 || num > int64(len(selects)) {
+			return nil, fmt.Errorf("column number out of range: %d", num)
+		}
+		aliased, ok := selects[num-1].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("cannot reference a '*' expression: %d", num)
+		}
+		return aliased.Expr, nil
+	}
+	return expr, nil
+}
+
+// aggregationPlanner splits the aggregation of a scatter query in two.
+// The route groups the rows of every shard by the grouping keys, and computes
+// a partial aggregate for each group. An orderedAggregate merges the partial
+// aggregates of all shards, and the expressions of the select list and HAVING
+// are evaluated by vtgate on the merged rows.
+type aggregationPlanner struct {
+	sel      *sqlparser.Select
+	rb       *route
+	tree     joinTree
+	semTable *semantics.SemTable
+	inner    *sqlparser.Select
+	eaggr    *engine.OrderedAggregate
+
+	// aggregates are the aggregate expressions already pushed to the route,
+	// with the expression that computes their final value.
+	aggregates []pushedAggregate
+
+	// distinctArgs are the arguments of the distinct aggregates. The route
+	// groups by them, so that every shard returns each value once per group.
+	distinctArgs []sqlparser.Expr
+
+	// reserved are the columns of the route that hold the argument of a
+	// distinct aggregate. They can't be shared with other expressions,
+	// because the orderedAggregate replaces their value.
+	reserved map[int]bool
+
+	// distinctAsGrouping is set when the DISTINCT of the query
+	// is planned as a grouping by all the select expressions.
+	distinctAsGrouping bool
+
+	// sortedByKeys is set when the route returns the rows
+	// in the order requested by the query.
+	sortedByKeys bool
+
+	// resolveAliases is set while planning HAVING and ORDER BY,
+	// which can reference the aliases of the select list.
+	resolveAliases bool
+}
+
+type pushedAggregate struct {
+	expr   sqlparser.Expr
+	result evalengine.Expr
+}
+
+func newAggregationPlanner(sel *sqlparser.Select, rb *route, tree joinTree, semTable *semantics.SemTable) *aggregationPlanner {
+	return &aggregationPlanner{
+		sel:      sel,
+		rb:       rb,
+		tree:     tree,
+		semTable: semTable,
+		inner:    rb.Select.(*sqlparser.Select),
+		eaggr:    &engine.OrderedAggregate{},
+		reserved: map[int]bool{},
+	}
+}
+
+func (ap *aggregationPlanner) plan() (logicalPlan, error) {
+	ap.inner.Comments = ap.sel.Comments
+
+	var exprs []evalengine.Expr
+	var cols []string
+	for _, selectExpr := range ap.sel.SelectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: '%s' expression with aggregates", sqlparser.String(selectExpr))
+		}
+		expr, err := ap.convert(aliased.Expr)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		if aliased.As.IsEmpty() {
+			cols = append(cols, sqlparser.String(aliased.Expr))
+		} else {
+			cols = append(cols, aliased.As.String())
+			ap.setAlias(expr, aliased.As)
+		}
+	}
+
+	if err := ap.planGrouping(); err != nil {
+		return nil, err
+	}
+
+	ap.resolveAliases = true
+	var predicate evalengine.Expr
+	if ap.sel.Having != nil {
+		var err error
+		if predicate, err = ap.convert(ap.sel.Having.Expr); err != nil {
+			return nil, err
+		}
+	}
+	var orderBy []engine.OrderbyParams
+	if !ap.sortedByKeys {
+		var err error
+		if orderBy, err = ap.planOrderBy(&exprs, &cols); err != nil {
+			return nil, err
+		}
+	}
+	if err := ap.planDistinctArgs(); err != nil {
+		return nil, err
+	}
+
+	var plan logicalPlan = &orderedAggregate{
+		resultsBuilder: newResultsBuilder(ap.rb, ap.eaggr),
+		eaggr:          ap.eaggr,
+	}
+	if predicate != nil {
+		plan = &filter{
+			logicalPlanCommon: newBuilderCommon(plan),
+			efilter:           &engine.Filter{Predicate: predicate},
+		}
+	}
+	switch {
+	case predicate != nil && len(exprs) < len(ap.inner.SelectExprs), !ap.isIdentity(exprs, cols):
+		// HAVING may need the hidden columns, so they are only dropped after it
+		plan = &projection{
+			logicalPlanCommon: newBuilderCommon(plan),
+			eproj:             &engine.Projection{Cols: cols, Exprs: exprs},
+		}
+	case len(exprs) < len(ap.inner.SelectExprs):
+		ap.eaggr.TruncateColumnCount = len(exprs)
+	}
+	if ap.sel.Distinct && !ap.distinctAsGrouping {
+		if len(exprs) > len(ap.sel.SelectExprs) {
+			return nil, errors.New("unsupported: in scatter query: order by must reference a column in the select list")
+		}
+		plan = newDistinct(plan)
+	}
+	if len(orderBy) > 0 {
+		eMemorySort := &engine.MemorySort{OrderBy: orderBy}
+		if len(exprs) > len(ap.sel.SelectExprs) {
+			eMemorySort.TruncateColumnCount = len(ap.sel.SelectExprs)
+		}
+		plan = &memorySort{
+			resultsBuilder: newResultsBuilder(plan, eMemorySort),
+			eMemorySort:    eMemorySort,
+		}
+	}
+	plan.Reorder(0)
+	return plan, nil
+}
+
+// isIdentity returns true if the projection would return the first
+// columns of the route unchanged, so that truncating the hidden
+// columns is enough.
+func (ap *aggregationPlanner) isIdentity(exprs []evalengine.Expr, cols []string) bool {
+	if len(exprs) > len(ap.inner.SelectExprs) {
+		return false
+	}
+	for i, expr := range exprs {
+		col, ok := expr.(*evalengine.Column)
+		if !ok || col.Offset != i || cols[i] != ap.columnName(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// setAlias names the column of the route, or the distinct aggregate,
+// that computes a whole select expression after its alias.
+func (ap *aggregationPlanner) setAlias(expr evalengine.Expr, alias sqlparser.ColIdent) {
+	col, ok := expr.(*evalengine.Column)
+	if !ok {
+		return
+	}
+	if ap.reserved[col.Offset] {
+		for i := range ap.eaggr.Aggregates {
+			if ap.eaggr.Aggregates[i].Col == col.Offset {
+				ap.eaggr.Aggregates[i].Alias = alias.String()
+			}
+		}
+		return
+	}
+	if inner := ap.inner.SelectExprs[col.Offset].(*sqlparser.AliasedExpr); inner.As.IsEmpty() {
+		inner.As = alias
+	}
+}
+
+// columnName returns the name of a column returned by the orderedAggregate.
+func (ap *aggregationPlanner) columnName(offset int) string {
+	if ap.reserved[offset] {
+		for _, aggr := range ap.eaggr.Aggregates {
+			if aggr.Col == offset {
+				return aggr.Alias
+			}
+		}
+	}
+	inner := ap.inner.SelectExprs[offset].(*sqlparser.AliasedExpr)
+	if !inner.As.IsEmpty() {
+		return inner.As.String()
+	}
+	return sqlparser.String(inner.Expr)
+}
+
+// planGrouping makes the route group and sort its rows by the grouping keys.
+// If the ORDER BY of the query only references grouping keys, they are sorted
+// in that order, so that the result doesn't need to be sorted again.
+func (ap *aggregationPlanner) planGrouping() error {
+	groupBy := ap.sel.GroupBy
+	if len(groupBy) == 0 && ap.sel.Distinct && len(ap.aggregates) == 0 {
+		// A DISTINCT without aggregates groups by the whole select list.
+		ap.distinctAsGrouping = true
+		for _, selectExpr := range ap.sel.SelectExprs {
+			groupBy = append(groupBy, selectExpr.(*sqlparser.AliasedExpr).Expr)
+		}
+	}
+	var keys []sqlparser.Expr
+	for _, key := range groupBy {
+		expr, err := resolveSelectReference(key, ap.sel.SelectExprs)
+		if err != nil {
+			return err
+		}
+		if nodeHasAggregates(expr) {
+			return fmt.Errorf("unsupported: in scatter query: group by aggregate expression '%s'", sqlparser.String(key))
+		}
+		keys = append(keys, expr)
+	}
+
+	orders := ap.keyOrdering(keys)
+	if orders != nil {
+		ap.sortedByKeys = true
+	} else {
+		for _, key := range keys {
+			orders = append(orders, &sqlparser.Order{Expr: key, Direction: sqlparser.AscOrder})
+		}
+	}
+	for _, order := range orders {
+		offset := ap.pushColumn(order.Expr)
+		if isTextColumn(ap.tree, order.Expr, ap.semTable) {
+			// the groups are compared with the collation of the column
+			offset = ap.pushColumn(weightStringExpr(order.Expr))
+		}
+		ap.eaggr.Keys = append(ap.eaggr.Keys, offset)
+		ap.inner.GroupBy = append(ap.inner.GroupBy, order.Expr)
+		ap.inner.OrderBy = append(ap.inner.OrderBy, order)
+		ap.rb.eroute.OrderBy = append(ap.rb.eroute.OrderBy, engine.OrderbyParams{
+			Col:  offset,
+			Desc: order.Direction == sqlparser.DescOrder,
+		})
+	}
+	return nil
+}
+
+// keyOrdering returns the grouping keys in the order requested by the ORDER BY
+// of the query, or nil if it references anything else than the grouping keys.
+func (ap *aggregationPlanner) keyOrdering(keys []sqlparser.Expr) []*sqlparser.Order {
+	if len(ap.sel.OrderBy) == 0 || len(keys) == 0 {
+		return nil
+	}
+	var orders []*sqlparser.Order
+	var ordered []sqlparser.Expr
+	for _, order := range ap.sel.OrderBy {
+		expr, err := resolveSelectReference(order.Expr, ap.sel.SelectExprs)
+		if err != nil || !containsExpr(keys, expr) {
+			return nil
+		}
+		if containsExpr(ordered, expr) {
+			continue
+		}
+		ordered = append(ordered, expr)
+		orders = append(orders, &sqlparser.Order{Expr: expr, Direction: order.Direction})
+	}
+	for _, key := range keys {
+		if !containsExpr(ordered, key) {
+			ordered = append(ordered, key)
+			orders = append(orders, &sqlparser.Order{Expr: key, Direction: sqlparser.AscOrder})
+		}
+	}
+	return orders
+}
+
+// planDistinctArgs groups the rows of the route by the arguments of the
+// distinct aggregates, after the grouping keys. Text arguments are ordered
+// and compared by their weight_string, to follow their collation.
+func (ap *aggregationPlanner) planDistinctArgs() error {
+	for i, arg := range ap.distinctArgs {
+		if containsExpr(ap.inner.GroupBy, arg) || containsExpr(ap.distinctArgs[:i], arg) {
+			continue
+		}
+		offset := -1
+		for col := range ap.inner.SelectExprs {
+			if ap.reserved[col] && sameExpr(ap.inner.SelectExprs[col].(*sqlparser.AliasedExpr).Expr, arg) {
+				offset = col
+				break
+			}
+		}
+		if offset == -1 {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: no column for the distinct aggregate argument %s", sqlparser.String(arg))
+		}
+		if isTextColumn(ap.tree, arg, ap.semTable) {
+			offset = ap.pushColumn(weightStringExpr(arg))
+			for j, aggr := range ap.eaggr.Aggregates {
+				if aggr.Opcode != engine.AggregateCountDistinct && aggr.Opcode != engine.AggregateSumDistinct {
+					continue
+				}
+				if sameExpr(ap.inner.SelectExprs[aggr.Col].(*sqlparser.AliasedExpr).Expr, arg) {
+					ap.eaggr.Aggregates[j].WCol = offset
+				}
+			}
+		}
+		ap.inner.GroupBy = append(ap.inner.GroupBy, arg)
+		ap.inner.OrderBy = append(ap.inner.OrderBy, &sqlparser.Order{Expr: arg, Direction: sqlparser.AscOrder})
+		ap.rb.eroute.OrderBy = append(ap.rb.eroute.OrderBy, engine.OrderbyParams{Col: offset})
+	}
+	return nil
+}
+
+// planOrderBy returns the ordering of the result. An ORDER BY expression that
+// isn't in the select list is added to the projection as a hidden column.
+func (ap *aggregationPlanner) planOrderBy(exprs *[]evalengine.Expr, cols *[]string) ([]engine.OrderbyParams, error) {
+	var params []engine.OrderbyParams
+	for _, order := range ap.sel.OrderBy {
+		if sqlparser.IsNull(order.Expr) {
+			// ORDER BY NULL disables the ordering.
+			continue
+		}
+		expr, err := resolveSelectReference(order.Expr, ap.sel.SelectExprs)
+		if err != nil {
+			return nil, err
+		}
+		col := -1
+		for i, selectExpr := range ap.sel.SelectExprs {
+			if sameExpr(selectExpr.(*sqlparser.AliasedExpr).Expr, expr) {
+				col = i
+				break
+			}
+		}
+		switch {
+		case isTextColumn(ap.tree, expr, ap.semTable):
+			// text columns are sorted by their weight_string,
+			// which is returned as a hidden column
+			col = ap.hiddenColumn(exprs, cols, ap.pushColumn(weightStringExpr(expr)), expr)
+		case col == -1:
+			hidden, err := ap.convert(expr)
+			if err != nil {
+				return nil, err
+			}
+			col = len(*exprs)
+			*exprs = append(*exprs, hidden)
+			*cols = append(*cols, sqlparser.String(expr))
+		}
+		params = append(params, engine.OrderbyParams{
+			Col:  col,
+			Desc: order.Direction == sqlparser.DescOrder,
+		})
+	}
+	return params, nil
+}
+
+// hiddenColumn returns the column of the projection that returns the column
+// of the route at offset, after the select list, and adds it if needed.
+func (ap *aggregationPlanner) hiddenColumn(exprs *[]evalengine.Expr, cols *[]string, offset int, expr sqlparser.Expr) int {
+	for i := len(ap.sel.SelectExprs); i < len(*exprs); i++ {
+		if col, ok := (*exprs)[i].(*evalengine.Column); ok && col.Offset == offset {
+			return i
+		}
+	}
+	*exprs = append(*exprs, evalengine.NewColumn(offset))
+	*cols = append(*cols, sqlparser.String(weightStringExpr(expr)))
+	return len(*exprs) - 1
+}
+
+// convert returns the expression that computes the value of expr from the
+// merged rows of the route.
+func (ap *aggregationPlanner) convert(expr sqlparser.Expr) (evalengine.Expr, error) {
+	result, err := sqlparser.ConvertWithResolver(expr, ap.resolve)
+	if err == sqlparser.ErrExprNotSupported {
+		return nil, errors.New("unsupported: in scatter query: complex aggregate expression")
+	}
+	return result, err
+}
+
+// resolve implements sqlparser.ExprResolver. Aggregates, and the parts of the
+// expression that don't contain any, are computed by the route.
+func (ap *aggregationPlanner) resolve(expr sqlparser.Expr) (evalengine.Expr, error) {
+	switch node := expr.(type) {
+	case *sqlparser.FuncExpr:
+		if node.IsAggregate() {
+			return ap.pushAggregate(node)
+		}
+	case *sqlparser.GroupConcatExpr:
+		return ap.pushGroupConcat(node)
+	case *sqlparser.ColName:
+		if ap.resolveAliases {
+			if aliased := findAlias(node, ap.sel.SelectExprs); aliased != nil {
+				return ap.convert(aliased)
+			}
+		}
+	case *sqlparser.Literal, sqlparser.Argument, sqlparser.BoolVal, *sqlparser.NullVal, sqlparser.ValTuple:
+		return nil, nil
+	}
+	if nodeHasAggregates(expr) || ap.referencesAlias(expr) {
+		return nil, nil
+	}
+	return evalengine.NewColumn(ap.pushColumn(expr)), nil
+}
+
+// referencesAlias returns true if the expression references
+// an alias of the select list, while those are resolved.
+func (ap *aggregationPlanner) referencesAlias(expr sqlparser.Expr) bool {
+	if !ap.resolveAliases {
+		return false
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if findAlias(node, ap.sel.SelectExprs) != nil {
+				found = true
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+func (ap *aggregationPlanner) pushAggregate(node *sqlparser.FuncExpr) (evalengine.Expr, error) {
+	for _, aggr := range ap.aggregates {
+		if sameExpr(aggr.expr, node) {
+			return aggr.result, nil
+		}
+	}
+
+	if len(node.Exprs) != 1 {
+		return nil, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(node))
+	}
+	var result evalengine.Expr
+	switch name := node.Name.Lowered(); name {
+	case "avg":
+		// The average is the sum of all the shards divided by their count.
+		sum, err := ap.pushAggregate(&sqlparser.FuncExpr{Name: sqlparser.NewColIdent("sum"), Distinct: node.Distinct, Exprs: node.Exprs})
+		if err != nil {
+			return nil, err
+		}
+		count, err := ap.pushAggregate(&sqlparser.FuncExpr{Name: sqlparser.NewColIdent("count"), Distinct: node.Distinct, Exprs: node.Exprs})
+		if err != nil {
+			return nil, err
+		}
+		sumCol, ok1 := sum.(*evalengine.Column)
+		countCol, ok2 := count.(*evalengine.Column)
+		if !ok1 || !ok2 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: the sum and count of %s are not columns", sqlparser.String(node))
+		}
+		result = &evalengine.AverageExpr{Sum: sumCol.Offset, Count: countCol.Offset}
+	case "count", "sum":
+		if node.Distinct {
+			arg, ok := node.Exprs[0].(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, fmt.Errorf("syntax error: %s", sqlparser.String(node))
+			}
+			opcode := engine.AggregateCountDistinct
+			if name == "sum" {
+				opcode = engine.AggregateSumDistinct
+			}
+			offset := len(ap.inner.SelectExprs)
+			ap.inner.SelectExprs = append(ap.inner.SelectExprs, &sqlparser.AliasedExpr{Expr: arg.Expr})
+			ap.reserved[offset] = true
+			ap.distinctArgs = append(ap.distinctArgs, arg.Expr)
+			ap.eaggr.HasDistinct = true
+			ap.eaggr.Aggregates = append(ap.eaggr.Aggregates, engine.AggregateParams{
+				Opcode: opcode,
+				Col:    offset,
+				Alias:  sqlparser.String(node),
+			})
+			result = evalengine.NewColumn(offset)
+			break
+		}
+		fallthrough
+	case "min", "max":
+		// MIN(DISTINCT x) and MAX(DISTINCT x) are the same as MIN(x) and MAX(x).
+		offset := ap.pushColumn(node)
+		ap.eaggr.Aggregates = append(ap.eaggr.Aggregates, engine.AggregateParams{
+			Opcode: engine.SupportedAggregates[name],
+			Col:    offset,
+		})
+		result = evalengine.NewColumn(offset)
+	default:
+		return nil, fmt.Errorf("unsupported: in scatter query: aggregation function '%s'", name)
+	}
+
+	ap.aggregates = append(ap.aggregates, pushedAggregate{expr: node, result: result})
+	return result, nil
+}
+
+func (ap *aggregationPlanner) pushGroupConcat(node *sqlparser.GroupConcatExpr) (evalengine.Expr, error) {
+	for _, aggr := range ap.aggregates {
+		if sameExpr(aggr.expr, node) {
+			return aggr.result, nil
+		}
+	}
+	if node.Distinct || len(node.OrderBy) > 0 || node.Limit != nil {
+		return nil, errors.New("unsupported: in scatter query: group_concat with distinct, order by or limit")
+	}
+	separator := ","
+	if node.Separator != "" {
+		separator = strings.TrimSuffix(strings.TrimPrefix(node.Separator, " separator '"), "'")
+	}
+	offset := ap.pushColumn(node)
+	ap.eaggr.Aggregates = append(ap.eaggr.Aggregates, engine.AggregateParams{
+		Opcode:    engine.AggregateGroupConcat,
+		Col:       offset,
+		Separator: separator,
+	})
+	result := evalengine.NewColumn(offset)
+	ap.aggregates = append(ap.aggregates, pushedAggregate{expr: node, result: result})
+	return result, nil
+}
+
+// pushColumn adds expr to the select list of the route,
+// unless it's already there, and returns its offset.
+func (ap *aggregationPlanner) pushColumn(expr sqlparser.Expr) int {
+	for i, selectExpr := range ap.inner.SelectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if ok && !ap.reserved[i] && sameExpr(aliased.Expr, expr) {
+			return i
+		}
+	}
+	ap.inner.SelectExprs = append(ap.inner.SelectExprs, &sqlparser.AliasedExpr{Expr: expr})
+	return len(ap.inner.SelectExprs) - 1
+}
+
+func containsExpr(exprs []sqlparser.Expr, expr sqlparser.Expr) bool {
+	for _, e := range exprs {
+		if sameExpr(e, expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameExpr returns true if the two expressions are written the same way.
+func sameExpr(a, b sqlparser.Expr) bool {
+	return sqlparser.String(a) == sqlparser.String(b)
+}
+
+// windowPlanner plans the window functions of a scatter query whose partitions
+// span multiple shards, like the window of the V3 planner. The route returns
+// the arguments of the functions, sorted by the partitioning and the ordering
+// of the window, and a Window primitive computes the functions one partition
+// at a time.
+type windowPlanner struct {
+	sel      *sqlparser.Select
+	rb       *route
+	tree     joinTree
+	semTable *semantics.SemTable
+	inner    *sqlparser.Select
+	eWindow  *engine.Window
+
+	// reserved are the columns of the route that hold the argument of a window
+	// function. They can't be shared with other expressions, because the window
+	// replaces their value with the result of the function.
+	reserved map[int]bool
+}
+
+func newWindowPlanner(sel *sqlparser.Select, rb *route, tree joinTree, semTable *semantics.SemTable) *windowPlanner {
+	return &windowPlanner{
+		sel:      sel,
+		rb:       rb,
+		tree:     tree,
+		semTable: semTable,
+		inner:    rb.Select.(*sqlparser.Select),
+		eWindow:  &engine.Window{},
+		reserved: map[int]bool{},
+	}
+}
+
+func (wp *windowPlanner) plan(spec *sqlparser.WindowSpecification) (logicalPlan, error) {
+	wp.inner.Comments = wp.sel.Comments
+	for _, selectExpr := range wp.sel.SelectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: '%s' expression with window functions", sqlparser.String(selectExpr))
+		}
+		if funcExpr, ok := aliased.Expr.(*sqlparser.FuncExpr); ok && funcExpr.IsWindowFunc() {
+			params, arg, err := newWindowFuncParams(aliased, wp.sel.Windows)
+			if err != nil {
+				return nil, err
+			}
+			params.Col = len(wp.inner.SelectExprs)
+			wp.reserved[params.Col] = true
+			wp.inner.SelectExprs = append(wp.inner.SelectExprs, &sqlparser.AliasedExpr{Expr: arg})
+			wp.eWindow.Functions = append(wp.eWindow.Functions, params)
+			continue
+		}
+		if len(windowFuncs(aliased.Expr)) != 0 {
+			return nil, errors.New("unsupported: in scatter query: complex window function expression")
+		}
+		wp.inner.SelectExprs = append(wp.inner.SelectExprs, aliased)
+	}
+
+	for _, expr := range spec.PartitionClause {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex partition by expression: %s", sqlparser.String(expr))
+		}
+		offset := wp.sortRoute(&sqlparser.Order{Expr: col, Direction: sqlparser.AscOrder})
+		wp.eWindow.PartitionBy = append(wp.eWindow.PartitionBy, offset)
+	}
+	for _, order := range spec.OrderClause {
+		if _, ok := order.Expr.(*sqlparser.ColName); !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex window order by expression: %s", sqlparser.String(order.Expr))
+		}
+		offset := wp.sortRoute(order)
+		wp.eWindow.OrderBy = append(wp.eWindow.OrderBy, engine.OrderbyParams{
+			Col:  offset,
+			Desc: order.Direction == sqlparser.DescOrder,
+		})
+	}
+
+	orderBy, err := wp.planOrderBy()
+	if err != nil {
+		return nil, err
+	}
+
+	var plan logicalPlan = &window{
+		resultsBuilder: newResultsBuilder(wp.rb, wp.eWindow),
+		eWindow:        wp.eWindow,
+	}
+	if len(orderBy) > 0 {
+		eMemorySort := &engine.MemorySort{OrderBy: orderBy}
+		if len(wp.inner.SelectExprs) > len(wp.sel.SelectExprs) {
+			eMemorySort.TruncateColumnCount = len(wp.sel.SelectExprs)
+		}
+		plan = &memorySort{
+			resultsBuilder: newResultsBuilder(plan, eMemorySort),
+			eMemorySort:    eMemorySort,
+		}
+	} else if len(wp.inner.SelectExprs) > len(wp.sel.SelectExprs) {
+		wp.eWindow.TruncateColumnCount = len(wp.sel.SelectExprs)
+	}
+	plan.Reorder(0)
+	return plan, nil
+}
+
+// sortRoute makes the route sort its rows by the column of order, and returns
+// the offset of the column to compare: the weight_string of text columns,
+// because their comparison depends on their collation.
+func (wp *windowPlanner) sortRoute(order *sqlparser.Order) int {
+	offset := wp.compareColumn(order.Expr)
+	wp.inner.OrderBy = append(wp.inner.OrderBy, order)
+	wp.rb.eroute.OrderBy = append(wp.rb.eroute.OrderBy, engine.OrderbyParams{
+		Col:  offset,
+		Desc: order.Direction == sqlparser.DescOrder,
+	})
+	return offset
+}
+
+// compareColumn adds expr to the select list of the route, along with its
+// weight_string if it's a text column, and returns the offset to compare.
+func (wp *windowPlanner) compareColumn(expr sqlparser.Expr) int {
+	offset := wp.pushColumn(expr)
+	if !isTextColumn(wp.tree, expr, wp.semTable) {
+		return offset
+	}
+	return wp.pushColumn(weightStringExpr(expr))
+}
+
+// planOrderBy returns the ordering of the result of the window. An ORDER BY
+// expression must be in the select list.
+func (wp *windowPlanner) planOrderBy() ([]engine.OrderbyParams, error) {
+	var params []engine.OrderbyParams
+	for _, order := range wp.sel.OrderBy {
+		if sqlparser.IsNull(order.Expr) {
+			// ORDER BY NULL disables the ordering.
+			continue
+		}
+		expr, err := resolveSelectReference(order.Expr, wp.sel.SelectExprs)
+		if err != nil {
+			return nil, err
+		}
+		col := -1
+		for i, selectExpr := range wp.sel.SelectExprs {
+			if sameExpr(selectExpr.(*sqlparser.AliasedExpr).Expr, expr) {
+				col = i
+				break
+			}
+		}
+		if col == -1 {
+			return nil, errors.New("unsupported: in scatter query: order by must reference a column in the select list")
+		}
+		if !wp.reserved[col] {
+			col = wp.compareColumn(expr)
+		}
+		params = append(params, engine.OrderbyParams{
+			Col:  col,
+			Desc: order.Direction == sqlparser.DescOrder,
+		})
+	}
+	return params, nil
+}
+
+// pushColumn adds expr to the select list of the route, unless it's
+// already there, and returns its offset.
+func (wp *windowPlanner) pushColumn(expr sqlparser.Expr) int {
+	for i, selectExpr := range wp.inner.SelectExprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if ok && !wp.reserved[i] && sameExpr(aliased.Expr, expr) {
+			return i
+		}
+	}
+	wp.inner.SelectExprs = append(wp.inner.SelectExprs, &sqlparser.AliasedExpr{Expr: expr})
+	return len(wp.inner.SelectExprs) - 1
+}
+
+// isTextColumn returns true if expr is a text column of the route, whose
+// comparison depends on its collation.
+func isTextColumn(tree joinTree, expr sqlparser.Expr, semTable *semantics.SemTable) bool {
+	rp, ok := tree.(*routePlan)
+	return ok && sqltypes.IsText(findColumnType(rp, expr, semTable))
+}
+
+// weightStringExpr returns the weight_string of an expression.
+func weightStringExpr(expr sqlparser.Expr) sqlparser.Expr {
+	return &sqlparser.FuncExpr{
+		Name:  sqlparser.NewColIdent("weight_string"),
+		Exprs: []sqlparser.SelectExpr{&sqlparser.AliasedExpr{Expr: expr}},
+	}
+}
@@ -59,10 +59,7 @@ func buildInsertPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		}
 		return buildInsertUnshardedPlan(ins, vschemaTable)
 	}
-	if ins.Action == sqlparser.ReplaceAct {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
-	}
-	return buildInsertShardedPlan(ins, vschemaTable)
+	return buildInsertShardedPlan(ins, vschemaTable, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -109,7 +106,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -124,6 +121,9 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 		}
 		eins.Opcode = engine.InsertShardedIgnore
 	}
+	if ins.Action == sqlparser.ReplaceAct && len(table.Owned) > 0 {
+		eins.OwnedVindexQuery = generateReplaceSubquery(table)
+	}
 	if len(ins.Columns) == 0 {
 		if table.ColumnListAuthoritative {
 			populateInsertColumnlist(ins, table)
@@ -140,7 +140,7 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+		return buildInsertSelectPlan(ins, eins, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -177,6 +177,11 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 			}
 		}
 	}
+	if eins.OwnedVindexQuery != "" {
+		if err := setReplaceValues(ins, eins, rows); err != nil {
+			return nil, err
+		}
+	}
 	for _, colVindex := range eins.Table.ColumnVindexes {
 		for _, col := range colVindex.Columns {
 			colNum := findOrAddColumn(ins, col)
@@ -192,6 +197,67 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds the plan for an INSERT ... SELECT into a sharded
+// table. The select is planned on its own and executed by vtgate, and the rows
+// it returns are routed to the target shards the way VALUES rows would be.
+func buildInsertSelectPlan(ins *sqlparser.Insert, eins *engine.Insert, vschema ContextVSchema) (engine.Primitive, error) {
+	if len(ins.Columns) == 0 {
+		return nil, errors.New("column list required for insert into select on sharded tables")
+	}
+	sel := ins.Rows.(sqlparser.SelectStatement)
+	if count, ok := selectColumnCount(sel); ok && count != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+	// Planning the select rewrites its AST, so the query is captured first.
+	eins.Query = generateQuery(ins)
+	input, err := createInstructionFor(sqlparser.String(sel), sel, vschema)
+	if err != nil {
+		return nil, err
+	}
+	eins.Input = input
+
+	// Columns added by findOrAddColumn are not returned by the select.
+	// The engine treats them as NULL.
+	if eins.Table.AutoIncrement != nil {
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+			Offset:   findOrAddColumn(ins, eins.Table.AutoIncrement.Column),
+		}
+	}
+	eins.VindexValueOffset = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		for _, col := range colVindex.Columns {
+			eins.VindexValueOffset[vIdx] = append(eins.VindexValueOffset[vIdx], findOrAddColumn(ins, col))
+		}
+	}
+	if eins.OwnedVindexQuery != "" {
+		eins.ReplaceColumns = columnNames(ins.Columns)
+	}
+	generateInsertShardedQuery(ins, eins, nil)
+	eins.Mid = nil
+	return eins, nil
+}
+
+// selectColumnCount returns the number of columns returned by sel.
+// It returns false if the count can't be known before execution.
+func selectColumnCount(sel sqlparser.SelectStatement) (int, bool) {
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		for _, expr := range sel.SelectExprs {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				return 0, false
+			}
+		}
+		return len(sel.SelectExprs), true
+	case *sqlparser.Union:
+		return selectColumnCount(sel.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return selectColumnCount(sel.Select)
+	}
+	return 0, false
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -205,8 +271,12 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore.ToString(),
+	action := sqlparser.InsertStr
+	if node.Action == sqlparser.ReplaceAct {
+		action = sqlparser.ReplaceStr
+	}
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		action, node.Comments, node.Ignore.ToString(),
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
@@ -218,6 +288,54 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	eins.Suffix = suffixBuf.String()
 }
 
+// generateReplaceSubquery generates the query that selects the primary
+// vindex and owned vindex columns of the rows a REPLACE overwrites. The
+// engine completes it with a where clause on the unique keys of the table.
+func generateReplaceSubquery(table *vindexes.Table) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, column := range table.ColumnVindexes[0].Columns {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", column)
+	}
+	for _, cv := range table.Owned {
+		for _, column := range cv.Columns {
+			buf.Myprintf(", %v", column)
+		}
+	}
+	buf.Myprintf(" from %v", table.Name)
+	return buf.String()
+}
+
+// setReplaceValues records the values of the rows of a REPLACE into a table
+// with owned vindexes, which the engine finds the overwritten rows with.
+// It must be called before the vindex values are replaced by arguments.
+func setReplaceValues(ins *sqlparser.Insert, eins *engine.Insert, rows sqlparser.Values) error {
+	eins.ReplaceColumns = columnNames(ins.Columns)
+	eins.ReplaceValues = make([]sqltypes.PlanValue, len(ins.Columns))
+	for colNum, col := range ins.Columns {
+		eins.ReplaceValues[colNum].Values = make([]sqltypes.PlanValue, len(rows))
+		for rowNum, row := range rows {
+			pv, err := sqlparser.NewPlanValue(row[colNum])
+			if err != nil {
+				return vterrors.Wrapf(err, "unsupported: replace into a table with owned vindexes: could not compute value for column %s", col.String())
+			}
+			eins.ReplaceValues[colNum].Values[rowNum] = pv
+		}
+	}
+	return nil
+}
+
+func columnNames(cols sqlparser.Columns) []string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = col.String()
+	}
+	return names
+}
+
 // modifyForAutoinc modfies the AST and the plan to generate
 // necessary autoinc values. It must be called only if eins.Table.AutoIncrement
 // is set. Bind variable names are generated using baseName.
@@ -247,6 +365,7 @@ func modifyForAutoinc(ins *sqlparser.Insert, eins *engine.Insert) error {
 
 // findOrAddColumn finds the position of a column in the insert. If it's
 // absent it appends it to the with NULL values and returns that position.
+// For an insert with a select, only the column list is extended.
 func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 	for i, column := range ins.Columns {
 		if col.Equal(column) {
@@ -254,9 +373,10 @@ func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 		}
 	}
 	ins.Columns = append(ins.Columns, col)
-	rows := ins.Rows.(sqlparser.Values)
-	for i := range rows {
-		rows[i] = append(rows[i], &sqlparser.NullVal{})
+	if rows, ok := ins.Rows.(sqlparser.Values); ok {
+		for i := range rows {
+			rows[i] = append(rows[i], &sqlparser.NullVal{})
+		}
 	}
 	return len(ins.Columns) - 1
 }
@@ -31,6 +31,11 @@ type joinV4 struct {
 	Left, Right logicalPlan
 	Cols        []int
 	Vars        map[string]int
+
+	// HashJoin is set to build a HashJoin primitive,
+	// which compares the LHSKey and RHSKey columns.
+	HashJoin       bool
+	LHSKey, RHSKey int
 }
 
 // Order implements the logicalPlan interface
@@ -79,6 +84,16 @@ func (j *joinV4) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 
 // Primitive implements the logicalPlan interface
 func (j *joinV4) Primitive() engine.Primitive {
+	if j.HashJoin {
+		return &engine.HashJoin{
+			Opcode: engine.NormalJoin,
+			Left:   j.Left.Primitive(),
+			Right:  j.Right.Primitive(),
+			Cols:   j.Cols,
+			LHSKey: j.LHSKey,
+			RHSKey: j.RHSKey,
+		}
+	}
 	return &engine.Join{
 		Left:  j.Left.Primitive(),
 		Right: j.Right.Primitive(),
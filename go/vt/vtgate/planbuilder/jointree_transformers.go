@@ -24,7 +24,6 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
-	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -42,6 +41,10 @@ func transformToLogicalPlan(tree joinTree, semTable *semantics.SemTable) (logica
 }
 
 func transformJoinPlan(n *joinPlan, semTable *semantics.SemTable) (logicalPlan, error) {
+	if n.hashJoin && len(n.vars) != 0 {
+		// a hash join sends the RHS query once, so there are no LHS rows to bind the arguments to
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: hash join with a RHS that needs the LHS columns %v", n.vars)
+	}
 	lhs, err := transformToLogicalPlan(n.lhs, semTable)
 	if err != nil {
 		return nil, err
@@ -51,10 +54,13 @@ func transformJoinPlan(n *joinPlan, semTable *semantics.SemTable) (logicalPlan,
 		return nil, err
 	}
 	return &joinV4{
-		Left:  lhs,
-		Right: rhs,
-		Cols:  n.columns,
-		Vars:  n.vars,
+		Left:     lhs,
+		Right:    rhs,
+		Cols:     n.columns,
+		Vars:     n.vars,
+		HashJoin: n.hashJoin,
+		LHSKey:   n.lhsKey,
+		RHSKey:   n.rhsKey,
 	}, nil
 }
 
@@ -82,11 +88,6 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 		where = &sqlparser.Where{Expr: predicates, Type: sqlparser.WhereClause}
 	}
 
-	var singleColumn vindexes.SingleColumn
-	if n.vindex != nil {
-		singleColumn = n.vindex.(vindexes.SingleColumn)
-	}
-
 	var expressions sqlparser.SelectExprs
 	for _, col := range n.columns {
 		expressions = append(expressions, &sqlparser.AliasedExpr{Expr: col})
@@ -100,11 +101,13 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 
 	return &route{
 		eroute: &engine.Route{
-			Opcode:    n.routeOpCode,
-			TableName: strings.Join(tableNames, ", "),
-			Keyspace:  n.keyspace,
-			Vindex:    singleColumn,
-			Values:    n.vindexValues,
+			Opcode:       n.routeOpCode,
+			TableName:    strings.Join(tableNames, ", "),
+			Keyspace:     n.keyspace,
+			Vindex:       n.vindex,
+			Values:       n.vindexValues,
+			ExcludeStart: n.excludeStart,
+			ExcludeEnd:   n.excludeEnd,
 		},
 		Select: &sqlparser.Select{
 			SelectExprs: expressions,
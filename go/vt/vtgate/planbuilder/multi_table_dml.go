@@ -0,0 +1,360 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// multiTableDML is the analysis of an UPDATE or DELETE in a sharded
+// keyspace whose FROM clause joins more than one table.
+//
+// If all the tables end up in the same route, the statement is sent to
+// the shards as is. Otherwise, vtgate first selects the distinct values of
+// the target column the join depends on, along with the Vindex column of the
+// target table, and then issues a single-table DML restricted to those values.
+// Since every other predicate of the target table is kept in that DML, it
+// modifies exactly the rows that the join would have matched.
+type multiTableDML struct {
+	vschema    ContextVSchema
+	dmlType    string
+	pb         *primitiveBuilder
+	tableExprs sqlparser.TableExprs
+	where      *sqlparser.Where
+	// preds are the predicates of the WHERE clause and of the inner joins.
+	preds []sqlparser.Expr
+	// innerJoinsOnly is false if the FROM clause has an outer join.
+	innerJoinsOnly bool
+
+	// target is the table being modified, and targetName its name
+	// in the FROM clause.
+	target     *table
+	targetName sqlparser.TableName
+	// targetPreds are the predicates that only reference the target
+	// table. joinPreds are the ones that reference other tables.
+	targetPreds []sqlparser.Expr
+	joinPreds   []sqlparser.Expr
+}
+
+// isMultiTableDML returns true if the FROM clause of
+// a DML references more than one table.
+func isMultiTableDML(tableExprs sqlparser.TableExprs) bool {
+	if len(tableExprs) != 1 {
+		return true
+	}
+	_, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	return !ok
+}
+
+// analyzeMultiTableDML builds the multiTableDML for the statement. It returns
+// nil if all the tables are in an unsharded keyspace: these statements are
+// sent as is, and don't need any more analysis.
+func analyzeMultiTableDML(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit) (*multiTableDML, error) {
+	var whereExpr sqlparser.Expr
+	if where != nil {
+		whereExpr = where.Expr
+	}
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
+	if err := pb.processTableExprs(tableExprs, whereExpr); err != nil {
+		return nil, err
+	}
+	if rb, ok := pb.plan.(*route); ok && !rb.eroute.Keyspace.Sharded {
+		return nil, nil
+	}
+	if hasSubquery(stmt) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+	}
+	if len(orderBy) != 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect usage of %s and ORDER BY", strings.ToUpper(dmlType))
+	}
+	if limit != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect usage of %s and LIMIT", strings.ToUpper(dmlType))
+	}
+
+	m := &multiTableDML{
+		vschema:        vschema,
+		dmlType:        dmlType,
+		pb:             pb,
+		tableExprs:     tableExprs,
+		where:          where,
+		preds:          splitAndExpression(nil, whereExpr),
+		innerJoinsOnly: true,
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		join, ok := node.(*sqlparser.JoinTableExpr)
+		if !ok {
+			return true, nil
+		}
+		switch join.Join {
+		case sqlparser.NormalJoinType, sqlparser.StraightJoinType:
+			m.preds = splitAndExpression(m.preds, join.Condition.On)
+		default:
+			m.innerJoinsOnly = false
+		}
+		return true, nil
+	}, tableExprs)
+	return m, nil
+}
+
+// findTable returns the table with the given alias.
+func (m *multiTableDML) findTable(alias sqlparser.TableIdent) *table {
+	for _, name := range m.pb.st.tableNames {
+		if name.Name == alias {
+			return m.pb.st.tables[name]
+		}
+	}
+	return nil
+}
+
+// tableFor returns the table a column belongs to. Unqualified columns
+// are looked up in the column lists of the vschema.
+func (m *multiTableDML) tableFor(col *sqlparser.ColName) (*table, error) {
+	if !col.Qualifier.IsEmpty() {
+		if t := m.findTable(col.Qualifier.Name); t != nil {
+			return t, nil
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "symbol %s not found", sqlparser.String(col))
+	}
+	var found *table
+	for _, name := range m.pb.st.tableNames {
+		t := m.pb.st.tables[name]
+		if t.vschemaTable == nil {
+			continue
+		}
+		for _, c := range t.vschemaTable.Columns {
+			if !c.Name.Equal(col.Name) {
+				continue
+			}
+			if found != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Column '%s' is ambiguous", sqlparser.String(col))
+			}
+			found = t
+		}
+	}
+	if found == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column %s must be qualified in a multi-table %s", sqlparser.String(col), m.dmlType)
+	}
+	return found, nil
+}
+
+// onlyReferencesTarget returns true if every column
+// of expr belongs to the target table.
+func (m *multiTableDML) onlyReferencesTarget(expr sqlparser.SQLNode) (bool, error) {
+	onTarget := true
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		t, err := m.tableFor(col)
+		if err != nil {
+			return false, err
+		}
+		if t != m.target {
+			onTarget = false
+		}
+		return true, nil
+	}, expr)
+	return onTarget, err
+}
+
+// setTarget sets the table being modified, and splits the predicates between
+// the ones of the target table and the others. If strict is false, predicates
+// that reference columns that can't be resolved are treated as join predicates.
+func (m *multiTableDML) setTarget(t *table, strict bool) error {
+	if t.vschemaTable == nil {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: the target of a multi-table %s must be a table", m.dmlType)
+	}
+	if !t.vschemaTable.Keyspace.Sharded {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s of an unsharded table joined with sharded tables", m.dmlType)
+	}
+	m.target = t
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		expr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		name, ok := expr.Expr.(sqlparser.TableName)
+		if ok && (expr.As == t.alias.Name || expr.As.IsEmpty() && name.Name == t.alias.Name) {
+			m.targetName = name
+		}
+		return false, nil
+	}, m.tableExprs)
+
+	for _, pred := range m.preds {
+		onTarget, err := m.onlyReferencesTarget(pred)
+		if err != nil && strict {
+			return err
+		}
+		if onTarget && err == nil {
+			m.targetPreds = append(m.targetPreds, pred)
+		} else {
+			m.joinPreds = append(m.joinPreds, pred)
+		}
+	}
+	return nil
+}
+
+// mergedRoute returns the route of the statement if all
+// its tables are on the same shards.
+func (m *multiTableDML) mergedRoute() (*route, bool) {
+	rb, ok := m.pb.plan.(*route)
+	return rb, ok
+}
+
+// buildMergedPlan builds the DML for a statement whose tables are all in the
+// same route. The statement is sent as is, routed by the predicates of the
+// target table.
+func (m *multiTableDML) buildMergedPlan(rb *route, stmt sqlparser.Statement, comments sqlparser.Comments) (*engine.DML, error) {
+	for _, sub := range rb.substitutions {
+		*sub.oldExpr = *sub.newExpr
+	}
+	edml := &engine.DML{
+		Keyspace: rb.eroute.Keyspace,
+		Table:    m.target.vschemaTable,
+		Query:    generateQuery(stmt),
+	}
+	directives := sqlparser.ExtractCommentDirectives(comments)
+	if directives.IsSet(sqlparser.DirectiveMultiShardAutocommit) {
+		edml.MultiShardAutocommit = true
+	}
+	edml.QueryTimeout = queryTimeout(directives)
+
+	var where *sqlparser.Where
+	if len(m.targetPreds) != 0 {
+		where = &sqlparser.Where{Type: sqlparser.WhereClause, Expr: andExpressions(m.targetPreds...)}
+	}
+	routingType, _, vindex, values, err := getDMLRouting(where, edml.Table)
+	if err != nil {
+		return nil, err
+	}
+	edml.Opcode = routingType
+	if routingType != engine.Scatter {
+		edml.Vindex = vindex
+		edml.Values = values
+	}
+	return edml, nil
+}
+
+// keyColumns returns the Vindex column of the target table, and the column
+// the join depends on, which the rows to modify are selected by.
+func (m *multiTableDML) keyColumns() (ksidCol string, keyCol string, err error) {
+	if !m.innerJoinsOnly {
+		return "", "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard %s with an outer join", m.dmlType)
+	}
+	_, ksidVindex, _, _, err := getDMLRouting(nil, m.target.vschemaTable)
+	if err != nil {
+		return "", "", err
+	}
+	if _, ok := ksidVindex.Vindex.(vindexes.SingleColumn); !ok {
+		return "", "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard %s of a table with a multi-column primary vindex", m.dmlType)
+	}
+	ksidCol = ksidColumnList(ksidVindex)
+	var key *sqlparser.ColName
+	for _, pred := range m.joinPreds {
+		err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			col, ok := node.(*sqlparser.ColName)
+			if !ok {
+				return true, nil
+			}
+			t, err := m.tableFor(col)
+			if err != nil || t != m.target {
+				return false, err
+			}
+			if key != nil && !key.Name.Equal(col.Name) {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard %s joining on more than one column of %s", m.dmlType, sqlparser.String(m.target.alias.Name))
+			}
+			key = col
+			return true, nil
+		}, pred)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	if key == nil {
+		// The target rows are joined with every row of the other tables,
+		// and are all modified if the join returns any row.
+		return ksidCol, ksidCol, nil
+	}
+	return ksidCol, sqlparser.String(key.Name), nil
+}
+
+// formatTargetWhere writes the WHERE clause of the single-table DML: the
+// predicates of the target table, and the restriction to the selected keys.
+// buf must strip the qualifiers from column names.
+func (m *multiTableDML) formatTargetWhere(buf *sqlparser.TrackedBuffer, keyCol string) {
+	buf.Myprintf(" where ")
+	for _, pred := range m.targetPreds {
+		buf.Myprintf("%v and ", pred)
+	}
+	buf.Myprintf("%s in ::%s", keyCol, engine.DMLKeyVarName)
+}
+
+// buildInput plans the query that selects the keys of the rows to modify,
+// and sets it as the Input of the single-table DML that was planned for them.
+func (m *multiTableDML) buildInput(edml *engine.DML, ksidCol, keyCol string) error {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	qualifier := sqlparser.String(m.target.alias.Name)
+	buf.Myprintf("select %s.%s, %s.%s from %v%v for update", qualifier, ksidCol, qualifier, keyCol, m.tableExprs, m.where)
+	query := buf.String()
+	sel, err := sqlparser.Parse(query)
+	if err != nil {
+		return err
+	}
+	// The V3 planner is used regardless of the configured planner, since the
+	// row locks taken by the select are what keep the two steps consistent.
+	input, err := buildSelectPlan(query)(sel, m.vschema)
+	if err != nil {
+		return err
+	}
+	edml.Input = input
+	if edml.Opcode == engine.Scatter {
+		_, ksidVindex, _, _, err := getDMLRouting(nil, edml.Table)
+		if err != nil {
+			return err
+		}
+		edml.Opcode = engine.In
+		edml.Vindex = ksidVindex.Vindex
+		edml.Values = []sqltypes.PlanValue{{ListKey: engine.DMLVindexVarName}}
+	}
+	return nil
+}
+
+// unqualifiedFormatter strips the qualifier from column names.
+func unqualifiedFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+	if col, ok := node.(*sqlparser.ColName); ok {
+		col.Name.Format(buf)
+		return
+	}
+	node.Format(buf)
+}
+
+// andExpressions combines exprs with AND.
+func andExpressions(exprs ...sqlparser.Expr) sqlparser.Expr {
+	result := exprs[0]
+	for _, expr := range exprs[1:] {
+		result = &sqlparser.AndExpr{Left: result, Right: expr}
+	}
+	return result
+}
@@ -47,6 +47,8 @@ func planOrdering(pb *primitiveBuilder, input logicalPlan, orderBy sqlparser.Ord
 		return planJoinOrdering(pb, orderBy, node)
 	case *orderedAggregate:
 		return planOAOrdering(pb, orderBy, node)
+	case *window:
+		return planWindowOrdering(pb, orderBy, node)
 	case *mergeSort:
 		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "can't do ORDER BY on top of ORDER BY")
 	}
@@ -151,6 +153,52 @@ func planOAOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, oa *ordered
 	return oa, nil
 }
 
+func planWindowOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, w *window) (logicalPlan, error) {
+	// The rows must come ordered by the partition columns, followed by the
+	// ordering of the window. Any other ordering requested by the query
+	// has to be performed after the window functions are computed.
+	// Columns that are not in the select list are requested from the
+	// route, and truncated from the results of the window.
+	supplyCol := func(col *sqlparser.ColName) int {
+		_, colNumber := w.input.SupplyCol(col)
+		if colNumber >= len(w.resultColumns) {
+			w.eWindow.TruncateColumnCount = len(w.resultColumns)
+		}
+		return colNumber
+	}
+	selOrderBy := make(sqlparser.OrderBy, 0, len(w.partitionBy)+len(w.orderBy))
+	for _, col := range w.partitionBy {
+		colNumber := supplyCol(col)
+		w.eWindow.PartitionBy = append(w.eWindow.PartitionBy, colNumber)
+		selOrderBy = append(selOrderBy, &sqlparser.Order{Expr: col, Direction: sqlparser.AscOrder})
+	}
+	for _, order := range w.orderBy {
+		colNumber := supplyCol(order.Expr.(*sqlparser.ColName))
+		w.eWindow.OrderBy = append(w.eWindow.OrderBy, engine.OrderbyParams{
+			Col:  colNumber,
+			Desc: order.Direction == sqlparser.DescOrder,
+		})
+		selOrderBy = append(selOrderBy, order)
+	}
+
+	plan, err := planOrdering(pb, w.input, selOrderBy)
+	if err != nil {
+		return nil, err
+	}
+	w.input = plan
+
+	// Treat order by null as nil order by.
+	if len(orderBy) == 0 {
+		return w, nil
+	}
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			return w, nil
+		}
+	}
+	return newMemorySort(w, orderBy)
+}
+
 func planJoinOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, node *join) (logicalPlan, error) {
 	isSpecial := false
 	switch len(orderBy) {
@@ -43,6 +43,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -185,14 +186,51 @@ func TestPlan(t *testing.T) {
 	testFile(t, "use_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "set_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "union_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "cte_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "transaction_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "lock_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "large_cases.txt", testOutputTempDir, vschemaWrapper, true)
+	testFile(t, "result_cache_cases.txt", testOutputTempDir, vschemaWrapper, true)
 	testFile(t, "ddl_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
 	testFile(t, "flush_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
 	testFile(t, "show_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper, false)
 }
 
+func TestPlanWithTableStats(t *testing.T) {
+	vschemaWrapper := &vschemaWrapper{
+		v:             loadSchema(t, "schema_test.json"),
+		sysVarEnabled: true,
+		stats: map[string]*schema.TableStats{
+			"user": {
+				Rows:        1000000,
+				Cardinality: map[string]int64{"id": 1000000, "col": 1000},
+				Shards:      4,
+			},
+			"user_extra": {
+				Rows:        1000,
+				Cardinality: map[string]int64{"extra_id": 1000, "col": 100},
+				Shards:      4,
+			},
+			"music": {
+				Rows:        10000,
+				Cardinality: map[string]int64{"col": 5000},
+				Shards:      4,
+			},
+		},
+		maxMemoryRows: 5000,
+	}
+
+	testOutputTempDir, err := ioutil.TempDir("", "plan_test")
+	require.NoError(t, err)
+	defer func() {
+		if !t.Failed() {
+			os.RemoveAll(testOutputTempDir)
+		}
+	}()
+	testFile(t, "table_stats_cases.txt", testOutputTempDir, vschemaWrapper, false)
+}
+
 func TestSysVarSetDisabled(t *testing.T) {
 	vschemaWrapper := &vschemaWrapper{
 		v:             loadSchema(t, "schema_test.json"),
@@ -295,6 +333,8 @@ type vschemaWrapper struct {
 	dest          key.Destination
 	sysVarEnabled bool
 	version       PlannerVersion
+	stats         map[string]*schema.TableStats
+	maxMemoryRows int
 }
 
 func (vw *vschemaWrapper) AllKeyspace() ([]*vindexes.Keyspace, error) {
@@ -311,6 +351,14 @@ func (vw *vschemaWrapper) GetSemTable() *semantics.SemTable {
 	return nil
 }
 
+func (vw *vschemaWrapper) TableStats(table *vindexes.Table) *schema.TableStats {
+	return vw.stats[table.Name.String()]
+}
+
+func (vw *vschemaWrapper) MaxMemoryRows() int {
+	return vw.maxMemoryRows
+}
+
 func (vw *vschemaWrapper) KeyspaceExists(keyspace string) bool {
 	if vw.keyspace != nil {
 		return vw.keyspace.Name == keyspace
@@ -558,7 +606,9 @@ func iterateExecFile(name string) (testCaseIterator chan testCase) {
 			}
 			if len(binput) > 0 && string(binput) == samePlanMarker {
 				output2Planner = output
-			} else if len(binput) > 0 && (binput[0] == '"' || binput[0] == '{') {
+			} else if len(binput) > 0 && binput[0] == '"' {
+				output2Planner = binput[1 : len(binput)-2]
+			} else if len(binput) > 0 && binput[0] == '{' {
 				output2Planner = append(output2Planner, binput...)
 				for {
 					l, err := r.ReadBytes('\n')
@@ -122,8 +122,12 @@ func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 		// If it's a scatter query, the rows returned will be
 		// more than the upper limit, but enough for the limit
 		node.Select.SetLimit(&sqlparser.Limit{Rowcount: arg})
-	case *concatenate:
+	case *concatenate, *window, *filter:
 		return false, node, nil
+	case *orderedAggregate:
+		// With distinct aggregates, the route returns
+		// more than one row for each group.
+		return !node.eaggr.HasDistinct, node, nil
 	}
 	return true, plan, nil
 }
@@ -87,6 +87,30 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 			return nil, nil, 0, errors.New("unsupported: in scatter query: complex aggregate expression")
 		}
 
+		newInput, innerRC, _, err := planProjection(pb, node.input, expr, origin)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		node.input = newInput
+		node.resultColumns = append(node.resultColumns, innerRC)
+		return node, innerRC, len(node.resultColumns) - 1, nil
+		// window works like orderedAggregate. Window functions are computed by
+		// window, which is their originator. The route only returns their argument,
+		// while normal expressions are pushed through to the route.
+	case *window:
+		if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok && inner.IsWindowFunc() {
+			rc, colNumber, err := node.pushWindowFunc(pb, expr, origin)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			return node, rc, colNumber, nil
+		}
+
+		// Ensure that there are no window functions in the expression.
+		if len(windowFuncs(expr.Expr)) != 0 {
+			return nil, nil, 0, errors.New("unsupported: in scatter query: complex window function expression")
+		}
+
 		newInput, innerRC, _, err := planProjection(pb, node.input, expr, origin)
 		if err != nil {
 			return nil, nil, 0, err
@@ -135,7 +159,30 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 	case *subquery:
 		col, ok := expr.Expr.(*sqlparser.ColName)
 		if !ok {
-			return nil, nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+			// Expressions that vtgate can evaluate are computed
+			// on the rows returned by the subquery.
+			eexpr, err := sqlparser.ConvertWithColumns(expr.Expr, func(col *sqlparser.ColName) (int, error) {
+				c, ok := col.Metadata.(*column)
+				if !ok || c.Origin() != node {
+					return 0, sqlparser.ErrExprNotSupported
+				}
+				return c.colNumber, nil
+			})
+			if err != nil {
+				return nil, nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+			}
+			name := expr.As.String()
+			if name == "" {
+				name = sqlparser.String(expr.Expr)
+			}
+			rc := newResultColumn(expr, node)
+			node.resultColumns = append(node.resultColumns, rc)
+			node.esubquery.Cols = append(node.esubquery.Cols, -1)
+			node.esubquery.Exprs = append(node.esubquery.Exprs, engine.SubqueryExpr{
+				Name: name,
+				Expr: eexpr,
+			})
+			return node, rc, len(node.resultColumns) - 1, nil
 		}
 
 		// colNumber should already be set for subquery columns.
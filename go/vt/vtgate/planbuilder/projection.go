@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ logicalPlan = (*projection)(nil)
+
+// projection is the logicalPlan for engine.Projection.
+// It computes the select expressions that can't be
+// pushed down to a route, such as expressions on the
+// results of a cross-shard aggregation.
+type projection struct {
+	logicalPlanCommon
+	eproj *engine.Projection
+}
+
+// Primitive implements the logicalPlan interface
+func (p *projection) Primitive() engine.Primitive {
+	p.eproj.Input = p.input.Primitive()
+	return p.eproj
+}
+
+// Rewrite implements the logicalPlan interface
+func (p *projection) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "projection: wrong number of inputs")
+	}
+	p.input = inputs[0]
+	return nil
+}
+
+// Inputs implements the logicalPlan interface
+func (p *projection) Inputs() []logicalPlan {
+	return []logicalPlan{p.input}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"sort"
+
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -56,14 +58,20 @@ type (
 )
 
 func (qg *queryGraph) getPredicates(lhs, rhs semantics.TableSet) []sqlparser.Expr {
-	var allExprs []sqlparser.Expr
-	for tableSet, exprs := range qg.crossTable {
+	var tableSets []semantics.TableSet
+	for tableSet := range qg.crossTable {
 		if tableSet.IsSolvedBy(lhs|rhs) &&
 			tableSet.IsOverlapping(rhs) &&
 			tableSet.IsOverlapping(lhs) {
-			allExprs = append(allExprs, exprs...)
+			tableSets = append(tableSets, tableSet)
 		}
 	}
+	// the map is iterated in random order, so we sort the table sets to produce the same plan every time
+	sort.Slice(tableSets, func(i, j int) bool { return tableSets[i] < tableSets[j] })
+	var allExprs []sqlparser.Expr
+	for _, tableSet := range tableSets {
+		allExprs = append(allExprs, qg.crossTable[tableSet]...)
+	}
 	return allExprs
 }
 
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// nondeterministicFuncs are the functions whose results
+// change from one execution of a query to the next.
+var nondeterministicFuncs = map[string]bool{
+	"benchmark":         true,
+	"connection_id":     true,
+	"curdate":           true,
+	"current_date":      true,
+	"current_role":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"curtime":           true,
+	"found_rows":        true,
+	"get_lock":          true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"master_pos_wait":   true,
+	"now":               true,
+	"rand":              true,
+	"random_bytes":      true,
+	"release_all_locks": true,
+	"release_lock":      true,
+	"row_count":         true,
+	"session_user":      true,
+	"sleep":             true,
+	"source_pos_wait":   true,
+	"sysdate":           true,
+	"system_user":       true,
+	"unix_timestamp":    true,
+	"user":              true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"uuid_short":        true,
+}
+
+// resultCacheFor returns how the results of stmt can be cached, or nil if
+// they can't be. They can be if stmt is a read that only reads tables whose
+// results are cached, and that returns the same rows as long as the tables
+// don't change. The results are cached for the shortest TTL of the tables.
+func resultCacheFor(stmt sqlparser.Statement, vschema ContextVSchema, bindVarNeeds *sqlparser.BindVarNeeds) *engine.ResultCache {
+	switch stmt.(type) {
+	case *sqlparser.Select, *sqlparser.Union:
+	default:
+		return nil
+	}
+	if vschema.Destination() != nil || (bindVarNeeds != nil && bindVarNeeds.HasRewrites()) {
+		return nil
+	}
+
+	var ttl time.Duration
+	tables := make(map[string]bool)
+	cacheable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.Lock != sqlparser.NoLock || node.Into != nil || node.SQLCalcFoundRows || (node.Cache != nil && !*node.Cache) {
+				cacheable = false
+			}
+		case *sqlparser.Union:
+			if node.Lock != sqlparser.NoLock {
+				cacheable = false
+			}
+		case *sqlparser.Nextval, *sqlparser.CurTimeFuncExpr:
+			cacheable = false
+		case *sqlparser.ColName:
+			// User defined and system variables.
+			if strings.HasPrefix(node.Name.String(), "@") {
+				cacheable = false
+			}
+		case *sqlparser.FuncExpr:
+			if nondeterministicFuncs[node.Name.Lowered()] {
+				cacheable = false
+			}
+		case *sqlparser.AliasedTableExpr:
+			name, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				break
+			}
+			table, _, _, _, _, err := vschema.FindTableOrVindex(name)
+			if err != nil || table == nil || table.ResultCacheTTL == 0 {
+				cacheable = false
+				break
+			}
+			if ttl == 0 || table.ResultCacheTTL < ttl {
+				ttl = table.ResultCacheTTL
+			}
+			tables[table.Keyspace.Name+"."+table.Name.String()] = true
+		}
+		return cacheable, nil
+	}, stmt)
+	if !cacheable || len(tables) == 0 {
+		return nil
+	}
+
+	rc := &engine.ResultCache{TTL: ttl}
+	for table := range tables {
+		rc.Tables = append(rc.Tables, table)
+	}
+	sort.Strings(rc.Tables)
+	return rc
+}
@@ -62,13 +62,39 @@ type route struct {
 	// to resolve the ERoute Values field.
 	condition sqlparser.Expr
 
+	// start and end store the bounds of a SelectRange route
+	// instead. They are nil if the range is open on that side.
+	start, end *rangeBound
+
+	// multiColumn is set if the route uses a MultiColumn vindex.
+	// multiColumnCondition then stores the values of its first
+	// columns that are used to resolve the ERoute Values field.
+	multiColumn          *multiColumn
+	multiColumnCondition []sqlparser.Expr
+
+	// multiColumnValues stores the values of the columns of the
+	// MultiColumn vindexes found in the filters so far. A column
+	// that has no value yet has a nil one.
+	multiColumnValues map[*multiColumn][]sqlparser.Expr
+
 	// eroute is the primitive being built.
 	eroute *engine.Route
 
+	// reference is set if the route only reads a table that
+	// has copies in other keyspaces, or a copy of one. The route
+	// can then be switched to the table of another keyspace.
+	reference *vindexes.Table
+
 	// tables keeps track of which tables this route is covering
 	tables semantics.TableSet
 }
 
+// rangeBound is a bound of the range of values of a SelectRange route.
+type rangeBound struct {
+	expr    sqlparser.Expr
+	exclude bool
+}
+
 type tableSubstitution struct {
 	newExpr, oldExpr *sqlparser.AliasedTableExpr
 }
@@ -152,6 +178,17 @@ func (rb *route) ContainsTables() semantics.TableSet {
 // Wireup implements the logicalPlan interface
 func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 	// Precaution: update ERoute.Values only if it's not set already.
+	if rb.eroute.Values == nil && rb.multiColumn != nil {
+		// The values of the columns of a MultiColumn vindex are
+		// resolved separately, and the query is sent unchanged.
+		for _, val := range rb.multiColumnCondition {
+			pv, err := rb.procureValues(plan, jt, val)
+			if err != nil {
+				return err
+			}
+			rb.eroute.Values = append(rb.eroute.Values, pv)
+		}
+	}
 	if rb.eroute.Values == nil {
 		// Resolve values stored in the logical plan.
 		switch vals := rb.condition.(type) {
@@ -163,7 +200,19 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 			rb.eroute.Values = []sqltypes.PlanValue{pv}
 			vals.Right = sqlparser.ListArg("::" + engine.ListVarName)
 		case nil:
-			// no-op.
+			if rb.eroute.Opcode == engine.SelectRange {
+				start, err := rb.procureBound(plan, jt, rb.start)
+				if err != nil {
+					return err
+				}
+				end, err := rb.procureBound(plan, jt, rb.end)
+				if err != nil {
+					return err
+				}
+				rb.eroute.Values = []sqltypes.PlanValue{start, end}
+				rb.eroute.ExcludeStart = rb.start != nil && rb.start.exclude
+				rb.eroute.ExcludeEnd = rb.end != nil && rb.end.exclude
+			}
 		default:
 			pv, err := rb.procureValues(plan, jt, vals)
 			if err != nil {
@@ -274,6 +323,15 @@ func (rb *route) procureValues(plan logicalPlan, jt *jointab, val sqlparser.Expr
 	}
 }
 
+// procureBound procures the value of a bound of a SelectRange.
+// The value of an open bound is NULL.
+func (rb *route) procureBound(plan logicalPlan, jt *jointab, bound *rangeBound) (sqltypes.PlanValue, error) {
+	if bound == nil {
+		return sqltypes.PlanValue{}, nil
+	}
+	return rb.procureValues(plan, jt, bound.expr)
+}
+
 func (rb *route) isLocal(col *sqlparser.ColName) bool {
 	return col.Metadata.(*column).Origin() == rb
 }
@@ -385,6 +443,7 @@ func (rb *route) Inputs() []logicalPlan {
 func (rb *route) MergeSubquery(pb *primitiveBuilder, inner *route) bool {
 	if rb.SubqueryCanMerge(pb, inner) {
 		rb.substitutions = append(rb.substitutions, inner.substitutions...)
+		rb.reference = nil
 		inner.Redirect = rb
 		return true
 	}
@@ -396,12 +455,46 @@ func (rb *route) MergeSubquery(pb *primitiveBuilder, inner *route) bool {
 func (rb *route) MergeUnion(right *route, isDistinct bool) bool {
 	if rb.unionCanMerge(right, isDistinct) {
 		rb.substitutions = append(rb.substitutions, right.substitutions...)
+		rb.reference = nil
 		right.Redirect = rb
 		return true
 	}
 	return false
 }
 
+// switchReference switches a route that reads a table with copies,
+// or a copy, to the copy or the source that is in keyspace.
+// It returns false if there is none.
+func (rb *route) switchReference(keyspace string) bool {
+	if rb.reference == nil {
+		return false
+	}
+	table := referenceIn(rb.reference, keyspace)
+	if table == nil {
+		return false
+	}
+	opcode := engine.SelectReference
+	if table.Type != vindexes.TypeReference {
+		opcode = engine.SelectUnsharded
+	}
+	rb.eroute = engine.NewSimpleRoute(opcode, table.Keyspace)
+	rb.eroute.TableName = table.Name.String()
+	rb.reference = table
+	return true
+}
+
+// referenceIn returns the copy of a table, or its source, that is in keyspace.
+// Copies have the same name as their source.
+func referenceIn(table *vindexes.Table, keyspace string) *vindexes.Table {
+	if table.Source != nil {
+		table = table.Source
+	}
+	if table.Keyspace.Name == keyspace {
+		return table
+	}
+	return table.ReferencedBy[keyspace]
+}
+
 func (rb *route) isSingleShard() bool {
 	switch rb.eroute.Opcode {
 	case engine.SelectUnsharded, engine.SelectDBA, engine.SelectNext, engine.SelectEqualUnique, engine.SelectReference:
@@ -548,59 +641,119 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	case engine.SelectUnsharded, engine.SelectNext, engine.SelectDBA, engine.SelectReference, engine.SelectNone:
 		return
 	}
-	opcode, vindex, values := rb.computePlan(pb, filter)
+	opcode, single, values := rb.computePlan(pb, filter)
+	var vindex vindexes.Vindex
+	if single != nil {
+		vindex = single
+	}
+	mcOpcode, mc, mcValues := rb.computeMultiColumnPlan(pb, filter)
+	if mc != nil && routeOpcodeCost(mcOpcode) < routeOpcodeCost(opcode) {
+		opcode, vindex, values = mcOpcode, mc.vindex, nil
+	} else {
+		mc, mcValues = nil, nil
+	}
 	if opcode == engine.SelectScatter {
 		return
 	}
 	// If we get SelectNone in next filters, override the previous route plan.
 	if opcode == engine.SelectNone {
-		rb.updateRoute(opcode, vindex, values)
+		rb.updateRoute(opcode, vindex, values, nil, nil)
+		return
+	}
+	// The new filter only adds values to the MultiColumn vindex the route uses.
+	if mc != nil && mc == rb.multiColumn {
+		rb.updateRoute(opcode, vindex, nil, mc, mcValues)
 		return
 	}
 	switch rb.eroute.Opcode {
 	case engine.SelectEqualUnique:
 		if opcode == engine.SelectEqualUnique && vindex.Cost() < rb.eroute.Vindex.Cost() {
-			rb.updateRoute(opcode, vindex, values)
+			rb.updateRoute(opcode, vindex, values, mc, mcValues)
 		}
 	case engine.SelectEqual:
 		switch opcode {
 		case engine.SelectEqualUnique:
-			rb.updateRoute(opcode, vindex, values)
+			rb.updateRoute(opcode, vindex, values, mc, mcValues)
 		case engine.SelectEqual:
 			if vindex.Cost() < rb.eroute.Vindex.Cost() {
-				rb.updateRoute(opcode, vindex, values)
+				rb.updateRoute(opcode, vindex, values, mc, mcValues)
 			}
 		}
 	case engine.SelectIN:
 		switch opcode {
 		case engine.SelectEqualUnique, engine.SelectEqual:
-			rb.updateRoute(opcode, vindex, values)
+			rb.updateRoute(opcode, vindex, values, mc, mcValues)
 		case engine.SelectIN:
 			if vindex.Cost() < rb.eroute.Vindex.Cost() {
-				rb.updateRoute(opcode, vindex, values)
+				rb.updateRoute(opcode, vindex, values, mc, mcValues)
 			}
 		}
 	case engine.SelectMultiEqual:
 		switch opcode {
 		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN:
-			rb.updateRoute(opcode, vindex, values)
+			rb.updateRoute(opcode, vindex, values, mc, mcValues)
 		case engine.SelectMultiEqual:
 			if vindex.Cost() < rb.eroute.Vindex.Cost() {
-				rb.updateRoute(opcode, vindex, values)
+				rb.updateRoute(opcode, vindex, values, mc, mcValues)
+			}
+		}
+	case engine.SelectRange:
+		switch opcode {
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual:
+			rb.updateRoute(opcode, vindex, values, mc, mcValues)
+		case engine.SelectRange:
+			if vindex == rb.eroute.Vindex {
+				rb.narrowRange(values)
 			}
 		}
 	case engine.SelectScatter:
 		switch opcode {
-		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectNone:
-			rb.updateRoute(opcode, vindex, values)
+		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectRange, engine.SelectNone:
+			rb.updateRoute(opcode, vindex, values, mc, mcValues)
 		}
 	}
 }
 
-func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr, mc *multiColumn, mcValues []sqlparser.Expr) {
 	rb.eroute.Opcode = opcode
 	rb.eroute.Vindex = vindex
 	rb.condition = condition
+	rb.multiColumn, rb.multiColumnCondition = mc, mcValues
+	rb.start, rb.end = nil, nil
+	if opcode == engine.SelectRange {
+		rb.condition = nil
+		rb.narrowRange(condition)
+	}
+}
+
+// narrowRange sets the open bounds of a SelectRange
+// from a condition returned by computeRangePlan.
+func (rb *route) narrowRange(condition sqlparser.Expr) {
+	var start, end *rangeBound
+	switch condition := condition.(type) {
+	case *sqlparser.ComparisonExpr:
+		bound := &rangeBound{
+			expr:    condition.Right,
+			exclude: condition.Operator == sqlparser.GreaterThanOp || condition.Operator == sqlparser.LessThanOp,
+		}
+		switch condition.Operator {
+		case sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+			start = bound
+		default:
+			end = bound
+		}
+	case *sqlparser.RangeCond:
+		start = &rangeBound{expr: condition.From}
+		end = &rangeBound{expr: condition.To}
+	}
+	// Only one of the bounds on each side is kept,
+	// as the values aren't known until execution.
+	if rb.start == nil {
+		rb.start = start
+	}
+	if rb.end == nil {
+		rb.end = end
+	}
 }
 
 // computePlan computes the plan for the specified filter.
@@ -614,6 +767,12 @@ func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcod
 			return rb.computeINPlan(pb, node)
 		case sqlparser.NotInOp:
 			return rb.computeNotInPlan(node.Right), nil, nil
+		case sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+			return rb.computeRangePlan(pb, node)
+		}
+	case *sqlparser.RangeCond:
+		if node.Operator == sqlparser.BetweenOp {
+			return rb.computeBetweenPlan(pb, node)
 		}
 	case *sqlparser.IsExpr:
 		return rb.computeISPlan(pb, node)
@@ -621,6 +780,102 @@ func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcod
 	return engine.SelectScatter, nil, nil
 }
 
+// computeMultiColumnPlan computes the plan for the specified filter
+// using the MultiColumn vindexes of the route. The values of their
+// columns are added up over the filters, so that a vindex can be used
+// once the filters give values to its first columns.
+func (rb *route) computeMultiColumnPlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, mc *multiColumn, values []sqlparser.Expr) {
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return engine.SelectScatter, nil, nil
+	}
+	var refs []multiColumnRef
+	var value sqlparser.Expr
+	switch comparison.Operator {
+	case sqlparser.EqualOp:
+		left, right := comparison.Left, comparison.Right
+		refs = pb.st.MultiColumns(left, rb)
+		if refs == nil {
+			left, right = right, left
+			refs = pb.st.MultiColumns(left, rb)
+		}
+		if refs == nil || !rb.exprIsValue(right) {
+			return engine.SelectScatter, nil, nil
+		}
+		value = right
+	case sqlparser.InOp:
+		refs = pb.st.MultiColumns(comparison.Left, rb)
+		switch node := comparison.Right.(type) {
+		case sqlparser.ValTuple:
+			for _, n := range node {
+				if !rb.exprIsValue(n) {
+					return engine.SelectScatter, nil, nil
+				}
+			}
+		case sqlparser.ListArg:
+		default:
+			return engine.SelectScatter, nil, nil
+		}
+		value = comparison.Right
+	default:
+		return engine.SelectScatter, nil, nil
+	}
+
+	opcode = engine.SelectScatter
+	for _, ref := range refs {
+		refOpcode, refValues := rb.addMultiColumnValue(ref, value)
+		if refValues != nil && (mc == nil || routeOpcodeCost(refOpcode) < routeOpcodeCost(opcode)) {
+			opcode, mc, values = refOpcode, ref.multiColumn, refValues
+		}
+	}
+	return opcode, mc, values
+}
+
+// addMultiColumnValue sets the value of a column of a MultiColumn vindex,
+// unless it already has one. It returns the opcode of a route using the
+// vindex and the values to route by, which are nil if it can't be used:
+// a vindex that maps partial rows can be used by the values of its first
+// columns, and each of them can be a list.
+func (rb *route) addMultiColumnValue(ref multiColumnRef, value sqlparser.Expr) (engine.RouteOpcode, []sqlparser.Expr) {
+	if rb.multiColumnValues == nil {
+		rb.multiColumnValues = make(map[*multiColumn][]sqlparser.Expr)
+	}
+	values := rb.multiColumnValues[ref.multiColumn]
+	if values == nil {
+		values = make([]sqlparser.Expr, ref.multiColumn.columns)
+		rb.multiColumnValues[ref.multiColumn] = values
+	}
+	if values[ref.index] == nil {
+		values[ref.index] = value
+	}
+
+	prefix, hasList := 0, false
+	for _, value := range values {
+		if value == nil {
+			break
+		}
+		prefix++
+		switch value.(type) {
+		case sqlparser.ValTuple, sqlparser.ListArg:
+			hasList = true
+		}
+	}
+	covered := prefix == len(values)
+	// The values are copied, as the ones of the vindex keep being added to.
+	condition := make([]sqlparser.Expr, prefix)
+	copy(condition, values)
+	switch {
+	case prefix == 0, !covered && !ref.multiColumn.vindex.PartialVindex():
+		return engine.SelectScatter, nil
+	case hasList:
+		return engine.SelectMultiEqual, condition
+	case covered && ref.multiColumn.vindex.IsUnique():
+		return engine.SelectEqualUnique, condition
+	default:
+		return engine.SelectEqual, condition
+	}
+}
+
 // computeEqualPlan computes the plan for an equality constraint.
 func (rb *route) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
 	left := comparison.Left
@@ -647,6 +902,59 @@ func (rb *route) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.Co
 	return engine.SelectEqual, vindex, right
 }
 
+// computeRangePlan computes the plan for a comparison of the column of a
+// vindex that maps values monotonically. The condition it returns is the
+// comparison with the column on the left.
+func (rb *route) computeRangePlan(pb *primitiveBuilder, comparison *sqlparser.ComparisonExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+	left := comparison.Left
+	right := comparison.Right
+	operator := comparison.Operator
+
+	if sqlparser.IsNull(left) || sqlparser.IsNull(right) {
+		return engine.SelectNone, nil, nil
+	}
+
+	vindex = pb.st.Vindex(left, rb)
+	if rangeMapper(vindex) == nil {
+		left, right, operator = right, left, flippedOperators[operator]
+		vindex = pb.st.Vindex(left, rb)
+		if rangeMapper(vindex) == nil {
+			return engine.SelectScatter, nil, nil
+		}
+	}
+	if !rb.exprIsValue(right) {
+		return engine.SelectScatter, nil, nil
+	}
+	return engine.SelectRange, vindex, &sqlparser.ComparisonExpr{Operator: operator, Left: left, Right: right}
+}
+
+// flippedOperators contains the operators that
+// are equivalent once their operands are swapped.
+var flippedOperators = map[sqlparser.ComparisonExprOperator]sqlparser.ComparisonExprOperator{
+	sqlparser.LessThanOp:     sqlparser.GreaterThanOp,
+	sqlparser.LessEqualOp:    sqlparser.GreaterEqualOp,
+	sqlparser.GreaterThanOp:  sqlparser.LessThanOp,
+	sqlparser.GreaterEqualOp: sqlparser.LessEqualOp,
+}
+
+// computeBetweenPlan computes the plan for a BETWEEN constraint.
+func (rb *route) computeBetweenPlan(pb *primitiveBuilder, rangeCond *sqlparser.RangeCond) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+	if sqlparser.IsNull(rangeCond.From) || sqlparser.IsNull(rangeCond.To) {
+		return engine.SelectNone, nil, nil
+	}
+	vindex = pb.st.Vindex(rangeCond.Left, rb)
+	if rangeMapper(vindex) == nil || !rb.exprIsValue(rangeCond.From) || !rb.exprIsValue(rangeCond.To) {
+		return engine.SelectScatter, nil, nil
+	}
+	return engine.SelectRange, vindex, rangeCond
+}
+
+// rangeMapper returns the vindex if it maps values monotonically.
+func rangeMapper(vindex vindexes.SingleColumn) vindexes.RangeMapper {
+	mapper, _ := vindex.(vindexes.RangeMapper)
+	return mapper
+}
+
 // computeIS computes the plan for an equality constraint.
 func (rb *route) computeISPlan(pb *primitiveBuilder, comparison *sqlparser.IsExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, expr sqlparser.Expr) {
 	// we only handle IS NULL correct. IsExpr can contain other expressions as well
@@ -23,7 +23,9 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
@@ -46,7 +48,7 @@ func gen4Planner(_ string) func(sqlparser.Statement, ContextVSchema) (engine.Pri
 }
 
 func newBuildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
-	semTable, err := semantics.Analyse(sel) // TODO no nil no
+	semTable, err := semantics.Analyse(sel, vschema)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +76,8 @@ func newBuildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.P
 		return nil, err
 	}
 
-	if err := planProjections(sel, plan, semTable); err != nil {
+	plan, err = planHorizon(sel, tree, plan, semTable)
+	if err != nil {
 		return nil, err
 	}
 
@@ -118,6 +121,8 @@ func planProjections(sel *sqlparser.Select, plan logicalPlan, semTable *semantic
 		ast := rb.Select.(*sqlparser.Select)
 		ast.Distinct = sel.Distinct
 		ast.GroupBy = sel.GroupBy
+		ast.Having = sel.Having
+		ast.Windows = sel.Windows
 		ast.OrderBy = sel.OrderBy
 		ast.SelectExprs = sel.SelectExprs
 		ast.Comments = sel.Comments
@@ -155,6 +160,9 @@ type (
 	routeTable struct {
 		qtable *queryTable
 		vtable *vindexes.Table
+
+		// stats is nil if the statistics of the table are not known
+		stats *schema.TableStats
 	}
 	routePlan struct {
 		routeOpCode engine.RouteOpcode
@@ -171,6 +179,9 @@ type (
 		// vindex and vindexValues is set if a vindex will be used for this route.
 		vindex       vindexes.Vindex
 		vindexValues []sqltypes.PlanValue
+		// excludeStart and excludeEnd are set if the bounds of
+		// a SelectRange, in vindexValues, are excluded from it.
+		excludeStart, excludeEnd bool
 
 		// here we store the possible vindexes we can use so that when we add predicates to the plan,
 		// we can quickly check if the new predicates enables any new vindex options
@@ -186,6 +197,13 @@ type (
 		// arguments that need to be copied from the LHS/RHS
 		vars map[string]int
 
+		// hashJoin is set when the join is done in vtgate by
+		// comparing the lhsKey and rhsKey columns of both sides,
+		// instead of sending the RHS query once per LHS row
+		hashJoin       bool
+		lhsKey, rhsKey int
+		hashPredicate  sqlparser.Expr
+
 		lhs, rhs joinTree
 	}
 	routeTables []*routeTable
@@ -204,6 +222,7 @@ func (rp *routePlan) clone() joinTree {
 		p := *pred
 		result.vindexPreds[i] = &p
 	}
+	result.columns = append([]*sqlparser.ColName(nil), rp.columns...)
 	return &result
 }
 
@@ -214,7 +233,12 @@ func (rp *routePlan) tables() semantics.TableSet {
 
 // cost implements the joinTree interface
 func (rp *routePlan) cost() int {
-	switch rp.routeOpCode {
+	return routeOpcodeCost(rp.routeOpCode)
+}
+
+// routeOpcodeCost returns the cost of a route that uses the opcode.
+func routeOpcodeCost(opcode engine.RouteOpcode) int {
+	switch opcode {
 	case // these op codes will never be compared with each other - they are assigned by a rule and not a comparison
 		engine.SelectDBA,
 		engine.SelectNext,
@@ -231,6 +255,8 @@ func (rp *routePlan) cost() int {
 		return 10
 	case engine.SelectMultiEqual:
 		return 10
+	case engine.SelectRange:
+		return 15
 	case engine.SelectScatter:
 		return 20
 	}
@@ -240,11 +266,68 @@ func (rp *routePlan) cost() int {
 // vindexPlusPredicates is a struct used to store all the predicates that the vindex can be used to query
 type vindexPlusPredicates struct {
 	vindex *vindexes.ColumnVindex
+	// values holds the value of each column of the vindex, in the order
+	// of the columns. The columns that have no predicate yet have none.
 	values []sqltypes.PlanValue
 	// Vindex is covered if all the columns in the vindex have an associated predicate
 	covered bool
 }
 
+// setValue sets the value of a column of the vindex, unless it already
+// has one, and returns true if the vindex can now be used by the route.
+func (v *vindexPlusPredicates) setValue(col int, value sqltypes.PlanValue) bool {
+	// The values are copied, as they are shared with the clones of the route.
+	values := make([]sqltypes.PlanValue, len(v.vindex.Columns))
+	copy(values, v.values)
+	if !values[col].IsNull() {
+		return false
+	}
+	values[col] = value
+	v.values = values
+	v.covered = true
+	for _, value := range values {
+		v.covered = v.covered && !value.IsNull()
+	}
+	_, _, ok := v.routeOpcode()
+	return ok
+}
+
+// routeOpcode returns the opcode of a route that uses the vindex, and the
+// values to route by, or false if the predicates don't allow to use it.
+// A MultiColumn vindex that maps partial rows can be used by the values
+// of its first columns, and each of them can be a list.
+func (v *vindexPlusPredicates) routeOpcode() (engine.RouteOpcode, []sqltypes.PlanValue, bool) {
+	multiColumn, isMultiColumn := v.vindex.Vindex.(vindexes.MultiColumn)
+	if !isMultiColumn {
+		switch {
+		case !v.covered:
+			return 0, nil, false
+		case v.vindex.Vindex.IsUnique():
+			return engine.SelectEqualUnique, v.values, true
+		default:
+			return engine.SelectEqual, v.values, true
+		}
+	}
+	prefix, hasList := 0, false
+	for _, value := range v.values {
+		if value.IsNull() {
+			break
+		}
+		prefix++
+		hasList = hasList || value.IsList()
+	}
+	switch {
+	case prefix == 0, !v.covered && !multiColumn.PartialVindex():
+		return 0, nil, false
+	case hasList:
+		return engine.SelectMultiEqual, v.values[:prefix], true
+	case v.covered && multiColumn.IsUnique():
+		return engine.SelectEqualUnique, v.values, true
+	default:
+		return engine.SelectEqual, v.values[:prefix], true
+	}
+}
+
 // addPredicate clones this routePlan and returns a new one with these predicates added to it. if the predicates can help,
 // they will improve the routeOpCode
 func (rp *routePlan) addPredicate(predicates ...sqlparser.Expr) error {
@@ -301,42 +384,161 @@ func (rp *routePlan) searchForNewVindexes(predicates []sqlparser.Expr) (bool, er
 						return false, err
 					}
 					if ok {
-						for _, col := range v.vindex.Columns {
-							// If the column for the predicate matches any column in the vindex add it to the list
+						for i, col := range v.vindex.Columns {
+							// If the column for the predicate matches any column in the vindex set its value
 							if column.Name.Equal(col) {
-								v.values = append(v.values, value)
-								// Vindex is covered if all the columns in the vindex have a associated predicate
-								v.covered = len(v.values) == len(v.vindex.Columns)
-								newVindexFound = newVindexFound || v.covered
+								newVindexFound = v.setValue(i, value) || newVindexFound
 							}
 						}
 					}
 				}
+			case sqlparser.InOp:
+				// Only the columns of MultiColumn vindexes are routed
+				// by lists of values for now.
+				column, ok := node.Left.(*sqlparser.ColName)
+				if !ok || !sqlparser.IsSimpleTuple(node.Right) {
+					continue
+				}
+				value, err := sqlparser.NewPlanValue(node.Right)
+				if err != nil {
+					continue
+				}
+				for _, v := range rp.vindexPreds {
+					if _, ok := v.vindex.Vindex.(vindexes.MultiColumn); !ok {
+						continue
+					}
+					for i, col := range v.vindex.Columns {
+						if column.Name.Equal(col) {
+							newVindexFound = v.setValue(i, value) || newVindexFound
+						}
+					}
+				}
+			case sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+				if sqlparser.IsNull(node.Left) || sqlparser.IsNull(node.Right) {
+					rp.routeOpCode = engine.SelectNone
+					return false, nil
+				}
+				column, ok := node.Left.(*sqlparser.ColName)
+				other, operator := node.Right, node.Operator
+				if !ok {
+					column, ok = node.Right.(*sqlparser.ColName)
+					other, operator = node.Left, flippedOperators[operator]
+				}
+				if !ok {
+					continue
+				}
+				value, err := rangeBoundValue(other)
+				if err != nil {
+					return false, err
+				}
+				if value == nil {
+					// the bound isn't known at planning time
+					continue
+				}
+				switch operator {
+				case sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+					rp.narrowRange(column, value, nil, operator == sqlparser.GreaterThanOp, false)
+				default:
+					rp.narrowRange(column, nil, value, false, operator == sqlparser.LessThanOp)
+				}
+			}
+		case *sqlparser.RangeCond:
+			column, ok := node.Left.(*sqlparser.ColName)
+			if node.Operator != sqlparser.BetweenOp || !ok {
+				continue
 			}
+			if sqlparser.IsNull(node.From) || sqlparser.IsNull(node.To) {
+				rp.routeOpCode = engine.SelectNone
+				return false, nil
+			}
+			start, err := rangeBoundValue(node.From)
+			if err != nil {
+				return false, err
+			}
+			end, err := rangeBoundValue(node.To)
+			if err != nil {
+				return false, err
+			}
+			if start == nil || end == nil {
+				continue
+			}
+			rp.narrowRange(column, start, end, false, false)
 		}
 	}
 	return newVindexFound, nil
 }
 
+// rangeBoundValue returns the PlanValue of a bound of a range predicate,
+// or nil if the expression is too complex to be one.
+func rangeBoundValue(expr sqlparser.Expr) (*sqltypes.PlanValue, error) {
+	value, err := sqlparser.NewPlanValue(expr)
+	if err != nil {
+		if strings.Contains(err.Error(), "expression is too complex") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &value, nil
+}
+
+// narrowRange routes a scatter route by the range of values of a range
+// predicate on the column of a vindex that maps values monotonically.
+// If the route already uses the vindex, its open bounds are set.
+func (rp *routePlan) narrowRange(column *sqlparser.ColName, start, end *sqltypes.PlanValue, excludeStart, excludeEnd bool) {
+	var vindex vindexes.Vindex
+	for _, v := range rp.vindexPreds {
+		if _, ok := v.vindex.Vindex.(vindexes.RangeMapper); ok && column.Name.Equal(v.vindex.Columns[0]) {
+			vindex = v.vindex.Vindex
+			break
+		}
+	}
+	switch {
+	case vindex == nil:
+		return
+	case rp.routeOpCode == engine.SelectScatter:
+		rp.routeOpCode = engine.SelectRange
+		rp.vindex = vindex
+		rp.vindexValues = make([]sqltypes.PlanValue, 2)
+		rp.excludeStart, rp.excludeEnd = false, false
+	case rp.routeOpCode != engine.SelectRange || rp.vindex != vindex:
+		return
+	}
+	// The values are copied, as they are shared with the clones of the route.
+	values := append([]sqltypes.PlanValue(nil), rp.vindexValues...)
+	if start != nil && values[0].IsNull() {
+		values[0], rp.excludeStart = *start, excludeStart
+	}
+	if end != nil && values[1].IsNull() {
+		values[1], rp.excludeEnd = *end, excludeEnd
+	}
+	rp.vindexValues = values
+}
+
 // pickBestAvailableVindex goes over the available vindexes for this route and picks the best one available.
+// The route keeps its opcode if it is cheaper than using any of them.
 func (rp *routePlan) pickBestAvailableVindex() {
+	var best *vindexPlusPredicates
+	var bestOpcode engine.RouteOpcode
+	var bestValues []sqltypes.PlanValue
 	for _, v := range rp.vindexPreds {
-		if !v.covered {
+		opcode, values, ok := v.routeOpcode()
+		if !ok {
 			continue
 		}
-		// Choose the minimum cost vindex from the ones which are covered
-		if rp.vindex == nil || v.vindex.Vindex.Cost() < rp.vindex.Cost() {
-			rp.vindex = v.vindex.Vindex
-			rp.vindexValues = v.values
+		// Choose the cheapest route, and the minimum cost vindex for it
+		if best == nil ||
+			routeOpcodeCost(opcode) < routeOpcodeCost(bestOpcode) ||
+			routeOpcodeCost(opcode) == routeOpcodeCost(bestOpcode) && v.vindex.Vindex.Cost() < best.vindex.Vindex.Cost() {
+			best, bestOpcode, bestValues = v, opcode, values
 		}
 	}
-
-	if rp.vindex != nil {
-		rp.routeOpCode = engine.SelectEqual
-		if rp.vindex.IsUnique() {
-			rp.routeOpCode = engine.SelectEqualUnique
-		}
+	if best == nil || routeOpcodeCost(bestOpcode) > rp.cost() {
+		return
 	}
+	rp.routeOpCode = bestOpcode
+	rp.vindex = best.vindex.Vindex
+	rp.vindexValues = bestValues
+	rp.excludeStart, rp.excludeEnd = false, false
 }
 
 // Predicates takes all known predicates for this route and ANDs them together
@@ -374,8 +576,19 @@ func (jp *joinPlan) cost() int {
 
 func (jp *joinPlan) clone() joinTree {
 	result := &joinPlan{
-		lhs: jp.lhs.clone(),
-		rhs: jp.rhs.clone(),
+		columns:       append([]int(nil), jp.columns...),
+		lhs:           jp.lhs.clone(),
+		rhs:           jp.rhs.clone(),
+		hashJoin:      jp.hashJoin,
+		lhsKey:        jp.lhsKey,
+		rhsKey:        jp.rhsKey,
+		hashPredicate: jp.hashPredicate,
+	}
+	if jp.vars != nil {
+		result.vars = make(map[string]int, len(jp.vars))
+		for k, v := range jp.vars {
+			result.vars[k] = v
+		}
 	}
 	return result
 }
@@ -394,15 +607,17 @@ func (jp *joinPlan) pushOutputColumns(columns []*sqlparser.ColName, semTable *se
 		}
 	}
 	lhsOffset := jp.lhs.pushOutputColumns(lhs, semTable)
-	rhsOffset := -jp.rhs.pushOutputColumns(rhs, semTable)
+	rhsOffset := jp.rhs.pushOutputColumns(rhs, semTable)
 
+	// the columns of the LHS are negative and the ones of the RHS positive,
+	// both starting at 1, as expected by the Join primitives
 	for _, left := range toTheLeft {
 		if left {
-			jp.columns = append(jp.columns, lhsOffset)
+			jp.columns = append(jp.columns, -(lhsOffset + 1))
 			lhsOffset++
 		} else {
-			jp.columns = append(jp.columns, rhsOffset)
-			rhsOffset--
+			jp.columns = append(jp.columns, rhsOffset+1)
+			rhsOffset++
 		}
 	}
 	return resultIdx
@@ -419,10 +634,11 @@ func pushPredicate2(exprs []sqlparser.Expr, tree joinTree, semTable *semantics.S
 		return plan, nil
 
 	case *joinPlan:
+		plan := node.clone().(*joinPlan)
 		// we break up the predicates so that colnames from the LHS are replaced by arguments
 		var rhsPreds []sqlparser.Expr
 		var lhsColumns []*sqlparser.ColName
-		lhsSolves := node.lhs.tables()
+		lhsSolves := plan.lhs.tables()
 		for _, expr := range exprs {
 			cols, predicate, err := breakPredicateInLHSandRHS(expr, semTable, lhsSolves)
 			if err != nil {
@@ -431,15 +647,34 @@ func pushPredicate2(exprs []sqlparser.Expr, tree joinTree, semTable *semantics.S
 			lhsColumns = append(lhsColumns, cols...)
 			rhsPreds = append(rhsPreds, predicate)
 		}
-		node.pushOutputColumns(lhsColumns, semTable)
-		rhsPlan, err := pushPredicate2(rhsPreds, node.rhs, semTable)
+		if plan.hashJoin && len(lhsColumns) > 0 {
+			// A hash join sends the RHS query once, so the RHS can't be
+			// bound to the LHS columns: the join falls back to a nested
+			// loop, and its predicate is pushed to the RHS like the others.
+			cols, predicate, err := breakPredicateInLHSandRHS(plan.hashPredicate, semTable, lhsSolves)
+			if err != nil {
+				return nil, err
+			}
+			lhsColumns = append(lhsColumns, cols...)
+			rhsPreds = append(rhsPreds, predicate)
+			plan.hashJoin, plan.lhsKey, plan.rhsKey, plan.hashPredicate = false, 0, 0, nil
+		}
+		if len(lhsColumns) > 0 {
+			// the arguments are bound to the LHS columns for every LHS row
+			offset := plan.lhs.pushOutputColumns(lhsColumns, semTable)
+			if plan.vars == nil {
+				plan.vars = make(map[string]int)
+			}
+			for i, col := range lhsColumns {
+				plan.vars[col.CompliantName("")] = offset + i
+			}
+		}
+		rhsPlan, err := pushPredicate2(rhsPreds, plan.rhs, semTable)
 		if err != nil {
 			return nil, err
 		}
-		return &joinPlan{
-			lhs: node.lhs,
-			rhs: rhsPlan,
-		}, nil
+		plan.rhs = rhsPlan
+		return plan, nil
 	default:
 		panic(fmt.Sprintf("BUG: unknown type %T", node))
 	}
@@ -466,14 +701,73 @@ func breakPredicateInLHSandRHS(expr sqlparser.Expr, semTable *semantics.SemTable
 	return
 }
 
-func mergeOrJoin(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable) (joinTree, error) {
+func mergeOrJoin(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable, vschema ContextVSchema) (joinTree, error) {
 	newPlan := tryMerge(lhs, rhs, joinPredicates, semTable)
 	if newPlan != nil {
 		return newPlan, nil
 	}
 
 	tree := &joinPlan{lhs: lhs.clone(), rhs: rhs.clone()}
-	return pushPredicate2(joinPredicates, tree, semTable)
+	newPlan, err := pushPredicate2(joinPredicates, tree, semTable)
+	if err != nil {
+		return nil, err
+	}
+	hashJoin := tryHashJoin(lhs, rhs, joinPredicates, semTable)
+	if hashJoin == nil {
+		return newPlan, nil
+	}
+	// The hash join loads all the rows of the LHS in memory, so it is
+	// only used if the statistics estimate that they fit in max_memory_rows.
+	// Without statistics, the nested loop is used, as it always runs.
+	lhsEstimate, ok := estimate(hashJoin.(*joinPlan).lhs, semTable)
+	if !ok || lhsEstimate.rows >= float64(vschema.MaxMemoryRows()) {
+		return newPlan, nil
+	}
+	if cheaper(hashJoin, newPlan, semTable) {
+		return hashJoin, nil
+	}
+	return newPlan, nil
+}
+
+// tryHashJoin returns a hash join between two scatter routes if they are
+// joined by a single equality between a column of each side. Sending both
+// queries once can be cheaper than sending the RHS query to every shard
+// for each of the LHS rows.
+func tryHashJoin(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable) joinTree {
+	lhsRoute, ok := lhs.(*routePlan)
+	if !ok || lhsRoute.routeOpCode != engine.SelectScatter {
+		return nil
+	}
+	rhsRoute, ok := rhs.(*routePlan)
+	if !ok || rhsRoute.routeOpCode != engine.SelectScatter {
+		return nil
+	}
+	if len(joinPredicates) != 1 {
+		return nil
+	}
+	cmp, ok := joinPredicates[0].(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil
+	}
+	lhsCol, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	rhsCol, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if semTable.Dependencies(lhsCol).IsSolvedBy(rhs.tables()) {
+		lhsCol, rhsCol = rhsCol, lhsCol
+	}
+	if !semTable.Dependencies(lhsCol).IsSolvedBy(lhs.tables()) || !semTable.Dependencies(rhsCol).IsSolvedBy(rhs.tables()) {
+		return nil
+	}
+
+	tree := &joinPlan{lhs: lhs.clone(), rhs: rhs.clone(), hashJoin: true, hashPredicate: cmp}
+	tree.lhsKey = tree.lhs.pushOutputColumns([]*sqlparser.ColName{lhsCol}, semTable)
+	tree.rhsKey = tree.rhs.pushOutputColumns([]*sqlparser.ColName{rhsCol}, semTable)
+	return tree
 }
 
 type (
@@ -498,7 +792,7 @@ func greedySolve(qg *queryGraph, semTable *semantics.SemTable, vschema ContextVS
 
 	crossJoinsOK := false
 	for len(joinTrees) > 1 {
-		bestTree, lIdx, rIdx, err := findBestJoinTree(qg, semTable, joinTrees, planCache, crossJoinsOK)
+		bestTree, lIdx, rIdx, err := findBestJoinTree(qg, semTable, vschema, joinTrees, planCache, crossJoinsOK)
 		if err != nil {
 			return nil, err
 		}
@@ -524,14 +818,14 @@ func greedySolve(qg *queryGraph, semTable *semantics.SemTable, vschema ContextVS
 	return joinTrees[0], nil
 }
 
-func (cm cacheMap) getJoinTreeFor(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable) (joinTree, error) {
+func (cm cacheMap) getJoinTreeFor(lhs, rhs joinTree, joinPredicates []sqlparser.Expr, semTable *semantics.SemTable, vschema ContextVSchema) (joinTree, error) {
 	solves := tableSetPair{left: lhs.tables(), right: rhs.tables()}
 	cachedPlan := cm[solves]
 	if cachedPlan != nil {
 		return cachedPlan, nil
 	}
 
-	join, err := mergeOrJoin(lhs, rhs, joinPredicates, semTable)
+	join, err := mergeOrJoin(lhs, rhs, joinPredicates, semTable, vschema)
 	if err != nil {
 		return nil, err
 	}
@@ -542,6 +836,7 @@ func (cm cacheMap) getJoinTreeFor(lhs, rhs joinTree, joinPredicates []sqlparser.
 func findBestJoinTree(
 	qg *queryGraph,
 	semTable *semantics.SemTable,
+	vschema ContextVSchema,
 	plans []joinTree,
 	planCache cacheMap,
 	crossJoinsOK bool,
@@ -558,11 +853,11 @@ func findBestJoinTree(
 				// cartesian product, which is almost always a bad idea
 				continue
 			}
-			plan, err := planCache.getJoinTreeFor(lhs, rhs, joinPredicates, semTable)
+			plan, err := planCache.getJoinTreeFor(lhs, rhs, joinPredicates, semTable, vschema)
 			if err != nil {
 				return nil, 0, 0, err
 			}
-			if bestPlan == nil || plan.cost() < bestPlan.cost() {
+			if bestPlan == nil || cheaper(plan, bestPlan, semTable) {
 				bestPlan = plan
 				// remember which plans we based on, so we can remove them later
 				lIdx = i
@@ -586,7 +881,7 @@ func leftToRightSolve(qg *queryGraph, semTable *semantics.SemTable, vschema Cont
 			continue
 		}
 		joinPredicates := qg.getPredicates(acc.tables(), plan.tables())
-		acc, err = mergeOrJoin(acc, plan, joinPredicates, semTable)
+		acc, err = mergeOrJoin(acc, plan, joinPredicates, semTable, vschema)
 		if err != nil {
 			return nil, err
 		}
@@ -625,6 +920,7 @@ func createRoutePlan(table *queryTable, solves semantics.TableSet, vschema Conte
 		_tables: []*routeTable{{
 			qtable: table,
 			vtable: vschemaTable,
+			stats:  vschema.TableStats(vschemaTable),
 		}},
 		keyspace: vschemaTable.Keyspace,
 	}
@@ -657,6 +953,42 @@ func createRoutePlan(table *queryTable, solves semantics.TableSet, vschema Conte
 	return plan, nil
 }
 
+// switchReference returns a copy of a route that only reads a table with
+// copies, or a copy, that reads the copy or the source that is in keyspace
+// instead. It returns nil if there is none.
+func (rp *routePlan) switchReference(keyspace string) *routePlan {
+	if len(rp._tables) != 1 {
+		return nil
+	}
+	switch rp.routeOpCode {
+	case engine.SelectReference, engine.SelectUnsharded:
+	default:
+		return nil
+	}
+	table := referenceIn(rp._tables[0].vtable, keyspace)
+	if table == nil {
+		return nil
+	}
+	result := rp.clone().(*routePlan)
+	result._tables = routeTables{{
+		qtable: rp._tables[0].qtable,
+		vtable: table,
+		stats:  rp._tables[0].stats,
+	}}
+	result.keyspace = table.Keyspace
+	result.routeOpCode = engine.SelectReference
+	if table.Type != vindexes.TypeReference {
+		result.routeOpCode = engine.SelectUnsharded
+	}
+	return result
+}
+
+// useVindexOf makes the route use the vindex of another route.
+func (rp *routePlan) useVindexOf(other *routePlan) {
+	rp.vindex, rp.vindexValues = other.vindex, other.vindexValues
+	rp.excludeStart, rp.excludeEnd = other.excludeStart, other.excludeEnd
+}
+
 func findColumnVindex(a *routePlan, exp sqlparser.Expr, sem *semantics.SemTable) vindexes.SingleColumn {
 	left, isCol := exp.(*sqlparser.ColName)
 	if !isCol {
@@ -676,6 +1008,27 @@ func findColumnVindex(a *routePlan, exp sqlparser.Expr, sem *semantics.SemTable)
 	return nil
 }
 
+// findColumnType returns the type of a column of the route, as declared
+// in the vschema, or NULL_TYPE if it isn't known.
+func findColumnType(rp *routePlan, expr sqlparser.Expr, sem *semantics.SemTable) querypb.Type {
+	col, isCol := expr.(*sqlparser.ColName)
+	if !isCol {
+		return sqltypes.Null
+	}
+	dep := sem.Dependencies(col)
+	for _, table := range rp._tables {
+		if !dep.IsSolvedBy(table.qtable.tableID) {
+			continue
+		}
+		for _, column := range table.vtable.Columns {
+			if column.Name.Equal(col.Name) {
+				return column.Type
+			}
+		}
+	}
+	return sqltypes.Null
+}
+
 func canMergeOnFilter(a, b *routePlan, predicate sqlparser.Expr, sem *semantics.SemTable) bool {
 	comparison, ok := predicate.(*sqlparser.ComparisonExpr)
 	if !ok {
@@ -721,7 +1074,15 @@ func tryMerge(a, b joinTree, joinPredicates []sqlparser.Expr, semTable *semantic
 		return nil
 	}
 	if aRoute.keyspace != bRoute.keyspace {
-		return nil
+		// A table with copies is read from the one that is
+		// in the keyspace of the other route, if there is one.
+		if switched := bRoute.switchReference(aRoute.keyspace.Name); switched != nil {
+			bRoute = switched
+		} else if switched := aRoute.switchReference(bRoute.keyspace.Name); switched != nil {
+			aRoute = switched
+		} else {
+			return nil
+		}
 	}
 
 	newTabletSet := aRoute.solved | bRoute.solved
@@ -736,12 +1097,23 @@ func tryMerge(a, b joinTree, joinPredicates []sqlparser.Expr, semTable *semantic
 		vindexPreds: append(aRoute.vindexPreds, bRoute.vindexPreds...),
 	}
 
+	switch {
+	case bRoute.routeOpCode == engine.SelectReference:
+		// Any route can be merged with a reference table.
+		r.useVindexOf(aRoute)
+		return r
+	case aRoute.routeOpCode == engine.SelectReference:
+		r.routeOpCode = bRoute.routeOpCode
+		r.useVindexOf(bRoute)
+		return r
+	}
+
 	switch aRoute.routeOpCode {
 	case engine.SelectUnsharded, engine.SelectDBA:
 		if aRoute.routeOpCode != bRoute.routeOpCode {
 			return nil
 		}
-	case engine.SelectScatter, engine.SelectEqualUnique:
+	case engine.SelectScatter, engine.SelectEqualUnique, engine.SelectEqual, engine.SelectMultiEqual, engine.SelectRange:
 		if len(joinPredicates) == 0 {
 			// If we are doing two Scatters, we have to make sure that the
 			// joins are on the correct vindex to allow them to be merged
@@ -753,6 +1125,14 @@ func tryMerge(a, b joinTree, joinPredicates []sqlparser.Expr, semTable *semantic
 		if !canMerge {
 			return nil
 		}
+		// The merged route can be routed by the range of either route.
+		for _, route := range []*routePlan{aRoute, bRoute} {
+			if route.routeOpCode == engine.SelectRange {
+				r.routeOpCode = engine.SelectRange
+				r.useVindexOf(route)
+				break
+			}
+		}
 		r.pickBestAvailableVindex()
 	}
 
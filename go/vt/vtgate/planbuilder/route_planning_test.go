@@ -124,3 +124,15 @@ func TestClone(t *testing.T) {
 	assert.True(t, clonedRP.vindexPreds[0].covered)
 	assert.False(t, original.vindexPreds[0].covered)
 }
+
+func TestHashJoinWithArguments(t *testing.T) {
+	ks := &vindexes.Keyspace{Name: "user", Sharded: true}
+	tree := &joinPlan{
+		lhs:      selectScatter(1, ks),
+		rhs:      selectScatter(2, ks),
+		hashJoin: true,
+		vars:     map[string]int{"user_col": 0},
+	}
+	_, err := transformJoinPlan(tree, semantics.NewSemTable())
+	assert.EqualError(t, err, "BUG: hash join with a RHS that needs the LHS columns map[user_col:0]")
+}
@@ -47,9 +47,19 @@ func buildSelectPlan(query string) func(sqlparser.Statement, ContextVSchema) (en
 			return p, nil
 		}
 
+		// processSelect modifies sel, so it's parsed again
+		// if it's planned with its correlated subqueries.
+		original := sqlparser.String(sel)
 		pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 		if err := pb.processSelect(sel, nil, query); err != nil {
-			return nil, err
+			if err != errCorrelatedSubquery {
+				return nil, err
+			}
+			stmt, parseErr := sqlparser.Parse(original)
+			if parseErr != nil {
+				return nil, err
+			}
+			return buildCorrelatedSubqueryPlan(stmt.(*sqlparser.Select), vschema)
 		}
 		if err := pb.plan.Wireup(pb.plan, pb.jt); err != nil {
 			return nil, err
@@ -255,6 +265,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab,
 			return err
 		}
 	}
+	if err := pb.checkWindowFuncs(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -316,6 +329,9 @@ func buildSQLCalcFoundRowsPlan(query string, sel *sqlparser.Select, outer *symta
 		return nil, err
 	}
 	sel2 := statement.(*sqlparser.Select)
+	if err := inlineCTEs(sel2); err != nil {
+		return nil, err
+	}
 
 	sel2.SQLCalcFoundRows = false
 	sel2.OrderBy = nil
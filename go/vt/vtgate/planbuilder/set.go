@@ -29,6 +29,7 @@ import (
 
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/sysvars"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
 
@@ -195,6 +196,21 @@ func buildSetOpVitessAware(s setting) planFunc {
 	}
 }
 
+// buildSetOpTransactionIsolation plans the consistent snapshot isolation
+// like the settings VTGate is aware of, and the isolation levels of MySQL
+// like the settings that need a reserved connection.
+func buildSetOpTransactionIsolation(s setting) planFunc {
+	reservedConn := buildSetOpReservedConn(s)
+	vitessAware := buildSetOpVitessAware(s)
+	return func(expr *sqlparser.SetExpr, vschema ContextVSchema, ec *expressionConverter) (engine.SetOp, error) {
+		value, err := extractValue(expr, s.boolean)
+		if err == nil && strings.EqualFold(value, "'"+sysvars.ConsistentSnapshot+"'") {
+			return vitessAware(expr, vschema, ec)
+		}
+		return reservedConn(expr, vschema, ec)
+	}
+}
+
 func resolveDestination(vschema ContextVSchema) (*vindexes.Keyspace, key.Destination, error) {
 	keyspace, err := vschema.AnyKeyspace()
 	if err != nil {
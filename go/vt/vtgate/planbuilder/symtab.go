@@ -107,6 +107,12 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 	}
 
 	for _, cv := range vschemaTable.ColumnVindexes {
+		if multiCol, ok := cv.Vindex.(vindexes.MultiColumn); ok {
+			if err := t.addMultiColumn(st, rb, multiCol, cv.Columns); err != nil {
+				return err
+			}
+			continue
+		}
 		single, ok := cv.Vindex.(vindexes.SingleColumn)
 		if !ok {
 			continue
@@ -410,6 +416,27 @@ func (st *symtab) Vindex(expr sqlparser.Expr, scope *route) vindexes.SingleColum
 	return c.vindex
 }
 
+// MultiColumns returns the positions of the column in MultiColumn vindexes
+// if the expression is a plain column reference that is part of the
+// specified route.
+func (st *symtab) MultiColumns(expr sqlparser.Expr, scope *route) []multiColumnRef {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if col.Metadata == nil {
+		// Find will set the Metadata.
+		if _, _, err := st.Find(col); err != nil {
+			return nil
+		}
+	}
+	c := col.Metadata.(*column)
+	if c.Origin() != scope {
+		return nil
+	}
+	return c.multiColumns
+}
+
 // BuildColName builds a *sqlparser.ColName for the resultColumn specified
 // by the index. The built ColName will correctly reference the resultColumn
 // it was built from.
@@ -496,6 +523,23 @@ func (t *table) mergeColumn(alias sqlparser.ColIdent, c *column) (*column, error
 	return c, nil
 }
 
+// addMultiColumn adds the columns of a MultiColumn vindex of the table,
+// and records in each of them its position in the vindex.
+func (t *table) addMultiColumn(st *symtab, rb *route, vindex vindexes.MultiColumn, columns []sqlparser.ColIdent) error {
+	mc := &multiColumn{vindex: vindex, columns: len(columns)}
+	for i, cvcol := range columns {
+		col, err := t.mergeColumn(cvcol, &column{
+			origin: rb,
+			st:     st,
+		})
+		if err != nil {
+			return err
+		}
+		col.multiColumns = append(col.multiColumns, multiColumnRef{multiColumn: mc, index: i})
+	}
+	return nil
+}
+
 // Origin returns the route that originates the table.
 func (t *table) Origin() logicalPlan {
 	// If it's a route, we have to resolve it.
@@ -521,6 +565,22 @@ type column struct {
 	vindex    vindexes.SingleColumn
 	typ       querypb.Type
 	colNumber int
+
+	// multiColumns are the MultiColumn vindexes the column is part of.
+	multiColumns []multiColumnRef
+}
+
+// multiColumn is a MultiColumn vindex of a table of the query.
+// A table that appears twice in the query has two of them.
+type multiColumn struct {
+	vindex  vindexes.MultiColumn
+	columns int
+}
+
+// multiColumnRef is the position of a column in a MultiColumn vindex.
+type multiColumnRef struct {
+	multiColumn *multiColumn
+	index       int
 }
 
 // Origin returns the route that originates the column.
@@ -32,6 +32,7 @@ func init() {
 	forSettings(sysvars.CheckAndIgnore, buildSetOpCheckAndIgnore)
 	forSettings(sysvars.NotSupported, buildNotSupported)
 	forSettings(sysvars.VitessAware, buildSetOpVitessAware)
+	forSettings(sysvars.TransactionIsolations, buildSetOpTransactionIsolation)
 }
 
 func forSettings(systemVariables []sysvars.SystemVariable, f func(setting) planFunc) {
@@ -29,7 +29,16 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	if isMultiTableDML(upd.TableExprs) {
+		m, err := analyzeMultiTableDML(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit)
+		if err != nil {
+			return nil, err
+		}
+		if m != nil {
+			return buildMultiTableUpdatePlan(upd, m)
+		}
+	}
+	dml, ksidVindex, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -41,14 +50,75 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		return eupd, nil
 	}
 
-	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidCol)
+	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidVindex)
 	if err != nil {
 		return nil, err
 	}
 	eupd.ChangedVindexValues = cvv
 	eupd.OwnedVindexQuery = ovq
 	if len(eupd.ChangedVindexValues) != 0 {
-		eupd.KsidVindex = ksidVindex
+		eupd.KsidVindex = ksidVindex.Vindex
+		eupd.KsidLength = len(ksidColumns(ksidVindex))
+	}
+	return eupd, nil
+}
+
+// buildMultiTableUpdatePlan builds the instructions for an UPDATE that joins
+// tables of a sharded keyspace. See multiTableDML for details.
+func buildMultiTableUpdatePlan(upd *sqlparser.Update, m *multiTableDML) (engine.Primitive, error) {
+	var target *table
+	for _, assignment := range upd.Exprs {
+		t, err := m.tableFor(assignment.Name)
+		if err != nil {
+			return nil, err
+		}
+		if target != nil && t != target {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update statement modifying more than one table in sharded keyspace")
+		}
+		target = t
+	}
+
+	// Vindex changes are handled by the single-table plan.
+	rb, merged := m.mergedRoute()
+	merged = merged && !isVindexChanging(upd.Exprs, target.vschemaTable.ColumnVindexes)
+	if err := m.setTarget(target, !merged); err != nil {
+		return nil, err
+	}
+	if merged {
+		dml, err := m.buildMergedPlan(rb, upd, upd.Comments)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.Update{DML: *dml}, nil
+	}
+
+	for _, assignment := range upd.Exprs {
+		onTarget, err := m.onlyReferencesTarget(assignment.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if !onTarget {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard update with values from another table")
+		}
+	}
+	ksidCol, keyCol, err := m.keyColumns()
+	if err != nil {
+		return nil, err
+	}
+	buf := sqlparser.NewTrackedBuffer(unqualifiedFormatter)
+	buf.Myprintf("update %v%s%v set %v", upd.Comments, upd.Ignore.ToString(), m.targetName, upd.Exprs)
+	m.formatTargetWhere(buf, keyCol)
+	stmt, err := sqlparser.Parse(buf.String())
+	if err != nil {
+		return nil, err
+	}
+	plan, err := buildUpdatePlan(stmt, m.vschema)
+	if err != nil {
+		return nil, err
+	}
+	eupd := plan.(*engine.Update)
+	if err := m.buildInput(&eupd.DML, ksidCol, keyCol); err != nil {
+		return nil, err
 	}
 	return eupd, nil
 }
@@ -56,9 +126,9 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 // buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
 // Updates can only be performed to secondary lookup vindexes with no complex expressions
 // in the set clause.
-func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table, ksidCol string) (map[string]*engine.VindexValues, string, error) {
+func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table, ksidVindex *vindexes.ColumnVindex) (map[string]*engine.VindexValues, string, error) {
 	changedVindexes := make(map[string]*engine.VindexValues)
-	buf, offset := initialQuery(ksidCol, table)
+	buf, offset := initialQuery(ksidVindex, table)
 	for i, vindex := range table.ColumnVindexes {
 		vindexValueMap := make(map[string]sqltypes.PlanValue)
 		first := true
@@ -117,10 +187,10 @@ func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table,
 	return changedVindexes, buf.String(), nil
 }
 
-func initialQuery(ksidCol string, table *vindexes.Table) (*sqlparser.TrackedBuffer, int) {
+func initialQuery(ksidVindex *vindexes.ColumnVindex, table *vindexes.Table) (*sqlparser.TrackedBuffer, int) {
 	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("select %s", ksidCol)
-	offset := 1
+	buf.Myprintf("select %s", ksidColumnList(ksidVindex))
+	offset := len(ksidColumns(ksidVindex))
 	for _, cv := range table.Owned {
 		for _, column := range cv.Columns {
 			buf.Myprintf(", %v", column)
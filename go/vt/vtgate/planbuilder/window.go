@@ -0,0 +1,454 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*window)(nil)
+
+// window is the logicalPlan for engine.Window.
+// This gets built if there are window functions on a SelectScatter
+// route that can't be computed by the shards, because the partitions
+// span multiple shards. All the window functions must share the
+// same partitioning and ordering. The primitive requests the underlying
+// route to order the results by the partition columns followed by
+// the window ordering. This allows the engine code to compute the
+// functions one partition at a time, as the rows come.
+// For example: 'select col, row_number() over (partition by col order by id) from t'
+// will be sent to the scatter route as:
+// 'select col, 1, id from t order by col asc, id asc'
+// The window primitive built for this will be:
+//    &engine.Window {
+//      PartitionBy: []int{0},
+//      OrderBy: []OrderbyParams{{Col: 2}},
+//      Functions: []WindowFuncParams{{
+//        Opcode: WindowRowNumber,
+//        Col: 1,
+//      }},
+//      TruncateColumnCount: 2,
+//      Input: (Scatter Route with the order by request),
+//    }
+type window struct {
+	resultsBuilder
+	partitionBy []*sqlparser.ColName
+	orderBy     sqlparser.OrderBy
+	windows     sqlparser.WindowDefinitions
+	eWindow     *engine.Window
+}
+
+// checkWindowFuncs analyzes the select expressions for window functions.
+// If the window functions can be computed by the shards, the named windows
+// are pushed down along with the select expressions. Otherwise, it builds
+// a window primitive on top of the scatter route.
+func (pb *primitiveBuilder) checkWindowFuncs(sel *sqlparser.Select) error {
+	// The columns of the named windows have to be resolved here,
+	// because they're not part of any of the pushed down expressions.
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if _, _, err := pb.st.Find(col); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, sel.Windows)
+	if err != nil {
+		return err
+	}
+
+	rb, isRoute := pb.plan.(*route)
+	pushDown := func() error {
+		if isRoute {
+			rb.Select.(*sqlparser.Select).Windows = sel.Windows
+		}
+		return nil
+	}
+
+	funcs := windowFuncs(sel.SelectExprs)
+	if len(funcs) == 0 {
+		return pushDown()
+	}
+	specs, err := resolveWindowSpecs(funcs, sel.Windows)
+	if err != nil {
+		return err
+	}
+
+	if !isRoute {
+		return errors.New("unsupported: window functions in cross-shard query")
+	}
+	if rb.isSingleShard() || pb.partitionsHaveUniqueVindex(specs, rb) {
+		return pushDown()
+	}
+
+	// The partitions span multiple shards.
+	if sel.Distinct || len(sel.GroupBy) != 0 || sel.Having != nil || nodeHasAggregates(sel.SelectExprs) {
+		return errors.New("unsupported: in scatter query: window functions with aggregates")
+	}
+	spec, err := commonWindowSpec(funcs, specs)
+	if err != nil {
+		return err
+	}
+
+	w := &window{windows: sel.Windows}
+	for _, expr := range spec.PartitionClause {
+		col, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return fmt.Errorf("unsupported: in scatter query: complex partition by expression: %s", sqlparser.String(expr))
+		}
+		if _, _, err := pb.st.Find(col); err != nil {
+			return err
+		}
+		w.partitionBy = append(w.partitionBy, col)
+	}
+	for _, order := range spec.OrderClause {
+		col, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return fmt.Errorf("unsupported: in scatter query: complex window order by expression: %s", sqlparser.String(order.Expr))
+		}
+		if _, _, err := pb.st.Find(col); err != nil {
+			return err
+		}
+		w.orderBy = append(w.orderBy, order)
+	}
+
+	w.eWindow = &engine.Window{}
+	w.resultsBuilder = newResultsBuilder(rb, w.eWindow)
+	pb.plan = w
+	pb.plan.Reorder(0)
+	return nil
+}
+
+// windowFuncs returns the window functions referenced by the expressions.
+func windowFuncs(node sqlparser.SQLNode) []*sqlparser.FuncExpr {
+	var funcs []*sqlparser.FuncExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunc() {
+				funcs = append(funcs, node)
+				return false, nil
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return funcs
+}
+
+// resolveWindowSpecs returns the specification of the window of each function.
+func resolveWindowSpecs(funcs []*sqlparser.FuncExpr, windows sqlparser.WindowDefinitions) ([]*sqlparser.WindowSpecification, error) {
+	specs := make([]*sqlparser.WindowSpecification, 0, len(funcs))
+	for _, funcExpr := range funcs {
+		spec, err := resolveWindowSpec(funcExpr.Over, windows)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// commonWindowSpec returns the window shared by all the functions. The window
+// functions of a scatter query must share the same partitioning and ordering,
+// because the rows can only be sorted one way by the route.
+func commonWindowSpec(funcs []*sqlparser.FuncExpr, specs []*sqlparser.WindowSpecification) (*sqlparser.WindowSpecification, error) {
+	spec := specs[0]
+	for i, other := range specs[1:] {
+		if sqlparser.String(other.PartitionClause) != sqlparser.String(spec.PartitionClause) || sqlparser.String(other.OrderClause) != sqlparser.String(spec.OrderClause) {
+			return nil, fmt.Errorf("unsupported: in scatter query: window functions with different partitioning or ordering: %s", sqlparser.String(funcs[i+1]))
+		}
+	}
+	return spec, nil
+}
+
+// resolveWindowSpec returns the specification of the window of an OVER clause.
+// A window that references a named window inherits its partitioning and
+// ordering. It can only add an ordering or a frame of its own.
+func resolveWindowSpec(over *sqlparser.OverClause, windows sqlparser.WindowDefinitions) (*sqlparser.WindowSpecification, error) {
+	spec := over.WindowSpec
+	name := over.WindowName
+	if spec != nil {
+		name = spec.Name
+	}
+	if name.IsEmpty() {
+		return spec, nil
+	}
+	var named *sqlparser.WindowSpecification
+	for _, def := range windows {
+		if def.Name.Equal(name) {
+			named = def.WindowSpec
+			break
+		}
+	}
+	if named == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window name '%s' is not defined.", name.String())
+	}
+	named, err := resolveWindowSpec(&sqlparser.OverClause{WindowSpec: named}, windows)
+	if err != nil {
+		return nil, err
+	}
+	if spec == nil {
+		return named, nil
+	}
+	resolved := *named
+	resolved.Name = sqlparser.ColIdent{}
+	if len(spec.OrderClause) != 0 {
+		resolved.OrderClause = spec.OrderClause
+	}
+	if spec.FrameClause != nil {
+		resolved.FrameClause = spec.FrameClause
+	}
+	return &resolved, nil
+}
+
+// partitionsHaveUniqueVindex returns true if the partitioning of every window
+// references a unique vindex of the route. If so, a partition can't span
+// multiple shards, and the window functions can be computed by the shards.
+func (pb *primitiveBuilder) partitionsHaveUniqueVindex(specs []*sqlparser.WindowSpecification, rb *route) bool {
+	for _, spec := range specs {
+		found := false
+		for _, expr := range spec.PartitionClause {
+			vindex := pb.st.Vindex(expr, rb)
+			if vindex != nil && vindex.IsUnique() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// windowFrame converts the frame clause of a window to its engine equivalent.
+// RANGE frames are only supported with CURRENT ROW and UNBOUNDED bounds.
+func windowFrame(frameClause *sqlparser.FrameClause) (engine.WindowFrame, error) {
+	if frameClause == nil {
+		return engine.DefaultWindowFrame, nil
+	}
+	frame := engine.WindowFrame{Range: frameClause.Unit == sqlparser.FrameRangeType}
+	end := frameClause.End
+	if end == nil {
+		end = &sqlparser.FramePoint{Type: sqlparser.CurrentRowType}
+	}
+	for i, point := range []*sqlparser.FramePoint{frameClause.Start, end} {
+		var unbounded bool
+		var offset int64
+		switch point.Type {
+		case sqlparser.UnboundedPrecedingType:
+			if i != 0 {
+				return frame, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window frame end cannot be UNBOUNDED PRECEDING.")
+			}
+			unbounded = true
+		case sqlparser.UnboundedFollowingType:
+			if i == 0 {
+				return frame, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window frame start cannot be UNBOUNDED FOLLOWING.")
+			}
+			unbounded = true
+		case sqlparser.ExprPrecedingType, sqlparser.ExprFollowingType:
+			lit, ok := point.Expr.(*sqlparser.Literal)
+			if !ok || lit.Type != sqlparser.IntVal || frame.Range {
+				return frame, fmt.Errorf("unsupported: in scatter query: window frame bound: %s", sqlparser.String(point))
+			}
+			n, err := strconv.ParseInt(string(lit.Val), 10, 64)
+			if err != nil {
+				return frame, err
+			}
+			offset = n
+			if point.Type == sqlparser.ExprPrecedingType {
+				offset = -n
+			}
+		}
+		if i == 0 {
+			frame.UnboundedStart, frame.Start = unbounded, offset
+		} else {
+			frame.UnboundedEnd, frame.End = unbounded, offset
+		}
+	}
+	return frame, nil
+}
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.eWindow.Input = w.input.Primitive()
+	return w.eWindow
+}
+
+func (w *window) pushWindowFunc(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin logicalPlan) (rc *resultColumn, colNumber int, err error) {
+	params, arg, err := newWindowFuncParams(expr, w.windows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	newBuilder, _, innerCol, err := planProjection(pb, w.input, &sqlparser.AliasedExpr{Expr: arg}, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	w.input = newBuilder
+	params.Col = innerCol
+	w.eWindow.Functions = append(w.eWindow.Functions, params)
+
+	// Build a new rc with w as origin because it's semantically different
+	// from the expression we pushed down.
+	rc = newResultColumn(expr, w)
+	w.resultColumns = append(w.resultColumns, rc)
+	return rc, len(w.resultColumns) - 1, nil
+}
+
+// newWindowFuncParams returns the parameters of a window function computed
+// by vtgate, except for its column, and the argument the shards must return
+// in that column.
+func newWindowFuncParams(expr *sqlparser.AliasedExpr, windows sqlparser.WindowDefinitions) (engine.WindowFuncParams, sqlparser.Expr, error) {
+	funcExpr := expr.Expr.(*sqlparser.FuncExpr)
+	opcode, ok := engine.SupportedWindowFuncs[funcExpr.Name.Lowered()]
+	if !ok || funcExpr.Distinct {
+		return engine.WindowFuncParams{}, nil, fmt.Errorf("unsupported: in scatter query: window function %s", sqlparser.String(funcExpr))
+	}
+	params := engine.WindowFuncParams{Opcode: opcode}
+	if !expr.As.IsEmpty() {
+		params.Alias = expr.As.String()
+	} else {
+		params.Alias = sqlparser.String(funcExpr)
+	}
+
+	spec, err := resolveWindowSpec(funcExpr.Over, windows)
+	if err != nil {
+		return params, nil, err
+	}
+	if params.Frame, err = windowFrame(spec.FrameClause); err != nil {
+		return params, nil, err
+	}
+
+	// The shards return the argument of the function, if any. Otherwise,
+	// they return a placeholder column for vtgate to store the result in.
+	var arg sqlparser.Expr = sqlparser.NewIntLiteral([]byte("1"))
+	var nExpr, defaultExpr sqlparser.SelectExpr
+	switch opcode {
+	case engine.WindowNtile:
+		if len(funcExpr.Exprs) != 1 {
+			return params, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+		nExpr = funcExpr.Exprs[0]
+	case engine.WindowLag, engine.WindowLead, engine.WindowNthValue:
+		if len(funcExpr.Exprs) < 1 || len(funcExpr.Exprs) > 3 || (opcode == engine.WindowNthValue && len(funcExpr.Exprs) != 2) {
+			return params, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+		if len(funcExpr.Exprs) > 1 {
+			nExpr = funcExpr.Exprs[1]
+		}
+		if len(funcExpr.Exprs) > 2 {
+			defaultExpr = funcExpr.Exprs[2]
+		}
+		fallthrough
+	case engine.WindowFirstValue, engine.WindowLastValue, engine.WindowCount, engine.WindowSum, engine.WindowAvg, engine.WindowMin, engine.WindowMax:
+		if len(funcExpr.Exprs) == 0 {
+			return params, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+		switch inner := funcExpr.Exprs[0].(type) {
+		case *sqlparser.AliasedExpr:
+			arg = inner.Expr
+		case *sqlparser.StarExpr:
+			if opcode != engine.WindowCount {
+				return params, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+			}
+		default:
+			return params, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+	}
+	if params.N, err = windowFuncValue(nExpr); err != nil {
+		return params, nil, err
+	}
+	if params.Default, err = windowFuncValue(defaultExpr); err != nil {
+		return params, nil, err
+	}
+	return params, arg, nil
+}
+
+// windowFuncValue converts an argument of a window function, like
+// the offset of LAG, to a value that can be resolved by the engine.
+func windowFuncValue(expr sqlparser.SelectExpr) (sqltypes.PlanValue, error) {
+	if expr == nil {
+		return sqltypes.PlanValue{}, nil
+	}
+	aliased, ok := expr.(*sqlparser.AliasedExpr)
+	if !ok {
+		return sqltypes.PlanValue{}, fmt.Errorf("syntax error: %s", sqlparser.String(expr))
+	}
+	pv, err := sqlparser.NewPlanValue(aliased.Expr)
+	if err != nil {
+		return sqltypes.PlanValue{}, fmt.Errorf("unsupported: in scatter query: window function argument: %s", sqlparser.String(aliased.Expr))
+	}
+	return pv, nil
+}
+
+// Wireup implements the logicalPlan interface
+// If text columns are detected in the partitioning or ordering, then the
+// function modifies the primitive to pull a corresponding weight_string
+// from mysql and compare those instead. This is because we currently don't
+// have the ability to mimic mysql's collation behavior.
+func (w *window) Wireup(plan logicalPlan, jt *jointab) error {
+	weightString := func(colNumber int) (int, error) {
+		// The column may not be in the select list, so it's
+		// looked up in the results of the input.
+		rc := w.input.ResultColumns()[colNumber]
+		if !sqltypes.IsText(rc.column.typ) {
+			return colNumber, nil
+		}
+		if weightcolNumber, ok := w.weightStrings[rc]; ok {
+			return weightcolNumber, nil
+		}
+		weightcolNumber, err := w.input.SupplyWeightString(colNumber)
+		if err != nil {
+			return 0, err
+		}
+		w.weightStrings[rc] = weightcolNumber
+		w.eWindow.TruncateColumnCount = len(w.resultColumns)
+		return weightcolNumber, nil
+	}
+	for i, colNumber := range w.eWindow.PartitionBy {
+		weightcolNumber, err := weightString(colNumber)
+		if err != nil {
+			return err
+		}
+		w.eWindow.PartitionBy[i] = weightcolNumber
+	}
+	for i, orderby := range w.eWindow.OrderBy {
+		weightcolNumber, err := weightString(orderby.Col)
+		if err != nil {
+			return err
+		}
+		w.eWindow.OrderBy[i].Col = weightcolNumber
+	}
+	return w.input.Wireup(plan, jt)
+}
+
+func (w *window) WireupV4(semTable *semantics.SemTable) error {
+	return w.input.WireupV4(semTable)
+}
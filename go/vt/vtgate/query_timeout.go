@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var queryTimeouts = stats.NewCounter("QueryTimeouts", "Number of selects interrupted because they exceeded their maximum execution time")
+
+// errQueryTimeout is the error of MySQL for the selects
+// that exceed their maximum execution time.
+var errQueryTimeout = vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "Query execution was interrupted, maximum statement execution time exceeded (errno %d) (sqlstate %s)", mysql.ERQueryTimeout, mysql.SSUnknownSQLState)
+
+// queryTimeout is the deadline of a select, which is set with the
+// MAX_EXECUTION_TIME optimizer hint, or else with the max_execution_time
+// of the session. It is a deadline of the whole select: the queries that
+// it sends to the shards, which the tablets kill when it expires, and the
+// processing of their results in vtgate.
+type queryTimeout struct {
+	// parent is the context of the select, without the deadline.
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// startQueryTimeout sets the deadline of plan on vcursor. Like in MySQL,
+// only the selects time out. It returns nil if plan has no timeout.
+func startQueryTimeout(vcursor *vcursorImpl, plan *engine.Plan, safeSession *SafeSession) *queryTimeout {
+	if plan.Type != sqlparser.StmtSelect {
+		return nil
+	}
+	timeout := int64(plan.MaxExecutionTime)
+	if timeout == 0 {
+		timeout = safeSession.GetQueryTimeout()
+	}
+	if timeout <= 0 {
+		return nil
+	}
+	qt := &queryTimeout{parent: vcursor.ctx}
+	qt.cancel = vcursor.SetContextTimeout(time.Duration(timeout) * time.Millisecond)
+	qt.ctx = vcursor.ctx
+	return qt
+}
+
+// stop releases the deadline once the select is done.
+func (qt *queryTimeout) stop() {
+	if qt != nil {
+		qt.cancel()
+	}
+}
+
+// expired returns true if the select exceeded its deadline. The selects that
+// are canceled by their callers, or that exceed the deadline of their callers,
+// don't fail with the timeout of MySQL.
+func (qt *queryTimeout) expired() bool {
+	return qt != nil && qt.ctx.Err() == context.DeadlineExceeded && qt.parent.Err() == nil
+}
+
+// check returns the timeout error if the select exceeded its deadline,
+// or err otherwise. The select fails even if its results were complete,
+// so that the time vtgate spends processing them is limited too.
+func (qt *queryTimeout) check(err error) error {
+	if qt.expired() {
+		queryTimeouts.Add(1)
+		return errQueryTimeout
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func requireQueryTimeout(t *testing.T, err error) {
+	t.Helper()
+	require.Error(t, err)
+	sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError)
+	require.True(t, ok)
+	assert.Equal(t, mysql.ERQueryTimeout, sqlErr.Number(), err.Error())
+	assert.Equal(t, mysql.SSUnknownSQLState, sqlErr.SQLState())
+}
+
+func TestExecutorQueryTimeout(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	sbc1.MustWaitForContext = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		return err
+	}
+
+	// The hint interrupts the scatter selects that exceed it.
+	timeouts := queryTimeouts.Get()
+	start := time.Now()
+	requireQueryTimeout(t, exec("select /*+ MAX_EXECUTION_TIME(20) */ id from user"))
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+	assert.Equal(t, timeouts+1, queryTimeouts.Get())
+
+	// The selects that don't exceed it succeed.
+	require.NoError(t, exec("select /*+ MAX_EXECUTION_TIME(10000) */ id from user where id = 3"))
+
+	// The session variable applies to the selects that have no hint.
+	require.NoError(t, exec("set max_execution_time = 20"))
+	requireQueryTimeout(t, exec("select id from user"))
+	requireQueryTimeout(t, exec("select id from user where id = 1"))
+	require.NoError(t, exec("select /*+ MAX_EXECUTION_TIME(10000) */ id from user where id = 3"))
+
+	// The cancellations of the callers are not timeouts.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := executor.Execute(ctx, "TestExecute", session, "select /*+ MAX_EXECUTION_TIME(10000) */ id from user", nil)
+	require.Error(t, err)
+	assert.NotEqual(t, mysql.ERQueryTimeout, mysql.NewSQLErrorFromError(err).(*mysql.SQLError).Number())
+
+	// The streaming selects time out too.
+	err = executor.StreamExecute(context.Background(), "TestStreamExecute", session, "select id from user", nil, querypb.Target{}, func(*sqltypes.Result) error {
+		return nil
+	})
+	requireQueryTimeout(t, err)
+
+	// The session variable is disabled with 0.
+	require.NoError(t, exec("set max_execution_time = 0"))
+	assert.EqualValues(t, 0, session.GetQueryTimeout())
+	sbc1.MustWaitForContext = false
+	require.NoError(t, exec("select id from user"))
+}
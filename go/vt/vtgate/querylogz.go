@@ -48,6 +48,7 @@ var (
 				<th>Stmt Type</th>
 				<th>SQL</th>
 				<th>ShardQueries</th>
+				<th>Cached Result</th>
 				<th>RowsAffected</th>
 				<th>Error</th>
 			</tr>
@@ -74,6 +75,7 @@ var (
 			<td>{{.StmtType}}</td>
 			<td>{{.SQL | truncateQuery | unquote | cssWrappable}}</td>
 			<td>{{.ShardQueries}}</td>
+			<td>{{.CachedResult}}</td>
 			<td>{{.RowsAffected}}</td>
 			<td>{{.ErrorStr}}</td>
 		</tr>
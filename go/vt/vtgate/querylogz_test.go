@@ -75,6 +75,7 @@ func TestQuerylogzHandlerFormatting(t *testing.T) {
 		`<td>select</td>`,
 		`<td>select name from test_table limit 1000</td>`,
 		`<td>1</td>`,
+		`<td>false</td>`,
 		`<td>1000</td>`,
 		`<td></td>`,
 		`</tr>`,
@@ -104,6 +105,7 @@ func TestQuerylogzHandlerFormatting(t *testing.T) {
 		`<td>select</td>`,
 		`<td>select name from test_table limit 1000</td>`,
 		`<td>1</td>`,
+		`<td>false</td>`,
 		`<td>1000</td>`,
 		`<td></td>`,
 		`</tr>`,
@@ -133,6 +135,7 @@ func TestQuerylogzHandlerFormatting(t *testing.T) {
 		`<td>select</td>`,
 		`<td>select name from test_table limit 1000</td>`,
 		`<td>1</td>`,
+		`<td>false</td>`,
 		`<td>1000</td>`,
 		`<td></td>`,
 		`</tr>`,
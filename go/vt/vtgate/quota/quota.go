@@ -0,0 +1,346 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota limits the rate and the concurrency of the queries that
+// vtgate executes for a caller or a workload.
+//
+// The quotas are configured in a JSON file in the topo, which vtgate watches
+// so that it applies the changes as soon as they are made. A quota applies to
+// the queries of an immediate caller, an effective caller, or a query tag,
+// which is set with a /*vt+ QUERY_TAG=name */ margin comment. Without a value,
+// a quota gives every caller or tag its own limits:
+//
+//     {
+//       "quotas": [
+//         {"name": "per_user", "key": "immediate_caller", "max_qps": 500, "max_concurrency": 20},
+//         {"name": "reporting", "key": "query_tag", "value": "reporting", "max_concurrency": 2}
+//       ]
+//     }
+//
+// The queries that exceed a quota fail with a RESOURCE_EXHAUSTED error, which
+// the clients can retry.
+//
+// A quota without a value keeps the limits of at most MaxLimiters values,
+// and drops the limits of the values that haven't been used for a while. The
+// values past MaxLimiters share the same limits until some limits are dropped,
+// so that the callers or tags of the queries can't exhaust the memory.
+package quota
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/ratelimiter"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The keys that the queries are matched with.
+const (
+	KeyImmediateCaller = "immediate_caller"
+	KeyEffectiveCaller = "effective_caller"
+	KeyQueryTag        = "query_tag"
+)
+
+var (
+	// MaxLimiters is the maximum number of values of its key
+	// that a quota without a value keeps limits for.
+	MaxLimiters = 10000
+
+	// LimiterIdleTimeout is how long the limits of a value of a key are kept
+	// after its last query. They are kept at least as long as it takes
+	// the rate limiter to allow a burst of queries again.
+	LimiterIdleTimeout = 10 * time.Minute
+
+	timeNow = time.Now
+)
+
+var rejections = stats.NewCountersWithMultiLabels(
+	"QueryQuotaRejections",
+	"Number of queries rejected because they exceeded a quota, by quota and limit",
+	[]string{"Quota", "Limit"})
+
+// Config is the configuration of the quotas.
+type Config struct {
+	Quotas []Quota `json:"quotas"`
+}
+
+// Quota limits the queries whose key has a value.
+type Quota struct {
+	// Name identifies the quota in the errors and the stats.
+	Name string `json:"name"`
+	// Key is what the queries are matched with. It's one
+	// of immediate_caller, effective_caller or query_tag.
+	Key string `json:"key"`
+	// Value is the value of the key of the queries that the quota applies
+	// to. If it is empty, every value has its own limits.
+	Value string `json:"value,omitempty"`
+	// MaxQPS is the maximum number of queries per second. 0 is unlimited.
+	MaxQPS float64 `json:"max_qps,omitempty"`
+	// Burst is the number of queries that can be executed at once without
+	// exceeding MaxQPS. It defaults to a tenth of a second of queries.
+	Burst int `json:"burst,omitempty"`
+	// MaxConcurrency is the maximum number of queries
+	// executed at the same time. 0 is unlimited.
+	MaxConcurrency int `json:"max_concurrency,omitempty"`
+}
+
+// ParseConfig parses and validates the JSON configuration of the quotas.
+func ParseConfig(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, quota := range config.Quotas {
+		if quota.Name == "" {
+			return nil, fmt.Errorf("quota has no name")
+		}
+		if names[quota.Name] {
+			return nil, fmt.Errorf("duplicate quota name: %s", quota.Name)
+		}
+		names[quota.Name] = true
+		switch quota.Key {
+		case KeyImmediateCaller, KeyEffectiveCaller, KeyQueryTag:
+		default:
+			return nil, fmt.Errorf("invalid key %q for quota: %s", quota.Key, quota.Name)
+		}
+		if quota.MaxQPS < 0 || quota.Burst < 0 || quota.MaxConcurrency < 0 {
+			return nil, fmt.Errorf("negative limit for quota: %s", quota.Name)
+		}
+		if quota.MaxQPS == 0 && quota.MaxConcurrency == 0 {
+			return nil, fmt.Errorf("quota has no limit: %s", quota.Name)
+		}
+	}
+	return config, nil
+}
+
+// Query is what the quotas match a query with.
+type Query struct {
+	ImmediateCaller string
+	EffectiveCaller string
+	Tag             string
+}
+
+func (q Query) value(key string) string {
+	switch key {
+	case KeyImmediateCaller:
+		return q.ImmediateCaller
+	case KeyEffectiveCaller:
+		return q.EffectiveCaller
+	default:
+		return q.Tag
+	}
+}
+
+// Quotas limits the queries with a configuration of quotas.
+// It is safe for concurrent use.
+type Quotas struct {
+	mu     sync.RWMutex
+	quotas []*quota
+}
+
+// New creates Quotas that don't limit any query until they are configured.
+func New() *Quotas {
+	return &Quotas{}
+}
+
+// SetConfig replaces the configuration of the quotas. The quotas that did not
+// change keep their state, so that their limits are not reset.
+func (qs *Quotas) SetConfig(config *Config) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	old := make(map[string]*quota)
+	for _, q := range qs.quotas {
+		old[q.config.Name] = q
+	}
+	quotas := make([]*quota, 0, len(config.Quotas))
+	for _, c := range config.Quotas {
+		if q := old[c.Name]; q != nil && q.config == c {
+			quotas = append(quotas, q)
+			continue
+		}
+		quotas = append(quotas, newQuota(c))
+	}
+	qs.quotas = quotas
+}
+
+// Acquire checks that a query is within the quotas that apply to it. If it
+// is, release must be called once it has been executed. Otherwise, it
+// returns a RESOURCE_EXHAUSTED error.
+func (qs *Quotas) Acquire(query Query) (release func(), err error) {
+	qs.mu.RLock()
+	defer qs.mu.RUnlock()
+	var used []*limiter
+	release = func() {
+		for _, l := range used {
+			l.release()
+		}
+	}
+	for _, q := range qs.quotas {
+		value := query.value(q.config.Key)
+		if value == "" || (q.config.Value != "" && q.config.Value != value) {
+			continue
+		}
+		l := q.limiter(value)
+		if l.concurrency != nil && !l.concurrency.TryAcquire() {
+			l.done()
+			release()
+			rejections.Add([]string{q.config.Name, "Concurrency"}, 1)
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query quota %s exceeded for %s %s: more than %d concurrent queries", q.config.Name, q.config.Key, value, q.config.MaxConcurrency)
+		}
+		used = append(used, l)
+		if l.rate != nil && !l.rate.Allow() {
+			release()
+			rejections.Add([]string{q.config.Name, "QPS"}, 1)
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query quota %s exceeded for %s %s: more than %v queries per second", q.config.Name, q.config.Key, value, q.config.MaxQPS)
+		}
+	}
+	return release, nil
+}
+
+// quota is the state of a configured quota.
+type quota struct {
+	config Quota
+	// burst is the number of queries the rate limiters allow in every
+	// interval, which is how long MaxQPS takes to make up for them.
+	burst    int
+	interval time.Duration
+
+	mu sync.Mutex
+	// limiters are the limits of the values of the key.
+	limiters map[string]*list.Element
+	// lru orders the limiters, whose elements hold a *limiter,
+	// from the most recently used to the least recently used.
+	lru *list.List
+	// overflow is shared by the values that don't have limiters
+	// because there are MaxLimiters limiters already.
+	overflow *limiter
+}
+
+// limiter limits the queries of a value of the key of a quota.
+type limiter struct {
+	quota *quota
+	value string
+	// rate is nil if the QPS are not limited.
+	rate *ratelimiter.RateLimiter
+	// concurrency is nil if the concurrency is not limited.
+	concurrency *sync2.Semaphore
+
+	// users and lastUsed are protected by the mutex of the quota.
+	// users is the number of queries using the limiter, and
+	// lastUsed when the last one started.
+	users    int
+	lastUsed time.Time
+}
+
+func newQuota(config Quota) *quota {
+	q := &quota{
+		config:   config,
+		limiters: make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+	if config.MaxQPS > 0 {
+		q.burst = config.Burst
+		if q.burst == 0 {
+			q.burst = int(math.Max(1, math.Ceil(config.MaxQPS/10)))
+		}
+		q.interval = time.Duration(float64(q.burst) / config.MaxQPS * float64(time.Second))
+	}
+	return q
+}
+
+// limiter returns the limiter of a value of the key, which is used by a
+// query until done is called.
+func (q *quota) limiter(value string) *limiter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := timeNow()
+	q.evictIdle(now)
+
+	var l *limiter
+	if elem := q.limiters[value]; elem != nil {
+		q.lru.MoveToFront(elem)
+		l = elem.Value.(*limiter)
+	} else if len(q.limiters) < MaxLimiters {
+		l = q.newLimiter(value)
+		q.limiters[value] = q.lru.PushFront(l)
+	} else {
+		if q.overflow == nil {
+			q.overflow = q.newLimiter("")
+		}
+		l = q.overflow
+	}
+	l.users++
+	l.lastUsed = now
+	return l
+}
+
+func (q *quota) newLimiter(value string) *limiter {
+	l := &limiter{quota: q, value: value}
+	if q.config.MaxQPS > 0 {
+		l.rate = ratelimiter.NewRateLimiter(q.burst, q.interval)
+	}
+	if q.config.MaxConcurrency > 0 {
+		l.concurrency = sync2.NewSemaphore(q.config.MaxConcurrency, 0)
+	}
+	return l
+}
+
+// evictIdle drops the least recently used limiters that are not used by any
+// query, and haven't been for LimiterIdleTimeout and the interval of the rate
+// limiters, after which they don't limit the next queries anymore.
+func (q *quota) evictIdle(now time.Time) {
+	idle := LimiterIdleTimeout
+	if q.interval > idle {
+		idle = q.interval
+	}
+	for elem := q.lru.Back(); elem != nil; {
+		l := elem.Value.(*limiter)
+		if now.Sub(l.lastUsed) < idle {
+			return
+		}
+		prev := elem.Prev()
+		if l.users == 0 {
+			q.lru.Remove(elem)
+			delete(q.limiters, l.value)
+		}
+		elem = prev
+	}
+}
+
+// release releases the concurrency acquired by a query, and ends its use
+// of the limiter.
+func (l *limiter) release() {
+	if l.concurrency != nil {
+		l.concurrency.Release()
+	}
+	l.done()
+}
+
+// done ends the use of the limiter by a query.
+func (l *limiter) done() {
+	l.quota.mu.Lock()
+	defer l.quota.mu.Unlock()
+	l.users--
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestParseConfig(t *testing.T) {
+	testcases := []struct {
+		config string
+		err    string
+	}{{
+		config: `{"quotas": [{"name": "q1", "key": "immediate_caller", "max_qps": 10}, {"name": "q2", "key": "query_tag", "value": "batch", "max_concurrency": 1}]}`,
+	}, {
+		config: `{"quotas": [{"key": "immediate_caller", "max_qps": 10}]}`,
+		err:    "quota has no name",
+	}, {
+		config: `{"quotas": [{"name": "q1", "key": "immediate_caller", "max_qps": 10}, {"name": "q1", "key": "query_tag", "max_qps": 10}]}`,
+		err:    "duplicate quota name: q1",
+	}, {
+		config: `{"quotas": [{"name": "q1", "key": "user", "max_qps": 10}]}`,
+		err:    `invalid key "user" for quota: q1`,
+	}, {
+		config: `{"quotas": [{"name": "q1", "key": "query_tag", "max_concurrency": -1}]}`,
+		err:    "negative limit for quota: q1",
+	}, {
+		config: `{"quotas": [{"name": "q1", "key": "query_tag", "burst": 10}]}`,
+		err:    "quota has no limit: q1",
+	}, {
+		config: `{"quotas": `,
+		err:    "unexpected end of JSON input",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.config, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tc.config))
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func mustParseConfig(t *testing.T, config string) *Config {
+	t.Helper()
+	c, err := ParseConfig([]byte(config))
+	require.NoError(t, err)
+	return c
+}
+
+func TestQuotasConcurrency(t *testing.T) {
+	qs := New()
+	qs.SetConfig(mustParseConfig(t, `{"quotas": [
+		{"name": "per_user", "key": "immediate_caller", "max_concurrency": 2},
+		{"name": "batch", "key": "query_tag", "value": "batch", "max_concurrency": 1}
+	]}`))
+
+	// Every caller has its own limit.
+	release1, err := qs.Acquire(Query{ImmediateCaller: "u1"})
+	require.NoError(t, err)
+	release2, err := qs.Acquire(Query{ImmediateCaller: "u1"})
+	require.NoError(t, err)
+	_, err = qs.Acquire(Query{ImmediateCaller: "u1"})
+	require.EqualError(t, err, "query quota per_user exceeded for immediate_caller u1: more than 2 concurrent queries")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	release3, err := qs.Acquire(Query{ImmediateCaller: "u2"})
+	require.NoError(t, err)
+	release3()
+
+	release1()
+	release1, err = qs.Acquire(Query{ImmediateCaller: "u1"})
+	require.NoError(t, err)
+	release1()
+	release2()
+
+	// A query that exceeds a quota does not hold the other quotas.
+	release1, err = qs.Acquire(Query{ImmediateCaller: "u1", Tag: "batch"})
+	require.NoError(t, err)
+	_, err = qs.Acquire(Query{ImmediateCaller: "u1", Tag: "batch"})
+	require.EqualError(t, err, "query quota batch exceeded for query_tag batch: more than 1 concurrent queries")
+	release2, err = qs.Acquire(Query{ImmediateCaller: "u1", Tag: "interactive"})
+	require.NoError(t, err)
+	release1()
+	release2()
+
+	// The queries without the key of a quota are not limited by it.
+	for i := 0; i < 3; i++ {
+		_, err = qs.Acquire(Query{EffectiveCaller: "u1"})
+		require.NoError(t, err)
+	}
+}
+
+func TestQuotasQPS(t *testing.T) {
+	qs := New()
+	qs.SetConfig(mustParseConfig(t, `{"quotas": [{"name": "per_user", "key": "effective_caller", "max_qps": 1, "burst": 2}]}`))
+
+	for i := 0; i < 2; i++ {
+		release, err := qs.Acquire(Query{EffectiveCaller: "u1"})
+		require.NoError(t, err)
+		release()
+	}
+	_, err := qs.Acquire(Query{EffectiveCaller: "u1"})
+	require.EqualError(t, err, "query quota per_user exceeded for effective_caller u1: more than 1 queries per second")
+	_, err = qs.Acquire(Query{EffectiveCaller: "u2"})
+	require.NoError(t, err)
+
+	// The quotas that don't change keep their state.
+	qs.SetConfig(mustParseConfig(t, `{"quotas": [
+		{"name": "per_user", "key": "effective_caller", "max_qps": 1, "burst": 2},
+		{"name": "per_tag", "key": "query_tag", "max_qps": 1}
+	]}`))
+	_, err = qs.Acquire(Query{EffectiveCaller: "u1"})
+	require.Error(t, err)
+
+	// The quotas that change are reset.
+	qs.SetConfig(mustParseConfig(t, `{"quotas": [{"name": "per_user", "key": "effective_caller", "max_qps": 1, "burst": 3}]}`))
+	_, err = qs.Acquire(Query{EffectiveCaller: "u1"})
+	require.NoError(t, err)
+
+	qs.SetConfig(&Config{})
+	_, err = qs.Acquire(Query{EffectiveCaller: "u1"})
+	require.NoError(t, err)
+}
+
+func TestQuotasLimiters(t *testing.T) {
+	defer func(maxLimiters int, idleTimeout time.Duration) {
+		MaxLimiters = maxLimiters
+		LimiterIdleTimeout = idleTimeout
+		timeNow = time.Now
+	}(MaxLimiters, LimiterIdleTimeout)
+	MaxLimiters = 2
+	LimiterIdleTimeout = time.Minute
+	now := time.Now()
+	timeNow = func() time.Time { return now }
+
+	qs := New()
+	qs.SetConfig(mustParseConfig(t, `{"quotas": [{"name": "per_tag", "key": "query_tag", "max_concurrency": 1}]}`))
+	q := qs.quotas[0]
+
+	release1, err := qs.Acquire(Query{Tag: "t1"})
+	require.NoError(t, err)
+	release2, err := qs.Acquire(Query{Tag: "t2"})
+	require.NoError(t, err)
+
+	// The tags past MaxLimiters share the same limits.
+	release3, err := qs.Acquire(Query{Tag: "t3"})
+	require.NoError(t, err)
+	_, err = qs.Acquire(Query{Tag: "t4"})
+	require.EqualError(t, err, "query quota per_tag exceeded for query_tag t4: more than 1 concurrent queries")
+	assert.Len(t, q.limiters, 2)
+	release2()
+	release3()
+
+	// The limits that are not used for LimiterIdleTimeout are dropped,
+	// but not the ones of the queries that are still executing.
+	now = now.Add(time.Minute)
+	release4, err := qs.Acquire(Query{Tag: "t4"})
+	require.NoError(t, err)
+	assert.Len(t, q.limiters, 2)
+	assert.Contains(t, q.limiters, "t1")
+	assert.Contains(t, q.limiters, "t4")
+	_, err = qs.Acquire(Query{Tag: "t1"})
+	require.Error(t, err)
+	release1()
+	release4()
+
+	// The limits that are kept are the most recently used.
+	now = now.Add(30 * time.Second)
+	release1, err = qs.Acquire(Query{Tag: "t1"})
+	require.NoError(t, err)
+	release1()
+	now = now.Add(30 * time.Second)
+	release2, err = qs.Acquire(Query{Tag: "t2"})
+	require.NoError(t, err)
+	release2()
+	assert.Len(t, q.limiters, 2)
+	assert.Contains(t, q.limiters, "t1")
+	assert.Contains(t, q.limiters, "t2")
+}
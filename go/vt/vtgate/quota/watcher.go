@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// sleepDuringTopoFailure is how long to sleep before retrying in case of error.
+// (it's a var not a const so the test can change the value).
+var sleepDuringTopoFailure = 30 * time.Second
+
+// Watcher watches the configuration file of the quotas in the topo, and
+// applies it whenever it changes. There are no quotas while the file
+// does not exist.
+type Watcher struct {
+	// quotas, conn and filePath are set at construction time.
+	quotas   *Quotas
+	conn     topo.Conn
+	filePath string
+
+	// mu protects the following variables.
+	mu sync.Mutex
+
+	// cancel is the function to call to cancel the current watch, if any.
+	cancel func()
+
+	// stopped is set when Stop() is called. It is a protection for race conditions.
+	stopped bool
+}
+
+// NewWatcher creates a Watcher that configures quotas with the file at
+// filePath in conn.
+func NewWatcher(quotas *Quotas, conn topo.Conn, filePath string) *Watcher {
+	return &Watcher{
+		quotas:   quotas,
+		conn:     conn,
+		filePath: filePath,
+	}
+}
+
+// Start watches the file in the background, until Stop is called.
+func (w *Watcher) Start() {
+	go func() {
+		for {
+			if err := w.oneWatch(); err != nil {
+				if topo.IsErrType(err, topo.NoNode) {
+					w.quotas.SetConfig(&Config{})
+				} else {
+					log.Warningf("Background watch of query quotas failed: %v", err)
+				}
+			}
+
+			w.mu.Lock()
+			stopped := w.stopped
+			w.mu.Unlock()
+
+			if stopped {
+				log.Infof("Query quotas watch was terminated")
+				return
+			}
+
+			time.Sleep(sleepDuringTopoFailure)
+		}
+	}()
+}
+
+// Stop stops watching the file. The quotas keep their last configuration.
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.stopped = true
+	w.mu.Unlock()
+}
+
+func (w *Watcher) apply(wd *topo.WatchData) error {
+	config, err := ParseConfig(wd.Contents)
+	if err != nil {
+		return fmt.Errorf("error parsing query quotas: %v, original data '%s' version %v", err, wd.Contents, wd.Version)
+	}
+	w.quotas.SetConfig(config)
+	log.Infof("Query quotas version %v fetched from topo and applied", wd.Version)
+	return nil
+}
+
+func (w *Watcher) oneWatch() error {
+	defer func() {
+		// Whatever happens, cancel() won't be valid after this function exits.
+		w.mu.Lock()
+		w.cancel = nil
+		w.mu.Unlock()
+	}()
+
+	current, wdChannel, cancel := w.conn.Watch(context.Background(), w.filePath)
+	if current.Err != nil {
+		return current.Err
+	}
+
+	w.mu.Lock()
+	if w.stopped {
+		// We're not interested in the result any more.
+		w.mu.Unlock()
+		cancel()
+		for range wdChannel {
+		}
+		return topo.NewError(topo.Interrupted, "watch")
+	}
+	w.cancel = cancel
+	w.mu.Unlock()
+
+	// An invalid file is logged, and the quotas keep their last
+	// valid configuration until it is fixed.
+	if err := w.apply(current); err != nil {
+		log.Warning(err)
+	}
+	for wd := range wdChannel {
+		if wd.Err != nil {
+			// Last error value, we're done.
+			// wdChannel will be closed right after
+			// this, no need to do anything.
+			return wd.Err
+		}
+		if err := w.apply(wd); err != nil {
+			log.Warning(err)
+		}
+	}
+
+	return fmt.Errorf("watch terminated with no error")
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+// waitForLimit waits until the quotas allow as many concurrent queries of
+// caller u1 as limit, or any number of them if limit is 0.
+func waitForLimit(t *testing.T, qs *Quotas, limit int) {
+	t.Helper()
+	start := time.Now()
+	for {
+		var releases []func()
+		allowed := 0
+		for allowed <= limit {
+			release, err := qs.Acquire(Query{ImmediateCaller: "u1"})
+			if err != nil {
+				break
+			}
+			releases = append(releases, release)
+			allowed++
+		}
+		for _, release := range releases {
+			release()
+		}
+		if allowed == limit || (limit == 0 && allowed == 1) {
+			return
+		}
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("timeout: quotas in topo were not applied in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	cell := "cell1"
+	filePath := "/vtgate/query_quotas.json"
+	ts := memorytopo.NewServer(cell)
+	sleepDuringTopoFailure = time.Millisecond
+	ctx := context.Background()
+	conn, err := ts.ConnForCell(ctx, cell)
+	require.NoError(t, err)
+
+	qs := New()
+	w := NewWatcher(qs, conn, filePath)
+	w.Start()
+	defer w.Stop()
+
+	_, err = conn.Create(ctx, filePath, []byte(`{"quotas": [{"name": "q", "key": "immediate_caller", "max_concurrency": 2}]}`))
+	require.NoError(t, err)
+	waitForLimit(t, qs, 2)
+
+	_, err = conn.Update(ctx, filePath, []byte(`{"quotas": [{"name": "q", "key": "immediate_caller", "max_concurrency": 3}]}`), nil)
+	require.NoError(t, err)
+	waitForLimit(t, qs, 3)
+
+	// An invalid file does not change the quotas.
+	_, err = conn.Update(ctx, filePath, []byte(`{"quotas": [{"name": "q", "key": "user", "max_concurrency": 1}]}`), nil)
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	waitForLimit(t, qs, 3)
+
+	// The quotas are removed with the file.
+	require.NoError(t, conn.Delete(ctx, filePath, nil))
+	waitForLimit(t, qs, 0)
+}
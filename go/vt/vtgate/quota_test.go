@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/quota"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestExecutorQuotas(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	config, err := quota.ParseConfig([]byte(`{"quotas": [
+		{"name": "per_user", "key": "immediate_caller", "max_qps": 0.001, "burst": 2},
+		{"name": "batch", "key": "query_tag", "value": "batch", "max_qps": 0.001, "burst": 1}
+	]}`))
+	require.NoError(t, err)
+	executor.quotas = quota.New()
+	executor.quotas.SetConfig(config)
+
+	user := func(name string) context.Context {
+		return callerid.NewContext(context.Background(), &vtrpcpb.CallerID{}, &querypb.VTGateCallerID{Username: name})
+	}
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	exec := func(ctx context.Context, sql string) error {
+		_, err := executor.Execute(ctx, "TestExecute", session, sql, nil)
+		return err
+	}
+
+	require.NoError(t, exec(user("u1"), "select id from user where id = 1"))
+	require.NoError(t, exec(user("u1"), "select id from user where id = 1"))
+	err = exec(user("u1"), "select id from user where id = 1")
+	require.EqualError(t, err, "query quota per_user exceeded for immediate_caller u1: more than 0.001 queries per second")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	// The transactions can always be ended.
+	require.NoError(t, exec(user("u1"), "commit"))
+
+	// The other callers have their own quotas, and are
+	// limited by the quotas of the tags of their queries.
+	require.NoError(t, exec(user("u2"), "/*vt+ QUERY_TAG=batch */ select id from user where id = 1"))
+	require.EqualError(t,
+		exec(user("u3"), "/*vt+ QUERY_TAG=batch */ select id from user where id = 1"),
+		"query quota batch exceeded for query_tag batch: more than 0.001 queries per second")
+	require.NoError(t, exec(user("u3"), "/*vt+ QUERY_TAG=interactive */ select id from user where id = 1"))
+
+	err = executor.StreamExecute(user("u1"), "TestStreamExecute", NewSafeSession(&vtgatepb.Session{TargetString: "@master"}), "select id from user", nil, querypb.Target{}, func(*sqltypes.Result) error {
+		return nil
+	})
+	require.EqualError(t, err, "query quota per_user exceeded for immediate_caller u1: more than 0.001 queries per second")
+}
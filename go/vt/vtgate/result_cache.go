@@ -0,0 +1,302 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	resultCacheHits          = stats.NewCounter("ResultCacheHits", "Number of selects served by the result cache")
+	resultCacheMisses        = stats.NewCounter("ResultCacheMisses", "Number of cacheable selects not served by the result cache")
+	resultCacheInvalidations = stats.NewCountersWithSingleLabel("ResultCacheInvalidations", "Number of times the cached results of a table were invalidated because it changed", "Table")
+)
+
+// resultCache caches the results of the selects that only read tables that
+// have a result_cache_ttl in the vschema, by their normalized query and bind
+// variables. A result is cached until its TTL expires, or until one of its
+// tables changes. The changes of the tables are streamed from the master
+// tablets, so the results read from replicas can be cached for a while
+// before the changes are replicated to them.
+type resultCache struct {
+	vstream    vstreamFunc
+	retryDelay time.Duration
+	maxRows    int
+	results    *cache.LRUCache
+
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	// tables are the tables whose results are cached,
+	// by their name qualified by their keyspace.
+	tables map[string]*resultCacheTable
+	// lastGeneration is the last generation given to a table.
+	lastGeneration uint64
+}
+
+// resultCacheTable is a table whose results are cached.
+type resultCacheTable struct {
+	cancel context.CancelFunc
+	// generation changes when the table changes. The results that were read
+	// at another generation of one of their tables are not used. It is 0
+	// while the table is not streamed, and its results are not cached then.
+	generation uint64
+}
+
+// cachedResult is a cached result, and the generations of its tables that
+// it was read at.
+type cachedResult struct {
+	result      *sqltypes.Result
+	generations []uint64
+	expires     time.Time
+	size        int64
+}
+
+func newResultCache(vstream vstreamFunc, memory int64, maxRows int) *resultCache {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &resultCache{
+		vstream:    vstream,
+		retryDelay: 5 * time.Second,
+		maxRows:    maxRows,
+		results: cache.NewLRUCache(memory, func(val interface{}) int64 {
+			return val.(*cachedResult).size
+		}),
+		ctx:    ctx,
+		cancel: cancel,
+		tables: make(map[string]*resultCacheTable),
+	}
+}
+
+// setVSchema must be called with every new vschema, before it is used.
+// It streams the tables that have a result_cache_ttl in it.
+func (rc *resultCache) setVSchema(vschema *vindexes.VSchema) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.ctx.Err() != nil {
+		return
+	}
+
+	cached := make(map[string]keyspaceTable)
+	for ksName, ks := range vschema.Keyspaces {
+		for name, table := range ks.Tables {
+			if table.ResultCacheTTL > 0 {
+				table := keyspaceTable{keyspace: ksName, name: name}
+				cached[table.String()] = table
+			}
+		}
+	}
+	for name, state := range rc.tables {
+		if _, ok := cached[name]; !ok {
+			state.cancel()
+			delete(rc.tables, name)
+		}
+	}
+	for name, table := range cached {
+		if rc.tables[name] != nil {
+			continue
+		}
+		ctx, cancel := context.WithCancel(rc.ctx)
+		state := &resultCacheTable{cancel: cancel}
+		rc.tables[name] = state
+		go streamTable(ctx, rc.vstream, rc.retryDelay, table, rc.sendFunc(name, state), rc.restartFunc(state))
+	}
+}
+
+// stop stops streaming the tables.
+func (rc *resultCache) stop() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.cancel()
+}
+
+// sendFunc returns the function that the changes of table are streamed to.
+// The first events start the stream, and the next changes invalidate the
+// results of the table.
+func (rc *resultCache) sendFunc(name string, state *resultCacheTable) func(events []*binlogdatapb.VEvent) error {
+	return func(events []*binlogdatapb.VEvent) error {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		if state.generation == 0 {
+			rc.lastGeneration++
+			state.generation = rc.lastGeneration
+		}
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_ROW, binlogdatapb.VEventType_DDL:
+				rc.lastGeneration++
+				state.generation = rc.lastGeneration
+				resultCacheInvalidations.Add(name, 1)
+				return nil
+			}
+		}
+		return nil
+	}
+}
+
+// restartFunc returns the function that is called when the stream of a table
+// failed. Its results are not cached until the stream is started again.
+func (rc *resultCache) restartFunc(state *resultCacheTable) func() {
+	return func() {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		state.generation = 0
+	}
+}
+
+// generations returns the current generations of tables, or false if
+// the results of one of them can't be cached.
+func (rc *resultCache) generations(tables []string) ([]uint64, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	generations := make([]uint64, len(tables))
+	for i, name := range tables {
+		state := rc.tables[name]
+		if state == nil || state.generation == 0 {
+			return nil, false
+		}
+		generations[i] = state.generation
+	}
+	return generations, true
+}
+
+// get returns the cached result of key, if its tables did not change and
+// it did not expire.
+func (rc *resultCache) get(key string, options *engine.ResultCache) (*sqltypes.Result, bool) {
+	val, ok := rc.results.Get(key)
+	if !ok {
+		resultCacheMisses.Add(1)
+		return nil, false
+	}
+	cached := val.(*cachedResult)
+	generations, ok := rc.generations(options.Tables)
+	if !ok || time.Now().After(cached.expires) || !equalGenerations(generations, cached.generations) {
+		rc.results.Delete(key)
+		resultCacheMisses.Add(1)
+		return nil, false
+	}
+	resultCacheHits.Add(1)
+	return cached.result.Copy(), true
+}
+
+// set caches the result of key, which was read at generations.
+func (rc *resultCache) set(key string, options *engine.ResultCache, generations []uint64, result *sqltypes.Result) {
+	if len(result.Rows) > rc.maxRows {
+		return
+	}
+	rc.results.Set(key, &cachedResult{
+		result:      result.Copy(),
+		generations: generations,
+		expires:     time.Now().Add(options.TTL),
+		size:        int64(len(key)) + resultSize(result),
+	})
+}
+
+func equalGenerations(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// resultSize estimates the memory used by a result.
+func resultSize(result *sqltypes.Result) int64 {
+	size := int64(0)
+	for _, field := range result.Fields {
+		size += int64(proto.Size(field))
+	}
+	for _, row := range result.Rows {
+		for _, value := range row {
+			// The size of a Value, and of its bytes.
+			size += 32 + int64(value.Len())
+		}
+	}
+	return size
+}
+
+// resultCacheKey returns the key of the result of a query: the prefix of
+// its plan, its callers, the query, and its bind variables, sorted by name.
+// The tablets check the table ACLs against the callers, so a result is only
+// served to the callers it was read for.
+func resultCacheKey(ctx context.Context, prefix, query string, bindVars map[string]*querypb.BindVariable) string {
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var key strings.Builder
+	key.WriteString(prefix)
+	ef := callerid.EffectiveCallerIDFromContext(ctx)
+	writeResultCacheKeyString(&key, callerid.GetPrincipal(ef))
+	writeResultCacheKeyString(&key, callerid.GetComponent(ef))
+	writeResultCacheKeyString(&key, callerid.GetSubcomponent(ef))
+	writeResultCacheKeyString(&key, callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx)))
+	key.WriteByte(':')
+	key.WriteString(query)
+	for _, name := range names {
+		bv := bindVars[name]
+		key.WriteByte(0)
+		key.WriteString(name)
+		writeResultCacheKeyValue(&key, bv.Type, bv.Value)
+		for _, value := range bv.Values {
+			writeResultCacheKeyValue(&key, value.Type, value.Value)
+		}
+	}
+	return key.String()
+}
+
+// writeResultCacheKeyString writes the length of a string before it,
+// so that different callers can't have the same key.
+func writeResultCacheKeyString(key *strings.Builder, value string) {
+	key.WriteByte(0)
+	key.WriteString(strconv.Itoa(len(value)))
+	key.WriteByte(',')
+	key.WriteString(value)
+}
+
+// writeResultCacheKeyValue writes the type and the length of a value before
+// it, so that different bind variables can't have the same key.
+func writeResultCacheKeyValue(key *strings.Builder, typ querypb.Type, value []byte) {
+	key.WriteByte(0)
+	key.WriteString(strconv.Itoa(int(typ)))
+	key.WriteByte(',')
+	key.WriteString(strconv.Itoa(len(value)))
+	key.WriteByte(',')
+	key.Write(value)
+}
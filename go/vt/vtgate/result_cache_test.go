@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func resultCacheVSchema(t *testing.T, ttl string) *vindexes.VSchema {
+	t.Helper()
+	vschema, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {ResultCacheTtl: ttl},
+					"t2": {},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return vschema
+}
+
+func resultCacheRowEvent() []*binlogdatapb.VEvent {
+	return []*binlogdatapb.VEvent{{
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName:  "ks.t1",
+			RowChanges: []*binlogdatapb.RowChange{{After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1)})}},
+		},
+	}}
+}
+
+// cacheResult caches the result of key if its tables can be cached,
+// the way the executor does.
+func cacheResult(rc *resultCache, key string, options *engine.ResultCache, result *sqltypes.Result) {
+	if generations, ok := rc.generations(options.Tables); ok {
+		rc.set(key, options, generations, result)
+	}
+}
+
+func TestResultCache(t *testing.T) {
+	streams := newFakeTableStreams()
+	rc := newResultCache(streams.vstream, 1024*1024, 2)
+	rc.retryDelay = time.Millisecond
+	defer rc.stop()
+
+	rc.setVSchema(resultCacheVSchema(t, "1m"))
+	assert.Equal(t, "ks.t1", streams.waitForStream(t))
+	options := &engine.ResultCache{TTL: time.Minute, Tables: []string{"ks.t1"}}
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+
+	// The results are not cached until the table is streamed.
+	cacheResult(rc, "q1", options, result)
+	_, ok := rc.get("q1", options)
+	assert.False(t, ok)
+
+	require.NoError(t, streams.sends["ks.t1"](nil))
+	cacheResult(rc, "q1", options, result)
+	cached, ok := rc.get("q1", options)
+	require.True(t, ok)
+	assert.True(t, result.Equal(cached))
+
+	// The results that have too many rows are not cached.
+	cacheResult(rc, "q2", options, sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2", "3"))
+	_, ok = rc.get("q2", options)
+	assert.False(t, ok)
+
+	// The results of a table are invalidated when it changes.
+	invalidations := resultCacheInvalidations.Counts()["ks.t1"]
+	require.NoError(t, streams.sends["ks.t1"](resultCacheRowEvent()))
+	_, ok = rc.get("q1", options)
+	assert.False(t, ok)
+	assert.Equal(t, invalidations+1, resultCacheInvalidations.Counts()["ks.t1"])
+
+	// The results are cached for their TTL.
+	cacheResult(rc, "q1", &engine.ResultCache{TTL: time.Nanosecond, Tables: options.Tables}, result)
+	time.Sleep(time.Millisecond)
+	_, ok = rc.get("q1", options)
+	assert.False(t, ok)
+
+	// The results are not cached while the stream is restarted.
+	cacheResult(rc, "q1", options, result)
+	streams.errs <- fmt.Errorf("stream failed")
+	assert.Equal(t, "ks.t1", streams.waitForStream(t))
+	_, ok = rc.get("q1", options)
+	assert.False(t, ok)
+	cacheResult(rc, "q1", options, result)
+	_, ok = rc.get("q1", options)
+	assert.False(t, ok)
+	require.NoError(t, streams.sends["ks.t1"](nil))
+	cacheResult(rc, "q1", options, result)
+	_, ok = rc.get("q1", options)
+	assert.True(t, ok)
+
+	// The table is not streamed when its results are not cached anymore.
+	rc.setVSchema(resultCacheVSchema(t, ""))
+	assert.Empty(t, rc.tables)
+	_, ok = rc.get("q1", options)
+	assert.False(t, ok)
+}
+
+func TestResultCacheKey(t *testing.T) {
+	ctx := context.Background()
+	key := resultCacheKey(ctx, "ks@master", "select 1 from t1 where a = :a and b = :b", map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.StringBindVariable("x"),
+	})
+	assert.Equal(t, key, resultCacheKey(ctx, "ks@master", "select 1 from t1 where a = :a and b = :b", map[string]*querypb.BindVariable{
+		"b": sqltypes.StringBindVariable("x"),
+		"a": sqltypes.Int64BindVariable(1),
+	}))
+	assert.NotEqual(t, key, resultCacheKey(ctx, "ks@replica", "select 1 from t1 where a = :a and b = :b", map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(1),
+		"b": sqltypes.StringBindVariable("x"),
+	}))
+	assert.NotEqual(t, key, resultCacheKey(ctx, "ks@master", "select 1 from t1 where a = :a and b = :b", map[string]*querypb.BindVariable{
+		"a": sqltypes.StringBindVariable("1"),
+		"b": sqltypes.StringBindVariable("x"),
+	}))
+	assert.NotEqual(t,
+		resultCacheKey(ctx, "ks@master", "select 1 from t1 where a in ::a", map[string]*querypb.BindVariable{
+			"a": sqltypes.TestBindVariable([]interface{}{"a", "bc"}),
+		}),
+		resultCacheKey(ctx, "ks@master", "select 1 from t1 where a in ::a", map[string]*querypb.BindVariable{
+			"a": sqltypes.TestBindVariable([]interface{}{"ab", "c"}),
+		}))
+
+	// The results read for other callers are not used.
+	sql := "select 1 from t1"
+	alice := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("alice", "", ""), callerid.NewImmediateCallerID("app"))
+	bob := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("bob", "", ""), callerid.NewImmediateCallerID("app"))
+	admin := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("alice", "", ""), callerid.NewImmediateCallerID("admin"))
+	assert.Equal(t, resultCacheKey(alice, "ks@master", sql, nil), resultCacheKey(alice, "ks@master", sql, nil))
+	assert.NotEqual(t, resultCacheKey(alice, "ks@master", sql, nil), resultCacheKey(bob, "ks@master", sql, nil))
+	assert.NotEqual(t, resultCacheKey(alice, "ks@master", sql, nil), resultCacheKey(admin, "ks@master", sql, nil))
+	assert.NotEqual(t, resultCacheKey(alice, "ks@master", sql, nil), resultCacheKey(ctx, "ks@master", sql, nil))
+}
+
+func TestExecutorResultCache(t *testing.T) {
+	vschema := `
+{
+	"sharded": true,
+	"vindexes": {
+		"hash_index": {
+			"type": "hash"
+		}
+	},
+	"tables": {
+		"cached": {
+			"column_vindexes": [
+				{
+					"column": "id",
+					"name": "hash_index"
+				}
+			],
+			"result_cache_ttl": "1m"
+		}
+	}
+}
+`
+	executor, sbc1, _, _ := createCustomExecutor(vschema)
+	streams := newFakeTableStreams()
+	rc := newResultCache(streams.vstream, 1024*1024, 10)
+	defer rc.stop()
+	executor.setResultCache(rc)
+	assert.Equal(t, "TestExecutor.cached", streams.waitForStream(t))
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|a")
+	sbc1.SetResults([]*sqltypes.Result{result, result, result, result, result})
+	exec := func(session *vtgatepb.Session, sql string) {
+		t.Helper()
+		qr, err := executor.Execute(context.Background(), "TestExecute", NewSafeSession(session), sql, nil)
+		require.NoError(t, err)
+		assert.Equal(t, result.Rows, qr.Rows)
+	}
+	autocommit := &vtgatepb.Session{TargetString: "@master", Autocommit: true}
+	sql := "select id, name from cached where id = 1"
+
+	// The results are not cached until the table is streamed.
+	exec(autocommit, sql)
+	require.NoError(t, streams.sends["TestExecutor.cached"](nil))
+	hits := resultCacheHits.Get()
+	exec(autocommit, sql)
+	exec(autocommit, sql)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+	assert.Equal(t, hits+1, resultCacheHits.Get())
+
+	// The results are not cached in transactions.
+	exec(&vtgatepb.Session{TargetString: "@master"}, sql)
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+
+	// The results are read again when the table changes.
+	require.NoError(t, streams.sends["TestExecutor.cached"](resultCacheRowEvent()))
+	exec(autocommit, sql)
+	exec(autocommit, sql)
+	assert.EqualValues(t, 4, sbc1.ExecCount.Get())
+
+	// The cached results are logged.
+	logChan := QueryLogger.Subscribe("Test")
+	defer QueryLogger.Unsubscribe(logChan)
+	exec(autocommit, sql)
+	logStats := testQueryLog(t, logChan, "TestExecute", "SELECT", sql, 0)
+	assert.True(t, logStats.CachedResult)
+}
+
+func TestExecutorResultCacheCallers(t *testing.T) {
+	vschema := `
+{
+	"sharded": true,
+	"vindexes": {
+		"hash_index": {
+			"type": "hash"
+		}
+	},
+	"tables": {
+		"cached": {
+			"column_vindexes": [
+				{
+					"column": "id",
+					"name": "hash_index"
+				}
+			],
+			"result_cache_ttl": "1m"
+		}
+	}
+}
+`
+	executor, sbc1, _, _ := createCustomExecutor(vschema)
+	streams := newFakeTableStreams()
+	rc := newResultCache(streams.vstream, 1024*1024, 10)
+	defer rc.stop()
+	executor.setResultCache(rc)
+	assert.Equal(t, "TestExecutor.cached", streams.waitForStream(t))
+	require.NoError(t, streams.sends["TestExecutor.cached"](nil))
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|a")
+	sbc1.SetResults([]*sqltypes.Result{result, result})
+	exec := func(user string) (*sqltypes.Result, error) {
+		ctx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID(user))
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+		return executor.Execute(ctx, "TestExecute", session, "select id, name from cached where id = 1", nil)
+	}
+
+	qr, err := exec("allowed")
+	require.NoError(t, err)
+	assert.Equal(t, result.Rows, qr.Rows)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+
+	// The table ACL of the tablet denies the query to the other caller,
+	// which is not served the result cached for the first one.
+	sbc1.MustFailCodes[vtrpcpb.Code_PERMISSION_DENIED] = 1
+	_, err = exec("denied")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "PERMISSION_DENIED")
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	qr, err = exec("allowed")
+	require.NoError(t, err)
+	assert.Equal(t, result.Rows, qr.Rows)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+}
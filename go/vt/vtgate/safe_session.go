@@ -457,6 +457,43 @@ func (session *SafeSession) ResetShard(tabletAlias *topodatapb.TabletAlias) erro
 	return nil
 }
 
+// SetConsistentSnapshot sets whether the transactions of the session are
+// read only consistent snapshots. The shards that a transaction reads
+// start their snapshots at a common cut when it begins.
+func (session *SafeSession) SetConsistentSnapshot(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	switch {
+	case enable:
+		session.GetOrCreateOptions().TransactionIsolation = querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+	case session.inConsistentSnapshot():
+		session.Options.TransactionIsolation = querypb.ExecuteOptions_DEFAULT
+	}
+}
+
+// InConsistentSnapshot returns true if the transactions of the session are consistent snapshots.
+func (session *SafeSession) InConsistentSnapshot() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.inConsistentSnapshot()
+}
+
+func (session *SafeSession) inConsistentSnapshot() bool {
+	return session.Options != nil && session.Options.TransactionIsolation == querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+}
+
+// HasShardTransactions returns true if the session has begun a transaction on a shard.
+func (session *SafeSession) HasShardTransactions() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // SetDDLStrategy set the DDLStrategy setting.
 func (session *SafeSession) SetDDLStrategy(strategy string) {
 	session.mu.Lock()
@@ -492,6 +529,20 @@ func (session *SafeSession) GetSessionEnableSystemSettings() bool {
 	return session.EnableSystemSettings
 }
 
+// SetQueryTimeout sets the max_execution_time of the session, in milliseconds.
+func (session *SafeSession) SetQueryTimeout(timeout int64) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.QueryTimeout = timeout
+}
+
+// GetQueryTimeout returns the max_execution_time of the session, in milliseconds.
+func (session *SafeSession) GetQueryTimeout() int64 {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.QueryTimeout
+}
+
 // SetReadAfterWriteGTID set the ReadAfterWriteGtid setting.
 func (session *SafeSession) SetReadAfterWriteGTID(vtgtid string) {
 	session.mu.Lock()
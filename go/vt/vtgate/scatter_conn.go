@@ -183,6 +183,12 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		}()
 	}
 
+	if session.InConsistentSnapshot() && !autocommit {
+		if err := stc.startConsistentSnapshots(ctx, rss, session); err != nil {
+			return nil, []error{err}
+		}
+	}
+
 	allErrors := stc.multiGoTransaction(
 		ctx,
 		"Execute",
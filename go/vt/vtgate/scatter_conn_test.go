@@ -35,6 +35,9 @@ import (
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // This file uses the sandbox_test framework.
@@ -304,3 +307,128 @@ func TestReservedConnFail(t *testing.T) {
 	require.Equal(t, 1, len(session.ShardSessions))
 	assert.NotEqual(t, oldRId, session.Session.ShardSessions[0].ReservedId, "should have recreated a reserved connection since the last connection was lost")
 }
+
+func TestConsistentSnapshots(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, rss01 := newTestTxConnEnv(t, "TestConsistentSnapshots")
+	consistentSnapshotShards.Reset()
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session.SetConsistentSnapshot(true)
+
+	queries := []*querypb.BoundQuery{{Sql: "select id from t1"}, {Sql: "select id from t1"}}
+	_, errs := sc.ExecuteMultiShard(ctx, rss01, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+
+	// The masters are locked while the snapshots are started at their GTID sets.
+	wantQueries := []string{
+		"set @@session.lock_wait_timeout = 10",
+		"flush tables with read lock",
+		"select @@global.gtid_executed",
+		cutQuery,
+		"select id from t1",
+	}
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc0, sbc1} {
+		var gotQueries []string
+		for _, query := range sbc.Queries {
+			gotQueries = append(gotQueries, query.Sql)
+		}
+		assert.Equal(t, wantQueries, gotQueries)
+		assert.EqualValues(t, 1, sbc.ReserveCount.Get())
+		assert.EqualValues(t, 1, sbc.ReleaseCount.Get())
+		assert.EqualValues(t, 1, sbc.BeginCount.Get())
+		assert.Equal(t, querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY, sbc.Options[3].TransactionIsolation)
+	}
+	require.Len(t, session.ShardSessions, 2)
+	assert.NotZero(t, session.ShardSessions[0].TransactionId)
+	assert.NotZero(t, session.ShardSessions[1].TransactionId)
+	assert.EqualValues(t, 2, consistentSnapshotShards.Get())
+
+	// The shards that are in the transaction are not locked again.
+	_, errs = sc.ExecuteMultiShard(ctx, rss01, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.EqualValues(t, 1, sbc0.ReserveCount.Get())
+	assert.EqualValues(t, 1, sbc1.ReserveCount.Get())
+	assert.Len(t, sbc0.Queries, 6)
+
+	// A single shard is not locked.
+	session = NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session.SetConsistentSnapshot(true)
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, queries[:1], session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.EqualValues(t, 1, sbc0.ReserveCount.Get())
+	assert.EqualValues(t, 2, sbc0.BeginCount.Get())
+
+	// But the transaction can't start a snapshot on another shard.
+	_, errs = sc.ExecuteMultiShard(ctx, rss1, queries[:1], session, false, false)
+	require.EqualError(t, vterrors.Aggregate(errs), "a consistent snapshot transaction can only read the shards it started on, it can't start a snapshot on TestConsistentSnapshots/1")
+	assert.EqualValues(t, 1, sbc1.BeginCount.Get())
+}
+
+func TestConsistentSnapshotsReplicas(t *testing.T) {
+	name := "TestConsistentSnapshotsReplicas"
+	sc, sbc0, sbc1, _, _, _ := newTestTxConnEnv(t, name)
+	consistentSnapshotWaits.Reset()
+	hc := sc.gateway.(*TabletGateway).hc.(*discovery.FakeHealthCheck)
+	replica0 := hc.AddTestTablet("aa", "2", 1, name, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	replica1 := hc.AddTestTablet("aa", "3", 1, name, "1", topodatapb.TabletType_REPLICA, true, 1, nil)
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	rss, err := res.ResolveDestination(ctx, name, topodatapb.TabletType_REPLICA, key.DestinationShards([]string{"0", "1"}))
+	require.NoError(t, err)
+	// The masters execute the setting of the lock wait timeout,
+	// the lock, and the read of their GTID set.
+	gtidResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid_executed", "varchar"), "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10")
+	sbc0.SetResults([]*sqltypes.Result{{}, {}, gtidResult})
+	sbc1.SetResults([]*sqltypes.Result{{}, {}, gtidResult})
+	// The first replica has reached the cut, the second one waits for it.
+	reached := sqltypes.MakeTestResult(sqltypes.MakeTestFields("reached", "int64"), "1")
+	notReached := sqltypes.MakeTestResult(sqltypes.MakeTestFields("reached", "int64"), "0")
+	waited := sqltypes.MakeTestResult(sqltypes.MakeTestFields("waited", "int64"), "0")
+	replica0.SetResults([]*sqltypes.Result{reached})
+	replica1.SetResults([]*sqltypes.Result{notReached, waited, reached})
+
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session.SetConsistentSnapshot(true)
+	queries := []*querypb.BoundQuery{{Sql: "select id from t1"}, {Sql: "select id from t1"}}
+	_, errs := sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+
+	// The masters are locked, and the replicas start their snapshots.
+	for _, sbc := range []*sandboxconn.SandboxConn{sbc0, sbc1} {
+		assert.EqualValues(t, 1, sbc.ReserveCount.Get())
+		assert.EqualValues(t, 1, sbc.ReleaseCount.Get())
+		assert.EqualValues(t, 0, sbc.BeginCount.Get())
+	}
+	assert.EqualValues(t, 1, replica0.BeginCount.Get())
+	assert.EqualValues(t, 0, replica0.RollbackCount.Get())
+	assert.EqualValues(t, 2, replica1.BeginCount.Get())
+	assert.EqualValues(t, 1, replica1.RollbackCount.Get())
+	var gotQueries []string
+	for _, query := range replica1.Queries {
+		gotQueries = append(gotQueries, query.Sql)
+	}
+	assert.Equal(t, []string{cutQuery, waitCutQuery, cutQuery, "select id from t1"}, gotQueries)
+	assert.Equal(t, gtidResult.Rows[0][0].ToString(), string(replica1.Queries[1].BindVariables["cut"].Value))
+	assert.EqualValues(t, 1, consistentSnapshotWaits.Get())
+	require.Len(t, session.ShardSessions, 2)
+}
+
+func TestConsistentSnapshotsLockFailure(t *testing.T) {
+	sc, sbc0, sbc1, _, _, rss01 := newTestTxConnEnv(t, "TestConsistentSnapshotsLockFailure")
+	consistentSnapshotErrors.ResetAll()
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	session.SetConsistentSnapshot(true)
+
+	// The setting of the lock wait timeout and the lock fail.
+	sbc1.MustFailCodes[vtrpcpb.Code_DEADLINE_EXCEEDED] = 2
+	queries := []*querypb.BoundQuery{{Sql: "select id from t1"}, {Sql: "select id from t1"}}
+	_, errs := sc.ExecuteMultiShard(ctx, rss01, queries, session, false, false)
+	err := vterrors.Aggregate(errs)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to start consistent snapshots: target: TestConsistentSnapshotsLockFailure.1.master")
+
+	// The other shard is unlocked, and no snapshot is started.
+	assert.EqualValues(t, 1, sbc0.ReleaseCount.Get())
+	assert.EqualValues(t, 0, sbc0.BeginCount.Get())
+	assert.EqualValues(t, 0, sbc1.BeginCount.Get())
+	assert.Empty(t, session.ShardSessions)
+	assert.Equal(t, map[string]int64{"Lock": 1}, consistentSnapshotErrors.Counts())
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// allColumnsQuery returns the columns of all the tables.
+	allColumnsQuery = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() order by table_name, ordinal_position"
+
+	// tableColumnsQuery returns the columns of the tables in :tableNames.
+	tableColumnsQuery = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() and table_name in ::tableNames order by table_name, ordinal_position"
+
+	// loadColumnsTimeout is the timeout of the queries loading the columns.
+	loadColumnsTimeout = 30 * time.Second
+)
+
+// ColumnTracker keeps track of the columns of the tables, as reported by
+// the master tablets. It loads the columns of all the tables of a keyspace
+// when it first sees its master, and then only reloads the columns of the
+// tables that the health messages of the masters signal as changed. The
+// changes are missed while the health messages of a master are not
+// received, and a new master doesn't signal the changes made before it
+// was promoted, so all the tables are loaded again when the master of a
+// shard changes, or when it serves again after it stopped serving.
+type ColumnTracker struct {
+	ch     chan *discovery.TabletHealth
+	cancel context.CancelFunc
+
+	// wake is signaled when there are keyspaces to load.
+	wake chan struct{}
+
+	mu     sync.Mutex
+	signal func()
+	tables map[string]map[string][]vindexes.Column
+	// pending contains the keyspaces that have to be loaded.
+	pending map[string]*keyspaceUpdate
+	// masters contains the serving masters, by keyspace and shard.
+	masters map[keyspaceShard]masterTerm
+}
+
+type keyspaceShard struct {
+	keyspace string
+	shard    string
+}
+
+// masterTerm identifies the term of a master tablet.
+type masterTerm struct {
+	alias     string
+	startTime int64
+}
+
+// keyspaceUpdate contains the tables of a keyspace
+// whose columns have to be loaded.
+type keyspaceUpdate struct {
+	// th is the master tablet the columns are loaded from.
+	th *discovery.TabletHealth
+
+	// all is true if the columns of all the tables have to be loaded.
+	all    bool
+	tables map[string]bool
+}
+
+// NewColumnTracker creates a ColumnTracker that receives
+// the health messages of the tablets from ch.
+func NewColumnTracker(ch chan *discovery.TabletHealth) *ColumnTracker {
+	return &ColumnTracker{
+		ch:      ch,
+		wake:    make(chan struct{}, 1),
+		tables:  make(map[string]map[string][]vindexes.Column),
+		pending: make(map[string]*keyspaceUpdate),
+		masters: make(map[keyspaceShard]masterTerm),
+	}
+}
+
+// RegisterSignalReceiver registers the function that is
+// called every time the columns of some tables are loaded.
+func (t *ColumnTracker) RegisterSignalReceiver(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.signal = f
+}
+
+// Start starts tracking the columns in the background.
+func (t *ColumnTracker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	go t.receive(ctx)
+	go t.load(ctx)
+}
+
+// Stop stops tracking the columns.
+func (t *ColumnTracker) Stop() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+// Tables returns the columns of the tables of a keyspace, by table name.
+// The map must not be modified.
+func (t *ColumnTracker) Tables(keyspace string) map[string][]vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[keyspace]
+}
+
+// receive must not block, as the health check
+// drops the messages that can't be delivered.
+func (t *ColumnTracker) receive(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case th := <-t.ch:
+			if th == nil {
+				// The channel was closed.
+				return
+			}
+			if t.update(th) {
+				select {
+				case t.wake <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// update records the tables to load from a health message,
+// and returns true if there are tables to load.
+func (t *ColumnTracker) update(th *discovery.TabletHealth) bool {
+	if th.Target == nil || th.Target.TabletType != topodatapb.TabletType_MASTER {
+		return false
+	}
+	keyspace := th.Target.Keyspace
+	shard := keyspaceShard{keyspace: keyspace, shard: th.Target.Shard}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !th.Serving || th.Conn == nil {
+		// The changes are not signaled until it serves again.
+		delete(t.masters, shard)
+		return false
+	}
+	term := masterTerm{alias: topoproto.TabletAliasString(th.Tablet.GetAlias()), startTime: th.MasterTermStartTime}
+	known, ok := t.masters[shard]
+	t.masters[shard] = term
+	changed := th.Stats.GetTableSchemaChanged()
+
+	_, loaded := t.tables[keyspace]
+	// The changes made before this master, or while it wasn't
+	// serving, were not signaled, so all the tables are loaded.
+	all := !loaded || !ok || known != term
+	update := t.pending[keyspace]
+	if update == nil {
+		if !all && len(changed) == 0 {
+			return false
+		}
+		update = &keyspaceUpdate{tables: make(map[string]bool)}
+		t.pending[keyspace] = update
+	}
+	update.th = th
+	update.all = update.all || all
+	for _, table := range changed {
+		update.tables[table] = true
+	}
+	return true
+}
+
+func (t *ColumnTracker) load(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.wake:
+		}
+
+		t.mu.Lock()
+		pending := t.pending
+		t.pending = make(map[string]*keyspaceUpdate)
+		t.mu.Unlock()
+
+		for keyspace, update := range pending {
+			tables, err := loadColumns(ctx, update)
+			if err != nil {
+				// The keyspace is loaded again with the next health message.
+				log.Warningf("Error loading the columns of the tables of keyspace %v: %v", keyspace, err)
+				t.retry(keyspace, update)
+				continue
+			}
+			t.save(keyspace, update, tables)
+		}
+	}
+}
+
+// retry adds back the tables of an update that failed to the pending ones.
+func (t *ColumnTracker) retry(keyspace string, update *keyspaceUpdate) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if next := t.pending[keyspace]; next != nil {
+		next.all = next.all || update.all
+		for table := range update.tables {
+			next.tables[table] = true
+		}
+		return
+	}
+	t.pending[keyspace] = update
+}
+
+func (t *ColumnTracker) save(keyspace string, update *keyspaceUpdate, loaded map[string][]vindexes.Column) {
+	t.mu.Lock()
+	tables := loaded
+	if !update.all {
+		// The maps are never modified once saved, as they are returned by Tables.
+		tables = make(map[string][]vindexes.Column)
+		// The tables of the update are replaced by the loaded ones,
+		// which don't include the tables that were dropped.
+		for table, columns := range t.tables[keyspace] {
+			if !update.tables[table] {
+				tables[table] = columns
+			}
+		}
+		for table, columns := range loaded {
+			tables[table] = columns
+		}
+	}
+	t.tables[keyspace] = tables
+	signal := t.signal
+	t.mu.Unlock()
+
+	if signal != nil {
+		signal()
+	}
+}
+
+// loadColumns loads the columns of the tables of an update from its tablet.
+func loadColumns(ctx context.Context, update *keyspaceUpdate) (map[string][]vindexes.Column, error) {
+	ctx, cancel := context.WithTimeout(ctx, loadColumnsTimeout)
+	defer cancel()
+
+	query := allColumnsQuery
+	var bindVars map[string]*querypb.BindVariable
+	if !update.all {
+		var names []string
+		for table := range update.tables {
+			names = append(names, table)
+		}
+		sort.Strings(names)
+		tableNames, err := sqltypes.BuildBindVariable(names)
+		if err != nil {
+			return nil, err
+		}
+		query = tableColumnsQuery
+		bindVars = map[string]*querypb.BindVariable{"tableNames": tableNames}
+	}
+	qr, err := update.th.Conn.Execute(ctx, update.th.Target, query, bindVars, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[string][]vindexes.Column)
+	for _, row := range qr.Rows {
+		table := row[0].ToString()
+		columnType := &sqlparser.ColumnType{
+			Type:     row[2].ToString(),
+			Unsigned: strings.Contains(strings.ToLower(row[3].ToString()), "unsigned"),
+		}
+		tables[table] = append(tables[table], vindexes.Column{
+			Name: sqlparser.NewColIdent(row[1].ToString()),
+			Type: columnType.SQLType(),
+		})
+	}
+	return tables, nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var columnFields = sqltypes.MakeTestFields("table_name|column_name|data_type|column_type", "varchar|varchar|varchar|varchar")
+
+func newTabletHealth(sbc *sandboxconn.SandboxConn, tabletType topodatapb.TabletType, changed ...string) *discovery.TabletHealth {
+	return &discovery.TabletHealth{
+		Conn:                sbc,
+		Tablet:              &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}},
+		Target:              &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: tabletType},
+		MasterTermStartTime: 1,
+		Serving:             true,
+		Stats:               &querypb.RealtimeStats{TableSchemaChanged: changed},
+	}
+}
+
+func waitForSignal(t *testing.T, signal chan struct{}) {
+	t.Helper()
+	select {
+	case <-signal:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the columns to be loaded")
+	}
+}
+
+func TestColumnTracker(t *testing.T) {
+	sbc := sandboxconn.NewSandboxConn(&topodatapb.Tablet{Keyspace: "ks", Shard: "-80"})
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(columnFields,
+			"t1|id|int|int(10) unsigned",
+			"t1|name|varchar|varchar(10)",
+			"t2|id|bigint|bigint(20)",
+		),
+		sqltypes.MakeTestResult(columnFields,
+			"t2|id|bigint|bigint(20)",
+			"t2|col|decimal|decimal(10,2)",
+		),
+	})
+	ch := make(chan *discovery.TabletHealth)
+	signal := make(chan struct{}, 10)
+	tracker := NewColumnTracker(ch)
+	tracker.RegisterSignalReceiver(func() { signal <- struct{}{} })
+	tracker.Start()
+	defer tracker.Stop()
+
+	// The columns of all the tables are loaded when the master is first seen.
+	ch <- newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	waitForSignal(t, signal)
+	assert.Equal(t, map[string][]vindexes.Column{
+		"t1": {
+			{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Uint32},
+			{Name: sqlparser.NewColIdent("name"), Type: sqltypes.VarChar},
+		},
+		"t2": {
+			{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64},
+		},
+	}, tracker.Tables("ks"))
+	require.Len(t, sbc.Queries, 1)
+	assert.Equal(t, allColumnsQuery, sbc.Queries[0].Sql)
+
+	// Then only the changed tables are loaded again.
+	ch <- newTabletHealth(sbc, topodatapb.TabletType_MASTER, "t2", "t3")
+	waitForSignal(t, signal)
+	assert.Equal(t, map[string][]vindexes.Column{
+		"t1": {
+			{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Uint32},
+			{Name: sqlparser.NewColIdent("name"), Type: sqltypes.VarChar},
+		},
+		"t2": {
+			{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64},
+			{Name: sqlparser.NewColIdent("col"), Type: sqltypes.Decimal},
+		},
+	}, tracker.Tables("ks"))
+	require.Len(t, sbc.Queries, 2)
+	assert.Equal(t, tableColumnsQuery, sbc.Queries[1].Sql)
+	tableNames, err := sqltypes.BuildBindVariable([]string{"t2", "t3"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*querypb.BindVariable{"tableNames": tableNames}, sbc.Queries[1].BindVariables)
+}
+
+func TestColumnTrackerUpdate(t *testing.T) {
+	sbc := sandboxconn.NewSandboxConn(&topodatapb.Tablet{Keyspace: "ks", Shard: "-80"})
+	tracker := NewColumnTracker(nil)
+
+	// The replicas and the masters that are not serving are ignored.
+	assert.False(t, tracker.update(newTabletHealth(sbc, topodatapb.TabletType_REPLICA, "t1")))
+	notServing := newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	notServing.Serving = false
+	assert.False(t, tracker.update(notServing))
+
+	assert.True(t, tracker.update(newTabletHealth(sbc, topodatapb.TabletType_MASTER)))
+	assert.True(t, tracker.update(newTabletHealth(sbc, topodatapb.TabletType_MASTER, "t1")))
+	assert.Equal(t, &keyspaceUpdate{
+		th:     newTabletHealth(sbc, topodatapb.TabletType_MASTER, "t1"),
+		all:    true,
+		tables: map[string]bool{"t1": true},
+	}, tracker.pending["ks"])
+
+	// Once the keyspace is loaded, only the changed tables are.
+	tracker.save("ks", tracker.pending["ks"], map[string][]vindexes.Column{})
+	delete(tracker.pending, "ks")
+	assert.False(t, tracker.update(newTabletHealth(sbc, topodatapb.TabletType_MASTER)))
+	assert.True(t, tracker.update(newTabletHealth(sbc, topodatapb.TabletType_MASTER, "t2")))
+	assert.False(t, tracker.pending["ks"].all)
+}
+
+func TestColumnTrackerMasterChange(t *testing.T) {
+	sbc := sandboxconn.NewSandboxConn(&topodatapb.Tablet{Keyspace: "ks", Shard: "-80"})
+	tracker := NewColumnTracker(nil)
+	// load loads the pending keyspace, which is then
+	// not loaded again with the health messages of th.
+	load := func(th *discovery.TabletHealth) {
+		t.Helper()
+		tracker.save("ks", tracker.pending["ks"], map[string][]vindexes.Column{})
+		delete(tracker.pending, "ks")
+		require.False(t, tracker.update(th))
+	}
+	master := newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	require.True(t, tracker.update(master))
+	load(master)
+
+	// All the tables are loaded when the master is reparented,
+	// as the new master didn't signal the earlier changes.
+	reparented := newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	reparented.Tablet = &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}}
+	reparented.MasterTermStartTime = 2
+	assert.True(t, tracker.update(reparented))
+	assert.True(t, tracker.pending["ks"].all)
+	load(reparented)
+
+	// Or when a tablet starts a new term.
+	newTerm := newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	newTerm.MasterTermStartTime = 3
+	assert.True(t, tracker.update(newTerm))
+	assert.True(t, tracker.pending["ks"].all)
+	load(newTerm)
+
+	// Or when the master serves again, as the changes
+	// made while it was not serving were not signaled.
+	notServing := newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	notServing.MasterTermStartTime = 3
+	notServing.Serving = false
+	assert.False(t, tracker.update(notServing))
+	assert.True(t, tracker.update(newTerm))
+	assert.True(t, tracker.pending["ks"].all)
+	load(newTerm)
+
+	// The master of each shard is tracked.
+	otherShard := newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	otherShard.Target.Shard = "80-"
+	assert.True(t, tracker.update(otherShard))
+	load(otherShard)
+	assert.False(t, tracker.update(newTerm))
+}
+
+func TestColumnTrackerRetry(t *testing.T) {
+	sbc := sandboxconn.NewSandboxConn(&topodatapb.Tablet{Keyspace: "ks", Shard: "-80"})
+	sbc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(columnFields, "t1|id|int|int(11)"),
+	})
+	ch := make(chan *discovery.TabletHealth)
+	signal := make(chan struct{}, 10)
+	tracker := NewColumnTracker(ch)
+	tracker.RegisterSignalReceiver(func() { signal <- struct{}{} })
+	tracker.Start()
+	defer tracker.Stop()
+
+	// The first load fails, and the keyspace is loaded
+	// again with the next health message.
+	ch <- newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	for sbc.ExecCount.Get() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	ch <- newTabletHealth(sbc, topodatapb.TabletType_MASTER)
+	waitForSignal(t, signal)
+	assert.Equal(t, map[string][]vindexes.Column{
+		"t1": {{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int32}},
+	}, tracker.Tables("ks"))
+}
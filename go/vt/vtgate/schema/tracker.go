@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema keeps track of the schema of the tables in the keyspaces
+// served by vtgate, as reported by the tablets.
+package schema
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/discovery"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// TableStats contains the statistics of a table,
+// added up over all the shards of its keyspace.
+type TableStats struct {
+	// Rows is the estimated number of rows in the table.
+	Rows int64
+
+	// Cardinality is the estimated number of distinct values of the
+	// columns that are the first column of an index, by lowercase
+	// column name. It is only an upper bound for the columns
+	// that are not the sharding key, as the same value can be
+	// counted once in each shard.
+	Cardinality map[string]int64
+
+	// Shards is the number of shards the table is in.
+	Shards int
+}
+
+// Tracker keeps track of the statistics of the tables, as reported by
+// the master tablets in their health messages. The statistics are the
+// ones that MySQL maintains in information_schema. They are estimates
+// that change all the time, so the statistics of a table are only
+// updated, and the receiver signaled, when they change significantly.
+type Tracker struct {
+	ch     chan *discovery.TabletHealth
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	signal func(keyspace string, tables []string)
+	// shards contains the statistics sent by the master
+	// of every shard, by keyspace and shard.
+	shards map[string]map[string][]*querypb.TableStatistics
+	// tables contains the statistics returned by Stats,
+	// by keyspace and table.
+	tables map[string]map[string]*TableStats
+}
+
+// NewTracker creates a Tracker that receives
+// the health messages of the tablets from ch.
+func NewTracker(ch chan *discovery.TabletHealth) *Tracker {
+	return &Tracker{
+		ch:     ch,
+		shards: make(map[string]map[string][]*querypb.TableStatistics),
+		tables: make(map[string]map[string]*TableStats),
+	}
+}
+
+// RegisterSignalReceiver registers the function that is called
+// with the tables of a keyspace whose statistics were updated.
+func (t *Tracker) RegisterSignalReceiver(f func(keyspace string, tables []string)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.signal = f
+}
+
+// Start starts tracking the statistics in the background.
+func (t *Tracker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	go t.receive(ctx)
+}
+
+// Stop stops tracking the statistics.
+func (t *Tracker) Stop() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+}
+
+// Stats returns the statistics of a table,
+// or nil if they are not known.
+func (t *Tracker) Stats(keyspace, table string) *TableStats {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[keyspace][table]
+}
+
+// receive must not block, as the health check
+// drops the messages that can't be delivered.
+func (t *Tracker) receive(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case th := <-t.ch:
+			if th == nil {
+				// The channel was closed.
+				return
+			}
+			t.update(th)
+		}
+	}
+}
+
+// update saves the statistics of a health message, and signals
+// the tables whose statistics changed significantly.
+func (t *Tracker) update(th *discovery.TabletHealth) {
+	if th.Target == nil || th.Target.TabletType != topodatapb.TabletType_MASTER || !th.Serving {
+		return
+	}
+	stats := th.Stats.GetTableStatistics()
+	if len(stats) == 0 {
+		// The master doesn't send statistics, or hasn't loaded them
+		// yet after a reparent: the last known ones are kept.
+		return
+	}
+	keyspace, shard := th.Target.Keyspace, th.Target.Shard
+
+	t.mu.Lock()
+	shards := t.shards[keyspace]
+	if shards == nil {
+		shards = make(map[string][]*querypb.TableStatistics)
+		t.shards[keyspace] = shards
+	}
+	if tableStatisticsEqual(shards[shard], stats) {
+		t.mu.Unlock()
+		return
+	}
+	shards[shard] = stats
+	changed := t.aggregateLocked(keyspace)
+	signal := t.signal
+	t.mu.Unlock()
+
+	if signal != nil && len(changed) > 0 {
+		signal(keyspace, changed)
+	}
+}
+
+// aggregateLocked adds up the statistics of the shards of a keyspace,
+// and updates the statistics of the tables that changed significantly.
+// It returns the names of these tables, sorted.
+func (t *Tracker) aggregateLocked(keyspace string) []string {
+	aggregated := make(map[string]*TableStats)
+	for _, stats := range t.shards[keyspace] {
+		for _, table := range stats {
+			agg := aggregated[table.Name]
+			if agg == nil {
+				agg = &TableStats{Cardinality: make(map[string]int64)}
+				aggregated[table.Name] = agg
+			}
+			agg.Rows += table.Rows
+			agg.Shards++
+			for column, cardinality := range table.Cardinality {
+				agg.Cardinality[column] += cardinality
+			}
+		}
+	}
+
+	// The maps are never modified once saved, as they are returned by Stats.
+	tables := make(map[string]*TableStats, len(aggregated))
+	var changed []string
+	for name, agg := range aggregated {
+		old := t.tables[keyspace][name]
+		if old != nil && !tableStatsChanged(old, agg) {
+			tables[name] = old
+			continue
+		}
+		tables[name] = agg
+		changed = append(changed, name)
+	}
+	for name := range t.tables[keyspace] {
+		if aggregated[name] == nil {
+			changed = append(changed, name)
+		}
+	}
+	t.tables[keyspace] = tables
+	sort.Strings(changed)
+	return changed
+}
+
+// tableStatsChanged returns true if the statistics
+// of a table changed significantly.
+func tableStatsChanged(old, new *TableStats) bool {
+	if old.Shards != new.Shards || len(old.Cardinality) != len(new.Cardinality) || valueChanged(old.Rows, new.Rows) {
+		return true
+	}
+	for column, cardinality := range new.Cardinality {
+		oldCardinality, ok := old.Cardinality[column]
+		if !ok || valueChanged(oldCardinality, cardinality) {
+			return true
+		}
+	}
+	return false
+}
+
+// valueChanged returns true if a statistic changed by more than 10%.
+func valueChanged(old, new int64) bool {
+	diff := new - old
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff*10 > old
+}
+
+func tableStatisticsEqual(a, b []*querypb.TableStatistics) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/discovery"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+type trackerSignal struct {
+	keyspace string
+	tables   []string
+}
+
+func newStatsHealth(shard string, tabletType topodatapb.TabletType, stats ...*querypb.TableStatistics) *discovery.TabletHealth {
+	return &discovery.TabletHealth{
+		Target:  &querypb.Target{Keyspace: "ks", Shard: shard, TabletType: tabletType},
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{TableStatistics: stats},
+	}
+}
+
+func TestTracker(t *testing.T) {
+	ch := make(chan *discovery.TabletHealth)
+	signals := make(chan trackerSignal, 10)
+	tracker := NewTracker(ch)
+	tracker.RegisterSignalReceiver(func(keyspace string, tables []string) {
+		signals <- trackerSignal{keyspace: keyspace, tables: tables}
+	})
+	tracker.Start()
+	defer tracker.Stop()
+
+	ch <- newStatsHealth("-80", topodatapb.TabletType_MASTER,
+		&querypb.TableStatistics{Name: "t1", Rows: 100, Cardinality: map[string]int64{"id": 100, "col": 20}},
+		&querypb.TableStatistics{Name: "t2", Rows: 10},
+	)
+	select {
+	case signal := <-signals:
+		assert.Equal(t, trackerSignal{keyspace: "ks", tables: []string{"t1", "t2"}}, signal)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the statistics")
+	}
+	assert.Equal(t, &TableStats{
+		Rows:        100,
+		Cardinality: map[string]int64{"id": 100, "col": 20},
+		Shards:      1,
+	}, tracker.Stats("ks", "t1"))
+	assert.Nil(t, tracker.Stats("ks", "t3"))
+	assert.Nil(t, tracker.Stats("other", "t1"))
+}
+
+func TestTrackerUpdate(t *testing.T) {
+	var signals []trackerSignal
+	tracker := NewTracker(nil)
+	tracker.RegisterSignalReceiver(func(keyspace string, tables []string) {
+		signals = append(signals, trackerSignal{keyspace: keyspace, tables: tables})
+	})
+
+	t1 := &querypb.TableStatistics{Name: "t1", Rows: 100, Cardinality: map[string]int64{"id": 100, "col": 20}}
+	t2 := &querypb.TableStatistics{Name: "t2", Rows: 10}
+	tracker.update(newStatsHealth("-80", topodatapb.TabletType_MASTER, t1, t2))
+	tracker.update(newStatsHealth("80-", topodatapb.TabletType_MASTER,
+		&querypb.TableStatistics{Name: "t1", Rows: 50, Cardinality: map[string]int64{"id": 50, "col": 40}},
+		&querypb.TableStatistics{Name: "t2", Rows: 30},
+	))
+	assert.Equal(t, []trackerSignal{
+		{keyspace: "ks", tables: []string{"t1", "t2"}},
+		{keyspace: "ks", tables: []string{"t1", "t2"}},
+	}, signals)
+	assert.Equal(t, &TableStats{
+		Rows:        150,
+		Cardinality: map[string]int64{"id": 150, "col": 60},
+		Shards:      2,
+	}, tracker.Stats("ks", "t1"))
+	assert.Equal(t, &TableStats{
+		Rows:        40,
+		Cardinality: map[string]int64{},
+		Shards:      2,
+	}, tracker.Stats("ks", "t2"))
+
+	// The replicas, the masters that are not serving and the
+	// masters that don't send statistics are ignored.
+	signals = nil
+	tracker.update(newStatsHealth("-80", topodatapb.TabletType_REPLICA, &querypb.TableStatistics{Name: "t3"}))
+	notServing := newStatsHealth("-80", topodatapb.TabletType_MASTER, &querypb.TableStatistics{Name: "t3"})
+	notServing.Serving = false
+	tracker.update(notServing)
+	tracker.update(newStatsHealth("-80", topodatapb.TabletType_MASTER))
+	assert.Nil(t, signals)
+	assert.Equal(t, int64(150), tracker.Stats("ks", "t1").Rows)
+
+	// Small changes are not signaled, and don't change the statistics.
+	tracker.update(newStatsHealth("-80", topodatapb.TabletType_MASTER,
+		&querypb.TableStatistics{Name: "t1", Rows: 110, Cardinality: map[string]int64{"id": 110, "col": 20}},
+		t2,
+	))
+	assert.Nil(t, signals)
+	assert.Equal(t, int64(150), tracker.Stats("ks", "t1").Rows)
+
+	// Significant changes and dropped tables are.
+	tracker.update(newStatsHealth("-80", topodatapb.TabletType_MASTER,
+		&querypb.TableStatistics{Name: "t1", Rows: 200, Cardinality: map[string]int64{"id": 200, "col": 20}},
+	))
+	tracker.update(newStatsHealth("80-", topodatapb.TabletType_MASTER,
+		&querypb.TableStatistics{Name: "t1", Rows: 50, Cardinality: map[string]int64{"id": 50, "col": 40}},
+	))
+	assert.Equal(t, []trackerSignal{
+		{keyspace: "ks", tables: []string{"t1", "t2"}},
+		{keyspace: "ks", tables: []string{"t2"}},
+	}, signals)
+	assert.Equal(t, int64(250), tracker.Stats("ks", "t1").Rows)
+	assert.Nil(t, tracker.Stats("ks", "t2"))
+}
+
+func TestTrackerNil(t *testing.T) {
+	var tracker *Tracker
+	assert.Nil(t, tracker.Stats("ks", "t1"))
+}
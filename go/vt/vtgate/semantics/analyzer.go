@@ -21,6 +21,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 type (
@@ -31,13 +32,19 @@ type (
 		scopes   []*scope
 		exprDeps map[sqlparser.Expr]TableSet
 		err      error
+
+		si SchemaInformation
+		// vtables contains the vschema tables of the table expressions
+		vtables map[table]*vindexes.Table
 	}
 )
 
 // newAnalyzer create the semantic analyzer
-func newAnalyzer() *analyzer {
+func newAnalyzer(si SchemaInformation) *analyzer {
 	return &analyzer{
 		exprDeps: map[sqlparser.Expr]TableSet{},
+		si:       si,
+		vtables:  map[table]*vindexes.Table{},
 	}
 }
 
@@ -77,7 +84,7 @@ func (a *analyzer) resolveColumn(colName *sqlparser.ColName, current *scope) (Ta
 	var t table
 	var err error
 	if colName.Qualifier.IsEmpty() {
-		t, err = a.resolveUnQualifiedColumn(current)
+		t, err = a.resolveUnQualifiedColumn(current, colName)
 	} else {
 		t, err = a.resolveQualifiedColumn(current, colName)
 	}
@@ -131,14 +138,46 @@ func (a *analyzer) resolveQualifiedColumn(current *scope, expr *sqlparser.ColNam
 	return nil, mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown table referenced by '%s'", sqlparser.String(expr))
 }
 
-// resolveUnQualifiedColumn
-func (a *analyzer) resolveUnQualifiedColumn(current *scope) (table, error) {
+// resolveUnQualifiedColumn handles `col` expressions. With more than one table in
+// the scope, the column can only be resolved if all of them have an authoritative
+// column list. If none of them has the column, it is looked for in the outer scopes.
+func (a *analyzer) resolveUnQualifiedColumn(current *scope, expr *sqlparser.ColName) (table, error) {
 	if len(current.tables) == 1 {
 		for _, tableExpr := range current.tables {
 			return tableExpr, nil
 		}
 	}
-	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "todo - figure out which table this column belongs to")
+
+	var found table
+	for _, tableExpr := range current.tables {
+		vtable := a.vtables[tableExpr]
+		if vtable == nil || !vtable.ColumnListAuthoritative {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "todo - figure out which table this column belongs to")
+		}
+		if !hasColumn(vtable, expr.Name) {
+			continue
+		}
+		if found != nil {
+			return nil, mysql.NewSQLError(mysql.ERNonUniq, mysql.SSAmbiguousColumn, "Column '%s' in field list is ambiguous", sqlparser.String(expr))
+		}
+		found = tableExpr
+	}
+	if found != nil {
+		return found, nil
+	}
+	if current.parent != nil {
+		return a.resolveUnQualifiedColumn(current.parent, expr)
+	}
+	return nil, mysql.NewSQLError(mysql.ERBadFieldError, mysql.SSBadFieldError, "Unknown column '%s' in 'field list'", sqlparser.String(expr))
+}
+
+func hasColumn(vtable *vindexes.Table, name sqlparser.ColIdent) bool {
+	for _, col := range vtable.Columns {
+		if col.Name.Equal(name) {
+			return true
+		}
+	}
+	return false
 }
 
 func (a *analyzer) tableSetFor(t table) TableSet {
@@ -163,6 +202,12 @@ func (a *analyzer) bindTable(alias *sqlparser.AliasedTableExpr, expr sqlparser.S
 	case sqlparser.TableName:
 		scope := a.currentScope()
 		a.Tables = append(a.Tables, alias)
+		if a.si != nil {
+			// the tables that can't be found are reported by the planner
+			if vtable, _, _, _, err := a.si.FindTable(t); err == nil && vtable != nil {
+				a.vtables[alias] = vtable
+			}
+		}
 		if alias.As.IsEmpty() {
 			return scope.addTable(t.Name.String(), alias)
 		}
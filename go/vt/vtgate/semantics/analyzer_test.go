@@ -23,7 +23,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 const (
@@ -158,7 +162,7 @@ func TestNotUniqueTableName(t *testing.T) {
 	for _, query := range queries {
 		t.Run(query, func(t *testing.T) {
 			parse, _ := sqlparser.Parse(query)
-			_, err := Analyse(parse)
+			_, err := Analyse(parse, nil)
 			require.Error(t, err)
 			require.Contains(t, err.Error(), "Not unique table/alias")
 		})
@@ -173,17 +177,96 @@ func TestMissingTable(t *testing.T) {
 	for _, query := range queries {
 		t.Run(query, func(t *testing.T) {
 			parse, _ := sqlparser.Parse(query)
-			_, err := Analyse(parse)
+			_, err := Analyse(parse, nil)
 			require.Error(t, err)
 			require.Contains(t, err.Error(), "Unknown table")
 		})
 	}
 }
 
+type fakeSchemaInfo map[string]*vindexes.Table
+
+func (f fakeSchemaInfo) FindTable(tablename sqlparser.TableName) (*vindexes.Table, string, topodatapb.TabletType, key.Destination, error) {
+	return f[tablename.Name.String()], "", topodatapb.TabletType_MASTER, nil, nil
+}
+
+func authoritativeTable(name string, columns ...string) *vindexes.Table {
+	table := &vindexes.Table{Name: sqlparser.NewTableIdent(name), ColumnListAuthoritative: true}
+	for _, col := range columns {
+		table.Columns = append(table.Columns, vindexes.Column{Name: sqlparser.NewColIdent(col)})
+	}
+	return table
+}
+
+func TestBindingAuthoritativeColumns(t *testing.T) {
+	si := fakeSchemaInfo{
+		"t1": authoritativeTable("t1", "id", "a"),
+		"t2": authoritativeTable("t2", "id", "b"),
+		"t3": authoritativeTable("t3", "c"),
+		"t4": {Name: sqlparser.NewTableIdent("t4")},
+	}
+	tcases := []struct {
+		query string
+		deps  TableSet
+	}{{
+		query: "select a from t1, t2",
+		deps:  T0,
+	}, {
+		query: "select B from t1 join t2",
+		deps:  T1,
+	}, {
+		query: "select a from t1 as x, t2 as y",
+		deps:  T0,
+	}, {
+		query: "select (select b from t3, t1) from t1 as x, t2",
+		deps:  T1,
+	}}
+	for _, tc := range tcases {
+		t.Run(tc.query, func(t *testing.T) {
+			parse, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			semTable, err := Analyse(parse, si)
+			require.NoError(t, err)
+			sel := parse.(*sqlparser.Select)
+			expr := extract(sel, 0)
+			if subquery, ok := expr.(*sqlparser.Subquery); ok {
+				expr = extract(subquery.Select.(*sqlparser.Select), 0)
+			}
+			assert.Equal(t, tc.deps, semTable.Dependencies(expr))
+		})
+	}
+
+	errCases := []struct {
+		query string
+		err   string
+	}{{
+		query: "select id from t1, t2",
+		err:   "Column 'id' in field list is ambiguous",
+	}, {
+		query: "select x from t1, t2",
+		err:   "Unknown column 'x' in 'field list'",
+	}, {
+		query: "select a from t1, t4",
+		err:   "todo - figure out which table this column belongs to",
+	}, {
+		query: "select a from t1, unknown",
+		err:   "todo - figure out which table this column belongs to",
+	}}
+	for _, tc := range errCases {
+		t.Run(tc.query, func(t *testing.T) {
+			parse, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			_, err = Analyse(parse, si)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
 func parseAndAnalyze(t *testing.T, query string) (sqlparser.Statement, *SemTable) {
 	parse, err := sqlparser.Parse(query)
 	require.NoError(t, err)
-	semTable, err := Analyse(parse)
+	semTable, err := Analyse(parse, nil)
 	require.NoError(t, err)
 	return parse, semTable
 }
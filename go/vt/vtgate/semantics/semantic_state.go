@@ -18,8 +18,11 @@ package semantics
 
 import (
 	"vitess.io/vitess/go/mysql"
-
+	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 type (
@@ -40,6 +43,12 @@ type (
 		parent *scope
 		tables map[string]*sqlparser.AliasedTableExpr
 	}
+
+	// SchemaInformation is used to find the vschema tables of the query,
+	// to know the columns of the tables with an authoritative column list.
+	SchemaInformation interface {
+		FindTable(tablename sqlparser.TableName) (*vindexes.Table, string, topodatapb.TabletType, key.Destination, error)
+	}
 )
 
 // NewSemTable creates a new empty SemTable
@@ -91,9 +100,9 @@ func (s *scope) addTable(name string, table *sqlparser.AliasedTableExpr) error {
 	return nil
 }
 
-// Analyse analyzes the parsed query.
-func Analyse(statement sqlparser.Statement) (*SemTable, error) {
-	analyzer := newAnalyzer()
+// Analyse analyzes the parsed query. si can be nil if the vschema is not known.
+func Analyse(statement sqlparser.Statement, si SchemaInformation) (*SemTable, error) {
+	analyzer := newAnalyzer(si)
 	// Initial scope
 	err := analyzer.analyze(statement)
 	if err != nil {
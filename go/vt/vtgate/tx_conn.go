@@ -19,6 +19,7 @@ package vtgate
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 
@@ -26,6 +27,7 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/dtids"
 	"vitess.io/vitess/go/vt/log"
@@ -35,6 +37,11 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
+var (
+	twoPCTimings = stats.NewTimings("TwoPCTimings", "Time spent committing distributed transactions with 2PC, by phase", "Phase")
+	twoPCErrors  = stats.NewCountersWithSingleLabel("TwoPCErrors", "Number of failures to commit distributed transactions with 2PC, by phase", "Phase")
+)
+
 // TxConn is used for executing transactional requests.
 type TxConn struct {
 	gateway Gateway
@@ -152,15 +159,19 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 	}
 	mmShard := session.ShardSessions[0]
 	dtid := dtids.New(mmShard)
-	err := txc.gateway.CreateTransaction(ctx, mmShard.Target, dtid, participants)
+	err := twoPCPhase("CreateTransaction", func() error {
+		return txc.gateway.CreateTransaction(ctx, mmShard.Target, dtid, participants)
+	})
 	if err != nil {
 		// Normal rollback is safe because nothing was prepared yet.
 		_ = txc.Rollback(ctx, session)
 		return err
 	}
 
-	err = txc.runSessions(ctx, session.ShardSessions[1:], func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
-		return txc.gateway.Prepare(ctx, s.Target, s.TransactionId, dtid)
+	err = twoPCPhase("Prepare", func() error {
+		return txc.runSessions(ctx, session.ShardSessions[1:], func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+			return txc.gateway.Prepare(ctx, s.Target, s.TransactionId, dtid)
+		})
 	})
 	if err != nil {
 		// TODO(sougou): Perform a more fine-grained cleanup
@@ -172,19 +183,37 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
-	err = txc.gateway.StartCommit(ctx, mmShard.Target, mmShard.TransactionId, dtid)
+	err = twoPCPhase("StartCommit", func() error {
+		return txc.gateway.StartCommit(ctx, mmShard.Target, mmShard.TransactionId, dtid)
+	})
 	if err != nil {
 		return err
 	}
 
-	err = txc.runSessions(ctx, session.ShardSessions[1:], func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
-		return txc.gateway.CommitPrepared(ctx, s.Target, dtid)
+	err = twoPCPhase("CommitPrepared", func() error {
+		return txc.runSessions(ctx, session.ShardSessions[1:], func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+			return txc.gateway.CommitPrepared(ctx, s.Target, dtid)
+		})
 	})
 	if err != nil {
 		return err
 	}
 
-	return txc.gateway.ConcludeTransaction(ctx, mmShard.Target, dtid)
+	return twoPCPhase("ConcludeTransaction", func() error {
+		return txc.gateway.ConcludeTransaction(ctx, mmShard.Target, dtid)
+	})
+}
+
+// twoPCPhase runs a phase of a 2PC commit, and records its latency and errors.
+// A transaction that fails after StartCommit is committed by the resolution
+// of the tablets' watchdogs, or by the vtctld transaction resolver.
+func twoPCPhase(phase string, f func() error) error {
+	defer twoPCTimings.Record(phase, time.Now())
+	err := f()
+	if err != nil {
+		twoPCErrors.Add(phase, 1)
+	}
+	return err
 }
 
 // Rollback rolls back the current transaction. There are no retries on this operation.
@@ -719,6 +719,7 @@ func TestTxConnReservedCommitOrderSuccess(t *testing.T) {
 func TestTxConnCommit2PC(t *testing.T) {
 	sc, sbc0, sbc1, rss0, _, rss01 := newLegacyTestTxConnEnv(t, "TestTxConnCommit2PC")
 
+	twoPCTimings.Reset()
 	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
 	sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
 	sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
@@ -730,6 +731,10 @@ func TestTxConnCommit2PC(t *testing.T) {
 	assert.EqualValues(t, 1, sbc0.StartCommitCount.Get(), "sbc0.StartCommitCount")
 	assert.EqualValues(t, 1, sbc1.CommitPreparedCount.Get(), "sbc1.CommitPreparedCount")
 	assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+
+	for _, phase := range []string{"CreateTransaction", "Prepare", "StartCommit", "CommitPrepared", "ConcludeTransaction"} {
+		assert.EqualValues(t, 1, twoPCTimings.Counts()[phase], phase)
+	}
 }
 
 func TestTxConnCommit2PCOneParticipant(t *testing.T) {
@@ -771,6 +776,7 @@ func TestTxConnCommit2PCPrepareFail(t *testing.T) {
 	sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
 	sc.ExecuteMultiShard(ctx, rss01, twoQueries, session, false, false)
 
+	twoPCErrors.ResetAll()
 	sbc1.MustFailPrepare = 1
 	session.TransactionMode = vtgatepb.TransactionMode_TWOPC
 	err := sc.txConn.Commit(ctx, session)
@@ -782,6 +788,7 @@ func TestTxConnCommit2PCPrepareFail(t *testing.T) {
 	assert.EqualValues(t, 0, sbc0.StartCommitCount.Get(), "sbc0.StartCommitCount")
 	assert.EqualValues(t, 0, sbc1.CommitPreparedCount.Get(), "sbc1.CommitPreparedCount")
 	assert.EqualValues(t, 0, sbc0.ConcludeTransactionCount.Get(), "sbc0.ConcludeTransactionCount")
+	assert.Equal(t, map[string]int64{"Prepare": 1}, twoPCErrors.Counts())
 }
 
 func TestTxConnCommit2PCStartCommitFail(t *testing.T) {
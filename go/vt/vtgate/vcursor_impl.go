@@ -48,6 +48,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -69,6 +70,7 @@ type iExecute interface {
 	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
+	TableStats(keyspace, table string) *vtschema.TableStats
 
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
@@ -377,6 +379,11 @@ func (vc *vcursorImpl) GetSemTable() *semantics.SemTable {
 	return vc.semTable
 }
 
+// TableStats implements the ContextVSchema interface
+func (vc *vcursorImpl) TableStats(table *vindexes.Table) *vtschema.TableStats {
+	return vc.executor.TableStats(table.Keyspace.Name, table.Name.String())
+}
+
 // TargetString returns the current TargetString of the session.
 func (vc *vcursorImpl) TargetString() string {
 	return vc.safeSession.TargetString
@@ -411,6 +418,11 @@ func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries [
 	return qr, errs
 }
 
+// InTransaction returns true if the session is in a transaction.
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession.InTransaction()
+}
+
 func (vc *vcursorImpl) InTransactionAndIsDML() bool {
 	if !vc.safeSession.InTransaction() {
 		return false
@@ -458,6 +470,10 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
+	if len(rss) > 1 && vc.safeSession.InConsistentSnapshot() {
+		// The streams don't run in the transactions of the session.
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "consistent snapshots are not supported for streaming reads of several shards")
+	}
 	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession.Options, callback)
 }
 
@@ -627,6 +643,11 @@ func (vc *vcursorImpl) SetSQLSelectLimit(limit int64) error {
 	return nil
 }
 
+// SetQueryTimeout implements the SessionActions interface
+func (vc *vcursorImpl) SetQueryTimeout(timeout int64) {
+	vc.safeSession.SetQueryTimeout(timeout)
+}
+
 // SetSkipQueryPlanCache implements the SessionActions interface
 func (vc *vcursorImpl) SetTransactionMode(mode vtgatepb.TransactionMode) {
 	vc.safeSession.TransactionMode = mode
@@ -637,6 +658,11 @@ func (vc *vcursorImpl) SetWorkload(workload querypb.ExecuteOptions_Workload) {
 	vc.safeSession.GetOrCreateOptions().Workload = workload
 }
 
+// SetConsistentSnapshot implements the SessionActions interface
+func (vc *vcursorImpl) SetConsistentSnapshot(enable bool) {
+	vc.safeSession.SetConsistentSnapshot(enable)
+}
+
 // SetPlannerVersion implements the SessionActions interface
 func (vc *vcursorImpl) SetPlannerVersion(v planbuilder.PlannerVersion) {
 	vc.safeSession.GetOrCreateOptions().PlannerVersion = v
@@ -150,13 +150,29 @@ func (cached *Keyspace) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.Name))
 	return size
 }
+func (cached *LookupCache) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(72)
+	}
+	// field table string
+	size += int64(len(cached.table))
+	// field column string
+	size += int64(len(cached.column))
+	// field state *vitess.io/vitess/go/vt/vtgate/vindexes.lookupCacheState
+	size += cached.state.CachedSize(true)
+	return size
+}
 func (cached *LookupHash) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(144)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -170,7 +186,7 @@ func (cached *LookupHashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(144)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -184,7 +200,7 @@ func (cached *LookupNonUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(144)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -198,7 +214,7 @@ func (cached *LookupUnicodeLooseMD5Hash) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(144)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -212,7 +228,7 @@ func (cached *LookupUnicodeLooseMD5HashUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(144)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -226,7 +242,7 @@ func (cached *LookupUnique) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(136)
+		size += int64(144)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -234,6 +250,31 @@ func (cached *LookupUnique) CachedSize(alloc bool) int64 {
 	size += cached.lkp.CachedSize(false)
 	return size
 }
+func (cached *MultiCol) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(72)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field columnVdx []vitess.io/vitess/go/vt/vtgate/vindexes.SingleColumn
+	{
+		size += int64(cap(cached.columnVdx)) * int64(16)
+		for _, elem := range cached.columnVdx {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field columnBytes []int
+	{
+		size += int64(cap(cached.columnBytes)) * int64(8)
+	}
+	return size
+}
 func (cached *Null) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -283,6 +324,22 @@ func (cached *NumericStaticMap) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Range) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field boundaries []int64
+	{
+		size += int64(cap(cached.boundaries)) * int64(8)
+	}
+	return size
+}
 func (cached *RegionExperimental) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -341,7 +398,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(169)
+		size += int64(200)
 	}
 	// field Type string
 	size += int64(len(cached.Type))
@@ -425,7 +482,7 @@ func (cached *clCommon) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(256)
+		size += int64(264)
 	}
 	// field name string
 	size += int64(len(cached.name))
@@ -452,13 +509,51 @@ func (cached *clCommon) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.updateLookupQuery))
 	return size
 }
+func (cached *lookupCacheEntry) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field id string
+	size += int64(len(cached.id))
+	// field rows [][]vitess.io/vitess/go/sqltypes.Value
+	{
+		size += int64(cap(cached.rows)) * int64(24)
+		for _, elem := range cached.rows {
+			{
+				size += int64(cap(elem)) * int64(32)
+				for _, elem := range elem {
+					size += elem.CachedSize(false)
+				}
+			}
+		}
+	}
+	return size
+}
+func (cached *lookupCacheState) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field cache vitess.io/vitess/go/cache.Cache
+	if cc, ok := cached.cache.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(112)
+		size += int64(120)
 	}
 	// field Table string
 	size += int64(len(cached.Table))
@@ -477,5 +572,7 @@ func (cached *lookupInternal) CachedSize(alloc bool) int64 {
 	size += int64(len(cached.ver))
 	// field del string
 	size += int64(len(cached.del))
+	// field cache *vitess.io/vitess/go/vt/vtgate/vindexes.LookupCache
+	size += cached.cache.CachedSize(true)
 	return size
 }
@@ -37,9 +37,11 @@ var (
 	_ SingleColumn  = (*ConsistentLookupUnique)(nil)
 	_ Lookup        = (*ConsistentLookupUnique)(nil)
 	_ WantOwnerInfo = (*ConsistentLookupUnique)(nil)
+	_ CachedLookup  = (*ConsistentLookupUnique)(nil)
 	_ SingleColumn  = (*ConsistentLookup)(nil)
 	_ Lookup        = (*ConsistentLookup)(nil)
 	_ WantOwnerInfo = (*ConsistentLookup)(nil)
+	_ CachedLookup  = (*ConsistentLookup)(nil)
 )
 
 func init() {
@@ -237,6 +239,11 @@ func (lu *clCommon) String() string {
 	return lu.name
 }
 
+// LookupCache returns the cache of the lookups, or nil if they are not cached.
+func (lu *clCommon) LookupCache() *LookupCache {
+	return lu.lkp.cache
+}
+
 // Verify returns true if ids maps to ksids.
 func (lu *clCommon) Verify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
 	if lu.writeOnly {
@@ -251,6 +258,8 @@ func (lu *clCommon) Verify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte
 
 // Create reserves the id by inserting it into the vindex table.
 func (lu *clCommon) Create(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte, ignoreMode bool) error {
+	// The duplicates are handled after createCustom invalidated the ids.
+	defer lu.lkp.invalidate(rowsColValues)
 	origErr := lu.lkp.createCustom(vcursor, rowsColValues, ksidsToValues(ksids), ignoreMode, vtgatepb.CommitOrder_PRE)
 	if origErr == nil {
 		return nil
@@ -508,10 +508,18 @@ func (vc *loggingVCursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	return vtgatepb.CommitOrder_PRE
 }
 
+func (vc *loggingVCursor) InTransaction() bool {
+	return false
+}
+
 func (vc *loggingVCursor) InTransactionAndIsDML() bool {
 	return false
 }
 
+func (vc *loggingVCursor) TabletType() topodatapb.TabletType {
+	return topodatapb.TabletType_MASTER
+}
+
 type bv struct {
 	Name string
 	Bv   string
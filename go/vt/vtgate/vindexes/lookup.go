@@ -29,8 +29,10 @@ import (
 var (
 	_ SingleColumn = (*LookupUnique)(nil)
 	_ Lookup       = (*LookupUnique)(nil)
+	_ CachedLookup = (*LookupUnique)(nil)
 	_ SingleColumn = (*LookupNonUnique)(nil)
 	_ Lookup       = (*LookupNonUnique)(nil)
+	_ CachedLookup = (*LookupNonUnique)(nil)
 )
 
 func init() {
@@ -66,6 +68,11 @@ func (ln *LookupNonUnique) NeedsVCursor() bool {
 	return true
 }
 
+// LookupCache returns the cache of the lookups, or nil if they are not cached.
+func (ln *LookupNonUnique) LookupCache() *LookupCache {
+	return ln.lkp.cache
+}
+
 // Map can map ids to key.Destination objects.
 func (ln *LookupNonUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
@@ -143,6 +150,7 @@ func (ln *LookupNonUnique) MarshalJSON() ([]byte, error) {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of ids whose lookups are cached. See LookupCache.
 func NewLookup(name string, m map[string]string) (Vindex, error) {
 	lookup := &LookupNonUnique{name: name}
 
@@ -190,6 +198,7 @@ type LookupUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of ids whose lookups are cached. See LookupCache.
 func NewLookupUnique(name string, m map[string]string) (Vindex, error) {
 	lu := &LookupUnique{name: name}
 
@@ -229,6 +238,11 @@ func (lu *LookupUnique) NeedsVCursor() bool {
 	return true
 }
 
+// LookupCache returns the cache of the lookups, or nil if they are not cached.
+func (lu *LookupUnique) LookupCache() *LookupCache {
+	return lu.lkp.cache
+}
+
 // Map can map ids to key.Destination objects.
 func (lu *LookupUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+)
+
+var (
+	lookupCacheHits   = stats.NewCountersWithSingleLabel("LookupVindexCacheHits", "Lookups of vindex ids served from the lookup vindex cache", "Table")
+	lookupCacheMisses = stats.NewCountersWithSingleLabel("LookupVindexCacheMisses", "Lookups of vindex ids not found in the lookup vindex cache", "Table")
+)
+
+// CachedLookup is implemented by the lookup vindexes,
+// which can cache the rows of their lookup table.
+type CachedLookup interface {
+	Vindex
+	// LookupCache returns the cache of the vindex,
+	// or nil if the vindex does not cache its lookups.
+	LookupCache() *LookupCache
+}
+
+// LookupCache caches the rows that a lookup vindex found in its lookup
+// table for an id. It is enabled by the cache_size param of the vindex,
+// which is the maximum number of cached ids. The cache_memory and
+// cache_lfu params select the memory limit and the algorithm of the
+// cache, like the flags of the query plan cache.
+//
+// The vindex invalidates the ids it writes to the lookup table, and
+// VTGate invalidates the ids of the rows that change in the lookup
+// table, as streamed from the tablets, so that the writes of other
+// VTGates are seen. The cache is not used in transactions, which
+// must see their own writes, and it is only filled by the lookups
+// that read from the master, as the replicas can lag behind the
+// invalidations. VTGate disables the cache when it can't stream the
+// lookup table, as the writes of other VTGates would not be seen.
+type LookupCache struct {
+	table  string
+	column string
+	config cache.Config
+	state  *lookupCacheState
+	// disabled is set if the lookups must not be cached.
+	disabled bool
+}
+
+type lookupCacheState struct {
+	cache cache.Cache
+	// mu serializes the invalidations and the cached lookups, so
+	// that a lookup can't cache its rows after an invalidation
+	// that happened since it checked the generation.
+	mu sync.Mutex
+	// generation is incremented by every invalidation. A lookup only
+	// caches its rows if there was no invalidation while it ran, as
+	// they could predate the invalidated write.
+	generation sync2.AtomicInt64
+}
+
+// lookupCacheEntry is the cached rows of an id.
+type lookupCacheEntry struct {
+	id   string
+	rows [][]sqltypes.Value
+}
+
+// lookupCacheKey returns the key of the cached rows of an id. The
+// collation of the lookup column is not known, so the key of a string is
+// its weight in the loose Unicode collation that unicode_loose_md5 also
+// uses, which ignores the case and the accents like utf8mb4_0900_ai_ci
+// does, and its trailing spaces are trimmed like in the PAD SPACE
+// collations. The strings that are equal in these collations then have
+// the same key: the write of 'É' invalidates the rows cached for 'e'.
+// A key has the rows of a single id, which a lookup must match exactly,
+// as the ids that have the same key can be different in the collation
+// of the column. The numbers have the key of the strings of their digits,
+// and the strings that aren't valid UTF-8 are keyed by their bytes.
+func lookupCacheKey(id sqltypes.Value) string {
+	collator := collatorPool.Get().(*pooledCollator)
+	defer collatorPool.Put(collator)
+	key, err := normalize(collator.col, collator.buf, id.ToBytes())
+	if err != nil {
+		return id.ToString()
+	}
+	// The key points into the buffer of the collator, so it is copied.
+	return string(key)
+}
+
+// newLookupCache returns the cache configured by the params of
+// a lookup vindex, or nil if the cache is not enabled.
+func newLookupCache(table, column string, m map[string]string) (*LookupCache, error) {
+	size, err := int64FromMap(m, "cache_size", 0)
+	if err != nil || size == 0 {
+		return nil, err
+	}
+	lc := &LookupCache{
+		table:  table,
+		column: column,
+		config: cache.Config{MaxEntries: size},
+	}
+	lc.config.MaxMemoryUsage, err = int64FromMap(m, "cache_memory", cache.DefaultConfig.MaxMemoryUsage)
+	if err != nil {
+		return nil, err
+	}
+	lc.config.LFU, err = boolFromMap(m, "cache_lfu")
+	if err != nil {
+		return nil, err
+	}
+	lc.state = &lookupCacheState{cache: cache.NewDefaultCacheImpl(&lc.config)}
+	return lc, nil
+}
+
+// Table returns the lookup table of the vindex.
+func (lc *LookupCache) Table() string {
+	return lc.table
+}
+
+// Column returns the column of the lookup table that has the ids.
+func (lc *LookupCache) Column() string {
+	return lc.column
+}
+
+// Reuse makes lc share the cached rows of old, which is the cache
+// of the previous instance of the vindex, if they are configured
+// the same. It must be called before lc is used.
+func (lc *LookupCache) Reuse(old *LookupCache) bool {
+	if old == nil || old.table != lc.table || old.column != lc.column || old.config != lc.config {
+		return false
+	}
+	lc.state = old.state
+	return true
+}
+
+// Disable makes the vindex look up every id in its lookup table, as if
+// its lookups were not cached. It must be called before lc is used.
+func (lc *LookupCache) Disable() {
+	lc.disabled = true
+}
+
+// Invalidate removes the cached rows of the ids.
+func (lc *LookupCache) Invalidate(ids ...sqltypes.Value) {
+	lc.state.mu.Lock()
+	defer lc.state.mu.Unlock()
+	lc.state.generation.Add(1)
+	for _, id := range ids {
+		lc.state.cache.Delete(lookupCacheKey(id))
+	}
+}
+
+// Clear removes all the cached rows.
+func (lc *LookupCache) Clear() {
+	lc.state.mu.Lock()
+	defer lc.state.mu.Unlock()
+	lc.state.generation.Add(1)
+	lc.state.cache.Clear()
+}
+
+// Close releases the resources of the cache, which must not be used anymore.
+func (lc *LookupCache) Close() {
+	if closer, ok := lc.state.cache.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+// generation returns the number of invalidations so far, which
+// must be passed to set by the lookups that fill the cache.
+func (lc *LookupCache) generation() int64 {
+	return lc.state.generation.Get()
+}
+
+func (lc *LookupCache) get(id sqltypes.Value) ([][]sqltypes.Value, bool) {
+	if v, ok := lc.state.cache.Get(lookupCacheKey(id)); ok {
+		if entry := v.(*lookupCacheEntry); entry.id == id.ToString() {
+			lookupCacheHits.Add(lc.table, 1)
+			return entry.rows, true
+		}
+	}
+	lookupCacheMisses.Add(lc.table, 1)
+	return nil, false
+}
+
+func (lc *LookupCache) set(id sqltypes.Value, rows [][]sqltypes.Value, generation int64) {
+	key := lookupCacheKey(id)
+	lc.state.mu.Lock()
+	defer lc.state.mu.Unlock()
+	if lc.state.generation.Get() != generation {
+		return
+	}
+	lc.state.cache.Set(key, &lookupCacheEntry{id: id.ToString(), rows: rows})
+}
+
+func int64FromMap(m map[string]string, key string, def int64) (int64, error) {
+	val, ok := m[key]
+	if !ok {
+		return def, nil
+	}
+	num, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || num < 0 {
+		return 0, fmt.Errorf("%s value must be a non-negative number: '%s'", key, val)
+	}
+	return num, nil
+}
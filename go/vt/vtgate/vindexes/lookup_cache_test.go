@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createCachedLookup(t *testing.T, name string, params map[string]string) SingleColumn {
+	t.Helper()
+	m := map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "100",
+	}
+	for k, v := range params {
+		m[k] = v
+	}
+	l, err := CreateVindex(name, name, m)
+	require.NoError(t, err)
+	return l.(SingleColumn)
+}
+
+func TestLookupCacheNew(t *testing.T) {
+	for _, name := range []string{"lookup", "lookup_unique", "lookup_hash", "lookup_hash_unique", "consistent_lookup", "consistent_lookup_unique"} {
+		l := createLookup(t, name, false)
+		assert.Nil(t, l.(CachedLookup).LookupCache(), name)
+
+		cache := createCachedLookup(t, name, nil).(CachedLookup).LookupCache()
+		require.NotNil(t, cache, name)
+		assert.Equal(t, "t", cache.Table())
+		assert.Equal(t, "fromc", cache.Column())
+	}
+
+	_, err := CreateVindex("lookup", "lookup", map[string]string{
+		"table":      "t",
+		"from":       "fromc",
+		"to":         "toc",
+		"cache_size": "-1",
+	})
+	assert.EqualError(t, err, "cache_size value must be a non-negative number: '-1'")
+
+	_, err = CreateVindex("lookup", "lookup", map[string]string{
+		"table":        "t",
+		"from":         "fromc",
+		"to":           "toc",
+		"cache_size":   "10",
+		"cache_memory": "a lot",
+	})
+	assert.EqualError(t, err, "cache_memory value must be a non-negative number: 'a lot'")
+}
+
+func TestLookupCacheMap(t *testing.T) {
+	lookupCacheHits.ResetAll()
+	lookupCacheMisses.ResetAll()
+	lookup := createCachedLookup(t, "lookup", nil)
+	vc := &vcursor{numRows: 3}
+
+	ksids := key.DestinationKeyspaceIDs([][]byte{[]byte("1"), []byte("2"), []byte("3")})
+	want := []key.Destination{ksids, ksids}
+	got, err := lookup.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Len(t, vc.queries, 1)
+
+	// The ids that are cached are not looked up.
+	got, err = lookup.Map(vc, []sqltypes.Value{sqltypes.NewInt64(2), sqltypes.NewInt64(3)})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.Len(t, vc.queries, 2)
+	assert.Equal(t, sqltypes.TestBindVariable([]interface{}{sqltypes.NewInt64(3)}), vc.queries[1].BindVariables["fromc"])
+	assert.Equal(t, map[string]int64{"t": 1}, lookupCacheHits.Counts())
+	assert.Equal(t, map[string]int64{"t": 3}, lookupCacheMisses.Counts())
+
+	// Transactions don't use the cache.
+	vc.inTransaction = true
+	_, err = lookup.Map(vc, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 3)
+}
+
+func TestLookupCacheReplica(t *testing.T) {
+	lookup := createCachedLookup(t, "lookup", nil)
+	vc := &vcursor{numRows: 1, tabletType: topodatapb.TabletType_REPLICA}
+	ids := []sqltypes.Value{sqltypes.NewInt64(1)}
+
+	// The rows read from a replica are not cached,
+	// as they could predate the invalidations.
+	_, err := lookup.Map(vc, ids)
+	require.NoError(t, err)
+	vc.numRows = 0
+	got, err := lookup.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationNone{}}, got)
+	assert.Len(t, vc.queries, 2)
+
+	// But the replicas use the rows read from the master.
+	vc.tabletType = topodatapb.TabletType_MASTER
+	vc.numRows = 1
+	_, err = lookup.Map(vc, ids)
+	require.NoError(t, err)
+	vc.tabletType = topodatapb.TabletType_REPLICA
+	vc.numRows = 0
+	got, err = lookup.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationKeyspaceIDs([][]byte{[]byte("1")})}, got)
+	assert.Len(t, vc.queries, 3)
+}
+
+func TestLookupCacheInvalidate(t *testing.T) {
+	lookup := createCachedLookup(t, "lookup", nil)
+	vc := &vcursor{numRows: 1}
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+
+	_, err := lookup.Map(vc, ids)
+	require.NoError(t, err)
+	_, err = lookup.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 1)
+
+	// The vindex invalidates the ids that it writes.
+	err = lookup.(Lookup).Create(vc, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, [][]byte{[]byte("test")}, false)
+	require.NoError(t, err)
+	err = lookup.(Lookup).Delete(vc, [][]sqltypes.Value{{sqltypes.NewInt64(2)}}, []byte("test"))
+	require.NoError(t, err)
+	vc.queries = nil
+	_, err = lookup.Map(vc, ids)
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	assert.Equal(t, sqltypes.TestBindVariable([]interface{}{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}), vc.queries[0].BindVariables["fromc"])
+
+	cache := lookup.(CachedLookup).LookupCache()
+	cache.Invalidate(sqltypes.NewInt64(2))
+	vc.queries = nil
+	_, err = lookup.Map(vc, ids)
+	require.NoError(t, err)
+	require.Len(t, vc.queries, 1)
+	assert.Equal(t, sqltypes.TestBindVariable([]interface{}{sqltypes.NewInt64(2)}), vc.queries[0].BindVariables["fromc"])
+
+	cache.Clear()
+	vc.queries = nil
+	_, err = lookup.Map(vc, ids)
+	require.NoError(t, err)
+	assert.Len(t, vc.queries, 1)
+}
+
+func TestLookupCacheGeneration(t *testing.T) {
+	lookup := createCachedLookup(t, "lookup_unique", nil)
+	cache := lookup.(CachedLookup).LookupCache()
+
+	// The rows of a lookup that ran during an invalidation are not cached.
+	generation := cache.generation()
+	cache.Invalidate(sqltypes.NewInt64(1))
+	cache.set(sqltypes.NewInt64(1), nil, generation)
+	_, ok := cache.get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+
+	cache.set(sqltypes.NewInt64(1), nil, cache.generation())
+	_, ok = cache.get(sqltypes.NewInt64(1))
+	assert.True(t, ok)
+}
+
+func TestLookupCacheKey(t *testing.T) {
+	lookup := createCachedLookup(t, "lookup_unique", nil)
+	cache := lookup.(CachedLookup).LookupCache()
+	rows := [][]sqltypes.Value{{sqltypes.NewVarBinary("test")}}
+
+	// The ids that differ by their case or trailing spaces
+	// invalidate each other, but don't share their rows.
+	cache.set(sqltypes.NewVarChar("abc"), rows, cache.generation())
+	_, ok := cache.get(sqltypes.NewVarChar("ABC"))
+	assert.False(t, ok)
+	got, ok := cache.get(sqltypes.NewVarChar("abc"))
+	require.True(t, ok)
+	assert.Equal(t, rows, got)
+	cache.Invalidate(sqltypes.NewVarChar("ABC "))
+	_, ok = cache.get(sqltypes.NewVarChar("abc"))
+	assert.False(t, ok)
+
+	// So do the ids that differ by their accents.
+	cache.set(sqltypes.NewVarChar("e"), rows, cache.generation())
+	_, ok = cache.get(sqltypes.NewVarChar("é"))
+	assert.False(t, ok)
+	cache.Invalidate(sqltypes.NewVarChar("É"))
+	_, ok = cache.get(sqltypes.NewVarChar("e"))
+	assert.False(t, ok)
+
+	// A number and a string of its digits are the same id.
+	cache.set(sqltypes.NewInt64(1), rows, cache.generation())
+	_, ok = cache.get(sqltypes.NewVarChar("1"))
+	assert.True(t, ok)
+	cache.Invalidate(sqltypes.NewVarBinary("1"))
+	_, ok = cache.get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+}
+
+func TestLookupCacheReuse(t *testing.T) {
+	old := createCachedLookup(t, "lookup", nil).(CachedLookup).LookupCache()
+	old.set(sqltypes.NewInt64(1), nil, old.generation())
+
+	cache := createCachedLookup(t, "lookup", nil).(CachedLookup).LookupCache()
+	assert.False(t, cache.Reuse(nil))
+	require.True(t, cache.Reuse(old))
+	_, ok := cache.get(sqltypes.NewInt64(1))
+	assert.True(t, ok)
+
+	cache = createCachedLookup(t, "lookup", map[string]string{"cache_size": "200"}).(CachedLookup).LookupCache()
+	assert.False(t, cache.Reuse(old))
+	_, ok = cache.get(sqltypes.NewInt64(1))
+	assert.False(t, ok)
+}
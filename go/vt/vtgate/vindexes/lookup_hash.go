@@ -31,8 +31,10 @@ import (
 var (
 	_ SingleColumn = (*LookupHash)(nil)
 	_ Lookup       = (*LookupHash)(nil)
+	_ CachedLookup = (*LookupHash)(nil)
 	_ SingleColumn = (*LookupHashUnique)(nil)
 	_ Lookup       = (*LookupHashUnique)(nil)
+	_ CachedLookup = (*LookupHashUnique)(nil)
 )
 
 func init() {
@@ -61,6 +63,7 @@ type LookupHash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of ids whose lookups are cached. See LookupCache.
 func NewLookupHash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupHash{name: name}
 
@@ -100,6 +103,11 @@ func (lh *LookupHash) NeedsVCursor() bool {
 	return true
 }
 
+// LookupCache returns the cache of the lookups, or nil if they are not cached.
+func (lh *LookupHash) LookupCache() *LookupCache {
+	return lh.lkp.cache
+}
+
 // Map can map ids to key.Destination objects.
 func (lh *LookupHash) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
@@ -225,6 +233,7 @@ type LookupHashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of ids whose lookups are cached. See LookupCache.
 func NewLookupHashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupHashUnique{name: name}
 
@@ -264,6 +273,11 @@ func (lhu *LookupHashUnique) NeedsVCursor() bool {
 	return true
 }
 
+// LookupCache returns the cache of the lookups, or nil if they are not cached.
+func (lhu *LookupHashUnique) LookupCache() *LookupCache {
+	return lhu.lkp.cache
+}
+
 // Map can map ids to key.Destination objects.
 func (lhu *LookupHashUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
@@ -38,6 +39,8 @@ type lookupInternal struct {
 	Upsert        bool     `json:"upsert,omitempty"`
 	IgnoreNulls   bool     `json:"ignore_nulls,omitempty"`
 	sel, ver, del string
+	// cache is nil if the lookups are not cached.
+	cache *LookupCache
 }
 
 func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit, upsert bool) error {
@@ -64,7 +67,8 @@ func (lkp *lookupInternal) Init(lookupQueryParams map[string]string, autocommit,
 	lkp.sel = fmt.Sprintf("select %s, %s from %s where %s in ::%s", lkp.FromColumns[0], lkp.To, lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0])
 	lkp.ver = fmt.Sprintf("select %s from %s where %s = :%s and %s = :%s", lkp.FromColumns[0], lkp.Table, lkp.FromColumns[0], lkp.FromColumns[0], lkp.To, lkp.To)
 	lkp.del = lkp.initDelStmt()
-	return nil
+	lkp.cache, err = newLookupCache(lkp.Table, lkp.FromColumns[0], lookupQueryParams)
+	return err
 }
 
 // Lookup performs a lookup for the ids.
@@ -72,6 +76,42 @@ func (lkp *lookupInternal) Lookup(vcursor VCursor, ids []sqltypes.Value, co vtga
 	if vcursor == nil {
 		return nil, fmt.Errorf("cannot perform lookup: no vcursor provided")
 	}
+	if lkp.cache == nil || lkp.cache.disabled || vcursor.InTransaction() {
+		return lkp.lookup(vcursor, ids, co)
+	}
+	results := make([]*sqltypes.Result, len(ids))
+	var missing []sqltypes.Value
+	var missingIdx []int
+	for i, id := range ids {
+		if rows, ok := lkp.cache.get(id); ok {
+			results[i] = &sqltypes.Result{Rows: rows}
+			continue
+		}
+		missing = append(missing, id)
+		missingIdx = append(missingIdx, i)
+	}
+	if len(missing) == 0 {
+		return results, nil
+	}
+	generation := lkp.cache.generation()
+	missingResults, err := lkp.lookup(vcursor, missing, co)
+	if err != nil {
+		return nil, err
+	}
+	// The invalidations are streamed from the master, so the rows
+	// read from a lagging replica could predate them: only the
+	// rows read from the master are cached.
+	fill := vcursor.TabletType() == topodatapb.TabletType_MASTER
+	for j, result := range missingResults {
+		if fill {
+			lkp.cache.set(missing[j], result.Rows, generation)
+		}
+		results[missingIdx[j]] = result
+	}
+	return results, nil
+}
+
+func (lkp *lookupInternal) lookup(vcursor VCursor, ids []sqltypes.Value, co vtgatepb.CommitOrder) ([]*sqltypes.Result, error) {
 	results := make([]*sqltypes.Result, 0, len(ids))
 	if lkp.Autocommit {
 		co = vtgatepb.CommitOrder_AUTOCOMMIT
@@ -203,6 +243,7 @@ func (lkp *lookupInternal) Create(vcursor VCursor, rowsColValues [][]sqltypes.Va
 }
 
 func (lkp *lookupInternal) createCustom(vcursor VCursor, rowsColValues [][]sqltypes.Value, toValues []sqltypes.Value, ignoreMode bool, co vtgatepb.CommitOrder) error {
+	defer lkp.invalidate(rowsColValues)
 	// Trim rows with null values
 	trimmedRowsCols := make([][]sqltypes.Value, 0, len(rowsColValues))
 	trimmedToValues := make([]sqltypes.Value, 0, len(toValues))
@@ -299,6 +340,7 @@ func (lkp *lookupInternal) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Va
 	if len(rowsColValues[0]) != len(lkp.FromColumns) {
 		return fmt.Errorf("lookup.Delete: column vindex count does not match the columns in the lookup: %d vs %v", len(rowsColValues[0]), lkp.FromColumns)
 	}
+	defer lkp.invalidate(rowsColValues)
 	for _, column := range rowsColValues {
 		bindVars := make(map[string]*querypb.BindVariable, len(rowsColValues))
 		for colIdx, columnValue := range column {
@@ -321,6 +363,20 @@ func (lkp *lookupInternal) Update(vcursor VCursor, oldValues []sqltypes.Value, k
 	return lkp.Create(vcursor, [][]sqltypes.Value{newValues}, []sqltypes.Value{toValue}, false /* ignoreMode */)
 }
 
+// invalidate removes the cached lookups of the rows written to the lookup table.
+func (lkp *lookupInternal) invalidate(rowsColValues [][]sqltypes.Value) {
+	if lkp.cache == nil {
+		return
+	}
+	ids := make([]sqltypes.Value, 0, len(rowsColValues))
+	for _, row := range rowsColValues {
+		if len(row) != 0 {
+			ids = append(ids, row[0])
+		}
+	}
+	lkp.cache.Invalidate(ids...)
+}
+
 func (lkp *lookupInternal) initDelStmt() string {
 	var delBuffer bytes.Buffer
 	fmt.Fprintf(&delBuffer, "delete from %s where ", lkp.Table)
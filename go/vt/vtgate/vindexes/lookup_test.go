@@ -49,16 +49,31 @@ type vcursor struct {
 	autocommits int
 	pre, post   int
 	keys        []sqltypes.Value
+
+	inTransaction bool
+	// tabletType is the master if it's not set.
+	tabletType topodatapb.TabletType
 }
 
 func (vc *vcursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	panic("implement me")
 }
 
+func (vc *vcursor) InTransaction() bool {
+	return vc.inTransaction
+}
+
 func (vc *vcursor) InTransactionAndIsDML() bool {
 	return false
 }
 
+func (vc *vcursor) TabletType() topodatapb.TabletType {
+	if vc.tabletType == topodatapb.TabletType_UNKNOWN {
+		return topodatapb.TabletType_MASTER
+	}
+	return vc.tabletType
+}
+
 func (vc *vcursor) Execute(method string, query string, bindvars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
 	switch co {
 	case vtgatepb.CommitOrder_PRE:
@@ -32,8 +32,10 @@ import (
 var (
 	_ SingleColumn = (*LookupUnicodeLooseMD5Hash)(nil)
 	_ Lookup       = (*LookupUnicodeLooseMD5Hash)(nil)
+	_ CachedLookup = (*LookupUnicodeLooseMD5Hash)(nil)
 	_ SingleColumn = (*LookupUnicodeLooseMD5HashUnique)(nil)
 	_ Lookup       = (*LookupUnicodeLooseMD5HashUnique)(nil)
+	_ CachedLookup = (*LookupUnicodeLooseMD5HashUnique)(nil)
 )
 
 func init() {
@@ -62,6 +64,7 @@ type LookupUnicodeLooseMD5Hash struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause inserts to upsert and deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of ids whose lookups are cached. See LookupCache.
 func NewLookupUnicodeLooseMD5Hash(name string, m map[string]string) (Vindex, error) {
 	lh := &LookupUnicodeLooseMD5Hash{name: name}
 
@@ -101,6 +104,11 @@ func (lh *LookupUnicodeLooseMD5Hash) NeedsVCursor() bool {
 	return true
 }
 
+// LookupCache returns the cache of the lookups, or nil if they are not cached.
+func (lh *LookupUnicodeLooseMD5Hash) LookupCache() *LookupCache {
+	return lh.lkp.cache
+}
+
 // Map can map ids to key.Destination objects.
 func (lh *LookupUnicodeLooseMD5Hash) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
@@ -237,6 +245,7 @@ type LookupUnicodeLooseMD5HashUnique struct {
 // The following fields are optional:
 //   autocommit: setting this to "true" will cause deletes to be ignored.
 //   write_only: in this mode, Map functions return the full keyrange causing a full scatter.
+//   cache_size: the number of ids whose lookups are cached. See LookupCache.
 func NewLookupUnicodeLooseMD5HashUnique(name string, m map[string]string) (Vindex, error) {
 	lhu := &LookupUnicodeLooseMD5HashUnique{name: name}
 
@@ -276,6 +285,11 @@ func (lhu *LookupUnicodeLooseMD5HashUnique) NeedsVCursor() bool {
 	return true
 }
 
+// LookupCache returns the cache of the lookups, or nil if they are not cached.
+func (lhu *LookupUnicodeLooseMD5HashUnique) LookupCache() *LookupCache {
+	return lhu.lkp.cache
+}
+
 // Map can map ids to key.Destination objects.
 func (lhu *LookupUnicodeLooseMD5HashUnique) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, 0, len(ids))
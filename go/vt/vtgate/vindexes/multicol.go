@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ MultiColumn = (*MultiCol)(nil)
+)
+
+const (
+	// multiColKeyspaceIDLength is the default length of
+	// the keyspace ids of the MultiCol vindex.
+	multiColKeyspaceIDLength = 8
+
+	multiColDefaultVindex = "hash"
+)
+
+func init() {
+	Register("multicol", NewMultiCol)
+}
+
+// MultiCol is a multi-column unique vindex. Every column is mapped by its
+// own functional vindex, and contributes the first bytes of the keyspace id
+// of its value to the keyspace id of the row, in the order of the columns.
+// The values of the first columns of a row thus map to a key range: the
+// keyspace ids that start with their bytes. This lets VTGate route the
+// queries that only know a prefix of the columns to the shards that cover it.
+// The keyspace ids of the columns that are too short are padded with zeros.
+type MultiCol struct {
+	name        string
+	cost        int
+	columnVdx   []SingleColumn
+	columnBytes []int
+}
+
+// NewMultiCol creates a MultiCol vindex. The column_count param is the number
+// of columns of the vindex. The column_vindex param is the comma-separated list
+// of the types of the vindexes of the columns, which default to "hash". The
+// column_bytes param is the comma-separated list of the number of bytes each
+// column contributes to the keyspace id, which are by default the 8 bytes of
+// a keyspace id split evenly between the columns.
+func NewMultiCol(name string, m map[string]string) (Vindex, error) {
+	count, err := strconv.Atoi(m["column_count"])
+	if err != nil || count < 1 || count > multiColKeyspaceIDLength {
+		return nil, fmt.Errorf("multicol: column_count must be a number between 1 and %d: %q", multiColKeyspaceIDLength, m["column_count"])
+	}
+	vind := &MultiCol{
+		name:        name,
+		columnVdx:   make([]SingleColumn, count),
+		columnBytes: make([]int, count),
+	}
+
+	vindexTypes, err := multiColParam(m, "column_vindex", count)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vind.columnVdx {
+		vindexType := multiColDefaultVindex
+		if vindexTypes != nil {
+			vindexType = vindexTypes[i]
+		}
+		vdx, err := CreateVindex(vindexType, fmt.Sprintf("%s_%d", name, i), nil)
+		if err != nil {
+			return nil, fmt.Errorf("multicol: %v", err)
+		}
+		single, ok := vdx.(SingleColumn)
+		if !ok || !vdx.IsUnique() || vdx.NeedsVCursor() {
+			return nil, fmt.Errorf("multicol: column_vindex must be a functional unique single column vindex: %s", vindexType)
+		}
+		vind.columnVdx[i] = single
+		if vdx.Cost() > vind.cost {
+			vind.cost = vdx.Cost()
+		}
+	}
+
+	columnBytes, err := multiColParam(m, "column_bytes", count)
+	if err != nil {
+		return nil, err
+	}
+	if columnBytes == nil {
+		// The first columns get the bytes that can't be split evenly.
+		for i := range vind.columnBytes {
+			vind.columnBytes[i] = multiColKeyspaceIDLength / count
+			if i < multiColKeyspaceIDLength%count {
+				vind.columnBytes[i]++
+			}
+		}
+		return vind, nil
+	}
+	for i, s := range columnBytes {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 || n > multiColKeyspaceIDLength {
+			return nil, fmt.Errorf("multicol: column_bytes must be numbers between 1 and %d: %q", multiColKeyspaceIDLength, m["column_bytes"])
+		}
+		vind.columnBytes[i] = n
+	}
+	return vind, nil
+}
+
+// multiColParam returns the comma-separated values of a param,
+// which must be one per column, or nil if the param is not set.
+func multiColParam(m map[string]string, param string, count int) ([]string, error) {
+	if m[param] == "" {
+		return nil, nil
+	}
+	values := strings.Split(m[param], ",")
+	if len(values) != count {
+		return nil, fmt.Errorf("multicol: %s must have %d values: %q", param, count, m[param])
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values, nil
+}
+
+// String returns the name of the vindex.
+func (vind *MultiCol) String() string {
+	return vind.name
+}
+
+// Cost returns the highest cost of the vindexes of the columns.
+func (vind *MultiCol) Cost() int {
+	return vind.cost
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (vind *MultiCol) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *MultiCol) NeedsVCursor() bool {
+	return false
+}
+
+// PartialVindex returns true since the values of the first
+// columns of the vindex map to a key range.
+func (vind *MultiCol) PartialVindex() bool {
+	return true
+}
+
+// Map satisfies MultiColumn. A row that has the values of all the columns
+// maps to a keyspace id, and a row that has the values of the first
+// columns maps to the key range of the keyspace ids that start with them.
+func (vind *MultiCol) Map(_ VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	prefixes, err := vind.mapPrefixes(rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]key.Destination, len(rowsColValues))
+	for i, prefix := range prefixes {
+		switch {
+		case prefix == nil:
+			out[i] = key.DestinationNone{}
+		case len(rowsColValues[i]) == len(vind.columnVdx):
+			out[i] = key.DestinationKeyspaceID(prefix)
+		default:
+			out[i] = key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: prefix, End: prefixEnd(prefix)}}
+		}
+	}
+	return out, nil
+}
+
+// Verify satisfies MultiColumn.
+func (vind *MultiCol) Verify(_ VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	prefixes, err := vind.mapPrefixes(rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]bool, len(rowsColValues))
+	for i, prefix := range prefixes {
+		out[i] = prefix != nil && len(rowsColValues[i]) == len(vind.columnVdx) && bytes.Equal(prefix, ksids[i])
+	}
+	return out, nil
+}
+
+// mapPrefixes returns the bytes of the keyspace ids that the values of
+// the rows contribute, or nil for the rows that can't be mapped.
+func (vind *MultiCol) mapPrefixes(rowsColValues [][]sqltypes.Value) ([][]byte, error) {
+	prefixes := make([][]byte, len(rowsColValues))
+	valid := make([]bool, len(rowsColValues))
+	for i, row := range rowsColValues {
+		valid[i] = len(row) > 0 && len(row) <= len(vind.columnVdx)
+	}
+	// The values are mapped one column at a time, to map
+	// the values of the rows with a single call.
+	for col, vdx := range vind.columnVdx {
+		var ids []sqltypes.Value
+		var rows []int
+		for i, row := range rowsColValues {
+			if valid[i] && col < len(row) {
+				ids = append(ids, row[col])
+				rows = append(rows, i)
+			}
+		}
+		if len(ids) == 0 {
+			break
+		}
+		destinations, err := vdx.Map(nil, ids)
+		if err != nil {
+			return nil, err
+		}
+		for j, dest := range destinations {
+			i := rows[j]
+			ksid, ok := dest.(key.DestinationKeyspaceID)
+			if !ok {
+				valid[i] = false
+				continue
+			}
+			// A shorter keyspace id is padded with zeros.
+			columnKsid := make([]byte, vind.columnBytes[col])
+			copy(columnKsid, ksid)
+			prefixes[i] = append(prefixes[i], columnKsid...)
+		}
+	}
+	for i := range prefixes {
+		if !valid[i] {
+			prefixes[i] = nil
+		}
+	}
+	return prefixes, nil
+}
+
+// prefixEnd returns the smallest keyspace id above all the keyspace ids
+// that start with prefix, or nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createMultiCol(t *testing.T, params map[string]string) MultiColumn {
+	t.Helper()
+	vindex, err := CreateVindex("multicol", "multicol", params)
+	require.NoError(t, err)
+	return vindex.(MultiColumn)
+}
+
+func TestMultiColInfo(t *testing.T) {
+	multicol := createMultiCol(t, map[string]string{"column_count": "2"})
+	assert.Equal(t, 1, multicol.Cost())
+	assert.Equal(t, "multicol", multicol.String())
+	assert.True(t, multicol.IsUnique())
+	assert.False(t, multicol.NeedsVCursor())
+	assert.True(t, multicol.PartialVindex())
+
+	multicol = createMultiCol(t, map[string]string{"column_count": "2", "column_vindex": "binary,numeric"})
+	assert.Equal(t, 0, multicol.Cost())
+}
+
+func TestMultiColNew(t *testing.T) {
+	tcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    `multicol: column_count must be a number between 1 and 8: ""`,
+	}, {
+		params: map[string]string{"column_count": "9"},
+		err:    `multicol: column_count must be a number between 1 and 8: "9"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash"},
+		err:    `multicol: column_vindex must have 2 values: "hash"`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,foo"},
+		err:    `multicol: vindexType "foo" not found`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,region_experimental"},
+		err:    `multicol: region_experimental missing region_bytes param`,
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,0"},
+		err:    `multicol: column_bytes must be numbers between 1 and 8: "4,0"`,
+	}}
+	for _, tcase := range tcases {
+		_, err := CreateVindex("multicol", "multicol", tcase.params)
+		assert.EqualError(t, err, tcase.err)
+	}
+}
+
+func TestMultiColMap(t *testing.T) {
+	multicol := createMultiCol(t, map[string]string{"column_count": "3"})
+	got, err := multicol.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(1),
+	}, {
+		// The values of the first columns map to a key range.
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewVarChar("abc"), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {}})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyspaceID("\x16\x6b\x40\x06\xe7\xea\x16\x6b"),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x16\x6b\x40\x06\xe7\xea"),
+			End:   []byte("\x16\x6b\x40\x06\xe7\xeb"),
+		}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x16\x6b\x40"),
+			End:   []byte("\x16\x6b\x41"),
+		}},
+		key.DestinationNone{},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}, got)
+}
+
+func TestMultiColMapColumnVindexes(t *testing.T) {
+	multicol := createMultiCol(t, map[string]string{
+		"column_count":  "3",
+		"column_vindex": "binary, xxhash, numeric",
+		"column_bytes":  "2,4,2",
+	})
+	got, err := multicol.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewVarChar("a"), sqltypes.NewVarChar("abc"), sqltypes.NewInt64(2),
+	}, {
+		sqltypes.NewVarChar("\xff\xff"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		// The keyspace id of the binary column is padded.
+		key.DestinationKeyspaceID("a\x00\x99\x09\x77\xad\x00\x00"),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\xff\xff"),
+		}},
+	}, got)
+}
+
+func TestMultiColVerify(t *testing.T) {
+	multicol := createMultiCol(t, map[string]string{"column_count": "2"})
+	got, err := multicol.Verify(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(1),
+	}}, [][]byte{
+		[]byte("\x16\x6b\x40\xb4\x06\xe7\xea\x22"),
+		[]byte("\x16\x6b\x40\xb4\x06\xe7\xea\x22"),
+		[]byte("\x16\x6b\x40\xb4"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestMultiColPrefixEnd(t *testing.T) {
+	assert.Equal(t, []byte("\x01\x03"), prefixEnd([]byte("\x01\x02")))
+	assert.Equal(t, []byte("\x02"), prefixEnd([]byte("\x01\xff")))
+	assert.Nil(t, prefixEnd([]byte("\xff\xff")))
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ RangeMapper = (*Range)(nil)
+	_ Reversible  = (*Range)(nil)
+)
+
+const (
+	// rangeKeyspaceIDLength is the length of the keyspace ids of
+	// the Range vindex: the index of the range, and the value.
+	rangeKeyspaceIDLength = 10
+
+	rangeTypeInt      = "int"
+	rangeTypeDatetime = "datetime"
+
+	microsPerSecond = 1000000
+)
+
+var rangeDatetimeLayouts = []string{"2006-01-02 15:04:05.999999", "2006-01-02"}
+
+func init() {
+	Register("range", NewRange)
+}
+
+// Range is a unique vindex that maps values monotonically to keyspace ids,
+// so that range predicates on its column can be routed to the shards that
+// cover them. The values are split into ranges by explicit boundaries: the
+// first range holds the values below the first boundary, and range i the
+// values from boundary i. The keyspace id of a value is the 2-byte index of
+// its range, followed by the 8 bytes of the value. Range i thus maps to the
+// keyspace ids from i to i+1, like 0002-0003, and it can be split further
+// by splitting its shard at the keyspace id of a value.
+//
+// New boundaries must be appended, above the values already stored,
+// as the values above a new boundary change range.
+type Range struct {
+	name       string
+	datetime   bool
+	boundaries []int64
+}
+
+// NewRange creates a Range vindex. The boundaries param is the
+// comma-separated list of the ascending boundaries of the ranges.
+// The type param is "int", the default, for integer values, or
+// "datetime" for datetime values, which are compared in UTC to
+// the microsecond.
+func NewRange(name string, m map[string]string) (Vindex, error) {
+	vind := &Range{name: name}
+	switch typ := m["type"]; typ {
+	case "", rangeTypeInt:
+	case rangeTypeDatetime:
+		vind.datetime = true
+	default:
+		return nil, fmt.Errorf("range: type must be %s or %s: %v", rangeTypeInt, rangeTypeDatetime, typ)
+	}
+	if m["boundaries"] == "" {
+		return vind, nil
+	}
+	for _, boundary := range strings.Split(m["boundaries"], ",") {
+		num, err := vind.toInt64(sqltypes.NewVarChar(strings.TrimSpace(boundary)))
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "range: invalid boundary %q", boundary)
+		}
+		if len(vind.boundaries) > 0 && num <= vind.boundaries[len(vind.boundaries)-1] {
+			return nil, fmt.Errorf("range: boundaries must be in ascending order: %v", m["boundaries"])
+		}
+		vind.boundaries = append(vind.boundaries, num)
+	}
+	if len(vind.boundaries) > math.MaxUint16 {
+		return nil, fmt.Errorf("range: too many boundaries: %d", len(vind.boundaries))
+	}
+	return vind, nil
+}
+
+// String returns the name of the vindex.
+func (vind *Range) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*Range) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (*Range) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (*Range) NeedsVCursor() bool {
+	return false
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *Range) Map(_ VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		num, err := vind.toInt64(id)
+		if err != nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(vind.keyspaceID(num)))
+	}
+	return out, nil
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *Range) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		num, err := vind.toInt64(ids[i])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "Range.Verify")
+		}
+		out[i] = bytes.Equal(vind.keyspaceID(num), ksids[i])
+	}
+	return out, nil
+}
+
+// MapRange returns the key range of the keyspace ids of the values
+// between start and end. The bounds that can't be converted are
+// treated as open, which only widens the range.
+func (vind *Range) MapRange(_ VCursor, start, end sqltypes.Value, excludeStart, excludeEnd bool) (key.Destination, error) {
+	kr := &topodatapb.KeyRange{}
+	low := int64(math.MinInt64)
+	if num, err := vind.toInt64(start); err == nil {
+		low = num
+		if excludeStart {
+			if low == math.MaxInt64 {
+				return key.DestinationNone{}, nil
+			}
+			low++
+		}
+		kr.Start = vind.keyspaceID(low)
+	}
+	if num, err := vind.toInt64(end); err == nil {
+		if !excludeEnd {
+			if num == math.MaxInt64 {
+				return key.DestinationKeyRange{KeyRange: kr}, nil
+			}
+			num++
+		}
+		if num <= low {
+			return key.DestinationNone{}, nil
+		}
+		kr.End = vind.keyspaceID(num)
+		if vind.isBoundary(num) {
+			// No value maps between the start of the range and its boundary.
+			kr.End = kr.End[:2]
+		}
+	}
+	return key.DestinationKeyRange{KeyRange: kr}, nil
+}
+
+// ReverseMap returns the associated ids for the ksids.
+func (vind *Range) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
+	reverseIds := make([]sqltypes.Value, len(ksids))
+	for i, keyspaceID := range ksids {
+		if len(keyspaceID) != rangeKeyspaceIDLength {
+			return nil, fmt.Errorf("Range.ReverseMap: length of keyspaceId is not %d: %d", rangeKeyspaceIDLength, len(keyspaceID))
+		}
+		num := int64(binary.BigEndian.Uint64(keyspaceID[2:]) ^ (1 << 63))
+		if !vind.datetime {
+			reverseIds[i] = sqltypes.NewInt64(num)
+			continue
+		}
+		t := time.Unix(num/microsPerSecond, num%microsPerSecond*1000).UTC()
+		reverseIds[i] = sqltypes.MakeTrusted(sqltypes.Datetime, []byte(t.Format(rangeDatetimeLayouts[0])))
+	}
+	return reverseIds, nil
+}
+
+// keyspaceID returns the index of the range of num, followed by num
+// with its sign bit flipped, so that the negative numbers come first.
+func (vind *Range) keyspaceID(num int64) []byte {
+	index := sort.Search(len(vind.boundaries), func(i int) bool {
+		return vind.boundaries[i] > num
+	})
+	var keybytes [rangeKeyspaceIDLength]byte
+	binary.BigEndian.PutUint16(keybytes[:2], uint16(index))
+	binary.BigEndian.PutUint64(keybytes[2:], uint64(num)^(1<<63))
+	return keybytes[:]
+}
+
+func (vind *Range) isBoundary(num int64) bool {
+	i := sort.Search(len(vind.boundaries), func(i int) bool {
+		return vind.boundaries[i] >= num
+	})
+	return i < len(vind.boundaries) && vind.boundaries[i] == num
+}
+
+// toInt64 converts a value to the number it is compared by.
+func (vind *Range) toInt64(v sqltypes.Value) (int64, error) {
+	if v.IsNull() {
+		return 0, fmt.Errorf("cannot map NULL")
+	}
+	if !vind.datetime {
+		return evalengine.ToInt64(v)
+	}
+	for _, layout := range rangeDatetimeLayouts {
+		if t, err := time.Parse(layout, v.ToString()); err == nil {
+			return t.Unix()*microsPerSecond + int64(t.Nanosecond()/1000), nil
+		}
+	}
+	return 0, fmt.Errorf("could not parse datetime: %s", v.ToString())
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func createRange(t *testing.T, params map[string]string) RangeMapper {
+	t.Helper()
+	vindex, err := CreateVindex("range", "rng", params)
+	require.NoError(t, err)
+	return vindex.(RangeMapper)
+}
+
+func TestRangeInfo(t *testing.T) {
+	rng := createRange(t, map[string]string{"boundaries": "100,200"})
+	assert.Equal(t, 1, rng.Cost())
+	assert.Equal(t, "rng", rng.String())
+	assert.True(t, rng.IsUnique())
+	assert.False(t, rng.NeedsVCursor())
+}
+
+func TestRangeNew(t *testing.T) {
+	tcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{"type": "float"},
+		err:    "range: type must be int or datetime: float",
+	}, {
+		params: map[string]string{"boundaries": "100,abc"},
+		err:    `range: invalid boundary "abc": could not parse value: 'abc'`,
+	}, {
+		params: map[string]string{"boundaries": "200,100"},
+		err:    "range: boundaries must be in ascending order: 200,100",
+	}, {
+		params: map[string]string{"boundaries": "2021-02-01,2021-01-01", "type": "datetime"},
+		err:    "range: boundaries must be in ascending order: 2021-02-01,2021-01-01",
+	}, {
+		params: map[string]string{"boundaries": "2021-01-01 10:00", "type": "datetime"},
+		err:    `range: invalid boundary "2021-01-01 10:00": could not parse datetime: 2021-01-01 10:00`,
+	}}
+	for _, tcase := range tcases {
+		_, err := CreateVindex("range", "rng", tcase.params)
+		assert.EqualError(t, err, tcase.err)
+	}
+}
+
+func TestRangeMap(t *testing.T) {
+	rng := createRange(t, map[string]string{"boundaries": "100, 200"})
+	got, err := rng.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(-1),
+		sqltypes.NewInt64(99),
+		sqltypes.NewInt64(100),
+		sqltypes.NewVarChar("150"),
+		sqltypes.NewUint64(300),
+		sqltypes.NewFloat64(1.1),
+		sqltypes.NULL,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{
+		key.DestinationKeyspaceID("\x00\x00\x7f\xff\xff\xff\xff\xff\xff\xff"),
+		key.DestinationKeyspaceID("\x00\x00\x80\x00\x00\x00\x00\x00\x00\x63"),
+		key.DestinationKeyspaceID("\x00\x01\x80\x00\x00\x00\x00\x00\x00\x64"),
+		key.DestinationKeyspaceID("\x00\x01\x80\x00\x00\x00\x00\x00\x00\x96"),
+		key.DestinationKeyspaceID("\x00\x02\x80\x00\x00\x00\x00\x00\x01\x2c"),
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}, got)
+}
+
+func TestRangeVerify(t *testing.T) {
+	rng := createRange(t, map[string]string{"boundaries": "100"})
+	got, err := rng.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(100), sqltypes.NewInt64(100)},
+		[][]byte{[]byte("\x00\x01\x80\x00\x00\x00\x00\x00\x00\x64"), []byte("\x00\x00\x80\x00\x00\x00\x00\x00\x00\x64")})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+
+	_, err = rng.Verify(nil, []sqltypes.Value{sqltypes.NewVarBinary("aa")}, [][]byte{nil})
+	assert.EqualError(t, err, "Range.Verify: could not parse value: 'aa'")
+}
+
+func TestRangeMapRange(t *testing.T) {
+	rng := createRange(t, map[string]string{"boundaries": "100,200"})
+	// The shards hold one range each, except for the
+	// second range, which is split at the value 150.
+	shards := []*topodatapb.ShardReference{
+		shardReference(t, "-0001"),
+		shardReference(t, "0001-00018000000000000096"),
+		shardReference(t, "00018000000000000096-0002"),
+		shardReference(t, "0002-"),
+	}
+	tcases := []struct {
+		start, end               sqltypes.Value
+		excludeStart, excludeEnd bool
+		shards                   []string
+	}{{
+		start:  sqltypes.NewInt64(100),
+		end:    sqltypes.NewInt64(200),
+		shards: []string{"0001-00018000000000000096", "00018000000000000096-0002", "0002-"},
+	}, {
+		start:      sqltypes.NewInt64(100),
+		end:        sqltypes.NewInt64(200),
+		excludeEnd: true,
+		shards:     []string{"0001-00018000000000000096", "00018000000000000096-0002"},
+	}, {
+		start:        sqltypes.NewInt64(99),
+		end:          sqltypes.NewInt64(149),
+		excludeStart: true,
+		shards:       []string{"0001-00018000000000000096"},
+	}, {
+		start:  sqltypes.NewInt64(99),
+		end:    sqltypes.NewInt64(149),
+		shards: []string{"-0001", "0001-00018000000000000096"},
+	}, {
+		start:  sqltypes.NULL,
+		end:    sqltypes.NewInt64(50),
+		shards: []string{"-0001"},
+	}, {
+		start:        sqltypes.NewInt64(250),
+		end:          sqltypes.NULL,
+		excludeStart: true,
+		shards:       []string{"0002-"},
+	}, {
+		// The bounds that can't be mapped leave the range open.
+		start:  sqltypes.NewVarChar("abc"),
+		end:    sqltypes.NewInt64(120),
+		shards: []string{"-0001", "0001-00018000000000000096"},
+	}, {
+		start:  sqltypes.NewInt64(200),
+		end:    sqltypes.NewInt64(100),
+		shards: nil,
+	}, {
+		start:        sqltypes.NewInt64(100),
+		end:          sqltypes.NewInt64(100),
+		excludeStart: true,
+		shards:       nil,
+	}}
+	for _, tcase := range tcases {
+		dest, err := rng.MapRange(nil, tcase.start, tcase.end, tcase.excludeStart, tcase.excludeEnd)
+		require.NoError(t, err)
+		var got []string
+		err = dest.Resolve(shards, func(shard string) error {
+			got = append(got, shard)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tcase.shards, got, "%v - %v", tcase.start, tcase.end)
+	}
+}
+
+func TestRangeDatetime(t *testing.T) {
+	rng := createRange(t, map[string]string{"boundaries": "2021-01-01,2021-02-01", "type": "datetime"})
+	got, err := rng.Map(nil, []sqltypes.Value{
+		sqltypes.NewVarChar("2020-12-31 23:59:59.999999"),
+		sqltypes.NewVarChar("2021-01-01"),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-02-01 00:00:00")),
+		sqltypes.NewVarChar("yesterday"),
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 4)
+	for i, index := range []byte{0, 1, 2} {
+		assert.Equal(t, []byte{0, index}, []byte(got[i].(key.DestinationKeyspaceID)[:2]), "value %d", i)
+	}
+	assert.Equal(t, key.DestinationNone{}, got[3])
+
+	ids, err := rng.(Reversible).ReverseMap(nil, [][]byte{got[0].(key.DestinationKeyspaceID), got[2].(key.DestinationKeyspaceID)})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-12-31 23:59:59.999999")),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-02-01 00:00:00")),
+	}, ids)
+
+	// The last value of January is in the same range as the first.
+	dest, err := rng.MapRange(nil, sqltypes.NewVarChar("2021-01-01"), sqltypes.NewVarChar("2021-02-01"), false, true)
+	require.NoError(t, err)
+	kr := dest.(key.DestinationKeyRange).KeyRange
+	assert.Equal(t, []byte{0, 1}, kr.Start[:2])
+	assert.Equal(t, []byte{0, 2}, kr.End[:2])
+}
+
+func TestRangeReverseMap(t *testing.T) {
+	rng := createRange(t, map[string]string{"boundaries": "100"})
+	got, err := rng.(Reversible).ReverseMap(nil, [][]byte{
+		[]byte("\x00\x00\x7f\xff\xff\xff\xff\xff\xff\xff"),
+		[]byte("\x00\x01\x80\x00\x00\x00\x00\x00\x00\x64"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(-1), sqltypes.NewInt64(100)}, got)
+
+	_, err = rng.(Reversible).ReverseMap(nil, [][]byte{[]byte("aa")})
+	assert.EqualError(t, err, "Range.ReverseMap: length of keyspaceId is not 10: 2")
+}
+
+func shardReference(t *testing.T, shard string) *topodatapb.ShardReference {
+	t.Helper()
+	parts := strings.Split(shard, "-")
+	kr, err := key.ParseKeyRangeParts(parts[0], parts[1])
+	require.NoError(t, err)
+	return &topodatapb.ShardReference{Name: shard, KeyRange: kr}
+}
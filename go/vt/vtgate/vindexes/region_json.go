@@ -153,3 +153,8 @@ func (rv *RegionJSON) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 func (rv *RegionJSON) NeedsVCursor() bool {
 	return false
 }
+
+// PartialVindex returns false since the vindex needs the values of all its columns.
+func (rv *RegionJSON) PartialVindex() bool {
+	return false
+}
@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -37,8 +38,11 @@ import (
 type VCursor interface {
 	Execute(method string, query string, bindvars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 	ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError, autocommit bool) (*sqltypes.Result, error)
+	InTransaction() bool
 	InTransactionAndIsDML() bool
 	LookupRowLockShardSession() vtgatepb.CommitOrder
+	// TabletType returns the type of the tablets that Execute reads from.
+	TabletType() topodatapb.TabletType
 }
 
 // Vindex defines the interface required to register a vindex.
@@ -89,6 +93,9 @@ type MultiColumn interface {
 	Vindex
 	Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+	// PartialVindex returns true if Map accepts rows that only have
+	// the values of the first columns of the vindex.
+	PartialVindex() bool
 }
 
 // A Reversible vindex is one that can perform a
@@ -101,6 +108,19 @@ type Reversible interface {
 	ReverseMap(vcursor VCursor, ks [][]byte) ([]sqltypes.Value, error)
 }
 
+// A RangeMapper vindex is one that maps values monotonically
+// to keyspace ids. The values between two bounds then map
+// to a key range, which lets VTGate route range predicates
+// to the shards that cover it.
+type RangeMapper interface {
+	SingleColumn
+	// MapRange returns the destination of the values between start
+	// and end. A NULL start or end leaves the range open on that side.
+	// The bounds are in the range, unless excludeStart or excludeEnd
+	// is set.
+	MapRange(vcursor VCursor, start, end sqltypes.Value, excludeStart, excludeEnd bool) (key.Destination, error)
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"sort"
+	"time"
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/sqltypes"
@@ -90,6 +91,15 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+
+	// Source is the table a reference table is copied from.
+	Source *Table `json:"-"`
+	// ReferencedBy contains the reference tables that
+	// are copied from this table, by keyspace.
+	ReferencedBy map[string]*Table `json:"-"`
+	// ResultCacheTTL is how long the results of the queries
+	// that read the table can be cached. 0 disables caching.
+	ResultCacheTTL time.Duration `json:"result_cache_ttl,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -167,6 +177,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema, err error) {
 		Keyspaces:      make(map[string]*KeyspaceSchema),
 	}
 	buildKeyspaces(source, vschema)
+	resolveReferences(source, vschema)
 	resolveAutoIncrement(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
@@ -174,8 +185,8 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema, err error) {
 }
 
 // BuildKeyspaceSchema builds the vschema portion for one keyspace.
-// The build ignores sequence references because those dependencies can
-// go cross-keyspace.
+// The build ignores sequence references and the sources of reference
+// tables because those dependencies can go cross-keyspace.
 func BuildKeyspaceSchema(input *vschemapb.Keyspace, keyspace string) (*KeyspaceSchema, error) {
 	if input == nil {
 		input = &vschemapb.Keyspace{}
@@ -241,6 +252,14 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
 		}
+		if table.Source != "" {
+			if table.Type != TypeReference {
+				return fmt.Errorf("source is only allowed for reference tables: %s", tname)
+			}
+			if table.Source == keyspace.Name {
+				return fmt.Errorf("reference table %s cannot be copied from its own keyspace", tname)
+			}
+		}
 		switch table.Type {
 		case "", TypeReference:
 			t.Type = table.Type
@@ -252,6 +271,13 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		default:
 			return fmt.Errorf("unidentified table type %s", table.Type)
 		}
+		if table.ResultCacheTtl != "" {
+			ttl, err := time.ParseDuration(table.ResultCacheTtl)
+			if err != nil || ttl < 0 {
+				return fmt.Errorf("invalid result_cache_ttl %q for table: %s", table.ResultCacheTtl, tname)
+			}
+			t.ResultCacheTTL = ttl
+		}
 		if table.Pinned != "" {
 			decoded, err := hex.DecodeString(table.Pinned)
 			if err != nil {
@@ -330,8 +356,9 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		t.Ordered = colVindexSorted(t.ColumnVindexes)
 
 		// Add the table to the map entries.
-		// If the keyspace requires explicit routing, don't include it in global routing
-		if !ks.RequireExplicitRouting {
+		// If the keyspace requires explicit routing, don't include it in global routing.
+		// The copies of a table are left out too: their name resolves to their source.
+		if !ks.RequireExplicitRouting && table.Source == "" {
 			if _, ok := vschema.uniqueTables[tname]; ok {
 				vschema.uniqueTables[tname] = nil
 			} else {
@@ -343,6 +370,64 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 	return nil
 }
 
+// resolveReferences links the reference tables to the tables they are copied from.
+func resolveReferences(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	for ksname, ks := range source.Keyspaces {
+		ksvschema := vschema.Keyspaces[ksname]
+		for tname, table := range ks.Tables {
+			t := ksvschema.Tables[tname]
+			if t == nil || table.Source == "" {
+				continue
+			}
+			src, err := findReferenceSource(source, vschema, table.Source, tname)
+			if err != nil {
+				// Better to remove the table than to leave it partially initialized.
+				delete(ksvschema.Tables, tname)
+				ksvschema.Error = fmt.Errorf("cannot resolve source of reference table %s: %v", tname, err)
+				continue
+			}
+			t.Source = src
+			if src.ReferencedBy == nil {
+				src.ReferencedBy = make(map[string]*Table)
+			}
+			src.ReferencedBy[ksname] = t
+		}
+	}
+}
+
+// findReferenceSource returns the table named tname of the source keyspace.
+// The table doesn't have to be in the vschema of the keyspace, as it is
+// unsharded: it's added to it if it isn't.
+func findReferenceSource(source *vschemapb.SrvVSchema, vschema *VSchema, keyspace, tname string) (*Table, error) {
+	ksvschema, ok := vschema.Keyspaces[keyspace]
+	if !ok {
+		return nil, fmt.Errorf("keyspace %s not found in vschema", keyspace)
+	}
+	if ksvschema.Keyspace.Sharded {
+		return nil, fmt.Errorf("source keyspace %s is sharded", keyspace)
+	}
+	ks := source.Keyspaces[keyspace]
+	if ks.Tables[tname].GetSource() != "" {
+		return nil, fmt.Errorf("table %s.%s is itself a copy", keyspace, tname)
+	}
+	if t := ksvschema.Tables[tname]; t != nil {
+		return t, nil
+	}
+	t := &Table{
+		Name:     sqlparser.NewTableIdent(tname),
+		Keyspace: ksvschema.Keyspace,
+	}
+	ksvschema.Tables[tname] = t
+	if !ks.RequireExplicitRouting {
+		if _, ok := vschema.uniqueTables[tname]; ok {
+			vschema.uniqueTables[tname] = nil
+		} else {
+			vschema.uniqueTables[tname] = t
+		}
+	}
+	return t, nil
+}
+
 func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	for ksname, ks := range source.Keyspaces {
 		ksvschema := vschema.Keyspaces[ksname]
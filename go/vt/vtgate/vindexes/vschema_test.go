@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -2114,6 +2115,149 @@ func TestBadShardedSequence(t *testing.T) {
 	}
 }
 
+func TestReferenceTableSource(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"main": {
+				Tables: map[string]*vschemapb.Table{
+					"declared": {},
+				},
+			},
+			"sharded1": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"declared":   {Type: "reference", Source: "main"},
+					"undeclared": {Type: "reference", Source: "main"},
+				},
+			},
+			"sharded2": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"declared": {Type: "reference", Source: "main"},
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	for _, ks := range vschema.Keyspaces {
+		require.NoError(t, ks.Error)
+	}
+
+	declared := vschema.Keyspaces["main"].Tables["declared"]
+	assert.Equal(t, map[string]*Table{
+		"sharded1": vschema.Keyspaces["sharded1"].Tables["declared"],
+		"sharded2": vschema.Keyspaces["sharded2"].Tables["declared"],
+	}, declared.ReferencedBy)
+	assert.Equal(t, declared, vschema.Keyspaces["sharded1"].Tables["declared"].Source)
+	assert.Equal(t, declared, vschema.Keyspaces["sharded2"].Tables["declared"].Source)
+
+	// The source is added to the unsharded keyspace if it's not in its vschema.
+	undeclared := vschema.Keyspaces["main"].Tables["undeclared"]
+	require.NotNil(t, undeclared)
+	assert.Equal(t, "main", undeclared.Keyspace.Name)
+	assert.Equal(t, undeclared, vschema.Keyspaces["sharded1"].Tables["undeclared"].Source)
+
+	// The copies don't make the table names ambiguous.
+	got, err := vschema.FindTable("", "declared")
+	require.NoError(t, err)
+	assert.Equal(t, declared, got)
+	got, err = vschema.FindTable("", "undeclared")
+	require.NoError(t, err)
+	assert.Equal(t, undeclared, got)
+	got, err = vschema.FindTable("sharded2", "declared")
+	require.NoError(t, err)
+	assert.Equal(t, TypeReference, got.Type)
+	assert.Equal(t, "sharded2", got.Keyspace.Name)
+}
+
+func TestBadReferenceTableSource(t *testing.T) {
+	testcases := []struct {
+		source string
+		table  *vschemapb.Table
+		err    string
+	}{{
+		source: "main",
+		table:  &vschemapb.Table{Source: "main"},
+		err:    "source is only allowed for reference tables: t1",
+	}, {
+		source: "sharded",
+		table:  &vschemapb.Table{Type: "reference", Source: "sharded"},
+		err:    "reference table t1 cannot be copied from its own keyspace",
+	}, {
+		source: "other",
+		table:  &vschemapb.Table{Type: "reference", Source: "other"},
+		err:    "cannot resolve source of reference table t1: keyspace other not found in vschema",
+	}, {
+		source: "sharded2",
+		table:  &vschemapb.Table{Type: "reference", Source: "sharded2"},
+		err:    "cannot resolve source of reference table t1: source keyspace sharded2 is sharded",
+	}, {
+		source: "copy",
+		table:  &vschemapb.Table{Type: "reference", Source: "copy"},
+		err:    "cannot resolve source of reference table t1: table copy.t1 is itself a copy",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.source, func(t *testing.T) {
+			input := vschemapb.SrvVSchema{
+				Keyspaces: map[string]*vschemapb.Keyspace{
+					"main": {},
+					"copy": {
+						Tables: map[string]*vschemapb.Table{
+							"t1": {Type: "reference", Source: "main"},
+						},
+					},
+					"sharded": {
+						Sharded: true,
+						Tables: map[string]*vschemapb.Table{
+							"t1": tcase.table,
+						},
+					},
+					"sharded2": {
+						Sharded: true,
+					},
+				},
+			}
+			vschema, err := BuildVSchema(&input)
+			require.NoError(t, err)
+			ks := vschema.Keyspaces["sharded"]
+			require.EqualError(t, ks.Error, tcase.err)
+			assert.Nil(t, ks.Tables["t1"])
+		})
+	}
+}
+
+func TestTableResultCacheTTL(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"cached":   {ResultCacheTtl: "1m30s"},
+					"uncached": {},
+					"bad":      {ResultCacheTtl: "often"},
+				},
+			},
+			"unsharded2": {
+				Tables: map[string]*vschemapb.Table{
+					"negative": {ResultCacheTtl: "-1s"},
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	assert.EqualError(t, vschema.Keyspaces["unsharded"].Error, "invalid result_cache_ttl \"often\" for table: bad")
+	assert.EqualError(t, vschema.Keyspaces["unsharded2"].Error, "invalid result_cache_ttl \"-1s\" for table: negative")
+
+	delete(input.Keyspaces["unsharded"].Tables, "bad")
+	vschema, err = BuildVSchema(&input)
+	require.NoError(t, err)
+	ks := vschema.Keyspaces["unsharded"]
+	require.NoError(t, ks.Error)
+	assert.Equal(t, 90*time.Second, ks.Tables["cached"].ResultCacheTTL)
+	assert.Zero(t, ks.Tables["uncached"].ResultCacheTTL)
+}
+
 func TestFindTable(t *testing.T) {
 	input := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -19,6 +19,7 @@ package vtgate
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -27,6 +28,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
 
@@ -37,7 +39,17 @@ var _ VSchemaOperator = (*VSchemaManager)(nil)
 type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
+	cell              string
 	currentSrvVschema *vschemapb.SrvVSchema
+	// schema is nil if the schema of the tables is not tracked.
+	schema SchemaInfo
+}
+
+// SchemaInfo is the interface to the schema of the tables,
+// as tracked from the tablets.
+type SchemaInfo interface {
+	// Tables returns the columns of the tables of a keyspace.
+	Tables(keyspace string) map[string][]vindexes.Column
 }
 
 //GetCurrentVschema return the denormalized VSchema from SrvVSchema
@@ -65,6 +77,7 @@ func (vm *VSchemaManager) GetCurrentSrvVschema() *vschemapb.SrvVSchema {
 // This function will wait until the first value has either been processed
 // or triggered an error before returning.
 func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
+	vm.cell = cell
 	vm.e.serv.WatchSrvVSchema(ctx, cell, func(v *vschemapb.SrvVSchema, err error) {
 		// Create a closure to save the vschema. If the value
 		// passed is nil, it means we encountered an error and
@@ -89,46 +102,121 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 
 		// keep a copy of the latest SrvVschema
 		vm.mu.Lock()
+		defer vm.mu.Unlock()
 		vm.currentSrvVschema = v
-		vm.mu.Unlock()
-
-		// Transform the provided SrvVSchema into a VSchema.
-		var vschema *vindexes.VSchema
-		if v != nil {
-			vschema, err = vindexes.BuildVSchema(v)
-			if err != nil {
-				log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", cell, err)
-				err = fmt.Errorf("error creating VSchema for cell %v: %v", cell, err)
-				if vschemaCounters != nil {
-					vschemaCounters.Add("Parsing", 1)
-				}
-			}
-		}
-		if v == nil {
-			// We encountered an error, build an empty vschema.
-			vschema, _ = vindexes.BuildVSchema(&vschemapb.SrvVSchema{})
-		}
 
-		// Build the display version. At this point, three cases:
-		// - v is nil, vschema is empty, and err is set:
-		//     1. when the watch returned an error.
-		//     2. when BuildVSchema failed.
-		// - v is set, vschema is full, and err is nil:
-		//     3. when everything worked.
-		errorMessage := ""
+		vm.buildAndSaveVSchema(v, err)
+	})
+}
+
+// Rebuild builds the vschema again from the latest SrvVSchema.
+// It is called when the tracked schema of some tables changed.
+func (vm *VSchemaManager) Rebuild() {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	if vm.currentSrvVschema == nil {
+		return
+	}
+	vm.buildAndSaveVSchema(vm.currentSrvVschema, nil)
+}
+
+func (vm *VSchemaManager) setSchema(schema SchemaInfo) {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	vm.schema = schema
+}
+
+// buildAndSaveVSchema must be called while holding a lock on vm.mu,
+// so that the vschema is built from the latest SrvVSchema.
+func (vm *VSchemaManager) buildAndSaveVSchema(v *vschemapb.SrvVSchema, err error) {
+	// Transform the provided SrvVSchema into a VSchema.
+	var vschema *vindexes.VSchema
+	if v != nil {
+		vschema, err = vindexes.BuildVSchema(vm.addTrackedTables(v))
 		if err != nil {
-			errorMessage = err.Error()
+			log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", vm.cell, err)
+			err = fmt.Errorf("error creating VSchema for cell %v: %v", vm.cell, err)
+			if vschemaCounters != nil {
+				vschemaCounters.Add("Parsing", 1)
+			}
 		}
-		stats := NewVSchemaStats(vschema, errorMessage)
+	}
+	if v == nil {
+		// We encountered an error, build an empty vschema.
+		vschema, _ = vindexes.BuildVSchema(&vschemapb.SrvVSchema{})
+	}
 
-		// save our value. if there was an error, then keep the
-		// existing vschema instead of overwriting it.
-		if v == nil && vm.e.vschema != nil {
-			vschema = vm.e.vschema
-		}
+	// Build the display version. At this point, three cases:
+	// - v is nil, vschema is empty, and err is set:
+	//     1. when the watch returned an error.
+	//     2. when BuildVSchema failed.
+	// - v is set, vschema is full, and err is nil:
+	//     3. when everything worked.
+	errorMessage := ""
+	if err != nil {
+		errorMessage = err.Error()
+	}
+	stats := NewVSchemaStats(vschema, errorMessage)
 
-		vm.e.SaveVSchema(vschema, stats)
-	})
+	// save our value. if there was an error, then keep the
+	// existing vschema instead of overwriting it.
+	if v == nil && vm.e.vschema != nil {
+		vschema = vm.e.vschema
+	}
+
+	vm.e.SaveVSchema(vschema, stats)
+}
+
+// addTrackedTables returns a copy of the SrvVSchema with the columns
+// of the tracked tables. The tables that don't have an authoritative
+// column list get the tracked columns, but keep the types declared
+// in the vschema. The tracked tables that are missing from an
+// unsharded keyspace are added to it, unless another keyspace
+// has a table with the same name, as that would make the
+// table name ambiguous.
+func (vm *VSchemaManager) addTrackedTables(v *vschemapb.SrvVSchema) *vschemapb.SrvVSchema {
+	if vm.schema == nil {
+		return v
+	}
+	v = proto.Clone(v).(*vschemapb.SrvVSchema)
+	keyspacesByTable := make(map[string]int)
+	for _, ks := range v.Keyspaces {
+		for name := range ks.Tables {
+			keyspacesByTable[name]++
+		}
+	}
+	for ksName, ks := range v.Keyspaces {
+		for name, columns := range vm.schema.Tables(ksName) {
+			table := ks.Tables[name]
+			if table == nil {
+				if ks.Sharded || keyspacesByTable[name] != 0 {
+					continue
+				}
+				table = &vschemapb.Table{}
+				if ks.Tables == nil {
+					ks.Tables = make(map[string]*vschemapb.Table)
+				}
+				ks.Tables[name] = table
+			}
+			if table.ColumnListAuthoritative || table.Type == vindexes.TypeSequence {
+				continue
+			}
+			declared := make(map[string]querypb.Type)
+			for _, col := range table.Columns {
+				declared[strings.ToLower(col.Name)] = col.Type
+			}
+			table.Columns = make([]*vschemapb.Column, 0, len(columns))
+			for _, col := range columns {
+				typ := col.Type
+				if declaredType := declared[col.Name.Lowered()]; declaredType != querypb.Type_NULL_TYPE {
+					typ = declaredType
+				}
+				table.Columns = append(table.Columns, &vschemapb.Column{Name: col.Name.String(), Type: typ})
+			}
+			table.ColumnListAuthoritative = true
+		}
+	}
+	return v
 }
 
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type fakeSchemaInfo map[string]map[string][]vindexes.Column
+
+func (f fakeSchemaInfo) Tables(keyspace string) map[string][]vindexes.Column {
+	return f[keyspace]
+}
+
+func column(name string, typ querypb.Type) vindexes.Column {
+	return vindexes.Column{Name: sqlparser.NewColIdent(name), Type: typ}
+}
+
+func TestVSchemaManagerTrackedTables(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.vm.setSchema(fakeSchemaInfo{
+		"TestExecutor": {
+			"user": {
+				column("id", sqltypes.Int64),
+				column("textcol", sqltypes.VarBinary),
+				column("name", sqltypes.VarChar),
+			},
+			"not_in_vschema": {column("id", sqltypes.Int64)},
+		},
+		KsTestUnsharded: {
+			"simple":  {column("id", sqltypes.Int64)},
+			"tracked": {column("id", sqltypes.Int64), column("val", sqltypes.Blob)},
+			"user":    {column("id", sqltypes.Int64)},
+		},
+	})
+	executor.vm.Rebuild()
+
+	vschema := executor.VSchema()
+	sharded := vschema.Keyspaces["TestExecutor"]
+	// The type declared in the vschema takes precedence.
+	assert.Equal(t, []vindexes.Column{
+		column("id", sqltypes.Int64),
+		column("textcol", sqltypes.VarChar),
+		column("name", sqltypes.VarChar),
+	}, sharded.Tables["user"].Columns)
+	assert.True(t, sharded.Tables["user"].ColumnListAuthoritative)
+	// The tables can't be added to a sharded keyspace, as they have no vindex.
+	assert.Nil(t, sharded.Tables["not_in_vschema"])
+
+	unsharded := vschema.Keyspaces[KsTestUnsharded]
+	assert.Equal(t, []vindexes.Column{column("id", sqltypes.Int64)}, unsharded.Tables["simple"].Columns)
+	table, err := vschema.FindTable("", "tracked")
+	require.NoError(t, err)
+	assert.Equal(t, KsTestUnsharded, table.Keyspace.Name)
+	assert.Equal(t, []vindexes.Column{column("id", sqltypes.Int64), column("val", sqltypes.Blob)}, table.Columns)
+	assert.True(t, table.ColumnListAuthoritative)
+	// The table would become ambiguous if it was added.
+	assert.Nil(t, unsharded.Tables["user"])
+
+	// The SrvVSchema isn't changed, as it is the one saved by the vschema DDLs.
+	srvVSchema := executor.vm.GetCurrentSrvVschema()
+	assert.False(t, srvVSchema.Keyspaces["TestExecutor"].Tables["user"].ColumnListAuthoritative)
+	assert.Nil(t, srvVSchema.Keyspaces[KsTestUnsharded].Tables["tracked"])
+}
@@ -43,6 +43,8 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	"vitess.io/vitess/go/vt/vtgate/quota"
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -80,6 +82,17 @@ var (
 
 	// lockHeartbeatTime is used to set the next heartbeat time.
 	lockHeartbeatTime = flag.Duration("lock_heartbeat_time", 5*time.Second, "If there is lock function used. This will keep the lock connection active by using this heartbeat")
+
+	// consistentSnapshotTimeout is how long the consistent snapshots of a transaction wait to lock the masters of its shards, and for its replicas to reach their positions.
+	consistentSnapshotTimeout = flag.Duration("consistent_snapshot_timeout", 10*time.Second, "The maximum time to wait for the global read locks on the masters of the shards, and for the replicas to reach the GTID sets of their masters, when a session starts consistent snapshots on several shards at a common cut. The commits on the masters wait while they are locked. The app user of the tablets needs the RELOAD privilege.")
+
+	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker. vtgate then loads the columns of the tables from the master tablets, and reloads them when the tablets signal that their schema changed. The tables that don't have an authoritative column list in the vschema get the tracked columns.")
+
+	resultCacheMemory  = flag.Int64("result_cache_memory", 0, "The amount of memory in bytes that the results of the selects on the tables that have a result_cache_ttl in the vschema are cached in. The results are invalidated with the changes streamed from the master tablets. Zero disables the result cache.")
+	resultCacheMaxRows = flag.Int("result_cache_max_rows", 1000, "The maximum number of rows of a result that is cached by the result cache.")
+
+	queryQuotasCell = flag.String("query_quotas_cell", "global", "The topo cell of the query quotas file.")
+	queryQuotasPath = flag.String("query_quotas_path", "", "The path of the JSON file in the topo that configures the quotas of the rate and the concurrency of the queries of the callers and the query tags. vtgate applies the changes to the file as soon as they are made. Disabled if empty.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -187,8 +200,49 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		LFU:            *queryPlanCacheLFU,
 	}
 
+	executor := NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *streamBufferSize, cacheCfg)
+	lookupCaches := newLookupCaches(vsm.VStream)
+	executor.setLookupCaches(lookupCaches)
+	servenv.OnTerm(lookupCaches.stop)
+	if *resultCacheMemory > 0 {
+		rc := newResultCache(vsm.VStream, *resultCacheMemory, *resultCacheMaxRows)
+		executor.setResultCache(rc)
+		servenv.OnTerm(rc.stop)
+		stats.NewGaugeFunc("ResultCacheLength", "Result cache length", func() int64 {
+			return int64(rc.results.Len())
+		})
+		stats.NewGaugeFunc("ResultCacheSize", "Result cache size", rc.results.UsedCapacity)
+		stats.NewGaugeFunc("ResultCacheCapacity", "Result cache capacity", rc.results.MaxCapacity)
+		stats.NewCounterFunc("ResultCacheEvictions", "Result cache evictions", rc.results.Evictions)
+	}
+	if *queryQuotasPath != "" {
+		ts, err := serv.GetTopoServer()
+		if err != nil {
+			log.Fatalf("Unable to get the topo server for the query quotas: %v", err)
+		}
+		conn, err := ts.ConnForCell(ctx, *queryQuotasCell)
+		if err != nil {
+			log.Fatalf("Unable to get the topo connection for the query quotas: %v", err)
+		}
+		executor.quotas = quota.New()
+		watcher := quota.NewWatcher(executor.quotas, conn, *queryQuotasPath)
+		watcher.Start()
+		servenv.OnTerm(watcher.Stop)
+	}
+	executor.tableStats = vtschema.NewTracker(gw.hc.Subscribe())
+	executor.tableStats.RegisterSignalReceiver(executor.tableStatsChanged)
+	executor.tableStats.Start()
+	servenv.OnTerm(executor.tableStats.Stop)
+	if *enableSchemaChangeSignal {
+		columns := vtschema.NewColumnTracker(gw.hc.Subscribe())
+		executor.vm.setSchema(columns)
+		columns.RegisterSignalReceiver(executor.vm.Rebuild)
+		columns.Start()
+		servenv.OnTerm(columns.Stop)
+	}
+
 	rpcVTGate = &VTGate{
-		executor: NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *streamBufferSize, cacheCfg),
+		executor: executor,
 		resolver: resolver,
 		vsm:      vsm,
 		txConn:   tc,
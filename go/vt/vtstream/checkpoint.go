@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtstream
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/log"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// CheckpointStore stores the positions of streams, by name.
+type CheckpointStore interface {
+	// Load returns the position of a stream, or nil if it has none.
+	Load(ctx context.Context, name string) (*binlogdatapb.VGtid, error)
+	// Save stores the position of a stream.
+	Save(ctx context.Context, name string, vgtid *binlogdatapb.VGtid) error
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// CheckpointStoreFactory returns a CheckpointStore, given the location of
+// its data. The meaning of the location depends on the store.
+type CheckpointStoreFactory func(location string) (CheckpointStore, error)
+
+var checkpointStoreFactories = make(map[string]CheckpointStoreFactory)
+
+// RegisterCheckpointStore registers a CheckpointStoreFactory by name. It's
+// meant to be called from the init function of the package of the store.
+func RegisterCheckpointStore(name string, factory CheckpointStoreFactory) {
+	if checkpointStoreFactories[name] != nil {
+		log.Fatalf("Duplicate checkpoint store registration for %v", name)
+	}
+	checkpointStoreFactories[name] = factory
+}
+
+// NewCheckpointStore returns a CheckpointStore of a registered type.
+func NewCheckpointStore(name, location string) (CheckpointStore, error) {
+	factory, ok := checkpointStoreFactories[name]
+	if !ok {
+		var names []string
+		for name := range checkpointStoreFactories {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown checkpoint store %s, must be one of %v", name, names)
+	}
+	return factory(location)
+}
+
+func init() {
+	RegisterCheckpointStore("file", func(location string) (CheckpointStore, error) {
+		return NewFileCheckpointStore(location)
+	})
+	RegisterCheckpointStore("memory", func(string) (CheckpointStore, error) {
+		return NewMemoryCheckpointStore(), nil
+	})
+}
+
+// FileCheckpointStore stores each position as a JSON file in a directory.
+// The files are replaced atomically.
+type FileCheckpointStore struct {
+	dir string
+}
+
+// NewFileCheckpointStore returns a FileCheckpointStore that stores the
+// positions in dir, which is created if needed.
+func NewFileCheckpointStore(dir string) (*FileCheckpointStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("the file checkpoint store needs a directory")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileCheckpointStore{dir: dir}, nil
+}
+
+func (s *FileCheckpointStore) path(name string) string {
+	return filepath.Join(s.dir, name+".json")
+}
+
+// Load is part of the CheckpointStore interface.
+func (s *FileCheckpointStore) Load(ctx context.Context, name string) (*binlogdatapb.VGtid, error) {
+	data, err := ioutil.ReadFile(s.path(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vgtid := &binlogdatapb.VGtid{}
+	if err := json2.Unmarshal(data, vgtid); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %v", s.path(name), err)
+	}
+	return vgtid, nil
+}
+
+// Save is part of the CheckpointStore interface.
+func (s *FileCheckpointStore) Save(ctx context.Context, name string, vgtid *binlogdatapb.VGtid) error {
+	data, err := json2.MarshalIndentPB(vgtid, "  ")
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(s.dir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path(name))
+}
+
+// Close is part of the CheckpointStore interface.
+func (s *FileCheckpointStore) Close() error {
+	return nil
+}
+
+// MemoryCheckpointStore stores the positions in memory. The positions are
+// lost when the process exits.
+type MemoryCheckpointStore struct {
+	mu     sync.Mutex
+	vgtids map[string]*binlogdatapb.VGtid
+}
+
+// NewMemoryCheckpointStore returns an empty MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{vgtids: make(map[string]*binlogdatapb.VGtid)}
+}
+
+// Load is part of the CheckpointStore interface.
+func (s *MemoryCheckpointStore) Load(ctx context.Context, name string) (*binlogdatapb.VGtid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	vgtid, ok := s.vgtids[name]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(vgtid).(*binlogdatapb.VGtid), nil
+}
+
+// Save is part of the CheckpointStore interface.
+func (s *MemoryCheckpointStore) Save(ctx context.Context, name string, vgtid *binlogdatapb.VGtid) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vgtids[name] = proto.Clone(vgtid).(*binlogdatapb.VGtid)
+	return nil
+}
+
+// Close is part of the CheckpointStore interface.
+func (s *MemoryCheckpointStore) Close() error {
+	return nil
+}
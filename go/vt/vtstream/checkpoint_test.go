@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtstream
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestCheckpointStores(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vtstream")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ctx := context.Background()
+	vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{
+		{Keyspace: "ks", Shard: "-80", Gtid: "MySQL56/a:1-10"},
+		{Keyspace: "ks", Shard: "80-", Gtid: "MySQL56/b:1-5", TablePKs: []*binlogdatapb.TableLastPK{{TableName: "t1"}}},
+	}}
+	for _, name := range []string{"file", "memory"} {
+		t.Run(name, func(t *testing.T) {
+			store, err := NewCheckpointStore(name, dir)
+			require.NoError(t, err)
+			defer store.Close()
+
+			got, err := store.Load(ctx, "s1")
+			require.NoError(t, err)
+			assert.Nil(t, got)
+
+			require.NoError(t, store.Save(ctx, "s1", vgtid))
+			got, err = store.Load(ctx, "s1")
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(vgtid, got), "got %v, want %v", got, vgtid)
+
+			got, err = store.Load(ctx, "s2")
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		})
+	}
+
+	// The file store keeps the positions across instances.
+	store, err := NewFileCheckpointStore(dir)
+	require.NoError(t, err)
+	got, err := store.Load(ctx, "s1")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(vgtid, got), "got %v, want %v", got, vgtid)
+
+	_, err = NewCheckpointStore("etcd", "")
+	assert.EqualError(t, err, "unknown checkpoint store etcd, must be one of [file memory]")
+}
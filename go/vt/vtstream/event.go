@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vtstream turns the events of a VTGate VStream into change events
+// in the JSON envelope of Debezium, and writes them to a sink. The position
+// of the stream is checkpointed after each transaction, so that a restarted
+// stream resumes where it left off.
+package vtstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Op is the operation of a change event, with the codes of Debezium.
+type Op string
+
+// The operations of change events.
+const (
+	OpCreate Op = "c"
+	OpUpdate Op = "u"
+	OpDelete Op = "d"
+	// OpRead is the operation of the rows read while copying a table.
+	OpRead Op = "r"
+)
+
+// Format is the format of the messages written to the sinks.
+type Format string
+
+// The supported formats.
+const (
+	// FormatDebezium is the Debezium envelope, as produced by the Debezium
+	// connectors with schemas disabled.
+	FormatDebezium Format = "debezium"
+	// FormatCloudEvents is the Debezium envelope, as the data of a
+	// CloudEvent in the structured JSON format.
+	FormatCloudEvents Format = "cloudevents"
+)
+
+// connector is the name of the connector in the source of change events.
+const connector = "vitess"
+
+// Row is a row of a table, encoded as a JSON object with the columns in the
+// order of the table.
+type Row struct {
+	Fields []*querypb.Field
+	Values []sqltypes.Value
+}
+
+// MarshalJSON encodes NULL as null, integers and floats as numbers, binary
+// values in base64, and the other values as strings, like Debezium.
+func (r *Row) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, field := range r.Fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(jsonValue(r.Values[i]))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func jsonValue(v sqltypes.Value) interface{} {
+	switch {
+	case v.IsNull():
+		return nil
+	case v.IsIntegral(), v.IsFloat():
+		return json.Number(v.ToString())
+	case v.IsBinary(), v.Type() == sqltypes.Bit:
+		return v.ToBytes()
+	}
+	return v.ToString()
+}
+
+// keyRow returns the primary key columns of a row, or nil if the table has
+// no primary key.
+func keyRow(fields []*querypb.Field, values []sqltypes.Value) *Row {
+	key := &Row{}
+	for i, field := range fields {
+		if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) != 0 {
+			key.Fields = append(key.Fields, field)
+			key.Values = append(key.Values, values[i])
+		}
+	}
+	if len(key.Fields) == 0 {
+		return nil
+	}
+	return key
+}
+
+// ChangeEvent is the change of a row.
+type ChangeEvent struct {
+	// Name is the logical name of the stream, which prefixes the topics.
+	Name     string
+	Keyspace string
+	Shard    string
+	Table    string
+	Op       Op
+	// Before is nil for inserts and After is nil for deletes.
+	Before *Row
+	After  *Row
+	// Key is the primary key of the row, or nil if the table has none.
+	Key *Row
+	// Snapshot is true for the rows read while copying a table.
+	Snapshot bool
+	// Timestamp is the time of the transaction in the binlog.
+	Timestamp time.Time
+	// VGtid is the position of the stream after the transaction.
+	VGtid *binlogdatapb.VGtid
+	// Index is the index of the change in the transaction.
+	Index int
+}
+
+// Topic returns the topic of the event: the name of the stream, the
+// keyspace and the table, separated by dots.
+func (e *ChangeEvent) Topic() string {
+	return fmt.Sprintf("%s.%s.%s", e.Name, e.Keyspace, e.Table)
+}
+
+// shardGtid returns the position of the shard of the event,
+// or nil if it's not in the position of the stream.
+func (e *ChangeEvent) shardGtid() *binlogdatapb.ShardGtid {
+	for _, sgtid := range e.VGtid.GetShardGtids() {
+		if sgtid.Keyspace == e.Keyspace && sgtid.Shard == e.Shard {
+			return sgtid
+		}
+	}
+	return nil
+}
+
+// id returns the ID of the CloudEvent of the event. The GTID of a shard
+// doesn't change while its tables are copied, so the ID of a copied row
+// also has the table and the last primary key copied, which changes with
+// each batch of rows.
+func (e *ChangeEvent) id() (string, error) {
+	sgtid := e.shardGtid()
+	id := fmt.Sprintf("%s:%s", e.Shard, sgtid.GetGtid())
+	if e.Snapshot {
+		lastPK, err := tableLastPK(sgtid, e.Table)
+		if err != nil {
+			return "", err
+		}
+		id = fmt.Sprintf("%s:%s:%s", id, e.Table, lastPK)
+	}
+	return fmt.Sprintf("%s:%d", id, e.Index), nil
+}
+
+// tableLastPK returns the JSON object of the last primary key copied from a
+// table, or an empty string if the position doesn't have one: the table has
+// been copied.
+func tableLastPK(sgtid *binlogdatapb.ShardGtid, table string) (string, error) {
+	for _, tablePK := range sgtid.GetTablePKs() {
+		if tablePK.TableName != table {
+			continue
+		}
+		result := sqltypes.Proto3ToResult(tablePK.Lastpk)
+		if result == nil || len(result.Rows) == 0 {
+			return "", nil
+		}
+		lastPK, err := json.Marshal(&Row{Fields: result.Fields, Values: result.Rows[0]})
+		if err != nil {
+			return "", err
+		}
+		return string(lastPK), nil
+	}
+	return "", nil
+}
+
+type shardPosition struct {
+	Keyspace string `json:"keyspace"`
+	Shard    string `json:"shard"`
+	Gtid     string `json:"gtid"`
+}
+
+type source struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	DB        string `json:"db"`
+	Keyspace  string `json:"keyspace"`
+	Shard     string `json:"shard"`
+	Table     string `json:"table"`
+	// Vgtid is the JSON encoding of the position, as a string.
+	Vgtid string `json:"vgtid"`
+}
+
+type envelope struct {
+	Before *Row    `json:"before"`
+	After  *Row    `json:"after"`
+	Source *source `json:"source"`
+	Op     Op      `json:"op"`
+	TsMs   int64   `json:"ts_ms"`
+}
+
+type cloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            string    `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            *envelope `json:"data"`
+}
+
+func (e *ChangeEvent) envelope(now time.Time) (*envelope, error) {
+	var positions []shardPosition
+	for _, sgtid := range e.VGtid.GetShardGtids() {
+		positions = append(positions, shardPosition{Keyspace: sgtid.Keyspace, Shard: sgtid.Shard, Gtid: sgtid.Gtid})
+	}
+	vgtid, err := json.Marshal(positions)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := "false"
+	if e.Snapshot {
+		snapshot = "true"
+	}
+	return &envelope{
+		Before: e.Before,
+		After:  e.After,
+		Source: &source{
+			Connector: connector,
+			Name:      e.Name,
+			TsMs:      e.Timestamp.UnixNano() / int64(time.Millisecond),
+			Snapshot:  snapshot,
+			DB:        e.Keyspace,
+			Keyspace:  e.Keyspace,
+			Shard:     e.Shard,
+			Table:     e.Table,
+			Vgtid:     string(vgtid),
+		},
+		Op:   e.Op,
+		TsMs: now.UnixNano() / int64(time.Millisecond),
+	}, nil
+}
+
+// Encode returns the key and the value of the message of the event, in the
+// format. The key is the JSON object of the primary key columns, or nil.
+// now is the time at which the event is processed.
+func (e *ChangeEvent) Encode(format Format, now time.Time) (key, value []byte, err error) {
+	if e.Key != nil {
+		if key, err = json.Marshal(e.Key); err != nil {
+			return nil, nil, err
+		}
+	}
+	env, err := e.envelope(now)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch format {
+	case FormatDebezium:
+		value, err = json.Marshal(env)
+	case FormatCloudEvents:
+		var id string
+		if id, err = e.id(); err != nil {
+			return nil, nil, err
+		}
+		value, err = json.Marshal(&cloudEvent{
+			SpecVersion:     "1.0",
+			ID:              id,
+			Source:          fmt.Sprintf("/vitess/%s/%s/%s", e.Name, e.Keyspace, e.Shard),
+			Type:            "io.vitess.vtstream.change",
+			Subject:         e.Table,
+			Time:            e.Timestamp.UTC().Format(time.RFC3339),
+			DataContentType: "application/json",
+			Data:            env,
+		})
+	default:
+		return nil, nil, fmt.Errorf("unsupported format %s", format)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtstream
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var testFields = []*querypb.Field{
+	{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG)},
+	{Name: "price", Type: sqltypes.Decimal},
+	{Name: "ratio", Type: sqltypes.Float64},
+	{Name: "name", Type: sqltypes.VarChar},
+	{Name: "data", Type: sqltypes.VarBinary},
+	{Name: "note", Type: sqltypes.Text},
+}
+
+func TestRowMarshalJSON(t *testing.T) {
+	row := &Row{
+		Fields: testFields,
+		Values: []sqltypes.Value{
+			sqltypes.NewInt64(1),
+			sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.50")),
+			sqltypes.NewFloat64(0.5),
+			sqltypes.NewVarChar(`a"b`),
+			sqltypes.NewVarBinary("\x00\x01"),
+			sqltypes.NULL,
+		},
+	}
+	got, err := row.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1,"price":"1.50","ratio":0.5,"name":"a\"b","data":"AAE=","note":null}`, string(got))
+
+	key := keyRow(row.Fields, row.Values)
+	got, err = key.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(got))
+	assert.Nil(t, keyRow(testFields[1:], row.Values[1:]))
+}
+
+func TestChangeEventEncode(t *testing.T) {
+	fields := testFields[:1]
+	event := &ChangeEvent{
+		Name:      "cdc",
+		Keyspace:  "ks",
+		Shard:     "-80",
+		Table:     "t1",
+		Op:        OpUpdate,
+		Before:    &Row{Fields: fields, Values: []sqltypes.Value{sqltypes.NewInt64(1)}},
+		After:     &Row{Fields: fields, Values: []sqltypes.Value{sqltypes.NewInt64(2)}},
+		Key:       &Row{Fields: fields, Values: []sqltypes.Value{sqltypes.NewInt64(2)}},
+		Timestamp: time.Unix(1600000000, 0),
+		VGtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{
+			{Keyspace: "ks", Shard: "-80", Gtid: "MySQL56/a:1-10"},
+			{Keyspace: "ks", Shard: "80-", Gtid: "MySQL56/b:1-5"},
+		}},
+		Index: 3,
+	}
+	assert.Equal(t, "cdc.ks.t1", event.Topic())
+	now := time.Unix(1600000001, 0)
+
+	key, value, err := event.Encode(FormatDebezium, now)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":2}`, string(key))
+	want := `{"before":{"id":1},"after":{"id":2},` +
+		`"source":{"connector":"vitess","name":"cdc","ts_ms":1600000000000,"snapshot":"false","db":"ks","keyspace":"ks","shard":"-80","table":"t1",` +
+		`"vgtid":"[{\"keyspace\":\"ks\",\"shard\":\"-80\",\"gtid\":\"MySQL56/a:1-10\"},{\"keyspace\":\"ks\",\"shard\":\"80-\",\"gtid\":\"MySQL56/b:1-5\"}]"},` +
+		`"op":"u","ts_ms":1600000001000}`
+	assert.Equal(t, want, string(value))
+
+	_, value, err = event.Encode(FormatCloudEvents, now)
+	require.NoError(t, err)
+	assert.Equal(t, `{"specversion":"1.0","id":"-80:MySQL56/a:1-10:3","source":"/vitess/cdc/ks/-80","type":"io.vitess.vtstream.change",`+
+		`"subject":"t1","time":"2020-09-13T12:26:40Z","datacontenttype":"application/json","data":`+want+`}`, string(value))
+
+	event.Op, event.After, event.Key = OpDelete, nil, nil
+	key, value, err = event.Encode(FormatDebezium, now)
+	require.NoError(t, err)
+	assert.Nil(t, key)
+	assert.Contains(t, string(value), `"after":null`)
+
+	_, _, err = event.Encode("avro", now)
+	assert.EqualError(t, err, "unsupported format avro")
+}
+
+func TestChangeEventID(t *testing.T) {
+	lastPK := func(id int64) *binlogdatapb.VGtid {
+		return &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Shard:    "-80",
+			Gtid:     "MySQL56/a:1-10",
+			TablePKs: []*binlogdatapb.TableLastPK{{
+				TableName: "t1",
+				Lastpk:    sqltypes.ResultToProto3(sqltypes.MakeTestResult(testFields[:1], fmt.Sprint(id))),
+			}},
+		}}}
+	}
+	event := &ChangeEvent{Keyspace: "ks", Shard: "-80", Table: "t1", Snapshot: true, VGtid: lastPK(2)}
+
+	// The index restarts with each batch of copied rows.
+	id, err := event.id()
+	require.NoError(t, err)
+	assert.Equal(t, `-80:MySQL56/a:1-10:t1:{"id":2}:0`, id)
+
+	event.VGtid = lastPK(4)
+	id, err = event.id()
+	require.NoError(t, err)
+	assert.Equal(t, `-80:MySQL56/a:1-10:t1:{"id":4}:0`, id)
+
+	// The last batch of a table has no last primary key.
+	event.VGtid.ShardGtids[0].TablePKs = nil
+	id, err = event.id()
+	require.NoError(t, err)
+	assert.Equal(t, `-80:MySQL56/a:1-10:t1::0`, id)
+}
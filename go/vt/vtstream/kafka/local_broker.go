@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"net"
+	"strconv"
+	"sync"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// LocalBroker is a single Kafka broker that keeps its records in memory.
+// It only supports the requests sent by Producer, and creates topics when
+// their metadata is requested. It's meant to stand in for Kafka in tests.
+type LocalBroker struct {
+	partitions int
+	listener   net.Listener
+	wg         sync.WaitGroup
+
+	mu     sync.Mutex
+	topics map[string][][]Record
+	conns  map[net.Conn]bool
+	// errors are returned instead of handling the next produce requests.
+	errors []KafkaError
+}
+
+// NewLocalBroker starts a LocalBroker on a local port. Topics are created
+// with the specified number of partitions.
+func NewLocalBroker(partitions int) (*LocalBroker, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	b := &LocalBroker{
+		partitions: partitions,
+		listener:   listener,
+		topics:     make(map[string][][]Record),
+		conns:      make(map[net.Conn]bool),
+	}
+	b.wg.Add(1)
+	go b.serve()
+	return b, nil
+}
+
+// Addr returns the host:port of the broker.
+func (b *LocalBroker) Addr() string {
+	return b.listener.Addr().String()
+}
+
+// Records returns the records of a topic partition.
+func (b *LocalBroker) Records(topic string, partition int) []Record {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	partitions := b.topics[topic]
+	if partition >= len(partitions) {
+		return nil
+	}
+	return append([]Record(nil), partitions[partition]...)
+}
+
+// Topics returns the names of the topics.
+func (b *LocalBroker) Topics() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	topics := make([]string, 0, len(b.topics))
+	for topic := range b.topics {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// FailProduce makes the next produce requests fail with err, once for
+// each time it's called.
+func (b *LocalBroker) FailProduce(err KafkaError) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.errors = append(b.errors, err)
+}
+
+// Close stops the broker and closes its connections.
+func (b *LocalBroker) Close() {
+	b.listener.Close()
+	b.mu.Lock()
+	for conn := range b.conns {
+		conn.Close()
+	}
+	b.mu.Unlock()
+	b.wg.Wait()
+}
+
+func (b *LocalBroker) serve() {
+	defer b.wg.Done()
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		b.mu.Lock()
+		b.conns[conn] = true
+		b.mu.Unlock()
+		b.wg.Add(1)
+		go b.handleConn(conn)
+	}
+}
+
+func (b *LocalBroker) handleConn(conn net.Conn) {
+	defer func() {
+		b.mu.Lock()
+		delete(b.conns, conn)
+		b.mu.Unlock()
+		conn.Close()
+		b.wg.Done()
+	}()
+	for {
+		buf, err := readMessage(conn)
+		if err != nil {
+			return
+		}
+		d := &decoder{buf: buf}
+		header := requestHeader{
+			apiKey:        d.int16(),
+			apiVersion:    d.int16(),
+			correlationID: d.int32(),
+			clientID:      d.string(),
+		}
+		if d.err != nil {
+			log.Errorf("kafka: invalid request header: %v", d.err)
+			return
+		}
+
+		e := &encoder{}
+		e.int32(0)
+		e.int32(header.correlationID)
+		switch {
+		case header.apiKey == apiKeyMetadata && header.apiVersion == metadataVersion:
+			topics := decodeMetadataRequest(d)
+			if d.err != nil {
+				log.Errorf("kafka: invalid metadata request: %v", d.err)
+				return
+			}
+			encodeMetadataResponse(e, b.metadata(topics))
+		case header.apiKey == apiKeyProduce && header.apiVersion == produceVersion:
+			req, err := decodeProduceRequest(d)
+			if err != nil {
+				log.Errorf("kafka: invalid produce request: %v", err)
+				return
+			}
+			encodeProduceResponse(e, b.produce(req))
+		default:
+			log.Errorf("kafka: unsupported request %d version %d", header.apiKey, header.apiVersion)
+			return
+		}
+		e.putInt32(0, int32(len(e.buf)-4))
+		if _, err := conn.Write(e.buf); err != nil {
+			return
+		}
+	}
+}
+
+func (b *LocalBroker) metadata(topics []string) *metadataResponse {
+	host, portStr, _ := net.SplitHostPort(b.Addr())
+	port, _ := strconv.Atoi(portStr)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	resp := &metadataResponse{
+		brokers: []brokerMetadata{{nodeID: 0, host: host, port: int32(port)}},
+	}
+	for _, topic := range topics {
+		if _, ok := b.topics[topic]; !ok {
+			b.topics[topic] = make([][]Record, b.partitions)
+		}
+		metadata := topicMetadata{name: topic}
+		for i := range b.topics[topic] {
+			metadata.partitions = append(metadata.partitions, partitionMetadata{index: int32(i), leader: 0})
+		}
+		resp.topics = append(resp.topics, metadata)
+	}
+	return resp
+}
+
+func (b *LocalBroker) produce(req *produceRequest) *produceResponse {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var injected KafkaError
+	if len(b.errors) != 0 {
+		injected = b.errors[0]
+		b.errors = b.errors[1:]
+	}
+	resp := &produceResponse{}
+	for _, topic := range req.topics {
+		topicResp := produceTopicResponse{name: topic.name}
+		partitions := b.topics[topic.name]
+		for _, partition := range topic.partitions {
+			partitionResp := producePartitionResponse{index: partition.index, baseOffset: -1}
+			switch {
+			case injected != errNone:
+				partitionResp.errorCode = int16(injected)
+			case partition.index < 0 || int(partition.index) >= len(partitions):
+				partitionResp.errorCode = errUnknownTopicOrPartiton
+			default:
+				stored := partitions[partition.index]
+				partitionResp.baseOffset = int64(len(stored))
+				for _, record := range partition.records {
+					record.Offset = int64(len(stored))
+					stored = append(stored, record)
+				}
+				partitions[partition.index] = stored
+			}
+			topicResp.partitions = append(topicResp.partitions, partitionResp)
+		}
+		resp.topics = append(resp.topics, topicResp)
+	}
+	return resp
+}
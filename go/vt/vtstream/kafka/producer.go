@@ -0,0 +1,453 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Message is a message to produce to a topic.
+type Message struct {
+	Topic string
+	// Key is used to pick the partition of the message. Messages without a
+	// key are spread across partitions.
+	Key   []byte
+	Value []byte
+}
+
+// ProducerConfig configures a Producer.
+type ProducerConfig struct {
+	// ClientID is sent to the brokers with every request.
+	ClientID string
+	// Timeout bounds the time the brokers wait for the replicas to
+	// acknowledge a produce request.
+	Timeout time.Duration
+	// MaxRetries is the number of times a produce request is retried when
+	// it fails with a retriable error.
+	MaxRetries int
+	// RetryBackoff is the time to wait before retrying.
+	RetryBackoff time.Duration
+}
+
+// DefaultProducerConfig returns the default configuration of a Producer.
+func DefaultProducerConfig() ProducerConfig {
+	return ProducerConfig{
+		ClientID:     "vtstream",
+		Timeout:      30 * time.Second,
+		MaxRetries:   5,
+		RetryBackoff: 500 * time.Millisecond,
+	}
+}
+
+// Producer produces messages to Kafka, waiting for all the in-sync replicas
+// to acknowledge them. It's safe for concurrent use.
+type Producer struct {
+	config    ProducerConfig
+	bootstrap []string
+
+	mu         sync.Mutex
+	brokers    map[int32]string
+	topics     map[string][]partitionMetadata
+	conns      map[string]*brokerConn
+	roundRobin uint32
+}
+
+// NewProducer returns a Producer that discovers the cluster from the
+// bootstrap brokers, given as host:port.
+func NewProducer(bootstrap []string, config ProducerConfig) (*Producer, error) {
+	if len(bootstrap) == 0 {
+		return nil, errors.New("kafka: no bootstrap brokers")
+	}
+	return &Producer{
+		config:    config,
+		bootstrap: bootstrap,
+		brokers:   make(map[int32]string),
+		topics:    make(map[string][]partitionMetadata),
+		conns:     make(map[string]*brokerConn),
+	}, nil
+}
+
+// Produce produces the messages, and returns once they're acknowledged.
+// The messages of a partition are appended in order. If it fails, some of
+// the messages may have been produced.
+func (p *Producer) Produce(ctx context.Context, messages []Message) error {
+	for attempt := 0; ; attempt++ {
+		remaining, err := p.produce(ctx, messages)
+		if err == nil {
+			return nil
+		}
+		if attempt >= p.config.MaxRetries || !isRetriable(err) {
+			return err
+		}
+		p.invalidateMetadata()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(p.config.RetryBackoff):
+		}
+		messages = remaining
+	}
+}
+
+// Close closes the connections to the brokers.
+func (p *Producer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for addr, conn := range p.conns {
+		conn.close()
+		delete(p.conns, addr)
+	}
+	return nil
+}
+
+// isRetriable returns true if a request that failed with err may succeed
+// after the metadata is refreshed and the connections reopened.
+func isRetriable(err error) bool {
+	var kerr KafkaError
+	if errors.As(err, &kerr) {
+		return kerr.retriable()
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr) || errors.Is(err, errConnClosed)
+}
+
+type partitionKey struct {
+	topic     string
+	partition int32
+}
+
+// produce sends the messages to the leaders of their partitions. It returns
+// the messages of the partitions that failed.
+func (p *Producer) produce(ctx context.Context, messages []Message) ([]Message, error) {
+	topics := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, msg := range messages {
+		if !seen[msg.Topic] {
+			seen[msg.Topic] = true
+			topics = append(topics, msg.Topic)
+		}
+	}
+	if err := p.loadMetadata(ctx, topics); err != nil {
+		return messages, err
+	}
+
+	// Group the messages by leader, then by partition, preserving their
+	// order within a partition.
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	byLeader := make(map[string]map[partitionKey][]Record)
+	byPartition := make(map[partitionKey][]Message)
+	var partitionOrder []partitionKey
+	p.mu.Lock()
+	for _, msg := range messages {
+		partitions := p.topics[msg.Topic]
+		var index int32
+		if msg.Key != nil {
+			index = partitionForKey(msg.Key, len(partitions))
+		} else {
+			index = int32(p.roundRobin % uint32(len(partitions)))
+			p.roundRobin++
+		}
+		partition := partitions[index]
+		addr, ok := p.brokers[partition.leader]
+		if !ok {
+			p.mu.Unlock()
+			return messages, KafkaError(errLeaderNotAvailable)
+		}
+		key := partitionKey{topic: msg.Topic, partition: partition.index}
+		if byLeader[addr] == nil {
+			byLeader[addr] = make(map[partitionKey][]Record)
+		}
+		if _, ok := byPartition[key]; !ok {
+			partitionOrder = append(partitionOrder, key)
+		}
+		byLeader[addr][key] = append(byLeader[addr][key], Record{Key: msg.Key, Value: msg.Value, Timestamp: now})
+		byPartition[key] = append(byPartition[key], msg)
+	}
+	p.mu.Unlock()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failed   = make(map[partitionKey]bool)
+		firstErr error
+	)
+	for addr, records := range byLeader {
+		wg.Add(1)
+		go func(addr string, records map[partitionKey][]Record) {
+			defer wg.Done()
+			failedPartitions, err := p.produceToBroker(ctx, addr, records)
+			mu.Lock()
+			defer mu.Unlock()
+			for _, key := range failedPartitions {
+				failed[key] = true
+			}
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}(addr, records)
+	}
+	wg.Wait()
+
+	var remaining []Message
+	for _, key := range partitionOrder {
+		if failed[key] {
+			remaining = append(remaining, byPartition[key]...)
+		}
+	}
+	return remaining, firstErr
+}
+
+// produceToBroker sends a produce request to a broker. It returns the
+// partitions that failed.
+func (p *Producer) produceToBroker(ctx context.Context, addr string, records map[partitionKey][]Record) ([]partitionKey, error) {
+	allPartitions := func() []partitionKey {
+		keys := make([]partitionKey, 0, len(records))
+		for key := range records {
+			keys = append(keys, key)
+		}
+		return keys
+	}
+
+	req := &produceRequest{
+		acks:      -1,
+		timeoutMs: int32(p.config.Timeout / time.Millisecond),
+	}
+	topicIndex := make(map[string]int)
+	for key, recs := range records {
+		i, ok := topicIndex[key.topic]
+		if !ok {
+			i = len(req.topics)
+			topicIndex[key.topic] = i
+			req.topics = append(req.topics, produceTopic{name: key.topic})
+		}
+		req.topics[i].partitions = append(req.topics[i].partitions, producePartition{index: key.partition, records: recs})
+	}
+
+	conn, err := p.conn(addr)
+	if err != nil {
+		return allPartitions(), err
+	}
+	d, err := conn.roundTrip(ctx, apiKeyProduce, produceVersion, func(e *encoder) {
+		encodeProduceRequest(e, req)
+	})
+	if err != nil {
+		p.closeConn(addr, conn)
+		return allPartitions(), err
+	}
+	resp, err := decodeProduceResponse(d)
+	if err != nil {
+		p.closeConn(addr, conn)
+		return allPartitions(), err
+	}
+
+	acked := make(map[partitionKey]bool)
+	var failed []partitionKey
+	for _, topic := range resp.topics {
+		for _, partition := range topic.partitions {
+			key := partitionKey{topic: topic.name, partition: partition.index}
+			if partition.errorCode != errNone {
+				if err == nil {
+					err = fmt.Errorf("topic %s partition %d: %w", topic.name, partition.index, KafkaError(partition.errorCode))
+				}
+				continue
+			}
+			acked[key] = true
+		}
+	}
+	for key := range records {
+		if !acked[key] {
+			failed = append(failed, key)
+		}
+	}
+	if len(failed) != 0 && err == nil {
+		err = KafkaError(errRequestTimedOut)
+	}
+	return failed, err
+}
+
+// loadMetadata loads the metadata of the topics that aren't known yet.
+func (p *Producer) loadMetadata(ctx context.Context, topics []string) error {
+	p.mu.Lock()
+	var missing []string
+	for _, topic := range topics {
+		if len(p.topics[topic]) == 0 {
+			missing = append(missing, topic)
+		}
+	}
+	addrs := make([]string, 0, len(p.brokers)+len(p.bootstrap))
+	for _, addr := range p.brokers {
+		addrs = append(addrs, addr)
+	}
+	addrs = append(addrs, p.bootstrap...)
+	p.mu.Unlock()
+	if len(missing) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, addr := range addrs {
+		var resp *metadataResponse
+		resp, err = p.fetchMetadata(ctx, addr, missing)
+		if err != nil {
+			continue
+		}
+		p.mu.Lock()
+		for _, broker := range resp.brokers {
+			p.brokers[broker.nodeID] = net.JoinHostPort(broker.host, strconv.Itoa(int(broker.port)))
+		}
+		for _, topic := range resp.topics {
+			if topic.errorCode != errNone {
+				if err == nil {
+					err = fmt.Errorf("topic %s: %w", topic.name, KafkaError(topic.errorCode))
+				}
+				continue
+			}
+			partitions := make([]partitionMetadata, len(topic.partitions))
+			for _, partition := range topic.partitions {
+				if partition.index < 0 || int(partition.index) >= len(partitions) {
+					continue
+				}
+				partitions[partition.index] = partition
+			}
+			p.topics[topic.name] = partitions
+		}
+		for _, topic := range missing {
+			if len(p.topics[topic]) == 0 && err == nil {
+				err = fmt.Errorf("topic %s: %w", topic, KafkaError(errUnknownTopicOrPartiton))
+			}
+		}
+		p.mu.Unlock()
+		return err
+	}
+	return err
+}
+
+func (p *Producer) fetchMetadata(ctx context.Context, addr string, topics []string) (*metadataResponse, error) {
+	conn, err := p.conn(addr)
+	if err != nil {
+		return nil, err
+	}
+	d, err := conn.roundTrip(ctx, apiKeyMetadata, metadataVersion, func(e *encoder) {
+		encodeMetadataRequest(e, topics)
+	})
+	if err != nil {
+		p.closeConn(addr, conn)
+		return nil, err
+	}
+	return decodeMetadataResponse(d)
+}
+
+// invalidateMetadata forgets the partitions of the topics, so that they're
+// loaded again before the next request.
+func (p *Producer) invalidateMetadata() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.topics = make(map[string][]partitionMetadata)
+}
+
+func (p *Producer) conn(addr string) (*brokerConn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if conn, ok := p.conns[addr]; ok {
+		return conn, nil
+	}
+	conn, err := dialBroker(addr, p.config.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	p.conns[addr] = conn
+	return conn, nil
+}
+
+func (p *Producer) closeConn(addr string, conn *brokerConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conns[addr] == conn {
+		delete(p.conns, addr)
+	}
+	conn.close()
+}
+
+var errConnClosed = errors.New("kafka: connection closed")
+
+// brokerConn is a connection to a broker. Requests are sent one at a time.
+type brokerConn struct {
+	clientID string
+
+	mu            sync.Mutex
+	conn          net.Conn
+	correlationID int32
+}
+
+func dialBroker(addr, clientID string) (*brokerConn, error) {
+	conn, err := net.DialTimeout("tcp", addr, 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &brokerConn{clientID: clientID, conn: conn}, nil
+}
+
+// roundTrip sends a request and returns a decoder positioned at the body of
+// the response.
+func (c *brokerConn) roundTrip(ctx context.Context, apiKey, apiVersion int16, body func(e *encoder)) (*decoder, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return nil, errConnClosed
+	}
+	c.correlationID++
+	header := requestHeader{
+		apiKey:        apiKey,
+		apiVersion:    apiVersion,
+		correlationID: c.correlationID,
+		clientID:      c.clientID,
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Minute)
+	}
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	if _, err := c.conn.Write(encodeRequest(header, body)); err != nil {
+		return nil, err
+	}
+	buf, err := readMessage(c.conn)
+	if err != nil {
+		return nil, err
+	}
+	d := &decoder{buf: buf}
+	if correlationID := d.int32(); correlationID != c.correlationID {
+		return nil, fmt.Errorf("kafka: got correlation id %d, want %d", correlationID, c.correlationID)
+	}
+	return d, d.err
+}
+
+func (c *brokerConn) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+}
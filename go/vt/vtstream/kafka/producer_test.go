@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMurmur2(t *testing.T) {
+	// The hashes computed by the Java client.
+	testcases := map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	}
+	for in, want := range testcases {
+		assert.Equal(t, want, murmur2([]byte(in)), in)
+	}
+}
+
+func TestRecordBatch(t *testing.T) {
+	records := []Record{
+		{Key: []byte("k1"), Value: []byte("v1"), Timestamp: 1000},
+		{Key: nil, Value: []byte("v2"), Timestamp: 1005},
+		{Key: []byte("k3"), Value: nil, Timestamp: 1001},
+	}
+	batch := encodeRecordBatch(records)
+	got, err := decodeRecordBatches(batch)
+	require.NoError(t, err)
+	for i := range records {
+		records[i].Offset = int64(i)
+	}
+	assert.Equal(t, records, got)
+
+	// Corrupt the value of the last record.
+	batch[len(batch)-2] ^= 0xff
+	_, err = decodeRecordBatches(batch)
+	assert.EqualError(t, err, "kafka: corrupt record batch")
+}
+
+func newTestProducer(t *testing.T, broker *LocalBroker) *Producer {
+	config := DefaultProducerConfig()
+	config.RetryBackoff = time.Millisecond
+	producer, err := NewProducer([]string{broker.Addr()}, config)
+	require.NoError(t, err)
+	return producer
+}
+
+func TestProducer(t *testing.T) {
+	broker, err := NewLocalBroker(4)
+	require.NoError(t, err)
+	defer broker.Close()
+	producer := newTestProducer(t, broker)
+	defer producer.Close()
+
+	var messages []Message
+	for i := 0; i < 20; i++ {
+		messages = append(messages, Message{
+			Topic: "t1",
+			Key:   []byte(fmt.Sprintf("key%d", i%5)),
+			Value: []byte(fmt.Sprintf("value%d", i)),
+		})
+	}
+	messages = append(messages, Message{Topic: "t2", Value: []byte("nokey")})
+	require.NoError(t, producer.Produce(context.Background(), messages))
+
+	assert.ElementsMatch(t, []string{"t1", "t2"}, broker.Topics())
+	total := 0
+	for partition := 0; partition < 4; partition++ {
+		records := broker.Records("t1", partition)
+		total += len(records)
+		// Records of a key are in the partition of the key, in order.
+		last := map[string]int{}
+		for i, record := range records {
+			assert.Equal(t, int64(i), record.Offset)
+			assert.Equal(t, int32(partition), partitionForKey(record.Key, 4))
+			var n int
+			_, err := fmt.Sscanf(string(record.Value), "value%d", &n)
+			require.NoError(t, err)
+			if prev, ok := last[string(record.Key)]; ok {
+				assert.Less(t, prev, n)
+			}
+			last[string(record.Key)] = n
+		}
+	}
+	assert.Equal(t, 20, total)
+
+	total = 0
+	for partition := 0; partition < 4; partition++ {
+		for _, record := range broker.Records("t2", partition) {
+			total++
+			assert.Nil(t, record.Key)
+			assert.Equal(t, "nokey", string(record.Value))
+		}
+	}
+	assert.Equal(t, 1, total)
+}
+
+func TestProducerRetry(t *testing.T) {
+	broker, err := NewLocalBroker(1)
+	require.NoError(t, err)
+	defer broker.Close()
+	producer := newTestProducer(t, broker)
+	defer producer.Close()
+
+	broker.FailProduce(KafkaError(errNotLeaderForPartition))
+	broker.FailProduce(KafkaError(errLeaderNotAvailable))
+	require.NoError(t, producer.Produce(context.Background(), []Message{{Topic: "t1", Key: []byte("k"), Value: []byte("v")}}))
+	records := broker.Records("t1", 0)
+	require.Len(t, records, 1)
+	assert.Equal(t, "v", string(records[0].Value))
+
+	// Errors that aren't retriable are returned.
+	broker.FailProduce(KafkaError(errUnsupportedVersion))
+	err = producer.Produce(context.Background(), []Message{{Topic: "t1", Value: []byte("v")}})
+	assert.EqualError(t, err, "topic t1 partition 0: kafka: unsupported version")
+	assert.Len(t, broker.Records("t1", 0), 1)
+}
@@ -0,0 +1,695 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafka implements the subset of the Kafka wire protocol that is
+// needed to produce records: Metadata v1 and Produce v3 requests, with
+// records in the v2 record batch format. It also provides a local broker
+// that speaks the same subset, as a stand-in for Kafka in tests.
+package kafka
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// API keys and versions of the supported requests.
+const (
+	apiKeyProduce  = 0
+	apiKeyMetadata = 3
+
+	produceVersion  = 3
+	metadataVersion = 1
+
+	recordBatchMagic = 2
+)
+
+// Error codes of the protocol.
+const (
+	errNone                   = 0
+	errUnknownTopicOrPartiton = 3
+	errLeaderNotAvailable     = 5
+	errNotLeaderForPartition  = 6
+	errRequestTimedOut        = 7
+	errUnsupportedVersion     = 35
+)
+
+// maxMessageSize bounds the size of the requests and responses that are read.
+const maxMessageSize = 100 << 20
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// Record is a record of a topic partition.
+type Record struct {
+	Key   []byte
+	Value []byte
+	// Timestamp is in milliseconds since the epoch.
+	Timestamp int64
+	// Offset is assigned by the broker. It's ignored when producing.
+	Offset int64
+}
+
+// KafkaError is an error code returned by a broker.
+type KafkaError int16
+
+func (e KafkaError) Error() string {
+	switch e {
+	case errUnknownTopicOrPartiton:
+		return "kafka: unknown topic or partition"
+	case errLeaderNotAvailable:
+		return "kafka: leader not available"
+	case errNotLeaderForPartition:
+		return "kafka: not leader for partition"
+	case errRequestTimedOut:
+		return "kafka: request timed out"
+	case errUnsupportedVersion:
+		return "kafka: unsupported version"
+	}
+	return fmt.Sprintf("kafka: error code %d", int16(e))
+}
+
+// retriable returns true if a request that failed with the error may succeed
+// after the metadata is refreshed.
+func (e KafkaError) retriable() bool {
+	switch e {
+	case errUnknownTopicOrPartiton, errLeaderNotAvailable, errNotLeaderForPartition, errRequestTimedOut:
+		return true
+	}
+	return false
+}
+
+// encoder appends the primitive types of the protocol to a buffer.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) int8(v int8) {
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *encoder) int16(v int16) {
+	e.buf = append(e.buf, byte(v>>8), byte(v))
+}
+
+func (e *encoder) int32(v int32) {
+	e.buf = append(e.buf, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (e *encoder) int64(v int64) {
+	e.int32(int32(v >> 32))
+	e.int32(int32(v))
+}
+
+func (e *encoder) varint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	e.buf = append(e.buf, b[:n]...)
+}
+
+func (e *encoder) string(v string) {
+	e.int16(int16(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *encoder) nullableString(v *string) {
+	if v == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*v)
+}
+
+func (e *encoder) bytes(v []byte) {
+	if v == nil {
+		e.int32(-1)
+		return
+	}
+	e.int32(int32(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// varintBytes appends the length of v as a varint, then v. A nil v is
+// encoded with a length of -1.
+func (e *encoder) varintBytes(v []byte) {
+	if v == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *encoder) putInt32(offset int, v int32) {
+	binary.BigEndian.PutUint32(e.buf[offset:], uint32(v))
+}
+
+var errShortBuffer = errors.New("kafka: message is too short")
+
+// decoder reads the primitive types of the protocol from a buffer. Once it
+// fails, it keeps returning zero values, and err is set.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.buf) {
+		d.err = errShortBuffer
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) int8() int8 {
+	b := d.next(1)
+	if b == nil {
+		return 0
+	}
+	return int8(b[0])
+}
+
+func (d *decoder) int16() int16 {
+	b := d.next(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (d *decoder) int32() int32 {
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (d *decoder) int64() int64 {
+	b := d.next(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errShortBuffer
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+	return d.next(int(n))
+}
+
+func (d *decoder) varintBytes() []byte {
+	n := d.varint()
+	if n < 0 {
+		return nil
+	}
+	return d.next(int(n))
+}
+
+// arrayLen reads the length of an array, which must fit in the rest of
+// the buffer given the minimum size of its elements.
+func (d *decoder) arrayLen(minElementSize int) int {
+	n := d.int32()
+	if n < 0 {
+		return 0
+	}
+	if int(n)*minElementSize > len(d.buf) {
+		d.err = errShortBuffer
+		return 0
+	}
+	return int(n)
+}
+
+// requestHeader is the header of a request, with version 1.
+type requestHeader struct {
+	apiKey        int16
+	apiVersion    int16
+	correlationID int32
+	clientID      string
+}
+
+// encodeRequest returns a request prefixed with its size, given its body.
+func encodeRequest(header requestHeader, body func(e *encoder)) []byte {
+	e := &encoder{}
+	e.int32(0)
+	e.int16(header.apiKey)
+	e.int16(header.apiVersion)
+	e.int32(header.correlationID)
+	e.string(header.clientID)
+	body(e)
+	e.putInt32(0, int32(len(e.buf)-4))
+	return e.buf
+}
+
+// readMessage reads a message prefixed with its size.
+func readMessage(r io.Reader) ([]byte, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return nil, err
+	}
+	n := int32(binary.BigEndian.Uint32(size[:]))
+	if n < 0 || n > maxMessageSize {
+		return nil, fmt.Errorf("kafka: invalid message size %d", n)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// brokerMetadata is a broker of the cluster.
+type brokerMetadata struct {
+	nodeID int32
+	host   string
+	port   int32
+}
+
+// partitionMetadata is a partition of a topic.
+type partitionMetadata struct {
+	errorCode int16
+	index     int32
+	leader    int32
+}
+
+// topicMetadata is a topic of the cluster.
+type topicMetadata struct {
+	errorCode  int16
+	name       string
+	partitions []partitionMetadata
+}
+
+// metadataResponse is the body of a Metadata v1 response.
+type metadataResponse struct {
+	brokers []brokerMetadata
+	topics  []topicMetadata
+}
+
+func encodeMetadataRequest(e *encoder, topics []string) {
+	e.int32(int32(len(topics)))
+	for _, topic := range topics {
+		e.string(topic)
+	}
+}
+
+func decodeMetadataRequest(d *decoder) []string {
+	n := d.arrayLen(2)
+	topics := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		topics = append(topics, d.string())
+	}
+	return topics
+}
+
+func encodeMetadataResponse(e *encoder, resp *metadataResponse) {
+	e.int32(int32(len(resp.brokers)))
+	for _, broker := range resp.brokers {
+		e.int32(broker.nodeID)
+		e.string(broker.host)
+		e.int32(broker.port)
+		e.nullableString(nil)
+	}
+	// controller_id
+	if len(resp.brokers) > 0 {
+		e.int32(resp.brokers[0].nodeID)
+	} else {
+		e.int32(-1)
+	}
+	e.int32(int32(len(resp.topics)))
+	for _, topic := range resp.topics {
+		e.int16(topic.errorCode)
+		e.string(topic.name)
+		// is_internal
+		e.int8(0)
+		e.int32(int32(len(topic.partitions)))
+		for _, partition := range topic.partitions {
+			e.int16(partition.errorCode)
+			e.int32(partition.index)
+			e.int32(partition.leader)
+			// replica_nodes and isr_nodes
+			e.int32(1)
+			e.int32(partition.leader)
+			e.int32(1)
+			e.int32(partition.leader)
+		}
+	}
+}
+
+func decodeMetadataResponse(d *decoder) (*metadataResponse, error) {
+	resp := &metadataResponse{}
+	n := d.arrayLen(12)
+	for i := 0; i < n; i++ {
+		broker := brokerMetadata{
+			nodeID: d.int32(),
+			host:   d.string(),
+			port:   d.int32(),
+		}
+		// rack
+		d.string()
+		resp.brokers = append(resp.brokers, broker)
+	}
+	// controller_id
+	d.int32()
+	n = d.arrayLen(9)
+	for i := 0; i < n; i++ {
+		topic := topicMetadata{
+			errorCode: d.int16(),
+			name:      d.string(),
+		}
+		// is_internal
+		d.int8()
+		m := d.arrayLen(18)
+		for j := 0; j < m; j++ {
+			partition := partitionMetadata{
+				errorCode: d.int16(),
+				index:     d.int32(),
+				leader:    d.int32(),
+			}
+			// replica_nodes and isr_nodes
+			for k := d.arrayLen(4); k > 0; k-- {
+				d.int32()
+			}
+			for k := d.arrayLen(4); k > 0; k-- {
+				d.int32()
+			}
+			topic.partitions = append(topic.partitions, partition)
+		}
+		resp.topics = append(resp.topics, topic)
+	}
+	return resp, d.err
+}
+
+// produceRequest is the body of a Produce v3 request.
+type produceRequest struct {
+	acks      int16
+	timeoutMs int32
+	topics    []produceTopic
+}
+
+type produceTopic struct {
+	name       string
+	partitions []producePartition
+}
+
+type producePartition struct {
+	index   int32
+	records []Record
+}
+
+// produceResponse is the body of a Produce v3 response.
+type produceResponse struct {
+	topics []produceTopicResponse
+}
+
+type produceTopicResponse struct {
+	name       string
+	partitions []producePartitionResponse
+}
+
+type producePartitionResponse struct {
+	index      int32
+	errorCode  int16
+	baseOffset int64
+}
+
+func encodeProduceRequest(e *encoder, req *produceRequest) {
+	// transactional_id
+	e.nullableString(nil)
+	e.int16(req.acks)
+	e.int32(req.timeoutMs)
+	e.int32(int32(len(req.topics)))
+	for _, topic := range req.topics {
+		e.string(topic.name)
+		e.int32(int32(len(topic.partitions)))
+		for _, partition := range topic.partitions {
+			e.int32(partition.index)
+			e.bytes(encodeRecordBatch(partition.records))
+		}
+	}
+}
+
+func decodeProduceRequest(d *decoder) (*produceRequest, error) {
+	req := &produceRequest{}
+	// transactional_id
+	d.string()
+	req.acks = d.int16()
+	req.timeoutMs = d.int32()
+	n := d.arrayLen(6)
+	for i := 0; i < n; i++ {
+		topic := produceTopic{name: d.string()}
+		m := d.arrayLen(8)
+		for j := 0; j < m; j++ {
+			partition := producePartition{index: d.int32()}
+			batch := d.bytes()
+			if d.err != nil {
+				return nil, d.err
+			}
+			records, err := decodeRecordBatches(batch)
+			if err != nil {
+				return nil, err
+			}
+			partition.records = records
+			topic.partitions = append(topic.partitions, partition)
+		}
+		req.topics = append(req.topics, topic)
+	}
+	return req, d.err
+}
+
+func encodeProduceResponse(e *encoder, resp *produceResponse) {
+	e.int32(int32(len(resp.topics)))
+	for _, topic := range resp.topics {
+		e.string(topic.name)
+		e.int32(int32(len(topic.partitions)))
+		for _, partition := range topic.partitions {
+			e.int32(partition.index)
+			e.int16(partition.errorCode)
+			e.int64(partition.baseOffset)
+			// log_append_time_ms
+			e.int64(-1)
+		}
+	}
+	// throttle_time_ms
+	e.int32(0)
+}
+
+func decodeProduceResponse(d *decoder) (*produceResponse, error) {
+	resp := &produceResponse{}
+	n := d.arrayLen(6)
+	for i := 0; i < n; i++ {
+		topic := produceTopicResponse{name: d.string()}
+		m := d.arrayLen(22)
+		for j := 0; j < m; j++ {
+			partition := producePartitionResponse{
+				index:      d.int32(),
+				errorCode:  d.int16(),
+				baseOffset: d.int64(),
+			}
+			// log_append_time_ms
+			d.int64()
+			topic.partitions = append(topic.partitions, partition)
+		}
+		resp.topics = append(resp.topics, topic)
+	}
+	// throttle_time_ms
+	d.int32()
+	return resp, d.err
+}
+
+// encodeRecordBatch encodes records as a v2 record batch, without
+// compression, transactions or idempotence.
+func encodeRecordBatch(records []Record) []byte {
+	var firstTimestamp, maxTimestamp int64
+	for i, record := range records {
+		if i == 0 || record.Timestamp < firstTimestamp {
+			firstTimestamp = record.Timestamp
+		}
+		if record.Timestamp > maxTimestamp {
+			maxTimestamp = record.Timestamp
+		}
+	}
+
+	e := &encoder{}
+	// baseOffset
+	e.int64(0)
+	// batchLength, set below
+	e.int32(0)
+	// partitionLeaderEpoch
+	e.int32(-1)
+	e.int8(recordBatchMagic)
+	// crc, set below
+	e.int32(0)
+	crcStart := len(e.buf)
+	// attributes
+	e.int16(0)
+	// lastOffsetDelta
+	e.int32(int32(len(records) - 1))
+	e.int64(firstTimestamp)
+	e.int64(maxTimestamp)
+	// producerId, producerEpoch and baseSequence
+	e.int64(-1)
+	e.int16(-1)
+	e.int32(-1)
+	e.int32(int32(len(records)))
+	for i, record := range records {
+		r := &encoder{}
+		// attributes
+		r.int8(0)
+		r.varint(record.Timestamp - firstTimestamp)
+		r.varint(int64(i))
+		r.varintBytes(record.Key)
+		r.varintBytes(record.Value)
+		// headers
+		r.varint(0)
+		e.varint(int64(len(r.buf)))
+		e.buf = append(e.buf, r.buf...)
+	}
+	e.putInt32(8, int32(len(e.buf)-12))
+	e.putInt32(crcStart-4, int32(crc32.Checksum(e.buf[crcStart:], crc32c)))
+	return e.buf
+}
+
+// decodeRecordBatches decodes the v2 record batches of a records field.
+func decodeRecordBatches(buf []byte) ([]Record, error) {
+	var records []Record
+	for len(buf) > 0 {
+		d := &decoder{buf: buf}
+		baseOffset := d.int64()
+		batchLength := d.int32()
+		batch := d.next(int(batchLength))
+		if d.err != nil {
+			return nil, d.err
+		}
+		buf = d.buf
+
+		d = &decoder{buf: batch}
+		// partitionLeaderEpoch
+		d.int32()
+		if magic := d.int8(); magic != recordBatchMagic {
+			return nil, fmt.Errorf("kafka: unsupported record batch magic %d", magic)
+		}
+		crc := uint32(d.int32())
+		if d.err == nil && crc32.Checksum(d.buf, crc32c) != crc {
+			return nil, errors.New("kafka: corrupt record batch")
+		}
+		if attributes := d.int16(); attributes&0x7 != 0 {
+			return nil, errors.New("kafka: compressed record batches are not supported")
+		}
+		// lastOffsetDelta
+		d.int32()
+		firstTimestamp := d.int64()
+		// maxTimestamp, producerId, producerEpoch and baseSequence
+		d.int64()
+		d.int64()
+		d.int16()
+		d.int32()
+		n := d.arrayLen(7)
+		for i := 0; i < n; i++ {
+			length := d.varint()
+			r := &decoder{buf: d.next(int(length))}
+			// attributes
+			r.int8()
+			timestampDelta := r.varint()
+			offsetDelta := r.varint()
+			record := Record{
+				Key:       r.varintBytes(),
+				Value:     r.varintBytes(),
+				Timestamp: firstTimestamp + timestampDelta,
+				Offset:    baseOffset + offsetDelta,
+			}
+			if r.err != nil {
+				return nil, r.err
+			}
+			records = append(records, record)
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+	}
+	return records, nil
+}
+
+// murmur2 is the hash function of the default partitioner of the Java
+// client, so that records with the same key are produced to the same
+// partition by both.
+func murmur2(data []byte) int32 {
+	const (
+		seed uint32 = 0x9747b28c
+		m    uint32 = 0x5bd1e995
+		r           = 24
+	)
+	length := len(data)
+	h := seed ^ uint32(length)
+	for i := 0; i+4 <= length; i += 4 {
+		k := uint32(data[i]) | uint32(data[i+1])<<8 | uint32(data[i+2])<<16 | uint32(data[i+3])<<24
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+	tail := length &^ 3
+	switch length % 4 {
+	case 3:
+		h ^= uint32(data[tail+2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(data[tail+1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(data[tail])
+		h *= m
+	}
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return int32(h)
+}
+
+// partitionForKey returns the partition of a key among n partitions.
+func partitionForKey(key []byte, n int) int32 {
+	return (murmur2(key) & 0x7fffffff) % int32(n)
+}
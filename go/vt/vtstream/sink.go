@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtstream
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"vitess.io/vitess/go/vt/vtstream/kafka"
+)
+
+// Message is a change event, encoded for a sink.
+type Message struct {
+	Topic string
+	// Key is nil for the tables without a primary key.
+	Key   []byte
+	Value []byte
+}
+
+// Sink receives the messages of the change events.
+type Sink interface {
+	// Write writes the messages of a transaction. When it returns, the
+	// messages must be durable, because the position of the stream is
+	// saved after the transaction.
+	Write(ctx context.Context, messages []*Message) error
+	// Close flushes and closes the sink.
+	Close() error
+}
+
+// WriterSink writes the values of the messages to a writer, one per line.
+type WriterSink struct {
+	mu     sync.Mutex
+	w      *bufio.Writer
+	closer io.Closer
+	// sync is called after each write, if it's set.
+	sync func() error
+}
+
+// NewStdoutSink returns a WriterSink that writes to the standard output.
+func NewStdoutSink() *WriterSink {
+	return &WriterSink{w: bufio.NewWriter(os.Stdout)}
+}
+
+// NewFileSink returns a WriterSink that appends to a file, which is created
+// if needed. The file is synced after each write.
+func NewFileSink(path string) (*WriterSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &WriterSink{w: bufio.NewWriter(f), closer: f, sync: f.Sync}, nil
+}
+
+// Write is part of the Sink interface.
+func (s *WriterSink) Write(ctx context.Context, messages []*Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, msg := range messages {
+		if _, err := s.w.Write(msg.Value); err != nil {
+			return err
+		}
+		if err := s.w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	if err := s.w.Flush(); err != nil {
+		return err
+	}
+	if s.sync != nil {
+		return s.sync()
+	}
+	return nil
+}
+
+// Close is part of the Sink interface.
+func (s *WriterSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.w.Flush(); err != nil {
+		return err
+	}
+	if s.closer != nil {
+		return s.closer.Close()
+	}
+	return nil
+}
+
+// KafkaSink produces the messages to Kafka. The messages of a row are
+// produced to the same partition, because their key is its primary key.
+type KafkaSink struct {
+	producer *kafka.Producer
+}
+
+// NewKafkaSink returns a KafkaSink that discovers the cluster from the
+// bootstrap brokers, given as host:port.
+func NewKafkaSink(brokers []string) (*KafkaSink, error) {
+	producer, err := kafka.NewProducer(brokers, kafka.DefaultProducerConfig())
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaSink{producer: producer}, nil
+}
+
+// Write is part of the Sink interface.
+func (s *KafkaSink) Write(ctx context.Context, messages []*Message) error {
+	kmessages := make([]kafka.Message, 0, len(messages))
+	for _, msg := range messages {
+		kmessages = append(kmessages, kafka.Message{Topic: msg.Topic, Key: msg.Key, Value: msg.Value})
+	}
+	return s.producer.Produce(ctx, kmessages)
+}
+
+// Close is part of the Sink interface.
+func (s *KafkaSink) Close() error {
+	return s.producer.Close()
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtstream
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// VStreamer starts a VStream. It's implemented by vtgateconn.VTGateConn.
+type VStreamer interface {
+	VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (vtgateconn.VStreamReader, error)
+}
+
+// Config configures a Streamer.
+type Config struct {
+	// Name is the name of the stream. It prefixes the topics, and
+	// identifies the position in the checkpoint store.
+	Name     string
+	Keyspace string
+	// Shards are the shards to stream from. All the shards of the keyspace
+	// are streamed if it's empty.
+	Shards []string
+	// Position is the position to start from when there's no checkpoint:
+	// "current" to stream the changes from now on, or "" to copy the
+	// tables first. All the shards must be specified to copy the tables.
+	Position   string
+	TabletType topodatapb.TabletType
+	// Filter selects the tables and the columns to stream. All the tables
+	// are streamed if it's nil.
+	Filter *binlogdatapb.Filter
+	Format Format
+	// RetryDelay is the time to wait before restarting a stream that
+	// failed.
+	RetryDelay time.Duration
+}
+
+// Streamer streams the changes of a keyspace to a sink. The position of
+// the stream is saved after the changes of each transaction are written,
+// so changes are delivered at least once: the changes of the last
+// transaction may be written again after a restart.
+type Streamer struct {
+	config    Config
+	vstreamer VStreamer
+	store     CheckpointStore
+	sink      Sink
+	now       func() time.Time
+
+	// The state of the current stream.
+	vgtid  *binlogdatapb.VGtid
+	fields map[string][]*querypb.Field
+	rows   []*binlogdatapb.VEvent
+	events []*ChangeEvent
+	dirty  bool
+}
+
+// NewStreamer returns a Streamer.
+func NewStreamer(config Config, vstreamer VStreamer, store CheckpointStore, sink Sink) (*Streamer, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("the stream needs a name")
+	}
+	if config.Keyspace == "" {
+		return nil, fmt.Errorf("the stream needs a keyspace")
+	}
+	if len(config.Shards) == 0 && config.Position != "current" {
+		return nil, fmt.Errorf("the shards must be specified to start from position %q", config.Position)
+	}
+	switch config.Format {
+	case FormatDebezium, FormatCloudEvents:
+	default:
+		return nil, fmt.Errorf("unsupported format %s", config.Format)
+	}
+	if config.Filter == nil {
+		config.Filter = &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "/.*"}},
+		}
+	}
+	return &Streamer{
+		config:    config,
+		vstreamer: vstreamer,
+		store:     store,
+		sink:      sink,
+		now:       time.Now,
+	}, nil
+}
+
+// Run streams until the context is done. The stream is restarted from the
+// last checkpoint when it fails.
+func (s *Streamer) Run(ctx context.Context) error {
+	for {
+		err := s.stream(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Errorf("Stream %s failed, restarting in %v: %v", s.config.Name, s.config.RetryDelay, err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.config.RetryDelay):
+		}
+	}
+}
+
+// startPosition returns the last checkpoint, or the configured position if
+// there's none.
+func (s *Streamer) startPosition(ctx context.Context) (*binlogdatapb.VGtid, error) {
+	vgtid, err := s.store.Load(ctx, s.config.Name)
+	if err != nil || vgtid != nil {
+		return vgtid, err
+	}
+	vgtid = &binlogdatapb.VGtid{}
+	if len(s.config.Shards) == 0 {
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{
+			Keyspace: s.config.Keyspace,
+			Gtid:     s.config.Position,
+		})
+	}
+	for _, shard := range s.config.Shards {
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{
+			Keyspace: s.config.Keyspace,
+			Shard:    shard,
+			Gtid:     s.config.Position,
+		})
+	}
+	return vgtid, nil
+}
+
+func (s *Streamer) stream(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	vgtid, err := s.startPosition(ctx)
+	if err != nil {
+		return err
+	}
+	s.vgtid = vgtid
+	s.fields = make(map[string][]*querypb.Field)
+	s.rows = nil
+	s.events = nil
+	s.dirty = false
+
+	log.Infof("Starting stream %s at %v", s.config.Name, vgtid)
+	reader, err := s.vstreamer.VStream(ctx, s.config.TabletType, vgtid, s.config.Filter)
+	if err != nil {
+		return err
+	}
+	for {
+		events, err := reader.Recv()
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := s.processEvent(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Streamer) processEvent(ctx context.Context, event *binlogdatapb.VEvent) error {
+	switch event.Type {
+	case binlogdatapb.VEventType_BEGIN:
+		s.rows = nil
+		s.events = nil
+	case binlogdatapb.VEventType_FIELD:
+		s.fields[event.FieldEvent.TableName] = event.FieldEvent.Fields
+	case binlogdatapb.VEventType_ROW:
+		s.rows = append(s.rows, event)
+	case binlogdatapb.VEventType_VGTID:
+		// The position is sent after the rows of a transaction, and before
+		// the DDLs and the other events that aren't in a transaction.
+		if err := s.bufferRows(event.Vgtid); err != nil {
+			return err
+		}
+		s.vgtid = event.Vgtid
+		s.dirty = true
+	case binlogdatapb.VEventType_COMMIT:
+		if err := s.bufferRows(s.vgtid); err != nil {
+			return err
+		}
+		return s.flush(ctx)
+	case binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+		// DDLs aren't sent to the sink, but the position is saved past
+		// them.
+		if len(s.events) == 0 {
+			return s.flush(ctx)
+		}
+	}
+	return nil
+}
+
+// bufferRows turns the buffered rows into change events, given the
+// position after them.
+func (s *Streamer) bufferRows(vgtid *binlogdatapb.VGtid) error {
+	if len(s.rows) == 0 {
+		return nil
+	}
+	shard, snapshot := changedShard(s.vgtid, vgtid)
+	for _, event := range s.rows {
+		fields, ok := s.fields[event.RowEvent.TableName]
+		if !ok {
+			return fmt.Errorf("no fields for table %s", event.RowEvent.TableName)
+		}
+		keyspace, table := s.config.Keyspace, event.RowEvent.TableName
+		if i := strings.IndexByte(table, '.'); i >= 0 {
+			keyspace, table = table[:i], table[i+1:]
+		}
+		for _, change := range event.RowEvent.RowChanges {
+			ce := &ChangeEvent{
+				Name:      s.config.Name,
+				Keyspace:  keyspace,
+				Shard:     shard,
+				Table:     table,
+				Snapshot:  snapshot,
+				Timestamp: time.Unix(event.Timestamp, 0),
+				VGtid:     vgtid,
+				Index:     len(s.events),
+			}
+			var key *Row
+			if change.Before != nil {
+				ce.Before = &Row{Fields: fields, Values: sqltypes.MakeRowTrusted(fields, change.Before)}
+				key = keyRow(fields, ce.Before.Values)
+			}
+			if change.After != nil {
+				ce.After = &Row{Fields: fields, Values: sqltypes.MakeRowTrusted(fields, change.After)}
+				key = keyRow(fields, ce.After.Values)
+			}
+			ce.Key = key
+			switch {
+			case snapshot:
+				ce.Op = OpRead
+			case ce.Before == nil:
+				ce.Op = OpCreate
+			case ce.After == nil:
+				ce.Op = OpDelete
+			default:
+				ce.Op = OpUpdate
+			}
+			s.events = append(s.events, ce)
+		}
+	}
+	s.rows = nil
+	return nil
+}
+
+// flush writes the change events of the transaction to the sink, then
+// saves the position.
+func (s *Streamer) flush(ctx context.Context) error {
+	if len(s.events) != 0 {
+		now := s.now()
+		messages := make([]*Message, 0, len(s.events))
+		for _, event := range s.events {
+			key, value, err := event.Encode(s.config.Format, now)
+			if err != nil {
+				return err
+			}
+			messages = append(messages, &Message{Topic: event.Topic(), Key: key, Value: value})
+		}
+		if err := s.sink.Write(ctx, messages); err != nil {
+			return err
+		}
+		s.events = nil
+	}
+	if !s.dirty {
+		return nil
+	}
+	if err := s.store.Save(ctx, s.config.Name, s.vgtid); err != nil {
+		return err
+	}
+	s.dirty = false
+	return nil
+}
+
+// changedShard returns the shard whose position changed between two
+// positions, and whether the change is a batch of rows copied from a table:
+// the GTID of the shard stays the same, and the last primary keys copied
+// change. Each position sent by VTGate follows a transaction of a single
+// shard, so the shard is unknown only if the previous position didn't list
+// the shards yet.
+func changedShard(prev, cur *binlogdatapb.VGtid) (shard string, snapshot bool) {
+	prevGtids := make(map[string]*binlogdatapb.ShardGtid)
+	for _, sgtid := range prev.GetShardGtids() {
+		prevGtids[sgtid.Keyspace+"/"+sgtid.Shard] = sgtid
+	}
+	var changed []*binlogdatapb.ShardGtid
+	for _, sgtid := range cur.GetShardGtids() {
+		prevGtid, ok := prevGtids[sgtid.Keyspace+"/"+sgtid.Shard]
+		switch {
+		case !ok:
+			changed = append(changed, sgtid)
+			snapshot = false
+		case prevGtid.Gtid != sgtid.Gtid:
+			// The first batch copied from a shard also sets its GTID.
+			changed = append(changed, sgtid)
+			snapshot = prevGtid.Gtid == "" && len(sgtid.TablePKs) != 0
+		case !tablePKsEqual(prevGtid.TablePKs, sgtid.TablePKs):
+			changed = append(changed, sgtid)
+			snapshot = len(sgtid.TablePKs) != 0
+		}
+	}
+	switch {
+	case len(changed) == 1:
+		return changed[0].Shard, snapshot
+	case len(cur.GetShardGtids()) == 1:
+		return cur.ShardGtids[0].Shard, false
+	}
+	return "", false
+}
+
+func tablePKsEqual(a, b []*binlogdatapb.TableLastPK) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+	"vitess.io/vitess/go/vt/vtstream/kafka"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeVStreamer replays a list of streams, one per call to VStream, and
+// cancels the test once they're all replayed.
+type fakeVStreamer struct {
+	streams [][][]*binlogdatapb.VEvent
+	vgtids  []*binlogdatapb.VGtid
+	cancel  context.CancelFunc
+}
+
+func (f *fakeVStreamer) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (vtgateconn.VStreamReader, error) {
+	f.vgtids = append(f.vgtids, proto.Clone(vgtid).(*binlogdatapb.VGtid))
+	if len(f.streams) == 0 {
+		f.cancel()
+		return nil, ctx.Err()
+	}
+	reader := &fakeReader{batches: f.streams[0]}
+	f.streams = f.streams[1:]
+	return reader, nil
+}
+
+type fakeReader struct {
+	batches [][]*binlogdatapb.VEvent
+}
+
+func (r *fakeReader) Recv() ([]*binlogdatapb.VEvent, error) {
+	if len(r.batches) == 0 {
+		return nil, io.EOF
+	}
+	batch := r.batches[0]
+	r.batches = r.batches[1:]
+	return batch, nil
+}
+
+// memorySink keeps the messages, and fails the writes whose numbers are
+// in fail, counting from 1.
+type memorySink struct {
+	messages []*Message
+	writes   int
+	fail     map[int]bool
+}
+
+func (s *memorySink) Write(ctx context.Context, messages []*Message) error {
+	s.writes++
+	if s.fail[s.writes] {
+		return errors.New("sink is down")
+	}
+	s.messages = append(s.messages, messages...)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
+
+var fieldEvent = &binlogdatapb.VEvent{
+	Type: binlogdatapb.VEventType_FIELD,
+	FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG)},
+		{Name: "val", Type: sqltypes.VarChar},
+	}},
+}
+
+func vgtid(gtids ...string) *binlogdatapb.VGtid {
+	vgtid := &binlogdatapb.VGtid{}
+	shards := []string{"-80", "80-"}
+	for i, gtid := range gtids {
+		vgtid.ShardGtids = append(vgtid.ShardGtids, &binlogdatapb.ShardGtid{Keyspace: "ks", Shard: shards[i], Gtid: gtid})
+	}
+	return vgtid
+}
+
+func vgtidEvent(vgtid *binlogdatapb.VGtid) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid}
+}
+
+// rowEvent returns the change of a row of t1, given its id and val before
+// and after.
+func rowEvent(before, after []string) *binlogdatapb.VEvent {
+	toRow := func(values []string) *querypb.Row {
+		if values == nil {
+			return nil
+		}
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarChar(values[0]), sqltypes.NewVarChar(values[1])})
+	}
+	return &binlogdatapb.VEvent{
+		Type:      binlogdatapb.VEventType_ROW,
+		Timestamp: 1600000000,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName:  "ks.t1",
+			RowChanges: []*binlogdatapb.RowChange{{Before: toRow(before), After: toRow(after)}},
+		},
+	}
+}
+
+func transaction(position *binlogdatapb.VGtid, rows ...*binlogdatapb.VEvent) []*binlogdatapb.VEvent {
+	events := []*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_BEGIN}}
+	events = append(events, rows...)
+	return append(events, vgtidEvent(position), &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT})
+}
+
+func decodeMessages(t *testing.T, messages []*Message) []string {
+	var got []string
+	for _, msg := range messages {
+		var m struct {
+			Before map[string]interface{} `json:"before"`
+			After  map[string]interface{} `json:"after"`
+			Source struct {
+				Shard    string `json:"shard"`
+				Table    string `json:"table"`
+				Snapshot string `json:"snapshot"`
+			} `json:"source"`
+			Op string `json:"op"`
+		}
+		require.NoError(t, json.Unmarshal(msg.Value, &m))
+		got = append(got, fmt.Sprintf("%s %s %s/%s snapshot=%s key=%s before=%v after=%v",
+			msg.Topic, m.Op, m.Source.Shard, m.Source.Table, m.Source.Snapshot, msg.Key, m.Before, m.After))
+	}
+	return got
+}
+
+func TestStreamer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	copied := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{
+		{Keyspace: "ks", Shard: "-80", Gtid: "a:1", TablePKs: []*binlogdatapb.TableLastPK{{TableName: "t1"}}},
+		{Keyspace: "ks", Shard: "80-"},
+	}}
+	vstreamer := &fakeVStreamer{
+		cancel: cancel,
+		streams: [][][]*binlogdatapb.VEvent{{
+			// The copy of the first shard, then changes on both shards,
+			// and a DDL.
+			{fieldEvent},
+			transaction(copied, rowEvent(nil, []string{"1", "copied"})),
+			{vgtidEvent(vgtid("a:1", ""))},
+			transaction(vgtid("a:1", "b:1"), rowEvent(nil, []string{"2", "inserted"})),
+			transaction(vgtid("a:2", "b:1"), rowEvent([]string{"2", "inserted"}, []string{"2", "updated"}), rowEvent([]string{"1", "copied"}, nil)),
+			{vgtidEvent(vgtid("a:3", "b:1")), {Type: binlogdatapb.VEventType_DDL, Statement: "alter table t1 add column c int"}},
+		}, {
+			// The write of the transaction fails.
+			{fieldEvent},
+			transaction(vgtid("a:3", "b:2"), rowEvent(nil, []string{"3", "retried"})),
+		}, {
+			{fieldEvent},
+			transaction(vgtid("a:3", "b:2"), rowEvent(nil, []string{"3", "retried"})),
+		}},
+	}
+	sink := &memorySink{fail: map[int]bool{4: true}}
+	streamer, err := NewStreamer(Config{
+		Name:     "cdc",
+		Keyspace: "ks",
+		Shards:   []string{"-80", "80-"},
+		Format:   FormatDebezium,
+	}, vstreamer, NewMemoryCheckpointStore(), sink)
+	require.NoError(t, err)
+	require.NoError(t, streamer.Run(ctx))
+
+	assert.Equal(t, []string{
+		`cdc.ks.t1 r -80/t1 snapshot=true key={"id":1} before=map[] after=map[id:1 val:copied]`,
+		`cdc.ks.t1 c 80-/t1 snapshot=false key={"id":2} before=map[] after=map[id:2 val:inserted]`,
+		`cdc.ks.t1 u -80/t1 snapshot=false key={"id":2} before=map[id:2 val:inserted] after=map[id:2 val:updated]`,
+		`cdc.ks.t1 d -80/t1 snapshot=false key={"id":1} before=map[id:1 val:copied] after=map[]`,
+		`cdc.ks.t1 c 80-/t1 snapshot=false key={"id":3} before=map[] after=map[id:3 val:retried]`,
+	}, decodeMessages(t, sink.messages))
+
+	// Each stream starts from the last checkpoint.
+	require.Len(t, vstreamer.vgtids, 4)
+	for i, want := range []*binlogdatapb.VGtid{vgtid("", ""), vgtid("a:3", "b:1"), vgtid("a:3", "b:1"), vgtid("a:3", "b:2")} {
+		assert.True(t, proto.Equal(want, vstreamer.vgtids[i]), "stream %d: got %v, want %v", i, vstreamer.vgtids[i], want)
+	}
+}
+
+func TestStreamerKafka(t *testing.T) {
+	broker, err := kafka.NewLocalBroker(2)
+	require.NoError(t, err)
+	defer broker.Close()
+	sink, err := NewKafkaSink([]string{broker.Addr()})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vstreamer := &fakeVStreamer{
+		cancel: cancel,
+		streams: [][][]*binlogdatapb.VEvent{{
+			{fieldEvent},
+			transaction(vgtid("a:1"), rowEvent(nil, []string{"1", "v1"}), rowEvent(nil, []string{"2", "v2"})),
+			transaction(vgtid("a:2"), rowEvent([]string{"1", "v1"}, []string{"1", "v3"})),
+		}},
+	}
+	streamer, err := NewStreamer(Config{
+		Name:     "cdc",
+		Keyspace: "ks",
+		Position: "current",
+		Format:   FormatCloudEvents,
+	}, vstreamer, NewMemoryCheckpointStore(), sink)
+	require.NoError(t, err)
+	require.NoError(t, streamer.Run(ctx))
+
+	// The changes of a row are in the partition of its primary key.
+	var got []string
+	for partition := 0; partition < 2; partition++ {
+		for _, record := range broker.Records("cdc.ks.t1", partition) {
+			var event struct {
+				Source string `json:"source"`
+				Data   struct {
+					Op    string                 `json:"op"`
+					After map[string]interface{} `json:"after"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(record.Value, &event))
+			got = append(got, fmt.Sprintf("%s %s %s %v", record.Key, event.Source, event.Data.Op, event.Data.After["val"]))
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		`{"id":1} /vitess/cdc/ks/-80 c v1`,
+		`{"id":2} /vitess/cdc/ks/-80 c v2`,
+		`{"id":1} /vitess/cdc/ks/-80 u v3`,
+	}, got)
+}
+
+func TestNewStreamerErrors(t *testing.T) {
+	store, sink := NewMemoryCheckpointStore(), &memorySink{}
+	_, err := NewStreamer(Config{Keyspace: "ks", Position: "current", Format: FormatDebezium}, nil, store, sink)
+	assert.EqualError(t, err, "the stream needs a name")
+	_, err = NewStreamer(Config{Name: "cdc", Keyspace: "ks", Format: FormatDebezium}, nil, store, sink)
+	assert.EqualError(t, err, `the shards must be specified to start from position ""`)
+	_, err = NewStreamer(Config{Name: "cdc", Keyspace: "ks", Position: "current", Format: "avro"}, nil, store, sink)
+	assert.EqualError(t, err, "unsupported format avro")
+}
@@ -40,6 +40,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/schema"
@@ -50,12 +51,15 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/shlex"
 )
 
@@ -99,12 +103,16 @@ var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
 var migrationNextCheckInterval = 5 * time.Second
+var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long to keep the original table of a completed migration with the vitess strategy, which is needed to revert it")
+var vreplCutOverThreshold = flag.Duration("online_ddl_cut_over_threshold", 5*time.Second, "How long the cut-over of a migration with the vitess strategy may block writes to the migrated table, before it's abandoned and retried later")
+var vreplPosCheckInterval = 50 * time.Millisecond
 
 const (
 	maxPasswordLength             = 32 // MySQL's *replication* password may not exceed 32 characters
 	staleMigrationMinutes         = 10
 	progressPctStarted    float64 = 0
 	progressPctFull       float64 = 100.0
+	progressPctCopied     float64 = 99.0
 	gcHoldHours                   = 72
 	databasePoolSize              = 3
 )
@@ -824,6 +832,537 @@ export MYSQL_PWD
 	return nil
 }
 
+// vreplicationExec runs a VReplication command on this tablet.
+func (e *Executor) vreplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error) {
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return nil, err
+	}
+	tmClient := tmclient.NewTabletManagerClient()
+	defer tmClient.Close()
+
+	return tmClient.VReplicationExec(ctx, tablet.Tablet, query)
+}
+
+// vreplStream is a row of _vt.vreplication
+type vreplStream struct {
+	id      int64
+	bls     *binlogdatapb.BinlogSource
+	pos     string
+	state   string
+	message string
+}
+
+// targetTable returns the table the stream writes to
+func (s *vreplStream) targetTable() string {
+	return s.bls.Filter.Rules[0].Match
+}
+
+// readVReplStream reads the stream of the given workflow, or returns nil if there's none.
+func (e *Executor) readVReplStream(ctx context.Context, workflow string) (*vreplStream, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectVReplStream, ":db_name", ":workflow")
+	bindVars := map[string]*querypb.BindVariable{
+		"db_name":  sqltypes.StringBindVariable(e.dbName),
+		"workflow": sqltypes.StringBindVariable(workflow),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return nil, nil
+	}
+	stream := &vreplStream{
+		bls:     &binlogdatapb.BinlogSource{},
+		pos:     row["pos"].ToString(),
+		state:   row["state"].ToString(),
+		message: row["message"].ToString(),
+	}
+	if stream.id, err = row.ToInt64("id"); err != nil {
+		return nil, err
+	}
+	if err := proto.UnmarshalText(row["source"].ToString(), stream.bls); err != nil {
+		return nil, err
+	}
+	if len(stream.bls.GetFilter().GetRules()) != 1 {
+		return nil, fmt.Errorf("unexpected source for stream %d of workflow %s: %v", stream.id, workflow, stream.bls)
+	}
+	return stream, nil
+}
+
+// deleteVReplStream deletes the stream of the given workflow, if there's one.
+func (e *Executor) deleteVReplStream(ctx context.Context, workflow string) error {
+	query, err := sqlparser.ParseAndBind(sqlDeleteVReplStream,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(workflow),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.vreplicationExec(ctx, query)
+	return err
+}
+
+// readVReplStreamPos reads the position that the given stream applied events up to.
+func (e *Executor) readVReplStreamPos(ctx context.Context, id int64) (pos mysql.Position, err error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectVReplStreamPos, sqltypes.Int64BindVariable(id))
+	if err != nil {
+		return pos, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return pos, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return pos, fmt.Errorf("stream %d not found", id)
+	}
+	return mysql.DecodePosition(row["pos"].ToString())
+}
+
+// waitForVReplStreamPos waits for the given stream to apply the events up to the given position.
+func (e *Executor) waitForVReplStreamPos(ctx context.Context, id int64, pos mysql.Position, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(vreplPosCheckInterval)
+	defer ticker.Stop()
+	for {
+		streamPos, err := e.readVReplStreamPos(ctx, id)
+		if err != nil {
+			return err
+		}
+		if streamPos.AtLeast(pos) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stream %d did not reach position %v within %v, it is at %v", id, pos, timeout, streamPos)
+		case <-ticker.C:
+		}
+	}
+}
+
+// readColumns reads the columns of a table with the given query, which selects a column_name.
+func (e *Executor) readColumns(ctx context.Context, columnsQuery string, tableName string) (columns []string, err error) {
+	query, err := sqlparser.ParseAndBind(columnsQuery,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range r.Named().Rows {
+		columns = append(columns, row["column_name"].ToString())
+	}
+	return columns, nil
+}
+
+// analyzeVRepl reads the columns of the source and the target tables of the stream.
+func (e *Executor) analyzeVRepl(ctx context.Context, v *VRepl) error {
+	var columns [4][]string
+	for i, columnsQuery := range []struct {
+		query string
+		table string
+	}{
+		{sqlSelectColumns, v.sourceTable},
+		{sqlSelectColumns, v.targetTable},
+		{sqlSelectPrimaryKeyColumns, v.sourceTable},
+		{sqlSelectPrimaryKeyColumns, v.targetTable},
+	} {
+		var err error
+		if columns[i], err = e.readColumns(ctx, columnsQuery.query, columnsQuery.table); err != nil {
+			return err
+		}
+	}
+	return v.analyzeColumns(columns[0], columns[1], columns[2], columns[3])
+}
+
+// analyzeReverseVRepl reads the columns of the tables of the stream that
+// reverts a migration, which gives back their old names to the columns that
+// the ALTER of the migration renames.
+func (e *Executor) analyzeReverseVRepl(ctx context.Context, v *VRepl, sql string) error {
+	stmt, _, err := schema.ParseOnlineDDLStatement(sql)
+	if err != nil {
+		return err
+	}
+	alterTable, ok := stmt.(*sqlparser.AlterTable)
+	if !ok {
+		return fmt.Errorf("Unsupported statement for the %s strategy: %s", schema.DDLStrategyVitess, sql)
+	}
+	v.columnRenames = reverseColumnRenames(alterColumnRenames(alterTable))
+	return e.analyzeVRepl(ctx, v)
+}
+
+// readTableRows returns the estimated number of rows of a table.
+func (e *Executor) readTableRows(ctx context.Context, tableName string) (int64, error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectTableRows,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return 0, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return 0, fmt.Errorf("table %s not found", tableName)
+	}
+	return row.AsInt64("table_rows", 0), nil
+}
+
+// ExecuteWithVReplication runs a migration with VReplication: the ALTER is
+// applied to a shadow table, which a stream populates with the rows of the
+// migrated table and keeps up to date. The migration is then driven by
+// reviewVReplMigration, which cuts it over once the stream has caught up.
+func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	if atomic.LoadInt64(&e.migrationRunning) > 0 {
+		return ErrExecutorMigrationAlreadyRunning
+	}
+
+	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+		return ErrExecutorNotWritableTablet
+	}
+
+	stmt, _, err := schema.ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		return err
+	}
+	alterTable, ok := stmt.(*sqlparser.AlterTable)
+	if !ok || !alterTable.FullyParsed {
+		return fmt.Errorf("Unsupported statement for the %s strategy: %s", schema.DDLStrategyVitess, onlineDDL.SQL)
+	}
+
+	// A previous attempt of this migration may have left its stream behind.
+	if err := e.deleteVReplStream(ctx, onlineDDL.UUID); err != nil {
+		return err
+	}
+	shadowTable := vreplShadowTableName(onlineDDL.UUID, ReadableTimestamp())
+	if err := e.updateArtifacts(ctx, onlineDDL.UUID, shadowTable); err != nil {
+		return err
+	}
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	parsed := sqlparser.BuildParsedQuery(sqlCreateTableLike, shadowTable, onlineDDL.Table)
+	if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+		return err
+	}
+	alterTable.Table = sqlparser.TableName{Name: sqlparser.NewTableIdent(shadowTable)}
+	if _, err := conn.ExecuteFetch(sqlparser.String(alterTable), 0, false); err != nil {
+		return err
+	}
+
+	v := NewVRepl(onlineDDL.UUID, e.keyspace, e.shard, e.dbName, e.tabletAlias.Cell, onlineDDL.Table, shadowTable)
+	v.columnRenames = alterColumnRenames(alterTable)
+	if err := e.analyzeVRepl(ctx, v); err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(&e.migrationRunning, 1)
+	e.lastMigrationUUID = onlineDDL.UUID
+
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted)
+	if _, err := e.vreplicationExec(ctx, v.generateInsertStatement("")); err != nil {
+		atomic.StoreInt64(&e.migrationRunning, 0)
+		return err
+	}
+	startedMigrations.Add(1)
+	e.triggerNextCheckInterval()
+	return nil
+}
+
+// adoptVReplMigration makes the running migration with the vitess strategy, if
+// there's one, the migration of this executor. Such a migration outlives the
+// executor that started it, e.g. on a vttablet restart or on a failover.
+func (e *Executor) adoptVReplMigration(ctx context.Context) (adopted bool, err error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectRunningMigrations, "_vt", ":strategy")
+	bindVars := map[string]*querypb.BindVariable{
+		"strategy": sqltypes.StringBindVariable(string(schema.DDLStrategyVitess)),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return false, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return false, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return false, nil
+	}
+	atomic.StoreInt64(&e.migrationRunning, 1)
+	e.lastMigrationUUID = row["migration_uuid"].ToString()
+	log.Infof("Executor.adoptVReplMigration: adopted migration %s", e.lastMigrationUUID)
+	return true, nil
+}
+
+// reviewVReplMigration follows the progress of a running migration with the
+// vitess strategy, and cuts it over once its stream has copied the table and
+// caught up with the primary.
+func (e *Executor) reviewVReplMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	failMigration := func(err error) error {
+		_ = e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed)
+		failedMigrations.Add(1)
+		if onlineDDL.UUID == e.lastMigrationUUID {
+			atomic.StoreInt64(&e.migrationRunning, 0)
+		}
+		e.triggerNextCheckInterval()
+		return err
+	}
+
+	stream, err := e.readVReplStream(ctx, onlineDDL.UUID)
+	if err != nil {
+		return err
+	}
+	if stream == nil {
+		return failMigration(fmt.Errorf("the stream of the migration was not found"))
+	}
+	if stream.state == binlogplayer.BlpError {
+		return failMigration(fmt.Errorf("the stream of the migration failed: %s", stream.message))
+	}
+	if err := e.updateMigrationTimestamp(ctx, "liveness_timestamp", onlineDDL.UUID); err != nil {
+		return err
+	}
+
+	query, err := sqlparser.ParseAndBind(sqlSelectVReplCopyState, sqltypes.Int64BindVariable(stream.id))
+	if err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	copyingTables, err := r.Named().Row().ToInt64("count_tables")
+	if err != nil {
+		return err
+	}
+	if copyingTables > 0 || stream.state != binlogplayer.BlpRunning {
+		// The copy is in progress. The progress is estimated by the rows in the
+		// shadow table, relative to the rows in the migrated table.
+		tableRows, err := e.readTableRows(ctx, onlineDDL.Table)
+		if err != nil {
+			return err
+		}
+		shadowRows, err := e.readTableRows(ctx, stream.targetTable())
+		if err != nil {
+			return err
+		}
+		if tableRows > 0 {
+			return e.updateMigrationProgress(ctx, onlineDDL.UUID, math.Min(100.0*float64(shadowRows)/float64(tableRows), progressPctCopied))
+		}
+		return nil
+	}
+	if err := e.updateMigrationProgress(ctx, onlineDDL.UUID, progressPctCopied); err != nil {
+		return err
+	}
+
+	pos, err := e.cutOverVRepl(ctx, onlineDDL.Table, stream)
+	if err != nil {
+		// The stream is behind, or the cut-over couldn't get its locks in time. It's retried at the next review.
+		return err
+	}
+	log.Infof("Executor.reviewVReplMigration: migration %s cut over at %v", onlineDDL.UUID, pos)
+	if err := e.deleteVReplStream(ctx, onlineDDL.UUID); err != nil {
+		return err
+	}
+
+	// The original table now has the name of the shadow table. It's kept up to
+	// date by a stream in the opposite direction, so that the migration can be
+	// reverted, until it's collected.
+	reverse := NewVRepl(vreplReverseWorkflow(onlineDDL.UUID), e.keyspace, e.shard, e.dbName, e.tabletAlias.Cell, onlineDDL.Table, stream.targetTable())
+	if err := e.analyzeReverseVRepl(ctx, reverse, onlineDDL.SQL); err != nil {
+		log.Errorf("Executor.reviewVReplMigration: migration %s cannot be reverted: %v", onlineDDL.UUID, err)
+	} else if _, err := e.vreplicationExec(ctx, reverse.generateInsertStatement(mysql.EncodePosition(pos))); err != nil {
+		log.Errorf("Executor.reviewVReplMigration: migration %s cannot be reverted: %v", onlineDDL.UUID, err)
+	}
+
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull)
+	if onlineDDL.UUID == e.lastMigrationUUID {
+		atomic.StoreInt64(&e.migrationRunning, 0)
+	}
+	successfulMigrations.Add(1)
+	return nil
+}
+
+// cutOverVRepl swaps the table with the target table of the stream, which is
+// populated from it. Writes to the table are blocked while the stream applies
+// the last of them, and until the tables are swapped: one connection locks the
+// table, while another issues the RENAME, which waits for the lock and takes
+// precedence over the blocked writes once the table is unlocked. The stream is
+// stopped on success. It returns the position of the primary at which both
+// tables were identical.
+func (e *Executor) cutOverVRepl(ctx context.Context, tableName string, stream *vreplStream) (pos mysql.Position, err error) {
+	lockConn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return pos, err
+	}
+	defer lockConn.Close()
+	renameConn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return pos, err
+	}
+	defer renameConn.Close()
+
+	// Make sure that the stream has caught up before anything is locked.
+	pos, err = lockConn.MasterPosition()
+	if err != nil {
+		return pos, err
+	}
+	if err := e.waitForVReplStreamPos(ctx, stream.id, pos, *vreplCutOverThreshold); err != nil {
+		return pos, err
+	}
+
+	lockWaitTimeout := int64(math.Ceil(vreplCutOverThreshold.Seconds()))
+	parsed := sqlparser.BuildParsedQuery(sqlSetLockWaitTimeout, fmt.Sprintf("%d", lockWaitTimeout))
+	for _, conn := range []*dbconnpool.DBConnection{lockConn, renameConn} {
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			return pos, err
+		}
+	}
+
+	parsed = sqlparser.BuildParsedQuery(sqlLockTableWrite, tableName)
+	if _, err := lockConn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+		return pos, err
+	}
+	defer lockConn.ExecuteFetch(sqlUnlockTables, 0, false)
+
+	// No more writes can happen to the table from here on.
+	pos, err = lockConn.MasterPosition()
+	if err != nil {
+		return pos, err
+	}
+	if err := e.waitForVReplStreamPos(ctx, stream.id, pos, *vreplCutOverThreshold); err != nil {
+		return pos, err
+	}
+	if _, err := e.vreplicationExec(ctx, binlogplayer.StopVReplication(uint32(stream.id), "stopped for cut-over")); err != nil {
+		return pos, err
+	}
+	defer func() {
+		if err != nil {
+			if _, startErr := e.vreplicationExec(ctx, binlogplayer.StartVReplication(uint32(stream.id))); startErr != nil {
+				log.Errorf("Executor.cutOverVRepl: failed to restart stream %d: %v", stream.id, startErr)
+			}
+		}
+	}()
+
+	swapTable := vreplSwapTableName(stream.targetTable())
+	parsed = sqlparser.BuildParsedQuery(sqlSwapTables,
+		tableName, swapTable,
+		stream.targetTable(), tableName,
+		swapTable, stream.targetTable(),
+	)
+	renameErr := make(chan error, 1)
+	go func() {
+		_, err := renameConn.ExecuteFetch(parsed.Query, 0, false)
+		renameErr <- err
+	}()
+
+	if err := e.waitForMetadataLock(ctx, renameConn.ID(), *vreplCutOverThreshold); err != nil {
+		killParsed := sqlparser.BuildParsedQuery(sqlKillQuery, fmt.Sprintf("%d", renameConn.ID()))
+		_, _ = lockConn.ExecuteFetch(killParsed.Query, 0, false)
+		<-renameErr
+		return pos, err
+	}
+	if _, err := lockConn.ExecuteFetch(sqlUnlockTables, 0, false); err != nil {
+		<-renameErr
+		return pos, err
+	}
+	if err := <-renameErr; err != nil {
+		return pos, err
+	}
+	return pos, nil
+}
+
+// waitForMetadataLock waits for the given connection to wait for a metadata lock.
+func (e *Executor) waitForMetadataLock(ctx context.Context, connectionID int64, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	query, err := sqlparser.ParseAndBind(sqlSelectWaitingForMetadataLock, sqltypes.Int64BindVariable(connectionID))
+	if err != nil {
+		return err
+	}
+	ticker := time.NewTicker(vreplPosCheckInterval)
+	defer ticker.Stop()
+	for {
+		r, err := e.execQuery(ctx, query)
+		if err != nil {
+			return err
+		}
+		if len(r.Rows) > 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("connection %d did not wait for the table lock within %v", connectionID, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// revertMigration reverts a completed migration with the vitess strategy: the
+// original table, which has been kept up to date by the reverse stream, is
+// swapped back with the migrated table.
+func (e *Executor) revertMigration(ctx context.Context, uuid string) (result *sqltypes.Result, err error) {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	if atomic.LoadInt64(&e.migrationRunning) > 0 {
+		return nil, ErrExecutorMigrationAlreadyRunning
+	}
+	onlineDDL, err := e.readMigration(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	if onlineDDL.Strategy != schema.DDLStrategyVitess || onlineDDL.Status != schema.OnlineDDLStatusComplete {
+		return nil, fmt.Errorf("Only complete migrations with the %s strategy can be reverted. Migration %s has strategy %s and status %s",
+			schema.DDLStrategyVitess, uuid, onlineDDL.Strategy, onlineDDL.Status)
+	}
+	stream, err := e.readVReplStream(ctx, vreplReverseWorkflow(uuid))
+	if err != nil {
+		return nil, err
+	}
+	if stream == nil {
+		return nil, fmt.Errorf("Migration %s can no longer be reverted", uuid)
+	}
+	if stream.state != binlogplayer.BlpRunning {
+		return nil, fmt.Errorf("Migration %s cannot be reverted: its reverse stream is %s: %s", uuid, stream.state, stream.message)
+	}
+
+	if _, err := e.cutOverVRepl(ctx, onlineDDL.Table, stream); err != nil {
+		return nil, err
+	}
+	if err := e.deleteVReplStream(ctx, vreplReverseWorkflow(uuid)); err != nil {
+		return nil, err
+	}
+	if err := e.updateMigrationStatus(ctx, uuid, schema.OnlineDDLStatusReverted); err != nil {
+		return nil, err
+	}
+	e.triggerNextCheckInterval()
+	return &sqltypes.Result{RowsAffected: 1}, nil
+}
+
 func (e *Executor) readMigration(ctx context.Context, uuid string) (onlineDDL *schema.OnlineDDL, err error) {
 
 	parsed := sqlparser.BuildParsedQuery(sqlSelectMigration, "_vt", ":migration_uuid")
@@ -893,6 +1432,26 @@ func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.Onl
 		if err := e.createGhostPanicFlagFile(onlineDDL.UUID); err != nil {
 			return foundRunning, fmt.Errorf("Error cancelling migration, flag file error: %+v", err)
 		}
+	case schema.DDLStrategyVitess:
+		// There's no process to kill: the migration runs for as long as its stream exists.
+		stream, err := e.readVReplStream(ctx, onlineDDL.UUID)
+		if err != nil {
+			return foundRunning, err
+		}
+		if stream == nil {
+			return foundRunning, nil
+		}
+		foundRunning = true
+		if err := e.deleteVReplStream(ctx, onlineDDL.UUID); err != nil {
+			return foundRunning, err
+		}
+		if err := e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed); err != nil {
+			return foundRunning, err
+		}
+		if onlineDDL.UUID == lastMigrationUUID {
+			atomic.StoreInt64(&e.migrationRunning, 0)
+		}
+		failedMigrations.Add(1)
 	}
 	return foundRunning, nil
 }
@@ -1075,6 +1634,12 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 					failMigration(err)
 				}
 			}()
+		case schema.DDLStrategyVitess:
+			go func() {
+				if err := e.ExecuteWithVReplication(ctx, onlineDDL); err != nil {
+					failMigration(err)
+				}
+			}()
 		default:
 			{
 				return failMigration(fmt.Errorf("Unsupported strategy: %+v", onlineDDL.Strategy))
@@ -1091,6 +1656,11 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 	if atomic.LoadInt64(&e.migrationRunning) > 0 {
 		return ErrExecutorMigrationAlreadyRunning
 	}
+	if adopted, err := e.adoptVReplMigration(ctx); err != nil {
+		return err
+	} else if adopted {
+		return ErrExecutorMigrationAlreadyRunning
+	}
 
 	parsed := sqlparser.BuildParsedQuery(sqlSelectReadyMigration, "_vt")
 	r, err := e.execQuery(ctx, parsed.Query)
@@ -1220,6 +1790,35 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 			runningNotByThisProcess = append(runningNotByThisProcess, uuid)
 		}
 	}
+
+	// Migrations with the vitess strategy are not run by a process: their streams
+	// survive a restart of vttablet, and they are driven from here to completion.
+	parsed = sqlparser.BuildParsedQuery(sqlSelectRunningMigrations, "_vt", ":strategy")
+	bindVars = map[string]*querypb.BindVariable{
+		"strategy": sqltypes.StringBindVariable(string(schema.DDLStrategyVitess)),
+	}
+	bound, err = parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return countRunnning, runningNotByThisProcess, err
+	}
+	r, err = e.execQuery(ctx, bound)
+	if err != nil {
+		return countRunnning, runningNotByThisProcess, err
+	}
+	for _, row := range r.Named().Rows {
+		uuid := row["migration_uuid"].ToString()
+		countRunnning++
+
+		onlineDDL, err := e.readMigration(ctx, uuid)
+		if err != nil {
+			return countRunnning, runningNotByThisProcess, err
+		}
+		if err := e.reviewVReplMigration(ctx, onlineDDL); err != nil {
+			log.Errorf("Executor.reviewRunningMigrations: migration %s: %v", uuid, err)
+		}
+		// Keep reviewing at a short interval, so that the cut-over follows the copy closely.
+		e.triggerNextCheckInterval()
+	}
 	return countRunnning, runningNotByThisProcess, err
 }
 
@@ -1304,8 +1903,15 @@ func (e *Executor) gcArtifacts(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	parsed := sqlparser.BuildParsedQuery(sqlSelectUncollectedArtifacts, "_vt")
-	r, err := e.execQuery(ctx, parsed.Query)
+	parsed := sqlparser.BuildParsedQuery(sqlSelectUncollectedArtifacts, "_vt", ":retain_seconds")
+	bindVars := map[string]*querypb.BindVariable{
+		"retain_seconds": sqltypes.Int64BindVariable(int64(retainOnlineDDLTables.Seconds())),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, bound)
 	if err != nil {
 		return err
 	}
@@ -1313,6 +1919,16 @@ func (e *Executor) gcArtifacts(ctx context.Context) error {
 		uuid := row["migration_uuid"].ToString()
 		artifacts := row["artifacts"].ToString()
 
+		if schema.DDLStrategy(row["strategy"].ToString()) == schema.DDLStrategyVitess {
+			// The streams must not write to the tables once they're collected.
+			if err := e.deleteVReplStream(ctx, uuid); err != nil {
+				return err
+			}
+			if err := e.deleteVReplStream(ctx, vreplReverseWorkflow(uuid)); err != nil {
+				return err
+			}
+		}
+
 		artifactTables := textutil.SplitDelimitedList(artifacts)
 		for _, artifactTable := range artifactTables {
 			if err := e.gcArtifactTable(ctx, artifactTable, uuid); err != nil {
@@ -1638,6 +2254,15 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
 			}
 			return response(e.cancelMigration(ctx, uuid, true))
+		case revertMigrationHint:
+			uuid, err := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
+			if err != nil {
+				return nil, err
+			}
+			if !schema.IsOnlineDDLUUID(uuid) {
+				return nil, fmt.Errorf("Not an Online DDL UUID: %s", uuid)
+			}
+			return response(e.revertMigration(ctx, uuid))
 		case cancelAllMigrationHint:
 			uuid, _ := vx.ColumnStringVal(vx.WhereCols, "migration_uuid")
 			if uuid != "" {
@@ -1645,8 +2270,8 @@ func (e *Executor) VExec(ctx context.Context, vx *vexec.TabletVExec) (qr *queryp
 			}
 			return response(e.cancelPendingMigrations(ctx))
 		default:
-			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s",
-				statusVal, retryMigrationHint, cancelMigrationHint)
+			return nil, fmt.Errorf("Unexpected value for migration_status: %v. Supported values are: %s, %s, %s",
+				statusVal, retryMigrationHint, cancelMigrationHint, revertMigrationHint)
 		}
 	default:
 		return nil, fmt.Errorf("No handler for this query: %s", vx.Query)
@@ -153,11 +153,17 @@ const (
 	`
 	sqlSelectUncollectedArtifacts = `SELECT
 			migration_uuid,
+			strategy,
 			artifacts
 		FROM %s.schema_migrations
 		WHERE
-			migration_status IN ('complete', 'failed')
+			migration_status IN ('complete', 'failed', 'reverted')
 			AND cleanup_timestamp IS NULL
+			AND NOT (
+				strategy='vitess'
+				AND migration_status='complete'
+				AND completed_timestamp > NOW() - INTERVAL %a SECOND
+			)
 	`
 	sqlSelectMigration = `SELECT
 			id,
@@ -216,14 +222,80 @@ const (
 			AND ACTION_TIMING='AFTER'
 			AND LEFT(TRIGGER_NAME, 7)='pt_osc_'
 		`
-	sqlDropTrigger    = "DROP TRIGGER IF EXISTS `%a`.`%a`"
-	sqlShowTablesLike = "SHOW TABLES LIKE '%a'"
+	sqlDropTrigger        = "DROP TRIGGER IF EXISTS `%a`.`%a`"
+	sqlShowTablesLike     = "SHOW TABLES LIKE '%a'"
+	sqlCreateTableLike    = "CREATE TABLE `%a` LIKE `%a`"
+	sqlSwapTables         = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
+	sqlLockTableWrite     = "LOCK TABLES `%a` WRITE"
+	sqlUnlockTables       = "UNLOCK TABLES"
+	sqlSetLockWaitTimeout = "SET @@session.lock_wait_timeout=%a"
+	sqlKillQuery          = "KILL QUERY %a"
+	sqlSelectColumns      = `SELECT
+			COLUMN_NAME as column_name
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+		ORDER BY ORDINAL_POSITION
+	`
+	sqlSelectPrimaryKeyColumns = `SELECT
+			COLUMN_NAME as column_name
+		FROM INFORMATION_SCHEMA.STATISTICS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+			AND INDEX_NAME='PRIMARY'
+		ORDER BY SEQ_IN_INDEX
+	`
+	sqlSelectTableRows = `SELECT
+			TABLE_ROWS as table_rows
+		FROM INFORMATION_SCHEMA.TABLES
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+	`
+	sqlSelectWaitingForMetadataLock = `SELECT
+			ID as id
+		FROM INFORMATION_SCHEMA.PROCESSLIST
+		WHERE
+			ID=%a
+			AND STATE='Waiting for table metadata lock'
+	`
+	sqlSelectVReplStream = `SELECT
+			id,
+			source,
+			pos,
+			state,
+			message
+		FROM _vt.vreplication
+		WHERE
+			db_name=%a
+			AND workflow=%a
+	`
+	sqlSelectVReplStreamPos = `SELECT
+			pos
+		FROM _vt.vreplication
+		WHERE
+			id=%a
+	`
+	sqlSelectVReplCopyState = `SELECT
+			count(*) as count_tables
+		FROM _vt.copy_state
+		WHERE
+			vrepl_id=%a
+	`
+	sqlDeleteVReplStream = `DELETE FROM _vt.vreplication
+		WHERE
+			db_name=%a
+			AND workflow=%a
+	`
 )
 
 const (
 	retryMigrationHint     = "retry"
 	cancelMigrationHint    = "cancel"
 	cancelAllMigrationHint = "cancel-all"
+	revertMigrationHint    = "revert"
 )
 
 var (
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// VRepl describes a VReplication stream that populates a target table from a
+// source table of the same shard, and keeps applying the changes to it. A
+// migration with the "vitess" strategy runs such a stream from the migrated
+// table into a shadow table, and, once it's cut over, another stream in the
+// opposite direction, so that the migration can be reverted.
+type VRepl struct {
+	workflow    string
+	keyspace    string
+	shard       string
+	dbName      string
+	cell        string
+	sourceTable string
+	targetTable string
+
+	// columnRenames holds the new names of the columns of the source table
+	// that are renamed in the target table, by their lowercased old names.
+	columnRenames map[string]string
+
+	// sharedColumns are the columns of the source table that are copied,
+	// and sharedTargetColumns are their names in the target table.
+	sharedColumns       []string
+	sharedTargetColumns []string
+}
+
+// NewVRepl creates a VRepl from the source table into the target table.
+func NewVRepl(workflow, keyspace, shard, dbName, cell, sourceTable, targetTable string) *VRepl {
+	return &VRepl{
+		workflow:    workflow,
+		keyspace:    keyspace,
+		shard:       shard,
+		dbName:      dbName,
+		cell:        cell,
+		sourceTable: sourceTable,
+		targetTable: targetTable,
+	}
+}
+
+// vreplShadowTableName returns the name of the table the migration copies
+// into. The original table is given this name at cut-over.
+func vreplShadowTableName(uuid, timestamp string) string {
+	return fmt.Sprintf("_%s_%s_vrepl", uuid, timestamp)
+}
+
+// vreplSwapTableName returns the name of the transient table used when the
+// migrated table and the shadow table are swapped.
+func vreplSwapTableName(shadowTable string) string {
+	return strings.TrimSuffix(shadowTable, "_vrepl") + "_del"
+}
+
+// vreplReverseWorkflow returns the workflow of the stream that applies the
+// changes of a migrated table to the original table.
+func vreplReverseWorkflow(uuid string) string {
+	return uuid + "_reverse"
+}
+
+// alterColumnRenames returns the columns that an ALTER TABLE renames with
+// CHANGE COLUMN, as a map of their new names by their lowercased old names.
+func alterColumnRenames(alterTable *sqlparser.AlterTable) map[string]string {
+	renames := make(map[string]string)
+	for _, option := range alterTable.AlterOptions {
+		change, ok := option.(*sqlparser.ChangeColumn)
+		if !ok {
+			continue
+		}
+		oldName, newName := change.OldColumn.Name.String(), change.NewColDefinition.Name.String()
+		if !strings.EqualFold(oldName, newName) {
+			renames[strings.ToLower(oldName)] = newName
+		}
+	}
+	return renames
+}
+
+// reverseColumnRenames returns the renames that give back their old names
+// to the renamed columns. The names are lowercased, as the columns are
+// matched regardless of their case.
+func reverseColumnRenames(renames map[string]string) map[string]string {
+	reverse := make(map[string]string, len(renames))
+	for oldName, newName := range renames {
+		reverse[strings.ToLower(newName)] = oldName
+	}
+	return reverse
+}
+
+// analyzeColumns computes the columns that are shared by the source and the
+// target tables, in the order of the source table. A column is shared under
+// its new name if it's renamed. The primary keys of both tables must consist
+// of shared columns, since they identify the rows that are streamed in either
+// direction.
+func (v *VRepl) analyzeColumns(sourceColumns, targetColumns, sourcePKColumns, targetPKColumns []string) error {
+	targetColumnsMap := make(map[string]string, len(targetColumns))
+	for _, column := range targetColumns {
+		targetColumnsMap[strings.ToLower(column)] = column
+	}
+	v.sharedColumns, v.sharedTargetColumns = nil, nil
+	sourceSharedMap := make(map[string]bool)
+	targetSharedMap := make(map[string]bool)
+	for _, column := range sourceColumns {
+		name := column
+		if newName, ok := v.columnRenames[strings.ToLower(column)]; ok {
+			name = newName
+		}
+		if targetColumn, ok := targetColumnsMap[strings.ToLower(name)]; ok {
+			v.sharedColumns = append(v.sharedColumns, column)
+			v.sharedTargetColumns = append(v.sharedTargetColumns, targetColumn)
+			sourceSharedMap[strings.ToLower(column)] = true
+			targetSharedMap[strings.ToLower(targetColumn)] = true
+		}
+	}
+	if len(v.sharedColumns) == 0 {
+		return fmt.Errorf("tables %s and %s have no shared columns", v.sourceTable, v.targetTable)
+	}
+	for _, table := range []struct {
+		name      string
+		pkColumns []string
+		shared    map[string]bool
+	}{
+		{v.sourceTable, sourcePKColumns, sourceSharedMap},
+		{v.targetTable, targetPKColumns, targetSharedMap},
+	} {
+		if len(table.pkColumns) == 0 {
+			return fmt.Errorf("table %s has no PRIMARY KEY", table.name)
+		}
+		for _, column := range table.pkColumns {
+			if !table.shared[strings.ToLower(column)] {
+				return fmt.Errorf("column %s of the PRIMARY KEY of table %s is not shared by tables %s and %s", column, table.name, v.sourceTable, v.targetTable)
+			}
+		}
+	}
+	return nil
+}
+
+// filterQuery returns the query that selects the shared columns from the
+// source table, aliased to their names in the target table if they differ.
+func (v *VRepl) filterQuery() string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString("select ")
+	for i, column := range v.sharedColumns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(column))
+		if !strings.EqualFold(v.sharedTargetColumns[i], column) {
+			buf.Myprintf(" as %v", sqlparser.NewColIdent(v.sharedTargetColumns[i]))
+		}
+	}
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(v.sourceTable))
+	return buf.String()
+}
+
+// bls returns the source of the stream.
+func (v *VRepl) bls() *binlogdatapb.BinlogSource {
+	return &binlogdatapb.BinlogSource{
+		Keyspace: v.keyspace,
+		Shard:    v.shard,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  v.targetTable,
+				Filter: v.filterQuery(),
+			}},
+		},
+		OnDdl: binlogdatapb.OnDDLAction_IGNORE,
+	}
+}
+
+// generateInsertStatement returns the statement that creates the stream. The
+// stream copies the rows of the source table first if pos is empty, else it
+// starts applying the changes from pos. It streams from the primary, so that
+// the positions of the stream can be compared with the positions of the
+// primary at cut-over.
+func (v *VRepl) generateInsertStatement(pos string) string {
+	ig := vreplication.NewInsertGenerator(binlogplayer.BlpRunning, v.dbName)
+	ig.AddRow(v.workflow, v.bls(), pos, v.cell, topodatapb.TabletType_MASTER.String())
+	return ig.String()
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestVReplTableNames(t *testing.T) {
+	shadowTable := vreplShadowTableName("4e5dcf80_354b_11eb_82cd_f875a4d24e90", "20201203114014")
+	assert.Equal(t, "_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_vrepl", shadowTable)
+	assert.True(t, schema.IsOnlineDDLTableName(shadowTable))
+
+	swapTable := vreplSwapTableName(shadowTable)
+	assert.Equal(t, "_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_del", swapTable)
+	assert.True(t, schema.IsOnlineDDLTableName(swapTable))
+}
+
+func TestVReplAnalyzeColumns(t *testing.T) {
+	tt := []struct {
+		name            string
+		sourceColumns   []string
+		targetColumns   []string
+		sourcePKColumns []string
+		targetPKColumns []string
+		columnRenames   map[string]string
+		sharedColumns   []string
+		// sharedTargetColumns are the names of sharedColumns in the target table
+		sharedTargetColumns []string
+		err                 string
+	}{
+		{
+			name:                "added and dropped columns",
+			sourceColumns:       []string{"id", "c1", "c2"},
+			targetColumns:       []string{"id", "c3", "C2"},
+			sourcePKColumns:     []string{"id"},
+			targetPKColumns:     []string{"id"},
+			sharedColumns:       []string{"id", "c2"},
+			sharedTargetColumns: []string{"id", "C2"},
+		},
+		{
+			name:                "renamed columns",
+			sourceColumns:       []string{"id", "c1", "c2"},
+			targetColumns:       []string{"key_id", "c1", "c3"},
+			sourcePKColumns:     []string{"id"},
+			targetPKColumns:     []string{"key_id"},
+			columnRenames:       map[string]string{"id": "key_id", "c2": "c3"},
+			sharedColumns:       []string{"id", "c1", "c2"},
+			sharedTargetColumns: []string{"key_id", "c1", "c3"},
+		},
+		{
+			name:                "renamed and added columns",
+			sourceColumns:       []string{"id", "c1"},
+			targetColumns:       []string{"id", "c1", "c2"},
+			sourcePKColumns:     []string{"id"},
+			targetPKColumns:     []string{"id"},
+			columnRenames:       map[string]string{"c1": "c2"},
+			sharedColumns:       []string{"id", "c1"},
+			sharedTargetColumns: []string{"id", "c2"},
+		},
+		{
+			name:                "extended primary key",
+			sourceColumns:       []string{"id", "c1"},
+			targetColumns:       []string{"id", "c1"},
+			sourcePKColumns:     []string{"id"},
+			targetPKColumns:     []string{"id", "c1"},
+			sharedColumns:       []string{"id", "c1"},
+			sharedTargetColumns: []string{"id", "c1"},
+		},
+		{
+			name:            "primary key on a new column",
+			sourceColumns:   []string{"id", "c1"},
+			targetColumns:   []string{"id", "c1", "c2"},
+			sourcePKColumns: []string{"id"},
+			targetPKColumns: []string{"c2"},
+			err:             "column c2 of the PRIMARY KEY of table _t_vrepl is not shared by tables t and _t_vrepl",
+		},
+		{
+			name:            "dropped primary key",
+			sourceColumns:   []string{"id", "c1"},
+			targetColumns:   []string{"id", "c1"},
+			sourcePKColumns: []string{"id"},
+			err:             "table _t_vrepl has no PRIMARY KEY",
+		},
+		{
+			name:            "no shared columns",
+			sourceColumns:   []string{"id"},
+			targetColumns:   []string{"c1"},
+			sourcePKColumns: []string{"id"},
+			targetPKColumns: []string{"c1"},
+			err:             "tables t and _t_vrepl have no shared columns",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			v := NewVRepl("wf", "ks", "0", "vt_ks", "zone1", "t", "_t_vrepl")
+			v.columnRenames = tc.columnRenames
+			err := v.analyzeColumns(tc.sourceColumns, tc.targetColumns, tc.sourcePKColumns, tc.targetPKColumns)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.sharedColumns, v.sharedColumns)
+			assert.Equal(t, tc.sharedTargetColumns, v.sharedTargetColumns)
+		})
+	}
+}
+
+func TestVReplColumnRenames(t *testing.T) {
+	stmt, err := sqlparser.Parse("alter table t change column c1 c2 int, change id ID bigint, modify c3 int, add column c4 int")
+	require.NoError(t, err)
+	renames := alterColumnRenames(stmt.(*sqlparser.AlterTable))
+	assert.Equal(t, map[string]string{"c1": "c2"}, renames)
+
+	v := NewVRepl("wf", "ks", "0", "vt_ks", "zone1", "t", "_t_vrepl")
+	v.columnRenames = renames
+	require.NoError(t, v.analyzeColumns([]string{"id", "c1", "c3"}, []string{"ID", "c2", "c3", "c4"}, []string{"id"}, []string{"ID"}))
+	assert.Equal(t, "select id, c1 as c2, c3 from t", v.filterQuery())
+
+	// The reverse stream gives back its old name to the renamed column.
+	reverse := NewVRepl("wf_reverse", "ks", "0", "vt_ks", "zone1", "t", "_t_vrepl")
+	reverse.columnRenames = reverseColumnRenames(renames)
+	require.NoError(t, reverse.analyzeColumns([]string{"ID", "c2", "c3", "c4"}, []string{"id", "c1", "c3"}, []string{"ID"}, []string{"id"}))
+	assert.Equal(t, "select ID, c2 as c1, c3 from t", reverse.filterQuery())
+}
+
+func TestVReplInsertStatement(t *testing.T) {
+	v := NewVRepl("wf", "ks", "0", "vt_ks", "zone1", "t", "_t_vrepl")
+	require.NoError(t, v.analyzeColumns([]string{"id", "order", "c1"}, []string{"id", "order"}, []string{"id"}, []string{"id"}))
+	assert.Equal(t, "select id, `order` from t", v.filterQuery())
+
+	query := v.generateInsertStatement("MySQL56/00000000-0000-0000-0000-000000000000:1-10")
+	assert.Regexp(t, `^insert into _vt.vreplication\(.*\) values \('wf', 'keyspace:\\"ks\\" shard:\\"0\\" filter:<rules:<match:\\"_t_vrepl\\" filter:\\"select id, `+"`order`"+` from t\\" > > ', 'MySQL56/00000000-0000-0000-0000-000000000000:1-10', 9223372036854775807, 9223372036854775807, 'zone1', 'MASTER', [0-9]+, 0, 'Running', 'vt_ks'\)$`, query)
+}
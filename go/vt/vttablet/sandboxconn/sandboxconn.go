@@ -54,6 +54,10 @@ type SandboxConn struct {
 	MustFailSetRollback         int
 	MustFailConcludeTransaction int
 
+	// If set, Execute and StreamExecute wait until their context is done,
+	// and fail with its error, like the queries that are killed.
+	MustWaitForContext bool
+
 	// These Count vars report how often the corresponding
 	// functions were called.
 	ExecCount                sync2.AtomicInt64
@@ -162,6 +166,10 @@ func (sbc *SandboxConn) Execute(ctx context.Context, target *querypb.Target, que
 	if err := sbc.getError(); err != nil {
 		return nil, err
 	}
+	if sbc.MustWaitForContext {
+		<-ctx.Done()
+		return nil, vterrors.New(vtrpcpb.Code_CANCELED, ctx.Err().Error())
+	}
 
 	stmt, _ := sqlparser.Parse(query) // knowingly ignoring the error
 	return sbc.getNextResult(stmt), nil
@@ -203,6 +211,11 @@ func (sbc *SandboxConn) StreamExecute(ctx context.Context, target *querypb.Targe
 		sbc.sExecMu.Unlock()
 		return err
 	}
+	if sbc.MustWaitForContext {
+		sbc.sExecMu.Unlock()
+		<-ctx.Done()
+		return vterrors.New(vtrpcpb.Code_CANCELED, ctx.Err().Error())
+	}
 	parse, _ := sqlparser.Parse(query)
 	nextRs := sbc.getNextResult(parse)
 	sbc.sExecMu.Unlock()
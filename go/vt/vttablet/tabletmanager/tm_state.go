@@ -241,6 +241,11 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	// The vdiffs use the streams of the vreplication engine.
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type != topodatapb.TabletType_MASTER {
+		ts.tm.VDiffEngine.Close()
+	}
+
 	if ts.tm.VREngine != nil {
 		if ts.tablet.Type == topodatapb.TabletType_MASTER {
 			ts.tm.VREngine.Open(ts.tm.BatchCtx)
@@ -255,6 +260,11 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 			log.Errorf("Cannot start query service: %v", err)
 		}
 	}
+
+	// The vdiffs stream the rows of the target through the query service.
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type == topodatapb.TabletType_MASTER {
+		ts.tm.VDiffEngine.Open(ts.tm.BatchCtx)
+	}
 }
 
 func (ts *tmState) canServe(tabletType topodatapb.TabletType) string {
@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+// maxErrorLength is the length of the last_error column.
+const maxErrorLength = 1000
+
+// controller runs one vdiff. It diffs the tables of the workflow in
+// parallel, and records its state and the progress of each table,
+// so that the vdiff can resume after it's stopped.
+type controller struct {
+	e  *Engine
+	id int64
+
+	// cancel stops the vdiff, and done is closed when it's stopped.
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// The following fields are loaded when the vdiff starts.
+	uuid     string
+	workflow string
+	options  *Options
+	// sourceKeyspace is the keyspace of the sources of the workflow,
+	// and sources has the ids of the streams of each source shard.
+	sourceKeyspace string
+	sources        map[string][]int
+	filter         *binlogdatapb.Filter
+
+	tmc tmclient.TabletManagerClient
+}
+
+func newController(e *Engine, id int64) *controller {
+	return &controller{
+		e:    e,
+		id:   id,
+		done: make(chan struct{}),
+	}
+}
+
+// run runs the vdiff until it's complete, it fails, or ctx is canceled.
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	err := ct.diff(ctx)
+	switch {
+	case ctx.Err() != nil:
+		// The vdiff was stopped, or the engine was closed. Its state is
+		// left as is, so that it resumes when the engine opens again.
+		log.Infof("VDiff %d stopped: %v", ct.id, err)
+		return
+	case err != nil:
+		log.Errorf("VDiff %d failed: %v", ct.id, err)
+		message := err.Error()
+		if len(message) > maxErrorLength {
+			message = message[:maxErrorLength]
+		}
+		err = ct.exec(ctx, sqlFailVDiff, sqltypes.StringBindVariable(message), sqltypes.Int64BindVariable(ct.id))
+	default:
+		log.Infof("VDiff %d completed", ct.id)
+		err = ct.exec(ctx, sqlCompleteVDiff, sqltypes.Int64BindVariable(ct.id))
+	}
+	if err != nil {
+		log.Errorf("VDiff %d: could not record its state: %v", ct.id, err)
+	}
+}
+
+// stop stops the vdiff and waits for it to return.
+func (ct *controller) stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+// diff diffs the tables that are not complete, in parallel.
+func (ct *controller) diff(ctx context.Context) error {
+	if err := ct.exec(ctx, sqlStartVDiff, sqltypes.Int64BindVariable(ct.id)); err != nil {
+		return err
+	}
+	if err := ct.load(ctx); err != nil {
+		return err
+	}
+	log.Infof("Starting VDiff %s of workflow %s, options %+v", ct.uuid, ct.workflow, *ct.options)
+
+	schm, err := ct.e.mysqld.GetSchema(ctx, ct.e.dbName, nil, nil, false)
+	if err != nil {
+		return vterrors.Wrap(err, "GetSchema")
+	}
+	pl := &planner{
+		keyspace: ct.e.keyspace,
+		vschema: func() (*vindexes.VSchema, error) {
+			srvVSchema, err := ct.e.ts.GetSrvVSchema(ctx, ct.e.cell)
+			if err != nil {
+				return nil, err
+			}
+			return vindexes.BuildVSchema(srvVSchema)
+		},
+	}
+	plans, err := pl.buildTablePlans(ct.filter, schm, ct.options.Tables)
+	if err != nil {
+		return vterrors.Wrap(err, "buildTablePlans")
+	}
+	if len(plans) == 0 {
+		return fmt.Errorf("workflow %s has no tables to diff", ct.workflow)
+	}
+	names := make([]string, 0, len(plans))
+	for name, plan := range plans {
+		names = append(names, name)
+		if err := ct.exec(ctx, sqlInitVDiffTable, sqltypes.Int64BindVariable(ct.id), sqltypes.StringBindVariable(name), sqltypes.Uint64BindVariable(plan.table.RowCount)); err != nil {
+			return err
+		}
+	}
+	sort.Strings(names)
+	differs, err := ct.buildTableDiffers(ctx, plans)
+	if err != nil {
+		return err
+	}
+
+	ct.tmc = tmclient.NewTabletManagerClient()
+	defer ct.tmc.Close()
+
+	sema := make(chan struct{}, ct.options.MaxConcurrency)
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, name := range names {
+		td := differs[name]
+		if td == nil {
+			// The table is complete.
+			continue
+		}
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			select {
+			case sema <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sema }()
+			if err := td.run(ctx); err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "table %s", name))
+			}
+		}(name)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// load loads the vdiff and the streams of its workflow.
+func (ct *controller) load(ctx context.Context) error {
+	query, err := sqlparser.ParseAndBind(sqlGetVDiff, sqltypes.Int64BindVariable(ct.id))
+	if err != nil {
+		return err
+	}
+	qr, err := ct.e.execQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 {
+		return fmt.Errorf("vdiff %d not found", ct.id)
+	}
+	row := qr.Named().Row()
+	ct.uuid = row["vdiff_uuid"].ToString()
+	ct.workflow = row["workflow"].ToString()
+	ct.options = NewOptions()
+	if err := json.Unmarshal(row["options"].ToBytes(), ct.options); err != nil {
+		return vterrors.Wrap(err, "invalid options")
+	}
+	if ct.options.MaxConcurrency <= 0 {
+		ct.options.MaxConcurrency = 1
+	}
+
+	query, err = ct.workflowQuery(sqlGetWorkflowStreams)
+	if err != nil {
+		return err
+	}
+	qr, err = ct.e.execQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		return fmt.Errorf("workflow %s not found", ct.workflow)
+	}
+	ct.sources = make(map[string][]int)
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return err
+		}
+		var bls binlogdatapb.BinlogSource
+		if err := proto.UnmarshalText(row[1].ToString(), &bls); err != nil {
+			return err
+		}
+		if bls.Filter == nil {
+			return fmt.Errorf("stream %d of workflow %s has no filter", id, ct.workflow)
+		}
+		ct.sourceKeyspace = bls.Keyspace
+		ct.sources[bls.Shard] = append(ct.sources[bls.Shard], int(id))
+		ct.filter = bls.Filter
+	}
+	return nil
+}
+
+// buildTableDiffers builds the differs of the tables, which resume after
+// the progress they recorded. The tables that are complete have no differs.
+func (ct *controller) buildTableDiffers(ctx context.Context, plans map[string]*tablePlan) (map[string]*tableDiffer, error) {
+	query, err := sqlparser.ParseAndBind(sqlGetVDiffTables, sqltypes.Int64BindVariable(ct.id))
+	if err != nil {
+		return nil, err
+	}
+	qr, err := ct.e.execQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	differs := make(map[string]*tableDiffer)
+	for _, row := range qr.Named().Rows {
+		name := row["table_name"].ToString()
+		plan := plans[name]
+		if plan == nil || row["state"].ToString() == CompletedState {
+			continue
+		}
+		td := &tableDiffer{
+			ct:     ct,
+			plan:   plan,
+			report: &TableReport{TableName: name},
+		}
+		if td.lastpk, err = decodeLastPK(row["lastpk"].ToString()); err != nil {
+			return nil, vterrors.Wrapf(err, "table %s", name)
+		}
+		if report := row["report"].ToBytes(); len(report) != 0 {
+			if err := json.Unmarshal(report, td.report); err != nil {
+				return nil, vterrors.Wrapf(err, "table %s", name)
+			}
+		}
+		for shard := range ct.sources {
+			td.sources = append(td.sources, &shardStreamer{
+				shard:  shard,
+				filter: plan.filter,
+			})
+		}
+		td.target = &shardStreamer{
+			shard:  ct.e.shard,
+			stream: ct.e.targetStreamer(),
+		}
+		differs[name] = td
+	}
+	return differs, nil
+}
+
+// pickSourceTablet picks a tablet of the source shard.
+func (ct *controller) pickSourceTablet(ctx context.Context, shard string) (*topodatapb.Tablet, error) {
+	cell := ct.options.SourceCell
+	if cell == "" {
+		cell = ct.e.cell
+	}
+	tp, err := discovery.NewTabletPicker(ct.e.ts, []string{cell}, ct.sourceKeyspace, shard, ct.options.TabletTypes)
+	if err != nil {
+		return nil, err
+	}
+	return tp.PickForStreaming(ctx)
+}
+
+// restartTargets restarts the streams of the workflow.
+func (ct *controller) restartTargets() error {
+	query, err := ct.workflowQuery(sqlRestartWorkflow)
+	if err != nil {
+		return err
+	}
+	log.Infof("restarting target replication with %s", query)
+	_, err = ct.e.vre.Exec(query)
+	return err
+}
+
+func (ct *controller) updateTableState(ctx context.Context, table, state string) error {
+	return ct.exec(ctx, sqlUpdateTableState, sqltypes.StringBindVariable(state), sqltypes.Int64BindVariable(ct.id), sqltypes.StringBindVariable(table))
+}
+
+// workflowQuery binds the db name and the workflow to query.
+func (ct *controller) workflowQuery(query string) (string, error) {
+	return sqlparser.ParseAndBind(query, sqltypes.StringBindVariable(ct.e.dbName), sqltypes.StringBindVariable(ct.workflow))
+}
+
+func (ct *controller) exec(ctx context.Context, query string, binds ...*querypb.BindVariable) error {
+	query, err := sqlparser.ParseAndBind(query, binds...)
+	if err != nil {
+		return err
+	}
+	_, err = ct.e.execQuery(ctx, query)
+	return err
+}
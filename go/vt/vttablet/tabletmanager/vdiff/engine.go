@@ -0,0 +1,415 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vdiff runs the vdiffs of the workflows that replicate into a
+// target primary. A vdiff is a job that is stored in _vt.vdiff, and its
+// progress in _vt.vdiff_table, so that it resumes where it stopped after
+// the primary restarts or fails over. The vdiffs are created, stopped and
+// resumed through VExec.
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+	"vitess.io/vitess/go/vt/withddl"
+)
+
+var withDDL = withddl.New(vdiffDDLs)
+
+var openRetryInterval = sync2.NewAtomicDuration(1 * time.Second)
+
+var emptyResult = &sqltypes.Result{}
+
+// Engine runs the vdiffs of a target primary.
+type Engine struct {
+	ts     *topo.Server
+	cell   string
+	mysqld mysqlctl.MysqlDaemon
+	vre    *vreplication.Engine
+	qs     queryservice.QueryService
+
+	keyspace        string
+	shard           string
+	dbName          string
+	dbClientFactory func() binlogplayer.DBClient
+
+	// mu protects isOpen, controllers and the contexts.
+	mu          sync.Mutex
+	isOpen      bool
+	controllers map[int64]*controller
+	// ctx is the root context of the controllers, and cancel cancels it.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// syncMu serializes the syncs of the tables, which stop and restart
+	// the workflows.
+	syncMu sync.Mutex
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, vre *vreplication.Engine, qs queryservice.QueryService) *Engine {
+	return &Engine{
+		ts:          ts,
+		cell:        cell,
+		mysqld:      mysqld,
+		vre:         vre,
+		qs:          qs,
+		controllers: make(map[int64]*controller),
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (e *Engine) InitDBConfig(target querypb.Target, dbcfgs *dbconfigs.DBConfigs) {
+	e.keyspace = target.Keyspace
+	e.shard = target.Shard
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if e.dbClientFactory != nil {
+		return
+	}
+	e.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.FilteredWithDB())
+	}
+	e.dbName = dbcfgs.DBName
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, vre *vreplication.Engine, qs queryservice.QueryService, dbClientFactory func() binlogplayer.DBClient, dbname string) *Engine {
+	e := NewEngine(ts, cell, mysqld, vre, qs)
+	e.dbClientFactory = dbClientFactory
+	e.dbName = dbname
+	return e
+}
+
+// Open starts the Engine, and resumes the vdiffs that were running.
+func (e *Engine) Open(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.ts == nil || e.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+	e.ctx, e.cancel = context.WithCancel(ctx)
+	e.isOpen = true
+	go e.resumeAll(e.ctx)
+}
+
+// resumeAll resumes the vdiffs that are pending or started. It retries
+// until the vreplication engine is open and the vdiffs can be read.
+func (e *Engine) resumeAll(ctx context.Context) {
+	for {
+		err := e.resumeRunning(ctx)
+		if err == nil {
+			return
+		}
+		log.Errorf("VDiff Engine: could not resume the vdiffs: %v, will keep retrying.", err)
+		timer := time.NewTimer(openRetryInterval.Get())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (e *Engine) resumeRunning(ctx context.Context) error {
+	if !e.vre.IsOpen() {
+		return fmt.Errorf("vreplication engine is not open")
+	}
+	query, err := sqlparser.ParseAndBind(sqlGetResumableVDiffs, sqltypes.StringBindVariable(e.dbName))
+	if err != nil {
+		return err
+	}
+	qr, err := e.execQuery(ctx, query)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// Close cancels ctx within the lock.
+	if ctx.Err() != nil {
+		return nil
+	}
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return err
+		}
+		e.startControllerLocked(id)
+	}
+	return nil
+}
+
+// IsOpen returns true if Engine is open.
+func (e *Engine) IsOpen() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.isOpen
+}
+
+// Close stops the vdiffs and closes the Engine. The vdiffs keep their
+// state, and resume when the Engine opens again.
+func (e *Engine) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.isOpen {
+		return
+	}
+	e.cancel()
+	for _, ct := range e.controllers {
+		<-ct.done
+	}
+	e.controllers = make(map[int64]*controller)
+	e.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// startControllerLocked starts the vdiff, unless it's running or the
+// Engine is closed.
+func (e *Engine) startControllerLocked(id int64) {
+	if !e.isOpen {
+		return
+	}
+	if ct, ok := e.controllers[id]; ok {
+		select {
+		case <-ct.done:
+		default:
+			return
+		}
+	}
+	ct := newController(e, id)
+	var ctx context.Context
+	ctx, ct.cancel = context.WithCancel(e.ctx)
+	e.controllers[id] = ct
+	go ct.run(ctx)
+}
+
+// stopControllerLocked stops the vdiff, and waits for it to return.
+func (e *Engine) stopControllerLocked(id int64) {
+	if ct, ok := e.controllers[id]; ok {
+		ct.stop()
+		delete(e.controllers, id)
+	}
+}
+
+// VExec is called by a VExec invocation. Inserts create vdiffs, and updates
+// of their state stop or resume them.
+func (e *Engine) VExec(ctx context.Context, vx *vexec.TabletVExec) (*querypb.QueryResult, error) {
+	response := func(result *sqltypes.Result, err error) (*querypb.QueryResult, error) {
+		if err != nil {
+			return nil, err
+		}
+		return sqltypes.ResultToProto3(result), nil
+	}
+	if !e.IsOpen() {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed")
+	}
+
+	if vx.TableName == fmt.Sprintf("%s.%s", vexec.TableQualifier, VDiffTableTableName) {
+		if _, ok := vx.Stmt.(*sqlparser.Select); !ok {
+			return nil, fmt.Errorf("only SELECT statements are supported for this table. query=%s", vx.Query)
+		}
+		return response(e.execQuery(ctx, vx.Query))
+	}
+
+	switch stmt := vx.Stmt.(type) {
+	case *sqlparser.Delete:
+		return nil, fmt.Errorf("DELETE statements not supported for this table. query=%s", vx.Query)
+	case *sqlparser.Select:
+		return response(e.execQuery(ctx, vx.Query))
+	case *sqlparser.Insert:
+		match, err := sqlparser.QueryMatchesTemplates(vx.Query, vexecInsertTemplates)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			return nil, fmt.Errorf("Query must match one of these templates: %s", strings.Join(vexecInsertTemplates, "; "))
+		}
+		return response(e.createVDiff(ctx, vx))
+	case *sqlparser.Update:
+		match, err := sqlparser.QueryMatchesTemplates(vx.Query, vexecUpdateTemplates)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			return nil, fmt.Errorf("Query must match one of these templates: %s; query=%s", strings.Join(vexecUpdateTemplates, "; "), vx.Query)
+		}
+		state, err := vx.ColumnStringVal(vx.UpdateCols, "state")
+		if err != nil {
+			return nil, err
+		}
+		switch state {
+		case StoppedState:
+			return response(e.stopVDiffs(ctx, sqlparser.String(stmt.Where.Expr)))
+		case PendingState:
+			return response(e.resumeVDiffs(ctx, sqlparser.String(stmt.Where.Expr)))
+		default:
+			return nil, fmt.Errorf("Unexpected value for state: %v. Supported values are: %s, %s", state, StoppedState, PendingState)
+		}
+	default:
+		return nil, fmt.Errorf("No handler for this query: %s", vx.Query)
+	}
+}
+
+// createVDiff creates the vdiff and starts it. The vdiff is only created
+// if the workflow has streams on this tablet.
+func (e *Engine) createVDiff(ctx context.Context, vx *vexec.TabletVExec) (*sqltypes.Result, error) {
+	workflow, err := vx.ColumnStringVal(vx.InsertCols, "workflow")
+	if err != nil {
+		return nil, err
+	}
+	options, err := vx.ColumnStringVal(vx.InsertCols, "options")
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(options), NewOptions()); err != nil {
+		return nil, vterrors.Wrap(err, "invalid options")
+	}
+	query, err := sqlparser.ParseAndBind(sqlGetWorkflowStreams, sqltypes.StringBindVariable(e.dbName), sqltypes.StringBindVariable(workflow))
+	if err != nil {
+		return nil, err
+	}
+	qr, err := e.execQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		// The workflow doesn't replicate into this shard.
+		return emptyResult, nil
+	}
+
+	// Vexec naturally runs outside shard/schema context. It does not supply values for those columns.
+	// We can fill them in.
+	vx.ReplaceInsertColumnVal("shard", vx.ToStringVal(e.shard))
+	vx.ReplaceInsertColumnVal("db_name", vx.ToStringVal(e.dbName))
+	vx.ReplaceInsertColumnVal("state", vx.ToStringVal(PendingState))
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	qr, err = e.execQuery(ctx, vx.Query)
+	if err != nil {
+		return nil, err
+	}
+	e.startControllerLocked(int64(qr.InsertID))
+	return qr, nil
+}
+
+// stopVDiffs stops the vdiffs that match where.
+func (e *Engine) stopVDiffs(ctx context.Context, where string) (*sqltypes.Result, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.updateVDiffs(ctx, where, sqlStopVDiff, e.stopControllerLocked)
+}
+
+// resumeVDiffs resumes the vdiffs that match where, and that are stopped
+// or failed. They resume where they stopped.
+func (e *Engine) resumeVDiffs(ctx context.Context, where string) (*sqltypes.Result, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.updateVDiffs(ctx, where, sqlResumeVDiff, e.startControllerLocked)
+}
+
+// updateVDiffs updates the state of each vdiff that matches where with
+// update, and calls action for the vdiffs that were updated.
+func (e *Engine) updateVDiffs(ctx context.Context, where, update string, action func(id int64)) (*sqltypes.Result, error) {
+	qr, err := e.execQuery(ctx, fmt.Sprintf(sqlGetVDiffIDs, where))
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	for _, row := range qr.Rows {
+		id, err := row[0].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		query, err := sqlparser.ParseAndBind(update, sqltypes.Int64BindVariable(id))
+		if err != nil {
+			return nil, err
+		}
+		qr, err := e.execQuery(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		if qr.RowsAffected == 0 {
+			continue
+		}
+		result.RowsAffected += qr.RowsAffected
+		action(id)
+	}
+	return result, nil
+}
+
+// execQuery runs query on the local database, in the _vt database.
+func (e *Engine) execQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	dbClient := e.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+	if _, err := withDDL.Exec(ctx, "use _vt", dbClient.ExecuteFetch); err != nil {
+		return nil, err
+	}
+	return withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+}
+
+// sourceStreamer returns the streamFunc that streams rows from the source tablet.
+func (e *Engine) sourceStreamer(tablet *topodatapb.Tablet, target *querypb.Target) streamFunc {
+	return func(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+		conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+		return conn.VStreamResults(ctx, target, query, send)
+	}
+}
+
+// targetStreamer returns the streamFunc that streams rows from this tablet.
+func (e *Engine) targetStreamer() streamFunc {
+	target := &querypb.Target{
+		Keyspace:   e.keyspace,
+		Shard:      e.shard,
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	return func(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+		return e.qs.VStreamResults(ctx, target, query, send)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+)
+
+// newTestOpenEngine returns an Engine that is open, but that doesn't
+// resume the vdiffs.
+func newTestOpenEngine(dbClient *binlogplayer.MockDBClient) *Engine {
+	e := NewTestEngine(nil, "cell", nil, nil, nil, func() binlogplayer.DBClient { return dbClient }, "db")
+	e.shard = "-80"
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+	e.isOpen = true
+	return e
+}
+
+func analyze(t *testing.T, query string) *vexec.TabletVExec {
+	vx := vexec.NewTabletVExec("wf", "ks")
+	require.NoError(t, vx.AnalyzeQuery(context.Background(), query))
+	return vx
+}
+
+func TestVExecCreateVDiff(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	e := newTestOpenEngine(dbClient)
+	defer e.Close()
+	insert := `insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values ('uuid', 'wf', 'ks', '', '', 'pending', '{}')`
+
+	// The workflow has no streams on this tablet.
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id, source from _vt.vreplication where db_name='db' and workflow='wf'", &sqltypes.Result{}, nil)
+	qr, err := e.VExec(ctx, analyze(t, insert))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), qr.RowsAffected)
+	dbClient.Wait()
+
+	// The vdiff is created and started. It fails because it can't be
+	// loaded, which is recorded.
+	streams := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|source", "int64|varbinary"), `1|keyspace:"src" shard:"0"`)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id, source from _vt.vreplication where db_name='db' and workflow='wf'", streams, nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values ('uuid', 'wf', 'ks', '-80', 'db', 'pending', '{}')", &sqltypes.Result{RowsAffected: 1, InsertID: 5}, nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state='started', started_at=ifnull(started_at, now()), completed_at=null, last_error='' where id=5", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select vdiff_uuid, workflow, options from _vt.vdiff where id=5", nil, fmt.Errorf("error loading the vdiff"))
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state='error', last_error='error loading the vdiff' where id=5 and state='started'", &sqltypes.Result{}, nil)
+	qr, err = e.VExec(ctx, analyze(t, insert))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), qr.RowsAffected)
+	dbClient.Wait()
+}
+
+func TestVExecUpdateVDiffs(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	e := newTestOpenEngine(dbClient)
+	defer e.Close()
+	ids := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|state", "int64|varbinary"), "1|started", "2|completed")
+
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select id, state from _vt.vdiff where db_name = 'db' and workflow = 'wf'", ids, nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state='stopped' where id=1 and state in ('pending', 'started')", &sqltypes.Result{RowsAffected: 1}, nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state='stopped' where id=2 and state in ('pending', 'started')", &sqltypes.Result{}, nil)
+	qr, err := e.VExec(ctx, analyze(t, "update _vt.vdiff set state = 'stopped' where db_name = 'db' and workflow = 'wf'"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), qr.RowsAffected)
+	dbClient.Wait()
+
+	_, err = e.VExec(ctx, analyze(t, "update _vt.vdiff set state = 'completed' where vdiff_uuid = 'uuid' and db_name = 'db' and workflow = 'wf'"))
+	assert.EqualError(t, err, "Unexpected value for state: completed. Supported values are: stopped, pending")
+
+	_, err = e.VExec(ctx, analyze(t, "update _vt.vdiff set options = '' where vdiff_uuid = 'uuid' and db_name = 'db' and workflow = 'wf'"))
+	assert.Contains(t, err.Error(), "Query must match one of these templates")
+}
+
+func TestVExecUnsupported(t *testing.T) {
+	ctx := context.Background()
+	dbClient := binlogplayer.NewMockDBClient(t)
+	e := newTestOpenEngine(dbClient)
+	defer e.Close()
+
+	_, err := e.VExec(ctx, analyze(t, "delete from _vt.vdiff where db_name = 'db' and workflow = 'wf'"))
+	assert.EqualError(t, err, "DELETE statements not supported for this table. query=delete from _vt.vdiff where db_name = 'db' and workflow = 'wf'")
+	_, err = e.VExec(ctx, analyze(t, "update _vt.vdiff_table set state = 'completed' where vdiff_id = 1"))
+	assert.EqualError(t, err, "only SELECT statements are supported for this table. query=update _vt.vdiff_table set state = 'completed' where vdiff_id = 1")
+
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("select * from _vt.vdiff_table where vdiff_id = 1", &sqltypes.Result{}, nil)
+	_, err = e.VExec(ctx, analyze(t, "select * from _vt.vdiff_table where vdiff_id = 1"))
+	require.NoError(t, err)
+	dbClient.Wait()
+
+	e.Close()
+	_, err = e.VExec(ctx, analyze(t, "select * from _vt.vdiff"))
+	assert.EqualError(t, err, "vdiff engine is closed")
+}
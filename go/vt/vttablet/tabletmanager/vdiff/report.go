@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"time"
+)
+
+// The states of a vdiff, and of each of its tables.
+const (
+	// PendingState is the state of the vdiffs that wait to be started or resumed.
+	PendingState = "pending"
+	// StartedState is the state of the vdiffs that are running.
+	StartedState = "started"
+	// StoppedState is the state of the vdiffs that were stopped, and can be resumed.
+	StoppedState = "stopped"
+	// CompletedState is the state of the vdiffs that compared all the rows.
+	CompletedState = "completed"
+	// ErrorState is the state of the vdiffs that failed, and can be resumed.
+	ErrorState = "error"
+)
+
+// Options are the options of a vdiff. They are stored as JSON with it.
+type Options struct {
+	// SourceCell is the cell of the source tablets that are picked for
+	// the diff. It defaults to the cell of the target primary.
+	SourceCell string
+	// TabletTypes are the types of the source tablets that can be picked.
+	TabletTypes string
+	// Tables restricts the diff to these tables of the workflow.
+	Tables []string `json:",omitempty"`
+	// MaxConcurrency is the number of tables that are diffed in parallel.
+	MaxConcurrency int
+	// MaxReportKeys is the number of row keys of each kind of difference
+	// that is kept in the report of a table.
+	MaxReportKeys int
+	// FilteredReplicationWaitTime is how long the diff of a table waits for
+	// the sources and the workflow to reach the positions it compares at.
+	FilteredReplicationWaitTime time.Duration
+}
+
+// NewOptions returns the default options.
+func NewOptions() *Options {
+	return &Options{
+		TabletTypes:                 "master,replica,rdonly",
+		MaxConcurrency:              4,
+		MaxReportKeys:               100,
+		FilteredReplicationWaitTime: 30 * time.Second,
+	}
+}
+
+// RowKey is the primary key of a row: the values of its columns by name.
+type RowKey map[string]string
+
+// TableReport is the summary of the differences of one table.
+type TableReport struct {
+	TableName       string
+	ProcessedRows   int64
+	MatchingRows    int64
+	MismatchedRows  int64
+	ExtraRowsSource int64
+	ExtraRowsTarget int64
+
+	// The keys of the first rows of each kind of difference.
+	MismatchedRowsKeys  []RowKey `json:",omitempty"`
+	ExtraRowsSourceKeys []RowKey `json:",omitempty"`
+	ExtraRowsTargetKeys []RowKey `json:",omitempty"`
+}
+
+// HasMismatch returns true if the table differs.
+func (tr *TableReport) HasMismatch() bool {
+	return tr.MismatchedRows != 0 || tr.ExtraRowsSource != 0 || tr.ExtraRowsTarget != 0
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+const (
+	// VDiffTableName is used by VExec interceptor to call the correct handler
+	VDiffTableName = "vdiff"
+	// VDiffTableTableName is the table of the progress of each table of the vdiffs.
+	VDiffTableTableName = "vdiff_table"
+
+	sqlCreateSidecarDB  = "create database if not exists _vt"
+	sqlCreateVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint unsigned not null auto_increment,
+  vdiff_uuid varbinary(64) not null,
+  workflow varbinary(1000) not null,
+  keyspace varbinary(256) not null,
+  shard varbinary(255) not null,
+  db_name varbinary(255) not null,
+  state varbinary(64) not null,
+  options blob not null,
+  created_at timestamp not null default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  last_error varbinary(1000) not null default '',
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key state_idx (state))`
+	sqlCreateVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint unsigned not null,
+  table_name varbinary(128) not null,
+  state varbinary(64) not null,
+  lastpk varbinary(2000),
+  table_rows bigint not null default 0,
+  rows_compared bigint not null default 0,
+  mismatch tinyint not null default 0,
+  report blob,
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name))`
+
+	sqlGetResumableVDiffs = "select id from _vt.vdiff where db_name=%a and state in ('pending', 'started')"
+	sqlGetVDiff           = "select vdiff_uuid, workflow, options from _vt.vdiff where id=%a"
+	sqlGetVDiffIDs        = "select id, state from _vt.vdiff where %s"
+	sqlGetWorkflowStreams = "select id, source from _vt.vreplication where db_name=%a and workflow=%a"
+	sqlStartVDiff         = "update _vt.vdiff set state='started', started_at=ifnull(started_at, now()), completed_at=null, last_error='' where id=%a"
+	sqlCompleteVDiff      = "update _vt.vdiff set state='completed', completed_at=now() where id=%a and state='started'"
+	sqlFailVDiff          = "update _vt.vdiff set state='error', last_error=%a where id=%a and state='started'"
+	sqlStopVDiff          = "update _vt.vdiff set state='stopped' where id=%a and state in ('pending', 'started')"
+	sqlResumeVDiff        = "update _vt.vdiff set state='pending' where id=%a and state in ('stopped', 'error')"
+
+	sqlStopWorkflow         = "update _vt.vreplication set state='Stopped', message='for vdiff' where db_name=%a and workflow=%a"
+	sqlGetWorkflowPositions = "select source, pos from _vt.vreplication where db_name=%a and workflow=%a"
+	sqlSyncWorkflowStream   = "update _vt.vreplication set state='Running', stop_pos=%a, message='synchronizing for vdiff' where id=%a"
+	sqlRestartWorkflow      = "update _vt.vreplication set state='Running', message='', stop_pos='' where db_name=%a and workflow=%a"
+
+	sqlInitVDiffTable      = "insert ignore into _vt.vdiff_table(vdiff_id, table_name, state, table_rows) values (%a, %a, 'pending', %a)"
+	sqlGetVDiffTables      = "select table_name, state, lastpk, report from _vt.vdiff_table where vdiff_id=%a"
+	sqlUpdateTableState    = "update _vt.vdiff_table set state=%a where vdiff_id=%a and table_name=%a"
+	sqlUpdateTableProgress = "update _vt.vdiff_table set lastpk=%a, rows_compared=%a, mismatch=%a, report=%a where vdiff_id=%a and table_name=%a"
+)
+
+var vdiffDDLs = []string{
+	sqlCreateSidecarDB,
+	sqlCreateVDiffTable,
+	sqlCreateVDiffTableTable,
+}
+
+// vexecInsertTemplates are the inserts that create the vdiffs. The
+// shard and db_name are filled by the tablets.
+var vexecInsertTemplates = []string{
+	`insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values ('val', 'val', 'val', 'val', 'val', 'val', 'val')`,
+}
+
+// vexecUpdateTemplates are the updates that stop and resume the vdiffs.
+var vexecUpdateTemplates = []string{
+	`update _vt.vdiff set state='val' where vdiff_uuid='val' and db_name='val' and workflow='val'`,
+	`update _vt.vdiff set state='val' where db_name='val' and workflow='val'`,
+}
@@ -0,0 +1,555 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// checkpointRows is the number of rows that are compared between two
+// checkpoints of the progress of a table.
+var checkpointRows int64 = 10000
+
+// streamFunc streams the results of query, along with the gtid of its snapshot.
+type streamFunc func(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error
+
+// shardStreamer streams rows from one shard. This works for
+// the sources as well as the target.
+// shardStreamer satisfies engine.StreamExecutor, and can be
+// added to Primitives of engine.MergeSort.
+type shardStreamer struct {
+	shard string
+	// tablet is the source tablet that was picked for the diff.
+	// It's nil for the target, whose rows are streamed locally.
+	tablet *topodatapb.Tablet
+	stream streamFunc
+	// filter drops the rows of the sources that belong to other targets.
+	filter *keyrangeFilter
+
+	// position is the position that the source tablet must reach before
+	// its rows are streamed, and snapshotPosition the position they are
+	// streamed at.
+	position         mysql.Position
+	snapshotPosition string
+	result           chan *sqltypes.Result
+	err              error
+}
+
+// tableDiffer diffs one table of a vdiff. The diff resumes after lastpk,
+// and adds the differences it finds to report.
+type tableDiffer struct {
+	ct     *controller
+	plan   *tablePlan
+	lastpk []sqltypes.Value
+	report *TableReport
+
+	sources []*shardStreamer
+	target  *shardStreamer
+}
+
+// run diffs the table, and records its progress and its state.
+func (td *tableDiffer) run(ctx context.Context) error {
+	name := td.plan.table.Name
+	if err := td.ct.updateTableState(ctx, name, StartedState); err != nil {
+		return err
+	}
+	log.Infof("VDiff %s: starting the diff of table %s after %v", td.ct.uuid, name, td.lastpk)
+
+	// The streams are canceled when the diff ends, even if they were not
+	// consumed entirely.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	err := td.sync(ctx)
+	if err == nil {
+		err = td.diff(ctx)
+	}
+	switch {
+	case ctx.Err() != nil:
+		// The table stays started, and is resumed with the vdiff.
+		return err
+	case err != nil:
+		if serr := td.ct.updateTableState(ctx, name, ErrorState); serr != nil {
+			log.Errorf("VDiff %s: could not record the error of table %s: %v", td.ct.uuid, name, serr)
+		}
+		return err
+	}
+	log.Infof("VDiff %s: table %s is complete: %+v", td.ct.uuid, name, *td.report)
+	return td.ct.updateTableState(ctx, name, CompletedState)
+}
+
+// sync stops the workflow, and starts the streams of the sources and of the
+// target at the same position of the sources. The workflow is restarted when
+// the streams have started. The streams run after sync returns, and are
+// consumed by diff.
+func (td *tableDiffer) sync(ctx context.Context) (err error) {
+	ct := td.ct
+	e := ct.e
+	// The tables of the vdiffs share the workflows, which they stop and
+	// restart. Their diffs can run in parallel, but not their syncs.
+	e.syncMu.Lock()
+	defer e.syncMu.Unlock()
+
+	// The keyspace lock prevents the traffic of the workflow from being
+	// switched while it is stopped.
+	_, unlock, err := e.ts.LockKeyspace(ctx, e.keyspace, "vdiff")
+	if err != nil {
+		return vterrors.Wrap(err, "LockKeyspace")
+	}
+	defer unlock(&err)
+
+	defer func() {
+		if rerr := ct.restartTargets(); rerr != nil {
+			log.Errorf("VDiff %s: could not restart workflow %s: %v, please restart it manually", ct.uuid, ct.workflow, rerr)
+			if err == nil {
+				err = rerr
+			}
+		}
+	}()
+	// Stop the targets and record their source positions.
+	if err = td.stopTargets(ctx); err != nil {
+		return vterrors.Wrap(err, "stopTargets")
+	}
+	if err = td.pickSources(ctx); err != nil {
+		return vterrors.Wrap(err, "pickSources")
+	}
+	// Make sure all sources are past the target's positions and start a query stream that records the current source positions.
+	query, err := td.plan.sourceQuery(td.lastpk)
+	if err != nil {
+		return err
+	}
+	if err = td.startQueryStreams(ctx, td.sources, query); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(sources)")
+	}
+	// Fast forward the targets to the newly recorded source positions.
+	if err = td.syncTargets(ctx); err != nil {
+		return vterrors.Wrap(err, "syncTargets")
+	}
+	// Sources and targets are in sync. Start query streams on the targets.
+	query, err = td.plan.targetQuery(td.lastpk)
+	if err != nil {
+		return err
+	}
+	if err = td.startQueryStreams(ctx, []*shardStreamer{td.target}, query); err != nil {
+		return vterrors.Wrap(err, "startQueryStreams(target)")
+	}
+	// Now that queries are running, target vreplication streams can be restarted.
+	return nil
+}
+
+// stopTargets stops the streams of the workflow and records their source positions.
+func (td *tableDiffer) stopTargets(ctx context.Context) error {
+	ct := td.ct
+	query, err := ct.workflowQuery(sqlStopWorkflow)
+	if err != nil {
+		return err
+	}
+	if _, err := ct.e.vre.Exec(query); err != nil {
+		return err
+	}
+	query, err = ct.workflowQuery(sqlGetWorkflowPositions)
+	if err != nil {
+		return err
+	}
+	qr, err := ct.e.vre.Exec(query)
+	if err != nil {
+		return err
+	}
+	sources := make(map[string]*shardStreamer, len(td.sources))
+	for _, source := range td.sources {
+		sources[source.shard] = source
+	}
+	for _, row := range qr.Rows {
+		var bls binlogdatapb.BinlogSource
+		if err := proto.UnmarshalText(row[0].ToString(), &bls); err != nil {
+			return err
+		}
+		pos, err := mysql.DecodePosition(row[1].ToString())
+		if err != nil {
+			return err
+		}
+		source, ok := sources[bls.Shard]
+		if !ok {
+			return fmt.Errorf("workflow %s has a new source shard %s, please restart the vdiff", ct.workflow, bls.Shard)
+		}
+		if !source.position.IsZero() && source.position.AtLeast(pos) {
+			continue
+		}
+		source.position = pos
+	}
+	return nil
+}
+
+// pickSources picks the source tablets whose rows are diffed.
+func (td *tableDiffer) pickSources(ctx context.Context) error {
+	return forAll(td.sources, func(source *shardStreamer) error {
+		tablet, err := td.ct.pickSourceTablet(ctx, source.shard)
+		if err != nil {
+			return err
+		}
+		target := &querypb.Target{
+			Keyspace:   td.ct.sourceKeyspace,
+			Shard:      source.shard,
+			TabletType: tablet.Type,
+		}
+		source.tablet = tablet
+		source.stream = td.ct.e.sourceStreamer(tablet, target)
+		return nil
+	})
+}
+
+// startQueryStreams makes sure the sources are past the target's positions, starts the query streams,
+// and records the snapshot position of the query. It creates a result channel which StreamExecute
+// will use to serve rows.
+func (td *tableDiffer) startQueryStreams(ctx context.Context, participants []*shardStreamer, query string) error {
+	waitCtx, cancel := context.WithTimeout(ctx, td.ct.options.FilteredReplicationWaitTime)
+	defer cancel()
+	return forAll(participants, func(participant *shardStreamer) error {
+		// The target is stopped at the position of the sources, so that
+		// only the sources have to wait.
+		if participant.tablet != nil {
+			if participant.position.IsZero() {
+				return fmt.Errorf("workflow %s: stream has not started for source shard %s", td.ct.workflow, participant.shard)
+			}
+			log.Infof("WaitForPosition: tablet %s should reach position %s", participant.tablet.Alias.String(), mysql.EncodePosition(participant.position))
+			if err := td.ct.tmc.WaitForPosition(waitCtx, participant.tablet, mysql.EncodePosition(participant.position)); err != nil {
+				return vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(participant.tablet.Alias))
+			}
+		}
+		participant.result = make(chan *sqltypes.Result, 1)
+		gtidch := make(chan string, 1)
+
+		// Start the stream in a separate goroutine.
+		go streamOne(ctx, participant, query, gtidch)
+
+		// Wait for the gtid to be sent. If it's not received, there was an error
+		// which would be stored in participant.err.
+		gtid, ok := <-gtidch
+		if !ok {
+			return participant.err
+		}
+		// Save the new position, as of when the query executed.
+		participant.snapshotPosition = gtid
+		return nil
+	})
+}
+
+// streamOne is called as a goroutine, and communicates its results through channels.
+// It first sends the snapshot gtid to gtidch.
+// Then it streams results to participant.result.
+// Before returning, it sets participant.err, and closes all channels.
+// If any channel is closed, then participant.err can be checked if there was an error.
+// The shardStreamer's StreamExecute consumes the result channel.
+func streamOne(ctx context.Context, participant *shardStreamer, query string, gtidch chan string) {
+	defer close(participant.result)
+	defer close(gtidch)
+
+	// Wrap the streaming in a separate function so we can capture the error.
+	// This shows that the error will be set before the channels are closed.
+	participant.err = func() error {
+		var fields []*querypb.Field
+		return participant.stream(ctx, query, func(vrs *binlogdatapb.VStreamResultsResponse) error {
+			if vrs.Fields != nil {
+				fields = vrs.Fields
+				gtidch <- vrs.Gtid
+			}
+			p3qr := &querypb.QueryResult{
+				Fields: fields,
+				Rows:   vrs.Rows,
+			}
+			result := sqltypes.Proto3ToResult(p3qr)
+			// Fields should be received only once, and sent only once.
+			if vrs.Fields == nil {
+				result.Fields = nil
+			}
+			if participant.filter != nil {
+				rows, err := participant.filter.filter(result.Rows)
+				if err != nil {
+					return err
+				}
+				result.Rows = rows
+			}
+			select {
+			case participant.result <- result:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "VStreamResults")
+			}
+			return nil
+		})
+	}()
+}
+
+// syncTargets fast-forwards the streams of the workflow to the source
+// snapshot positions, where they stop.
+func (td *tableDiffer) syncTargets(ctx context.Context) error {
+	waitCtx, cancel := context.WithTimeout(ctx, td.ct.options.FilteredReplicationWaitTime)
+	defer cancel()
+	for _, source := range td.sources {
+		for _, id := range td.ct.sources[source.shard] {
+			query, err := sqlparser.ParseAndBind(sqlSyncWorkflowStream, sqltypes.StringBindVariable(source.snapshotPosition), sqltypes.Int64BindVariable(int64(id)))
+			if err != nil {
+				return err
+			}
+			if _, err := td.ct.e.vre.Exec(query); err != nil {
+				return err
+			}
+			if err := td.ct.e.vre.WaitForPos(waitCtx, id, source.snapshotPosition); err != nil {
+				return vterrors.Wrapf(err, "WaitForPos for stream %d", id)
+			}
+		}
+	}
+	return nil
+}
+
+func forAll(participants []*shardStreamer, f func(*shardStreamer) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, participant := range participants {
+		wg.Add(1)
+		go func(participant *shardStreamer) {
+			defer wg.Done()
+
+			if err := f(participant); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(participant)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// diff compares the rows of the sources and of the target, which are
+// sorted by primary key. It periodically saves its progress, and saves
+// it again when it returns, so that the diff can resume where it stopped.
+func (td *tableDiffer) diff(ctx context.Context) (err error) {
+	plan := td.plan
+	var sourcePrimitive engine.Primitive = newMergeSorter(td.sources, plan.comparePKs)
+	// If there were aggregate expressions, we have to re-aggregate
+	// the results, which engine.OrderedAggregate can do.
+	if len(plan.aggregates) != 0 {
+		sourcePrimitive = &engine.OrderedAggregate{
+			Aggregates: plan.aggregates,
+			Keys:       plan.comparePKs,
+			Input:      sourcePrimitive,
+		}
+	}
+	sourceExecutor := newPrimitiveExecutor(ctx, sourcePrimitive)
+	targetExecutor := newPrimitiveExecutor(ctx, newMergeSorter([]*shardStreamer{td.target}, plan.comparePKs))
+
+	defer func() {
+		if serr := td.saveProgress(ctx); serr != nil && err == nil {
+			err = serr
+		}
+	}()
+	dr := td.report
+	var sourceRow, targetRow []sqltypes.Value
+	advanceSource := true
+	advanceTarget := true
+	for rows := int64(1); ; rows++ {
+		if advanceSource {
+			sourceRow, err = sourceExecutor.next()
+			if err != nil {
+				return err
+			}
+		}
+		if advanceTarget {
+			targetRow, err = targetExecutor.next()
+			if err != nil {
+				return err
+			}
+		}
+
+		if sourceRow == nil && targetRow == nil {
+			return nil
+		}
+
+		advanceSource = true
+		advanceTarget = true
+
+		// Compare pk values. The rows that remain on one side after the
+		// other is exhausted are extra rows.
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			c, err = compare(sourceRow, targetRow, plan.comparePKs)
+			if err != nil {
+				return err
+			}
+		}
+		switch {
+		case c < 0:
+			dr.ExtraRowsSource++
+			dr.ExtraRowsSourceKeys = td.appendKey(dr.ExtraRowsSourceKeys, sourceRow)
+			td.setLastPK(sourceRow)
+			advanceTarget = false
+		case c > 0:
+			dr.ExtraRowsTarget++
+			dr.ExtraRowsTargetKeys = td.appendKey(dr.ExtraRowsTargetKeys, targetRow)
+			td.setLastPK(targetRow)
+			advanceSource = false
+		default:
+			// Compare non-pk values.
+			c, err = compare(sourceRow, targetRow, plan.compareCols)
+			if err != nil {
+				return err
+			}
+			if c != 0 {
+				dr.MismatchedRows++
+				dr.MismatchedRowsKeys = td.appendKey(dr.MismatchedRowsKeys, targetRow)
+			} else {
+				dr.MatchingRows++
+			}
+			td.setLastPK(targetRow)
+		}
+		dr.ProcessedRows++
+
+		if rows%checkpointRows == 0 {
+			if err := td.saveProgress(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func compare(sourceRow, targetRow []sqltypes.Value, cols []int) (int, error) {
+	for _, col := range cols {
+		if col == -1 {
+			continue
+		}
+		c, err := evalengine.NullsafeCompare(sourceRow[col], targetRow[col])
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// setLastPK records the primary key of the last row that was compared.
+// The rows of both sides that don't follow it were all compared.
+func (td *tableDiffer) setLastPK(row []sqltypes.Value) {
+	lastpk := make([]sqltypes.Value, 0, len(td.plan.pkCols))
+	for _, col := range td.plan.pkCols {
+		lastpk = append(lastpk, row[col])
+	}
+	td.lastpk = lastpk
+}
+
+// appendKey appends the key of row to keys, up to the maximum of the report.
+func (td *tableDiffer) appendKey(keys []RowKey, row []sqltypes.Value) []RowKey {
+	if len(keys) >= td.ct.options.MaxReportKeys {
+		return keys
+	}
+	rowKey := make(RowKey, len(td.plan.pkCols))
+	for i, col := range td.plan.pkCols {
+		rowKey[td.plan.pkFields[i].Name] = row[col].ToString()
+	}
+	return append(keys, rowKey)
+}
+
+// saveProgress saves the last primary key that was compared and the report.
+func (td *tableDiffer) saveProgress(ctx context.Context) error {
+	if td.lastpk == nil {
+		return nil
+	}
+	lastpk, err := encodeLastPK(td.plan.pkFields, td.lastpk)
+	if err != nil {
+		return err
+	}
+	report, err := json.Marshal(td.report)
+	if err != nil {
+		return err
+	}
+	mismatch := int64(0)
+	if td.report.HasMismatch() {
+		mismatch = 1
+	}
+	query, err := sqlparser.ParseAndBind(sqlUpdateTableProgress,
+		sqltypes.StringBindVariable(lastpk),
+		sqltypes.Int64BindVariable(td.report.ProcessedRows),
+		sqltypes.Int64BindVariable(mismatch),
+		sqltypes.StringBindVariable(string(report)),
+		sqltypes.Int64BindVariable(td.ct.id),
+		sqltypes.StringBindVariable(td.plan.table.Name),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = td.ct.e.execQuery(ctx, query)
+	return err
+}
+
+// encodeLastPK encodes the primary key of a row like the lastpk of
+// the copy state of vreplication: as a text QueryResult.
+func encodeLastPK(fields []*querypb.Field, lastpk []sqltypes.Value) (string, error) {
+	qr := &querypb.QueryResult{
+		Fields: fields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(lastpk)},
+	}
+	return proto.CompactTextString(qr), nil
+}
+
+// decodeLastPK decodes a primary key that was encoded by encodeLastPK.
+func decodeLastPK(encoded string) ([]sqltypes.Value, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	var qr querypb.QueryResult
+	if err := proto.UnmarshalText(encoded, &qr); err != nil {
+		return nil, err
+	}
+	result := sqltypes.Proto3ToResult(&qr)
+	if len(result.Rows) != 1 {
+		return nil, fmt.Errorf("invalid lastpk: %s", encoded)
+	}
+	return result.Rows[0], nil
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range sm.result {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return sm.err
+}
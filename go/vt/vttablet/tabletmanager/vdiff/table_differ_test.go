@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// fakeStreamer returns a streamFunc that streams the rows in two
+// responses, and records the query that it streamed.
+func fakeStreamer(result *sqltypes.Result, query *string) streamFunc {
+	return func(ctx context.Context, q string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+		*query = q
+		p3qr := sqltypes.ResultToProto3(result)
+		half := len(p3qr.Rows) / 2
+		if err := send(&binlogdatapb.VStreamResultsResponse{
+			Fields: p3qr.Fields,
+			Gtid:   "MySQL56/00000000-0000-0000-0000-000000000000:1-10",
+			Rows:   p3qr.Rows[:half],
+		}); err != nil {
+			return err
+		}
+		return send(&binlogdatapb.VStreamResultsResponse{
+			Rows: p3qr.Rows[half:],
+		})
+	}
+}
+
+func newTestTableDiffer(t *testing.T, dbClient *binlogplayer.MockDBClient, rule *binlogdatapb.Rule) *tableDiffer {
+	e := NewTestEngine(nil, "cell", nil, nil, nil, func() binlogplayer.DBClient { return dbClient }, "db")
+	options := NewOptions()
+	options.MaxReportKeys = 2
+	ct := newController(e, 1)
+	ct.uuid = "uuid"
+	ct.options = options
+	plans, err := newTestPlanner(t).buildTablePlans(&binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{rule}}, testSchema, nil)
+	require.NoError(t, err)
+	plan := plans[rule.Match]
+	return &tableDiffer{
+		ct:     ct,
+		plan:   plan,
+		report: &TableReport{TableName: rule.Match},
+	}
+}
+
+func TestTableDifferDiff(t *testing.T) {
+	defer func(saved int64) { checkpointRows = saved }(checkpointRows)
+	checkpointRows = 3
+
+	dbClient := binlogplayer.NewMockDBClient(t)
+	td := newTestTableDiffer(t, dbClient, &binlogdatapb.Rule{Match: "t1", Filter: "-80"})
+	fields := testSchema.TableDefinitions[0].Fields
+
+	// The keyspace ids of 1, 2, 3 and 5 are in -80, and the keyspace
+	// id of 4 is in 80-, so that the source row 4 is filtered out.
+	var source1Query, source2Query, targetQuery string
+	td.sources = []*shardStreamer{{
+		shard:  "-40",
+		stream: fakeStreamer(sqltypes.MakeTestResult(fields, "1|a", "3|c", "4|d"), &source1Query),
+		filter: td.plan.filter,
+	}, {
+		shard:  "40-",
+		stream: fakeStreamer(sqltypes.MakeTestResult(fields, "2|b", "5|e"), &source2Query),
+		filter: td.plan.filter,
+	}}
+	td.target = &shardStreamer{
+		shard:  "-80",
+		stream: fakeStreamer(sqltypes.MakeTestResult(fields, "1|a", "2|x", "5|e", "6|f"), &targetQuery),
+	}
+	td.lastpk = []sqltypes.Value{sqltypes.NewInt64(0)}
+
+	ctx := context.Background()
+	query, err := td.plan.sourceQuery(td.lastpk)
+	require.NoError(t, err)
+	require.NoError(t, td.startQueryStreams(ctx, td.sources, query))
+	query, err = td.plan.targetQuery(td.lastpk)
+	require.NoError(t, err)
+	require.NoError(t, td.startQueryStreams(ctx, []*shardStreamer{td.target}, query))
+
+	// The progress is saved after 3 rows, and at the end.
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequestRE(`update _vt.vdiff_table set lastpk='fields:<name:\\"c1\\" type:INT64 > rows:<lengths:1 values:\\"3\\" > ', rows_compared=3, mismatch=1, .* where vdiff_id=1 and table_name='t1'`, &sqltypes.Result{}, nil)
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequestRE(`update _vt.vdiff_table set lastpk='fields:<name:\\"c1\\" type:INT64 > rows:<lengths:1 values:\\"6\\" > ', rows_compared=5, mismatch=1, .* where vdiff_id=1 and table_name='t1'`, &sqltypes.Result{}, nil)
+	require.NoError(t, td.diff(ctx))
+	dbClient.Wait()
+
+	assert.Equal(t, "select c1, c2 from t1 where c1 > 0 order by c1 asc", source1Query)
+	assert.Equal(t, "select c1, c2 from t1 where c1 > 0 order by c1 asc", source2Query)
+	assert.Equal(t, "select c1, c2 from t1 where c1 > 0 order by c1 asc", targetQuery)
+	want := &TableReport{
+		TableName:           "t1",
+		ProcessedRows:       5,
+		MatchingRows:        2,
+		MismatchedRows:      1,
+		ExtraRowsSource:     1,
+		ExtraRowsTarget:     1,
+		MismatchedRowsKeys:  []RowKey{{"c1": "2"}},
+		ExtraRowsSourceKeys: []RowKey{{"c1": "3"}},
+		ExtraRowsTargetKeys: []RowKey{{"c1": "6"}},
+	}
+	assert.Equal(t, want, td.report)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(6)}, td.lastpk)
+}
+
+func TestTableDifferReportKeys(t *testing.T) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	td := newTestTableDiffer(t, dbClient, &binlogdatapb.Rule{Match: "multipk"})
+	fields := testSchema.TableDefinitions[1].Fields
+
+	var sourceQuery, targetQuery string
+	td.sources = []*shardStreamer{{
+		shard:  "0",
+		stream: fakeStreamer(sqltypes.MakeTestResult(fields, "1|1|1", "1|2|1", "1|3|1", "2|1|1"), &sourceQuery),
+	}}
+	td.target = &shardStreamer{
+		shard:  "0",
+		stream: fakeStreamer(sqltypes.MakeTestResult(fields, "1|1|2", "1|2|2", "1|3|2", "2|1|2"), &targetQuery),
+	}
+
+	ctx := context.Background()
+	query, err := td.plan.sourceQuery(td.lastpk)
+	require.NoError(t, err)
+	require.NoError(t, td.startQueryStreams(ctx, td.sources, query))
+	query, err = td.plan.targetQuery(td.lastpk)
+	require.NoError(t, err)
+	require.NoError(t, td.startQueryStreams(ctx, []*shardStreamer{td.target}, query))
+
+	dbClient.ExpectRequest("use _vt", &sqltypes.Result{}, nil)
+	dbClient.ExpectRequestRE(`update _vt.vdiff_table set lastpk=.*, rows_compared=4, mismatch=1, .* where vdiff_id=1 and table_name='multipk'`, &sqltypes.Result{}, nil)
+	require.NoError(t, td.diff(ctx))
+	dbClient.Wait()
+
+	// The keys are capped at MaxReportKeys.
+	assert.Equal(t, int64(4), td.report.MismatchedRows)
+	assert.Equal(t, []RowKey{{"c1": "1", "c2": "1"}, {"c1": "1", "c2": "2"}}, td.report.MismatchedRowsKeys)
+}
+
+func TestEncodeLastPK(t *testing.T) {
+	fields := testSchema.TableDefinitions[1].Fields[:2]
+	lastpk := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(20)}
+	encoded, err := encodeLastPK(fields, lastpk)
+	require.NoError(t, err)
+	decoded, err := decodeLastPK(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, lastpk, decoded)
+
+	decoded, err = decodeLastPK("")
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+}
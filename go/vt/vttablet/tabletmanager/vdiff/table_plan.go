@@ -0,0 +1,545 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+)
+
+// tablePlan is the plan of the diff of one table.
+type tablePlan struct {
+	table *tabletmanagerdatapb.TableDefinition
+
+	// sourceSelect and targetSelect are the queries of the rows, without
+	// the condition that skips the rows that were already compared.
+	sourceSelect *sqlparser.Select
+	targetSelect *sqlparser.Select
+
+	// compareCols is the list of non-pk columns to compare.
+	// If the value is -1, it's a pk column and should not be
+	// compared.
+	compareCols []int
+	// comparePKs is the list of pk columns to compare. The logic
+	// for comparing pk columns is different from compareCols
+	comparePKs []int
+
+	// pkCols are the columns of the values of the primary key in the
+	// rows, and pkFields their fields in the target table. Unlike
+	// comparePKs, they never point at weight_string columns.
+	pkCols   []int
+	pkFields []*querypb.Field
+	// sourcePKExprs are the expressions of the primary key in the source.
+	sourcePKExprs []sqlparser.Expr
+
+	// aggregates contains the list if Aggregate functions, if any.
+	aggregates []engine.AggregateParams
+
+	// filter drops the source rows that belong to other target shards.
+	filter *keyrangeFilter
+}
+
+// keyrangeFilter drops the source rows whose keyspace ids are outside
+// of the keyrange of the target shard. MySQL can't evaluate in_keyrange,
+// so the sources stream all the rows of their shards.
+type keyrangeFilter struct {
+	vindex   vindexes.Vindex
+	cols     []int
+	keyRange *topodatapb.KeyRange
+}
+
+// planner builds the table plans of a workflow.
+type planner struct {
+	// keyspace is the target keyspace, whose vschema resolves the
+	// vindexes of the keyrange filters.
+	keyspace string
+	vschema  func() (*vindexes.VSchema, error)
+}
+
+// buildTablePlans builds the plans of the tables of the workflow.
+func (pl *planner) buildTablePlans(filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, tablesToInclude []string) (map[string]*tablePlan, error) {
+	plans := make(map[string]*tablePlan)
+	for _, table := range schm.TableDefinitions {
+		rule, err := vreplication.MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == "exclude" {
+			continue
+		}
+		if len(tablesToInclude) > 0 && !containsTable(tablesToInclude, table.Name) {
+			continue
+		}
+		query := rule.Filter
+		keyRange := ""
+		if rule.Filter == "" || key.IsKeyRange(rule.Filter) {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+			query = buf.String()
+			keyRange = rule.Filter
+		}
+		plans[table.Name], err = pl.buildTablePlan(table, query, keyRange)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(tablesToInclude) > 0 && len(tablesToInclude) != len(plans) {
+		return nil, fmt.Errorf("one or more tables provided are not present in the workflow: %v", tablesToInclude)
+	}
+	return plans, nil
+}
+
+func containsTable(tables []string, name string) bool {
+	for _, t := range tables {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// buildTablePlan builds the plan of one table. keyRange is the keyrange of
+// the rule of the table, if it has no query.
+func (pl *planner) buildTablePlan(table *tabletmanagerdatapb.TableDefinition, query, keyRange string) (*tablePlan, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	tp := &tablePlan{
+		table: table,
+	}
+	sourceSelect := &sqlparser.Select{}
+	targetSelect := &sqlparser.Select{}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				aliased := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, aliased)
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, aliased)
+			}
+		case *sqlparser.AliasedExpr:
+			var targetCol *sqlparser.ColName
+			if !selExpr.As.IsEmpty() {
+				targetCol = &sqlparser.ColName{Name: selExpr.As}
+			} else {
+				if colAs, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+					targetCol = colAs
+				} else {
+					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
+				}
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+
+			// Check if it's an aggregate expression
+			if expr, ok := selExpr.Expr.(*sqlparser.FuncExpr); ok {
+				switch fname := expr.Name.Lowered(); fname {
+				case "count", "sum":
+					tp.aggregates = append(tp.aggregates, engine.AggregateParams{
+						Opcode: engine.SupportedAggregates[fname],
+						Col:    len(sourceSelect.SelectExprs) - 1,
+					})
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+		}
+	}
+	fields := make(map[string]*querypb.Field)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = field
+	}
+
+	// Start with adding all columns for comparison.
+	numCols := len(sourceSelect.SelectExprs)
+	tp.compareCols = make([]int, numCols)
+	for i := range tp.compareCols {
+		colname := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
+		field, ok := fields[colname]
+		if !ok {
+			return nil, fmt.Errorf("column %v not found in table %v", colname, table.Name)
+		}
+		tp.compareCols[i] = i
+		if sqltypes.IsText(field.Type) {
+			// For text columns, we need to additionally pull their weight string values for lexical comparisons.
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, wrapWeightString(sourceSelect.SelectExprs[i]))
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, wrapWeightString(targetSelect.SelectExprs[i]))
+			// Update the column number to point at the weight_string column instead.
+			tp.compareCols[i] = len(sourceSelect.SelectExprs) - 1
+		}
+	}
+
+	sourceSelect.From = sel.From
+	// The target table name should the one that matched the rule.
+	// It can be different from the source table.
+	targetSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: &sqlparser.TableName{
+				Name: sqlparser.NewTableIdent(table.Name),
+			},
+		},
+	}
+
+	orderby, err := tp.findPKs(fields, sourceSelect, targetSelect)
+	if err != nil {
+		return nil, err
+	}
+
+	// The keyrange of the rule becomes a filter of the source rows.
+	inKeyrange, err := findInKeyrange(sel.Where)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case inKeyrange != nil:
+		tp.filter, err = pl.buildInKeyrangeFilter(table.Name, inKeyrange.Exprs, sourceSelect)
+	case keyRange != "":
+		tp.filter, err = pl.buildKeyrangeFilter(table.Name, nil, keyRange, sourceSelect)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if tp.filter != nil && len(tp.aggregates) != 0 {
+		return nil, fmt.Errorf("unsupported: aggregates in the rule of table %v, which streams from a subset of the source rows", table.Name)
+	}
+
+	// Remove in_keyrange. It's not understood by mysql.
+	sourceSelect.Where = removeKeyrange(sel.Where)
+	// The source should also perform the group by.
+	sourceSelect.GroupBy = sel.GroupBy
+	sourceSelect.OrderBy = orderby
+
+	// The target should perform the order by, but not the group by.
+	targetSelect.OrderBy = orderby
+
+	tp.sourceSelect = sourceSelect
+	tp.targetSelect = targetSelect
+	return tp, nil
+}
+
+// findPKs identifies PKs and removes them from the columns to do data comparison
+func (tp *tablePlan) findPKs(fields map[string]*querypb.Field, sourceSelect, targetSelect *sqlparser.Select) (sqlparser.OrderBy, error) {
+	var orderby sqlparser.OrderBy
+	for _, pk := range tp.table.PrimaryKeyColumns {
+		found := false
+		for i, selExpr := range targetSelect.SelectExprs {
+			expr := selExpr.(*sqlparser.AliasedExpr).Expr
+			colname := ""
+			switch ct := expr.(type) {
+			case *sqlparser.ColName:
+				colname = ct.Name.String()
+			case *sqlparser.FuncExpr: //eg. weight_string()
+				//no-op
+			default:
+				log.Warningf("Not considering column %v for PK, type %v not handled", selExpr, ct)
+			}
+			if strings.EqualFold(pk, colname) {
+				tp.comparePKs = append(tp.comparePKs, tp.compareCols[i])
+				tp.pkCols = append(tp.pkCols, i)
+				tp.pkFields = append(tp.pkFields, &querypb.Field{Name: pk, Type: fields[strings.ToLower(pk)].Type})
+				tp.sourcePKExprs = append(tp.sourcePKExprs, sourceSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr)
+				// We'll be comparing pks separately. So, remove them from compareCols.
+				tp.compareCols[i] = -1
+				found = true
+				break
+			}
+		}
+		if !found {
+			// Unreachable.
+			return nil, fmt.Errorf("column %v not found in table %v", pk, tp.table.Name)
+		}
+		orderby = append(orderby, &sqlparser.Order{
+			Expr:      &sqlparser.ColName{Name: sqlparser.NewColIdent(pk)},
+			Direction: sqlparser.AscOrder,
+		})
+	}
+	return orderby, nil
+}
+
+// findInKeyrange returns the in_keyrange function of the where clause, if any.
+func findInKeyrange(where *sqlparser.Where) (*sqlparser.FuncExpr, error) {
+	if where == nil {
+		return nil, nil
+	}
+	var found *sqlparser.FuncExpr
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		if !isFuncKeyrange(expr) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("unsupported: more than one in_keyrange in %v", sqlparser.String(where))
+		}
+		found = expr.(*sqlparser.FuncExpr)
+	}
+	return found, nil
+}
+
+// buildInKeyrangeFilter builds the filter of the following constructs:
+// "in_keyrange('-80')", "in_keyrange(col, 'hash', '-80')",
+// "in_keyrange(col, 'local_vindex', '-80')", or
+// "in_keyrange(col, 'ks.external_vindex', '-80')".
+func (pl *planner) buildInKeyrangeFilter(tableName string, exprs sqlparser.SelectExprs, sourceSelect *sqlparser.Select) (*keyrangeFilter, error) {
+	switch {
+	case len(exprs) == 1:
+		kr, err := selString(exprs[0])
+		if err != nil {
+			return nil, err
+		}
+		return pl.buildKeyrangeFilter(tableName, nil, kr, sourceSelect)
+	case len(exprs) >= 3:
+		var colnames []sqlparser.ColIdent
+		for _, expr := range exprs[:len(exprs)-2] {
+			aexpr, ok := expr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			}
+			colname, ok := aexpr.Expr.(*sqlparser.ColName)
+			if !ok || !colname.Qualifier.IsEmpty() {
+				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			}
+			colnames = append(colnames, colname.Name)
+		}
+		vindexName, err := selString(exprs[len(exprs)-2])
+		if err != nil {
+			return nil, err
+		}
+		vindex, err := pl.findOrCreateVindex(vindexName)
+		if err != nil {
+			return nil, err
+		}
+		kr, err := selString(exprs[len(exprs)-1])
+		if err != nil {
+			return nil, err
+		}
+		kf, err := pl.buildKeyrangeFilter(tableName, vindex, kr, sourceSelect)
+		if err != nil || kf == nil {
+			return nil, err
+		}
+		kf.cols = vindexColumns(colnames, sourceSelect)
+		return kf, nil
+	}
+	return nil, fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(exprs))
+}
+
+// buildKeyrangeFilter builds the filter of the keyrange kr. If vindex is
+// nil, the primary vindex of the table is used. It returns nil if the
+// keyrange is complete, because all the source rows belong to the target.
+func (pl *planner) buildKeyrangeFilter(tableName string, vindex vindexes.Vindex, kr string, sourceSelect *sqlparser.Select) (*keyrangeFilter, error) {
+	keyRanges, err := key.ParseShardingSpec(kr)
+	if err != nil {
+		return nil, err
+	}
+	if len(keyRanges) != 1 {
+		return nil, fmt.Errorf("unexpected keyrange: %v", kr)
+	}
+	if !key.KeyRangeIsPartial(keyRanges[0]) {
+		return nil, nil
+	}
+	kf := &keyrangeFilter{
+		vindex:   vindex,
+		keyRange: keyRanges[0],
+	}
+	if vindex == nil {
+		vschema, err := pl.vschema()
+		if err != nil {
+			return nil, err
+		}
+		table, err := vschema.FindTable(pl.keyspace, tableName)
+		if err != nil {
+			return nil, err
+		}
+		cv, err := vindexes.FindBestColVindex(table)
+		if err != nil {
+			return nil, err
+		}
+		kf.vindex = cv.Vindex
+		kf.cols = vindexColumns(cv.Columns, sourceSelect)
+	}
+	if !kf.vindex.IsUnique() || kf.vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("vindex of table %v must be unique and functional to be used by vdiff", tableName)
+	}
+	return kf, nil
+}
+
+func (pl *planner) findOrCreateVindex(qualifiedName string) (vindexes.Vindex, error) {
+	splits := strings.Split(qualifiedName, ".")
+	var keyspace, name string
+	switch len(splits) {
+	case 1:
+		name = splits[0]
+	case 2:
+		keyspace, name = splits[0], splits[1]
+	default:
+		return nil, fmt.Errorf("invalid vindex name: %v", qualifiedName)
+	}
+	vschema, err := pl.vschema()
+	if err != nil {
+		return nil, err
+	}
+	vindex, err := vschema.FindVindex(keyspace, name)
+	if err != nil {
+		return nil, err
+	}
+	if vindex != nil {
+		return vindex, nil
+	}
+	if keyspace != "" {
+		return nil, fmt.Errorf("vindex %v not found", qualifiedName)
+	}
+	return vindexes.CreateVindex(name, name, map[string]string{})
+}
+
+// vindexColumns returns the numbers of the columns of the source rows
+// that are the input of the vindex. The columns that are not selected
+// are added at the end of the source select, where they are not compared.
+func vindexColumns(colnames []sqlparser.ColIdent, sourceSelect *sqlparser.Select) []int {
+	cols := make([]int, 0, len(colnames))
+	for _, colname := range colnames {
+		col := -1
+		for i, selExpr := range sourceSelect.SelectExprs {
+			aliased := selExpr.(*sqlparser.AliasedExpr)
+			if c, ok := aliased.Expr.(*sqlparser.ColName); ok && c.Qualifier.IsEmpty() && c.Name.Equal(colname) {
+				col = i
+				break
+			}
+		}
+		if col == -1 {
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: colname}})
+			col = len(sourceSelect.SelectExprs) - 1
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+func selString(expr sqlparser.SelectExpr) (string, error) {
+	aexpr, ok := expr.(*sqlparser.AliasedExpr)
+	if !ok {
+		return "", fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+	}
+	val, ok := aexpr.Expr.(*sqlparser.Literal)
+	if !ok {
+		return "", fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+	}
+	return string(val.Val), nil
+}
+
+// sourceQuery returns the query of the source rows that follow lastpk.
+func (tp *tablePlan) sourceQuery(lastpk []sqltypes.Value) (string, error) {
+	return tp.query(tp.sourceSelect, tp.sourcePKExprs, lastpk)
+}
+
+// targetQuery returns the query of the target rows that follow lastpk.
+func (tp *tablePlan) targetQuery(lastpk []sqltypes.Value) (string, error) {
+	pkExprs := make([]sqlparser.Expr, 0, len(tp.pkFields))
+	for _, field := range tp.pkFields {
+		pkExprs = append(pkExprs, &sqlparser.ColName{Name: sqlparser.NewColIdent(field.Name)})
+	}
+	return tp.query(tp.targetSelect, pkExprs, lastpk)
+}
+
+// query adds the condition pk > lastpk to sel, expanded as
+// "pk1 > v1 or (pk1 = v1 and pk2 > v2) or ...", which MySQL can
+// resolve with a range scan of the primary key.
+func (tp *tablePlan) query(sel *sqlparser.Select, pkExprs []sqlparser.Expr, lastpk []sqltypes.Value) (string, error) {
+	if lastpk == nil {
+		return sqlparser.String(sel), nil
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(lastpk))
+	var cond sqlparser.Expr
+	for i := range pkExprs {
+		var term sqlparser.Expr
+		for j := 0; j <= i; j++ {
+			name := fmt.Sprintf("lastpk_%d", j)
+			bindVars[name] = sqltypes.ValueBindVariable(lastpk[j])
+			op := sqlparser.EqualOp
+			if j == i {
+				op = sqlparser.GreaterThanOp
+			}
+			comparison := &sqlparser.ComparisonExpr{
+				Left:     pkExprs[j],
+				Operator: op,
+				Right:    sqlparser.NewArgument([]byte(":" + name)),
+			}
+			if term == nil {
+				term = comparison
+			} else {
+				term = &sqlparser.AndExpr{Left: term, Right: comparison}
+			}
+		}
+		if cond == nil {
+			cond = term
+		} else {
+			cond = &sqlparser.OrExpr{Left: cond, Right: term}
+		}
+	}
+	withLastPK := *sel
+	if sel.Where == nil {
+		withLastPK.Where = &sqlparser.Where{Type: sqlparser.WhereClause, Expr: cond}
+	} else {
+		withLastPK.Where = &sqlparser.Where{
+			Type: sqlparser.WhereClause,
+			Expr: &sqlparser.AndExpr{Left: sel.Where.Expr, Right: cond},
+		}
+	}
+	return sqlparser.NewParsedQuery(&withLastPK).GenerateQuery(bindVars, nil)
+}
+
+// filter drops the rows that don't belong to the keyrange.
+func (kf *keyrangeFilter) filter(rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	filtered := rows[:0]
+	for _, row := range rows {
+		vindexValues := make([]sqltypes.Value, 0, len(kf.cols))
+		for _, col := range kf.cols {
+			vindexValues = append(vindexValues, row[col])
+		}
+		destinations, err := vindexes.Map(kf.vindex, nil, [][]sqltypes.Value{vindexValues})
+		if err != nil {
+			return nil, err
+		}
+		if len(destinations) != 1 {
+			return nil, fmt.Errorf("mapping row to keyspace id returned an invalid array of destinations: %v", key.DestinationsString(destinations))
+		}
+		ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+		if !ok || len(ksid) == 0 {
+			return nil, fmt.Errorf("could not map %v to a keyspace id, got destination %v", vindexValues, destinations[0])
+		}
+		if key.KeyRangeContains(kf.keyRange, ksid) {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered, nil
+}
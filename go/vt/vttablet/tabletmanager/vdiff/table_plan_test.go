@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var testSchema = &tabletmanagerdatapb.SchemaDefinition{
+	TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+		Name:              "t1",
+		Columns:           []string{"c1", "c2"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2", "int64|varbinary"),
+	}, {
+		Name:              "multipk",
+		Columns:           []string{"c1", "c2", "c3"},
+		PrimaryKeyColumns: []string{"c1", "c2"},
+		Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|int64|int64"),
+	}, {
+		Name:              "aggr",
+		Columns:           []string{"c1", "c2"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+	}},
+}
+
+func newTestPlanner(t *testing.T) *planner {
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "hash"}},
+					},
+					"aggr": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "hash"}},
+					},
+				},
+			},
+		},
+	}
+	vschema, err := vindexes.BuildVSchema(srvVSchema)
+	require.NoError(t, err)
+	return &planner{
+		keyspace: "ks",
+		vschema:  func() (*vindexes.VSchema, error) { return vschema, nil },
+	}
+}
+
+func TestBuildTablePlans(t *testing.T) {
+	pl := newTestPlanner(t)
+	testcases := []struct {
+		rule         *binlogdatapb.Rule
+		table        string
+		sourceSelect string
+		targetSelect string
+		filterCols   []int
+		err          string
+	}{{
+		rule:         &binlogdatapb.Rule{Match: "t1"},
+		table:        "t1",
+		sourceSelect: "select c1, c2 from t1 order by c1 asc",
+		targetSelect: "select c1, c2 from t1 order by c1 asc",
+	}, {
+		rule:         &binlogdatapb.Rule{Match: "t1", Filter: "-80"},
+		table:        "t1",
+		sourceSelect: "select c1, c2 from t1 order by c1 asc",
+		targetSelect: "select c1, c2 from t1 order by c1 asc",
+		filterCols:   []int{0},
+	}, {
+		// A complete keyrange needs no filter.
+		rule:         &binlogdatapb.Rule{Match: "t1", Filter: "-"},
+		table:        "t1",
+		sourceSelect: "select c1, c2 from t1 order by c1 asc",
+		targetSelect: "select c1, c2 from t1 order by c1 asc",
+	}, {
+		// The vindex column is a column of the source table.
+		rule:         &binlogdatapb.Rule{Match: "t1", Filter: "select c2 as c1, c1 as c2 from t1 where in_keyrange(c1, 'hash', '80-')"},
+		table:        "t1",
+		sourceSelect: "select c2 as c1, c1 as c2 from t1 order by c1 asc",
+		targetSelect: "select c1, c2 from t1 order by c1 asc",
+		filterCols:   []int{1},
+	}, {
+		// The vindex column is added to the source select.
+		rule:         &binlogdatapb.Rule{Match: "t1", Filter: "select c1, c2 from t1 where in_keyrange(c3, 'hash', '80-')"},
+		table:        "t1",
+		sourceSelect: "select c1, c2, c3 from t1 order by c1 asc",
+		targetSelect: "select c1, c2 from t1 order by c1 asc",
+		filterCols:   []int{2},
+	}, {
+		rule:         &binlogdatapb.Rule{Match: "multipk", Filter: "select * from multipk where c3 > 0"},
+		table:        "multipk",
+		sourceSelect: "select c1, c2, c3 from multipk where c3 > 0 order by c1 asc, c2 asc",
+		targetSelect: "select c1, c2, c3 from multipk order by c1 asc, c2 asc",
+	}, {
+		rule:  &binlogdatapb.Rule{Match: "aggr", Filter: "select c1, count(*) as c2 from aggr where in_keyrange('-80') group by c1"},
+		table: "aggr",
+		err:   "unsupported: aggregates in the rule of table aggr, which streams from a subset of the source rows",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.rule.Match+":"+tcase.rule.Filter, func(t *testing.T) {
+			filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{tcase.rule}}
+			plans, err := pl.buildTablePlans(filter, testSchema, nil)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, plans, 1)
+			plan := plans[tcase.table]
+			require.NotNil(t, plan)
+			assert.Equal(t, tcase.sourceSelect, sqlparser.String(plan.sourceSelect))
+			assert.Equal(t, tcase.targetSelect, sqlparser.String(plan.targetSelect))
+			if tcase.filterCols == nil {
+				assert.Nil(t, plan.filter)
+			} else {
+				require.NotNil(t, plan.filter)
+				assert.Equal(t, tcase.filterCols, plan.filter.cols)
+			}
+		})
+	}
+}
+
+func TestTablePlanQueryAfterLastPK(t *testing.T) {
+	pl := newTestPlanner(t)
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "multipk", Filter: "select * from multipk where c3 > 0"}}}
+	plans, err := pl.buildTablePlans(filter, testSchema, nil)
+	require.NoError(t, err)
+	plan := plans["multipk"]
+
+	query, err := plan.sourceQuery(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from multipk where c3 > 0 order by c1 asc, c2 asc", query)
+
+	lastpk := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+	query, err = plan.sourceQuery(lastpk)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from multipk where c3 > 0 and (c1 > 1 or c1 = 1 and c2 > 2) order by c1 asc, c2 asc", query)
+	query, err = plan.targetQuery(lastpk)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from multipk where c1 > 1 or c1 = 1 and c2 > 2 order by c1 asc, c2 asc", query)
+
+	// The plan is not modified by the queries.
+	assert.Equal(t, "select c1, c2, c3 from multipk order by c1 asc, c2 asc", sqlparser.String(plan.targetSelect))
+}
+
+func TestKeyrangeFilter(t *testing.T) {
+	pl := newTestPlanner(t)
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "-80"}}}
+	plans, err := pl.buildTablePlans(filter, testSchema, nil)
+	require.NoError(t, err)
+
+	// The keyspace ids of 1, 2 and 4 are 166b40b44aba4bd6,
+	// 06e7ea22ce92708f and d2fd8867d50d2dfe.
+	rows := sqltypes.MakeTestResult(testSchema.TableDefinitions[0].Fields, "1|a", "2|b", "4|c").Rows
+	filtered, err := plans["t1"].filter.filter(rows)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(testSchema.TableDefinitions[0].Fields, "1|a", "2|b").Rows, filtered)
+}
+
+func TestBuildTablePlansTables(t *testing.T) {
+	pl := newTestPlanner(t)
+	filter := &binlogdatapb.Filter{Rules: []*binlogdatapb.Rule{{Match: "/.*"}}}
+	plans, err := pl.buildTablePlans(filter, testSchema, []string{"t1", "aggr"})
+	require.NoError(t, err)
+	assert.Len(t, plans, 2)
+
+	_, err = pl.buildTablePlans(filter, testSchema, []string{"t1", "t2"})
+	require.EqualError(t, err, "one or more tables provided are not present in the workflow: [t1 t2]")
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+//-----------------------------------------------------------------
+// primitiveExecutor
+
+// primitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type primitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
+	pe := &primitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = pe.prim.StreamExecute(vcursor, make(map[string]*querypb.BindVariable), false, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
+
+//-----------------------------------------------------------------
+// Utility functions
+
+// newMergeSorter creates an engine.MergeSort based on the shard streamers and pk columns.
+func newMergeSorter(participants []*shardStreamer, comparePKs []int) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(participants))
+	for _, participant := range participants {
+		prims = append(prims, participant)
+	}
+	ob := make([]engine.OrderbyParams, 0, len(comparePKs))
+	for _, cpk := range comparePKs {
+		ob = append(ob, engine.OrderbyParams{Col: cpk})
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
+
+func removeKeyrange(where *sqlparser.Where) *sqlparser.Where {
+	if where == nil {
+		return nil
+	}
+	if isFuncKeyrange(where.Expr) {
+		return nil
+	}
+	return &sqlparser.Where{
+		Type: where.Type,
+		Expr: removeExprKeyrange(where.Expr),
+	}
+}
+
+func removeExprKeyrange(node sqlparser.Expr) sqlparser.Expr {
+	switch node := node.(type) {
+	case *sqlparser.AndExpr:
+		if isFuncKeyrange(node.Left) {
+			return removeExprKeyrange(node.Right)
+		}
+		if isFuncKeyrange(node.Right) {
+			return removeExprKeyrange(node.Left)
+		}
+		return &sqlparser.AndExpr{
+			Left:  removeExprKeyrange(node.Left),
+			Right: removeExprKeyrange(node.Right),
+		}
+	}
+	return node
+}
+
+func isFuncKeyrange(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	return ok && funcExpr.Name.EqualString("in_keyrange")
+}
+
+func wrapWeightString(expr sqlparser.SelectExpr) *sqlparser.AliasedExpr {
+	return &sqlparser.AliasedExpr{
+		Expr: &sqlparser.FuncExpr{
+			Name: sqlparser.NewColIdent("weight_string"),
+			Exprs: []sqlparser.SelectExpr{
+				&sqlparser.AliasedExpr{
+					Expr: expr.(*sqlparser.AliasedExpr).Expr,
+				},
+			},
+		},
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 	"time"
 
@@ -28,12 +29,14 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/history"
+	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -53,20 +56,34 @@ type healthStreamer struct {
 	degradedThreshold  time.Duration
 	unhealthyThreshold time.Duration
 
+	// se is nil if the health streamer doesn't
+	// signal the changes of the table schemas.
+	se *schema.Engine
+
+	// tableStatsTimer periodically loads the statistics of the tables
+	// when the tablet is a master. It is nil if they are disabled.
+	tableStatsTimer *timer.Timer
+	loadTableStats  func(ctx context.Context) ([]*querypb.TableStatistics, error)
+
 	mu      sync.Mutex
 	ctx     context.Context
 	cancel  context.CancelFunc
 	clients map[chan *querypb.StreamHealthResponse]struct{}
 	state   *querypb.StreamHealthResponse
+	// tableStats are the last statistics of the tables loaded by the
+	// master. They are not part of state, as they are only sent to the
+	// clients when they change, and in the first message of a stream.
+	tableStats []*querypb.TableStatistics
 
 	history *history.History
 }
 
-func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias) *healthStreamer {
-	return &healthStreamer{
+func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias, se *schema.Engine) *healthStreamer {
+	hs := &healthStreamer{
 		stats:              env.Stats(),
 		degradedThreshold:  env.Config().Healthcheck.DegradedThresholdSeconds.Get(),
 		unhealthyThreshold: env.Config().Healthcheck.UnhealthyThresholdSeconds.Get(),
+		se:                 se,
 		clients:            make(map[chan *querypb.StreamHealthResponse]struct{}),
 
 		state: &querypb.StreamHealthResponse{
@@ -79,6 +96,13 @@ func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias) *healthS
 
 		history: history.New(5),
 	}
+	if interval := env.Config().TableStatsIntervalSeconds.Get(); interval > 0 && se != nil {
+		hs.tableStatsTimer = timer.NewTimer(interval)
+		hs.loadTableStats = func(ctx context.Context) ([]*querypb.TableStatistics, error) {
+			return loadTableStatistics(ctx, se)
+		}
+	}
+	return hs
 }
 
 func (hs *healthStreamer) InitDBConfig(target querypb.Target) {
@@ -96,16 +120,24 @@ func (hs *healthStreamer) Open() {
 		return
 	}
 	hs.ctx, hs.cancel = context.WithCancel(context.TODO())
+	if hs.tableStatsTimer != nil {
+		hs.tableStatsTimer.Start(hs.refreshTableStats)
+	}
 }
 
 func (hs *healthStreamer) Close() {
 	hs.mu.Lock()
-	defer hs.mu.Unlock()
-
 	if hs.cancel != nil {
 		hs.cancel()
 		hs.cancel = nil
 	}
+	hs.mu.Unlock()
+
+	// The timer must be stopped without holding the lock,
+	// as it waits for refreshTableStats to return.
+	if hs.tableStatsTimer != nil {
+		hs.tableStatsTimer.Stop()
+	}
 }
 
 func (hs *healthStreamer) Stream(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
@@ -147,7 +179,9 @@ func (hs *healthStreamer) register() (chan *querypb.StreamHealthResponse, contex
 	hs.clients[ch] = struct{}{}
 
 	// Send the current state immediately.
+	hs.state.RealtimeStats.TableStatistics = hs.tableStats
 	ch <- proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.state.RealtimeStats.TableStatistics = nil
 	return ch, hs.ctx
 }
 
@@ -175,12 +209,29 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 	}
 	hs.state.RealtimeStats.SecondsBehindMaster = uint32(lag.Seconds())
 	hs.state.Serving = serving
+	if tabletType != topodatapb.TabletType_MASTER {
+		// The statistics are only sent by the masters. They are
+		// sent again if the tablet becomes a master again.
+		hs.tableStats = nil
+	}
 
 	hs.state.RealtimeStats.SecondsBehindMasterFilteredReplication, hs.state.RealtimeStats.BinlogPlayersCount = blpFunc()
 	hs.state.RealtimeStats.Qps = hs.stats.QPSRates.TotalRate()
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.broadcastLocked(shr)
+	hs.history.Add(&historyRecord{
+		Time:       time.Now(),
+		serving:    shr.Serving,
+		tabletType: shr.Target.TabletType,
+		lag:        lag,
+		err:        err,
+	})
+}
 
+// broadcastLocked sends a health message to all the clients.
+// It must be called while holding a lock on hs.mu.
+func (hs *healthStreamer) broadcastLocked(shr *querypb.StreamHealthResponse) {
 	for ch := range hs.clients {
 		select {
 		case ch <- shr:
@@ -202,13 +253,89 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 			delete(hs.clients, ch)
 		}
 	}
-	hs.history.Add(&historyRecord{
-		Time:       time.Now(),
-		serving:    shr.Serving,
-		tabletType: shr.Target.TabletType,
-		lag:        lag,
-		err:        err,
-	})
+}
+
+// WatchSchema registers the health streamer with the schema engine.
+// The schema engine drops its notifiers when it's closed, so it must
+// be called every time the schema engine is opened.
+func (hs *healthStreamer) WatchSchema() {
+	if hs.se == nil {
+		return
+	}
+	hs.se.RegisterNotifier("healthStreamer", hs.schemaChanged)
+}
+
+// schemaChanged is the schema engine notifier. It sends a health message
+// with the names of the tables that were created, altered or dropped,
+// so that vtgate can reload their columns.
+func (hs *healthStreamer) schemaChanged(_ map[string]*schema.Table, created, altered, dropped []string) {
+	var tables []string
+	for _, list := range [][]string{created, altered, dropped} {
+		for _, table := range list {
+			if table != "dual" {
+				tables = append(tables, table)
+			}
+		}
+	}
+	if len(tables) == 0 {
+		return
+	}
+	sort.Strings(tables)
+
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	hs.state.RealtimeStats.TableSchemaChanged = tables
+	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.state.RealtimeStats.TableSchemaChanged = nil
+	hs.broadcastLocked(shr)
+}
+
+// refreshTableStats loads the statistics of the tables if the tablet
+// is a master, and sends them in a health message if they changed. The
+// other health messages don't have them: vtgate keeps the last ones it
+// received. A client that can't keep up is disconnected rather than
+// missing a message, and it gets the statistics again when it reconnects.
+func (hs *healthStreamer) refreshTableStats() {
+	hs.mu.Lock()
+	ctx := hs.ctx
+	isMaster := hs.state.Target.TabletType == topodatapb.TabletType_MASTER
+	hs.mu.Unlock()
+	if ctx == nil || !isMaster {
+		return
+	}
+
+	tables, err := hs.loadTableStats(ctx)
+	if err != nil {
+		log.Warningf("Error loading the table statistics: %v", err)
+		return
+	}
+
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	// The tablet may have stopped being a master in the meantime.
+	if hs.state.Target.TabletType != topodatapb.TabletType_MASTER {
+		return
+	}
+	if tableStatisticsEqual(hs.tableStats, tables) {
+		return
+	}
+	hs.tableStats = tables
+	hs.state.RealtimeStats.TableStatistics = tables
+	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.state.RealtimeStats.TableStatistics = nil
+	hs.broadcastLocked(shr)
+}
+
+func tableStatisticsEqual(a, b []*querypb.TableStatistics) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 func (hs *healthStreamer) AppendDetails(details []*kv) []*kv {
@@ -37,7 +37,7 @@ func TestHealthStreamerClosed(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	err := hs.Stream(context.Background(), func(shr *querypb.StreamHealthResponse) error {
 		return nil
 	})
@@ -52,7 +52,7 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	hs.Open()
 	defer hs.Close()
 	target := querypb.Target{}
@@ -136,6 +136,113 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 	assert.Equal(t, want, shr)
 }
 
+func TestHealthStreamerSchemaChanged(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias, nil)
+	hs.Open()
+	defer hs.Close()
+	target := querypb.Target{}
+	hs.InitDBConfig(target)
+
+	ch, cancel := testStream(hs)
+	defer cancel()
+	<-ch
+
+	hs.ChangeState(topodatapb.TabletType_MASTER, time.Time{}, 0, nil, true)
+	<-ch
+
+	// The changed tables are only sent once, and dual is never sent.
+	hs.schemaChanged(nil, []string{"t2", "dual"}, []string{"t1"}, []string{"t3"})
+	shr := <-ch
+	assert.Equal(t, []string{"t1", "t2", "t3"}, shr.RealtimeStats.TableSchemaChanged)
+	assert.True(t, shr.Serving)
+
+	hs.ChangeState(topodatapb.TabletType_MASTER, time.Time{}, 0, nil, true)
+	shr = <-ch
+	assert.Nil(t, shr.RealtimeStats.TableSchemaChanged)
+
+	// Nothing is sent if no table changed.
+	hs.schemaChanged(nil, []string{"dual"}, nil, nil)
+	hs.ChangeState(topodatapb.TabletType_REPLICA, time.Time{}, 0, nil, true)
+	shr = <-ch
+	assert.Equal(t, topodatapb.TabletType_REPLICA, shr.Target.TabletType)
+	assert.Nil(t, shr.RealtimeStats.TableSchemaChanged)
+}
+
+func TestHealthStreamerTableStatistics(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias, nil)
+	stats := []*querypb.TableStatistics{{Name: "t1", Rows: 10}}
+	loads := 0
+	hs.loadTableStats = func(context.Context) ([]*querypb.TableStatistics, error) {
+		loads++
+		return stats, nil
+	}
+	hs.Open()
+	defer hs.Close()
+	target := querypb.Target{}
+	hs.InitDBConfig(target)
+
+	ch, cancel := testStream(hs)
+	defer cancel()
+	<-ch
+
+	// The statistics are not loaded by the replicas.
+	hs.ChangeState(topodatapb.TabletType_REPLICA, time.Time{}, 0, nil, true)
+	<-ch
+	hs.refreshTableStats()
+	assert.Equal(t, 0, loads)
+
+	hs.ChangeState(topodatapb.TabletType_MASTER, time.Time{}, 0, nil, true)
+	<-ch
+	hs.refreshTableStats()
+	shr := <-ch
+	assert.Equal(t, stats, shr.RealtimeStats.TableStatistics)
+
+	// They are only sent when they change, and
+	// not in the other health messages.
+	hs.refreshTableStats()
+	hs.ChangeState(topodatapb.TabletType_MASTER, time.Time{}, 0, nil, true)
+	shr = <-ch
+	assert.Nil(t, shr.RealtimeStats.TableStatistics)
+	assert.Equal(t, 2, loads)
+
+	stats = []*querypb.TableStatistics{{Name: "t1", Rows: 20}}
+	hs.refreshTableStats()
+	shr = <-ch
+	assert.Equal(t, stats, shr.RealtimeStats.TableStatistics)
+
+	// A new client gets them in its first message.
+	ch2, cancel2 := testStream(hs)
+	defer cancel2()
+	shr = <-ch2
+	assert.Equal(t, stats, shr.RealtimeStats.TableStatistics)
+
+	// They are sent again when the tablet is a master again.
+	hs.ChangeState(topodatapb.TabletType_REPLICA, time.Time{}, 0, nil, true)
+	<-ch
+	<-ch2
+	hs.ChangeState(topodatapb.TabletType_MASTER, time.Time{}, 0, nil, true)
+	<-ch
+	<-ch2
+	hs.refreshTableStats()
+	shr = <-ch
+	assert.Equal(t, stats, shr.RealtimeStats.TableStatistics)
+	<-ch2
+}
+
 func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *querypb.StreamHealthResponse)
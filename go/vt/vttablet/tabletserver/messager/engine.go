@@ -39,6 +39,7 @@ type TabletService interface {
 	tabletenv.Env
 	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -158,6 +159,45 @@ func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map
 	return query, bv, nil
 }
 
+// GenerateDeadLetterSelectQuery returns the query and bind vars for locking
+// the messages that must be moved to the dead-letter table.
+func (me *Engine) GenerateDeadLetterSelectQuery(name string, timeCutoff int64) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm, err := me.deadLetterManager(name)
+	if err != nil {
+		return "", nil, err
+	}
+	query, bv := mm.GenerateDeadLetterSelectQuery(timeCutoff)
+	return query, bv, nil
+}
+
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// messages to the dead-letter table.
+func (me *Engine) GenerateDeadLetterQueries(name string, ids []string) ([]string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm, err := me.deadLetterManager(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	queries, bv := mm.GenerateDeadLetterQueries(ids)
+	return queries, bv, nil
+}
+
+// deadLetterManager returns the manager of a message table that has a
+// dead-letter table. It must be called with mu held.
+func (me *Engine) deadLetterManager(name string) (*messageManager, error) {
+	mm := me.managers[name]
+	if mm == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	if mm.maxAttempts == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s has no dead-letter table", name)
+	}
+	return mm, nil
+}
+
 func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altered, dropped []string) {
 	me.mu.Lock()
 	defer me.mu.Unlock()
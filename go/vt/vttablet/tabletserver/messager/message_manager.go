@@ -161,6 +161,13 @@ type receiverWithStatus struct {
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
+//
+// Dead letters
+// If the table specifies a max number of attempts, the poller and
+// the vstream ignore the messages that were sent that many times.
+// The purge thread moves those messages to the dead-letter table
+// once their last ack wait has elapsed, and deletes them from the
+// message table in the same transaction.
 type messageManager struct {
 	tsv TabletService
 	vs  VStreamer
@@ -172,6 +179,7 @@ type messageManager struct {
 	minBackoff   time.Duration
 	maxBackoff   time.Duration
 	batchSize    int
+	maxAttempts  int
 	pollerTicks  *timer.Timer
 	purgeTicks   *timer.Timer
 	postponeSema *sync2.Semaphore
@@ -214,6 +222,9 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	deadLetterSelectQuery     *sqlparser.ParsedQuery
+	deadLetterInsertQuery     *sqlparser.ParsedQuery
+	deadLetterDeleteQuery     *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -232,6 +243,7 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		minBackoff:      table.MessageInfo.MinBackoff,
 		maxBackoff:      table.MessageInfo.MaxBackoff,
 		batchSize:       table.MessageInfo.BatchSize,
+		maxAttempts:     table.MessageInfo.MaxAttempts,
 		cache:           newCache(table.MessageInfo.CacheSize),
 		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
 		purgeTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
@@ -248,9 +260,24 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 			Filter: vsQuery,
 		}},
 	}
-	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+	if mm.maxAttempts > 0 {
+		mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
+			"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a and ifnull(epoch, 0) < %a order by priority, time_next desc limit %a",
+			columnList, mm.name, ":time_next", ":max_attempts", ":max")
+		mm.deadLetterSelectQuery = sqlparser.BuildParsedQuery(
+			"select id from %v where time_next < %a and ifnull(epoch, 0) >= %a and time_acked is null limit 500 for update",
+			mm.name, ":time_next", ":max_attempts")
+		allColumns := buildAllColumnList(table)
+		mm.deadLetterInsertQuery = sqlparser.BuildParsedQuery(
+			"insert into %v(%s) select %s from %v where id in %a",
+			sqlparser.NewTableIdent(table.MessageInfo.DeadLetterTable), allColumns, allColumns, mm.name, "::ids")
+		mm.deadLetterDeleteQuery = sqlparser.BuildParsedQuery(
+			"delete from %v where id in %a", mm.name, "::ids")
+	} else {
+		mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
+			"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
+			columnList, mm.name, ":time_next", ":max")
+	}
 	mm.ackQuery = sqlparser.BuildParsedQuery(
 		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
 		mm.name, ":time_acked", "::ids")
@@ -323,6 +350,20 @@ func buildSelectColumnList(t *schema.Table) string {
 	return buf.String()
 }
 
+// buildAllColumnList builds a column list for all the
+// columns of the table, which a dead-letter table must have.
+func buildAllColumnList(t *schema.Table) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	for i, c := range t.Fields {
+		if i == 0 {
+			buf.Myprintf("%v", sqlparser.NewColIdent(c.Name))
+		} else {
+			buf.Myprintf(", %v", sqlparser.NewColIdent(c.Name))
+		}
+	}
+	return buf.String()
+}
+
 // Open starts the messageManager service.
 func (mm *messageManager) Open() {
 	mm.mu.Lock()
@@ -728,6 +769,10 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 		if mr.TimeAcked != 0 || mr.TimeNext > now {
 			continue
 		}
+		if mm.maxAttempts > 0 && mr.Epoch >= int64(mm.maxAttempts) {
+			// It will be moved to the dead-letter table.
+			continue
+		}
 		mm.Add(mr)
 	}
 	return nil
@@ -753,6 +798,9 @@ func (mm *messageManager) runPoller() {
 		"time_next": sqltypes.Int64BindVariable(time.Now().UnixNano()),
 		"max":       sqltypes.Int64BindVariable(int64(size)),
 	}
+	if mm.maxAttempts > 0 {
+		bindVars["max_attempts"] = sqltypes.Int64BindVariable(int64(mm.maxAttempts))
+	}
 	qr, err := mm.readPending(ctx, bindVars)
 	if err != nil {
 		return
@@ -791,6 +839,9 @@ func (mm *messageManager) runPoller() {
 
 func (mm *messageManager) runPurge() {
 	go purge(mm.tsv, mm.name.String(), mm.purgeAfter, mm.purgeTicks.Interval())
+	if mm.maxAttempts > 0 {
+		go deadLetter(mm.tsv, mm.name.String(), mm.purgeTicks.Interval())
+	}
 }
 
 // purge is a non-member because it should be called asynchronously and should
@@ -816,6 +867,28 @@ func purge(tsv TabletService, name string, purgeAfter, purgeInterval time.Durati
 	}
 }
 
+// deadLetter is a non-member for the same reasons as purge.
+func deadLetter(tsv TabletService, name string, purgeInterval time.Duration) {
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), purgeInterval)
+	defer func() {
+		tsv.LogError()
+		cancel()
+	}()
+	for {
+		count, err := tsv.DeadLetterMessages(ctx, nil, name, time.Now().UnixNano())
+		if err != nil {
+			MessageStats.Add([]string{name, "DeadLetterFailed"}, 1)
+			log.Errorf("Unable to move messages to the dead-letter table: %v", err)
+		} else {
+			MessageStats.Add([]string{name, "DeadLettered"}, count)
+		}
+		// If moved 500 or more, we should continue.
+		if count < 500 {
+			return
+		}
+	}
+}
+
 // GenerateAckQuery returns the query and bind vars for acking a message.
 func (mm *messageManager) GenerateAckQuery(ids []string) (string, map[string]*querypb.BindVariable) {
 	idbvs := &querypb.BindVariable{
@@ -869,6 +942,35 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
+// GenerateDeadLetterSelectQuery returns the query and bind vars for locking
+// the messages that must be moved to the dead-letter table.
+// The message table must have a dead-letter table.
+func (mm *messageManager) GenerateDeadLetterSelectQuery(timeCutoff int64) (string, map[string]*querypb.BindVariable) {
+	return mm.deadLetterSelectQuery.Query, map[string]*querypb.BindVariable{
+		"time_next":    sqltypes.Int64BindVariable(timeCutoff),
+		"max_attempts": sqltypes.Int64BindVariable(int64(mm.maxAttempts)),
+	}
+}
+
+// GenerateDeadLetterQueries returns the queries and bind vars for moving
+// messages to the dead-letter table: the first query copies them, and the
+// second one deletes them from the message table.
+func (mm *messageManager) GenerateDeadLetterQueries(ids []string) ([]string, map[string]*querypb.BindVariable) {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARBINARY,
+			Value: []byte(id),
+		})
+	}
+	return []string{mm.deadLetterInsertQuery.Query, mm.deadLetterDeleteQuery.Query}, map[string]*querypb.BindVariable{
+		"ids": idbvs,
+	}
+}
+
 // BuildMessageRow builds a MessageRow for a db row.
 func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
@@ -96,6 +96,21 @@ func newMMTableWithBackoff() *schema.Table {
 	}
 }
 
+func newMMTableWithDeadLetters() *schema.Table {
+	ti := newMMTable()
+	ti.Fields = []*querypb.Field{
+		{Name: "priority", Type: sqltypes.Int64},
+		{Name: "time_next", Type: sqltypes.Int64},
+		{Name: "epoch", Type: sqltypes.Int64},
+		{Name: "time_acked", Type: sqltypes.Int64},
+		{Name: "id", Type: sqltypes.VarBinary},
+		{Name: "message", Type: sqltypes.VarBinary},
+	}
+	ti.MessageInfo.MaxAttempts = 3
+	ti.MessageInfo.DeadLetterTable = "foo_dlq"
+	return ti
+}
+
 func newMMRow(id int64) *querypb.Row {
 	return sqltypes.RowToProto3([]sqltypes.Value{
 		sqltypes.NewInt64(1),
@@ -107,6 +122,17 @@ func newMMRow(id int64) *querypb.Row {
 	})
 }
 
+func newMMRowWithEpoch(id, epoch int64) *querypb.Row {
+	return sqltypes.RowToProto3([]sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(epoch),
+		sqltypes.NULL,
+		sqltypes.NewInt64(id),
+		sqltypes.NewVarBinary(fmt.Sprintf("%v", id)),
+	})
+}
+
 type testReceiver struct {
 	rcv   func(*sqltypes.Result) error
 	count sync2.AtomicInt64
@@ -487,6 +513,53 @@ func TestMessageManagerStreamerSimple(t *testing.T) {
 	}
 }
 
+func TestMessageManagerStreamerMaxAttempts(t *testing.T) {
+	fvs := newFakeVStreamer()
+	fvs.setStreamerResponse([][]*binlogdatapb.VEvent{{{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: "foo",
+			Fields:    testDBFields,
+		},
+	}}, {{
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "foo",
+			RowChanges: []*binlogdatapb.RowChange{{
+				// This message was sent the max number of times.
+				After: newMMRowWithEpoch(1, 3),
+			}, {
+				After: newMMRowWithEpoch(2, 2),
+			}},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_GTID,
+		Gtid: "MySQL56/33333333-3333-3333-3333-333333333333:1-101",
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}}})
+	mm := newMessageManager(newFakeTabletServer(), fvs, newMMTableWithDeadLetters(), sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(2),
+			sqltypes.NewVarBinary("2"),
+		}},
+	}
+	assert.Equal(t, want, <-r1.ch)
+	select {
+	case qr := <-r1.ch:
+		t.Errorf("Expecting no value, got: %v", qr)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestMessageManagerStreamerAndPoller(t *testing.T) {
 	fvs := newFakeVStreamer()
 	fvs.setPollerResponse([]*binlogdatapb.VStreamResultsResponse{{
@@ -734,6 +807,26 @@ func TestMessageManagerPurge(t *testing.T) {
 	}
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	tsv := newFakeTabletServer()
+
+	// Make a buffered channel so the thread doesn't block on repeated calls.
+	ch := make(chan string, 20)
+	tsv.SetChannel(ch)
+
+	ti := newMMTableWithDeadLetters()
+	ti.MessageInfo.PollInterval = 1 * time.Millisecond
+	mm := newMessageManager(tsv, newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+	// Ensure the messages were moved to the dead-letter table.
+	for got := range ch {
+		if got == "deadletter" {
+			return
+		}
+	}
+}
+
 func TestMMGenerate(t *testing.T) {
 	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
 	mm.Open()
@@ -825,10 +918,40 @@ func TestMMGenerateWithBackoff(t *testing.T) {
 	}
 }
 
+func TestMMGenerateWithDeadLetters(t *testing.T) {
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithDeadLetters(), sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	assert.Equal(t,
+		"select priority, time_next, epoch, time_acked, id, message from foo where time_next < :time_next and ifnull(epoch, 0) < :max_attempts order by priority, time_next desc limit :max",
+		mm.readByPriorityAndTimeNext.Query)
+
+	query, bv := mm.GenerateDeadLetterSelectQuery(3)
+	assert.Equal(t, "select id from foo where time_next < :time_next and ifnull(epoch, 0) >= :max_attempts and time_acked is null limit 500 for update", query)
+	wantbv := map[string]*querypb.BindVariable{
+		"time_next":    sqltypes.Int64BindVariable(3),
+		"max_attempts": sqltypes.Int64BindVariable(3),
+	}
+	utils.MustMatch(t, wantbv, bv, "did not match")
+
+	queries, bv := mm.GenerateDeadLetterQueries([]string{"1", "2"})
+	wantQueries := []string{
+		"insert into foo_dlq(priority, time_next, epoch, time_acked, id, message) select priority, time_next, epoch, time_acked, id, message from foo where id in ::ids",
+		"delete from foo where id in ::ids",
+	}
+	assert.Equal(t, wantQueries, queries)
+	wantbv = map[string]*querypb.BindVariable{
+		"ids": sqltypes.TestBindVariable([]interface{}{"1", "2"}),
+	}
+	utils.MustMatch(t, wantbv, bv, "did not match")
+}
+
 type fakeTabletServer struct {
 	tabletenv.Env
-	postponeCount sync2.AtomicInt64
-	purgeCount    sync2.AtomicInt64
+	postponeCount   sync2.AtomicInt64
+	purgeCount      sync2.AtomicInt64
+	deadLetterCount sync2.AtomicInt64
 
 	mu sync.Mutex
 	ch chan string
@@ -871,6 +994,17 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error) {
+	fts.deadLetterCount.Add(1)
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return 0, nil
+}
+
 type fakeVStreamer struct {
 	streamInvocations sync2.AtomicInt64
 	mu                sync.Mutex
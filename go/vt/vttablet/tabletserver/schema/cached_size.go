@@ -23,7 +23,7 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(80)
+		size += int64(104)
 	}
 	// field Fields []*vitess.io/vitess/go/vt/proto/query.Field
 	{
@@ -32,6 +32,8 @@ func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field DeadLetterTable string
+	size += int64(len(cached.DeadLetterTable))
 	return size
 }
 func (cached *SequenceInfo) CachedSize(alloc bool) int64 {
@@ -73,16 +73,7 @@ func loadMessageInfo(ta *Table, comment string) error {
 	}
 
 	ta.MessageInfo = &MessageInfo{}
-	// Extract keyvalues.
-	keyvals := make(map[string]string)
-	inputs := strings.Split(comment, ",")
-	for _, input := range inputs {
-		kv := strings.Split(input, "=")
-		if len(kv) != 2 {
-			continue
-		}
-		keyvals[kv[0]] = kv[1]
-	}
+	keyvals := parseComment(comment)
 
 	var err error
 	if ta.MessageInfo.AckWaitDuration, err = getDuration(keyvals, "vt_ack_wait"); err != nil {
@@ -110,6 +101,16 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	// Messages are retried forever unless a max number of attempts is specified,
+	// in which case they are moved to the dead-letter table after the last one.
+	ta.MessageInfo.MaxAttempts, _ = getNum(keyvals, "vt_max_attempts")
+	if ta.MessageInfo.MaxAttempts > 0 {
+		ta.MessageInfo.DeadLetterTable = keyvals["vt_dead_letter_table"]
+		if ta.MessageInfo.DeadLetterTable == "" {
+			return fmt.Errorf("attribute vt_dead_letter_table not specified for message table %s with vt_max_attempts", ta.Name.String())
+		}
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -127,6 +128,29 @@ func loadMessageInfo(ta *Table, comment string) error {
 	return nil
 }
 
+// parseComment extracts the key=value pairs of a table comment.
+func parseComment(comment string) map[string]string {
+	keyvals := make(map[string]string)
+	inputs := strings.Split(comment, ",")
+	for _, input := range inputs {
+		kv := strings.Split(input, "=")
+		if len(kv) != 2 {
+			continue
+		}
+		keyvals[kv[0]] = kv[1]
+	}
+	return keyvals
+}
+
+// MessageDeadLetterTable returns the dead-letter table specified by the
+// comment of a message table, or an empty string if there's none.
+func MessageDeadLetterTable(comment string) string {
+	if !strings.Contains(comment, "vitess_message") {
+		return ""
+	}
+	return parseComment(comment)["vt_dead_letter_table"]
+}
+
 func getDuration(in map[string]string, key string) (time.Duration, error) {
 	sv := in[key]
 	if sv == "" {
@@ -141,6 +141,16 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max attempts and dead-letter table
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5,vt_dead_letter_table=test_table_dlq", db)
+	require.NoError(t, err)
+	want.MessageInfo.MaxAttempts = 5
+	want.MessageInfo.DeadLetterTable = "test_table_dlq"
+	assert.Equal(t, want, table)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_max_attempts=5", db)
+	assert.EqualError(t, err, "attribute vt_dead_letter_table not specified for message table test_table with vt_max_attempts")
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
@@ -158,6 +168,12 @@ func TestLoadTableMessage(t *testing.T) {
 	}
 }
 
+func TestMessageDeadLetterTable(t *testing.T) {
+	assert.Equal(t, "msg_dlq", MessageDeadLetterTable("vitess_message,vt_ack_wait=30,vt_max_attempts=3,vt_dead_letter_table=msg_dlq"))
+	assert.Equal(t, "", MessageDeadLetterTable("vitess_message,vt_ack_wait=30"))
+	assert.Equal(t, "", MessageDeadLetterTable("vt_dead_letter_table=msg_dlq"))
+}
+
 func newTestLoadTable(tableType string, comment string, db *fakesqldb.DB) (*Table, error) {
 	ctx := context.Background()
 	appParams := db.ConnParams()
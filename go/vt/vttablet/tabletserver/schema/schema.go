@@ -100,6 +100,15 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// MaxAttempts specifies how many times a message is sent
+	// before it's moved to the DeadLetterTable. Messages are
+	// sent until they're acked if it's 0.
+	MaxAttempts int
+
+	// DeadLetterTable specifies the table messages are moved
+	// to after MaxAttempts.
+	DeadLetterTable string
 }
 
 // NewTable creates a new Table.
@@ -496,6 +496,7 @@ func (sm *stateManager) connect(tabletType topodatapb.TabletType) error {
 	if err := sm.se.Open(); err != nil {
 		return err
 	}
+	sm.hs.WatchSchema()
 	sm.vstreamer.Open()
 	if err := sm.qe.Open(); err != nil {
 		return err
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+const (
+	// tableRowsQuery returns the estimated number of rows of every table.
+	tableRowsQuery = "select table_name, table_rows from information_schema.tables where table_schema = database()"
+
+	// indexCardinalityQuery returns the estimated number of distinct
+	// values of the columns that are the first column of an index.
+	indexCardinalityQuery = "select table_name, column_name, max(cardinality) from information_schema.statistics where table_schema = database() and seq_in_index = 1 group by table_name, column_name"
+
+	// maxTableStatisticsRows is the maximum number of rows
+	// read by the queries loading the table statistics.
+	maxTableStatisticsRows = 100000
+
+	// loadTableStatisticsTimeout is the timeout of the
+	// queries loading the table statistics.
+	loadTableStatisticsTimeout = 30 * time.Second
+)
+
+// loadTableStatistics loads the statistics that MySQL maintains
+// in information_schema for the tables of the database, so loading
+// them doesn't require scanning the tables.
+func loadTableStatistics(ctx context.Context, se *schema.Engine) ([]*querypb.TableStatistics, error) {
+	ctx, cancel := context.WithTimeout(ctx, loadTableStatisticsTimeout)
+	defer cancel()
+
+	conn, err := se.GetConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+
+	tables, err := conn.Exec(ctx, tableRowsQuery, maxTableStatisticsRows, false)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := conn.Exec(ctx, indexCardinalityQuery, maxTableStatisticsRows, false)
+	if err != nil {
+		return nil, err
+	}
+	return buildTableStatistics(tables, indexes), nil
+}
+
+// buildTableStatistics builds the statistics of the tables from the results
+// of tableRowsQuery and indexCardinalityQuery. The tables are sorted by name,
+// so that the statistics can be compared with proto.Equal.
+func buildTableStatistics(tables, indexes *sqltypes.Result) []*querypb.TableStatistics {
+	byName := make(map[string]*querypb.TableStatistics, len(tables.Rows))
+	for _, row := range tables.Rows {
+		// table_rows is NULL for views.
+		rows, _ := row[1].ToInt64()
+		stats := &querypb.TableStatistics{
+			Name: row[0].ToString(),
+			Rows: rows,
+		}
+		byName[stats.Name] = stats
+	}
+	for _, row := range indexes.Rows {
+		stats := byName[row[0].ToString()]
+		if stats == nil {
+			continue
+		}
+		cardinality, err := row[2].ToInt64()
+		if err != nil {
+			// The cardinality is NULL if the index has never been analyzed.
+			continue
+		}
+		// The cardinality is only an estimate, which can exceed the rows.
+		if cardinality > stats.Rows {
+			cardinality = stats.Rows
+		}
+		if stats.Cardinality == nil {
+			stats.Cardinality = make(map[string]int64)
+		}
+		stats.Cardinality[strings.ToLower(row[1].ToString())] = cardinality
+	}
+
+	result := make([]*querypb.TableStatistics, 0, len(byName))
+	for _, stats := range byName {
+		result = append(result, stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
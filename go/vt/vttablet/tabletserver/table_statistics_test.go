@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestBuildTableStatistics(t *testing.T) {
+	tables := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|table_rows", "varchar|uint64"),
+		"t2|10", "t1|100", "v1|null",
+	)
+	indexes := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|column_name|max(cardinality)", "varchar|varchar|int64"),
+		"t1|id|100", "t1|Col|20", "t2|id|12", "t2|col|null", "t3|id|5",
+	)
+	want := []*querypb.TableStatistics{{
+		Name:        "t1",
+		Rows:        100,
+		Cardinality: map[string]int64{"id": 100, "col": 20},
+	}, {
+		Name:        "t2",
+		Rows:        10,
+		Cardinality: map[string]int64{"id": 10},
+	}, {
+		Name: "v1",
+	}}
+	assert.Equal(t, want, buildTableStatistics(tables, indexes))
+}
@@ -168,8 +168,8 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.statefulql = NewQueryList("oltp-stateful")
 	tsv.olapql = NewQueryList("olap")
 	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
-	tsv.hs = newHealthStreamer(tsv, alias)
 	tsv.se = schema.NewEngine(tsv)
+	tsv.hs = newHealthStreamer(tsv, alias, tsv.se)
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
@@ -1050,18 +1050,64 @@ func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Targ
 	})
 }
 
+// DeadLetterMessages moves the messages of a given table that were sent the
+// max number of times, and whose last ack wait ended before the specified time
+// in Unix Nanoseconds, to its dead-letter table. It moves at most 500 messages.
+// It returns the number of messages successfully moved.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error) {
+	return tsv.execInTransaction(ctx, target, func(transactionID int64) (int64, error) {
+		query, bv, err := tsv.messager.GenerateDeadLetterSelectQuery(name, timeCutoff)
+		if err != nil {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
+		if err != nil {
+			return 0, err
+		}
+		if len(qr.Rows) == 0 {
+			return 0, nil
+		}
+		ids := make([]string, 0, len(qr.Rows))
+		for _, row := range qr.Rows {
+			ids = append(ids, row[0].ToString())
+		}
+		queries, bv, err := tsv.messager.GenerateDeadLetterQueries(name, ids)
+		if err != nil {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		for _, query := range queries {
+			if qr, err = tsv.Execute(ctx, target, query, bv, transactionID, 0, nil); err != nil {
+				return 0, err
+			}
+		}
+		// The last query deletes the moved messages.
+		return int64(qr.RowsAffected), nil
+	})
+}
+
 func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+	return tsv.execInTransaction(ctx, target, func(transactionID int64) (int64, error) {
+		query, bv, err := queryGenerator()
+		if err != nil {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
+		if err != nil {
+			return 0, err
+		}
+		return int64(qr.RowsAffected), nil
+	})
+}
+
+// execInTransaction runs execute in a new transaction, and commits it if
+// execute succeeds.
+func (tsv *TabletServer) execInTransaction(ctx context.Context, target *querypb.Target, execute func(transactionID int64) (int64, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.sm.EndRequest()
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
-	if err != nil {
-		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
-	}
-
 	transactionID, _, err := tsv.Begin(ctx, target, nil)
 	if err != nil {
 		return 0, err
@@ -1073,7 +1119,7 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
+	count, err = execute(transactionID)
 	if err != nil {
 		return 0, err
 	}
@@ -1082,7 +1128,7 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 		return 0, err
 	}
 	transactionID = 0
-	return int64(qr.RowsAffected), nil
+	return count, nil
 }
 
 // VStream streams VReplication events.
@@ -1389,7 +1435,7 @@ func convertErrorCode(err error) vtrpcpb.Code {
 	case mysql.ERDiskFull, mysql.EROutOfMemory, mysql.EROutOfSortMemory, mysql.ERConCount, mysql.EROutOfResources, mysql.ERRecordFileFull, mysql.ERHostIsBlocked,
 		mysql.ERCantCreateThread, mysql.ERTooManyDelayedThreads, mysql.ERNetPacketTooLarge, mysql.ERTooManyUserConnections, mysql.ERLockTableFull, mysql.ERUserLimitReached, mysql.ERVitessMaxRowsExceeded:
 		errCode = vtrpcpb.Code_RESOURCE_EXHAUSTED
-	case mysql.ERLockWaitTimeout:
+	case mysql.ERLockWaitTimeout, mysql.ERQueryTimeout:
 		errCode = vtrpcpb.Code_DEADLINE_EXCEEDED
 	case mysql.CRServerGone, mysql.ERServerShutdown:
 		errCode = vtrpcpb.Code_UNAVAILABLE
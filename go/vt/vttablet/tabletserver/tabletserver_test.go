@@ -1661,6 +1661,21 @@ func TestPurgeMessages(t *testing.T) {
 	}
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "message table nonmsg not found in schema")
+
+	_, err = tsv.DeadLetterMessages(ctx, &target, "msg", 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "message table msg has no dead-letter table")
+}
+
 func TestHandleExecUnknownError(t *testing.T) {
 	logStats := tabletenv.NewLogStats(ctx, "TestHandleExecError")
 	config := tabletenv.NewDefaultConfig()
@@ -58,6 +58,9 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// Expression is used for any other constraint, which is
+	// evaluated against the columns of the table by the evalengine
+	Expression
 )
 
 // Filter contains opcodes for filtering.
@@ -74,6 +77,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the constraint for Expression. The row matches if
+	// it evaluates to true.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -91,6 +98,10 @@ type ColExpr struct {
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
+
+	// Expr, if set, is evaluated against the columns of the table
+	// to compute the value. If so, ColNum is ignored.
+	Expr evalengine.Expr
 }
 
 // Table contains the metadata for a table.
@@ -129,11 +140,27 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil, nil
 			}
+		case Expression:
+			result, err := filter.Expr.Evaluate(evalengine.ExpressionEnv{Row: values, Fields: plan.Table.Fields})
+			if err != nil {
+				return false, nil, err
+			}
+			if !result.ToBoolean() {
+				return false, nil, nil
+			}
 		}
 	}
 
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			value, err := colExpr.Expr.Evaluate(evalengine.ExpressionEnv{Row: values, Fields: plan.Table.Fields})
+			if err != nil {
+				return false, nil, err
+			}
+			result[i] = value.Value()
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -366,52 +393,100 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			filter, err := plan.analyzeEqual(expr)
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := sqlparser.NewPlanValue(val)
-			if err != nil {
-				return err
-			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
-				return err
+			if filter != nil {
+				plan.Filters = append(plan.Filters, *filter)
+				continue
 			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
-				ColNum: colnum,
-				Value:  resolved,
-			})
 		case *sqlparser.FuncExpr:
-			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
-			}
-			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
-				return err
+			if expr.Name.EqualString("in_keyrange") {
+				if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
+					return err
+				}
+				continue
 			}
-		default:
+		}
+		evalExpr, err := plan.convertExpr(expr)
+		if err == sqlparser.ErrExprNotSupported {
 			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
 		}
+		if err != nil {
+			return err
+		}
+		plan.Filters = append(plan.Filters, Filter{
+			Opcode: Expression,
+			Expr:   evalExpr,
+		})
 	}
 	return nil
 }
 
+// analyzeEqual returns an Equal filter if the expression compares a column
+// to an integer or a string literal. It returns nil if the expression must
+// be evaluated by the evalengine instead.
+func (plan *Plan) analyzeEqual(expr *sqlparser.ComparisonExpr) (*Filter, error) {
+	if expr.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return nil, err
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	if !ok {
+		return nil, nil
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
+		return nil, nil
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := pv.ResolveValue(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{
+		Opcode: Equal,
+		ColNum: colnum,
+		Value:  resolved,
+	}, nil
+}
+
+// convertExpr converts an expression to be evaluated by the evalengine
+// against the columns of the table. Expressions that need bind variables
+// or subqueries are not supported.
+func (plan *Plan) convertExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case sqlparser.Argument, sqlparser.ListArg, *sqlparser.Subquery:
+			return false, sqlparser.ErrExprNotSupported
+		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return nil, err
+	}
+	return sqlparser.ConvertWithColumns(expr, func(col *sqlparser.ColName) (int, error) {
+		if !col.Qualifier.IsEmpty() {
+			return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+		}
+		return findColumn(plan.Table, col.Name)
+	})
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -473,7 +548,10 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			if !evalengine.IsBuiltin(inner.Name.Lowered()) {
+				return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			}
+			return plan.analyzeComputedExpr(aliased)
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -495,17 +573,14 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			VindexColumns: vindexColumns,
 		}, nil
 	case *sqlparser.Literal:
-		//allow only intval 1
-		if inner.Type != sqlparser.IntVal {
-			return ColExpr{}, fmt.Errorf("only integer literals are supported")
+		// 1 is sent as a fixed value, other literals are evaluated.
+		if inner.Type != sqlparser.IntVal || string(inner.Val) != "1" {
+			return plan.analyzeComputedExpr(aliased)
 		}
 		num, err := strconv.ParseInt(string(inner.Val), 0, 64)
 		if err != nil {
 			return ColExpr{}, err
 		}
-		if num != 1 {
-			return ColExpr{}, fmt.Errorf("only the integer literal 1 is supported")
-		}
 		return ColExpr{
 			Field: &querypb.Field{
 				Name: "1",
@@ -515,9 +590,38 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			FixedValue: sqltypes.NewInt64(num),
 		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
+		return plan.analyzeComputedExpr(aliased)
+	}
+}
+
+// analyzeComputedExpr builds a column that is computed by the evalengine
+// from the columns of the table. The field is named after the alias, or
+// the expression itself.
+func (plan *Plan) analyzeComputedExpr(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	evalExpr, err := plan.convertExpr(aliased.Expr)
+	if err == sqlparser.ErrExprNotSupported {
+		log.Infof("Unsupported expression: %v", aliased.Expr)
 		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
 	}
+	if err != nil {
+		return ColExpr{}, err
+	}
+	typ, err := evalExpr.Type(evalengine.ExpressionEnv{Fields: plan.Table.Fields})
+	if err != nil {
+		return ColExpr{}, err
+	}
+	name := aliased.As.String()
+	if name == "" {
+		name = sqlparser.String(aliased.Expr)
+	}
+	return ColExpr{
+		ColNum: -1,
+		Field: &querypb.Field{
+			Name: name,
+			Type: typ,
+		},
+		Expr: evalExpr,
+	}, nil
 }
 
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
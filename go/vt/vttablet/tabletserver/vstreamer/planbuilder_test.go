@@ -532,8 +532,20 @@ func TestPlanbuilder(t *testing.T) {
 		outErr:  `unsupported function: max(val)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, :a from t1"},
+		outErr:  `unsupported: :a`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = :a or id = 2"},
+		outErr:  `unsupported constraint: id = :a or id = 2`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = 1 or in_keyrange('-80')"},
+		outErr:  `unsupported constraint: id = 1 or in_keyrange('-80')`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where none in (1, 2)"},
+		outErr:  "column `none` not found in table t1",
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -577,3 +589,113 @@ func TestPlanbuilder(t *testing.T) {
 		}
 	}
 }
+
+func TestPlanFilterExpressions(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}, {
+			Name: "price",
+			Type: sqltypes.Int64,
+		}},
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("aaa"), sqltypes.NewInt64(10)},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("bbb"), sqltypes.NULL},
+		{sqltypes.NewInt64(3), sqltypes.NewVarBinary("ccc"), sqltypes.NewInt64(30)},
+		{sqltypes.NewInt64(4), sqltypes.NULL, sqltypes.NewInt64(40)},
+	}
+
+	testcases := []struct {
+		filter string
+		fields string
+		// matched has the projected rows of the matching rows.
+		matched string
+	}{{
+		filter:  "select id from t1 where id in (1, 3, 5)",
+		fields:  "[id:INT64]",
+		matched: "[[1] [3]]",
+	}, {
+		filter:  "select id from t1 where id not in (1, 3)",
+		fields:  "[id:INT64]",
+		matched: "[[2] [4]]",
+	}, {
+		filter:  "select id from t1 where id > 1 and id <= 3",
+		fields:  "[id:INT64]",
+		matched: "[[2] [3]]",
+	}, {
+		filter:  "select id from t1 where id between 2 and 3",
+		fields:  "[id:INT64]",
+		matched: "[[2] [3]]",
+	}, {
+		filter:  "select id from t1 where price is null or val is null",
+		fields:  "[id:INT64]",
+		matched: "[[2] [4]]",
+	}, {
+		filter:  "select id from t1 where (id = 1 or price >= 30) and val is not null",
+		fields:  "[id:INT64]",
+		matched: "[[1] [3]]",
+	}, {
+		filter:  "select id from t1 where not (id < 4) and id = 4",
+		fields:  "[id:INT64]",
+		matched: "[[4]]",
+	}, {
+		// A NULL constraint doesn't match.
+		filter:  "select id from t1 where price > 20",
+		fields:  "[id:INT64]",
+		matched: "[[3] [4]]",
+	}, {
+		filter:  "select id, price * 2 as double_price, concat(val, '!'), ifnull(price, 0) + 1 from t1 where id <= 2",
+		fields:  "[id:INT64 double_price:INT64 concat(val, '!'):VARBINARY ifnull(price, 0) + 1:INT64]",
+		matched: "[[1 20 aaa! 11] [2 NULL bbb! 1]]",
+	}, {
+		// The DECIMAL arithmetic keeps the digits after the decimal point.
+		filter:  "select 1, 'x', id + 0.50, id / 4 from t1 where id = 1",
+		fields:  "[1:INT64 'x':VARBINARY id + 0.50:DECIMAL id / 4:DECIMAL]",
+		matched: "[[1 x 1.50 0.2500]]",
+	}}
+	for _, tcase := range testcases {
+		plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.filter}},
+		})
+		if err != nil {
+			t.Errorf("buildPlan(%s): %v", tcase.filter, err)
+			continue
+		}
+		var fields []string
+		for _, field := range plan.fields() {
+			fields = append(fields, fmt.Sprintf("%s:%v", field.Name, field.Type))
+		}
+		if got := fmt.Sprint(fields); got != tcase.fields {
+			t.Errorf("buildPlan(%s) fields: %s, want %s", tcase.filter, got, tcase.fields)
+		}
+		var matched [][]string
+		for _, row := range rows {
+			ok, values, err := plan.filter(row)
+			if err != nil {
+				t.Errorf("filter(%s, %v): %v", tcase.filter, row, err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			var projected []string
+			for _, value := range values {
+				if value.IsNull() {
+					projected = append(projected, "NULL")
+					continue
+				}
+				projected = append(projected, value.ToString())
+			}
+			matched = append(matched, projected)
+		}
+		if got := fmt.Sprint(matched); got != tcase.matched {
+			t.Errorf("filter(%s): %s, want %s", tcase.filter, got, tcase.matched)
+		}
+	}
+}
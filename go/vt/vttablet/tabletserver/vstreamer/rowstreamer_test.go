@@ -261,6 +261,37 @@ func TestStreamRowsKeyRange(t *testing.T) {
 	checkStream(t, "select * from t1 where in_keyrange('-80')", nil, wantQuery, wantStream)
 }
 
+func TestStreamRowsFilterExpression(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	if err := env.SetVSchema(shardedVSchema); err != nil {
+		t.Fatal(err)
+	}
+	defer env.SetVSchema("{}")
+
+	execStatements(t, []string{
+		"create table t1(id1 int, id2 int, val varbinary(128), primary key(id1))",
+		"insert into t1 values (1, 100, 'kepler'), (2, 200, 'newton'), (3, 100, 'newton'), (4, 200, 'newton'), (5, 100, 'kepler'), (6, 200, 'kepler')",
+	})
+
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	time.Sleep(1 * time.Second)
+
+	// 4 and 6 are not in -80, and 3 doesn't match the expression.
+	wantStream := []string{
+		`fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63 > pkfields:<name:"id1" type:INT32 > `,
+		`rows:<lengths:1 lengths:6 values:"1kepler" > rows:<lengths:1 lengths:6 values:"2newton" > rows:<lengths:1 lengths:6 values:"5kepler" > lastpk:<lengths:1 values:"6" > `,
+	}
+	wantQuery := "select id1, id2, val from t1 order by id1"
+	checkStream(t, "select id1, val from t1 where in_keyrange('-80') and (id2 > 150 or val = 'kepler')", nil, wantQuery, wantStream)
+}
+
 func TestStreamRowsFilterInt(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
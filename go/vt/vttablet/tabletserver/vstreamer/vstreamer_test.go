@@ -1155,6 +1155,61 @@ func TestSelectFilter(t *testing.T) {
 	runCases(t, filter, testcases, "", nil)
 }
 
+func TestFilteredExpression(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id1 int, id2 int, val varbinary(128), primary key(id1))",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	setVSchema(t, shardedVSchema)
+	defer env.SetVSchema("{}")
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select id1, val from t1 where in_keyrange('-80') and (id2 > 150 or val = 'kepler')",
+		}},
+	}
+
+	// 1, 2 and 3 are in -80, 4 is not. An update is sent with
+	// only the before or the after image that matches the filter.
+	testcases := []testcase{{
+		input: []string{
+			"begin",
+			"insert into t1 values (1, 100, 'kepler')",
+			"insert into t1 values (2, 200, 'newton')",
+			"insert into t1 values (3, 100, 'newton')",
+			"insert into t1 values (4, 200, 'newton')",
+			"update t1 set id2 = 100 where id1 = 2",
+			"update t1 set val = 'kepler' where id1 = 3",
+			"update t1 set id2 = 300 where id1 = 1",
+			"update t1 set val = 'kepler' where id1 = 4",
+			"delete from t1 where id1 = 3",
+			"commit",
+		},
+		output: [][]string{{
+			`begin`,
+			`type:FIELD field_event:<table_name:"t1" fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63 > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<after:<lengths:1 lengths:6 values:"1kepler" > > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<after:<lengths:1 lengths:6 values:"2newton" > > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<before:<lengths:1 lengths:6 values:"2newton" > > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<after:<lengths:1 lengths:6 values:"3kepler" > > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<before:<lengths:1 lengths:6 values:"1kepler" > after:<lengths:1 lengths:6 values:"1kepler" > > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<before:<lengths:1 lengths:6 values:"3kepler" > > > `,
+			`gtid`,
+			`commit`,
+		}},
+	}}
+	runCases(t, filter, testcases, "", nil)
+}
+
 func TestDDLAddColumn(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Actions of MessageDeadLetters.
+const (
+	DeadLettersList    = "list"
+	DeadLettersRequeue = "requeue"
+	DeadLettersPurge   = "purge"
+)
+
+// deadLettersBatchSize is the number of messages requeued per transaction.
+const deadLettersBatchSize = 500
+
+// deadLetterQueries are the queries that act on the dead-letter table of a
+// message table.
+type deadLetterQueries struct {
+	// list selects the dead letters.
+	list *sqlparser.ParsedQuery
+	// lock selects the ids of a batch of dead letters for update.
+	lock *sqlparser.ParsedQuery
+	// requeue copies the locked dead letters to the message table, so
+	// that they're sent again as new messages.
+	requeue *sqlparser.ParsedQuery
+	// delete deletes the locked dead letters.
+	delete *sqlparser.ParsedQuery
+	// purge deletes the dead letters.
+	purge *sqlparser.ParsedQuery
+}
+
+// buildDeadLetterQueries builds the queries for the dead-letter table of a
+// message table, given the columns of the message table. The queries act on
+// the specified ids, or on all the dead letters if there are none.
+func buildDeadLetterQueries(table, deadLetterTable string, columns []string, hasIDs bool) *deadLetterQueries {
+	tableName := sqlparser.NewTableIdent(table)
+	deadLetterTableName := sqlparser.NewTableIdent(deadLetterTable)
+	where := ""
+	if hasIDs {
+		where = " where id in ::ids"
+	}
+
+	insertColumns := sqlparser.NewTrackedBuffer(nil)
+	selectExprs := sqlparser.NewTrackedBuffer(nil)
+	for i, column := range columns {
+		if i != 0 {
+			insertColumns.WriteString(", ")
+			selectExprs.WriteString(", ")
+		}
+		insertColumns.Myprintf("%v", sqlparser.NewColIdent(column))
+		switch strings.ToLower(column) {
+		case "time_next":
+			selectExprs.WriteString(":time_next")
+		case "epoch":
+			selectExprs.WriteString("0")
+		case "time_acked":
+			selectExprs.WriteString("null")
+		default:
+			selectExprs.Myprintf("%v", sqlparser.NewColIdent(column))
+		}
+	}
+
+	return &deadLetterQueries{
+		list: sqlparser.BuildParsedQuery("select * from %v%s", deadLetterTableName, where),
+		lock: sqlparser.BuildParsedQuery("select id from %v%s order by id limit %s for update",
+			deadLetterTableName, where, fmt.Sprint(deadLettersBatchSize)),
+		requeue: sqlparser.BuildParsedQuery("insert into %v(%s) select %s from %v where id in %a",
+			tableName, insertColumns.String(), selectExprs.String(), deadLetterTableName, "::locked_ids"),
+		delete: sqlparser.BuildParsedQuery("delete from %v where id in %a", deadLetterTableName, "::locked_ids"),
+		purge:  sqlparser.BuildParsedQuery("delete from %v%s", deadLetterTableName, where),
+	}
+}
+
+// MessageDeadLetters lists, requeues or purges the messages that were moved
+// to the dead-letter table of a message table, on the primaries of all the
+// shards of the keyspace. It acts on the messages with the specified ids, or
+// on all of them if there are none. Requeued messages are moved back to the
+// message table, and are sent again as new messages.
+func (wr *Wrangler) MessageDeadLetters(ctx context.Context, keyspace, table, action string, ids []string) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	switch action {
+	case DeadLettersList, DeadLettersRequeue, DeadLettersPurge:
+	default:
+		return nil, fmt.Errorf("invalid action %s, must be one of %s, %s, %s", action, DeadLettersList, DeadLettersRequeue, DeadLettersPurge)
+	}
+	vx := newVExec(ctx, "", keyspace, "", wr)
+	if err := vx.getMasters(); err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	results := make(map[*topo.TabletInfo]*sqltypes.Result)
+	var mu sync.Mutex
+	for _, master := range vx.masters {
+		wg.Add(1)
+		go func(master *topo.TabletInfo) {
+			defer wg.Done()
+			qr, err := wr.messageDeadLettersOnTablet(ctx, master, table, action, ids)
+			if err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "tablet %v", master.AliasString()))
+				return
+			}
+			mu.Lock()
+			results[master] = qr
+			mu.Unlock()
+		}(master)
+	}
+	wg.Wait()
+	return results, allErrors.AggrError(vterrors.Aggregate)
+}
+
+func (wr *Wrangler) messageDeadLettersOnTablet(ctx context.Context, master *topo.TabletInfo, table, action string, ids []string) (*sqltypes.Result, error) {
+	conn, err := tabletconn.GetDialer()(master.Tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(ctx)
+	target := &querypb.Target{
+		Keyspace:   master.Keyspace,
+		Shard:      master.Shard,
+		TabletType: master.Type,
+	}
+
+	qr, err := conn.Execute(ctx, target,
+		"select table_comment from information_schema.tables where table_schema = database() and table_name = :table_name",
+		map[string]*querypb.BindVariable{"table_name": sqltypes.StringBindVariable(table)}, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, fmt.Errorf("table %s not found", table)
+	}
+	deadLetterTable := schema.MessageDeadLetterTable(qr.Rows[0][0].ToString())
+	if deadLetterTable == "" {
+		return nil, fmt.Errorf("table %s is not a message table with a dead-letter table", table)
+	}
+	qr, err = conn.Execute(ctx, target, fmt.Sprintf("select * from %v where 1 != 1", sqlparser.NewTableIdent(table)), nil, 0, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(qr.Fields))
+	for _, field := range qr.Fields {
+		columns = append(columns, field.Name)
+	}
+
+	queries := buildDeadLetterQueries(table, deadLetterTable, columns, len(ids) != 0)
+	bindVars := map[string]*querypb.BindVariable{}
+	if len(ids) != 0 {
+		idbvs := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+		for _, id := range ids {
+			idbvs.Values = append(idbvs.Values, &querypb.Value{
+				Type:  querypb.Type_VARBINARY,
+				Value: []byte(id),
+			})
+		}
+		bindVars["ids"] = idbvs
+	}
+	switch action {
+	case DeadLettersList:
+		return conn.Execute(ctx, target, queries.list.Query, bindVars, 0, 0, nil)
+	case DeadLettersPurge:
+		return execDeadLettersTransaction(ctx, conn, target, queries.purge.Query, bindVars, nil)
+	}
+
+	requeued := &sqltypes.Result{}
+	for {
+		qr, err := execDeadLettersTransaction(ctx, conn, target, queries.lock.Query, bindVars, func(transactionID int64, locked *sqltypes.Result) (*sqltypes.Result, error) {
+			if len(locked.Rows) == 0 {
+				return locked, nil
+			}
+			lockedIDs := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+			for _, row := range locked.Rows {
+				lockedIDs.Values = append(lockedIDs.Values, sqltypes.ValueToProto(row[0]))
+			}
+			batchBindVars := map[string]*querypb.BindVariable{
+				"locked_ids": lockedIDs,
+				"time_next":  sqltypes.Int64BindVariable(time.Now().UnixNano()),
+			}
+			if _, err := conn.Execute(ctx, target, queries.requeue.Query, batchBindVars, transactionID, 0, nil); err != nil {
+				return nil, err
+			}
+			return conn.Execute(ctx, target, queries.delete.Query, batchBindVars, transactionID, 0, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+		requeued.RowsAffected += qr.RowsAffected
+		if qr.RowsAffected < deadLettersBatchSize {
+			return requeued, nil
+		}
+	}
+}
+
+// execDeadLettersTransaction executes query in a new transaction, then
+// execute if it's not nil, and commits the transaction. It returns the result
+// of the last query.
+func execDeadLettersTransaction(ctx context.Context, conn queryservice.QueryService, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, execute func(transactionID int64, qr *sqltypes.Result) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	qr, transactionID, _, err := conn.BeginExecute(ctx, target, nil, query, bindVars, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	if execute != nil {
+		if qr, err = execute(transactionID, qr); err != nil {
+			conn.Rollback(ctx, target, transactionID)
+			return nil, err
+		}
+	}
+	if _, err := conn.Commit(ctx, target, transactionID); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildDeadLetterQueries(t *testing.T) {
+	columns := []string{"id", "priority", "time_next", "epoch", "time_acked", "message"}
+
+	queries := buildDeadLetterQueries("msg", "msg_dlq", columns, true)
+	assert.Equal(t, "select * from msg_dlq where id in ::ids", queries.list.Query)
+	assert.Equal(t, "select id from msg_dlq where id in ::ids order by id limit 500 for update", queries.lock.Query)
+	assert.Equal(t, "insert into msg(id, priority, time_next, epoch, time_acked, message) select id, priority, :time_next, 0, null, message from msg_dlq where id in ::locked_ids", queries.requeue.Query)
+	assert.Equal(t, "delete from msg_dlq where id in ::locked_ids", queries.delete.Query)
+	assert.Equal(t, "delete from msg_dlq where id in ::ids", queries.purge.Query)
+
+	queries = buildDeadLetterQueries("msg", "msg_dlq", columns, false)
+	assert.Equal(t, "select * from msg_dlq", queries.list.Query)
+	assert.Equal(t, "select id from msg_dlq order by id limit 500 for update", queries.lock.Query)
+	assert.Equal(t, "delete from msg_dlq", queries.purge.Query)
+}
+
+func TestMessageDeadLettersInvalidAction(t *testing.T) {
+	wr := New(nil, nil, nil)
+	_, err := wr.MessageDeadLetters(context.Background(), "ks", "msg", "drop", nil)
+	assert.EqualError(t, err, "invalid action drop, must be one of list, requeue, purge")
+}
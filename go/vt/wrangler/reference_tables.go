@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const referenceTableWorkflowPrefix = "reference_"
+
+// ReferenceTableWorkflow returns the name of the workflow
+// that copies a reference table from its source keyspace.
+func ReferenceTableWorkflow(table string) string {
+	return referenceTableWorkflowPrefix + table
+}
+
+// ReferenceTableStream is the state of the stream
+// that copies a reference table to a shard.
+type ReferenceTableStream struct {
+	Table string
+	Shard string
+	// State is empty if the shard has no stream. Message
+	// then contains the error that prevented its creation.
+	State   string
+	Message string
+}
+
+// MaterializeReferenceTables copies the reference tables of keyspace that
+// have a source keyspace to all its shards. The workflows that copy them
+// are created and started on the shards that don't have one yet.
+// It returns the state of the streams of all those tables.
+func (wr *Wrangler) MaterializeReferenceTables(ctx context.Context, keyspace string) ([]*ReferenceTableStream, error) {
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for name, table := range vschema.Tables {
+		if table.Type == vindexes.TypeReference && table.Source != "" {
+			tables = append(tables, name)
+		}
+	}
+	if len(tables) == 0 {
+		return nil, nil
+	}
+	sort.Strings(tables)
+
+	shards, err := wr.ts.GetServingShards(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	streams, err := wr.referenceTableStreams(ctx, shards, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	created := false
+	createErrors := make(map[string]error)
+	for _, table := range tables {
+		var missing []*topo.ShardInfo
+		for _, si := range shards {
+			if streams[table][si.ShardName()] == nil {
+				missing = append(missing, si)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		wr.Logger().Infof("Materializing reference table %s.%s from keyspace %s on %d shards", keyspace, table, vschema.Tables[table].Source, len(missing))
+		if err := wr.materializeReferenceTable(ctx, keyspace, table, vschema.Tables[table].Source, missing); err != nil {
+			createErrors[table] = err
+			continue
+		}
+		created = true
+	}
+	if created {
+		if streams, err = wr.referenceTableStreams(ctx, shards, tables); err != nil {
+			return nil, err
+		}
+	}
+
+	var result []*ReferenceTableStream
+	for _, table := range tables {
+		for _, si := range shards {
+			stream := streams[table][si.ShardName()]
+			if stream == nil {
+				stream = &ReferenceTableStream{Table: table, Shard: si.ShardName()}
+				if err := createErrors[table]; err != nil {
+					stream.Message = err.Error()
+				}
+			}
+			result = append(result, stream)
+		}
+	}
+	return result, nil
+}
+
+// materializeReferenceTable creates and starts the streams
+// that copy a reference table to the given shards.
+func (wr *Wrangler) materializeReferenceTable(ctx context.Context, keyspace, table, source string, shards []*topo.ShardInfo) error {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       ReferenceTableWorkflow(table),
+		SourceKeyspace: source,
+		TargetKeyspace: keyspace,
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      table,
+			SourceExpression: fmt.Sprintf("select * from %s", sqlparser.String(sqlparser.NewTableIdent(table))),
+			CreateDdl:        createDDLAsCopy,
+		}},
+	}
+	mz, err := wr.buildMaterializer(ctx, ms)
+	if err != nil {
+		return err
+	}
+	// The other shards already have a stream.
+	mz.targetShards = shards
+	if err := mz.deploySchema(ctx); err != nil {
+		return err
+	}
+	inserts, err := mz.generateInserts(ctx)
+	if err != nil {
+		return err
+	}
+	if err := mz.createStreams(ctx, inserts); err != nil {
+		return err
+	}
+	return mz.startStreams(ctx)
+}
+
+// referenceTableStreams returns the streams of the workflows
+// that copy the tables to the shards, by table and shard.
+func (wr *Wrangler) referenceTableStreams(ctx context.Context, shards []*topo.ShardInfo, tables []string) (map[string]map[string]*ReferenceTableStream, error) {
+	workflows := make([]string, 0, len(tables))
+	for _, table := range tables {
+		workflows = append(workflows, encodeString(ReferenceTableWorkflow(table)))
+	}
+	streams := make(map[string]map[string]*ReferenceTableStream)
+	for _, table := range tables {
+		streams[table] = make(map[string]*ReferenceTableStream)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, si := range shards {
+		if si.MasterAlias == nil {
+			allErrors.RecordError(fmt.Errorf("shard has no master: %v", si.ShardName()))
+			continue
+		}
+		wg.Add(1)
+		go func(si *topo.ShardInfo) {
+			defer wg.Done()
+
+			master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+			if err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "GetTablet(%v) failed", si.MasterAlias))
+				return
+			}
+			query := fmt.Sprintf("select workflow, state, message from _vt.vreplication where db_name=%s and workflow in (%s)", encodeString(master.DbName()), strings.Join(workflows, ", "))
+			p3qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+			if err != nil {
+				allErrors.RecordError(vterrors.Wrapf(err, "VReplicationExec(%v, %s)", master.Tablet, query))
+				return
+			}
+			qr := sqltypes.Proto3ToResult(p3qr)
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, row := range qr.Rows {
+				table := strings.TrimPrefix(row[0].ToString(), referenceTableWorkflowPrefix)
+				streams[table][si.ShardName()] = &ReferenceTableStream{
+					Table:   table,
+					Shard:   si.ShardName(),
+					State:   row[1].ToString(),
+					Message: row[2].ToString(),
+				}
+			}
+		}(si)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	return streams, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	refSelectStreamsQuery = "select workflow, state, message from _vt.vreplication where db_name='vt_targetks' and workflow in ('reference_t1', 'reference_t2')"
+	refUpdateQuery        = "update _vt.vreplication set state='Running' where db_name='vt_targetks' and workflow='reference_t1'"
+)
+
+var refStreamsFields = sqltypes.MakeTestFields("workflow|state|message", "varchar|varchar|varchar")
+
+func TestMaterializeReferenceTables(t *testing.T) {
+	// The workflow is left empty, as the materialization
+	// doesn't validate that the workflow is new.
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}, {
+			TargetTable:      "t2",
+			SourceExpression: "select * from t2",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Tables: map[string]*vschemapb.Table{
+			"t1":  {Type: "reference", Source: "sourceks"},
+			"t2":  {Type: "reference", Source: "sourceks"},
+			"ref": {Type: "reference"},
+		},
+	}
+	err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs)
+	require.NoError(t, err)
+
+	// t2 is copied to all the shards, and t1 only to 80-.
+	env.tmc.expectVRQuery(200, refSelectStreamsQuery, sqltypes.MakeTestResult(refStreamsFields,
+		"reference_t2|Running|",
+	))
+	env.tmc.expectVRQuery(210, refSelectStreamsQuery, sqltypes.MakeTestResult(refStreamsFields,
+		"reference_t1|Running|",
+		"reference_t2|Error|duplicate key",
+	))
+	// The stream of t1 is created on -80, without a key range filter.
+	env.tmc.expectVRQuery(200, insertPrefix+`\('reference_t1', 'keyspace:\\"sourceks\\" shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select \* from t1\\" > > ', .*`, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, refUpdateQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, refSelectStreamsQuery, sqltypes.MakeTestResult(refStreamsFields,
+		"reference_t1|Copying|",
+		"reference_t2|Running|",
+	))
+	env.tmc.expectVRQuery(210, refSelectStreamsQuery, sqltypes.MakeTestResult(refStreamsFields,
+		"reference_t1|Running|",
+		"reference_t2|Error|duplicate key",
+	))
+
+	streams, err := env.wr.MaterializeReferenceTables(context.Background(), "targetks")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+	assert.Equal(t, []*ReferenceTableStream{
+		{Table: "t1", Shard: "-80", State: "Copying"},
+		{Table: "t1", Shard: "80-", State: "Running"},
+		{Table: "t2", Shard: "-80", State: "Running"},
+		{Table: "t2", Shard: "80-", State: "Error", Message: "duplicate key"},
+	}, streams)
+}
+
+func TestMaterializeReferenceTablesError(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Tables: map[string]*vschemapb.Table{
+			"t1": {Type: "reference", Source: "sourceks"},
+		},
+	}
+	err := env.topoServ.SaveVSchema(context.Background(), "targetks", vs)
+	require.NoError(t, err)
+
+	// The source table doesn't exist, so its schema can't be copied.
+	env.tmc.expectVRQuery(200, "select workflow, state, message from _vt.vreplication where db_name='vt_targetks' and workflow in ('reference_t1')", &sqltypes.Result{})
+
+	streams, err := env.wr.MaterializeReferenceTables(context.Background(), "targetks")
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+	assert.Equal(t, []*ReferenceTableStream{
+		{Table: "t1", Shard: "0", Message: "source table t1 does not exist"},
+	}, streams)
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+)
+
+// VDiffJobReport is the report of a vdiff that runs on the target primaries.
+type VDiffJobReport struct {
+	UUID     string
+	Workflow string
+	Keyspace string
+	// State is the state of the vdiff: it's the state of the shards if
+	// they agree, else "error" if any shard failed, else "started".
+	State    string
+	Mismatch bool
+	Shards   map[string]*VDiffShardReport
+}
+
+// VDiffShardReport is the report of a vdiff on one target shard.
+type VDiffShardReport struct {
+	State       string
+	StartedAt   string `json:",omitempty"`
+	CompletedAt string `json:",omitempty"`
+	LastError   string `json:",omitempty"`
+	Tables      map[string]*VDiffTableProgress
+}
+
+// VDiffTableProgress is the progress and the report of the diff of a table
+// on one target shard.
+type VDiffTableProgress struct {
+	State        string
+	TableRows    int64
+	RowsCompared int64
+	Mismatch     bool
+	Report       *tabletvdiff.TableReport `json:",omitempty"`
+}
+
+// StartVDiff creates a vdiff of the workflow, which runs on the target
+// primaries that have streams of the workflow. It returns the uuid of the vdiff.
+func (wr *Wrangler) StartVDiff(ctx context.Context, keyspace, workflow string, options *tabletvdiff.Options) (string, error) {
+	uuid, err := schema.CreateUUID()
+	if err != nil {
+		return "", err
+	}
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+	query, err := sqlparser.ParseAndBind("insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values (%a, %a, %a, '', '', %a, %a)",
+		sqltypes.StringBindVariable(uuid),
+		sqltypes.StringBindVariable(workflow),
+		sqltypes.StringBindVariable(keyspace),
+		sqltypes.StringBindVariable(tabletvdiff.PendingState),
+		sqltypes.StringBindVariable(string(optionsJSON)),
+	)
+	if err != nil {
+		return "", err
+	}
+	results, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	if err != nil {
+		return "", err
+	}
+	var started int
+	for _, result := range results {
+		started += int(result.RowsAffected)
+	}
+	if started == 0 {
+		return "", fmt.Errorf("no streams found for workflow %s in keyspace %s", workflow, keyspace)
+	}
+	return uuid, nil
+}
+
+// StopVDiff stops the vdiff with the given uuid, or all the vdiffs of the
+// workflow if uuid is empty. The vdiffs can be resumed with ResumeVDiff.
+func (wr *Wrangler) StopVDiff(ctx context.Context, keyspace, workflow, uuid string) (*sqltypes.Result, error) {
+	return wr.updateVDiffState(ctx, keyspace, workflow, uuid, tabletvdiff.StoppedState)
+}
+
+// ResumeVDiff resumes the vdiff with the given uuid, or all the vdiffs of
+// the workflow if uuid is empty, if they were stopped or failed. The tables
+// that were diffed are not diffed again, and the others resume where they stopped.
+func (wr *Wrangler) ResumeVDiff(ctx context.Context, keyspace, workflow, uuid string) (*sqltypes.Result, error) {
+	return wr.updateVDiffState(ctx, keyspace, workflow, uuid, tabletvdiff.PendingState)
+}
+
+func (wr *Wrangler) updateVDiffState(ctx context.Context, keyspace, workflow, uuid, state string) (*sqltypes.Result, error) {
+	query, err := sqlparser.ParseAndBind("update _vt.vdiff set state=%a", sqltypes.StringBindVariable(state))
+	if err != nil {
+		return nil, err
+	}
+	if uuid != "" {
+		condition, err := sqlparser.ParseAndBind(" where vdiff_uuid=%a", sqltypes.StringBindVariable(uuid))
+		if err != nil {
+			return nil, err
+		}
+		query += condition
+	}
+	return wr.VExecResult(ctx, workflow, keyspace, query, false)
+}
+
+// ListVDiffs lists the vdiffs of the workflow on each target primary.
+func (wr *Wrangler) ListVDiffs(ctx context.Context, keyspace, workflow string) (*sqltypes.Result, error) {
+	query := "select vdiff_uuid, shard, state, created_at, started_at, completed_at, last_error from _vt.vdiff"
+	return wr.VExecResult(ctx, workflow, keyspace, query, false)
+}
+
+// ShowVDiff returns the report of the vdiff with the given uuid, with the
+// progress of each table on each target shard.
+func (wr *Wrangler) ShowVDiff(ctx context.Context, keyspace, workflow, uuid string) (*VDiffJobReport, error) {
+	query, err := sqlparser.ParseAndBind("select id, shard, state, started_at, completed_at, last_error from _vt.vdiff where vdiff_uuid=%a",
+		sqltypes.StringBindVariable(uuid))
+	if err != nil {
+		return nil, err
+	}
+	results, err := wr.VExec(ctx, workflow, keyspace, query, false)
+	if err != nil {
+		return nil, err
+	}
+	report := &VDiffJobReport{
+		UUID:     uuid,
+		Workflow: workflow,
+		Keyspace: keyspace,
+		Shards:   make(map[string]*VDiffShardReport),
+	}
+	states := make(map[string]bool)
+	for master, result := range results {
+		if len(result.Rows) == 0 {
+			continue
+		}
+		row := result.Named().Row()
+		shardReport := &VDiffShardReport{
+			State:       row["state"].ToString(),
+			StartedAt:   row["started_at"].ToString(),
+			CompletedAt: row["completed_at"].ToString(),
+			LastError:   row["last_error"].ToString(),
+			Tables:      make(map[string]*VDiffTableProgress),
+		}
+		report.Shards[row["shard"].ToString()] = shardReport
+		states[shardReport.State] = true
+
+		query := fmt.Sprintf("select table_name, state, table_rows, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_id=%s", row["id"].ToString())
+		qr, err := wr.GenericVExec(ctx, master.Alias, query, workflow, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		for _, trow := range sqltypes.Proto3ToResult(qr).Named().Rows {
+			progress := &VDiffTableProgress{
+				State: trow["state"].ToString(),
+			}
+			if progress.TableRows, err = trow["table_rows"].ToInt64(); err != nil {
+				return nil, err
+			}
+			if progress.RowsCompared, err = trow["rows_compared"].ToInt64(); err != nil {
+				return nil, err
+			}
+			progress.Mismatch = trow["mismatch"].ToString() == "1"
+			if tableReport := trow["report"].ToBytes(); len(tableReport) != 0 {
+				progress.Report = &tabletvdiff.TableReport{}
+				if err := json.Unmarshal(tableReport, progress.Report); err != nil {
+					return nil, err
+				}
+			}
+			if progress.Mismatch {
+				report.Mismatch = true
+			}
+			shardReport.Tables[trow["table_name"].ToString()] = progress
+		}
+	}
+	switch {
+	case len(report.Shards) == 0:
+		return nil, fmt.Errorf("vdiff %s not found for workflow %s in keyspace %s", uuid, workflow, keyspace)
+	case len(states) == 1:
+		for state := range states {
+			report.State = state
+		}
+	case states[tabletvdiff.ErrorState]:
+		report.State = tabletvdiff.ErrorState
+	default:
+		report.State = tabletvdiff.StartedState
+	}
+	return report, nil
+}
@@ -16339,6 +16339,12 @@ export namespace query {
 
         /** RealtimeStats qps */
         qps?: (number|null);
+
+        /** RealtimeStats table_schema_changed */
+        table_schema_changed?: (string[]|null);
+
+        /** RealtimeStats table_statistics */
+        table_statistics?: (query.ITableStatistics[]|null);
     }
 
     /** Represents a RealtimeStats. */
@@ -16368,6 +16374,12 @@ export namespace query {
         /** RealtimeStats qps. */
         public qps: number;
 
+        /** RealtimeStats table_schema_changed. */
+        public table_schema_changed: string[];
+
+        /** RealtimeStats table_statistics. */
+        public table_statistics: query.ITableStatistics[];
+
         /**
          * Creates a new RealtimeStats instance using the specified properties.
          * @param [properties] Properties to set
@@ -16776,6 +16788,108 @@ export namespace query {
          */
         public toJSON(): { [k: string]: any };
     }
+
+    /** Properties of a TableStatistics. */
+    interface ITableStatistics {
+
+        /** TableStatistics name */
+        name?: (string|null);
+
+        /** TableStatistics rows */
+        rows?: (number|Long|null);
+
+        /** TableStatistics cardinality */
+        cardinality?: ({ [k: string]: (number|Long) }|null);
+    }
+
+    /** Represents a TableStatistics. */
+    class TableStatistics implements ITableStatistics {
+
+        /**
+         * Constructs a new TableStatistics.
+         * @param [properties] Properties to set
+         */
+        constructor(properties?: query.ITableStatistics);
+
+        /** TableStatistics name. */
+        public name: string;
+
+        /** TableStatistics rows. */
+        public rows: (number|Long);
+
+        /** TableStatistics cardinality. */
+        public cardinality: { [k: string]: (number|Long) };
+
+        /**
+         * Creates a new TableStatistics instance using the specified properties.
+         * @param [properties] Properties to set
+         * @returns TableStatistics instance
+         */
+        public static create(properties?: query.ITableStatistics): query.TableStatistics;
+
+        /**
+         * Encodes the specified TableStatistics message. Does not implicitly {@link query.TableStatistics.verify|verify} messages.
+         * @param message TableStatistics message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encode(message: query.ITableStatistics, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Encodes the specified TableStatistics message, length delimited. Does not implicitly {@link query.TableStatistics.verify|verify} messages.
+         * @param message TableStatistics message or plain object to encode
+         * @param [writer] Writer to encode to
+         * @returns Writer
+         */
+        public static encodeDelimited(message: query.ITableStatistics, writer?: $protobuf.Writer): $protobuf.Writer;
+
+        /**
+         * Decodes a TableStatistics message from the specified reader or buffer.
+         * @param reader Reader or buffer to decode from
+         * @param [length] Message length if known beforehand
+         * @returns TableStatistics
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): query.TableStatistics;
+
+        /**
+         * Decodes a TableStatistics message from the specified reader or buffer, length delimited.
+         * @param reader Reader or buffer to decode from
+         * @returns TableStatistics
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        public static decodeDelimited(reader: ($protobuf.Reader|Uint8Array)): query.TableStatistics;
+
+        /**
+         * Verifies a TableStatistics message.
+         * @param message Plain object to verify
+         * @returns `null` if valid, otherwise the reason why it is not
+         */
+        public static verify(message: { [k: string]: any }): (string|null);
+
+        /**
+         * Creates a TableStatistics message from a plain object. Also converts values to their respective internal types.
+         * @param object Plain object
+         * @returns TableStatistics
+         */
+        public static fromObject(object: { [k: string]: any }): query.TableStatistics;
+
+        /**
+         * Creates a plain object from a TableStatistics message. Also converts values to other types if specified.
+         * @param message TableStatistics
+         * @param [options] Conversion options
+         * @returns Plain object
+         */
+        public static toObject(message: query.TableStatistics, options?: $protobuf.IConversionOptions): { [k: string]: any };
+
+        /**
+         * Converts this TableStatistics to JSON.
+         * @returns JSON object
+         */
+        public toJSON(): { [k: string]: any };
+    }
 }
 
 /** Namespace topodata. */
@@ -24149,6 +24263,12 @@ export namespace vschema {
 
         /** Table column_list_authoritative */
         column_list_authoritative?: (boolean|null);
+
+        /** Table source */
+        source?: (string|null);
+
+        /** Table result_cache_ttl */
+        result_cache_ttl?: (string|null);
     }
 
     /** Represents a Table. */
@@ -24178,6 +24298,12 @@ export namespace vschema {
         /** Table column_list_authoritative. */
         public column_list_authoritative: boolean;
 
+        /** Table source. */
+        public source: string;
+
+        /** Table result_cache_ttl. */
+        public result_cache_ttl: string;
+
         /**
          * Creates a new Table instance using the specified properties.
          * @param [properties] Properties to set
@@ -38082,6 +38082,8 @@ $root.query = (function() {
          * @property {number|Long|null} [seconds_behind_master_filtered_replication] RealtimeStats seconds_behind_master_filtered_replication
          * @property {number|null} [cpu_usage] RealtimeStats cpu_usage
          * @property {number|null} [qps] RealtimeStats qps
+         * @property {Array.<string>|null} [table_schema_changed] RealtimeStats table_schema_changed
+         * @property {Array.<query.ITableStatistics>|null} [table_statistics] RealtimeStats table_statistics
          */
 
         /**
@@ -38093,6 +38095,8 @@ $root.query = (function() {
          * @param {query.IRealtimeStats=} [properties] Properties to set
          */
         function RealtimeStats(properties) {
+            this.table_schema_changed = [];
+            this.table_statistics = [];
             if (properties)
                 for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
                     if (properties[keys[i]] != null)
@@ -38147,6 +38151,22 @@ $root.query = (function() {
          */
         RealtimeStats.prototype.qps = 0;
 
+        /**
+         * RealtimeStats table_schema_changed.
+         * @member {Array.<string>} table_schema_changed
+         * @memberof query.RealtimeStats
+         * @instance
+         */
+        RealtimeStats.prototype.table_schema_changed = $util.emptyArray;
+
+        /**
+         * RealtimeStats table_statistics.
+         * @member {Array.<query.ITableStatistics>} table_statistics
+         * @memberof query.RealtimeStats
+         * @instance
+         */
+        RealtimeStats.prototype.table_statistics = $util.emptyArray;
+
         /**
          * Creates a new RealtimeStats instance using the specified properties.
          * @function create
@@ -38183,6 +38203,12 @@ $root.query = (function() {
                 writer.uint32(/* id 5, wireType 1 =*/41).double(message.cpu_usage);
             if (message.qps != null && Object.hasOwnProperty.call(message, "qps"))
                 writer.uint32(/* id 6, wireType 1 =*/49).double(message.qps);
+            if (message.table_schema_changed != null && message.table_schema_changed.length)
+                for (var i = 0; i < message.table_schema_changed.length; ++i)
+                    writer.uint32(/* id 7, wireType 2 =*/58).string(message.table_schema_changed[i]);
+            if (message.table_statistics != null && message.table_statistics.length)
+                for (var i = 0; i < message.table_statistics.length; ++i)
+                    $root.query.TableStatistics.encode(message.table_statistics[i], writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
             return writer;
         };
 
@@ -38235,6 +38261,16 @@ $root.query = (function() {
                 case 6:
                     message.qps = reader.double();
                     break;
+                case 7:
+                    if (!(message.table_schema_changed && message.table_schema_changed.length))
+                        message.table_schema_changed = [];
+                    message.table_schema_changed.push(reader.string());
+                    break;
+                case 8:
+                    if (!(message.table_statistics && message.table_statistics.length))
+                        message.table_statistics = [];
+                    message.table_statistics.push($root.query.TableStatistics.decode(reader, reader.uint32()));
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -38288,6 +38324,22 @@ $root.query = (function() {
             if (message.qps != null && message.hasOwnProperty("qps"))
                 if (typeof message.qps !== "number")
                     return "qps: number expected";
+            if (message.table_schema_changed != null && message.hasOwnProperty("table_schema_changed")) {
+                if (!Array.isArray(message.table_schema_changed))
+                    return "table_schema_changed: array expected";
+                for (var i = 0; i < message.table_schema_changed.length; ++i)
+                    if (!$util.isString(message.table_schema_changed[i]))
+                        return "table_schema_changed: string[] expected";
+            }
+            if (message.table_statistics != null && message.hasOwnProperty("table_statistics")) {
+                if (!Array.isArray(message.table_statistics))
+                    return "table_statistics: array expected";
+                for (var i = 0; i < message.table_statistics.length; ++i) {
+                    var error = $root.query.TableStatistics.verify(message.table_statistics[i]);
+                    if (error)
+                        return "table_statistics." + error;
+                }
+            }
             return null;
         };
 
@@ -38322,6 +38374,23 @@ $root.query = (function() {
                 message.cpu_usage = Number(object.cpu_usage);
             if (object.qps != null)
                 message.qps = Number(object.qps);
+            if (object.table_schema_changed) {
+                if (!Array.isArray(object.table_schema_changed))
+                    throw TypeError(".query.RealtimeStats.table_schema_changed: array expected");
+                message.table_schema_changed = [];
+                for (var i = 0; i < object.table_schema_changed.length; ++i)
+                    message.table_schema_changed[i] = String(object.table_schema_changed[i]);
+            }
+            if (object.table_statistics) {
+                if (!Array.isArray(object.table_statistics))
+                    throw TypeError(".query.RealtimeStats.table_statistics: array expected");
+                message.table_statistics = [];
+                for (var i = 0; i < object.table_statistics.length; ++i) {
+                    if (typeof object.table_statistics[i] !== "object")
+                        throw TypeError(".query.RealtimeStats.table_statistics: object expected");
+                    message.table_statistics[i] = $root.query.TableStatistics.fromObject(object.table_statistics[i]);
+                }
+            }
             return message;
         };
 
@@ -38338,6 +38407,10 @@ $root.query = (function() {
             if (!options)
                 options = {};
             var object = {};
+            if (options.arrays || options.defaults) {
+                object.table_schema_changed = [];
+                object.table_statistics = [];
+            }
             if (options.defaults) {
                 object.health_error = "";
                 object.seconds_behind_master = 0;
@@ -38365,6 +38438,16 @@ $root.query = (function() {
                 object.cpu_usage = options.json && !isFinite(message.cpu_usage) ? String(message.cpu_usage) : message.cpu_usage;
             if (message.qps != null && message.hasOwnProperty("qps"))
                 object.qps = options.json && !isFinite(message.qps) ? String(message.qps) : message.qps;
+            if (message.table_schema_changed && message.table_schema_changed.length) {
+                object.table_schema_changed = [];
+                for (var j = 0; j < message.table_schema_changed.length; ++j)
+                    object.table_schema_changed[j] = message.table_schema_changed[j];
+            }
+            if (message.table_statistics && message.table_statistics.length) {
+                object.table_statistics = [];
+                for (var j = 0; j < message.table_statistics.length; ++j)
+                    object.table_statistics[j] = $root.query.TableStatistics.toObject(message.table_statistics[j], options);
+            }
             return object;
         };
 
@@ -39272,6 +39355,298 @@ $root.query = (function() {
         return TransactionMetadata;
     })();
 
+    query.TableStatistics = (function() {
+
+        /**
+         * Properties of a TableStatistics.
+         * @memberof query
+         * @interface ITableStatistics
+         * @property {string|null} [name] TableStatistics name
+         * @property {number|Long|null} [rows] TableStatistics rows
+         * @property {Object.<string,number|Long>|null} [cardinality] TableStatistics cardinality
+         */
+
+        /**
+         * Constructs a new TableStatistics.
+         * @memberof query
+         * @classdesc Represents a TableStatistics.
+         * @implements ITableStatistics
+         * @constructor
+         * @param {query.ITableStatistics=} [properties] Properties to set
+         */
+        function TableStatistics(properties) {
+            this.cardinality = {};
+            if (properties)
+                for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                    if (properties[keys[i]] != null)
+                        this[keys[i]] = properties[keys[i]];
+        }
+
+        /**
+         * TableStatistics name.
+         * @member {string} name
+         * @memberof query.TableStatistics
+         * @instance
+         */
+        TableStatistics.prototype.name = "";
+
+        /**
+         * TableStatistics rows.
+         * @member {number|Long} rows
+         * @memberof query.TableStatistics
+         * @instance
+         */
+        TableStatistics.prototype.rows = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+
+        /**
+         * TableStatistics cardinality.
+         * @member {Object.<string,number|Long>} cardinality
+         * @memberof query.TableStatistics
+         * @instance
+         */
+        TableStatistics.prototype.cardinality = $util.emptyObject;
+
+        /**
+         * Creates a new TableStatistics instance using the specified properties.
+         * @function create
+         * @memberof query.TableStatistics
+         * @static
+         * @param {query.ITableStatistics=} [properties] Properties to set
+         * @returns {query.TableStatistics} TableStatistics instance
+         */
+        TableStatistics.create = function create(properties) {
+            return new TableStatistics(properties);
+        };
+
+        /**
+         * Encodes the specified TableStatistics message. Does not implicitly {@link query.TableStatistics.verify|verify} messages.
+         * @function encode
+         * @memberof query.TableStatistics
+         * @static
+         * @param {query.ITableStatistics} message TableStatistics message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        TableStatistics.encode = function encode(message, writer) {
+            if (!writer)
+                writer = $Writer.create();
+            if (message.name != null && Object.hasOwnProperty.call(message, "name"))
+                writer.uint32(/* id 1, wireType 2 =*/10).string(message.name);
+            if (message.rows != null && Object.hasOwnProperty.call(message, "rows"))
+                writer.uint32(/* id 2, wireType 0 =*/16).int64(message.rows);
+            if (message.cardinality != null && Object.hasOwnProperty.call(message, "cardinality"))
+                for (var keys = Object.keys(message.cardinality), i = 0; i < keys.length; ++i)
+                    writer.uint32(/* id 3, wireType 2 =*/26).fork().uint32(/* id 1, wireType 2 =*/10).string(keys[i]).uint32(/* id 2, wireType 0 =*/16).int64(message.cardinality[keys[i]]).ldelim();
+            return writer;
+        };
+
+        /**
+         * Encodes the specified TableStatistics message, length delimited. Does not implicitly {@link query.TableStatistics.verify|verify} messages.
+         * @function encodeDelimited
+         * @memberof query.TableStatistics
+         * @static
+         * @param {query.ITableStatistics} message TableStatistics message or plain object to encode
+         * @param {$protobuf.Writer} [writer] Writer to encode to
+         * @returns {$protobuf.Writer} Writer
+         */
+        TableStatistics.encodeDelimited = function encodeDelimited(message, writer) {
+            return this.encode(message, writer).ldelim();
+        };
+
+        /**
+         * Decodes a TableStatistics message from the specified reader or buffer.
+         * @function decode
+         * @memberof query.TableStatistics
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @param {number} [length] Message length if known beforehand
+         * @returns {query.TableStatistics} TableStatistics
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        TableStatistics.decode = function decode(reader, length) {
+            if (!(reader instanceof $Reader))
+                reader = $Reader.create(reader);
+            var end = length === undefined ? reader.len : reader.pos + length, message = new $root.query.TableStatistics(), key, value;
+            while (reader.pos < end) {
+                var tag = reader.uint32();
+                switch (tag >>> 3) {
+                case 1:
+                    message.name = reader.string();
+                    break;
+                case 2:
+                    message.rows = reader.int64();
+                    break;
+                case 3:
+                    if (message.cardinality === $util.emptyObject)
+                        message.cardinality = {};
+                    var end2 = reader.uint32() + reader.pos;
+                    key = "";
+                    value = 0;
+                    while (reader.pos < end2) {
+                        var tag2 = reader.uint32();
+                        switch (tag2 >>> 3) {
+                        case 1:
+                            key = reader.string();
+                            break;
+                        case 2:
+                            value = reader.int64();
+                            break;
+                        default:
+                            reader.skipType(tag2 & 7);
+                            break;
+                        }
+                    }
+                    message.cardinality[key] = value;
+                    break;
+                default:
+                    reader.skipType(tag & 7);
+                    break;
+                }
+            }
+            return message;
+        };
+
+        /**
+         * Decodes a TableStatistics message from the specified reader or buffer, length delimited.
+         * @function decodeDelimited
+         * @memberof query.TableStatistics
+         * @static
+         * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+         * @returns {query.TableStatistics} TableStatistics
+         * @throws {Error} If the payload is not a reader or valid buffer
+         * @throws {$protobuf.util.ProtocolError} If required fields are missing
+         */
+        TableStatistics.decodeDelimited = function decodeDelimited(reader) {
+            if (!(reader instanceof $Reader))
+                reader = new $Reader(reader);
+            return this.decode(reader, reader.uint32());
+        };
+
+        /**
+         * Verifies a TableStatistics message.
+         * @function verify
+         * @memberof query.TableStatistics
+         * @static
+         * @param {Object.<string,*>} message Plain object to verify
+         * @returns {string|null} `null` if valid, otherwise the reason why it is not
+         */
+        TableStatistics.verify = function verify(message) {
+            if (typeof message !== "object" || message === null)
+                return "object expected";
+            if (message.name != null && message.hasOwnProperty("name"))
+                if (!$util.isString(message.name))
+                    return "name: string expected";
+            if (message.rows != null && message.hasOwnProperty("rows"))
+                if (!$util.isInteger(message.rows) && !(message.rows && $util.isInteger(message.rows.low) && $util.isInteger(message.rows.high)))
+                    return "rows: integer|Long expected";
+            if (message.cardinality != null && message.hasOwnProperty("cardinality")) {
+                if (!$util.isObject(message.cardinality))
+                    return "cardinality: object expected";
+                var key = Object.keys(message.cardinality);
+                for (var i = 0; i < key.length; ++i)
+                    if (!$util.isInteger(message.cardinality[key[i]]) && !(message.cardinality[key[i]] && $util.isInteger(message.cardinality[key[i]].low) && $util.isInteger(message.cardinality[key[i]].high)))
+                        return "cardinality: integer|Long{k:string} expected";
+            }
+            return null;
+        };
+
+        /**
+         * Creates a TableStatistics message from a plain object. Also converts values to their respective internal types.
+         * @function fromObject
+         * @memberof query.TableStatistics
+         * @static
+         * @param {Object.<string,*>} object Plain object
+         * @returns {query.TableStatistics} TableStatistics
+         */
+        TableStatistics.fromObject = function fromObject(object) {
+            if (object instanceof $root.query.TableStatistics)
+                return object;
+            var message = new $root.query.TableStatistics();
+            if (object.name != null)
+                message.name = String(object.name);
+            if (object.rows != null)
+                if ($util.Long)
+                    (message.rows = $util.Long.fromValue(object.rows)).unsigned = false;
+                else if (typeof object.rows === "string")
+                    message.rows = parseInt(object.rows, 10);
+                else if (typeof object.rows === "number")
+                    message.rows = object.rows;
+                else if (typeof object.rows === "object")
+                    message.rows = new $util.LongBits(object.rows.low >>> 0, object.rows.high >>> 0).toNumber();
+            if (object.cardinality) {
+                if (typeof object.cardinality !== "object")
+                    throw TypeError(".query.TableStatistics.cardinality: object expected");
+                message.cardinality = {};
+                for (var keys = Object.keys(object.cardinality), i = 0; i < keys.length; ++i)
+                    if ($util.Long)
+                        (message.cardinality[keys[i]] = $util.Long.fromValue(object.cardinality[keys[i]])).unsigned = false;
+                    else if (typeof object.cardinality[keys[i]] === "string")
+                        message.cardinality[keys[i]] = parseInt(object.cardinality[keys[i]], 10);
+                    else if (typeof object.cardinality[keys[i]] === "number")
+                        message.cardinality[keys[i]] = object.cardinality[keys[i]];
+                    else if (typeof object.cardinality[keys[i]] === "object")
+                        message.cardinality[keys[i]] = new $util.LongBits(object.cardinality[keys[i]].low >>> 0, object.cardinality[keys[i]].high >>> 0).toNumber();
+            }
+            return message;
+        };
+
+        /**
+         * Creates a plain object from a TableStatistics message. Also converts values to other types if specified.
+         * @function toObject
+         * @memberof query.TableStatistics
+         * @static
+         * @param {query.TableStatistics} message TableStatistics
+         * @param {$protobuf.IConversionOptions} [options] Conversion options
+         * @returns {Object.<string,*>} Plain object
+         */
+        TableStatistics.toObject = function toObject(message, options) {
+            if (!options)
+                options = {};
+            var object = {};
+            if (options.objects || options.defaults)
+                object.cardinality = {};
+            if (options.defaults) {
+                object.name = "";
+                if ($util.Long) {
+                    var long = new $util.Long(0, 0, false);
+                    object.rows = options.longs === String ? long.toString() : options.longs === Number ? long.toNumber() : long;
+                } else
+                    object.rows = options.longs === String ? "0" : 0;
+            }
+            if (message.name != null && message.hasOwnProperty("name"))
+                object.name = message.name;
+            if (message.rows != null && message.hasOwnProperty("rows"))
+                if (typeof message.rows === "number")
+                    object.rows = options.longs === String ? String(message.rows) : message.rows;
+                else
+                    object.rows = options.longs === String ? $util.Long.prototype.toString.call(message.rows) : options.longs === Number ? new $util.LongBits(message.rows.low >>> 0, message.rows.high >>> 0).toNumber() : message.rows;
+            var keys2;
+            if (message.cardinality && (keys2 = Object.keys(message.cardinality)).length) {
+                object.cardinality = {};
+                for (var j = 0; j < keys2.length; ++j)
+                    if (typeof message.cardinality[keys2[j]] === "number")
+                        object.cardinality[keys2[j]] = options.longs === String ? String(message.cardinality[keys2[j]]) : message.cardinality[keys2[j]];
+                    else
+                        object.cardinality[keys2[j]] = options.longs === String ? $util.Long.prototype.toString.call(message.cardinality[keys2[j]]) : options.longs === Number ? new $util.LongBits(message.cardinality[keys2[j]].low >>> 0, message.cardinality[keys2[j]].high >>> 0).toNumber() : message.cardinality[keys2[j]];
+            }
+            return object;
+        };
+
+        /**
+         * Converts this TableStatistics to JSON.
+         * @function toJSON
+         * @memberof query.TableStatistics
+         * @instance
+         * @returns {Object.<string,*>} JSON object
+         */
+        TableStatistics.prototype.toJSON = function toJSON() {
+            return this.constructor.toObject(this, $protobuf.util.toJSONOptions);
+        };
+
+        return TableStatistics;
+    })();
+
     return query;
 })();
 
@@ -56890,6 +57265,8 @@ $root.vschema = (function() {
          * @property {Array.<vschema.IColumn>|null} [columns] Table columns
          * @property {string|null} [pinned] Table pinned
          * @property {boolean|null} [column_list_authoritative] Table column_list_authoritative
+         * @property {string|null} [source] Table source
+         * @property {string|null} [result_cache_ttl] Table result_cache_ttl
          */
 
         /**
@@ -56957,6 +57334,22 @@ $root.vschema = (function() {
          */
         Table.prototype.column_list_authoritative = false;
 
+        /**
+         * Table source.
+         * @member {string} source
+         * @memberof vschema.Table
+         * @instance
+         */
+        Table.prototype.source = "";
+
+        /**
+         * Table result_cache_ttl.
+         * @member {string} result_cache_ttl
+         * @memberof vschema.Table
+         * @instance
+         */
+        Table.prototype.result_cache_ttl = "";
+
         /**
          * Creates a new Table instance using the specified properties.
          * @function create
@@ -56995,6 +57388,10 @@ $root.vschema = (function() {
                 writer.uint32(/* id 5, wireType 2 =*/42).string(message.pinned);
             if (message.column_list_authoritative != null && Object.hasOwnProperty.call(message, "column_list_authoritative"))
                 writer.uint32(/* id 6, wireType 0 =*/48).bool(message.column_list_authoritative);
+            if (message.source != null && Object.hasOwnProperty.call(message, "source"))
+                writer.uint32(/* id 7, wireType 2 =*/58).string(message.source);
+            if (message.result_cache_ttl != null && Object.hasOwnProperty.call(message, "result_cache_ttl"))
+                writer.uint32(/* id 8, wireType 2 =*/66).string(message.result_cache_ttl);
             return writer;
         };
 
@@ -57051,6 +57448,12 @@ $root.vschema = (function() {
                 case 6:
                     message.column_list_authoritative = reader.bool();
                     break;
+                case 7:
+                    message.source = reader.string();
+                    break;
+                case 8:
+                    message.result_cache_ttl = reader.string();
+                    break;
                 default:
                     reader.skipType(tag & 7);
                     break;
@@ -57118,6 +57521,12 @@ $root.vschema = (function() {
             if (message.column_list_authoritative != null && message.hasOwnProperty("column_list_authoritative"))
                 if (typeof message.column_list_authoritative !== "boolean")
                     return "column_list_authoritative: boolean expected";
+            if (message.source != null && message.hasOwnProperty("source"))
+                if (!$util.isString(message.source))
+                    return "source: string expected";
+            if (message.result_cache_ttl != null && message.hasOwnProperty("result_cache_ttl"))
+                if (!$util.isString(message.result_cache_ttl))
+                    return "result_cache_ttl: string expected";
             return null;
         };
 
@@ -57164,6 +57573,10 @@ $root.vschema = (function() {
                 message.pinned = String(object.pinned);
             if (object.column_list_authoritative != null)
                 message.column_list_authoritative = Boolean(object.column_list_authoritative);
+            if (object.source != null)
+                message.source = String(object.source);
+            if (object.result_cache_ttl != null)
+                message.result_cache_ttl = String(object.result_cache_ttl);
             return message;
         };
 
@@ -57189,6 +57602,8 @@ $root.vschema = (function() {
                 object.auto_increment = null;
                 object.pinned = "";
                 object.column_list_authoritative = false;
+                object.source = "";
+                object.result_cache_ttl = "";
             }
             if (message.type != null && message.hasOwnProperty("type"))
                 object.type = message.type;
@@ -57208,6 +57623,10 @@ $root.vschema = (function() {
                 object.pinned = message.pinned;
             if (message.column_list_authoritative != null && message.hasOwnProperty("column_list_authoritative"))
                 object.column_list_authoritative = message.column_list_authoritative;
+            if (message.source != null && message.hasOwnProperty("source"))
+                object.source = message.source;
+            if (message.result_cache_ttl != null && message.hasOwnProperty("result_cache_ttl"))
+                object.result_cache_ttl = message.result_cache_ttl;
             return object;
         };
 